@@ -0,0 +1,34 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	lockupkeeper "github.com/osmosis-labs/osmosis/v16/x/lockup/keeper"
+)
+
+// bankAppModule is the bank module, with its message server wrapped to reject multi-sends of lock receipt tokens.
+type bankAppModule struct {
+	bank.AppModule
+
+	keeper bankkeeper.BaseKeeper
+}
+
+func newBankAppModule(cdc codec.Codec, keeper bankkeeper.BaseKeeper, accountKeeper banktypes.AccountKeeper) bankAppModule {
+	return bankAppModule{
+		AppModule: bank.NewAppModule(cdc, keeper, accountKeeper),
+		keeper:    keeper,
+	}
+}
+
+// RegisterServices registers the module services, as the bank module does.
+func (am bankAppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), lockupkeeper.NewLockReceiptBankMsgServer(bankkeeper.NewMsgServerImpl(am.keeper)))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), bankkeeper.Querier{BaseKeeper: am.keeper})
+
+	m := bankkeeper.NewMigrator(am.keeper)
+	cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2)
+}
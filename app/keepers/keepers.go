@@ -351,6 +351,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.tkeys[twaptypes.TransientStoreKey],
 		appKeepers.GetSubspace(twaptypes.ModuleName),
 		appKeepers.PoolManagerKeeper)
+	appKeepers.GAMMKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
 
 	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appKeepers.keys[epochstypes.StoreKey])
 
@@ -464,6 +465,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	)
 	appKeepers.WasmKeeper = &wasmKeeper
 	appKeepers.CosmwasmPoolKeeper.SetWasmKeeper(appKeepers.WasmKeeper)
+	appKeepers.GAMMKeeper.SetWasmKeeper(appKeepers.WasmKeeper)
 
 	// Pass the contract keeper to all the structs (generally ICS4Wrappers for ibc middlewares) that need it
 	appKeepers.ContractKeeper = wasmkeeper.NewDefaultPermissionKeeper(appKeepers.WasmKeeper)
@@ -678,6 +680,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 	appKeepers.BankKeeper.SetHooks(
 		banktypes.NewMultiBankHooks(
 			appKeepers.TokenFactoryKeeper.Hooks(),
+			appKeepers.IncentivesKeeper.Hooks(),
 		),
 	)
 
@@ -710,6 +713,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 		lockuptypes.NewMultiLockupHooks(
 			// insert lockup hooks receivers here
 			appKeepers.SuperfluidKeeper.Hooks(),
+			appKeepers.IncentivesKeeper.Hooks(),
 		),
 	)
 
@@ -735,6 +739,8 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.IncentivesKeeper.Hooks(),
 			appKeepers.MintKeeper.Hooks(),
 			appKeepers.ProtoRevKeeper.EpochHooks(),
+			appKeepers.ConcentratedLiquidityKeeper.EpochHooks(),
+			appKeepers.GAMMKeeper.EpochHooks(),
 		),
 	)
 
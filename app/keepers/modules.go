@@ -81,8 +81,15 @@ var AppModuleBasics = []module.AppModuleBasic{
 			superfluidclient.UpdateUnpoolWhitelistProposalHandler,
 			gammclient.ReplaceMigrationRecordsProposalHandler,
 			gammclient.UpdateMigrationRecordsProposalHandler,
+			gammclient.AddPoolAssetProposalHandler,
+			gammclient.RemovePoolAssetProposalHandler,
+			gammclient.RampAmplificationProposalHandler,
 			clclient.CreateConcentratedLiquidityPoolProposalHandler,
 			clclient.TickSpacingDecreaseProposalHandler,
+			clclient.TickSpacingUpdateProposalHandler,
+			clclient.SpreadFactorChangeProposalHandler,
+			clclient.DynamicSpreadFactorProposalHandler,
+			clclient.JITProtectionChangeProposalHandler,
 			cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 			cwpoolclient.MigratePoolContractsProposalHandler,
 		)...,
@@ -0,0 +1,49 @@
+package v17
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/osmosis-labs/osmosis/v16/app/keepers"
+	"github.com/osmosis-labs/osmosis/v16/app/upgrades"
+
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	bpm upgrades.BaseAppParamManager,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// Run migrations before applying any other state changes.
+		// NOTE: DO NOT PUT ANY STATE CHANGES BEFORE RunMigrations().
+		migrations, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		setDefaultEarlyUnlockConfigs(ctx, keepers)
+		setDefaultMinIncentiveAmounts(ctx, keepers)
+
+		return migrations, nil
+	}
+}
+
+// setDefaultEarlyUnlockConfigs sets the early unlock configs, newly added to the lockup params, to their default.
+// Reading the lockup params would otherwise panic, as the param is missing from the store.
+// The other lockup params are left unchanged.
+func setDefaultEarlyUnlockConfigs(ctx sdk.Context, keepers *keepers.AppKeepers) {
+	lockupParamSpace := keepers.GetSubspace(lockuptypes.ModuleName)
+	lockupParamSpace.Set(ctx, lockuptypes.KeyEarlyUnlockConfigs, lockuptypes.DefaultParams().EarlyUnlockConfigs)
+}
+
+// setDefaultMinIncentiveAmounts sets the min incentive amounts, newly added to the concentrated liquidity params,
+// to their default. The other concentrated liquidity params are left unchanged.
+func setDefaultMinIncentiveAmounts(ctx sdk.Context, keepers *keepers.AppKeepers) {
+	clParamSpace := keepers.GetSubspace(cltypes.ModuleName)
+	clParamSpace.Set(ctx, cltypes.KeyMinIncentiveAmounts, cltypes.DefaultMinIncentiveAmounts)
+}
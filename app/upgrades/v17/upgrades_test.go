@@ -0,0 +1,116 @@
+package v17_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	v17 "github.com/osmosis-labs/osmosis/v16/app/upgrades/v17"
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+)
+
+type UpgradeTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func (suite *UpgradeTestSuite) SetupTest() {
+	suite.Setup()
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+const dummyUpgradeHeight = 5
+
+func dummyUpgrade(suite *UpgradeTestSuite) {
+	suite.Ctx = suite.Ctx.WithBlockHeight(dummyUpgradeHeight - 1)
+	plan := upgradetypes.Plan{Name: v17.UpgradeName, Height: dummyUpgradeHeight}
+	err := suite.App.UpgradeKeeper.ScheduleUpgrade(suite.Ctx, plan)
+	suite.Require().NoError(err)
+	_, exists := suite.App.UpgradeKeeper.GetUpgradePlan(suite.Ctx)
+	suite.Require().True(exists)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(dummyUpgradeHeight)
+}
+
+func (suite *UpgradeTestSuite) TestUpgrade() {
+	testCases := []struct {
+		name         string
+		pre_upgrade  func()
+		upgrade      func()
+		post_upgrade func()
+	}{
+		{
+			"Test that the upgrade sets the default early unlock configs",
+			func() {
+				// Keep a non default param, which must be left unchanged by the upgrade.
+				params := suite.App.LockupKeeper.GetParams(suite.Ctx)
+				params.ForceUnlockAllowedAddresses = []string{suite.TestAccs[0].String()}
+				suite.App.LockupKeeper.SetParams(suite.Ctx, params)
+
+				// Remove the early unlock configs, as they are missing from the store before the upgrade.
+				paramsStore := prefix.NewStore(suite.Ctx.KVStore(suite.App.AppKeepers.GetKey(paramstypes.StoreKey)), []byte(lockuptypes.ModuleName+"/"))
+				paramsStore.Delete(lockuptypes.KeyEarlyUnlockConfigs)
+				suite.Require().Panics(func() {
+					suite.App.LockupKeeper.GetParams(suite.Ctx)
+				})
+			},
+			func() {
+				dummyUpgrade(suite)
+				suite.Require().NotPanics(func() {
+					suite.App.BeginBlocker(suite.Ctx, abci.RequestBeginBlock{})
+				})
+			},
+			func() {
+				params := suite.App.LockupKeeper.GetParams(suite.Ctx)
+				suite.Require().Empty(params.EarlyUnlockConfigs)
+				suite.Require().Equal([]string{suite.TestAccs[0].String()}, params.ForceUnlockAllowedAddresses)
+			},
+		},
+		{
+			"Test that the upgrade sets the default min incentive amounts",
+			func() {
+				// Keep a non default param, which must be left unchanged by the upgrade.
+				params := suite.App.ConcentratedLiquidityKeeper.GetParams(suite.Ctx)
+				params.IsPermissionlessPoolCreationEnabled = true
+				suite.App.ConcentratedLiquidityKeeper.SetParams(suite.Ctx, params)
+
+				// Remove the min incentive amounts, as they are missing from the store before the upgrade.
+				paramsStore := prefix.NewStore(suite.Ctx.KVStore(suite.App.AppKeepers.GetKey(paramstypes.StoreKey)), []byte(cltypes.ModuleName+"/"))
+				paramsStore.Delete(cltypes.KeyMinIncentiveAmounts)
+				suite.Require().Panics(func() {
+					suite.App.ConcentratedLiquidityKeeper.GetParams(suite.Ctx)
+				})
+			},
+			func() {
+				dummyUpgrade(suite)
+				suite.Require().NotPanics(func() {
+					suite.App.BeginBlocker(suite.Ctx, abci.RequestBeginBlock{})
+				})
+			},
+			func() {
+				params := suite.App.ConcentratedLiquidityKeeper.GetParams(suite.Ctx)
+				suite.Require().Equal(cltypes.DefaultMinIncentiveAmounts, params.MinIncentiveAmounts)
+				suite.Require().True(params.IsPermissionlessPoolCreationEnabled)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.pre_upgrade()
+			tc.upgrade()
+			tc.post_upgrade()
+		})
+	}
+}
@@ -199,6 +199,13 @@ func ParseFieldFromArg(fVal reflect.Value, fType reflect.StructField, arg string
 		}
 		fVal.SetInt(i)
 		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(arg)
+		if err != nil {
+			return fmt.Errorf("could not parse %s as bool for field %s: %w", arg, fType.Name, err)
+		}
+		fVal.SetBool(b)
+		return nil
 	case reflect.Float32, reflect.Float64:
 		typeStr := fType.Type.String()
 		f, err := ParseFloat(arg, typeStr)
@@ -231,6 +238,20 @@ func ParseFieldFromArg(fVal reflect.Value, fType reflect.StructField, arg string
 			fVal.Set(reflect.ValueOf(values))
 			return nil
 		}
+		if typeStr == "[]types.Dec" {
+			// Parse comma-separated decimal values into []sdk.Dec slice
+			strValues := strings.Split(arg, ",")
+			values := make([]sdk.Dec, len(strValues))
+			for i, strValue := range strValues {
+				d, err := ParseSdkDec(strValue, fType.Name)
+				if err != nil {
+					return err
+				}
+				values[i] = d
+			}
+			fVal.Set(reflect.ValueOf(values))
+			return nil
+		}
 		if typeStr == "types.Coins" {
 			coins, err := ParseCoins(arg, fType.Name)
 			if err != nil {
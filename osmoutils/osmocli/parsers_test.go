@@ -19,6 +19,7 @@ type testingStruct struct {
 	Slice    sdk.Coins
 	Struct   interface{}
 	Dec      sdk.Dec
+	Decs     []sdk.Dec
 }
 
 func TestParseFieldFromArg(t *testing.T) {
@@ -144,6 +145,18 @@ func TestParseFieldFromArg(t *testing.T) {
 			fieldIndex:     8,
 			expectedStruct: testingStruct{Dec: sdk.MustNewDecFromStr("10")},
 		},
+		"Dec slice": {
+			testingStruct:  testingStruct{Decs: []sdk.Dec{sdk.MustNewDecFromStr("1")}},
+			arg:            "0.005,0.01",
+			fieldIndex:     9,
+			expectedStruct: testingStruct{Decs: []sdk.Dec{sdk.MustNewDecFromStr("0.005"), sdk.MustNewDecFromStr("0.01")}},
+		},
+		"Invalid Dec slice": {
+			testingStruct: testingStruct{},
+			arg:           "0.005,foo",
+			fieldIndex:    9,
+			expectingErr:  true,
+		},
 	}
 
 	for name, tc := range tests {
@@ -164,6 +164,20 @@ func (mr *MockConcentratedPoolExtensionMockRecorder) GetIncentivesAddress() *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncentivesAddress", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).GetIncentivesAddress))
 }
 
+// GetJITProtectionBlocks mocks base method.
+func (m *MockConcentratedPoolExtension) GetJITProtectionBlocks() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJITProtectionBlocks")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetJITProtectionBlocks indicates an expected call of GetJITProtectionBlocks.
+func (mr *MockConcentratedPoolExtensionMockRecorder) GetJITProtectionBlocks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJITProtectionBlocks", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).GetJITProtectionBlocks))
+}
+
 // GetLastLiquidityUpdate mocks base method.
 func (m *MockConcentratedPoolExtension) GetLastLiquidityUpdate() time.Time {
 	m.ctrl.T.Helper()
@@ -338,6 +352,18 @@ func (mr *MockConcentratedPoolExtensionMockRecorder) SetCurrentTick(newTick inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCurrentTick", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).SetCurrentTick), newTick)
 }
 
+// SetJITProtectionBlocks mocks base method.
+func (m *MockConcentratedPoolExtension) SetJITProtectionBlocks(newJITProtectionBlocks uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetJITProtectionBlocks", newJITProtectionBlocks)
+}
+
+// SetJITProtectionBlocks indicates an expected call of SetJITProtectionBlocks.
+func (mr *MockConcentratedPoolExtensionMockRecorder) SetJITProtectionBlocks(newJITProtectionBlocks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetJITProtectionBlocks", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).SetJITProtectionBlocks), newJITProtectionBlocks)
+}
+
 // SetLastLiquidityUpdate mocks base method.
 func (m *MockConcentratedPoolExtension) SetLastLiquidityUpdate(newTime time.Time) {
 	m.ctrl.T.Helper()
@@ -350,6 +376,18 @@ func (mr *MockConcentratedPoolExtensionMockRecorder) SetLastLiquidityUpdate(newT
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastLiquidityUpdate", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).SetLastLiquidityUpdate), newTime)
 }
 
+// SetSpreadFactor mocks base method.
+func (m *MockConcentratedPoolExtension) SetSpreadFactor(newSpreadFactor types.Dec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSpreadFactor", newSpreadFactor)
+}
+
+// SetSpreadFactor indicates an expected call of SetSpreadFactor.
+func (mr *MockConcentratedPoolExtensionMockRecorder) SetSpreadFactor(newSpreadFactor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSpreadFactor", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).SetSpreadFactor), newSpreadFactor)
+}
+
 // SetTickSpacing mocks base method.
 func (m *MockConcentratedPoolExtension) SetTickSpacing(newTickSpacing uint64) {
 	m.ctrl.T.Helper()
@@ -159,6 +159,9 @@ func init() {
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/PositionById", &concentratedliquidityquery.PositionByIdResponse{})
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/Params", &concentratedliquidityquery.ParamsResponse{})
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards", &concentratedliquidityquery.ClaimableSpreadRewardsResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/Observe", &concentratedliquidityquery.ObserveResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/LiquidityDepth", &concentratedliquidityquery.LiquidityDepthResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/PositionPerformance", &concentratedliquidityquery.PositionPerformanceResponse{})
 }
 
 // GetWhitelistedQuery returns the whitelisted query at the provided path.
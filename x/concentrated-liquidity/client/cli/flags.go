@@ -5,9 +5,15 @@ import (
 )
 
 const (
-	FlagPoolId                     = "pool-id"
-	FlagPoolIdToTickSpacingRecords = "pool-tick-spacing-records"
-	FlagPoolRecords                = "pool-records"
+	FlagPoolId                             = "pool-id"
+	FlagPoolIdToTickSpacingRecords         = "pool-tick-spacing-records"
+	FlagPoolIdToSpreadFactorRecords        = "pool-spread-factor-records"
+	FlagPoolIdToDynamicSpreadFactorRecords = "pool-dynamic-spread-factor-records"
+	FlagPoolIdToJITProtectionBlocksRecords = "pool-jit-protection-blocks-records"
+	FlagPoolRecords                        = "pool-records"
+	FlagSwapRoutePoolIds                   = "swap-route-pool-ids"
+	FlagSwapRouteDenoms                    = "swap-route-denoms"
+	FlagEmissionSchedule                   = "emission-schedule"
 )
 
 func FlagSetJustPoolId() *flag.FlagSet {
@@ -15,3 +21,16 @@ func FlagSetJustPoolId() *flag.FlagSet {
 	fs.Uint64(FlagPoolId, 0, "The id of pool")
 	return fs
 }
+
+func FlagSetSwapRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagSwapRoutePoolIds, "", "comma-separated pool ids of the swap route")
+	fs.String(FlagSwapRouteDenoms, "", "comma-separated token out denoms of the swap route")
+	return fs
+}
+
+func FlagSetEmissionSchedule() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagEmissionSchedule, "", "comma-separated duration, start emission rate and end emission rate of each emission schedule segment")
+	return fs
+}
@@ -19,6 +19,12 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetClaimableIncentives)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetIncentiveRecords)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCFMMPoolIdLinkFromConcentratedPoolId)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetObserve)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetLiquidityDepth)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPositionPerformance)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetSwapTraceExactAmountIn)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetSwapTraceExactAmountOut)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetDynamicSpreadFactor)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -92,3 +98,57 @@ func GetCFMMPoolIdLinkFromConcentratedPoolId() (*osmocli.QueryDescriptor, *query
 {{.CommandPrefix}} cfmm-pool-link-from-cl 1`,
 	}, &queryproto.CFMMPoolIdLinkFromConcentratedPoolIdRequest{}
 }
+
+func GetObserve() (*osmocli.QueryDescriptor, *queryproto.ObserveRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "observe [poolID] [secondsAgos]",
+		Short: "Query the tick cumulative and seconds per liquidity cumulative values of a pool as of each of the given seconds ago",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} observe 1 0,60,3600`,
+	}, &queryproto.ObserveRequest{}
+}
+
+func GetLiquidityDepth() (*osmocli.QueryDescriptor, *queryproto.LiquidityDepthRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "liquidity-depth [poolID] [priceChanges]",
+		Short: "Query the amount of each token needed to move a pool's spot price up and down by each of the given fractions",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} liquidity-depth 1 0.005,0.01,0.02,0.05`,
+	}, &queryproto.LiquidityDepthRequest{}
+}
+
+func GetPositionPerformance() (*osmocli.QueryDescriptor, *queryproto.PositionPerformanceRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "position-performance [positionID]",
+		Short: "Query the lifetime deposits, withdrawals and collected rewards of a position along with its current underlying assets",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} position-performance 53`,
+	}, &queryproto.PositionPerformanceRequest{}
+}
+
+func GetSwapTraceExactAmountIn() (*osmocli.QueryDescriptor, *queryproto.SwapTraceExactAmountInRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "swap-trace-exact-amount-in [poolID] [tokenIn] [tokenOutDenom]",
+		Short: "Query every step of a swap of an exact amount in, including the ticks crossed and the spread charged per step",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} swap-trace-exact-amount-in 1 1000000uosmo uion`,
+	}, &queryproto.SwapTraceExactAmountInRequest{}
+}
+
+func GetSwapTraceExactAmountOut() (*osmocli.QueryDescriptor, *queryproto.SwapTraceExactAmountOutRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "swap-trace-exact-amount-out [poolID] [tokenOut] [tokenInDenom]",
+		Short: "Query every step of a swap of an exact amount out, including the ticks crossed and the spread charged per step",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} swap-trace-exact-amount-out 1 1000000uion uosmo`,
+	}, &queryproto.SwapTraceExactAmountOutRequest{}
+}
+
+func GetDynamicSpreadFactor() (*osmocli.QueryDescriptor, *queryproto.DynamicSpreadFactorRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "dynamic-spread-factor [poolID]",
+		Short: "Query the dynamic spread factor bounds and the current dynamic spread factor of a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} dynamic-spread-factor 1`,
+	}, &queryproto.DynamicSpreadFactorRequest{}
+}
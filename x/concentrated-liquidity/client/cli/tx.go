@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
@@ -18,6 +20,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	clmodel "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 )
 
 func NewTxCmd() *cobra.Command {
@@ -29,6 +32,19 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewCollectSpreadRewardsCmd)
 	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
 	osmocli.AddTxCmd(txCmd, NewFungifyChargedPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewTransferPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewCompoundPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewSetPositionAutoCompoundCmd)
+	osmocli.AddTxCmd(txCmd, NewSetPositionOperatorCmd)
+	osmocli.AddTxCmd(txCmd, NewRerangePositionCmd)
+	osmocli.AddTxCmd(txCmd, NewIncreaseObservationCardinalityCmd)
+	osmocli.AddTxCmd(txCmd, NewSplitPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewMergePositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewCreateIncentiveCmd)
+	osmocli.AddTxCmd(txCmd, NewAddToIncentiveCmd)
+	osmocli.AddTxCmd(txCmd, NewReduceIncentiveEmissionRateCmd)
+	osmocli.AddTxCmd(txCmd, NewCancelIncentiveCmd)
+	osmocli.AddTxCmd(txCmd, NewCreatePositionFromSingleAssetCmd)
 	return txCmd
 }
 
@@ -93,6 +109,73 @@ func NewFungifyChargedPositionsCmd() (*osmocli.TxCliDesc, *types.MsgFungifyCharg
 	}, &types.MsgFungifyChargedPositions{}
 }
 
+func NewTransferPositionsCmd() (*osmocli.TxCliDesc, *types.MsgTransferPositions) {
+	return &osmocli.TxCliDesc{
+		Use:     "transfer-positions [position-ids] [new-owner]",
+		Short:   "transfer a list of concentrated liquidity positions to a new owner",
+		Long:    "outstanding spread rewards and incentives of the positions are collected by the sender before the transfer",
+		Example: "osmosisd tx concentratedliquidity transfer-positions 1,2 osmo1hr8xy5uu3r9pumhsw9cqzr5znc7zrjnp6d3xdn --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+	}, &types.MsgTransferPositions{}
+}
+
+func NewCompoundPositionCmd() (*osmocli.TxCliDesc, *types.MsgCompoundPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "compound-position [position-id] [token-0-min-amount] [token-1-min-amount]",
+		Short:   "collect the spread rewards and incentives of a concentrated liquidity position and add them back to the position",
+		Long:    "the imbalance between the collected tokens is swapped through the position's pool. The position is replaced by a new position with a new id",
+		Example: "osmosisd tx concentratedliquidity compound-position 1 0 0 --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+	}, &types.MsgCompoundPosition{}
+}
+
+func NewSetPositionAutoCompoundCmd() (*osmocli.TxCliDesc, *types.MsgSetPositionAutoCompound) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-position-auto-compound [position-id] [auto-compound]",
+		Short:   "opt a concentrated liquidity position in or out of being compounded at the end of every day epoch",
+		Example: "osmosisd tx concentratedliquidity set-position-auto-compound 1 true --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+	}, &types.MsgSetPositionAutoCompound{}
+}
+
+func NewSetPositionOperatorCmd() (*osmocli.TxCliDesc, *types.MsgSetPositionOperator) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-position-operator [operator] [approved] [position-id]",
+		Short:   "approve or revoke an operator that may withdraw from, add to, collect from and re-range one of the sender's positions",
+		Long:    "tokens leaving a position as a result of an operator action are always sent to the position owner",
+		Example: "osmosisd tx concentratedliquidity set-position-operator osmo1hr8xy5uu3r9pumhsw9cqzr5znc7zrjnp6d3xdn true 1 --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+	}, &types.MsgSetPositionOperator{}
+}
+
+func NewRerangePositionCmd() (*osmocli.TxCliDesc, *types.MsgRerangePosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "rerange-position [position-id] [lower-tick] [upper-tick] [token-0-min-amount] [token-1-min-amount]",
+		Short:   "move a concentrated liquidity position to a new tick range",
+		Long:    "the position is withdrawn in full and a new position is created for the owner over the new range. May be submitted by the owner or an approved operator",
+		Example: "osmosisd tx concentratedliquidity rerange-position 1 \"[-69082]\" 69082 0 0 --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+	}, &types.MsgRerangePosition{}
+}
+
+func NewCreatePositionFromSingleAssetCmd() (*osmocli.TxCliDesc, *types.MsgCreatePositionFromSingleAsset) {
+	return &osmocli.TxCliDesc{
+		Use:   "create-position-from-single-asset [pool-id] [lower-tick] [upper-tick] [token-in] [token-0-min-amount] [token-1-min-amount]",
+		Short: "create a concentrated liquidity position from a single pool token",
+		Long: "part of the token in is swapped into the other pool token in the ratio required by the tick range at the current price. " +
+			"The swap goes through the pool itself, or through the route given with the swap route flags. Any dust remains in the sender's balance",
+		Example: "osmosisd tx concentratedliquidity create-position-from-single-asset 1 \"[-69082]\" 69082 1000000uosmo 0 0 --swap-route-pool-ids 2 --swap-route-denoms uion --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes": osmocli.FlagOnlyParser(parseSwapAmountInRoutes),
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetSwapRoutes()}},
+	}, &types.MsgCreatePositionFromSingleAsset{}
+}
+
+func NewIncreaseObservationCardinalityCmd() (*osmocli.TxCliDesc, *types.MsgIncreaseObservationCardinality) {
+	return &osmocli.TxCliDesc{
+		Use:     "increase-observation-cardinality [pool-id] [cardinality-next]",
+		Short:   "grow the number of tick cumulative observations stored for a concentrated liquidity pool",
+		Long:    "the sender pays for the storage of the additional observations",
+		Example: "osmosisd tx concentratedliquidity increase-observation-cardinality 1 100 --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+	}, &types.MsgIncreaseObservationCardinality{}
+}
+
 // NewCmdCreateConcentratedLiquidityPoolsProposal implements a command handler for create concentrated liquidity pool proposal
 func NewCmdCreateConcentratedLiquidityPoolsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -207,6 +290,232 @@ Note: The new tick spacing value must be less than the current tick spacing valu
 	return cmd
 }
 
+func NewTickSpacingUpdateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tick-spacing-update-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a tick spacing update proposal",
+		Long: strings.TrimSpace(`Submit a tick spacing update proposal.
+
+Passing in FlagPoolIdToTickSpacingRecords separated by commas would be parsed automatically to pairs of PoolIdToTickSpacing records.
+Ex) --pool-tick-spacing-records=1,10,5,1 -> [(poolId 1, newTickSpacing 10), (poolId 5, newTickSpacing 1)]
+Note: The new tick spacing value must be one of the authorized tick spacings and differ from the current tick spacing value.
+When the tick spacing is increased, the ticks of existing positions that are not divisible by it are moved outward.
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parsePoolIdToTickSpacingUpdateRecordsArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().String(FlagPoolIdToTickSpacingRecords, "", "The pool ID to new tick spacing records array")
+
+	return cmd
+}
+
+func NewSpreadFactorChangeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spread-factor-change-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a spread factor change proposal",
+		Long: strings.TrimSpace(`Submit a spread factor change proposal.
+
+Passing in FlagPoolIdToSpreadFactorRecords separated by commas would be parsed automatically to pairs of PoolIdToSpreadFactor records.
+Ex) --pool-spread-factor-records=1,0.003,5,0.0005 -> [(poolId 1, newSpreadFactor 0.003), (poolId 5, newSpreadFactor 0.0005)]
+Note: The new spread factor must be one of the authorized spread factors.
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parsePoolIdToSpreadFactorRecordsArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().String(FlagPoolIdToSpreadFactorRecords, "", "The pool ID to new spread factor records array")
+
+	return cmd
+}
+
+func NewDynamicSpreadFactorProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dynamic-spread-factor-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a dynamic spread factor proposal",
+		Long: strings.TrimSpace(`Submit a dynamic spread factor proposal.
+
+Passing in FlagPoolIdToDynamicSpreadFactorRecords separated by commas would be parsed automatically to tuples of PoolIdToDynamicSpreadFactor records.
+Each record is made of the pool id, whether the dynamic spread factor is enabled, the min spread factor, the max spread factor, the volatility multiplier and the lookback duration.
+Ex) --pool-dynamic-spread-factor-records=1,true,0.0005,0.01,2,1h,5,false,0,0,0,0s -> [(poolId 1, enabled, min 0.0005, max 0.01, multiplier 2, lookback 1h), (poolId 5, disabled)]
+Note: The bounds, multiplier and lookback duration of disabled records are ignored.
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parsePoolIdToDynamicSpreadFactorRecordsArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().String(FlagPoolIdToDynamicSpreadFactorRecords, "", "The pool ID to dynamic spread factor records array")
+
+	return cmd
+}
+
+func NewJITProtectionChangeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jit-protection-change-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a JIT protection change proposal",
+		Long: strings.TrimSpace(`Submit a JIT protection change proposal.
+
+Passing in FlagPoolIdToJITProtectionBlocksRecords separated by commas would be parsed automatically to pairs of PoolIdToJITProtectionBlocks records.
+Ex) --pool-jit-protection-blocks-records=1,10,5,0 -> [(poolId 1, newJITProtectionBlocks 10), (poolId 5, newJITProtectionBlocks 0)]
+Note: Positions withdrawn within the JIT protection blocks of their creation forfeit their spread rewards. Zero disables the protection.
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parsePoolIdToJITProtectionBlocksRecordsArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().String(FlagPoolIdToJITProtectionBlocksRecords, "", "The pool ID to new JIT protection blocks records array")
+
+	return cmd
+}
+
 func parseCreateConcentratedLiquidityPoolArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
@@ -256,6 +565,30 @@ func parsePoolIdToTickSpacingRecordsArgsToContent(cmd *cobra.Command) (govtypes.
 	return content, nil
 }
 
+func parsePoolIdToTickSpacingUpdateRecordsArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIdToTickSpacingRecords, err := parsePoolIdToTickSpacingRecords(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.TickSpacingUpdateProposal{
+		Title:                      title,
+		Description:                description,
+		PoolIdToTickSpacingRecords: poolIdToTickSpacingRecords,
+	}
+	return content, nil
+}
+
 func parsePoolIdToTickSpacingRecords(cmd *cobra.Command) ([]types.PoolIdToTickSpacingRecord, error) {
 	assetsStr, err := cmd.Flags().GetString(FlagPoolIdToTickSpacingRecords)
 	if err != nil {
@@ -292,6 +625,206 @@ func parsePoolIdToTickSpacingRecords(cmd *cobra.Command) ([]types.PoolIdToTickSp
 	return poolIdToTickSpacingRecords, nil
 }
 
+func parsePoolIdToSpreadFactorRecordsArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIdToSpreadFactorRecords, err := parsePoolIdToSpreadFactorRecords(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.SpreadFactorChangeProposal{
+		Title:                       title,
+		Description:                 description,
+		PoolIdToSpreadFactorRecords: poolIdToSpreadFactorRecords,
+	}
+	return content, nil
+}
+
+func parsePoolIdToSpreadFactorRecords(cmd *cobra.Command) ([]types.PoolIdToSpreadFactorRecord, error) {
+	recordsStr, err := cmd.Flags().GetString(FlagPoolIdToSpreadFactorRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	records := strings.Split(recordsStr, ",")
+
+	if len(records)%2 != 0 {
+		return nil, fmt.Errorf("poolIdToSpreadFactorRecords must be a list of pairs of poolId and newSpreadFactor")
+	}
+
+	poolIdToSpreadFactorRecords := []types.PoolIdToSpreadFactorRecord{}
+	i := 0
+	for i < len(records) {
+		poolId, err := strconv.ParseUint(records[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		newSpreadFactor, err := sdk.NewDecFromStr(records[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		poolIdToSpreadFactorRecords = append(poolIdToSpreadFactorRecords, types.PoolIdToSpreadFactorRecord{
+			PoolId:          poolId,
+			NewSpreadFactor: newSpreadFactor,
+		})
+
+		// increase counter by the next 2
+		i = i + 2
+	}
+
+	return poolIdToSpreadFactorRecords, nil
+}
+
+func parsePoolIdToDynamicSpreadFactorRecordsArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIdToDynamicSpreadFactorRecords, err := parsePoolIdToDynamicSpreadFactorRecords(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.DynamicSpreadFactorProposal{
+		Title:                              title,
+		Description:                        description,
+		PoolIdToDynamicSpreadFactorRecords: poolIdToDynamicSpreadFactorRecords,
+	}
+	return content, nil
+}
+
+func parsePoolIdToDynamicSpreadFactorRecords(cmd *cobra.Command) ([]types.PoolIdToDynamicSpreadFactorRecord, error) {
+	recordsStr, err := cmd.Flags().GetString(FlagPoolIdToDynamicSpreadFactorRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	records := strings.Split(recordsStr, ",")
+
+	if len(records)%6 != 0 {
+		return nil, fmt.Errorf("poolIdToDynamicSpreadFactorRecords must be a list of tuples of poolId, enabled, minSpreadFactor, maxSpreadFactor, volatilityMultiplier and lookbackDuration")
+	}
+
+	poolIdToDynamicSpreadFactorRecords := []types.PoolIdToDynamicSpreadFactorRecord{}
+	i := 0
+	for i < len(records) {
+		poolId, err := strconv.ParseUint(records[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		enabled, err := strconv.ParseBool(records[i+1])
+		if err != nil {
+			return nil, err
+		}
+		minSpreadFactor, err := sdk.NewDecFromStr(records[i+2])
+		if err != nil {
+			return nil, err
+		}
+		maxSpreadFactor, err := sdk.NewDecFromStr(records[i+3])
+		if err != nil {
+			return nil, err
+		}
+		volatilityMultiplier, err := sdk.NewDecFromStr(records[i+4])
+		if err != nil {
+			return nil, err
+		}
+		lookbackDuration, err := time.ParseDuration(records[i+5])
+		if err != nil {
+			return nil, err
+		}
+
+		poolIdToDynamicSpreadFactorRecords = append(poolIdToDynamicSpreadFactorRecords, types.PoolIdToDynamicSpreadFactorRecord{
+			PoolId:               poolId,
+			Enabled:              enabled,
+			MinSpreadFactor:      minSpreadFactor,
+			MaxSpreadFactor:      maxSpreadFactor,
+			VolatilityMultiplier: volatilityMultiplier,
+			LookbackDuration:     lookbackDuration,
+		})
+
+		// increase counter by the next 6
+		i = i + 6
+	}
+
+	return poolIdToDynamicSpreadFactorRecords, nil
+}
+
+func parsePoolIdToJITProtectionBlocksRecordsArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIdToJITProtectionBlocksRecords, err := parsePoolIdToJITProtectionBlocksRecords(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.JITProtectionChangeProposal{
+		Title:                              title,
+		Description:                        description,
+		PoolIdToJitProtectionBlocksRecords: poolIdToJITProtectionBlocksRecords,
+	}
+	return content, nil
+}
+
+func parsePoolIdToJITProtectionBlocksRecords(cmd *cobra.Command) ([]types.PoolIdToJITProtectionBlocksRecord, error) {
+	recordsStr, err := cmd.Flags().GetString(FlagPoolIdToJITProtectionBlocksRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	records := strings.Split(recordsStr, ",")
+
+	if len(records)%2 != 0 {
+		return nil, fmt.Errorf("poolIdToJITProtectionBlocksRecords must be a list of pairs of poolId and newJITProtectionBlocks")
+	}
+
+	poolIdToJITProtectionBlocksRecords := []types.PoolIdToJITProtectionBlocksRecord{}
+	i := 0
+	for i < len(records) {
+		poolId, err := strconv.ParseUint(records[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		newJITProtectionBlocks, err := strconv.ParseUint(records[i+1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		poolIdToJITProtectionBlocksRecords = append(poolIdToJITProtectionBlocksRecords, types.PoolIdToJITProtectionBlocksRecord{
+			PoolId:                 poolId,
+			NewJitProtectionBlocks: newJITProtectionBlocks,
+		})
+
+		// increase counter by the next 2
+		i = i + 2
+	}
+
+	return poolIdToJITProtectionBlocksRecords, nil
+}
+
 func parsePoolRecords(cmd *cobra.Command) ([]types.PoolRecord, error) {
 	poolRecordsStr, err := cmd.Flags().GetString(FlagPoolRecords)
 	if err != nil {
@@ -341,3 +874,130 @@ func parsePoolRecords(cmd *cobra.Command) ([]types.PoolRecord, error) {
 
 	return finalPoolRecords, nil
 }
+
+func NewSplitPositionCmd() (*osmocli.TxCliDesc, *types.MsgSplitPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "split-position [position-id] [liquidity]",
+		Short:   "split a concentrated liquidity position into two positions over the same range",
+		Long:    "the given liquidity is moved to the second new position and the rest is kept by the first new position together with the unclaimed rewards",
+		Example: "osmosisd tx concentratedliquidity split-position 1 1000000 --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+	}, &types.MsgSplitPosition{}
+}
+
+func NewMergePositionsCmd() (*osmocli.TxCliDesc, *types.MsgMergePositions) {
+	return &osmocli.TxCliDesc{
+		Use:     "merge-positions [position-ids]",
+		Short:   "merge concentrated liquidity positions over the same range into a single position",
+		Long:    "the positions must either all be fully charged or have joined at the same time",
+		Example: "osmosisd tx concentratedliquidity merge-positions 1,2 --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+	}, &types.MsgMergePositions{}
+}
+
+func NewCreateIncentiveCmd() (*osmocli.TxCliDesc, *types.MsgCreateIncentive) {
+	return &osmocli.TxCliDesc{
+		Use:   "create-incentive [pool-id] [incentive-coin] [emission-rate] [start-time] [min-uptime]",
+		Short: "create an incentive record on a concentrated liquidity pool",
+		Long: "the sender funds the incentive and is the only account that may later top up, reduce the emission rate of or cancel it. start-time is a unix timestamp or a sortable timestamp. " +
+			"An emission schedule of segments given as duration,start-emission-rate,end-emission-rate is emitted along first, after which the incentive emits at emission-rate, which may then be zero",
+		Example: "osmosisd tx concentratedliquidity create-incentive 1 1000000uion 0 1685000000 24h --emission-schedule 24h,0,0,168h,1,0 --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"EmissionSchedule": osmocli.FlagOnlyParser(parseEmissionSchedule),
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetEmissionSchedule()}},
+	}, &types.MsgCreateIncentive{}
+}
+
+func NewAddToIncentiveCmd() (*osmocli.TxCliDesc, *types.MsgAddToIncentive) {
+	return &osmocli.TxCliDesc{
+		Use:     "add-to-incentive [pool-id] [incentive-id] [incentive-coin]",
+		Short:   "top up an incentive record created by the sender",
+		Long:    "the emission rate is unchanged, so the incentive emits for longer",
+		Example: "osmosisd tx concentratedliquidity add-to-incentive 1 5 1000000uion --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+	}, &types.MsgAddToIncentive{}
+}
+
+func NewReduceIncentiveEmissionRateCmd() (*osmocli.TxCliDesc, *types.MsgReduceIncentiveEmissionRate) {
+	return &osmocli.TxCliDesc{
+		Use:     "reduce-incentive-emission-rate [pool-id] [incentive-id] [emission-rate]",
+		Short:   "reduce the emission rate of an incentive record created by the sender",
+		Long:    "the remaining coin is unchanged, so the incentive emits for longer",
+		Example: "osmosisd tx concentratedliquidity reduce-incentive-emission-rate 1 5 0.25 --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+	}, &types.MsgReduceIncentiveEmissionRate{}
+}
+
+func NewCancelIncentiveCmd() (*osmocli.TxCliDesc, *types.MsgCancelIncentive) {
+	return &osmocli.TxCliDesc{
+		Use:     "cancel-incentive [pool-id] [incentive-id]",
+		Short:   "cancel an incentive record created by the sender",
+		Long:    "the coins the incentive has not emitted yet are refunded to the sender",
+		Example: "osmosisd tx concentratedliquidity cancel-incentive 1 5 --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+	}, &types.MsgCancelIncentive{}
+}
+
+// parseSwapAmountInRoutes parses the swap route flags into a route. Returns an empty route if no pool ids are given.
+func parseSwapAmountInRoutes(fs *flag.FlagSet) ([]poolmanagertypes.SwapAmountInRoute, error) {
+	poolIdsStr, err := fs.GetString(FlagSwapRoutePoolIds)
+	if err != nil {
+		return nil, err
+	}
+	denomsStr, err := fs.GetString(FlagSwapRouteDenoms)
+	if err != nil {
+		return nil, err
+	}
+	if poolIdsStr == "" && denomsStr == "" {
+		return []poolmanagertypes.SwapAmountInRoute{}, nil
+	}
+
+	poolIds := strings.Split(poolIdsStr, ",")
+	denoms := strings.Split(denomsStr, ",")
+	if len(poolIds) != len(denoms) {
+		return nil, fmt.Errorf("swap route pool ids and denoms mismatch")
+	}
+
+	routes := make([]poolmanagertypes.SwapAmountInRoute, 0, len(poolIds))
+	for i, poolIdStr := range poolIds {
+		poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, poolmanagertypes.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: denoms[i]})
+	}
+	return routes, nil
+}
+
+func parseEmissionSchedule(fs *flag.FlagSet) ([]types.EmissionSegment, error) {
+	scheduleStr, err := fs.GetString(FlagEmissionSchedule)
+	if err != nil {
+		return nil, err
+	}
+	if scheduleStr == "" {
+		return []types.EmissionSegment{}, nil
+	}
+
+	segments := strings.Split(scheduleStr, ",")
+	if len(segments)%3 != 0 {
+		return nil, fmt.Errorf("emission schedule must be a list of tuples of duration, startEmissionRate and endEmissionRate")
+	}
+
+	emissionSchedule := make([]types.EmissionSegment, 0, len(segments)/3)
+	for i := 0; i < len(segments); i += 3 {
+		duration, err := time.ParseDuration(segments[i])
+		if err != nil {
+			return nil, err
+		}
+		startEmissionRate, err := sdk.NewDecFromStr(segments[i+1])
+		if err != nil {
+			return nil, err
+		}
+		endEmissionRate, err := sdk.NewDecFromStr(segments[i+2])
+		if err != nil {
+			return nil, err
+		}
+		emissionSchedule = append(emissionSchedule, types.EmissionSegment{
+			Duration:          duration,
+			StartEmissionRate: startEmissionRate,
+			EndEmissionRate:   endEmissionRate,
+		})
+	}
+	return emissionSchedule, nil
+}
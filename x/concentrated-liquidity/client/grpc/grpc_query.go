@@ -40,6 +40,36 @@ func (q Querier) TickAccumulatorTrackers(grpcCtx context.Context,
 	return q.Q.TickAccumulatorTrackers(ctx, *req)
 }
 
+func (q Querier) SwapTraceExactAmountOut(grpcCtx context.Context,
+	req *queryproto.SwapTraceExactAmountOutRequest,
+) (*queryproto.SwapTraceExactAmountOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.SwapTraceExactAmountOut(ctx, *req)
+}
+
+func (q Querier) SwapTraceExactAmountIn(grpcCtx context.Context,
+	req *queryproto.SwapTraceExactAmountInRequest,
+) (*queryproto.SwapTraceExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.SwapTraceExactAmountIn(ctx, *req)
+}
+
+func (q Querier) PositionPerformance(grpcCtx context.Context,
+	req *queryproto.PositionPerformanceRequest,
+) (*queryproto.PositionPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PositionPerformance(ctx, *req)
+}
+
 func (q Querier) PositionById(grpcCtx context.Context,
 	req *queryproto.PositionByIdRequest,
 ) (*queryproto.PositionByIdResponse, error) {
@@ -80,6 +110,16 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) Observe(grpcCtx context.Context,
+	req *queryproto.ObserveRequest,
+) (*queryproto.ObserveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Observe(ctx, *req)
+}
+
 func (q Querier) LiquidityPerTickRange(grpcCtx context.Context,
 	req *queryproto.LiquidityPerTickRangeRequest,
 ) (*queryproto.LiquidityPerTickRangeResponse, error) {
@@ -100,6 +140,16 @@ func (q Querier) LiquidityNetInDirection(grpcCtx context.Context,
 	return q.Q.LiquidityNetInDirection(ctx, *req)
 }
 
+func (q Querier) LiquidityDepth(grpcCtx context.Context,
+	req *queryproto.LiquidityDepthRequest,
+) (*queryproto.LiquidityDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.LiquidityDepth(ctx, *req)
+}
+
 func (q Querier) IncentiveRecords(grpcCtx context.Context,
 	req *queryproto.IncentiveRecordsRequest,
 ) (*queryproto.IncentiveRecordsResponse, error) {
@@ -110,6 +160,16 @@ func (q Querier) IncentiveRecords(grpcCtx context.Context,
 	return q.Q.IncentiveRecords(ctx, *req)
 }
 
+func (q Querier) DynamicSpreadFactor(grpcCtx context.Context,
+	req *queryproto.DynamicSpreadFactorRequest,
+) (*queryproto.DynamicSpreadFactorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.DynamicSpreadFactor(ctx, *req)
+}
+
 func (q Querier) ClaimableSpreadRewards(grpcCtx context.Context,
 	req *queryproto.ClaimableSpreadRewardsRequest,
 ) (*queryproto.ClaimableSpreadRewardsResponse, error) {
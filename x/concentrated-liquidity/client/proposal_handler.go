@@ -9,5 +9,9 @@ import (
 
 var (
 	TickSpacingDecreaseProposalHandler             = govclient.NewProposalHandler(cli.NewTickSpacingDecreaseProposal, rest.ProposalTickSpacingDecreaseRESTHandler)
+	TickSpacingUpdateProposalHandler               = govclient.NewProposalHandler(cli.NewTickSpacingUpdateProposal, rest.ProposalTickSpacingUpdateRESTHandler)
+	SpreadFactorChangeProposalHandler              = govclient.NewProposalHandler(cli.NewSpreadFactorChangeProposal, rest.ProposalSpreadFactorChangeRESTHandler)
+	DynamicSpreadFactorProposalHandler             = govclient.NewProposalHandler(cli.NewDynamicSpreadFactorProposal, rest.ProposalDynamicSpreadFactorRESTHandler)
+	JITProtectionChangeProposalHandler             = govclient.NewProposalHandler(cli.NewJITProtectionChangeProposal, rest.ProposalJITProtectionChangeRESTHandler)
 	CreateConcentratedLiquidityPoolProposalHandler = govclient.NewProposalHandler(cli.NewCmdCreateConcentratedLiquidityPoolsProposal, rest.ProposalCreateConcentratedLiquidityPoolHandler)
 )
@@ -9,6 +9,7 @@ import (
 	cl "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity"
 	clquery "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
 )
 
 // Querier defines a wrapper around the x/concentrated-liquidity keeper providing gRPC method
@@ -253,3 +254,106 @@ func (q Querier) CFMMPoolIdLinkFromConcentratedPoolId(ctx sdk.Context, req clque
 		CfmmPoolId: cfmmPoolId,
 	}, nil
 }
+
+// Observe returns the tick cumulative and seconds per liquidity cumulative values of the given pool
+// as of each of the given number of seconds ago, along with the state of the pool's observation ring buffer.
+func (q Querier) Observe(ctx sdk.Context, req clquery.ObserveRequest) (*clquery.ObserveResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+	for _, secondsAgo := range req.SecondsAgos {
+		if secondsAgo > types.MaxObserveSecondsAgo {
+			return nil, status.Error(codes.InvalidArgument, types.ObserveSecondsAgoTooLargeError{SecondsAgo: secondsAgo, MaxSecondsAgo: types.MaxObserveSecondsAgo}.Error())
+		}
+	}
+
+	tickCumulatives, secondsPerLiquidityCumulatives, observationState, err := q.Keeper.Observe(ctx, req.PoolId, req.SecondsAgos)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.ObserveResponse{
+		TickCumulatives:                tickCumulatives,
+		SecondsPerLiquidityCumulatives: secondsPerLiquidityCumulatives,
+		ObservationState:               observationState,
+	}, nil
+}
+
+// LiquidityDepth returns the amount of each token that has to be swapped into the given pool to move its spot price
+// by each of the given fractions.
+func (q Querier) LiquidityDepth(ctx sdk.Context, req clquery.LiquidityDepthRequest) (*clquery.LiquidityDepthResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+
+	depths, err := q.Keeper.LiquidityDepth(ctx, req.PoolId, req.PriceChanges)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.LiquidityDepthResponse{Depths: depths}, nil
+}
+
+// PositionPerformance returns the lifetime performance of the given position along with its current underlying assets.
+func (q Querier) PositionPerformance(ctx sdk.Context, req clquery.PositionPerformanceRequest) (*clquery.PositionPerformanceResponse, error) {
+	performance, asset0, asset1, err := q.Keeper.PositionPerformance(ctx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.PositionPerformanceResponse{
+		Performance: performance,
+		Asset0:      asset0,
+		Asset1:      asset1,
+	}, nil
+}
+
+// SwapTraceExactAmountIn returns every step of a swap of the given token in for the given denom out in the given pool.
+func (q Querier) SwapTraceExactAmountIn(ctx sdk.Context, req clquery.SwapTraceExactAmountInRequest) (*clquery.SwapTraceExactAmountInResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+
+	tokenIn, tokenOut, steps, err := q.Keeper.SwapTraceExactAmountIn(ctx, req.PoolId, req.TokenIn, req.TokenOutDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.SwapTraceExactAmountInResponse{
+		TokenIn:  tokenIn,
+		TokenOut: tokenOut,
+		Steps:    steps,
+	}, nil
+}
+
+// SwapTraceExactAmountOut returns every step of a swap of the given denom in for the given token out in the given pool.
+func (q Querier) SwapTraceExactAmountOut(ctx sdk.Context, req clquery.SwapTraceExactAmountOutRequest) (*clquery.SwapTraceExactAmountOutResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+
+	tokenIn, tokenOut, steps, err := q.Keeper.SwapTraceExactAmountOut(ctx, req.PoolId, req.TokenOut, req.TokenInDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.SwapTraceExactAmountOutResponse{
+		TokenIn:  tokenIn,
+		TokenOut: tokenOut,
+		Steps:    steps,
+	}, nil
+}
+
+// DynamicSpreadFactor returns the dynamic spread factor configuration of the given pool.
+func (q Querier) DynamicSpreadFactor(ctx sdk.Context, req clquery.DynamicSpreadFactorRequest) (*clquery.DynamicSpreadFactorResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+
+	dynamicSpreadFactor, err := q.Keeper.DynamicSpreadFactor(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &clquery.DynamicSpreadFactorResponse{DynamicSpreadFactor: dynamicSpreadFactor}, nil
+}
@@ -14,6 +14,34 @@ func ProposalTickSpacingDecreaseRESTHandler(clientCtx client.Context) govrest.Pr
 	}
 }
 
+func ProposalTickSpacingUpdateRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "tick-spacing-update",
+		Handler:  emptyHandler(clientCtx),
+	}
+}
+
+func ProposalSpreadFactorChangeRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "spread-factor-change",
+		Handler:  emptyHandler(clientCtx),
+	}
+}
+
+func ProposalDynamicSpreadFactorRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "dynamic-spread-factor",
+		Handler:  emptyHandler(clientCtx),
+	}
+}
+
+func ProposalJITProtectionChangeRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "jit-protection-change",
+		Handler:  emptyHandler(clientCtx),
+	}
+}
+
 func ProposalCreateConcentratedLiquidityPoolHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
 	return govrest.ProposalRESTHandler{
 		SubRoute: "create-concentratedliquidity-pool",
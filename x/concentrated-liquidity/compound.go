@@ -0,0 +1,393 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+// compoundPosition collects the spread rewards and incentives of the given position and adds
+// the collected pool tokens back into the position.
+// Since a position can only be added to in the ratio of its current underlying assets,
+// the imbalance between the collected token0 and token1 is first swapped through the position's own pool
+// via the poolmanager, bounded by the pool's time weighted average price (see rebalanceForRange).
+// The swap amount ignores price impact, so any leftover dust remains in the owner's balance.
+// Collected tokens that are not one of the pool's denoms are not compounded and remain in the owner's balance.
+// The liquidity is added to the position in place, so that the position keeps its id and its join time,
+// and with them its eligibility for the pool's uptime incentives, as well as its operator approvals and
+// its auto-compound flag. Only the compounded amounts are recorded as deposited into the position.
+// The sender may be the owner of the position or an operator approved by the owner.
+// Returns the position id and the amounts of token0 and token1 that were added back into the position.
+// Returns error if:
+// - the position does not exist or the sender is neither its owner nor an approved operator
+// - the position is superfluid staked
+// - no rewards in the pool's denoms were collected
+// - the spot price deviates from the time weighted average price of the pool
+// - the swap or the addition to the position fails
+func (k Keeper) compoundPosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, amount0Min, amount1Min sdk.Int) (uint64, sdk.Int, sdk.Int, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, err
+	}
+
+	if err := k.validatePositionOwnerOrOperator(ctx, position, sender); err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, err
+	}
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, err
+	}
+
+	// If the position is superfluid staked, return error.
+	// Its liquidity is backed by the shares of its underlying lock, which cannot grow in place.
+	positionHasUnderlyingLock, _, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, err
+	}
+	if positionHasUnderlyingLock {
+		return 0, sdk.Int{}, sdk.Int{}, types.PositionSuperfluidStakedError{PositionId: positionId}
+	}
+
+	pool, err := k.GetConcentratedPoolById(ctx, position.PoolId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, err
+	}
+
+	collectedSpreadRewards, err := k.collectSpreadRewards(ctx, owner, positionId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, err
+	}
+	collectedIncentives, _, err := k.collectIncentives(ctx, owner, positionId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, err
+	}
+	collected := collectedSpreadRewards.Add(collectedIncentives...)
+
+	amount0, amount1, err := k.rebalanceForRange(ctx, owner, pool, position.LowerTick, position.UpperTick, collected.AmountOf(pool.GetToken0()), collected.AmountOf(pool.GetToken1()), nil)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, err
+	}
+	if amount0.IsZero() && amount1.IsZero() {
+		return 0, sdk.Int{}, sdk.Int{}, types.NoRewardsToCompoundError{PositionId: positionId}
+	}
+
+	actualAmount0, actualAmount1, err := k.addLiquidityToPosition(ctx, owner, position, amount0, amount1, amount0Min, amount1Min)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCompoundPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeAmount0, actualAmount0.String()),
+			sdk.NewAttribute(types.AttributeAmount1, actualAmount1.String()),
+		),
+	})
+
+	return positionId, actualAmount0, actualAmount1, nil
+}
+
+// addLiquidityToPosition adds the liquidity that amount0 and amount1 of the owner provide over the range of
+// the given position to the position itself, keeping its id and join time.
+// The outstanding rewards of the position must have been collected beforehand, so that the added liquidity
+// does not earn rewards accrued before it was added.
+// Returns the amounts of token0 and token1 that were added to the position.
+// Returns error if the amounts provide no liquidity, the added amounts are lower than the given minimums or
+// the owner does not hold the amounts.
+func (k Keeper) addLiquidityToPosition(ctx sdk.Context, owner sdk.AccAddress, position model.Position, amount0, amount1, amount0Min, amount1Min sdk.Int) (sdk.Int, sdk.Int, error) {
+	// The pool is refetched since rebalancing may have moved its price.
+	pool, err := k.GetConcentratedPoolById(ctx, position.PoolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	_, _, sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(position.LowerTick, position.UpperTick)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	liquidityDelta := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0, amount1)
+	if !liquidityDelta.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, types.NoRewardsToCompoundError{PositionId: position.PositionId}
+	}
+
+	actualAmount0, actualAmount1, err := k.UpdatePosition(ctx, position.PoolId, owner, position.LowerTick, position.UpperTick, liquidityDelta, position.JoinTime, position.PositionId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	if actualAmount0.LT(amount0Min) {
+		return sdk.Int{}, sdk.Int{}, types.InsufficientLiquidityCreatedError{Actual: actualAmount0, Minimum: amount0Min, IsTokenZero: true}
+	}
+	if actualAmount1.LT(amount1Min) {
+		return sdk.Int{}, sdk.Int{}, types.InsufficientLiquidityCreatedError{Actual: actualAmount1, Minimum: amount1Min}
+	}
+
+	if err := k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), actualAmount0, actualAmount1, owner, pool.GetAddress()); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	k.recordPositionDeposit(ctx, position.PositionId, sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), actualAmount0), sdk.NewCoin(pool.GetToken1(), actualAmount1)))
+
+	return actualAmount0, actualAmount1, nil
+}
+
+// rebalanceForRange swaps the imbalance between amount0 and amount1 so that the resulting amounts are in the
+// same ratio as the underlying assets of a position over the given tick range at the current price.
+// If the current tick is outside the range, the entire amount of the token not held by such a position is swapped.
+// The swap amount is estimated from the current spot price, ignoring price impact and spread factor.
+// The swap goes through the given pool via the poolmanager, or through the given route if it is not empty.
+// Since the owner may not choose a minimum amount out for this swap, e.g. when auto-compounding, the spot price
+// must be close to the time weighted average price of the pool, and the swap must return at least the amount
+// worth the amount in at that time weighted average price net of the spread factor, within
+// types.RebalanceMaxTwapDeviation. This prevents the swap and the position created with its proceeds from
+// being sandwiched.
+// Returns the amounts of token0 and token1 held by the owner after the swap.
+// Returns error if the pool's observations do not cover types.RebalanceTwapDuration, the spot price deviates
+// from the time weighted average price or the swap fails.
+func (k Keeper) rebalanceForRange(ctx sdk.Context, owner sdk.AccAddress, pool types.ConcentratedPoolExtension, lowerTick, upperTick int64, amount0, amount1 sdk.Int, routes []poolmanagertypes.SwapAmountInRoute) (sdk.Int, sdk.Int, error) {
+	twapPrice, err := k.validateSpotPriceNearTwap(ctx, pool)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	// Minimum amount out per unit of value in at the time weighted average price.
+	spreadFactor := k.applyDynamicSpreadFactor(ctx, pool, pool.GetSpreadFactor(ctx))
+	minAmountOutFactor := sdk.OneDec().Sub(spreadFactor).Mul(sdk.OneDec().Sub(types.RebalanceMaxTwapDeviation))
+
+	token0, token1 := pool.GetToken0(), pool.GetToken1()
+	// price of token0 denominated in token1.
+	sqrtPrice := pool.GetCurrentSqrtPrice()
+	price := sqrtPrice.Power(2)
+
+	var amount0ToSwap, amount1ToSwap sdk.Int
+	switch {
+	case pool.GetCurrentTick() < lowerTick:
+		// The range only holds token0.
+		amount0ToSwap, amount1ToSwap = sdk.ZeroInt(), amount1
+	case pool.GetCurrentTick() >= upperTick:
+		// The range only holds token1.
+		amount0ToSwap, amount1ToSwap = amount0, sdk.ZeroInt()
+	default:
+		_, _, sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(lowerTick, upperTick)
+		if err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+		// Amounts of token0 and token1 held by the range per unit of liquidity, both scaled by
+		// sqrtPrice * sqrtPriceUpperTick to preserve precision.
+		rangeAmount0 := sqrtPriceUpperTick.Sub(sqrtPrice)
+		rangeAmount1 := sqrtPrice.Sub(sqrtPriceLowerTick).Mul(sqrtPrice).Mul(sqrtPriceUpperTick)
+
+		// Value of the given amounts denominated in token1.
+		totalValue := amount0.ToDec().Mul(price).Add(amount1.ToDec())
+		// target0 * price + target0 * (rangeAmount1 / rangeAmount0) = totalValue
+		target0 := totalValue.Mul(rangeAmount0).Quo(rangeAmount0.Mul(price).Add(rangeAmount1))
+		if amount0.ToDec().GT(target0) {
+			amount0ToSwap, amount1ToSwap = amount0.ToDec().Sub(target0).TruncateInt(), sdk.ZeroInt()
+		} else {
+			amount0ToSwap, amount1ToSwap = sdk.ZeroInt(), target0.Sub(amount0.ToDec()).Mul(price).TruncateInt()
+		}
+	}
+
+	if amount0ToSwap.IsPositive() {
+		tokenOutMinAmount := amount0ToSwap.ToDec().Mul(twapPrice).Mul(minAmountOutFactor).TruncateInt()
+		tokenOut, err := k.swapThroughPoolOrRoute(ctx, owner, pool.GetId(), routes, sdk.NewCoin(token0, amount0ToSwap), token1, tokenOutMinAmount)
+		if err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+		return amount0.Sub(amount0ToSwap), amount1.Add(tokenOut), nil
+	}
+	if amount1ToSwap.IsPositive() {
+		tokenOutMinAmount := amount1ToSwap.ToDec().Quo(twapPrice).Mul(minAmountOutFactor).TruncateInt()
+		tokenOut, err := k.swapThroughPoolOrRoute(ctx, owner, pool.GetId(), routes, sdk.NewCoin(token1, amount1ToSwap), token0, tokenOutMinAmount)
+		if err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+		return amount0.Add(tokenOut), amount1.Sub(amount1ToSwap), nil
+	}
+	return amount0, amount1, nil
+}
+
+// swapThroughPoolOrRoute swaps tokenIn into at least tokenOutMinAmount of tokenOutDenom through the given pool
+// via the poolmanager, or through the given route if it is not empty.
+// Returns error if the route does not end in tokenOutDenom or the swap fails.
+func (k Keeper) swapThroughPoolOrRoute(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, routes []poolmanagertypes.SwapAmountInRoute, tokenIn sdk.Coin, tokenOutDenom string, tokenOutMinAmount sdk.Int) (sdk.Int, error) {
+	if len(routes) == 0 {
+		return k.poolmanagerKeeper.SwapExactAmountIn(ctx, sender, poolId, tokenIn, tokenOutDenom, tokenOutMinAmount)
+	}
+	if routeTokenOutDenom := routes[len(routes)-1].TokenOutDenom; routeTokenOutDenom != tokenOutDenom {
+		return sdk.Int{}, types.SwapRouteTokenOutMismatchError{RouteTokenOutDenom: routeTokenOutDenom, ExpectedDenom: tokenOutDenom}
+	}
+	return k.poolmanagerKeeper.RouteExactAmountIn(ctx, sender, routes, tokenIn, tokenOutMinAmount)
+}
+
+// validateSpotPriceNearTwap returns the time weighted average price of token0 denominated in token1 of the given
+// pool over types.RebalanceTwapDuration.
+// Returns error if the pool's observations do not cover types.RebalanceTwapDuration, or if the spot price of the
+// pool deviates from the time weighted average price by more than types.RebalanceMaxTwapDeviation.
+func (k Keeper) validateSpotPriceNearTwap(ctx sdk.Context, pool types.ConcentratedPoolExtension) (sdk.Dec, error) {
+	twapPrice, err := k.getTwapPrice(ctx, pool.GetId(), types.RebalanceTwapDuration)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	spotPrice := pool.GetCurrentSqrtPrice().Power(2)
+	if spotPrice.Sub(twapPrice).Abs().GT(twapPrice.Mul(types.RebalanceMaxTwapDeviation)) {
+		return sdk.Dec{}, types.SpotPriceDeviatesFromTwapError{PoolId: pool.GetId(), SpotPrice: spotPrice, TwapPrice: twapPrice}
+	}
+	return twapPrice, nil
+}
+
+// setPositionAutoCompoundForOwner opts the given position in or out of auto-compounding.
+// Opting in grows the pool's observation ring buffer to types.AutoCompoundObservationCardinality slots,
+// paid for by the owner.
+// Returns error if the position does not exist or the owner does not own it.
+func (k Keeper) setPositionAutoCompoundForOwner(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, autoCompound bool) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	if owner.String() != position.Address {
+		return types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	// Compounding requires the pool's observations to cover types.RebalanceTwapDuration,
+	// so the pool keeps enough observations once any of its positions opts in.
+	if autoCompound {
+		if _, _, err := k.increaseObservationCardinality(ctx, owner, position.PoolId, types.AutoCompoundObservationCardinality); err != nil {
+			return err
+		}
+	}
+
+	k.setPositionAutoCompound(ctx, positionId, autoCompound)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSetPositionAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAutoCompound, strconv.FormatBool(autoCompound)),
+		),
+	})
+
+	return nil
+}
+
+// setPositionAutoCompound sets or removes the auto-compound flag of the given position.
+func (k Keeper) setPositionAutoCompound(ctx sdk.Context, positionId uint64, autoCompound bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyAutoCompoundPosition(positionId)
+	if !autoCompound {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(positionId))
+}
+
+// isPositionAutoCompound returns true if the given position opted into auto-compounding.
+func (k Keeper) isPositionAutoCompound(ctx sdk.Context, positionId uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyAutoCompoundPosition(positionId))
+}
+
+// compoundAllAutoCompoundPositions compounds up to types.MaxAutoCompoundPositionsPerEpoch positions that opted
+// into auto-compounding, resuming after the last position compounded in the previous epoch and wrapping around
+// once the end is reached, so that every opted in position is compounded in turn.
+// Each position is compounded in a cached context so that a failure (e.g. a position with no rewards
+// or with an active underlying lock) does not affect the other positions. Failures are logged and skipped.
+func (k Keeper) compoundAllAutoCompoundPositions(ctx sdk.Context) error {
+	cursor, hasCursor := k.getAutoCompoundCursor(ctx)
+	start := types.AutoCompoundPositionPrefix
+	if hasCursor {
+		// The keys following the cursor are the ones greater than it.
+		start = append(types.KeyAutoCompoundPosition(cursor), 0x00)
+	}
+
+	positionIds, err := k.getAutoCompoundPositionIdsFrom(ctx, start, types.MaxAutoCompoundPositionsPerEpoch)
+	if err != nil {
+		return err
+	}
+	if hasCursor && len(positionIds) < types.MaxAutoCompoundPositionsPerEpoch {
+		// Wrap around to the positions before the cursor.
+		wrappedPositionIds, err := k.getAutoCompoundPositionIdsFrom(ctx, types.AutoCompoundPositionPrefix, types.MaxAutoCompoundPositionsPerEpoch-len(positionIds))
+		if err != nil {
+			return err
+		}
+		for _, positionId := range wrappedPositionIds {
+			if osmoutils.Contains(positionIds, positionId) {
+				break
+			}
+			positionIds = append(positionIds, positionId)
+		}
+	}
+	if len(positionIds) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.KeyAutoCompoundCursor)
+		return nil
+	}
+	k.setAutoCompoundCursor(ctx, positionIds[len(positionIds)-1])
+
+	for _, positionId := range positionIds {
+		position, err := k.GetPosition(ctx, positionId)
+		if err != nil {
+			// The flag is removed together with the position, so this should never happen.
+			k.setPositionAutoCompound(ctx, positionId, false)
+			continue
+		}
+
+		owner, err := sdk.AccAddressFromBech32(position.Address)
+		if err != nil {
+			return err
+		}
+
+		positionId := positionId
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			_, _, _, err := k.compoundPosition(cacheCtx, owner, positionId, sdk.ZeroInt(), sdk.ZeroInt())
+			return err
+		})
+		if err != nil {
+			ctx.Logger().Error("failed to auto-compound position", "position_id", positionId, "error", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// getAutoCompoundPositionIdsFrom returns the ids of up to limit positions that opted into auto-compounding,
+// in key order starting at the given key.
+func (k Keeper) getAutoCompoundPositionIdsFrom(ctx sdk.Context, start []byte, limit int) ([]uint64, error) {
+	iterator := ctx.KVStore(k.storeKey).Iterator(start, sdk.PrefixEndBytes(types.AutoCompoundPositionPrefix))
+	defer iterator.Close()
+
+	positionIds := []uint64{}
+	for ; iterator.Valid() && len(positionIds) < limit; iterator.Next() {
+		positionId, err := ParsePositionIdFromBz(iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		positionIds = append(positionIds, positionId)
+	}
+	return positionIds, nil
+}
+
+// getAutoCompoundCursor returns the id of the last position compounded by the previous auto-compounding epoch,
+// and false if there is none.
+func (k Keeper) getAutoCompoundCursor(ctx sdk.Context) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyAutoCompoundCursor)
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// setAutoCompoundCursor sets the id of the last position compounded by the current auto-compounding epoch.
+func (k Keeper) setAutoCompoundCursor(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyAutoCompoundCursor, sdk.Uint64ToBigEndian(positionId))
+}
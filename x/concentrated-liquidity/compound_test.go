@@ -0,0 +1,230 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestCompoundPosition() {
+	spreadRewardsPerLiquidity := sdk.NewDecCoinFromDec(ETH, sdk.NewDecWithPrec(1, 4))
+
+	tests := []struct {
+		name               string
+		accrueRewards      bool
+		autoCompound       bool
+		compoundByNonOwner bool
+		moveSpotPrice      bool
+		amount0Min         sdk.Int
+		expectedError      error
+	}{
+		{
+			name:          "rewards only in token0 are rebalanced and compounded",
+			accrueRewards: true,
+			amount0Min:    sdk.ZeroInt(),
+		},
+		{
+			name:          "auto-compound flag is kept",
+			accrueRewards: true,
+			autoCompound:  true,
+			amount0Min:    sdk.ZeroInt(),
+		},
+		{
+			name:          "error: no rewards to compound",
+			amount0Min:    sdk.ZeroInt(),
+			expectedError: types.NoRewardsToCompoundError{PositionId: DefaultPositionId},
+		},
+		{
+			name:               "error: sender is not the owner",
+			accrueRewards:      true,
+			compoundByNonOwner: true,
+			amount0Min:         sdk.ZeroInt(),
+			expectedError:      types.NotPositionOwnerError{PositionId: DefaultPositionId},
+		},
+		{
+			name:          "error: minimum amount not met",
+			accrueRewards: true,
+			amount0Min:    sdk.NewInt(1_000_000_000),
+			expectedError: types.InsufficientLiquidityCreatedError{},
+		},
+		{
+			name:          "error: spot price deviates from the time weighted average price",
+			accrueRewards: true,
+			moveSpotPrice: true,
+			amount0Min:    sdk.ZeroInt(),
+			expectedError: types.SpotPriceDeviatesFromTwapError{PoolId: 1},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(DefaultJoinTime)
+			owner := s.TestAccs[0]
+			pool := s.PrepareConcentratedPool()
+
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			// Second position so that the compounded position is not the last one in the pool.
+			s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[1])
+
+			if tc.autoCompound {
+				s.Require().NoError(s.clk.SetPositionAutoCompoundForOwner(s.Ctx, owner, positionId, true))
+			}
+
+			if tc.moveSpotPrice {
+				// Keep the observation preceding the swap, so that the time weighted average price can be computed.
+				_, _, err := s.clk.IncreaseObservationCardinality(s.Ctx, owner, pool.GetId(), types.AutoCompoundObservationCardinality)
+				s.Require().NoError(err)
+			}
+
+			if tc.accrueRewards {
+				s.AddToSpreadRewardAccumulator(pool.GetId(), spreadRewardsPerLiquidity)
+				s.FundAcc(pool.GetSpreadRewardsAddress(), sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(1_000_000_000))))
+			}
+			s.AddBlockTime(time.Hour)
+
+			if tc.moveSpotPrice {
+				// Moves the spot price by about 3% within the current block, which does not affect the time weighted average price.
+				tokenIn := sdk.NewCoin(USDC, sdk.NewInt(3_000_000_000))
+				s.FundAcc(s.TestAccs[2], sdk.NewCoins(tokenIn))
+				pool, err := s.clk.GetPoolById(s.Ctx, pool.GetId())
+				s.Require().NoError(err)
+				_, err = s.clk.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool, tokenIn, ETH, sdk.ZeroInt(), pool.GetSpreadFactor(s.Ctx))
+				s.Require().NoError(err)
+			}
+
+			positionBefore, err := s.clk.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			performanceBefore, found := s.clk.GetPositionPerformance(s.Ctx, positionId)
+			s.Require().True(found)
+
+			sender := owner
+			if tc.compoundByNonOwner {
+				sender = s.TestAccs[1]
+			}
+
+			// System under test
+			newPositionId, amount0, amount1, err := s.clk.CompoundPosition(s.Ctx, sender, positionId, tc.amount0Min, sdk.ZeroInt())
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The imbalance was swapped, so both tokens were added back into the position.
+			s.Require().True(amount0.IsPositive())
+			s.Require().True(amount1.IsPositive())
+
+			// The liquidity was added to the position in place, keeping its id and join time.
+			s.Require().Equal(positionId, newPositionId)
+			position, err := s.clk.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(owner.String(), position.Address)
+			s.Require().Equal(positionBefore.LowerTick, position.LowerTick)
+			s.Require().Equal(positionBefore.UpperTick, position.UpperTick)
+			s.Require().Equal(positionBefore.JoinTime, position.JoinTime)
+			s.Require().True(position.Liquidity.GT(positionBefore.Liquidity))
+			s.Require().Equal(tc.autoCompound, s.clk.IsPositionAutoCompound(s.Ctx, positionId))
+
+			// Only the compounded amounts are recorded as deposited.
+			performanceAfter, found := s.clk.GetPositionPerformance(s.Ctx, positionId)
+			s.Require().True(found)
+			expectedDeposited := performanceBefore.Deposited.Add(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1))
+			s.Require().Equal(expectedDeposited.String(), performanceAfter.Deposited.String())
+			s.Require().Equal(performanceBefore.Withdrawn.String(), performanceAfter.Withdrawn.String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSetPositionAutoCompound() {
+	s.SetupTest()
+	owner, newOwner := s.TestAccs[0], s.TestAccs[1]
+	pool := s.PrepareConcentratedPool()
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+
+	// Only the owner can opt in.
+	err := s.clk.SetPositionAutoCompoundForOwner(s.Ctx, newOwner, positionId, true)
+	s.Require().ErrorAs(err, &types.NotPositionOwnerError{})
+	s.Require().False(s.clk.IsPositionAutoCompound(s.Ctx, positionId))
+
+	s.Require().NoError(s.clk.SetPositionAutoCompoundForOwner(s.Ctx, owner, positionId, true))
+	s.Require().True(s.clk.IsPositionAutoCompound(s.Ctx, positionId))
+
+	s.Require().NoError(s.clk.SetPositionAutoCompoundForOwner(s.Ctx, owner, positionId, false))
+	s.Require().False(s.clk.IsPositionAutoCompound(s.Ctx, positionId))
+
+	// The flag does not carry over to a new owner.
+	s.Require().NoError(s.clk.SetPositionAutoCompoundForOwner(s.Ctx, owner, positionId, true))
+	s.Require().NoError(s.clk.TransferPositions(s.Ctx, []uint64{positionId}, owner, newOwner))
+	s.Require().False(s.clk.IsPositionAutoCompound(s.Ctx, positionId))
+
+	// The flag is removed together with the position.
+	s.Require().NoError(s.clk.SetPositionAutoCompoundForOwner(s.Ctx, newOwner, positionId, true))
+	position, err := s.clk.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	_, _, err = s.clk.WithdrawPosition(s.Ctx, newOwner, positionId, position.Liquidity)
+	s.Require().NoError(err)
+	s.Require().False(s.clk.IsPositionAutoCompound(s.Ctx, positionId))
+}
+
+func (s *KeeperTestSuite) TestAutoCompoundEpochHook() {
+	spreadRewardsPerLiquidity := sdk.NewDecCoinFromDec(ETH, sdk.NewDecWithPrec(1, 4))
+
+	tests := []struct {
+		name               string
+		epochIdentifier    string
+		expectedCompounded bool
+	}{
+		{
+			name:               "day epoch compounds opted in positions",
+			epochIdentifier:    types.AutoCompoundEpochIdentifier,
+			expectedCompounded: true,
+		},
+		{
+			name:            "other epochs are ignored",
+			epochIdentifier: "week",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(DefaultJoinTime)
+			pool := s.PrepareConcentratedPool()
+
+			optedInPositionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[0])
+			optedOutPositionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[1])
+			s.Require().NoError(s.clk.SetPositionAutoCompoundForOwner(s.Ctx, s.TestAccs[0], optedInPositionId, true))
+
+			s.AddToSpreadRewardAccumulator(pool.GetId(), spreadRewardsPerLiquidity)
+			s.FundAcc(pool.GetSpreadRewardsAddress(), sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(1_000_000_000))))
+			s.AddBlockTime(time.Hour)
+
+			optedInPositionBefore, err := s.clk.GetPosition(s.Ctx, optedInPositionId)
+			s.Require().NoError(err)
+			optedOutPositionBefore, err := s.clk.GetPosition(s.Ctx, optedOutPositionId)
+			s.Require().NoError(err)
+
+			// System under test
+			err = s.clk.EpochHooks().AfterEpochEnd(s.Ctx, tc.epochIdentifier, 1)
+			s.Require().NoError(err)
+
+			// The opted out position is never compounded.
+			optedOutPosition, err := s.clk.GetPosition(s.Ctx, optedOutPositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(optedOutPositionBefore.Liquidity, optedOutPosition.Liquidity)
+
+			// The compounded position keeps its id and remains opted in.
+			optedInPosition, err := s.clk.GetPosition(s.Ctx, optedInPositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedCompounded, optedInPosition.Liquidity.GT(optedInPositionBefore.Liquidity))
+			s.Require().Equal(optedInPositionBefore.JoinTime, optedInPosition.JoinTime)
+			s.Require().True(s.clk.IsPositionAutoCompound(s.Ctx, optedInPositionId))
+		})
+	}
+}
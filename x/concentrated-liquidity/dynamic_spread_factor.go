@@ -0,0 +1,249 @@
+package concentrated_liquidity
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+// SetDynamicSpreadFactors opts each of the given pools into or out of a dynamic spread factor.
+// A pool that opts in starts at its static spread factor bounded by the given min and max spread factors, and the
+// dynamic spread factor is immediately recomputed from the pool's price history. Opting in grows the pool's
+// observation ring buffer so that its observations can cover the lookback duration, see
+// dynamicSpreadFactorObservationCardinality. Opting in again replaces the pool's configuration. A pool that opts out
+// is charged its static spread factor again.
+// Returns error if a pool does not exist, if a pool opting out did not opt in, or if the lookback duration exceeds
+// types.MaxDynamicSpreadFactorLookbackDuration.
+func (k Keeper) SetDynamicSpreadFactors(ctx sdk.Context, records []types.PoolIdToDynamicSpreadFactorRecord) error {
+	for _, record := range records {
+		pool, err := k.GetConcentratedPoolById(ctx, record.PoolId)
+		if err != nil {
+			return err
+		}
+
+		if !record.Enabled {
+			if _, found := k.getDynamicSpreadFactor(ctx, record.PoolId); !found {
+				return types.DynamicSpreadFactorNotFoundError{PoolId: record.PoolId}
+			}
+			k.deleteDynamicSpreadFactor(ctx, record.PoolId)
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.TypeEvtSetDynamicSpreadFactor,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(record.PoolId, 10)),
+				sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(false)),
+			))
+			continue
+		}
+
+		// The volatility is measured from the pool's observations, so the pool must keep enough of them to cover the
+		// lookback duration. Otherwise, the dynamic spread factor would never be updated.
+		if record.LookbackDuration > types.MaxDynamicSpreadFactorLookbackDuration {
+			return types.LookbackDurationTooLongError{PoolId: record.PoolId, LookbackDuration: record.LookbackDuration, MaxLookbackDuration: types.MaxDynamicSpreadFactorLookbackDuration}
+		}
+		cardinalityNext := dynamicSpreadFactorObservationCardinality(record.LookbackDuration)
+		if _, _, err := k.increaseObservationCardinality(ctx, authtypes.NewModuleAddress(govtypes.ModuleName), record.PoolId, cardinalityNext); err != nil {
+			return err
+		}
+
+		dynamicSpreadFactor := types.DynamicSpreadFactor{
+			PoolId:               record.PoolId,
+			MinSpreadFactor:      record.MinSpreadFactor,
+			MaxSpreadFactor:      record.MaxSpreadFactor,
+			VolatilityMultiplier: record.VolatilityMultiplier,
+			LookbackDuration:     record.LookbackDuration,
+			CurrentSpreadFactor:  boundSpreadFactor(pool.GetSpreadFactor(ctx), record.MinSpreadFactor, record.MaxSpreadFactor),
+		}
+		k.setDynamicSpreadFactor(ctx, dynamicSpreadFactor)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtSetDynamicSpreadFactor,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(record.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(true)),
+			sdk.NewAttribute(types.AttributeKeyMinSpreadFactor, record.MinSpreadFactor.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxSpreadFactor, record.MaxSpreadFactor.String()),
+		))
+
+		if err := k.updateDynamicSpreadFactor(ctx, dynamicSpreadFactor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DynamicSpreadFactor returns the dynamic spread factor configuration of the given pool.
+// Returns error if the pool did not opt into a dynamic spread factor.
+func (k Keeper) DynamicSpreadFactor(ctx sdk.Context, poolId uint64) (types.DynamicSpreadFactor, error) {
+	dynamicSpreadFactor, found := k.getDynamicSpreadFactor(ctx, poolId)
+	if !found {
+		return types.DynamicSpreadFactor{}, types.DynamicSpreadFactorNotFoundError{PoolId: poolId}
+	}
+	return dynamicSpreadFactor, nil
+}
+
+// UpdateAllDynamicSpreadFactors recomputes the dynamic spread factor of every pool that opted into one.
+// A pool whose dynamic spread factor fails to update keeps its current dynamic spread factor.
+func (k Keeper) UpdateAllDynamicSpreadFactors(ctx sdk.Context) {
+	dynamicSpreadFactors, err := k.getAllDynamicSpreadFactors(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, dynamicSpreadFactor := range dynamicSpreadFactors {
+		dynamicSpreadFactor := dynamicSpreadFactor
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.updateDynamicSpreadFactor(cacheCtx, dynamicSpreadFactor)
+		})
+		if err != nil {
+			ctx.Logger().Debug("failed to update dynamic spread factor", "pool_id", dynamicSpreadFactor.PoolId, "error", err.Error())
+		}
+	}
+}
+
+// updateDynamicSpreadFactor sets the current spread factor of the given dynamic spread factor to the volatility of the
+// pool's price over the lookback duration multiplied by the volatility multiplier, bounded by the min and max spread
+// factors. If the pool's observations do not cover the lookback duration yet, the current spread factor is kept.
+// Returns error if the volatility fails to be computed for any other reason.
+func (k Keeper) updateDynamicSpreadFactor(ctx sdk.Context, dynamicSpreadFactor types.DynamicSpreadFactor) error {
+	volatility, err := k.computeVolatility(ctx, dynamicSpreadFactor.PoolId, dynamicSpreadFactor.LookbackDuration)
+	if err != nil {
+		var notInitializedErr types.ObservationsNotInitializedError
+		var tooOldErr types.ObservationTooOldError
+		if errors.As(err, &notInitializedErr) || errors.As(err, &tooOldErr) {
+			return nil
+		}
+		return err
+	}
+
+	oldSpreadFactor := dynamicSpreadFactor.CurrentSpreadFactor
+	newSpreadFactor := boundSpreadFactor(volatility.Mul(dynamicSpreadFactor.VolatilityMultiplier), dynamicSpreadFactor.MinSpreadFactor, dynamicSpreadFactor.MaxSpreadFactor)
+	if newSpreadFactor.Equal(oldSpreadFactor) {
+		return nil
+	}
+
+	dynamicSpreadFactor.CurrentSpreadFactor = newSpreadFactor
+	k.setDynamicSpreadFactor(ctx, dynamicSpreadFactor)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtUpdateDynamicSpreadFactor,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(dynamicSpreadFactor.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyVolatility, volatility.String()),
+		sdk.NewAttribute(types.AttributeKeyOldSpreadFactor, oldSpreadFactor.String()),
+		sdk.NewAttribute(types.AttributeKeySpreadFactor, newSpreadFactor.String()),
+	))
+	return nil
+}
+
+// computeVolatility returns the volatility of the given pool's price over the lookback duration before the current
+// block time. The lookback duration is split into types.DynamicSpreadFactorVolatilityIntervals intervals, the time
+// weighted average price of each interval is derived from the pool's tick cumulative observations, and the volatility
+// is the mean absolute relative change of the average price between consecutive intervals.
+// Returns error if the pool's observations do not cover the lookback duration.
+func (k Keeper) computeVolatility(ctx sdk.Context, poolId uint64, lookbackDuration time.Duration) (sdk.Dec, error) {
+	intervals := uint64(types.DynamicSpreadFactorVolatilityIntervals)
+	intervalSeconds := uint64(lookbackDuration/time.Second) / intervals
+
+	// Observe the tick cumulatives at the boundaries of the intervals, from the oldest to the most recent.
+	secondsAgos := make([]uint64, intervals+1)
+	for i := range secondsAgos {
+		secondsAgos[i] = intervalSeconds * (intervals - uint64(i))
+	}
+	tickCumulatives, _, _, err := k.Observe(ctx, poolId, secondsAgos)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	averagePrices := make([]sdk.Dec, intervals)
+	for i := range averagePrices {
+		averageTick := tickCumulatives[i+1].Sub(tickCumulatives[i]).QuoInt64(int64(intervalSeconds)).RoundInt64()
+		averagePrices[i], err = math.TickToPrice(averageTick)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+	}
+
+	totalRelativeChange := sdk.ZeroDec()
+	for i := 1; i < len(averagePrices); i++ {
+		totalRelativeChange = totalRelativeChange.Add(averagePrices[i].Sub(averagePrices[i-1]).Abs().Quo(averagePrices[i-1]))
+	}
+	return totalRelativeChange.QuoInt64(int64(len(averagePrices) - 1)), nil
+}
+
+// dynamicSpreadFactorObservationCardinality returns the number of observation slots a pool needs to cover the given
+// lookback duration, assuming the observations are at least types.ObservationMinInterval apart. The slot of the
+// observation preceding the lookback duration is included.
+// CONTRACT: the lookback duration does not exceed types.MaxDynamicSpreadFactorLookbackDuration.
+func dynamicSpreadFactorObservationCardinality(lookbackDuration time.Duration) uint32 {
+	intervals := lookbackDuration / types.ObservationMinInterval
+	if lookbackDuration%types.ObservationMinInterval != 0 {
+		intervals++
+	}
+	return uint32(intervals) + 1
+}
+
+// applyDynamicSpreadFactor returns the spread factor to charge in a swap in the given pool, given the spread factor
+// the swap was requested with. If the pool opted into a dynamic spread factor, the dynamic spread factor replaces the
+// pool's static spread factor. Swaps may be requested with a fraction of the static spread factor, e.g. multihop
+// routes are discounted by the poolmanager, in which case the same fraction of the dynamic spread factor is charged.
+func (k Keeper) applyDynamicSpreadFactor(ctx sdk.Context, pool types.ConcentratedPoolExtension, spreadFactor sdk.Dec) sdk.Dec {
+	dynamicSpreadFactor, found := k.getDynamicSpreadFactor(ctx, pool.GetId())
+	if !found {
+		return spreadFactor
+	}
+
+	staticSpreadFactor := pool.GetSpreadFactor(ctx)
+	if staticSpreadFactor.IsZero() {
+		return dynamicSpreadFactor.CurrentSpreadFactor
+	}
+	return dynamicSpreadFactor.CurrentSpreadFactor.Mul(spreadFactor).Quo(staticSpreadFactor)
+}
+
+// boundSpreadFactor returns the given spread factor bounded by the given min and max spread factors.
+func boundSpreadFactor(spreadFactor, minSpreadFactor, maxSpreadFactor sdk.Dec) sdk.Dec {
+	if spreadFactor.LT(minSpreadFactor) {
+		return minSpreadFactor
+	}
+	if spreadFactor.GT(maxSpreadFactor) {
+		return maxSpreadFactor
+	}
+	return spreadFactor
+}
+
+// getDynamicSpreadFactor returns the dynamic spread factor configuration of the given pool and whether it exists.
+func (k Keeper) getDynamicSpreadFactor(ctx sdk.Context, poolId uint64) (types.DynamicSpreadFactor, bool) {
+	dynamicSpreadFactor := types.DynamicSpreadFactor{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactor(poolId), &dynamicSpreadFactor)
+	if err != nil {
+		panic(err)
+	}
+	return dynamicSpreadFactor, found
+}
+
+// setDynamicSpreadFactor sets the dynamic spread factor configuration of the pool it refers to.
+func (k Keeper) setDynamicSpreadFactor(ctx sdk.Context, dynamicSpreadFactor types.DynamicSpreadFactor) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactor(dynamicSpreadFactor.PoolId), &dynamicSpreadFactor)
+}
+
+// deleteDynamicSpreadFactor deletes the dynamic spread factor configuration of the given pool, if any.
+func (k Keeper) deleteDynamicSpreadFactor(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyDynamicSpreadFactor(poolId))
+}
+
+// getAllDynamicSpreadFactors returns the dynamic spread factor configurations of all pools that opted into one.
+func (k Keeper) getAllDynamicSpreadFactors(ctx sdk.Context) ([]types.DynamicSpreadFactor, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.DynamicSpreadFactorPrefix, func(value []byte) (types.DynamicSpreadFactor, error) {
+		dynamicSpreadFactor := types.DynamicSpreadFactor{}
+		err := k.cdc.Unmarshal(value, &dynamicSpreadFactor)
+		return dynamicSpreadFactor, err
+	})
+}
@@ -0,0 +1,259 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+var (
+	defaultMinDynamicSpreadFactor = sdk.MustNewDecFromStr("0.0005")
+	defaultMaxDynamicSpreadFactor = sdk.MustNewDecFromStr("0.01")
+	defaultLookbackDuration       = 100 * time.Second
+)
+
+func newDynamicSpreadFactorRecord(poolId uint64, volatilityMultiplier sdk.Dec) types.PoolIdToDynamicSpreadFactorRecord {
+	return types.PoolIdToDynamicSpreadFactorRecord{
+		PoolId:               poolId,
+		Enabled:              true,
+		MinSpreadFactor:      defaultMinDynamicSpreadFactor,
+		MaxSpreadFactor:      defaultMaxDynamicSpreadFactor,
+		VolatilityMultiplier: volatilityMultiplier,
+		LookbackDuration:     defaultLookbackDuration,
+	}
+}
+
+func (s *KeeperTestSuite) TestSetDynamicSpreadFactors() {
+	tests := []struct {
+		name                 string
+		staticSpreadFactor   sdk.Dec
+		preexisting          bool
+		record               func(poolId uint64) types.PoolIdToDynamicSpreadFactorRecord
+		expectedSpreadFactor sdk.Dec
+		expectedNotFound     bool
+		expectedErr          error
+	}{
+		{
+			name:               "enable: static spread factor within bounds is kept",
+			staticSpreadFactor: sdk.MustNewDecFromStr("0.003"),
+			record: func(poolId uint64) types.PoolIdToDynamicSpreadFactorRecord {
+				return newDynamicSpreadFactorRecord(poolId, sdk.OneDec())
+			},
+			expectedSpreadFactor: sdk.MustNewDecFromStr("0.003"),
+		},
+		{
+			name:               "enable: static spread factor below min is bounded",
+			staticSpreadFactor: sdk.ZeroDec(),
+			record: func(poolId uint64) types.PoolIdToDynamicSpreadFactorRecord {
+				return newDynamicSpreadFactorRecord(poolId, sdk.OneDec())
+			},
+			expectedSpreadFactor: defaultMinDynamicSpreadFactor,
+		},
+		{
+			name:               "enable: static spread factor above max is bounded",
+			staticSpreadFactor: sdk.MustNewDecFromStr("0.003"),
+			record: func(poolId uint64) types.PoolIdToDynamicSpreadFactorRecord {
+				record := newDynamicSpreadFactorRecord(poolId, sdk.OneDec())
+				record.MaxSpreadFactor = sdk.MustNewDecFromStr("0.002")
+				return record
+			},
+			expectedSpreadFactor: sdk.MustNewDecFromStr("0.002"),
+		},
+		{
+			name:               "disable: configuration is deleted",
+			staticSpreadFactor: sdk.MustNewDecFromStr("0.003"),
+			preexisting:        true,
+			record: func(poolId uint64) types.PoolIdToDynamicSpreadFactorRecord {
+				return types.PoolIdToDynamicSpreadFactorRecord{PoolId: poolId}
+			},
+			expectedNotFound: true,
+		},
+		{
+			name:               "error: disable a pool without a dynamic spread factor",
+			staticSpreadFactor: sdk.MustNewDecFromStr("0.003"),
+			record: func(poolId uint64) types.PoolIdToDynamicSpreadFactorRecord {
+				return types.PoolIdToDynamicSpreadFactorRecord{PoolId: poolId}
+			},
+			expectedErr: types.DynamicSpreadFactorNotFoundError{PoolId: 1},
+		},
+		{
+			name:               "error: pool does not exist",
+			staticSpreadFactor: sdk.MustNewDecFromStr("0.003"),
+			record: func(poolId uint64) types.PoolIdToDynamicSpreadFactorRecord {
+				return newDynamicSpreadFactorRecord(poolId+1, sdk.OneDec())
+			},
+			expectedErr: types.PoolNotFoundError{PoolId: 2},
+		},
+		{
+			name:               "error: lookback duration does not fit into the observations",
+			staticSpreadFactor: sdk.MustNewDecFromStr("0.003"),
+			record: func(poolId uint64) types.PoolIdToDynamicSpreadFactorRecord {
+				record := newDynamicSpreadFactorRecord(poolId, sdk.OneDec())
+				record.LookbackDuration = types.MaxDynamicSpreadFactorLookbackDuration + time.Second
+				return record
+			},
+			expectedErr: types.LookbackDurationTooLongError{PoolId: 1, LookbackDuration: types.MaxDynamicSpreadFactorLookbackDuration + time.Second, MaxLookbackDuration: types.MaxDynamicSpreadFactorLookbackDuration},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, tc.staticSpreadFactor)
+			poolId := pool.GetId()
+
+			if tc.preexisting {
+				err := s.clk.SetDynamicSpreadFactors(s.Ctx, []types.PoolIdToDynamicSpreadFactorRecord{newDynamicSpreadFactorRecord(poolId, sdk.OneDec())})
+				s.Require().NoError(err)
+			}
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test
+			err := s.clk.SetDynamicSpreadFactors(s.Ctx, []types.PoolIdToDynamicSpreadFactorRecord{tc.record(poolId)})
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtSetDynamicSpreadFactor, 1)
+
+			dynamicSpreadFactor, err := s.clk.DynamicSpreadFactor(s.Ctx, poolId)
+			if tc.expectedNotFound {
+				s.Require().ErrorIs(err, types.DynamicSpreadFactorNotFoundError{PoolId: poolId})
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedSpreadFactor, dynamicSpreadFactor.CurrentSpreadFactor)
+
+			// The pool keeps one observation per second over the lookback duration, plus the one preceding it.
+			observationState, found := s.clk.GetObservationState(s.Ctx, poolId)
+			s.Require().True(found)
+			s.Require().Equal(uint32(defaultLookbackDuration/time.Second)+1, observationState.CardinalityNext)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateAllDynamicSpreadFactors() {
+	volatileTicks := []int64{0, 1_000_000, 0, 1_000_000, 0, 1_000_000, 0, 1_000_000, 0, 1_000_000}
+	// The average prices alternate between 1 and 2, so the price changes by 100% five times and by 50% four times.
+	volatility := sdk.NewDec(7).QuoInt64(9)
+
+	tests := []struct {
+		name                 string
+		ticks                []int64
+		volatilityMultiplier sdk.Dec
+		expectedSpreadFactor sdk.Dec
+	}{
+		{
+			name:                 "calm price charges the min spread factor",
+			ticks:                []int64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			volatilityMultiplier: sdk.OneDec(),
+			expectedSpreadFactor: defaultMinDynamicSpreadFactor,
+		},
+		{
+			name:                 "volatile price charges the scaled volatility",
+			ticks:                volatileTicks,
+			volatilityMultiplier: sdk.MustNewDecFromStr("0.001"),
+			expectedSpreadFactor: volatility.Mul(sdk.MustNewDecFromStr("0.001")),
+		},
+		{
+			name:                 "very volatile price charges the max spread factor",
+			ticks:                volatileTicks,
+			volatilityMultiplier: sdk.OneDec(),
+			expectedSpreadFactor: defaultMaxDynamicSpreadFactor,
+		},
+		{
+			name:                 "history shorter than the lookback duration keeps the current spread factor",
+			ticks:                volatileTicks[:5],
+			volatilityMultiplier: sdk.OneDec(),
+			expectedSpreadFactor: defaultMinDynamicSpreadFactor,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(DefaultJoinTime)
+			pool := s.PrepareConcentratedPool()
+			poolId := pool.GetId()
+			_, _, err := s.clk.IncreaseObservationCardinality(s.Ctx, s.TestAccs[0], poolId, uint32(types.DynamicSpreadFactorVolatilityIntervals))
+			s.Require().NoError(err)
+
+			// Without any history, the dynamic spread factor starts at the static spread factor bounded by the min.
+			err = s.clk.SetDynamicSpreadFactors(s.Ctx, []types.PoolIdToDynamicSpreadFactorRecord{newDynamicSpreadFactorRecord(poolId, tc.volatilityMultiplier)})
+			s.Require().NoError(err)
+
+			// Each tick is held for one tenth of the lookback duration.
+			for _, tick := range tc.ticks {
+				s.clk.WriteObservation(s.Ctx, pool)
+				pool.SetCurrentTick(tick)
+				s.Require().NoError(s.clk.SetPool(s.Ctx, pool))
+				s.AddBlockTime(defaultLookbackDuration / types.DynamicSpreadFactorVolatilityIntervals)
+			}
+
+			// System under test
+			s.clk.UpdateAllDynamicSpreadFactors(s.Ctx)
+
+			dynamicSpreadFactor, err := s.clk.DynamicSpreadFactor(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedSpreadFactor, dynamicSpreadFactor.CurrentSpreadFactor)
+
+			// The pool keeps one observation per second over the lookback duration, plus the one preceding it.
+			observationState, found := s.clk.GetObservationState(s.Ctx, poolId)
+			s.Require().True(found)
+			s.Require().Equal(uint32(defaultLookbackDuration/time.Second)+1, observationState.CardinalityNext)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestApplyDynamicSpreadFactor() {
+	s.SetupTest()
+	staticSpreadFactor := sdk.MustNewDecFromStr("0.002")
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, staticSpreadFactor)
+	zeroSpreadFactorPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, sdk.ZeroDec())
+
+	// Without a dynamic spread factor, the requested spread factor is charged.
+	s.Require().Equal(staticSpreadFactor, s.clk.ApplyDynamicSpreadFactor(s.Ctx, pool, staticSpreadFactor))
+
+	// Pin both dynamic spread factors to 0.004 by setting equal bounds.
+	pinnedSpreadFactor := sdk.MustNewDecFromStr("0.004")
+	records := []types.PoolIdToDynamicSpreadFactorRecord{}
+	for _, poolId := range []uint64{pool.GetId(), zeroSpreadFactorPool.GetId()} {
+		record := newDynamicSpreadFactorRecord(poolId, sdk.OneDec())
+		record.MinSpreadFactor = pinnedSpreadFactor
+		record.MaxSpreadFactor = pinnedSpreadFactor
+		records = append(records, record)
+	}
+	s.Require().NoError(s.clk.SetDynamicSpreadFactors(s.Ctx, records))
+
+	// The dynamic spread factor replaces the static one, and discounts of the static one carry over.
+	s.Require().Equal(pinnedSpreadFactor, s.clk.ApplyDynamicSpreadFactor(s.Ctx, pool, staticSpreadFactor))
+	s.Require().Equal(sdk.MustNewDecFromStr("0.002"), s.clk.ApplyDynamicSpreadFactor(s.Ctx, pool, sdk.MustNewDecFromStr("0.001")))
+	s.Require().Equal(pinnedSpreadFactor, s.clk.ApplyDynamicSpreadFactor(s.Ctx, zeroSpreadFactorPool, sdk.ZeroDec()))
+}
+
+func (s *KeeperTestSuite) TestSwapChargesDynamicSpreadFactor() {
+	s.SetupTest()
+	dynamicPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, sdk.MustNewDecFromStr("0.0005"))
+	staticPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, sdk.MustNewDecFromStr("0.003"))
+	s.SetupDefaultPosition(dynamicPool.GetId())
+	s.SetupDefaultPosition(staticPool.GetId())
+
+	record := newDynamicSpreadFactorRecord(dynamicPool.GetId(), sdk.OneDec())
+	record.MinSpreadFactor = sdk.MustNewDecFromStr("0.003")
+	record.MaxSpreadFactor = sdk.MustNewDecFromStr("0.003")
+	s.Require().NoError(s.clk.SetDynamicSpreadFactors(s.Ctx, []types.PoolIdToDynamicSpreadFactorRecord{record}))
+
+	// A swap in the dynamic pool is charged the same as in a pool with the dynamic spread factor as its static one.
+	tokenIn := sdk.NewCoin(USDC, sdk.NewInt(1_000_000))
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(USDC, tokenIn.Amount.MulRaw(2))))
+	dynamicPoolOut, err := s.clk.SwapExactAmountIn(s.Ctx, s.TestAccs[1], dynamicPool, tokenIn, ETH, sdk.ZeroInt(), dynamicPool.GetSpreadFactor(s.Ctx))
+	s.Require().NoError(err)
+	staticPoolOut, err := s.clk.SwapExactAmountIn(s.Ctx, s.TestAccs[1], staticPool, tokenIn, ETH, sdk.ZeroInt(), staticPool.GetSpreadFactor(s.Ctx))
+	s.Require().NoError(err)
+	s.Require().Equal(staticPoolOut, dynamicPoolOut)
+}
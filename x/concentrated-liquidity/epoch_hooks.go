@@ -0,0 +1,32 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+func (k Keeper) EpochHooks() epochstypes.EpochHooks {
+	return EpochHooks{k}
+}
+
+// BeforeEpochStart is the epoch start hook.
+func (h EpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// AfterEpochEnd is the epoch end hook.
+// At the end of every day epoch, it compounds all positions that opted into auto-compounding.
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier == types.AutoCompoundEpochIdentifier {
+		return h.k.compoundAllAutoCompoundPositions(ctx)
+	}
+	return nil
+}
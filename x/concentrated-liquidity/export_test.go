@@ -36,6 +36,46 @@ func (k Keeper) DeletePosition(ctx sdk.Context, positionId uint64, owner sdk.Acc
 	return k.deletePosition(ctx, positionId, owner, poolId)
 }
 
+func (k Keeper) TransferPositions(ctx sdk.Context, positionIds []uint64, sender sdk.AccAddress, newOwner sdk.AccAddress) error {
+	return k.transferPositions(ctx, positionIds, sender, newOwner)
+}
+
+func (k Keeper) CompoundPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, amount0Min, amount1Min sdk.Int) (uint64, sdk.Int, sdk.Int, error) {
+	return k.compoundPosition(ctx, owner, positionId, amount0Min, amount1Min)
+}
+
+func (k Keeper) SetPositionAutoCompoundForOwner(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, autoCompound bool) error {
+	return k.setPositionAutoCompoundForOwner(ctx, owner, positionId, autoCompound)
+}
+
+func (k Keeper) IsPositionAutoCompound(ctx sdk.Context, positionId uint64) bool {
+	return k.isPositionAutoCompound(ctx, positionId)
+}
+
+func (k Keeper) SetPositionOperator(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, operator sdk.AccAddress, approved bool) error {
+	return k.setPositionOperator(ctx, owner, positionId, operator, approved)
+}
+
+func (k Keeper) IsPositionOperator(ctx sdk.Context, positionId uint64, operator sdk.AccAddress) bool {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return false
+	}
+	return k.isPositionOperator(ctx, position, operator)
+}
+
+func (k Keeper) RerangePosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, lowerTick, upperTick int64, amount0Min, amount1Min sdk.Int) (uint64, sdk.Int, sdk.Int, sdk.Dec, int64, int64, error) {
+	return k.rerangePosition(ctx, sender, positionId, lowerTick, upperTick, amount0Min, amount1Min)
+}
+
+func MaxAmountsForPosition(ctx sdk.Context, pool types.ConcentratedPoolExtension, lowerTick, upperTick int64, amount0, amount1 sdk.Int) (sdk.Int, sdk.Int, error) {
+	return maxAmountsForPosition(ctx, pool, lowerTick, upperTick, amount0, amount1)
+}
+
+func (k Keeper) CreatePositionFromSingleAsset(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, routes []poolmanagertypes.SwapAmountInRoute, amount0Min, amount1Min sdk.Int, lowerTick, upperTick int64) (uint64, sdk.Int, sdk.Int, sdk.Dec, int64, int64, sdk.Coins, error) {
+	return k.createPositionFromSingleAsset(ctx, sender, poolId, tokenIn, routes, amount0Min, amount1Min, lowerTick, upperTick)
+}
+
 func (k Keeper) GetPoolById(ctx sdk.Context, poolId uint64) (types.ConcentratedPoolExtension, error) {
 	return k.getPoolById(ctx, poolId)
 }
@@ -72,7 +112,7 @@ func (k Keeper) ComputeOutAmtGivenIn(
 	priceLimit sdk.Dec,
 
 ) (calcTokenIn, calcTokenOut sdk.Coin, poolUpdates PoolUpdates, totalSpreadRewards sdk.Dec, err error) {
-	return k.computeOutAmtGivenIn(ctx, poolId, tokenInMin, tokenOutDenom, spreadFactor, priceLimit)
+	return k.computeOutAmtGivenIn(ctx, poolId, tokenInMin, tokenOutDenom, spreadFactor, priceLimit, nil)
 }
 
 func (k Keeper) SwapInAmtGivenOut(
@@ -95,7 +135,7 @@ func (k Keeper) ComputeInAmtGivenOut(
 	poolId uint64,
 
 ) (calcTokenIn, calcTokenOut sdk.Coin, poolUpdates PoolUpdates, totalSpreadRewards sdk.Dec, err error) {
-	return k.computeInAmtGivenOut(ctx, desiredTokenOut, tokenInDenom, spreadFactor, priceLimit, poolId)
+	return k.computeInAmtGivenOut(ctx, desiredTokenOut, tokenInDenom, spreadFactor, priceLimit, poolId, nil)
 }
 
 func (k Keeper) InitOrUpdateTick(ctx sdk.Context, poolId uint64, currentTick int64, tickIndex int64, liquidityIn sdk.Dec, upper bool) (err error) {
@@ -146,6 +186,14 @@ func (k Keeper) FungifyChargedPosition(ctx sdk.Context, owner sdk.AccAddress, po
 	return k.fungifyChargedPosition(ctx, owner, positionIds)
 }
 
+func (k Keeper) SplitPosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, liquidity sdk.Dec) (uint64, uint64, error) {
+	return k.splitPosition(ctx, sender, positionId, liquidity)
+}
+
+func (k Keeper) MergePositions(ctx sdk.Context, sender sdk.AccAddress, positionIds []uint64) (uint64, error) {
+	return k.mergePositions(ctx, sender, positionIds)
+}
+
 func (k Keeper) ValidatePositionsAndGetTotalLiquidity(ctx sdk.Context, owner sdk.AccAddress, positionIds []uint64, fullyChargedDuration time.Duration) (uint64, int64, int64, sdk.Dec, error) {
 	return k.validatePositionsAndGetTotalLiquidity(ctx, owner, positionIds, fullyChargedDuration)
 }
@@ -345,9 +393,73 @@ func (k Keeper) GetLargestSupportedUptimeDuration(ctx sdk.Context) time.Duration
 func (k Keeper) SetupSwapStrategy(ctx sdk.Context, p types.ConcentratedPoolExtension,
 	spreadFactor sdk.Dec, tokenInDenom string,
 	priceLimit sdk.Dec) (strategy swapstrategy.SwapStrategy, sqrtPriceLimit sdk.Dec, err error) {
-	return k.setupSwapStrategy(p, spreadFactor, tokenInDenom, priceLimit)
+	return k.setupSwapStrategy(ctx, p, spreadFactor, tokenInDenom, priceLimit)
 }
 
 func MoveRewardsToNewPositionAndDeleteOldAcc(ctx sdk.Context, accum accum.AccumulatorObject, oldPositionName, newPositionName string, growthOutside sdk.DecCoins) error {
 	return moveRewardsToNewPositionAndDeleteOldAcc(accum, oldPositionName, newPositionName, growthOutside)
 }
+
+func (k Keeper) WriteObservation(ctx sdk.Context, pool types.ConcentratedPoolExtension) {
+	k.writeObservation(ctx, pool)
+}
+
+func (k Keeper) GetObservationState(ctx sdk.Context, poolId uint64) (types.ObservationState, bool) {
+	return k.getObservationState(ctx, poolId)
+}
+
+func (k Keeper) GetTwapPrice(ctx sdk.Context, poolId uint64, duration time.Duration) (sdk.Dec, error) {
+	return k.getTwapPrice(ctx, poolId, duration)
+}
+
+func (k Keeper) IncreaseObservationCardinality(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, cardinalityNext uint32) (uint32, uint32, error) {
+	return k.increaseObservationCardinality(ctx, sender, poolId, cardinalityNext)
+}
+
+func (k Keeper) AddToIncentive(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, incentiveId uint64, incentiveCoin sdk.Coin) (types.IncentiveRecord, error) {
+	return k.addToIncentive(ctx, sender, poolId, incentiveId, incentiveCoin)
+}
+
+func (k Keeper) ReduceIncentiveEmissionRate(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, incentiveId uint64, emissionRate sdk.Dec) error {
+	return k.reduceIncentiveEmissionRate(ctx, sender, poolId, incentiveId, emissionRate)
+}
+
+func (k Keeper) CancelIncentive(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, incentiveId uint64) (sdk.Coin, error) {
+	return k.cancelIncentive(ctx, sender, poolId, incentiveId)
+}
+
+func (k Keeper) GetPositionPerformance(ctx sdk.Context, positionId uint64) (types.PositionPerformance, bool) {
+	return k.getPositionPerformance(ctx, positionId)
+}
+
+func (k Keeper) ApplyDynamicSpreadFactor(ctx sdk.Context, pool types.ConcentratedPoolExtension, spreadFactor sdk.Dec) sdk.Dec {
+	return k.applyDynamicSpreadFactor(ctx, pool, spreadFactor)
+}
+
+func (k Keeper) CreateIncentiveWithEmissionSchedule(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveCoin sdk.Coin, emissionRate sdk.Dec, emissionSchedule []types.EmissionSegment, startTime time.Time, minUptime time.Duration) (types.IncentiveRecord, error) {
+	return k.createIncentive(ctx, poolId, sender, sender, incentiveCoin, emissionRate, emissionSchedule, startTime, minUptime)
+}
+
+func (k Keeper) CreateIncentiveForSender(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveCoin sdk.Coin, emissionRate sdk.Dec, startTime time.Time, minUptime time.Duration) (types.IncentiveRecord, error) {
+	return k.createIncentiveForSender(ctx, poolId, sender, incentiveCoin, emissionRate, nil, startTime, minUptime)
+}
+
+func (k Keeper) GetTickSpacingMigrationCursor(ctx sdk.Context, poolId uint64) (uint64, bool) {
+	return k.getTickSpacingMigrationCursor(ctx, poolId)
+}
+
+func (k Keeper) SetTickSpacingMigrationCursor(ctx sdk.Context, poolId uint64, positionId uint64) {
+	k.setTickSpacingMigrationCursor(ctx, poolId, positionId)
+}
+
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) (uint64, bool) {
+	return k.getAutoCompoundCursor(ctx)
+}
+
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, positionId uint64) {
+	k.setAutoCompoundCursor(ctx, positionId)
+}
+
+func (k Keeper) GetPositionCreationHeight(ctx sdk.Context, positionId uint64) (int64, bool) {
+	return k.getPositionCreationHeight(ctx, positionId)
+}
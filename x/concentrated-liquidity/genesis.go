@@ -18,6 +18,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 	k.SetNextPositionId(ctx, genState.NextPositionId)
 	k.SetNextIncentiveRecordId(ctx, genState.NextIncentiveRecordId)
+	if genState.AutoCompoundCursor != 0 {
+		k.setAutoCompoundCursor(ctx, genState.AutoCompoundCursor)
+	}
+	for _, approval := range genState.PositionOperatorApprovals {
+		k.setPositionOperatorApproval(ctx, sdk.MustAccAddressFromBech32(approval.Owner), approval.PositionId, sdk.MustAccAddressFromBech32(approval.Operator), true)
+	}
 	// Initialize pools
 	var unpacker codectypes.AnyUnpacker = k.cdc
 	for _, poolData := range genState.PoolData {
@@ -58,6 +64,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 			panic(err)
 		}
 
+		// set observations for pool
+		if poolData.ObservationState != nil {
+			for i, observation := range poolData.Observations {
+				k.setObservation(ctx, poolId, uint32(i), observation)
+			}
+			k.setObservationState(ctx, poolId, *poolData.ObservationState)
+		}
+
+		// set dynamic spread factor for pool
+		if poolData.DynamicSpreadFactor != nil {
+			k.setDynamicSpreadFactor(ctx, *poolData.DynamicSpreadFactor)
+		}
+
+		// resume the pending tick spacing migration of the pool, if any
+		if poolData.TickSpacingMigrationPending {
+			k.setTickSpacingMigrationCursor(ctx, poolId, poolData.TickSpacingMigrationCursor)
+		}
+
 		// set positions for pool
 		for _, positionWrapper := range poolData.PositionData {
 			err := k.SetPosition(ctx, poolId, sdk.MustAccAddressFromBech32(positionWrapper.Position.Address), positionWrapper.Position.LowerTick, positionWrapper.Position.UpperTick, positionWrapper.Position.JoinTime, positionWrapper.Position.Liquidity, positionWrapper.Position.PositionId, positionWrapper.LockId)
@@ -65,6 +89,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 				panic(err)
 			}
 
+			k.setPositionAutoCompound(ctx, positionWrapper.Position.PositionId, positionWrapper.AutoCompound)
+			if positionWrapper.Performance != nil {
+				k.setPositionPerformance(ctx, *positionWrapper.Performance)
+			}
+			if positionWrapper.CreationHeight != 0 {
+				k.setPositionCreationHeight(ctx, positionWrapper.Position.PositionId, int64(positionWrapper.CreationHeight))
+			}
+
 			// set individual spread reward accumulator state position
 			spreadRewardAccumObject, err := k.GetSpreadRewardAccumulator(ctx, poolId)
 			if err != nil {
@@ -149,11 +181,24 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 			positionDataMap[position.PoolId] = make([]genesis.PositionData, 0)
 		}
 
+		var performance *types.PositionPerformance
+		if positionPerformance, found := k.getPositionPerformance(ctx, position.PositionId); found {
+			performance = &positionPerformance
+		}
+
+		var creationHeight uint64
+		if height, found := k.getPositionCreationHeight(ctx, position.PositionId); found {
+			creationHeight = uint64(height)
+		}
+
 		positionDataMap[position.PoolId] = append(positionDataMap[position.PoolId], genesis.PositionData{
 			LockId:                  lockId,
 			Position:                &positionWithoutPoolId,
 			SpreadRewardAccumRecord: spreadRewardAccumPositionRecord,
 			UptimeAccumRecords:      uptimeAccumObject,
+			AutoCompound:            k.isPositionAutoCompound(ctx, position.PositionId),
+			Performance:             performance,
+			CreationHeight:          creationHeight,
 		})
 	}
 
@@ -226,21 +271,50 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 			positionData = positionDataMap[poolId]
 		}
 
+		var observationState *types.ObservationState
+		observations := make([]types.Observation, 0)
+		if state, found := k.getObservationState(ctx, poolId); found {
+			observationState = &state
+			observations = k.getAllObservations(ctx, poolId, state)
+		}
+
+		var dynamicSpreadFactor *types.DynamicSpreadFactor
+		if config, found := k.getDynamicSpreadFactor(ctx, poolId); found {
+			dynamicSpreadFactor = &config
+		}
+
+		tickSpacingMigrationCursor, tickSpacingMigrationPending := k.getTickSpacingMigrationCursor(ctx, poolId)
+
 		poolData = append(poolData, genesis.GenesisPoolData{
-			Pool:                    &anyCopy,
-			PositionData:            positionData,
-			Ticks:                   ticks,
-			SpreadRewardAccumulator: spreadRewardAccumObject,
-			IncentivesAccumulators:  incentivesAccumObject,
-			IncentiveRecords:        incentiveRecordsForPool,
+			Pool:                        &anyCopy,
+			PositionData:                positionData,
+			Ticks:                       ticks,
+			SpreadRewardAccumulator:     spreadRewardAccumObject,
+			IncentivesAccumulators:      incentivesAccumObject,
+			IncentiveRecords:            incentiveRecordsForPool,
+			ObservationState:            observationState,
+			Observations:                observations,
+			DynamicSpreadFactor:         dynamicSpreadFactor,
+			TickSpacingMigrationPending: tickSpacingMigrationPending,
+			TickSpacingMigrationCursor:  tickSpacingMigrationCursor,
 		})
 	}
 
+	positionOperatorApprovals, err := k.getAllPositionOperatorApprovals(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Position ids start at 1, so a zero cursor means that auto-compounding starts from the first position.
+	autoCompoundCursor, _ := k.getAutoCompoundCursor(ctx)
+
 	return &genesis.GenesisState{
-		Params:                k.GetParams(ctx),
-		PoolData:              poolData,
-		NextPositionId:        k.GetNextPositionId(ctx),
-		NextIncentiveRecordId: k.GetNextIncentiveRecordId(ctx),
+		Params:                    k.GetParams(ctx),
+		PoolData:                  poolData,
+		NextPositionId:            k.GetNextPositionId(ctx),
+		NextIncentiveRecordId:     k.GetNextIncentiveRecordId(ctx),
+		PositionOperatorApprovals: positionOperatorApprovals,
+		AutoCompoundCursor:        autoCompoundCursor,
 	}
 }
 
@@ -233,6 +233,8 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 							LockId:                  0,
 							Position:                withPositionId(testPositionModel, 2),
 							SpreadRewardAccumRecord: testSpreadRewardAccumRecord,
+							AutoCompound:            true,
+							CreationHeight:          5,
 							UptimeAccumRecords: []accum.Record{
 								accumRecordWithDefinedValues(accumRecord, sdk.NewDec(10000), sdk.NewInt(100), sdk.NewInt(50)),
 								accumRecordWithDefinedValues(accumRecord, sdk.NewDec(1000), sdk.NewInt(100), sdk.NewInt(50)),
@@ -295,6 +297,8 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 					LockId:                  0,
 					Position:                withPositionId(testPositionModel, 2),
 					SpreadRewardAccumRecord: testSpreadRewardAccumRecord,
+					AutoCompound:            true,
+					CreationHeight:          5,
 					UptimeAccumRecords: []accum.Record{
 						accumRecordWithDefinedValues(accumRecord, sdk.NewDec(10000), sdk.NewInt(100), sdk.NewInt(50)),
 						accumRecordWithDefinedValues(accumRecord, sdk.NewDec(1000), sdk.NewInt(100), sdk.NewInt(50)),
@@ -565,6 +569,11 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 					s.Require().Error(err)
 					s.Require().ErrorIs(err, types.PositionIdToLockNotFoundError{PositionId: positionDataEntry.Position.PositionId})
 				}
+				var creationHeight uint64
+				if height, found := clKeeper.GetPositionCreationHeight(ctx, positionDataEntry.Position.PositionId); found {
+					creationHeight = uint64(height)
+				}
+
 				positionWithoutPoolId := genesis.PositionWithoutPoolId{}
 				positionWithoutPoolId.Address = position.Address
 				positionWithoutPoolId.JoinTime = position.JoinTime
@@ -578,6 +587,8 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 					Position:                &positionWithoutPoolId,
 					SpreadRewardAccumRecord: positionDataEntry.SpreadRewardAccumRecord,
 					UptimeAccumRecords:      positionDataEntry.UptimeAccumRecords,
+					AutoCompound:            clKeeper.IsPositionAutoCompound(ctx, positionDataEntry.Position.PositionId),
+					CreationHeight:          creationHeight,
 				})
 			}
 
@@ -644,6 +655,7 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 							Position:                &testPositionModel,
 							SpreadRewardAccumRecord: testSpreadRewardAccumRecord,
 							UptimeAccumRecords:      testUptimeAccumRecord,
+							CreationHeight:          10,
 						},
 					},
 					spreadFactorAccumValues: genesis.AccumObject{
@@ -830,6 +842,51 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	}
 }
 
+// TestExportImportGenesisCursors tests that the pending tick spacing migrations and the auto-compounding cursor
+// survive a genesis export, marshaling to JSON and import, so that both resume where they left off.
+func (s *KeeperTestSuite) TestExportImportGenesisCursors() {
+	s.SetupTest()
+	migratingFromStart := s.PrepareConcentratedPool().GetId()
+	migratingFromCursor := s.PrepareConcentratedPool().GetId()
+	notMigrating := s.PrepareConcentratedPool().GetId()
+
+	// A pending migration that has not migrated any position yet has a zero cursor.
+	s.clk.SetTickSpacingMigrationCursor(s.Ctx, migratingFromStart, 0)
+	s.clk.SetTickSpacingMigrationCursor(s.Ctx, migratingFromCursor, 7)
+	s.clk.SetAutoCompoundCursor(s.Ctx, 5)
+
+	exported := s.clk.ExportGenesis(s.Ctx)
+	s.Require().Len(exported.PoolData, 3)
+	s.Require().True(exported.PoolData[0].TickSpacingMigrationPending)
+	s.Require().Equal(uint64(0), exported.PoolData[0].TickSpacingMigrationCursor)
+	s.Require().True(exported.PoolData[1].TickSpacingMigrationPending)
+	s.Require().Equal(uint64(7), exported.PoolData[1].TickSpacingMigrationCursor)
+	s.Require().False(exported.PoolData[2].TickSpacingMigrationPending)
+	s.Require().Equal(uint64(5), exported.AutoCompoundCursor)
+
+	bz := s.App.AppCodec().MustMarshalJSON(exported)
+	imported := genesis.GenesisState{}
+	s.App.AppCodec().MustUnmarshalJSON(bz, &imported)
+
+	// System under test.
+	s.SetupTest()
+	s.clk.InitGenesis(s.Ctx, imported)
+
+	cursor, found := s.clk.GetTickSpacingMigrationCursor(s.Ctx, migratingFromStart)
+	s.Require().True(found)
+	s.Require().Equal(uint64(0), cursor)
+	cursor, found = s.clk.GetTickSpacingMigrationCursor(s.Ctx, migratingFromCursor)
+	s.Require().True(found)
+	s.Require().Equal(uint64(7), cursor)
+	_, found = s.clk.GetTickSpacingMigrationCursor(s.Ctx, notMigrating)
+	s.Require().False(found)
+	cursor, found = s.clk.GetAutoCompoundCursor(s.Ctx)
+	s.Require().True(found)
+	s.Require().Equal(uint64(5), cursor)
+
+	s.Require().Equal(exported, s.clk.ExportGenesis(s.Ctx))
+}
+
 // TestMarshalUnmarshalGenesis tests the MarshalUnmarshalGenesis functions of the ConcentratedLiquidityKeeper.
 // It checks that the exported genesis can be marshaled and unmarshaled without panicking.
 func TestMarshalUnmarshalGenesis(t *testing.T) {
@@ -30,11 +30,39 @@ func (k Keeper) HandleTickSpacingDecreaseProposal(ctx sdk.Context, p *types.Tick
 	return k.DecreaseConcentratedPoolTickSpacing(ctx, p.PoolIdToTickSpacingRecords)
 }
 
+// HandleTickSpacingUpdateProposal handles a tick spacing update proposal to the corresponding keeper method.
+func (k Keeper) HandleTickSpacingUpdateProposal(ctx sdk.Context, p *types.TickSpacingUpdateProposal) error {
+	return k.UpdateConcentratedPoolTickSpacing(ctx, p.PoolIdToTickSpacingRecords)
+}
+
+// HandleSpreadFactorChangeProposal handles a spread factor change proposal to the corresponding keeper method.
+func (k Keeper) HandleSpreadFactorChangeProposal(ctx sdk.Context, p *types.SpreadFactorChangeProposal) error {
+	return k.ChangeConcentratedPoolSpreadFactor(ctx, p.PoolIdToSpreadFactorRecords)
+}
+
+// HandleDynamicSpreadFactorProposal handles a dynamic spread factor proposal to the corresponding keeper method.
+func (k Keeper) HandleDynamicSpreadFactorProposal(ctx sdk.Context, p *types.DynamicSpreadFactorProposal) error {
+	return k.SetDynamicSpreadFactors(ctx, p.PoolIdToDynamicSpreadFactorRecords)
+}
+
+// HandleJITProtectionChangeProposal handles a JIT protection change proposal to the corresponding keeper method.
+func (k Keeper) HandleJITProtectionChangeProposal(ctx sdk.Context, p *types.JITProtectionChangeProposal) error {
+	return k.ChangeConcentratedPoolJITProtectionBlocks(ctx, p.PoolIdToJitProtectionBlocksRecords)
+}
+
 func NewConcentratedLiquidityProposalHandler(k Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *types.TickSpacingDecreaseProposal:
 			return k.HandleTickSpacingDecreaseProposal(ctx, c)
+		case *types.TickSpacingUpdateProposal:
+			return k.HandleTickSpacingUpdateProposal(ctx, c)
+		case *types.SpreadFactorChangeProposal:
+			return k.HandleSpreadFactorChangeProposal(ctx, c)
+		case *types.DynamicSpreadFactorProposal:
+			return k.HandleDynamicSpreadFactorProposal(ctx, c)
+		case *types.JITProtectionChangeProposal:
+			return k.HandleJITProtectionChangeProposal(ctx, c)
 		case *types.CreateConcentratedLiquidityPoolsProposal:
 			return k.HandleCreateConcentratedLiquidityPoolsProposal(ctx, c)
 
@@ -460,6 +460,8 @@ func calcAccruedIncentivesForAccum(ctx sdk.Context, accumUptime time.Duration, l
 		return sdk.DecCoins{}, []types.IncentiveRecord{}, types.QualifyingLiquidityOrTimeElapsedNotPositiveError{QualifyingLiquidity: liquidityInAccum, TimeElapsed: timeElapsed}
 	}
 
+	accumUpdateStart := ctx.BlockTime().Add(-time.Duration(timeElapsed.Mul(dec1e9).TruncateInt64()))
+
 	copyPoolIncentiveRecords := make([]types.IncentiveRecord, len(poolIncentiveRecords))
 	copy(copyPoolIncentiveRecords, poolIncentiveRecords)
 	incentivesToAddToCurAccum := sdk.NewDecCoins()
@@ -473,6 +475,10 @@ func calcAccruedIncentivesForAccum(ctx sdk.Context, accumUptime time.Duration, l
 
 		// Total amount emitted = time elapsed * emission
 		totalEmittedAmount := timeElapsed.Mul(incentiveRecordBody.EmissionRate)
+		if len(incentiveRecordBody.EmissionSchedule) > 0 {
+			// Scheduled incentives emit the integral of their emission rate over the time elapsed since they started
+			totalEmittedAmount = incentiveRecordBody.GetScheduledEmission(accumUpdateStart, ctx.BlockTime())
+		}
 
 		// Incentives to emit per unit of qualifying liquidity = total emitted / liquidityInAccum
 		// Note that we truncate to ensure we do not overdistribute incentives
@@ -834,8 +840,6 @@ func updateAccumAndClaimRewards(accum accum.AccumulatorObject, positionKey strin
 // The given growth outside the positions range is used for claim rewards accounting.
 // The rewards are moved as "unclaimed rewards" to the new position.
 // Returns nil on success. Error otherwise.
-// NOTE: It is only used by fungifyChargedPosition which we disabled for launch.
-// nolint: unused
 func moveRewardsToNewPositionAndDeleteOldAcc(accum accum.AccumulatorObject, oldPositionName, newPositionName string, growthOutside sdk.DecCoins) error {
 	if oldPositionName == newPositionName {
 		return types.ModifySamePositionAccumulatorError{PositionAccName: oldPositionName}
@@ -961,6 +965,7 @@ func (k Keeper) GetClaimableIncentives(ctx sdk.Context, positionId uint64) (sdk.
 // Upon successful collection, it bank sends the incentives from the pool address to the owner and returns the collected coins.
 // Returns error if:
 // - position with the given id does not exist
+// - sender is neither the owner of the position nor an operator approved by the owner
 // - other internal database or math errors.
 func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (sdk.Coins, sdk.Coins, error) {
 	// Retrieve the position with the given ID.
@@ -969,12 +974,16 @@ func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positi
 		return sdk.Coins{}, sdk.Coins{}, err
 	}
 
-	if sender.String() != position.Address {
+	if !k.isPositionOwnerOrOperator(ctx, position, sender) {
 		return sdk.Coins{}, sdk.Coins{}, types.NotPositionOwnerError{
 			PositionId: positionId,
 			Address:    sender.String(),
 		}
 	}
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
 
 	// Claim all incentives for the position.
 	collectedIncentivesForPosition, forfeitedIncentivesForPosition, err := k.prepareClaimAllIncentivesForPosition(ctx, position.PositionId)
@@ -994,9 +1003,10 @@ func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positi
 	}
 
 	// Send the collected incentives to the position's owner from the pool's address.
-	if err := k.bankKeeper.SendCoins(ctx, pool.GetIncentivesAddress(), sender, collectedIncentivesForPosition); err != nil {
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetIncentivesAddress(), owner, collectedIncentivesForPosition); err != nil {
 		return sdk.Coins{}, sdk.Coins{}, err
 	}
+	k.recordPositionRewardsCollected(ctx, positionId, sdk.Coins{}, collectedIncentivesForPosition)
 
 	// Send the forfeited incentives to the community pool from the pool's address.
 	err = k.communityPoolKeeper.FundCommunityPool(ctx, forfeitedIncentivesForPosition, pool.GetIncentivesAddress())
@@ -1018,18 +1028,56 @@ func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positi
 	return collectedIncentivesForPosition, forfeitedIncentivesForPosition, nil
 }
 
+// CreateIncentive creates an incentive record in state for the given pool that emits at the constant emissionRate.
+// The incentive is funded by the sender, while the creator is the account that may later manage it.
+// See createIncentive for details.
+// WARNING: this method may mutate the pool, make sure to refetch the pool after calling this method.
+func (k Keeper) CreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, creator sdk.AccAddress, incentiveCoin sdk.Coin, emissionRate sdk.Dec, startTime time.Time, minUptime time.Duration) (types.IncentiveRecord, error) {
+	return k.createIncentive(ctx, poolId, sender, creator, incentiveCoin, emissionRate, nil, startTime, minUptime)
+}
+
+// createIncentiveForSender creates an incentive record in state for the given pool that is funded and managed by the sender.
+// Since anyone can create such incentive records, the incentive coin denom must be listed in the MinIncentiveAmounts
+// param with an amount of at most the incentive coin amount, and the pool must have fewer than
+// types.MaxIncentiveRecordsPerPool incentive records.
+// See createIncentive for details.
+// WARNING: this method may mutate the pool, make sure to refetch the pool after calling this method.
+func (k Keeper) createIncentiveForSender(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveCoin sdk.Coin, emissionRate sdk.Dec, emissionSchedule []types.EmissionSegment, startTime time.Time, minUptime time.Duration) (types.IncentiveRecord, error) {
+	// Param coins are validated to be positive, so a zero amount means that the denom is not listed.
+	minIncentiveAmount := k.GetParams(ctx).MinIncentiveAmounts.AmountOf(incentiveCoin.Denom)
+	if minIncentiveAmount.IsZero() {
+		return types.IncentiveRecord{}, types.IncentiveDenomNotAllowedError{PoolId: poolId, IncentiveCoin: incentiveCoin}
+	}
+	if incentiveCoin.Amount.LT(minIncentiveAmount) {
+		return types.IncentiveRecord{}, types.IncentiveAmountTooLowError{PoolId: poolId, IncentiveCoin: incentiveCoin, MinAmount: minIncentiveAmount}
+	}
+
+	incentiveRecords, err := k.GetAllIncentiveRecordsForPool(ctx, poolId)
+	if err != nil {
+		return types.IncentiveRecord{}, err
+	}
+	if len(incentiveRecords) >= types.MaxIncentiveRecordsPerPool {
+		return types.IncentiveRecord{}, types.MaxIncentiveRecordsReachedError{PoolId: poolId, MaxIncentiveRecords: types.MaxIncentiveRecordsPerPool}
+	}
+
+	return k.createIncentive(ctx, poolId, sender, sender, incentiveCoin, emissionRate, emissionSchedule, startTime, minUptime)
+}
+
 // createIncentive creates an incentive record in state for the given pool.
+// If emissionSchedule is not empty, the incentive emits along it from startTime, then at emissionRate.
+// The creator is recorded on the incentive record as the account that may later manage it.
 //
-// Upon successful creation, it bank sends the incentives from the owner address to the pool address and returns the incentives record.
+// Upon successful creation, it bank sends the incentives from the sender address to the pool address and returns the incentives record.
 // Returns error if:
 // - poolId is invalid
 // - incentiveAmount is invalid (zero or negative).
-// - emissionRate is invalid (zero or negative)
+// - emissionRate is invalid (zero or negative without an emission schedule, negative with one)
+// - emissionSchedule is invalid (see types.ValidateEmission).
 // - startTime is < blockTime.
 // - minUptime is not an authorizedUptime.
 // - other internal database or math errors.
 // WARNING: this method may mutate the pool, make sure to refetch the pool after calling this method.
-func (k Keeper) CreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveCoin sdk.Coin, emissionRate sdk.Dec, startTime time.Time, minUptime time.Duration) (types.IncentiveRecord, error) {
+func (k Keeper) createIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, creator sdk.AccAddress, incentiveCoin sdk.Coin, emissionRate sdk.Dec, emissionSchedule []types.EmissionSegment, startTime time.Time, minUptime time.Duration) (types.IncentiveRecord, error) {
 	pool, err := k.getPoolById(ctx, poolId)
 	if err != nil {
 		return types.IncentiveRecord{}, err
@@ -1045,9 +1093,9 @@ func (k Keeper) CreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAd
 		return types.IncentiveRecord{}, types.StartTimeTooEarlyError{PoolId: poolId, CurrentBlockTime: ctx.BlockTime(), StartTime: startTime}
 	}
 
-	// Ensure emission rate is nonzero and nonnegative
-	if !emissionRate.IsPositive() {
-		return types.IncentiveRecord{}, types.NonPositiveEmissionRateError{PoolId: poolId, EmissionRate: emissionRate}
+	// Ensure emission rate is nonzero and nonnegative, or that the emission schedule is valid
+	if err := types.ValidateEmission(poolId, emissionRate, emissionSchedule); err != nil {
+		return types.IncentiveRecord{}, err
 	}
 
 	// Ensure min uptime is one of the authorized uptimes.
@@ -1086,9 +1134,11 @@ func (k Keeper) CreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAd
 	k.SetNextIncentiveRecordId(ctx, incentiveRecordId+1)
 
 	incentiveRecordBody := types.IncentiveRecordBody{
-		RemainingCoin: sdk.NewDecCoinFromCoin(incentiveCoin),
-		EmissionRate:  emissionRate,
-		StartTime:     startTime,
+		RemainingCoin:    sdk.NewDecCoinFromCoin(incentiveCoin),
+		EmissionRate:     emissionRate,
+		StartTime:        startTime,
+		Creator:          creator.String(),
+		EmissionSchedule: emissionSchedule,
 	}
 
 	// Set up incentive record to put in state
@@ -1122,7 +1172,187 @@ func (k Keeper) CreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAd
 	return incentiveRecord, nil
 }
 
-// nolint: unused
+// getIncentiveRecordById returns the incentive record with the given id on the given pool.
+// Returns error if the pool has no incentive record with the given id.
+func (k Keeper) getIncentiveRecordById(ctx sdk.Context, poolId uint64, incentiveId uint64) (types.IncentiveRecord, error) {
+	incentiveRecords, err := k.GetAllIncentiveRecordsForPool(ctx, poolId)
+	if err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
+	for _, incentiveRecord := range incentiveRecords {
+		if incentiveRecord.IncentiveId == incentiveId {
+			return incentiveRecord, nil
+		}
+	}
+	return types.IncentiveRecord{}, types.IncentiveRecordIdNotFoundError{PoolId: poolId, IncentiveRecordId: incentiveId}
+}
+
+// getIncentiveRecordForCreator syncs the uptime accumulators of the given pool to the current block time and returns
+// the incentive record with the given id as it stands after the sync.
+// Syncing first ensures that everything emitted up to now is accounted for at the record's current emission rate and
+// remaining coin before either of them is changed.
+// Returns error if the pool does not exist, if the incentive record does not exist or has already emitted all of its
+// coins, or if the sender is not the creator of the incentive record.
+// WARNING: this method may mutate the pool, make sure to refetch the pool after calling this method.
+func (k Keeper) getIncentiveRecordForCreator(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, incentiveId uint64) (types.IncentiveRecord, error) {
+	if err := k.updatePoolUptimeAccumulatorsToNow(ctx, poolId); err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
+	incentiveRecord, err := k.getIncentiveRecordById(ctx, poolId, incentiveId)
+	if err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
+	if incentiveRecord.IncentiveRecordBody.Creator != sender.String() {
+		return types.IncentiveRecord{}, types.NotIncentiveCreatorError{IncentiveRecordId: incentiveId, Creator: incentiveRecord.IncentiveRecordBody.Creator, Sender: sender.String()}
+	}
+
+	return incentiveRecord, nil
+}
+
+// addToIncentive tops up the remaining coin of the given incentive record with incentiveCoin, sent from the sender
+// to the pool's incentives address. Since the emission rate is unchanged, the incentive emits for longer.
+// Returns the updated incentive record.
+// Returns error if:
+// - the incentive record does not exist or was not created by the sender.
+// - incentiveCoin is invalid, zero, or of a different denom than the incentive record.
+// - the sender does not have enough balance.
+// WARNING: this method may mutate the pool, make sure to refetch the pool after calling this method.
+func (k Keeper) addToIncentive(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, incentiveId uint64, incentiveCoin sdk.Coin) (types.IncentiveRecord, error) {
+	if !incentiveCoin.IsValid() || incentiveCoin.IsZero() {
+		return types.IncentiveRecord{}, types.InvalidIncentiveCoinError{PoolId: poolId, IncentiveCoin: incentiveCoin}
+	}
+
+	incentiveRecord, err := k.getIncentiveRecordForCreator(ctx, sender, poolId, incentiveId)
+	if err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
+	incentiveDenom := incentiveRecord.IncentiveRecordBody.RemainingCoin.Denom
+	if incentiveCoin.Denom != incentiveDenom {
+		return types.IncentiveRecord{}, types.IncentiveDenomMismatchError{IncentiveRecordId: incentiveId, IncentiveDenom: incentiveDenom, ProvidedDenom: incentiveCoin.Denom}
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetIncentivesAddress(), sdk.NewCoins(incentiveCoin)); err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
+	incentiveRecord.IncentiveRecordBody.RemainingCoin.Amount = incentiveRecord.IncentiveRecordBody.RemainingCoin.Amount.Add(incentiveCoin.Amount.ToDec())
+	if err := k.setIncentiveRecord(ctx, incentiveRecord); err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtAddToIncentive,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyIncentiveId, strconv.FormatUint(incentiveId, 10)),
+		sdk.NewAttribute(types.AttributeIncentiveCoin, incentiveCoin.String()),
+	))
+
+	return incentiveRecord, nil
+}
+
+// reduceIncentiveEmissionRate lowers the emission rate of the given incentive record to emissionRate. Since the
+// remaining coin is unchanged, the incentive emits for longer. An incentive record with an emission schedule emits at
+// its emission rate only once the schedule is over, so its emission rate can only be reduced from then on.
+// Returns error if:
+// - the incentive record does not exist or was not created by the sender.
+// - the emission schedule of the incentive record is not over.
+// - emissionRate is not positive or is not less than the current emission rate.
+// WARNING: this method may mutate the pool, make sure to refetch the pool after calling this method.
+func (k Keeper) reduceIncentiveEmissionRate(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, incentiveId uint64, emissionRate sdk.Dec) error {
+	if !emissionRate.IsPositive() {
+		return types.NonPositiveEmissionRateError{PoolId: poolId, EmissionRate: emissionRate}
+	}
+
+	incentiveRecord, err := k.getIncentiveRecordForCreator(ctx, sender, poolId, incentiveId)
+	if err != nil {
+		return err
+	}
+
+	// Reducing the rate that applies after the schedule would not lower the emissions of a running schedule.
+	if len(incentiveRecord.IncentiveRecordBody.EmissionSchedule) > 0 {
+		scheduleEndTime := incentiveRecord.IncentiveRecordBody.GetScheduleEndTime()
+		if ctx.BlockTime().Before(scheduleEndTime) {
+			return types.EmissionScheduleNotOverError{IncentiveRecordId: incentiveId, ScheduleEndTime: scheduleEndTime}
+		}
+	}
+
+	currentEmissionRate := incentiveRecord.IncentiveRecordBody.EmissionRate
+	if !emissionRate.LT(currentEmissionRate) {
+		return types.EmissionRateNotReducedError{IncentiveRecordId: incentiveId, CurrentEmissionRate: currentEmissionRate, EmissionRate: emissionRate}
+	}
+
+	incentiveRecord.IncentiveRecordBody.EmissionRate = emissionRate
+	if err := k.setIncentiveRecord(ctx, incentiveRecord); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtReduceIncentiveEmissionRate,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyIncentiveId, strconv.FormatUint(incentiveId, 10)),
+		sdk.NewAttribute(types.AttributeIncentiveEmissionRate, emissionRate.String()),
+	))
+
+	return nil
+}
+
+// cancelIncentive deletes the given incentive record and refunds the coins it has not emitted yet from the pool's
+// incentives address to the sender. The remaining coin is truncated to an integer amount, the fractional remainder
+// stays in the incentives address.
+// Returns the refunded coin.
+// Returns error if the incentive record does not exist or was not created by the sender.
+// WARNING: this method may mutate the pool, make sure to refetch the pool after calling this method.
+func (k Keeper) cancelIncentive(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, incentiveId uint64) (sdk.Coin, error) {
+	incentiveRecord, err := k.getIncentiveRecordForCreator(ctx, sender, poolId, incentiveId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	remainingCoin := incentiveRecord.IncentiveRecordBody.RemainingCoin
+	refundedCoin := sdk.NewCoin(remainingCoin.Denom, remainingCoin.Amount.TruncateInt())
+
+	// Setting a record with no remaining coin deletes it from state.
+	incentiveRecord.IncentiveRecordBody.RemainingCoin.Amount = sdk.ZeroDec()
+	if err := k.setIncentiveRecord(ctx, incentiveRecord); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if refundedCoin.IsPositive() {
+		pool, err := k.getPoolById(ctx, poolId)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetIncentivesAddress(), sender, sdk.NewCoins(refundedCoin)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtCancelIncentive,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyIncentiveId, strconv.FormatUint(incentiveId, 10)),
+		sdk.NewAttribute(types.AttributeKeyRefundedCoin, refundedCoin.String()),
+	))
+
+	return refundedCoin, nil
+}
+
 // getLargestDuration retrieves the largest duration from the given slice.
 func getLargestDuration(durations []time.Duration) time.Duration {
 	var largest time.Duration
@@ -1135,8 +1365,6 @@ func getLargestDuration(durations []time.Duration) time.Duration {
 }
 
 // getLargestAuthorizedUptimeDuration retrieves the largest authorized uptime duration from the params.
-// NOTE: It is only used by fungifyChargedPosition which we disabled for launch.
-// nolint: unused
 func (k Keeper) getLargestAuthorizedUptimeDuration(ctx sdk.Context) time.Duration {
 	return getLargestDuration(k.GetParams(ctx).AuthorizedUptimes)
 }
@@ -252,6 +252,18 @@ func withEmissionRate(record types.IncentiveRecord, emissionRate sdk.Dec) types.
 	return record
 }
 
+func withEmissionSchedule(record types.IncentiveRecord, emissionSchedule []types.EmissionSegment) types.IncentiveRecord {
+	record.IncentiveRecordBody.EmissionSchedule = emissionSchedule
+
+	return record
+}
+
+func withRemainingAmountCharged(record types.IncentiveRecord, amountEmitted sdk.Dec) types.IncentiveRecord {
+	record.IncentiveRecordBody.RemainingCoin.Amount = record.IncentiveRecordBody.RemainingCoin.Amount.Sub(amountEmitted)
+
+	return record
+}
+
 // TestCreateAndGetUptimeAccumulators tests the creation and retrieval logic for pool-wide uptime accumulators.
 // Note that this is distinct from the authorized uptime accumulators, which will always be a subset of the accumulators
 // considered in this test.
@@ -499,6 +511,19 @@ func (s *KeeperTestSuite) TestCalcAccruedIncentivesForAccum() {
 	incentiveRecordOneWithDifferentMinUpTime := withMinUptime(incentiveRecordOne, testUptimeTwo)
 	incentiveRecordOneWithDifferentDenom := withDenom(incentiveRecordOne, testDenomTwo)
 	incentiveRecordOneWithStartTimeAfterBlockTime := withStartTime(incentiveRecordOne, incentiveRecordOne.IncentiveRecordBody.StartTime.Add(time.Hour*24))
+	// Emits at 2 per second decaying linearly to zero over two hours, so 5400 over the first hour.
+	incentiveRecordOneWithLinearDecay := withEmissionSchedule(withEmissionRate(incentiveRecordOne, sdk.ZeroDec()), []types.EmissionSegment{
+		{Duration: 2 * time.Hour, StartEmissionRate: sdk.NewDec(2), EndEmissionRate: sdk.ZeroDec()},
+	})
+	// Emits nothing for 30 minutes then 1 per second for an hour, so 1800 over the first hour.
+	incentiveRecordOneWithCliff := withEmissionSchedule(withEmissionRate(incentiveRecordOne, sdk.ZeroDec()), []types.EmissionSegment{
+		{Duration: 30 * time.Minute, StartEmissionRate: sdk.ZeroDec(), EndEmissionRate: sdk.ZeroDec()},
+		{Duration: time.Hour, StartEmissionRate: sdk.OneDec(), EndEmissionRate: sdk.OneDec()},
+	})
+	// Emits at 1 per second for 30 minutes then at the emission rate of 3 per second, so 7200 over the first hour.
+	incentiveRecordOneWithStep := withEmissionSchedule(withEmissionRate(incentiveRecordOne, sdk.NewDec(3)), []types.EmissionSegment{
+		{Duration: 30 * time.Minute, StartEmissionRate: sdk.OneDec(), EndEmissionRate: sdk.OneDec()},
+	})
 
 	type calcAccruedIncentivesTest struct {
 		poolId               uint64
@@ -679,6 +704,39 @@ func (s *KeeperTestSuite) TestCalcAccruedIncentivesForAccum() {
 			},
 			expectedPass: true,
 		},
+		"incentive record with linear decay emission schedule": {
+			poolId:               defaultPoolId,
+			accumUptime:          types.SupportedUptimes[0],
+			qualifyingLiquidity:  sdk.NewDec(100),
+			timeElapsed:          time.Hour,
+			poolIncentiveRecords: []types.IncentiveRecord{incentiveRecordOneWithLinearDecay},
+
+			expectedResult:           sdk.DecCoins{sdk.NewDecCoinFromDec(testDenomOne, sdk.NewDec(54))},
+			expectedIncentiveRecords: []types.IncentiveRecord{withRemainingAmountCharged(incentiveRecordOneWithLinearDecay, sdk.NewDec(5400))},
+			expectedPass:             true,
+		},
+		"incentive record with cliff then constant emission schedule": {
+			poolId:               defaultPoolId,
+			accumUptime:          types.SupportedUptimes[0],
+			qualifyingLiquidity:  sdk.NewDec(100),
+			timeElapsed:          time.Hour,
+			poolIncentiveRecords: []types.IncentiveRecord{incentiveRecordOneWithCliff},
+
+			expectedResult:           sdk.DecCoins{sdk.NewDecCoinFromDec(testDenomOne, sdk.NewDec(18))},
+			expectedIncentiveRecords: []types.IncentiveRecord{withRemainingAmountCharged(incentiveRecordOneWithCliff, sdk.NewDec(1800))},
+			expectedPass:             true,
+		},
+		"incentive record with step emission schedule followed by its emission rate": {
+			poolId:               defaultPoolId,
+			accumUptime:          types.SupportedUptimes[0],
+			qualifyingLiquidity:  sdk.NewDec(100),
+			timeElapsed:          time.Hour,
+			poolIncentiveRecords: []types.IncentiveRecord{incentiveRecordOneWithStep},
+
+			expectedResult:           sdk.DecCoins{sdk.NewDecCoinFromDec(testDenomOne, sdk.NewDec(72))},
+			expectedIncentiveRecords: []types.IncentiveRecord{withRemainingAmountCharged(incentiveRecordOneWithStep, sdk.NewDec(7200))},
+			expectedPass:             true,
+		},
 		"four incentive records with only two eligilbe for emitting incentives": {
 			poolId:              defaultPoolId,
 			accumUptime:         types.SupportedUptimes[0],
@@ -2639,6 +2697,32 @@ func (s *KeeperTestSuite) TestCreateIncentive() {
 
 			expectedError: types.NonPositiveEmissionRateError{PoolId: 1, EmissionRate: sdk.NewDec(-1)},
 		},
+		"emission schedule with zero emission rate": {
+			poolId: defaultPoolId,
+			senderBalance: sdk.NewCoins(
+				sdk.NewCoin(
+					incentiveRecordOne.IncentiveRecordBody.RemainingCoin.Denom,
+					incentiveRecordOne.IncentiveRecordBody.RemainingCoin.Amount.Ceil().RoundInt(),
+				),
+			),
+			recordToSet: withEmissionSchedule(withEmissionRate(incentiveRecordOne, sdk.ZeroDec()), []types.EmissionSegment{
+				{Duration: time.Hour, StartEmissionRate: sdk.OneDec(), EndEmissionRate: sdk.ZeroDec()},
+			}),
+		},
+		"emission schedule with zero duration segment": {
+			poolId: defaultPoolId,
+			senderBalance: sdk.NewCoins(
+				sdk.NewCoin(
+					incentiveRecordOne.IncentiveRecordBody.RemainingCoin.Denom,
+					incentiveRecordOne.IncentiveRecordBody.RemainingCoin.Amount.Ceil().RoundInt(),
+				),
+			),
+			recordToSet: withEmissionSchedule(incentiveRecordOne, []types.EmissionSegment{
+				{Duration: 0, StartEmissionRate: sdk.OneDec(), EndEmissionRate: sdk.ZeroDec()},
+			}),
+
+			expectedError: types.InvalidEmissionSegmentError{Index: 0, Duration: 0, StartEmissionRate: sdk.OneDec(), EndEmissionRate: sdk.ZeroDec()},
+		},
 		"supported but unauthorized min uptime": {
 			poolId: defaultPoolId,
 			senderBalance: sdk.NewCoins(
@@ -2717,7 +2801,7 @@ func (s *KeeperTestSuite) TestCreateIncentive() {
 				clKeeper.SetNextIncentiveRecordId(s.Ctx, originalNextIncentiveRecordId)
 
 				// system under test
-				incentiveRecord, err := clKeeper.CreateIncentive(s.Ctx, tc.poolId, tc.sender, incentiveCoin, tc.recordToSet.IncentiveRecordBody.EmissionRate, tc.recordToSet.IncentiveRecordBody.StartTime, tc.recordToSet.MinUptime)
+				incentiveRecord, err := clKeeper.CreateIncentiveWithEmissionSchedule(s.Ctx, tc.poolId, tc.sender, incentiveCoin, tc.recordToSet.IncentiveRecordBody.EmissionRate, tc.recordToSet.IncentiveRecordBody.EmissionSchedule, tc.recordToSet.IncentiveRecordBody.StartTime, tc.recordToSet.MinUptime)
 
 				// Assertions
 				if tc.expectedError != nil {
@@ -2782,6 +2866,7 @@ func (s *KeeperTestSuite) TestCreateIncentive_NewId() {
 				RemainingCoin: sdk.NewDecCoinFromCoin(incentiveCoin),
 				EmissionRate:  emissionRate,
 				StartTime:     startTime,
+				Creator:       sender.String(),
 			},
 		}
 
@@ -2793,12 +2878,12 @@ func (s *KeeperTestSuite) TestCreateIncentive_NewId() {
 	s.FundAcc(sender, sdk.NewCoins(incentiveCoin.Add(incentiveCoin)))
 
 	// Create the first incentive with given parameters
-	actualIncentiveRecord, err := clKeeper.CreateIncentive(s.Ctx, poolId, sender, incentiveCoin, emissionRate, startTime, minUptime)
+	actualIncentiveRecord, err := clKeeper.CreateIncentive(s.Ctx, poolId, sender, sender, incentiveCoin, emissionRate, startTime, minUptime)
 	s.Require().NoError(err)
 	s.Require().Equal(expectedIncentiveRecord, actualIncentiveRecord)
 
 	// Create the second incentive with the same parameters
-	actualIncentiveRecord, err = clKeeper.CreateIncentive(s.Ctx, poolId, sender, incentiveCoin, emissionRate, startTime, minUptime)
+	actualIncentiveRecord, err = clKeeper.CreateIncentive(s.Ctx, poolId, sender, sender, incentiveCoin, emissionRate, startTime, minUptime)
 	s.Require().NoError(err)
 	s.Require().Equal(expectedIncentiveRecordTwo, actualIncentiveRecord)
 
@@ -2818,6 +2903,348 @@ func (s *KeeperTestSuite) TestCreateIncentive_NewId() {
 	s.Require().Equal(expectedRecords, actualIncentiveRecords)
 }
 
+// TestCreateIncentiveForSender tests that incentive records created directly by an account are bounded by
+// the per denom minimum incentive amounts param and a maximum number of incentive records per pool.
+func (s *KeeperTestSuite) TestCreateIncentiveForSender() {
+	// ETH has fewer decimals than USDC here, so its minimum amount in base units is lower.
+	minIncentiveAmounts := sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(1_000)), sdk.NewCoin(USDC, sdk.NewInt(1_000_000)))
+
+	tests := map[string]struct {
+		incentiveCoin   sdk.Coin
+		existingRecords int
+		expectedError   error
+	}{
+		"valid incentive record": {
+			incentiveCoin: sdk.NewCoin(ETH, sdk.NewInt(1_000)),
+		},
+		"valid incentive record in another denom": {
+			incentiveCoin: sdk.NewCoin(USDC, sdk.NewInt(1_000_000)),
+		},
+		"valid incentive record below the maximum number of records": {
+			incentiveCoin:   sdk.NewCoin(ETH, sdk.NewInt(1_000)),
+			existingRecords: types.MaxIncentiveRecordsPerPool - 1,
+		},
+		"error: incentive amount below minimum": {
+			incentiveCoin: sdk.NewCoin(USDC, sdk.NewInt(999_999)),
+			expectedError: types.IncentiveAmountTooLowError{PoolId: 1, IncentiveCoin: sdk.NewCoin(USDC, sdk.NewInt(999_999)), MinAmount: sdk.NewInt(1_000_000)},
+		},
+		"error: incentive denom not in min incentive amounts": {
+			incentiveCoin: sdk.NewCoin(BAR, sdk.NewInt(1_000_000_000)),
+			expectedError: types.IncentiveDenomNotAllowedError{PoolId: 1, IncentiveCoin: sdk.NewCoin(BAR, sdk.NewInt(1_000_000_000))},
+		},
+		"error: maximum number of records reached": {
+			incentiveCoin:   sdk.NewCoin(ETH, sdk.NewInt(1_000)),
+			existingRecords: types.MaxIncentiveRecordsPerPool,
+			expectedError:   types.MaxIncentiveRecordsReachedError{PoolId: 1, MaxIncentiveRecords: types.MaxIncentiveRecordsPerPool},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			s.setMinIncentiveAmounts(minIncentiveAmounts)
+
+			pool := s.PrepareConcentratedPool()
+			sender := s.TestAccs[0]
+			incentiveCoin := tc.incentiveCoin
+
+			// Incentive records created from gauges are not bounded.
+			for i := 0; i < tc.existingRecords; i++ {
+				s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(ETH, sdk.OneInt())))
+				_, err := s.clk.CreateIncentive(s.Ctx, pool.GetId(), sender, sender, sdk.NewCoin(ETH, sdk.OneInt()), sdk.OneDec(), s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+				s.Require().NoError(err)
+			}
+
+			s.FundAcc(sender, sdk.NewCoins(incentiveCoin))
+
+			// System under test
+			incentiveRecord, err := s.clk.CreateIncentiveForSender(s.Ctx, pool.GetId(), sender, incentiveCoin, sdk.OneDec(), s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+			if tc.expectedError != nil {
+				s.Require().ErrorContains(err, tc.expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(sender.String(), incentiveRecord.IncentiveRecordBody.Creator)
+		})
+	}
+}
+
+// setMinIncentiveAmounts sets the min incentive amounts param to the given coins.
+func (s *KeeperTestSuite) setMinIncentiveAmounts(minIncentiveAmounts sdk.Coins) {
+	params := s.clk.GetParams(s.Ctx)
+	params.MinIncentiveAmounts = minIncentiveAmounts
+	s.clk.SetParams(s.Ctx, params)
+}
+
+// setupIncentiveToManage creates a pool with the default position and an incentive record created by
+// s.TestAccs[1] that emits 100 testDenomOne per second out of 1_000_000, then advances the block time so that the
+// incentive has emitted for 100 seconds. Returns the pool id, the creator and the incentive record id.
+func (s *KeeperTestSuite) setupIncentiveToManage(startDelay time.Duration) (uint64, sdk.AccAddress, uint64) {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(defaultBlockTime)
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	creator := s.TestAccs[1]
+	incentiveCoin := sdk.NewCoin(testDenomOne, sdk.NewInt(1_000_000))
+	s.setMinIncentiveAmounts(sdk.NewCoins(incentiveCoin))
+	s.FundAcc(creator, sdk.NewCoins(incentiveCoin))
+	incentiveRecord, err := s.clk.CreateIncentiveForSender(s.Ctx, pool.GetId(), creator, incentiveCoin, sdk.NewDec(100), s.Ctx.BlockTime().Add(startDelay), types.DefaultAuthorizedUptimes[0])
+	s.Require().NoError(err)
+	s.Require().Equal(creator.String(), incentiveRecord.IncentiveRecordBody.Creator)
+
+	s.AddBlockTime(startDelay + 100*time.Second)
+	return pool.GetId(), creator, incentiveRecord.IncentiveId
+}
+
+func (s *KeeperTestSuite) TestAddToIncentive() {
+	tests := []struct {
+		name          string
+		notCreator    bool
+		incentiveId   uint64
+		incentiveCoin sdk.Coin
+		expectedErr   error
+	}{
+		{
+			name:          "happy path",
+			incentiveCoin: sdk.NewCoin(testDenomOne, sdk.NewInt(10_000)),
+		},
+		{
+			name:          "error: sender is not the creator",
+			notCreator:    true,
+			incentiveCoin: sdk.NewCoin(testDenomOne, sdk.NewInt(10_000)),
+		},
+		{
+			name:          "error: denom is not the incentive denom",
+			incentiveCoin: sdk.NewCoin(testDenomTwo, sdk.NewInt(10_000)),
+			expectedErr:   types.IncentiveDenomMismatchError{IncentiveRecordId: 1, IncentiveDenom: testDenomOne, ProvidedDenom: testDenomTwo},
+		},
+		{
+			name:          "error: zero coin",
+			incentiveCoin: sdk.NewCoin(testDenomOne, sdk.ZeroInt()),
+			expectedErr:   types.InvalidIncentiveCoinError{PoolId: 1, IncentiveCoin: sdk.NewCoin(testDenomOne, sdk.ZeroInt())},
+		},
+		{
+			name:          "error: incentive record does not exist",
+			incentiveId:   2,
+			incentiveCoin: sdk.NewCoin(testDenomOne, sdk.NewInt(10_000)),
+			expectedErr:   types.IncentiveRecordIdNotFoundError{PoolId: 1, IncentiveRecordId: 2},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			poolId, creator, incentiveId := s.setupIncentiveToManage(0)
+			if tc.incentiveId != 0 {
+				incentiveId = tc.incentiveId
+			}
+			sender := creator
+			if tc.notCreator {
+				sender = s.TestAccs[2]
+				tc.expectedErr = types.NotIncentiveCreatorError{IncentiveRecordId: incentiveId, Creator: creator.String(), Sender: sender.String()}
+			}
+			s.FundAcc(sender, sdk.NewCoins(tc.incentiveCoin))
+			pool, err := s.clk.GetPoolById(s.Ctx, poolId)
+			s.Require().NoError(err)
+			incentivesBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, pool.GetIncentivesAddress(), testDenomOne)
+
+			// System under test
+			incentiveRecord, err := s.clk.AddToIncentive(s.Ctx, sender, poolId, incentiveId, tc.incentiveCoin)
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			// The 10_000 emitted in the first 100 seconds are settled before the top up.
+			s.Require().Equal(sdk.NewDec(1_000_000), incentiveRecord.IncentiveRecordBody.RemainingCoin.Amount)
+			s.Require().Equal(sdk.NewDec(100), incentiveRecord.IncentiveRecordBody.EmissionRate)
+			storedRecord, err := s.clk.GetIncentiveRecord(s.Ctx, poolId, incentiveRecord.MinUptime, incentiveId)
+			s.Require().NoError(err)
+			s.Require().Equal(incentiveRecord, storedRecord)
+
+			incentivesBalanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, pool.GetIncentivesAddress(), testDenomOne)
+			s.Require().Equal(tc.incentiveCoin, incentivesBalanceAfter.Sub(incentivesBalanceBefore))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestReduceIncentiveEmissionRate() {
+	tests := []struct {
+		name         string
+		notCreator   bool
+		emissionRate sdk.Dec
+		expectedErr  error
+	}{
+		{
+			name:         "happy path",
+			emissionRate: sdk.NewDec(50),
+		},
+		{
+			name:         "error: sender is not the creator",
+			notCreator:   true,
+			emissionRate: sdk.NewDec(50),
+		},
+		{
+			name:         "error: emission rate is unchanged",
+			emissionRate: sdk.NewDec(100),
+			expectedErr:  types.EmissionRateNotReducedError{IncentiveRecordId: 1, CurrentEmissionRate: sdk.NewDec(100), EmissionRate: sdk.NewDec(100)},
+		},
+		{
+			name:         "error: emission rate is increased",
+			emissionRate: sdk.NewDec(150),
+			expectedErr:  types.EmissionRateNotReducedError{IncentiveRecordId: 1, CurrentEmissionRate: sdk.NewDec(100), EmissionRate: sdk.NewDec(150)},
+		},
+		{
+			name:         "error: zero emission rate",
+			emissionRate: sdk.ZeroDec(),
+			expectedErr:  types.NonPositiveEmissionRateError{PoolId: 1, EmissionRate: sdk.ZeroDec()},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			poolId, creator, incentiveId := s.setupIncentiveToManage(0)
+			sender := creator
+			if tc.notCreator {
+				sender = s.TestAccs[2]
+				tc.expectedErr = types.NotIncentiveCreatorError{IncentiveRecordId: incentiveId, Creator: creator.String(), Sender: sender.String()}
+			}
+
+			// System under test
+			err := s.clk.ReduceIncentiveEmissionRate(s.Ctx, sender, poolId, incentiveId, tc.emissionRate)
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			// The first 100 seconds emit at the old rate and the next 100 seconds at the new one.
+			s.AddBlockTime(100 * time.Second)
+			s.Require().NoError(s.clk.UpdateUptimeAccumulatorsToNow(s.Ctx, poolId))
+			incentiveRecord, err := s.clk.GetIncentiveRecord(s.Ctx, poolId, types.DefaultAuthorizedUptimes[0], incentiveId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.emissionRate, incentiveRecord.IncentiveRecordBody.EmissionRate)
+			s.Require().Equal(sdk.NewDec(1_000_000-100*100-50*100), incentiveRecord.IncentiveRecordBody.RemainingCoin.Amount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestReduceIncentiveEmissionRate_EmissionSchedule() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(defaultBlockTime)
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	creator := s.TestAccs[1]
+	incentiveCoin := sdk.NewCoin(testDenomOne, sdk.NewInt(1_000_000))
+	s.setMinIncentiveAmounts(sdk.NewCoins(incentiveCoin))
+	s.FundAcc(creator, sdk.NewCoins(incentiveCoin))
+	emissionSchedule := []types.EmissionSegment{{Duration: time.Hour, StartEmissionRate: sdk.NewDec(100), EndEmissionRate: sdk.ZeroDec()}}
+	incentiveRecord, err := s.clk.CreateIncentiveWithEmissionSchedule(s.Ctx, pool.GetId(), creator, incentiveCoin, sdk.NewDec(10), emissionSchedule, s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+	s.Require().NoError(err)
+	scheduleEndTime := s.Ctx.BlockTime().Add(time.Hour)
+
+	// The emission rate cannot be reduced while the schedule is running.
+	s.AddBlockTime(100 * time.Second)
+	err = s.clk.ReduceIncentiveEmissionRate(s.Ctx, creator, pool.GetId(), incentiveRecord.IncentiveId, sdk.NewDec(5))
+	s.Require().ErrorContains(err, types.EmissionScheduleNotOverError{IncentiveRecordId: incentiveRecord.IncentiveId, ScheduleEndTime: scheduleEndTime}.Error())
+
+	// The uptime accumulators were synced along the schedule, which the record keeps.
+	syncedRecord, err := s.clk.GetIncentiveRecord(s.Ctx, pool.GetId(), types.DefaultAuthorizedUptimes[0], incentiveRecord.IncentiveId)
+	s.Require().NoError(err)
+	s.Require().Equal(emissionSchedule, syncedRecord.IncentiveRecordBody.EmissionSchedule)
+	expectedEmitted := incentiveRecord.IncentiveRecordBody.GetScheduledEmission(incentiveRecord.IncentiveRecordBody.StartTime, s.Ctx.BlockTime())
+	s.Require().Equal(sdk.NewDec(1_000_000).Sub(expectedEmitted), syncedRecord.IncentiveRecordBody.RemainingCoin.Amount)
+
+	// Once the schedule is over, the emission rate that applies afterwards can be reduced.
+	s.Ctx = s.Ctx.WithBlockTime(scheduleEndTime)
+	s.Require().NoError(s.clk.ReduceIncentiveEmissionRate(s.Ctx, creator, pool.GetId(), incentiveRecord.IncentiveId, sdk.NewDec(5)))
+	incentiveRecord, err = s.clk.GetIncentiveRecord(s.Ctx, pool.GetId(), types.DefaultAuthorizedUptimes[0], incentiveRecord.IncentiveId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(5), incentiveRecord.IncentiveRecordBody.EmissionRate)
+}
+
+func (s *KeeperTestSuite) TestCancelIncentive() {
+	tests := []struct {
+		name           string
+		notCreator     bool
+		incentiveId    uint64
+		startDelay     time.Duration
+		expectedRefund sdk.Int
+		expectedErr    error
+	}{
+		{
+			name:           "happy path: undistributed remainder is refunded",
+			expectedRefund: sdk.NewInt(990_000),
+		},
+		{
+			name:           "happy path: incentive that has not started is refunded in full",
+			startDelay:     time.Hour,
+			expectedRefund: sdk.NewInt(1_000_000),
+		},
+		{
+			name:       "error: sender is not the creator",
+			notCreator: true,
+		},
+		{
+			name:        "error: incentive record does not exist",
+			incentiveId: 2,
+			expectedErr: types.IncentiveRecordIdNotFoundError{PoolId: 1, IncentiveRecordId: 2},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			poolId, creator, incentiveId := s.setupIncentiveToManage(tc.startDelay)
+			if tc.incentiveId != 0 {
+				incentiveId = tc.incentiveId
+			}
+			sender := creator
+			if tc.notCreator {
+				sender = s.TestAccs[2]
+				tc.expectedErr = types.NotIncentiveCreatorError{IncentiveRecordId: incentiveId, Creator: creator.String(), Sender: sender.String()}
+			}
+			if tc.startDelay > 0 {
+				// Move the block time back to before the incentive starts.
+				s.AddBlockTime(-tc.startDelay)
+			}
+
+			// System under test
+			refundedCoin, err := s.clk.CancelIncentive(s.Ctx, sender, poolId, incentiveId)
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewCoin(testDenomOne, tc.expectedRefund), refundedCoin)
+			s.Require().Equal(tc.expectedRefund, s.App.BankKeeper.GetBalance(s.Ctx, creator, testDenomOne).Amount)
+
+			incentiveRecords, err := s.clk.GetAllIncentiveRecordsForPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Empty(incentiveRecords)
+
+			_, err = s.clk.CancelIncentive(s.Ctx, sender, poolId, incentiveId)
+			s.Require().ErrorIs(err, types.IncentiveRecordIdNotFoundError{PoolId: poolId, IncentiveRecordId: incentiveId})
+
+			// Nothing is emitted after the cancellation and the incentives emitted before it remain claimable.
+			s.AddBlockTime(100 * time.Second)
+			owner := s.TestAccs[0]
+			ownerBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, owner, testDenomOne).Amount
+			_, _, err = s.clk.CollectIncentives(s.Ctx, owner, 1)
+			s.Require().NoError(err)
+			collected := s.App.BankKeeper.GetBalance(s.Ctx, owner, testDenomOne).Amount.Sub(ownerBalanceBefore)
+			emitted := sdk.NewInt(1_000_000).Sub(tc.expectedRefund)
+			s.Require().True(collected.LTE(emitted), "collected %s, emitted %s", collected, emitted)
+			s.Require().True(collected.GTE(emitted.SubRaw(1)), "collected %s, emitted %s", collected, emitted)
+		})
+	}
+}
+
 // TestUpdateAccumAndClaimRewards runs basic sanity checks on accumulator update and claiming logic, testing a simple happy path invariant.
 // Both claiming and updating functionality is tested more thoroughly in each function's respective unit tests.
 func (s *KeeperTestSuite) TestUpdateAccumAndClaimRewards() {
@@ -0,0 +1,134 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+// ChangeConcentratedPoolJITProtectionBlocks changes the number of blocks after their creation during which positions
+// in each of the given pools forfeit the spread rewards they claim. Only positions created while a pool's protection
+// is enabled are subject to it, so enabling the protection does not affect existing positions.
+// Returns error if a pool does not exist, or if the new number of blocks is equal to the current one of the pool.
+func (k Keeper) ChangeConcentratedPoolJITProtectionBlocks(ctx sdk.Context, records []types.PoolIdToJITProtectionBlocksRecord) error {
+	for _, record := range records {
+		pool, err := k.GetConcentratedPoolById(ctx, record.PoolId)
+		if err != nil {
+			return err
+		}
+
+		oldJITProtectionBlocks := pool.GetJITProtectionBlocks()
+		if record.NewJitProtectionBlocks == oldJITProtectionBlocks {
+			return types.JITProtectionBlocksUnchangedError{PoolId: pool.GetId(), JITProtectionBlocks: oldJITProtectionBlocks}
+		}
+
+		pool.SetJITProtectionBlocks(record.NewJitProtectionBlocks)
+		if err := k.setPool(ctx, pool); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtChangeJITProtectionBlocks,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyOldJITProtectionBlocks, strconv.FormatUint(oldJITProtectionBlocks, 10)),
+			sdk.NewAttribute(types.AttributeKeyJITProtectionBlocks, strconv.FormatUint(record.NewJitProtectionBlocks, 10)),
+		))
+	}
+	return nil
+}
+
+// isWithinJITProtectionWindow returns true if the given position was created less than the pool's JIT protection
+// blocks ago, in which case the spread rewards it claims are forfeited. Positions created while the pool's protection
+// was disabled have no creation height and are never within the window.
+func (k Keeper) isWithinJITProtectionWindow(ctx sdk.Context, pool types.ConcentratedPoolExtension, positionId uint64) bool {
+	jitProtectionBlocks := pool.GetJITProtectionBlocks()
+	if jitProtectionBlocks == 0 {
+		return false
+	}
+
+	creationHeight, found := k.getPositionCreationHeight(ctx, positionId)
+	if !found {
+		return false
+	}
+	return uint64(ctx.BlockHeight()-creationHeight) < jitProtectionBlocks
+}
+
+// forfeitSpreadRewards gives the given spread rewards claimed by a position to the other LPs that are in range, by
+// adding them to the pool's spread reward accumulator per unit of the pool's active liquidity excluding the liquidity
+// the forfeiting position still has in range. The position's accumulator record is advanced by the same growth, so it
+// earns none of its own forfeited spread rewards. The spread rewards stay in the pool's spread rewards address. The
+// dust lost to truncation is ignored.
+// If no other LP is in range, the spread rewards are sent to the community pool instead.
+// CONTRACT: the spread rewards of the position were just claimed, so its accumulator record is up to date.
+func (k Keeper) forfeitSpreadRewards(ctx sdk.Context, pool types.ConcentratedPoolExtension, positionId uint64, spreadRewards sdk.Coins) error {
+	if spreadRewards.IsZero() {
+		return nil
+	}
+
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	positionActiveLiquidity := sdk.ZeroDec()
+	currentTick := pool.GetCurrentTick()
+	if position.LowerTick <= currentTick && currentTick < position.UpperTick {
+		positionActiveLiquidity = position.Liquidity
+	}
+
+	otherActiveLiquidity := pool.GetLiquidity().Sub(positionActiveLiquidity)
+	if otherActiveLiquidity.IsPositive() {
+		spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, pool.GetId())
+		if err != nil {
+			return err
+		}
+		spreadRewardGrowth := sdk.NewDecCoinsFromCoins(spreadRewards...).QuoDecTruncate(otherActiveLiquidity)
+		spreadRewardAccumulator.AddToAccumulator(spreadRewardGrowth)
+
+		if positionActiveLiquidity.IsPositive() {
+			positionKey := types.KeySpreadRewardPositionAccumulator(positionId)
+			positionRecord, err := spreadRewardAccumulator.GetPosition(positionKey)
+			if err != nil {
+				return err
+			}
+			if err := spreadRewardAccumulator.SetPositionIntervalAccumulation(positionKey, positionRecord.AccumValuePerShare.Add(spreadRewardGrowth...)); err != nil {
+				return err
+			}
+		}
+	} else {
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, spreadRewards, pool.GetSpreadRewardsAddress()); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtForfeitSpreadRewards,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.GetId(), 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, spreadRewards.String()),
+	))
+	return nil
+}
+
+// getPositionCreationHeight returns the block height at which the given position was created and whether it was
+// recorded. It is only recorded for positions created while their pool's JIT protection is enabled.
+func (k Keeper) getPositionCreationHeight(ctx sdk.Context, positionId uint64) (int64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPositionCreationHeight(positionId))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// setPositionCreationHeight records the given block height as the creation height of the given position.
+func (k Keeper) setPositionCreationHeight(ctx sdk.Context, positionId uint64, height int64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPositionCreationHeight(positionId), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// deletePositionCreationHeight deletes the creation height of the given position, if any.
+func (k Keeper) deletePositionCreationHeight(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPositionCreationHeight(positionId))
+}
@@ -0,0 +1,302 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestChangeConcentratedPoolJITProtectionBlocks() {
+	tests := []struct {
+		name        string
+		preexisting uint64
+		poolId      uint64
+		newBlocks   uint64
+		expectedErr error
+	}{
+		{
+			name:      "enable protection",
+			newBlocks: 10,
+		},
+		{
+			name:        "change protection",
+			preexisting: 10,
+			newBlocks:   5,
+		},
+		{
+			name:        "disable protection",
+			preexisting: 10,
+			newBlocks:   0,
+		},
+		{
+			name:        "error: unchanged",
+			preexisting: 10,
+			newBlocks:   10,
+			expectedErr: types.JITProtectionBlocksUnchangedError{PoolId: 1, JITProtectionBlocks: 10},
+		},
+		{
+			name:        "error: pool does not exist",
+			poolId:      2,
+			newBlocks:   10,
+			expectedErr: types.PoolNotFoundError{PoolId: 2},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			if tc.preexisting > 0 {
+				s.Require().NoError(s.clk.ChangeConcentratedPoolJITProtectionBlocks(s.Ctx, []types.PoolIdToJITProtectionBlocksRecord{{PoolId: pool.GetId(), NewJitProtectionBlocks: tc.preexisting}}))
+			}
+			poolId := pool.GetId()
+			if tc.poolId != 0 {
+				poolId = tc.poolId
+			}
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			err := s.clk.ChangeConcentratedPoolJITProtectionBlocks(s.Ctx, []types.PoolIdToJITProtectionBlocksRecord{{PoolId: poolId, NewJitProtectionBlocks: tc.newBlocks}})
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtChangeJITProtectionBlocks, 1)
+
+			pool, err = s.clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.newBlocks, pool.GetJITProtectionBlocks())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestJITProtection() {
+	const (
+		creationHeight  = int64(10)
+		fullWithdraw    = "full withdraw"
+		partialWithdraw = "partial withdraw"
+		collect         = "collect"
+	)
+
+	tests := []struct {
+		name                string
+		jitProtectionBlocks uint64
+		blocksElapsed       int64
+		action              string
+		noLongTermPosition  bool
+		expectForfeit       bool
+	}{
+		{
+			name:          "protection disabled: full withdraw collects spread rewards",
+			action:        fullWithdraw,
+			expectForfeit: false,
+		},
+		{
+			name:                "full withdraw in the same block forfeits spread rewards",
+			jitProtectionBlocks: 5,
+			action:              fullWithdraw,
+			expectForfeit:       true,
+		},
+		{
+			name:                "full withdraw on the last block of the window forfeits spread rewards",
+			jitProtectionBlocks: 5,
+			blocksElapsed:       4,
+			action:              fullWithdraw,
+			expectForfeit:       true,
+		},
+		{
+			name:                "full withdraw once the window is over collects spread rewards",
+			jitProtectionBlocks: 5,
+			blocksElapsed:       5,
+			action:              fullWithdraw,
+			expectForfeit:       false,
+		},
+		{
+			name:                "partial withdraw within the window forfeits spread rewards",
+			jitProtectionBlocks: 5,
+			blocksElapsed:       1,
+			action:              partialWithdraw,
+			expectForfeit:       true,
+		},
+		{
+			name:                "partial withdraw once the window is over keeps spread rewards claimable",
+			jitProtectionBlocks: 5,
+			blocksElapsed:       5,
+			action:              partialWithdraw,
+			expectForfeit:       false,
+		},
+		{
+			name:                "collect within the window forfeits spread rewards",
+			jitProtectionBlocks: 5,
+			blocksElapsed:       1,
+			action:              collect,
+			expectForfeit:       true,
+		},
+		{
+			name:                "collect once the window is over collects spread rewards",
+			jitProtectionBlocks: 5,
+			blocksElapsed:       5,
+			action:              collect,
+			expectForfeit:       false,
+		},
+		{
+			name:                "full withdraw of the last position within the window sends spread rewards to the community pool",
+			jitProtectionBlocks: 5,
+			action:              fullWithdraw,
+			noLongTermPosition:  true,
+			expectForfeit:       true,
+		},
+		{
+			name:                "collect by the only in-range position within the window sends spread rewards to the community pool",
+			jitProtectionBlocks: 5,
+			blocksElapsed:       1,
+			action:              collect,
+			noLongTermPosition:  true,
+			expectForfeit:       true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockHeight(creationHeight)
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, sdk.MustNewDecFromStr("0.003"))
+			longTermOwner, jitOwner, swapper := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+
+			// The long-term position is created before the protection is enabled, so it is never within the window.
+			longTermPositionId := uint64(0)
+			if !tc.noLongTermPosition {
+				longTermPositionId = s.SetupDefaultPositionAcc(pool.GetId(), longTermOwner)
+			}
+			if tc.jitProtectionBlocks > 0 {
+				s.Require().NoError(s.clk.ChangeConcentratedPoolJITProtectionBlocks(s.Ctx, []types.PoolIdToJITProtectionBlocksRecord{{PoolId: pool.GetId(), NewJitProtectionBlocks: tc.jitProtectionBlocks}}))
+			}
+			jitLiquidity, jitPositionId := s.SetupPosition(pool.GetId(), jitOwner, DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+
+			// Swap to charge spread rewards.
+			tokenIn := sdk.NewCoin(USDC, sdk.NewInt(1_000_000))
+			s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+			_, err := s.clk.SwapExactAmountIn(s.Ctx, swapper, pool, tokenIn, ETH, sdk.ZeroInt(), pool.GetSpreadFactor(s.Ctx))
+			s.Require().NoError(err)
+			totalSpreadRewards := s.App.BankKeeper.GetBalance(s.Ctx, pool.GetSpreadRewardsAddress(), USDC).Amount
+
+			jitSpreadRewards, err := s.clk.GetClaimableSpreadRewards(s.Ctx, jitPositionId)
+			s.Require().NoError(err)
+			s.Require().False(jitSpreadRewards.IsZero())
+			longTermSpreadRewards := sdk.NewCoins()
+			if !tc.noLongTermPosition {
+				longTermSpreadRewards, err = s.clk.GetClaimableSpreadRewards(s.Ctx, longTermPositionId)
+				s.Require().NoError(err)
+			}
+
+			s.Ctx = s.Ctx.WithBlockHeight(creationHeight + tc.blocksElapsed).WithEventManager(sdk.NewEventManager())
+			jitBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, jitOwner, USDC).Amount
+			communityPoolBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, s.App.AccountKeeper.GetModuleAddress(distributiontypes.ModuleName), USDC).Amount
+
+			withdrawnUSDC := sdk.ZeroInt()
+			switch tc.action {
+			case fullWithdraw:
+				_, withdrawnUSDC, err = s.clk.WithdrawPosition(s.Ctx, jitOwner, jitPositionId, jitLiquidity)
+			case partialWithdraw:
+				_, withdrawnUSDC, err = s.clk.WithdrawPosition(s.Ctx, jitOwner, jitPositionId, jitLiquidity.QuoInt64(2))
+			case collect:
+				_, err = s.clk.CollectSpreadRewards(s.Ctx, jitOwner, jitPositionId)
+			}
+			s.Require().NoError(err)
+
+			// Spread rewards left in the JIT position are only collected by a full withdrawal or a collect.
+			jitCollected := s.App.BankKeeper.GetBalance(s.Ctx, jitOwner, USDC).Amount.Sub(jitBalanceBefore).Sub(withdrawnUSDC)
+			communityPoolFunded := s.App.BankKeeper.GetBalance(s.Ctx, s.App.AccountKeeper.GetModuleAddress(distributiontypes.ModuleName), USDC).Amount.Sub(communityPoolBalanceBefore)
+			if !tc.expectForfeit {
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtForfeitSpreadRewards, 0)
+				if tc.action != partialWithdraw {
+					s.Require().Equal(jitSpreadRewards.AmountOf(USDC), jitCollected)
+				} else {
+					remainingSpreadRewards, err := s.clk.GetClaimableSpreadRewards(s.Ctx, jitPositionId)
+					s.Require().NoError(err)
+					s.Require().Equal(jitSpreadRewards, remainingSpreadRewards)
+				}
+				if !tc.noLongTermPosition {
+					longTermSpreadRewardsAfter, err := s.clk.GetClaimableSpreadRewards(s.Ctx, longTermPositionId)
+					s.Require().NoError(err)
+					// The long-term position only gets the dust left by the JIT position's claim.
+					s.Require().True(longTermSpreadRewardsAfter.AmountOf(USDC).Sub(longTermSpreadRewards.AmountOf(USDC)).LTE(sdk.OneInt()))
+				}
+				return
+			}
+
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtForfeitSpreadRewards, 1)
+			s.Require().True(jitCollected.IsZero())
+
+			if tc.noLongTermPosition {
+				s.Require().Equal(jitSpreadRewards.AmountOf(USDC), communityPoolFunded)
+				return
+			}
+			s.Require().True(communityPoolFunded.IsZero())
+
+			// The forfeited spread rewards go to the in-range LPs, up to truncation.
+			longTermSpreadRewardsAfter, err := s.clk.GetClaimableSpreadRewards(s.Ctx, longTermPositionId)
+			s.Require().NoError(err)
+			longTermGain := longTermSpreadRewardsAfter.AmountOf(USDC).Sub(longTermSpreadRewards.AmountOf(USDC))
+			s.Require().True(longTermGain.IsPositive())
+			s.Require().True(longTermSpreadRewardsAfter.AmountOf(USDC).LTE(totalSpreadRewards))
+
+			remainingJITSpreadRewards := sdk.ZeroInt()
+			if tc.action != fullWithdraw {
+				remainingSpreadRewards, err := s.clk.GetClaimableSpreadRewards(s.Ctx, jitPositionId)
+				s.Require().NoError(err)
+				remainingJITSpreadRewards = remainingSpreadRewards.AmountOf(USDC)
+			}
+			// The JIT position's liquidity left in range earns none of the spread rewards it forfeited.
+			s.Require().True(remainingJITSpreadRewards.IsZero())
+			s.Require().True(jitSpreadRewards.AmountOf(USDC).Sub(longTermGain).LTE(sdk.NewInt(2)))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestJITProtectionSplitAndMergePositions() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, sdk.MustNewDecFromStr("0.003"))
+	s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[0])
+	s.Require().NoError(s.clk.ChangeConcentratedPoolJITProtectionBlocks(s.Ctx, []types.PoolIdToJITProtectionBlocksRecord{{PoolId: pool.GetId(), NewJitProtectionBlocks: 5}}))
+	jitLiquidity, jitPositionId := s.SetupPosition(pool.GetId(), s.TestAccs[1], DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+
+	tokenIn := sdk.NewCoin(USDC, sdk.NewInt(1_000_000))
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(tokenIn))
+	_, err := s.clk.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool, tokenIn, ETH, sdk.ZeroInt(), pool.GetSpreadFactor(s.Ctx))
+	s.Require().NoError(err)
+
+	// Splitting and merging the JIT position does not reset its window.
+	s.Ctx = s.Ctx.WithBlockHeight(12)
+	firstPositionId, secondPositionId, err := s.clk.SplitPosition(s.Ctx, s.TestAccs[1], jitPositionId, jitLiquidity.QuoInt64(2))
+	s.Require().NoError(err)
+	mergedPositionId, err := s.clk.MergePositions(s.Ctx, s.TestAccs[1], []uint64{firstPositionId, secondPositionId})
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockHeight(14).WithEventManager(sdk.NewEventManager())
+	collected, err := s.clk.CollectSpreadRewards(s.Ctx, s.TestAccs[1], mergedPositionId)
+	s.Require().NoError(err)
+	s.Require().True(collected.IsZero())
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtForfeitSpreadRewards, 1)
+
+	// The merged position earns none of the spread rewards it forfeited.
+	claimable, err := s.clk.GetClaimableSpreadRewards(s.Ctx, mergedPositionId)
+	s.Require().NoError(err)
+	s.Require().True(claimable.IsZero())
+
+	// Once the window of the original position is over, the merged position collects the spread rewards it earns.
+	s.Ctx = s.Ctx.WithBlockHeight(15).WithEventManager(sdk.NewEventManager())
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(tokenIn))
+	pool, err = s.clk.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	_, err = s.clk.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool, tokenIn, ETH, sdk.ZeroInt(), pool.GetSpreadFactor(s.Ctx))
+	s.Require().NoError(err)
+	collected, err = s.clk.CollectSpreadRewards(s.Ctx, s.TestAccs[1], mergedPositionId)
+	s.Require().NoError(err)
+	s.Require().False(collected.IsZero())
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtForfeitSpreadRewards, 0)
+}
@@ -0,0 +1,156 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/swapstrategy"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+// LiquidityDepth returns, for each of the given price changes, the amount of token1 that has to be swapped into
+// the pool to raise its spot price by that fraction and the amount of token0 that has to be swapped in to lower it
+// by that fraction. The amounts include the spread factor and are rounded up, as they would be charged by a swap.
+// Once the liquidity in a direction is exhausted, moving the price further costs nothing, so the amounts are capped
+// at what it takes to consume all the liquidity in that direction.
+// Returns error if the pool does not exist, has no spot price yet, or any price change is not in the (0, 1) range.
+func (k Keeper) LiquidityDepth(ctx sdk.Context, poolId uint64, priceChanges []sdk.Dec) ([]queryproto.LiquidityDepthAtPriceChange, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	sqrtPriceCurrent := pool.GetCurrentSqrtPrice()
+	if sqrtPriceCurrent.IsZero() {
+		return nil, types.NoSpotPriceWhenNoLiquidityError{PoolId: poolId}
+	}
+
+	sqrtPriceTargetsUp := make([]sdk.Dec, len(priceChanges))
+	sqrtPriceTargetsDown := make([]sdk.Dec, len(priceChanges))
+	for i, priceChange := range priceChanges {
+		if !priceChange.IsPositive() || priceChange.GTE(sdk.OneDec()) {
+			return nil, types.InvalidPriceChangeError{PriceChange: priceChange}
+		}
+
+		sqrtPriceTargetsUp[i], err = sqrtPriceTargetAfterPriceChange(sqrtPriceCurrent, sdk.OneDec().Add(priceChange))
+		if err != nil {
+			return nil, err
+		}
+		sqrtPriceTargetsDown[i], err = sqrtPriceTargetAfterPriceChange(sqrtPriceCurrent, sdk.OneDec().Sub(priceChange))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	spreadFactor := k.applyDynamicSpreadFactor(ctx, pool, pool.GetSpreadFactor(ctx))
+	amountsToMoveUp, err := k.computeAmountsInToReachSqrtPrices(ctx, pool, false, spreadFactor, sqrtPriceTargetsUp)
+	if err != nil {
+		return nil, err
+	}
+	amountsToMoveDown, err := k.computeAmountsInToReachSqrtPrices(ctx, pool, true, spreadFactor, sqrtPriceTargetsDown)
+	if err != nil {
+		return nil, err
+	}
+
+	depths := make([]queryproto.LiquidityDepthAtPriceChange, len(priceChanges))
+	for i, priceChange := range priceChanges {
+		depths[i] = queryproto.LiquidityDepthAtPriceChange{
+			PriceChange:        priceChange,
+			Token1InToMoveUp:   sdk.NewCoin(pool.GetToken1(), amountsToMoveUp[i]),
+			Token0InToMoveDown: sdk.NewCoin(pool.GetToken0(), amountsToMoveDown[i]),
+		}
+	}
+	return depths, nil
+}
+
+// sqrtPriceTargetAfterPriceChange returns the sqrt price reached by multiplying the spot price by priceMultiplier,
+// bounded by the minimum and maximum sqrt prices supported by concentrated liquidity.
+func sqrtPriceTargetAfterPriceChange(sqrtPriceCurrent, priceMultiplier sdk.Dec) (sdk.Dec, error) {
+	sqrtPriceMultiplier, err := priceMultiplier.ApproxSqrt()
+	if err != nil {
+		return sdk.Dec{}, types.SqrtRootCalculationError{SqrtPriceLimit: priceMultiplier}
+	}
+
+	sqrtPriceTarget := sqrtPriceCurrent.Mul(sqrtPriceMultiplier)
+	if sqrtPriceTarget.GT(types.MaxSqrtPrice) {
+		return types.MaxSqrtPrice, nil
+	}
+	if sqrtPriceTarget.LT(types.MinSqrtPrice) {
+		return types.MinSqrtPrice, nil
+	}
+	return sqrtPriceTarget, nil
+}
+
+// computeAmountsInToReachSqrtPrices walks the initialized ticks of the pool from its current sqrt price in the swap
+// direction given by zeroForOne and returns the cumulative amount of token in, including the spread factor, needed to
+// reach each of the given sqrt prices. The targets may be given in any order. No state is written.
+func (k Keeper) computeAmountsInToReachSqrtPrices(ctx sdk.Context, pool types.ConcentratedPoolExtension, zeroForOne bool, spreadFactor sdk.Dec, sqrtPriceTargets []sdk.Dec) ([]sdk.Int, error) {
+	poolId := pool.GetId()
+	sqrtPrice := pool.GetCurrentSqrtPrice()
+	liquidity := pool.GetLiquidity().Clone()
+	amountIn := sdk.ZeroDec()
+
+	// Visit the targets in the order they are reached by the walk.
+	order := make([]int, len(sqrtPriceTargets))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		if zeroForOne {
+			return sqrtPriceTargets[order[i]].GT(sqrtPriceTargets[order[j]])
+		}
+		return sqrtPriceTargets[order[i]].LT(sqrtPriceTargets[order[j]])
+	})
+
+	nextTickIter := swapstrategy.New(zeroForOne, sdk.ZeroDec(), k.storeKey, spreadFactor).InitializeNextTickIterator(ctx, poolId, pool.GetCurrentTick())
+	defer nextTickIter.Close()
+
+	amountsIn := make([]sdk.Int, len(sqrtPriceTargets))
+	for _, i := range order {
+		swapStrategy := swapstrategy.New(zeroForOne, sqrtPriceTargets[i], k.storeKey, spreadFactor)
+
+		for !sqrtPrice.Equal(sqrtPriceTargets[i]) {
+			// Past the last initialized tick there is no liquidity left to move the price against.
+			if !nextTickIter.Valid() {
+				sqrtPrice = sqrtPriceTargets[i]
+				break
+			}
+
+			nextTick, err := types.TickIndexFromBytes(nextTickIter.Key())
+			if err != nil {
+				return nil, err
+			}
+			_, nextTickSqrtPrice, err := math.TickToSqrtPrice(nextTick)
+			if err != nil {
+				return nil, fmt.Errorf("could not convert next tick (%v) to nextSqrtPrice", nextTick)
+			}
+
+			sqrtPriceTarget := swapStrategy.GetSqrtTargetPrice(nextTickSqrtPrice)
+			if zeroForOne {
+				amountIn.AddMut(math.CalcAmount0Delta(liquidity, sqrtPriceTarget, sqrtPrice, true))
+			} else {
+				amountIn.AddMut(math.CalcAmount1Delta(liquidity, sqrtPriceTarget, sqrtPrice, true))
+			}
+			sqrtPrice = sqrtPriceTarget
+
+			// Cross the tick if it was reached.
+			if sqrtPriceTarget.Equal(nextTickSqrtPrice) {
+				nextTickInfo, err := ParseTickFromBz(nextTickIter.Value())
+				if err != nil {
+					return nil, err
+				}
+				liquidity.AddMut(swapStrategy.SetLiquidityDeltaSign(nextTickInfo.LiquidityNet))
+				nextTickIter.Next()
+			}
+		}
+
+		// Gross up the amount in by the spread factor charged on it.
+		amountsIn[i] = amountIn.QuoRoundUp(sdk.OneDec().Sub(spreadFactor)).Ceil().TruncateInt()
+	}
+
+	return amountsIn, nil
+}
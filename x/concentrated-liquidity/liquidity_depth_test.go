@@ -0,0 +1,136 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestLiquidityDepth() {
+	priceChanges := []sdk.Dec{
+		sdk.MustNewDecFromStr("0.05"),
+		sdk.MustNewDecFromStr("0.005"),
+		sdk.MustNewDecFromStr("0.02"),
+		sdk.MustNewDecFromStr("0.01"),
+	}
+	// The default position spans from 4545 to 5500 around the spot price of 5000.
+	beyondDefaultRange := []sdk.Dec{sdk.MustNewDecFromStr("0.3"), sdk.MustNewDecFromStr("0.5")}
+
+	tests := []struct {
+		name              string
+		spreadFactor      sdk.Dec
+		withFullRange     bool
+		priceChanges      []sdk.Dec
+		expectedExhausted bool
+		expectedError     error
+	}{
+		{
+			name:         "single position, within range",
+			spreadFactor: sdk.ZeroDec(),
+			priceChanges: priceChanges,
+		},
+		{
+			name:         "single position, spread factor is included",
+			spreadFactor: sdk.MustNewDecFromStr("0.003"),
+			priceChanges: priceChanges,
+		},
+		{
+			name:          "crosses the ticks of the default position into the full range position",
+			spreadFactor:  sdk.MustNewDecFromStr("0.003"),
+			withFullRange: true,
+			priceChanges:  append(priceChanges, beyondDefaultRange...),
+		},
+		{
+			name:              "amounts are capped once the liquidity is exhausted",
+			spreadFactor:      sdk.ZeroDec(),
+			priceChanges:      beyondDefaultRange,
+			expectedExhausted: true,
+		},
+		{
+			name:          "error: zero price change",
+			spreadFactor:  sdk.ZeroDec(),
+			priceChanges:  []sdk.Dec{sdk.ZeroDec()},
+			expectedError: types.InvalidPriceChangeError{PriceChange: sdk.ZeroDec()},
+		},
+		{
+			name:          "error: price change of one",
+			spreadFactor:  sdk.ZeroDec(),
+			priceChanges:  []sdk.Dec{sdk.OneDec()},
+			expectedError: types.InvalidPriceChangeError{PriceChange: sdk.OneDec()},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, tc.spreadFactor)
+			s.SetupDefaultPosition(pool.GetId())
+			if tc.withFullRange {
+				s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+			}
+			pool, err := s.clk.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			// System under test
+			depths, err := s.clk.LiquidityDepth(s.Ctx, pool.GetId(), tc.priceChanges)
+			if tc.expectedError != nil {
+				s.Require().ErrorContains(err, tc.expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(depths, len(tc.priceChanges))
+
+			if tc.expectedExhausted {
+				s.Require().Equal(depths[0].Token1InToMoveUp, depths[1].Token1InToMoveUp)
+				s.Require().Equal(depths[0].Token0InToMoveDown, depths[1].Token0InToMoveDown)
+				return
+			}
+
+			spotPrice := pool.GetCurrentSqrtPrice().Power(2)
+			for i, depth := range depths {
+				s.Require().Equal(tc.priceChanges[i], depth.PriceChange)
+
+				// Swapping in the returned amounts moves the price by the requested fraction, up to rounding.
+				expectedPriceUp := spotPrice.Mul(sdk.OneDec().Add(depth.PriceChange))
+				s.Require().Equal(USDC, depth.Token1InToMoveUp.Denom)
+				s.requirePriceAfterSwap(pool, depth.Token1InToMoveUp, ETH, expectedPriceUp)
+
+				expectedPriceDown := spotPrice.Mul(sdk.OneDec().Sub(depth.PriceChange))
+				s.Require().Equal(ETH, depth.Token0InToMoveDown.Denom)
+				s.requirePriceAfterSwap(pool, depth.Token0InToMoveDown, USDC, expectedPriceDown)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestLiquidityDepth_Errors() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+
+	_, err := s.clk.LiquidityDepth(s.Ctx, pool.GetId(), []sdk.Dec{sdk.MustNewDecFromStr("0.01")})
+	s.Require().ErrorIs(err, types.NoSpotPriceWhenNoLiquidityError{PoolId: pool.GetId()})
+
+	_, err = s.clk.LiquidityDepth(s.Ctx, pool.GetId()+1, []sdk.Dec{sdk.MustNewDecFromStr("0.01")})
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: pool.GetId() + 1})
+}
+
+// requirePriceAfterSwap swaps tokenIn into the pool in a cached context and requires the resulting
+// spot price to reach the expected price and to be within a small relative tolerance of it.
+func (s *KeeperTestSuite) requirePriceAfterSwap(pool types.ConcentratedPoolExtension, tokenIn sdk.Coin, tokenOutDenom string, expectedPrice sdk.Dec) {
+	cacheCtx, _ := s.Ctx.CacheContext()
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(tokenIn))
+	_, err := s.clk.SwapExactAmountIn(cacheCtx, s.TestAccs[2], pool, tokenIn, tokenOutDenom, sdk.ZeroInt(), pool.GetSpreadFactor(cacheCtx))
+	s.Require().NoError(err)
+
+	poolAfterSwap, err := s.clk.GetPoolById(cacheCtx, pool.GetId())
+	s.Require().NoError(err)
+	priceAfterSwap := poolAfterSwap.GetCurrentSqrtPrice().Power(2)
+	if tokenIn.Denom == pool.GetToken1() {
+		s.Require().True(priceAfterSwap.GTE(expectedPrice), "expected price of at least %s, got %s", expectedPrice, priceAfterSwap)
+	} else {
+		s.Require().True(priceAfterSwap.LTE(expectedPrice), "expected price of at most %s, got %s", expectedPrice, priceAfterSwap)
+	}
+	relativeDiff := priceAfterSwap.Sub(expectedPrice).Abs().Quo(expectedPrice)
+	s.Require().True(relativeDiff.LT(sdk.MustNewDecFromStr("0.0001")), "expected price %s, got %s", expectedPrice, priceAfterSwap)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
 	types "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 )
 
 const noUnderlyingLockId = uint64(0)
@@ -118,6 +119,12 @@ func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	if err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
 	}
+	k.recordPositionDeposit(ctx, positionId, sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), actualAmount0), sdk.NewCoin(pool.GetToken1(), actualAmount1)))
+
+	// Record the creation height of the position so that its JIT protection window can be enforced.
+	if pool.GetJITProtectionBlocks() > 0 {
+		k.setPositionCreationHeight(ctx, positionId, ctx.BlockHeight())
+	}
 
 	event := &liquidityChangeEvent{
 		eventType:      types.TypeEvtCreatePosition,
@@ -153,21 +160,44 @@ func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 // is undefined.
 // Additionally, when the last position is removed by calling this method, the current sqrt price and current
 // tick of the pool are set to zero.
+// The sender may be the owner of the position or an operator approved by the owner. In either case,
+// the withdrawn tokens, spread rewards and incentives are sent to the owner.
+// If the position was created within the pool's JIT protection blocks, its spread rewards are forfeited to the
+// remaining in-range LPs instead, whether the position is withdrawn in full or in part.
 // Returns error if
-// - the provided owner does not own the position being withdrawn
+// - the provided sender is neither the owner of the position being withdrawn nor an approved operator
 // - there is no position in the given tick ranges
 // - if the position's underlying lock is not mature
 // - if tick ranges are invalid
 // - if attempts to withdraw an amount higher than originally provided in createPosition for a given range.
-func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error) {
+func (k Keeper) WithdrawPosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error) {
+	amtDenom0, amtDenom1, err = k.withdrawPosition(ctx, sender, positionId, requestedLiquidityAmountToWithdraw)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	// The performance record of a fully withdrawn position is no longer needed.
+	if !k.hasPosition(ctx, positionId) {
+		k.deletePositionPerformance(ctx, positionId)
+	}
+	return amtDenom0, amtDenom1, nil
+}
+
+// withdrawPosition implements WithdrawPosition, except that the performance record of a fully withdrawn position
+// is kept so that callers replacing the position can pass it on to the new position.
+func (k Keeper) withdrawPosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error) {
 	position, err := k.GetPosition(ctx, positionId)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
 
-	// Check if the provided owner owns the position being withdrawn.
-	if owner.String() != position.Address {
-		return sdk.Int{}, sdk.Int{}, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	// Check if the provided sender owns the position being withdrawn or is approved by the owner.
+	if err := k.validatePositionOwnerOrOperator(ctx, position, sender); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
 	}
 
 	// Defense in depth, requestedLiquidityAmountToWithdraw should always be a positive value.
@@ -225,6 +255,15 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
+	k.recordPositionWithdrawal(ctx, positionId, sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), actualAmount0.Abs()), sdk.NewCoin(pool.GetToken1(), actualAmount1.Abs())))
+
+	// Within the pool's JIT protection window, withdrawing part of the position also forfeits its spread rewards.
+	// Otherwise, the spread rewards earned by the withdrawn liquidity could be collected once the window is over.
+	if !requestedLiquidityAmountToWithdraw.Equal(positionLiquidity) && k.isWithinJITProtectionWindow(ctx, pool, positionId) {
+		if _, err := k.collectSpreadRewards(ctx, owner, positionId); err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+	}
 
 	// If the requested liquidity amount to withdraw is equal to the available liquidity, delete the position from state.
 	// Ensure we collect any outstanding spread factors and incentives prior to deleting the position from state. This claiming
@@ -264,7 +303,7 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 	event := &liquidityChangeEvent{
 		eventType:      types.TypeEvtWithdrawPosition,
 		positionId:     positionId,
-		sender:         owner,
+		sender:         sender,
 		poolId:         position.PoolId,
 		lowerTick:      position.LowerTick,
 		upperTick:      position.UpperTick,
@@ -286,20 +325,28 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 // Note that these field indicates the min amount corresponding to the total liquidity of the position,
 // not only for the liquidity amount that is being added.
 // Uses amounts withdrawn from the original position if provided min amount is zero.
+// The new position inherits the performance record of the original position, where only the added amounts count as deposited.
+// The sender may be the owner of the position or an operator approved by the owner. If the sender is an operator,
+// the added amounts are provided by the operator and the new position is created for the owner.
 // Returns error if
+// - Sender is neither the owner of the position nor an approved operator
 // - Withdrawing full position fails
 // - Creating new position with added liquidity fails
 // - Position with `positionId` is the last position in the pool
 // - Position is superfluid staked
-func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, amount0Added, amount1Added, amount0MinGiven, amount1MinGiven sdk.Int) (uint64, sdk.Int, sdk.Int, error) {
+func (k Keeper) addToPosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, amount0Added, amount1Added, amount0MinGiven, amount1MinGiven sdk.Int) (uint64, sdk.Int, sdk.Int, error) {
 	position, err := k.GetPosition(ctx, positionId)
 	if err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, err
 	}
 
-	// Check if the provided owner owns the position being added to.
-	if owner.String() != position.Address {
-		return 0, sdk.Int{}, sdk.Int{}, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	// Check if the provided sender owns the position being added to or is approved by the owner.
+	if err := k.validatePositionOwnerOrOperator(ctx, position, sender); err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, err
+	}
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, err
 	}
 
 	// if one of the liquidity is negative, or both liquidity being added is zero, error
@@ -321,8 +368,15 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 		return 0, sdk.Int{}, sdk.Int{}, types.PositionSuperfluidStakedError{PositionId: position.PositionId}
 	}
 
+	// The operator approvals of the position are removed with it, so they are passed on to the new position.
+	operatorApprovals, err := k.getPositionOperatorApprovals(ctx, positionId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, err
+	}
+
 	// Withdraw full position.
-	amount0Withdrawn, amount1Withdrawn, err := k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
+	lastWithdrawalTime := k.getOrInitPositionPerformance(ctx, positionId).LastWithdrawalTime
+	amount0Withdrawn, amount1Withdrawn, err := k.withdrawPosition(ctx, owner, positionId, position.Liquidity)
 	if err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, err
 	}
@@ -343,6 +397,14 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 	if err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, err
 	}
+
+	// If an operator is adding to the position, the added amounts are provided by the operator.
+	// They are sent to the owner so that the new position is funded by and created for the owner.
+	if !sender.Equals(owner) {
+		if err := k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), amount0Added, amount1Added, sender, owner); err != nil {
+			return 0, sdk.Int{}, sdk.Int{}, err
+		}
+	}
 	tokensProvided := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0Desired), sdk.NewCoin(pool.GetToken1(), amount1Desired))
 	minimumAmount0 := amount0Withdrawn
 	minimumAmount1 := amount1Withdrawn
@@ -357,13 +419,17 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 	if err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, err
 	}
+	withdrawn := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0Withdrawn), sdk.NewCoin(pool.GetToken1(), amount1Withdrawn))
+	deposited := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), actualAmount0), sdk.NewCoin(pool.GetToken1(), actualAmount1))
+	k.replacePositionPerformance(ctx, positionId, newPositionId, lastWithdrawalTime, withdrawn, deposited)
+	k.setPositionOperatorApprovals(ctx, newPositionId, operatorApprovals)
 
 	// Emit an event indicating that a position was added to.
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeEvtAddToPosition,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
 			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
 			sdk.NewAttribute(types.AttributeKeyNewPositionId, strconv.FormatUint(newPositionId, 10)),
 			sdk.NewAttribute(types.AttributeAmount0, actualAmount0.String()),
@@ -374,6 +440,199 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 	return newPositionId, actualAmount0, actualAmount1, nil
 }
 
+// rerangePosition withdraws the position with the given id in full and creates a new position for the same owner
+// over the given tick range, funded with the withdrawn amounts. Outstanding spread rewards and incentives of the old
+// position are collected to the owner as part of the withdrawal. Any amount that does not fit the new range's ratio
+// remains in the owner's balance. If the old position opted into auto-compounding, the new position inherits the flag.
+// The new position also inherits the performance record of the old position, where only the withdrawn amounts left in
+// the owner's balance count as withdrawn.
+// The sender may be the owner of the position or an operator approved by the owner.
+// Returns the new position id, the amounts of each token in the new position, the liquidity created and the canonical ticks.
+// Returns error if
+// - Sender is neither the owner of the position nor an approved operator
+// - Withdrawing full position fails (e.g. the position has an active underlying lock)
+// - Position with `positionId` is the last position in the pool
+// - Creating the new position fails
+func (k Keeper) rerangePosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, lowerTick, upperTick int64, amount0Min, amount1Min sdk.Int) (uint64, sdk.Int, sdk.Int, sdk.Dec, int64, int64, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
+	}
+
+	if err := k.validatePositionOwnerOrOperator(ctx, position, sender); err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
+	}
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
+	}
+
+	isAutoCompound := k.isPositionAutoCompound(ctx, positionId)
+	operatorApprovals, err := k.getPositionOperatorApprovals(ctx, positionId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
+	}
+
+	lastWithdrawalTime := k.getOrInitPositionPerformance(ctx, positionId).LastWithdrawalTime
+	amount0Withdrawn, amount1Withdrawn, err := k.withdrawPosition(ctx, sender, positionId, position.Liquidity)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
+	}
+
+	// Re-creating the last position in the pool would re-initialize the pool's price from the withdrawn amounts.
+	anyPositionsRemainingInPool, err := k.HasAnyPositionForPool(ctx, position.PoolId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
+	}
+	if !anyPositionsRemainingInPool {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, types.RerangeLastPositionInPoolError{PoolId: position.PoolId, PositionId: positionId}
+	}
+
+	pool, err := k.GetConcentratedPoolById(ctx, position.PoolId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
+	}
+	tokensProvided := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0Withdrawn), sdk.NewCoin(pool.GetToken1(), amount1Withdrawn))
+	newPositionId, actualAmount0, actualAmount1, liquidityCreated, lowerTick, upperTick, err := k.createPosition(ctx, position.PoolId, owner, tokensProvided, amount0Min, amount1Min, lowerTick, upperTick)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
+	}
+	deposited := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), actualAmount0), sdk.NewCoin(pool.GetToken1(), actualAmount1))
+	k.replacePositionPerformance(ctx, positionId, newPositionId, lastWithdrawalTime, tokensProvided, deposited)
+	k.setPositionOperatorApprovals(ctx, newPositionId, operatorApprovals)
+
+	if isAutoCompound {
+		k.setPositionAutoCompound(ctx, newPositionId, true)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtRerangePosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyNewPositionId, strconv.FormatUint(newPositionId, 10)),
+			sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(lowerTick, 10)),
+			sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(upperTick, 10)),
+			sdk.NewAttribute(types.AttributeAmount0, actualAmount0.String()),
+			sdk.NewAttribute(types.AttributeAmount1, actualAmount1.String()),
+		),
+	})
+
+	return newPositionId, actualAmount0, actualAmount1, liquidityCreated, lowerTick, upperTick, nil
+}
+
+// createPositionFromSingleAsset creates a position over the given tick range from tokenIn, which must be one of the
+// pool's tokens. Part of tokenIn is first swapped into the other pool token so that the amounts are in the ratio
+// required by the range at the current price. The swap goes through the pool itself, or through the given
+// poolmanager route if it is not empty, in which case the route must end in the other pool token.
+// Since the swap amount is estimated from the spot price, the amounts that do not fit into the position are left
+// over as dust and remain in the sender's balance.
+// Returns the position id, the amounts of each token in the position, the liquidity created, the canonical ticks
+// and the dust.
+// Returns error if
+// - the pool does not exist or has no liquidity yet
+// - tokenIn is not one of the pool's tokens
+// - the tick range is invalid
+// - the swap or creating the position fails
+func (k Keeper) createPositionFromSingleAsset(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, routes []poolmanagertypes.SwapAmountInRoute, amount0Min, amount1Min sdk.Int, lowerTick, upperTick int64) (uint64, sdk.Int, sdk.Int, sdk.Dec, int64, int64, sdk.Coins, error) {
+	pool, err := k.GetConcentratedPoolById(ctx, poolId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, nil, err
+	}
+
+	token0, token1 := pool.GetToken0(), pool.GetToken1()
+	if tokenIn.Denom != token0 && tokenIn.Denom != token1 {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, nil, types.TokenInDenomNotInPoolError{TokenInDenom: tokenIn.Denom}
+	}
+
+	if err := validateTickRangeIsValid(pool.GetTickSpacing(), lowerTick, upperTick); err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, nil, err
+	}
+
+	// The swap ratio is computed from the current price, which is undefined until the pool has liquidity.
+	if pool.GetCurrentSqrtPrice().IsZero() {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, nil, types.NoSpotPriceWhenNoLiquidityError{PoolId: poolId}
+	}
+
+	tokensIn := sdk.NewCoins(tokenIn)
+	amount0, amount1, err := k.rebalanceForRange(ctx, sender, pool, lowerTick, upperTick, tokensIn.AmountOf(token0), tokensIn.AmountOf(token1), routes)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, nil, err
+	}
+
+	// Refetch the pool since the rebalancing swap may have moved its price.
+	pool, err = k.GetConcentratedPoolById(ctx, poolId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, nil, err
+	}
+	amount0Provided, amount1Provided, err := maxAmountsForPosition(ctx, pool, lowerTick, upperTick, amount0, amount1)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, nil, err
+	}
+	tokensProvided := sdk.NewCoins(sdk.NewCoin(token0, amount0Provided), sdk.NewCoin(token1, amount1Provided))
+	positionId, actualAmount0, actualAmount1, liquidityCreated, lowerTick, upperTick, err := k.createPosition(ctx, poolId, sender, tokensProvided, amount0Min, amount1Min, lowerTick, upperTick)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, nil, err
+	}
+	dust := sdk.NewCoins(sdk.NewCoin(token0, amount0.Sub(actualAmount0)), sdk.NewCoin(token1, amount1.Sub(actualAmount1)))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCreatePositionFromSingleAsset,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, tokenIn.String()),
+			sdk.NewAttribute(types.AttributeAmount0, actualAmount0.String()),
+			sdk.NewAttribute(types.AttributeAmount1, actualAmount1.String()),
+			sdk.NewAttribute(types.AttributeKeyDust, dust.String()),
+		),
+	})
+
+	return positionId, actualAmount0, actualAmount1, liquidityCreated, lowerTick, upperTick, dust, nil
+}
+
+// maxAmountsForPosition returns the largest amounts to provide to createPosition, not exceeding amount0 and amount1,
+// for which the amounts required by the created position do not exceed amount0 and amount1 either.
+// Since the amounts required by the liquidity created from the provided amounts are rounded up, they may exceed the
+// provided amounts, in which case the provided amounts are reduced by the excess until the required amounts fit.
+// Returns error if the required amounts do not fit within types.MaxPositionAmountsIterations reductions.
+func maxAmountsForPosition(ctx sdk.Context, pool types.ConcentratedPoolExtension, lowerTick, upperTick int64, amount0, amount1 sdk.Int) (sdk.Int, sdk.Int, error) {
+	_, _, sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(lowerTick, upperTick)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	amount0Provided, amount1Provided := amount0, amount1
+	for i := 0; i < types.MaxPositionAmountsIterations; i++ {
+		liquidity := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0Provided, amount1Provided)
+		if !liquidity.IsPositive() {
+			// createPosition rejects the amounts as they create no liquidity.
+			return amount0Provided, amount1Provided, nil
+		}
+
+		// The required amounts are truncated the same way UpdatePosition truncates the amounts it returns.
+		required0, required1, err := pool.CalcActualAmounts(ctx, lowerTick, upperTick, liquidity)
+		if err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+		excess0, excess1 := required0.TruncateInt().Sub(amount0), required1.TruncateInt().Sub(amount1)
+		if !excess0.IsPositive() && !excess1.IsPositive() {
+			return amount0Provided, amount1Provided, nil
+		}
+
+		if excess0.IsPositive() {
+			amount0Provided = amount0Provided.Sub(excess0)
+		}
+		if excess1.IsPositive() {
+			amount1Provided = amount1Provided.Sub(excess1)
+		}
+	}
+	return sdk.Int{}, sdk.Int{}, types.PositionAmountsExceededError{LowerTick: lowerTick, UpperTick: upperTick, Amount0: amount0, Amount1: amount1}
+}
+
 // UpdatePosition updates the position in the given pool id and in the given tick range and liquidityAmount.
 // Negative liquidityDelta implies withdrawing liquidity.
 // Positive liquidityDelta implies adding liquidity.
@@ -424,6 +683,12 @@ func (k Keeper) UpdatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 		return sdk.Int{}, sdk.Int{}, err
 	}
 
+	// Record the pool's cumulative values before the active liquidity changes
+	// so that the seconds per liquidity cumulative reflects the previous liquidity.
+	if pool.IsCurrentTickInRange(lowerTick, upperTick) {
+		k.writeObservation(ctx, pool)
+	}
+
 	// the pool's liquidity value is only updated if this position is active
 	pool.UpdateLiquidityIfActivePosition(ctx, lowerTick, upperTick, liquidityDelta)
 
@@ -493,6 +758,9 @@ func (k Keeper) initializeInitialPositionForPool(ctx sdk.Context, pool types.Con
 	if err != nil {
 		return err
 	}
+
+	// The observations start from the initial tick, since the pool had no price before.
+	k.initializeObservations(ctx, pool.GetId())
 	return nil
 }
 
@@ -554,7 +822,7 @@ func (k Keeper) isLockMature(ctx sdk.Context, underlyingLockId uint64) (bool, er
 // Returns nil on success. Returns nil if position with the given id does not exist.
 // Returns an error if any of the parameters are invalid or mismatched.
 // If the position ID is zero, returns types.ErrZeroPositionId.
-// If the position owner does not match the update initiator, returns types.PositionOwnerMismatchError.
+// If the update initiator is neither the position owner nor an operator approved by the owner, returns types.PositionOwnerMismatchError.
 // If the lower tick provided does not match the position's lower tick, returns types.LowerTickMismatchError.
 // If the upper tick provided does not match the position's upper tick, returns types.UpperTickMismatchError.
 // If the liquidity to withdraw is greater than the current liquidity of the position, returns types.LiquidityWithdrawalError.
@@ -571,7 +839,7 @@ func (k Keeper) validatePositionUpdateById(ctx sdk.Context, positionId uint64, u
 			return err
 		}
 
-		if position.Address != updateInitiator.String() {
+		if !k.isPositionOwnerOrOperator(ctx, position, updateInitiator) {
 			return types.PositionOwnerMismatchError{PositionOwner: position.Address, Sender: updateInitiator.String()}
 		}
 
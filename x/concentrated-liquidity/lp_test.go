@@ -13,6 +13,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
 	clmodel "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
 	types "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 )
 
 type lpTest struct {
@@ -2158,3 +2159,222 @@ func (s *KeeperTestSuite) TestValidatePositionUpdateById() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMaxAmountsForPosition() {
+	tests := []struct {
+		name      string
+		lowerTick int64
+		upperTick int64
+		amount0   sdk.Int
+		amount1   sdk.Int
+	}{
+		{
+			name:      "range around the current price",
+			lowerTick: DefaultLowerTick,
+			upperTick: DefaultUpperTick,
+			amount0:   sdk.NewInt(9_999_999),
+			amount1:   sdk.NewInt(49_999_999_997),
+		},
+		{
+			name:      "range above the current price",
+			lowerTick: DefaultUpperTick,
+			upperTick: DefaultUpperTick + 500000,
+			amount0:   sdk.NewInt(10_000),
+			amount1:   sdk.ZeroInt(),
+		},
+		{
+			name:      "range below the current price",
+			lowerTick: DefaultLowerTick - 500000,
+			upperTick: DefaultLowerTick,
+			amount0:   sdk.ZeroInt(),
+			amount1:   sdk.NewInt(10_000),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+			pool, err := s.clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			// System under test
+			amount0, amount1, err := cl.MaxAmountsForPosition(s.Ctx, pool, tc.lowerTick, tc.upperTick, tc.amount0, tc.amount1)
+			s.Require().NoError(err)
+			s.Require().True(amount0.LTE(tc.amount0))
+			s.Require().True(amount1.LTE(tc.amount1))
+
+			// A position created from the returned amounts requires no more than the given amounts.
+			sender := s.TestAccs[1]
+			s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(ETH, tc.amount0), sdk.NewCoin(USDC, tc.amount1)))
+			tokensProvided := sdk.NewCoins(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1))
+			_, actualAmount0, actualAmount1, _, _, _, err := s.clk.CreatePosition(s.Ctx, pool.GetId(), sender, tokensProvided, sdk.ZeroInt(), sdk.ZeroInt(), tc.lowerTick, tc.upperTick)
+			s.Require().NoError(err)
+			s.Require().True(actualAmount0.LTE(tc.amount0))
+			s.Require().True(actualAmount1.LTE(tc.amount1))
+
+			// Only rounding is held back from the amount that limits the position's liquidity.
+			limited0 := tc.amount0.Sub(actualAmount0).LTE(sdk.OneInt())
+			limited1 := tc.amount1.Sub(actualAmount1).LTE(sdk.OneInt())
+			s.Require().True(limited0 || limited1, "amounts %s %s, actual %s %s", tc.amount0, tc.amount1, actualAmount0, actualAmount1)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCreatePositionFromSingleAsset() {
+	// The default position spans from 4545 to 5500 around the spot price of 5000.
+	aboveRangeLowerTick, aboveRangeUpperTick := DefaultUpperTick, DefaultUpperTick+500000
+	// Balancer pool with the same spot price as the concentrated pool.
+	balancerCoins := []sdk.Coin{sdk.NewCoin(ETH, sdk.NewInt(1_000_000_000)), sdk.NewCoin(USDC, sdk.NewInt(5_000_000_000_000))}
+
+	tests := []struct {
+		name            string
+		tokenIn         sdk.Coin
+		lowerTick       int64
+		upperTick       int64
+		viaRoute        bool
+		routeDenomOut   string
+		noLiquidity     bool
+		expectedSwap    bool
+		expectedAmount0 bool
+		expectedAmount1 bool
+		expectedError   error
+	}{
+		{
+			name:            "token0 in, range around the current price",
+			tokenIn:         sdk.NewCoin(ETH, sdk.NewInt(10_000)),
+			lowerTick:       DefaultLowerTick,
+			upperTick:       DefaultUpperTick,
+			expectedSwap:    true,
+			expectedAmount0: true,
+			expectedAmount1: true,
+		},
+		{
+			name:            "token1 in, range around the current price",
+			tokenIn:         sdk.NewCoin(USDC, sdk.NewInt(50_000_000)),
+			lowerTick:       DefaultLowerTick,
+			upperTick:       DefaultUpperTick,
+			expectedSwap:    true,
+			expectedAmount0: true,
+			expectedAmount1: true,
+		},
+		{
+			name:            "token0 in, range above the current price only holds token0",
+			tokenIn:         sdk.NewCoin(ETH, sdk.NewInt(10_000)),
+			lowerTick:       aboveRangeLowerTick,
+			upperTick:       aboveRangeUpperTick,
+			expectedAmount0: true,
+		},
+		{
+			name:            "token1 in, range above the current price is entirely swapped to token0",
+			tokenIn:         sdk.NewCoin(USDC, sdk.NewInt(50_000_000)),
+			lowerTick:       aboveRangeLowerTick,
+			upperTick:       aboveRangeUpperTick,
+			expectedSwap:    true,
+			expectedAmount0: true,
+		},
+		{
+			name:            "token0 in, swapped through the given route",
+			tokenIn:         sdk.NewCoin(ETH, sdk.NewInt(10_000)),
+			lowerTick:       DefaultLowerTick,
+			upperTick:       DefaultUpperTick,
+			viaRoute:        true,
+			routeDenomOut:   USDC,
+			expectedSwap:    true,
+			expectedAmount0: true,
+			expectedAmount1: true,
+		},
+		{
+			name:          "error: token in is not in the pool",
+			tokenIn:       sdk.NewCoin("uosmo", sdk.NewInt(10_000)),
+			lowerTick:     DefaultLowerTick,
+			upperTick:     DefaultUpperTick,
+			expectedError: types.TokenInDenomNotInPoolError{TokenInDenom: "uosmo"},
+		},
+		{
+			name:          "error: route does not end in the other pool token",
+			tokenIn:       sdk.NewCoin(ETH, sdk.NewInt(10_000)),
+			lowerTick:     DefaultLowerTick,
+			upperTick:     DefaultUpperTick,
+			viaRoute:      true,
+			routeDenomOut: ETH,
+			expectedError: types.SwapRouteTokenOutMismatchError{RouteTokenOutDenom: ETH, ExpectedDenom: USDC},
+		},
+		{
+			name:          "error: invalid tick range",
+			tokenIn:       sdk.NewCoin(ETH, sdk.NewInt(10_000)),
+			lowerTick:     DefaultUpperTick,
+			upperTick:     DefaultLowerTick,
+			expectedError: types.InvalidLowerUpperTickError{LowerTick: DefaultUpperTick, UpperTick: DefaultLowerTick},
+		},
+		{
+			name:          "error: pool has no liquidity",
+			tokenIn:       sdk.NewCoin(ETH, sdk.NewInt(10_000)),
+			lowerTick:     DefaultLowerTick,
+			upperTick:     DefaultUpperTick,
+			noLiquidity:   true,
+			expectedError: types.NoSpotPriceWhenNoLiquidityError{PoolId: 1},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			sender := s.TestAccs[2]
+			pool := s.PrepareConcentratedPool()
+			if !tc.noLiquidity {
+				s.SetupDefaultPosition(pool.GetId())
+			}
+			// The rebalancing swap is bounded by the time weighted average price of the pool.
+			s.AddBlockTime(types.RebalanceTwapDuration)
+
+			var routes []poolmanagertypes.SwapAmountInRoute
+			if tc.viaRoute {
+				balancerPoolId := s.PrepareBalancerPoolWithCoins(balancerCoins...)
+				routes = []poolmanagertypes.SwapAmountInRoute{{PoolId: balancerPoolId, TokenOutDenom: tc.routeDenomOut}}
+			}
+
+			pool, err := s.clk.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			sqrtPriceBefore := pool.GetCurrentSqrtPrice()
+			s.FundAcc(sender, sdk.NewCoins(tc.tokenIn))
+
+			// System under test
+			positionId, amount0, amount1, liquidity, lowerTick, upperTick, dust, err := s.clk.CreatePositionFromSingleAsset(s.Ctx, sender, pool.GetId(), tc.tokenIn, routes, sdk.ZeroInt(), sdk.ZeroInt(), tc.lowerTick, tc.upperTick)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedAmount0, amount0.IsPositive())
+			s.Require().Equal(tc.expectedAmount1, amount1.IsPositive())
+			s.Require().Equal(tc.lowerTick, lowerTick)
+			s.Require().Equal(tc.upperTick, upperTick)
+
+			position, err := s.clk.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(sender.String(), position.Address)
+			s.Require().Equal(liquidity, position.Liquidity)
+
+			// Only the dust remains in the sender's balance.
+			s.Require().Equal(dust, s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+			if !tc.expectedSwap {
+				s.Require().True(dust.IsZero())
+			}
+
+			// The dust is a small fraction of the value provided.
+			price := sqrtPriceBefore.Power(2)
+			valueIn := sdk.NewCoins(tc.tokenIn).AmountOf(ETH).ToDec().Mul(price).Add(sdk.NewCoins(tc.tokenIn).AmountOf(USDC).ToDec())
+			dustValue := dust.AmountOf(ETH).ToDec().Mul(price).Add(dust.AmountOf(USDC).ToDec())
+			s.Require().True(dustValue.LT(valueIn.Mul(sdk.NewDecWithPrec(1, 2))), "dust %s is too large for %s in", dust, tc.tokenIn)
+
+			// Swapping through a route leaves the price of the pool unchanged.
+			poolAfter, err := s.clk.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedSwap && !tc.viaRoute, !sqrtPriceBefore.Equal(poolAfter.GetCurrentSqrtPrice()))
+		})
+	}
+}
@@ -163,6 +163,12 @@ func (p Pool) GetLastLiquidityUpdate() time.Time {
 	return p.LastLiquidityUpdate
 }
 
+// GetJITProtectionBlocks returns the number of blocks after their creation during which positions forfeit their
+// spread rewards. Zero means the protection is disabled.
+func (p Pool) GetJITProtectionBlocks() uint64 {
+	return p.JitProtectionBlocks
+}
+
 func (p Pool) GetType() poolmanagertypes.PoolType {
 	return poolmanagertypes.Concentrated
 }
@@ -187,6 +193,16 @@ func (p *Pool) SetTickSpacing(tickSpacing uint64) {
 	p.TickSpacing = tickSpacing
 }
 
+// SetSpreadFactor updates the spread factor of the pool.
+func (p *Pool) SetSpreadFactor(spreadFactor sdk.Dec) {
+	p.SpreadFactor = spreadFactor
+}
+
+// SetJITProtectionBlocks updates the JIT protection blocks parameter of the pool.
+func (p *Pool) SetJITProtectionBlocks(jitProtectionBlocks uint64) {
+	p.JitProtectionBlocks = jitProtectionBlocks
+}
+
 // SetLastLiquidityUpdate updates the pool's LastLiquidityUpdate to newTime.
 func (p *Pool) SetLastLiquidityUpdate(newTime time.Time) {
 	p.LastLiquidityUpdate = newTime
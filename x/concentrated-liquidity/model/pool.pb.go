@@ -57,6 +57,10 @@ type Pool struct {
 	// last_liquidity_update is the last time either the pool liquidity or the
 	// active tick changed
 	LastLiquidityUpdate time.Time `protobuf:"bytes,13,opt,name=last_liquidity_update,json=lastLiquidityUpdate,proto3,stdtime" json:"last_liquidity_update" yaml:"last_liquidity_update"`
+	// jit_protection_blocks is the number of blocks after its creation during
+	// which a position forfeits the spread rewards it claims to the remaining
+	// in-range LPs. Zero disables the protection.
+	JitProtectionBlocks uint64 `protobuf:"varint,14,opt,name=jit_protection_blocks,json=jitProtectionBlocks,proto3" json:"jit_protection_blocks,omitempty" yaml:"jit_protection_blocks"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -100,48 +104,50 @@ func init() {
 }
 
 var fileDescriptor_3526ea5373d96c9a = []byte{
-	// 648 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6a, 0xdb, 0x4c,
-	0x14, 0xb5, 0xf2, 0xfb, 0x65, 0x9c, 0x84, 0x2f, 0x13, 0x27, 0x55, 0x42, 0x63, 0xb9, 0x82, 0x16,
-	0x17, 0x6a, 0xa9, 0x6e, 0xa1, 0x8b, 0xec, 0xe2, 0x96, 0x40, 0x21, 0x90, 0x30, 0x49, 0x29, 0x94,
-	0x82, 0x18, 0x4b, 0x13, 0x77, 0xb0, 0xac, 0x91, 0x35, 0xe3, 0x34, 0x79, 0x80, 0x42, 0x97, 0x59,
-	0x76, 0x99, 0x87, 0xe8, 0x43, 0x84, 0xae, 0xb2, 0x2c, 0x85, 0xaa, 0x25, 0x79, 0x03, 0x3f, 0x41,
-	0xd1, 0xcc, 0xc8, 0x56, 0x89, 0xbb, 0xc8, 0xca, 0x3e, 0xe7, 0x9e, 0x7b, 0xe6, 0xde, 0xab, 0xb9,
-	0x03, 0x1e, 0x33, 0xde, 0x63, 0x9c, 0x72, 0xd7, 0x67, 0x91, 0x4f, 0x22, 0x91, 0x60, 0x41, 0x82,
-	0x46, 0x48, 0xfb, 0x03, 0x1a, 0x50, 0x71, 0xe6, 0xc6, 0x8c, 0x85, 0x4e, 0x9c, 0x30, 0xc1, 0xe0,
-	0x43, 0x2d, 0x75, 0x8a, 0xd2, 0x91, 0xd2, 0x39, 0x69, 0xb6, 0x89, 0xc0, 0xcd, 0xcd, 0x0d, 0x5f,
-	0xea, 0x3c, 0x99, 0xe4, 0x2a, 0xa0, 0x1c, 0x36, 0x2b, 0x1d, 0xd6, 0x61, 0x8a, 0xcf, 0xfe, 0x69,
-	0xd6, 0xea, 0x30, 0xd6, 0x09, 0x89, 0x2b, 0x51, 0x7b, 0x70, 0xec, 0x0a, 0xda, 0x23, 0x5c, 0xe0,
-	0x5e, 0xac, 0x04, 0xf6, 0xcf, 0x79, 0x30, 0x73, 0xc0, 0x58, 0x08, 0x9f, 0x80, 0x79, 0x1c, 0x04,
-	0x09, 0xe1, 0xdc, 0x34, 0x6a, 0x46, 0x7d, 0xa1, 0x05, 0x87, 0xa9, 0xb5, 0x7c, 0x86, 0x7b, 0xe1,
-	0xb6, 0xad, 0x03, 0x36, 0xca, 0x25, 0x70, 0x0f, 0x40, 0x2a, 0x0b, 0xa5, 0x27, 0x84, 0x7b, 0x79,
-	0xe2, 0x94, 0x4c, 0xdc, 0x1a, 0xa6, 0xd6, 0x86, 0x4a, 0xbc, 0xad, 0xb1, 0xd1, 0xca, 0x98, 0xdc,
-	0xd1, 0x6e, 0x6f, 0xc1, 0x3a, 0x8f, 0x13, 0x82, 0x03, 0x2f, 0x21, 0x1f, 0x71, 0x12, 0x8c, 0x1d,
-	0xa7, 0xa5, 0xe3, 0x83, 0x61, 0x6a, 0x6d, 0x29, 0xc7, 0xc9, 0x3a, 0x1b, 0x55, 0x54, 0x00, 0x29,
-	0x3e, 0x37, 0x5e, 0x06, 0x53, 0x34, 0x30, 0x67, 0x6a, 0x46, 0x7d, 0x06, 0x4d, 0xd1, 0x00, 0x7e,
-	0x32, 0xc0, 0xba, 0x3f, 0x48, 0x12, 0x12, 0x09, 0x4f, 0x50, 0xbf, 0xeb, 0x8d, 0x46, 0x6c, 0xce,
-	0xca, 0x93, 0xf6, 0x2f, 0x53, 0xab, 0xf4, 0x23, 0xb5, 0x1e, 0x75, 0xa8, 0xf8, 0x30, 0x68, 0x3b,
-	0x3e, 0xeb, 0xe9, 0x31, 0xeb, 0x9f, 0x06, 0x0f, 0xba, 0xae, 0x38, 0x8b, 0x09, 0x77, 0x5e, 0x11,
-	0x7f, 0x5c, 0xd7, 0x64, 0x57, 0x1b, 0x55, 0x74, 0xe0, 0x88, 0xfa, 0xdd, 0xbd, 0x9c, 0x86, 0xeb,
-	0x60, 0x4e, 0xb0, 0x2e, 0x89, 0x9e, 0x9a, 0x73, 0xd9, 0xb1, 0x48, 0xa3, 0x11, 0xdf, 0x34, 0xe7,
-	0x0b, 0x7c, 0x13, 0xf6, 0x01, 0xcc, 0x0f, 0xe0, 0xfd, 0x44, 0x78, 0x71, 0x42, 0x7d, 0x62, 0xfe,
-	0x27, 0x4b, 0x7e, 0x79, 0xe7, 0x92, 0x57, 0xf2, 0x51, 0x32, 0xed, 0x64, 0xa3, 0xff, 0xb5, 0xfd,
-	0x61, 0x3f, 0x11, 0x07, 0x19, 0x05, 0xb7, 0xc1, 0x62, 0xb1, 0x27, 0x73, 0xa1, 0x66, 0xd4, 0xa7,
-	0x5b, 0xf7, 0x86, 0xa9, 0xb5, 0x7a, 0xbb, 0x63, 0x1b, 0x95, 0x0b, 0x7d, 0x66, 0xb9, 0x72, 0x0e,
-	0x3c, 0xc6, 0x3e, 0x8d, 0x3a, 0x26, 0xc8, 0x3e, 0x40, 0x31, 0xb7, 0x18, 0xb5, 0x51, 0x39, 0x83,
-	0x87, 0x0a, 0xc1, 0x43, 0xb0, 0x46, 0x4e, 0x63, 0x16, 0x65, 0xd6, 0x58, 0xd7, 0xe7, 0xb1, 0x88,
-	0x98, 0x65, 0x59, 0x40, 0x6d, 0x98, 0x5a, 0xf7, 0x95, 0xc9, 0x44, 0x99, 0x8d, 0x60, 0xce, 0xef,
-	0xa8, 0x4e, 0xf6, 0x23, 0x02, 0xbb, 0x60, 0x49, 0x5f, 0x9c, 0x63, 0xec, 0x0b, 0x96, 0x98, 0x8b,
-	0x72, 0x74, 0xbb, 0x77, 0x1e, 0x5d, 0xe5, 0xaf, 0x5b, 0xa8, 0xcc, 0x6c, 0xb4, 0xa8, 0xf0, 0xae,
-	0x84, 0xf0, 0x14, 0xac, 0x85, 0x98, 0x8b, 0xf1, 0x2d, 0xf0, 0x06, 0x71, 0x80, 0x05, 0x31, 0x97,
-	0x6a, 0x46, 0xbd, 0xfc, 0x6c, 0xd3, 0x51, 0x3b, 0xe9, 0xe4, 0x3b, 0xe9, 0x1c, 0xe5, 0x3b, 0xd9,
-	0xaa, 0x67, 0x05, 0x8d, 0x3b, 0x9c, 0x68, 0x63, 0x9f, 0xff, 0xb2, 0x0c, 0xb4, 0x9a, 0xc5, 0x46,
-	0x17, 0xea, 0x8d, 0x8c, 0x6c, 0xaf, 0x7c, 0xbe, 0xb0, 0x4a, 0x5f, 0x2e, 0xac, 0xd2, 0xb7, 0xaf,
-	0x8d, 0xd9, 0x6c, 0xab, 0x5f, 0xb7, 0xde, 0x5f, 0x5e, 0x57, 0x8d, 0xab, 0xeb, 0xaa, 0xf1, 0xfb,
-	0xba, 0x6a, 0x9c, 0xdf, 0x54, 0x4b, 0x57, 0x37, 0xd5, 0xd2, 0xf7, 0x9b, 0x6a, 0xe9, 0x5d, 0xab,
-	0xd0, 0xb4, 0x7e, 0x7d, 0x1a, 0x21, 0x6e, 0xf3, 0x1c, 0xb8, 0x27, 0xcd, 0x17, 0xee, 0xe9, 0xbf,
-	0xde, 0xae, 0x1e, 0x0b, 0x48, 0xd8, 0x9e, 0x93, 0x3d, 0x3c, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0x50, 0x0a, 0x9a, 0xe4, 0xea, 0x04, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0x13, 0x41,
+	0x14, 0xef, 0xf2, 0x9f, 0x29, 0x10, 0x19, 0x0a, 0x2e, 0x44, 0xba, 0x75, 0x13, 0x4d, 0x4d, 0xec,
+	0xae, 0xd5, 0xc4, 0x03, 0x37, 0xaa, 0x21, 0x31, 0x21, 0x81, 0x0c, 0x18, 0x13, 0x63, 0xb2, 0xd9,
+	0xce, 0x0e, 0x75, 0xe8, 0x76, 0x67, 0xbb, 0x33, 0x45, 0xf8, 0x00, 0x26, 0x1e, 0x39, 0x7a, 0xe4,
+	0x3b, 0xe8, 0x87, 0x20, 0x9e, 0x38, 0x1a, 0x0f, 0xd5, 0xc0, 0x37, 0xe8, 0x27, 0x30, 0x3b, 0x33,
+	0xdb, 0xae, 0xa1, 0x1e, 0x38, 0xb5, 0xef, 0xf7, 0x7e, 0xef, 0x37, 0xef, 0xfd, 0x76, 0xe6, 0x81,
+	0x27, 0x8c, 0x77, 0x18, 0xa7, 0xdc, 0xc5, 0x2c, 0xc2, 0x24, 0x12, 0x89, 0x2f, 0x48, 0x50, 0x0b,
+	0x69, 0xb7, 0x47, 0x03, 0x2a, 0xce, 0xdc, 0x98, 0xb1, 0xd0, 0x89, 0x13, 0x26, 0x18, 0x7c, 0xa4,
+	0xa9, 0x4e, 0x9e, 0x3a, 0x64, 0x3a, 0x27, 0xf5, 0x26, 0x11, 0x7e, 0x7d, 0x63, 0x1d, 0x4b, 0x9e,
+	0x27, 0x8b, 0x5c, 0x15, 0x28, 0x85, 0x8d, 0x52, 0x8b, 0xb5, 0x98, 0xc2, 0xd3, 0x7f, 0x1a, 0xb5,
+	0x5a, 0x8c, 0xb5, 0x42, 0xe2, 0xca, 0xa8, 0xd9, 0x3b, 0x72, 0x05, 0xed, 0x10, 0x2e, 0xfc, 0x4e,
+	0xac, 0x08, 0xf6, 0xb7, 0x39, 0x30, 0xb5, 0xcf, 0x58, 0x08, 0x9f, 0x82, 0x59, 0x3f, 0x08, 0x12,
+	0xc2, 0xb9, 0x69, 0x54, 0x8c, 0xea, 0x7c, 0x03, 0x0e, 0xfa, 0xd6, 0xd2, 0x99, 0xdf, 0x09, 0xb7,
+	0x6c, 0x9d, 0xb0, 0x51, 0x46, 0x81, 0xbb, 0x00, 0x52, 0xd9, 0x28, 0x3d, 0x21, 0xdc, 0xcb, 0x0a,
+	0x27, 0x64, 0xe1, 0xe6, 0xa0, 0x6f, 0xad, 0xab, 0xc2, 0xdb, 0x1c, 0x1b, 0x2d, 0x8f, 0xc0, 0x6d,
+	0xad, 0xf6, 0x0e, 0xac, 0xf1, 0x38, 0x21, 0x7e, 0xe0, 0x25, 0xe4, 0x93, 0x9f, 0x04, 0x23, 0xc5,
+	0x49, 0xa9, 0xf8, 0x70, 0xd0, 0xb7, 0x36, 0x95, 0xe2, 0x78, 0x9e, 0x8d, 0x4a, 0x2a, 0x81, 0x14,
+	0x9e, 0x09, 0x2f, 0x81, 0x09, 0x1a, 0x98, 0x53, 0x15, 0xa3, 0x3a, 0x85, 0x26, 0x68, 0x00, 0x3f,
+	0x1b, 0x60, 0x0d, 0xf7, 0x92, 0x84, 0x44, 0xc2, 0x13, 0x14, 0xb7, 0xbd, 0xa1, 0xc5, 0xe6, 0xb4,
+	0x3c, 0x69, 0xef, 0xb2, 0x6f, 0x15, 0x7e, 0xf5, 0xad, 0xc7, 0x2d, 0x2a, 0x3e, 0xf6, 0x9a, 0x0e,
+	0x66, 0x1d, 0x6d, 0xb3, 0xfe, 0xa9, 0xf1, 0xa0, 0xed, 0x8a, 0xb3, 0x98, 0x70, 0xe7, 0x35, 0xc1,
+	0xa3, 0xbe, 0xc6, 0xab, 0xda, 0xa8, 0xa4, 0x13, 0x87, 0x14, 0xb7, 0x77, 0x33, 0x18, 0xae, 0x81,
+	0x19, 0xc1, 0xda, 0x24, 0x7a, 0x66, 0xce, 0xa4, 0xc7, 0x22, 0x1d, 0x0d, 0xf1, 0xba, 0x39, 0x9b,
+	0xc3, 0xeb, 0xb0, 0x0b, 0x60, 0x76, 0x00, 0xef, 0x26, 0xc2, 0x8b, 0x13, 0x8a, 0x89, 0x39, 0x27,
+	0x5b, 0x7e, 0x75, 0xe7, 0x96, 0x97, 0x33, 0x2b, 0x99, 0x56, 0xb2, 0xd1, 0x3d, 0x2d, 0x7f, 0xd0,
+	0x4d, 0xc4, 0x7e, 0x0a, 0xc1, 0x2d, 0xb0, 0x90, 0x9f, 0xc9, 0x9c, 0xaf, 0x18, 0xd5, 0xc9, 0xc6,
+	0xfd, 0x41, 0xdf, 0x5a, 0xb9, 0x3d, 0xb1, 0x8d, 0x8a, 0xb9, 0x39, 0xd3, 0x5a, 0xe9, 0x03, 0x8f,
+	0x7d, 0x4c, 0xa3, 0x96, 0x09, 0xd2, 0x0f, 0x90, 0xaf, 0xcd, 0x67, 0x6d, 0x54, 0x4c, 0xc3, 0x03,
+	0x15, 0xc1, 0x03, 0xb0, 0x4a, 0x4e, 0x63, 0x16, 0xa5, 0xd2, 0xbe, 0xee, 0xcf, 0x63, 0x11, 0x31,
+	0x8b, 0xb2, 0x81, 0xca, 0xa0, 0x6f, 0x3d, 0x50, 0x22, 0x63, 0x69, 0x36, 0x82, 0x19, 0xbe, 0xad,
+	0x26, 0xd9, 0x8b, 0x08, 0x6c, 0x83, 0x45, 0x7d, 0x71, 0x8e, 0x7c, 0x2c, 0x58, 0x62, 0x2e, 0x48,
+	0xeb, 0x76, 0xee, 0x6c, 0x5d, 0xe9, 0x9f, 0x5b, 0xa8, 0xc4, 0x6c, 0xb4, 0xa0, 0xe2, 0x1d, 0x19,
+	0xc2, 0x53, 0xb0, 0x1a, 0xfa, 0x5c, 0x8c, 0x6e, 0x81, 0xd7, 0x8b, 0x03, 0x5f, 0x10, 0x73, 0xb1,
+	0x62, 0x54, 0x8b, 0xcf, 0x37, 0x1c, 0xf5, 0x26, 0x9d, 0xec, 0x4d, 0x3a, 0x87, 0xd9, 0x9b, 0x6c,
+	0x54, 0xd3, 0x86, 0x46, 0x13, 0x8e, 0x95, 0xb1, 0xcf, 0x7f, 0x5b, 0x06, 0x5a, 0x49, 0x73, 0xc3,
+	0x0b, 0xf5, 0x56, 0x66, 0xe0, 0x21, 0x58, 0x3d, 0xa6, 0x42, 0x6e, 0x07, 0x82, 0x05, 0x65, 0x91,
+	0xd7, 0x0c, 0x19, 0x6e, 0x73, 0x73, 0x49, 0x7e, 0x80, 0x9c, 0x77, 0x63, 0x69, 0x36, 0x5a, 0x39,
+	0xa6, 0x62, 0x7f, 0x08, 0x37, 0x24, 0xba, 0xb5, 0xfc, 0xe5, 0xc2, 0x2a, 0x7c, 0xbd, 0xb0, 0x0a,
+	0x3f, 0xbe, 0xd7, 0xa6, 0xd3, 0x5d, 0xf1, 0xa6, 0xf1, 0xe1, 0xf2, 0xba, 0x6c, 0x5c, 0x5d, 0x97,
+	0x8d, 0x3f, 0xd7, 0x65, 0xe3, 0xfc, 0xa6, 0x5c, 0xb8, 0xba, 0x29, 0x17, 0x7e, 0xde, 0x94, 0x0b,
+	0xef, 0x1b, 0x39, 0x2b, 0xf5, 0x4e, 0xab, 0x85, 0x7e, 0x93, 0x67, 0x81, 0x7b, 0x52, 0x7f, 0xe9,
+	0x9e, 0xfe, 0x6f, 0x23, 0x76, 0x58, 0x40, 0xc2, 0xe6, 0x8c, 0x74, 0xe6, 0xc5, 0xdf, 0x01, 0x00,
+	0xd8, 0x7a, 0xad, 0x4f, 0x40, 0x05, 0x00, 0x00,
 }
 
 func (m *Pool) Marshal() (dAtA []byte, err error) {
@@ -164,6 +170,11 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JitProtectionBlocks != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.JitProtectionBlocks))
+		i--
+		dAtA[i] = 0x70
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastLiquidityUpdate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastLiquidityUpdate):])
 	if err1 != nil {
 		return 0, err1
@@ -317,6 +328,9 @@ func (m *Pool) Size() (n int) {
 	n += 1 + l + sovPool(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastLiquidityUpdate)
 	n += 1 + l + sovPool(uint64(l))
+	if m.JitProtectionBlocks != 0 {
+		n += 1 + sovPool(uint64(m.JitProtectionBlocks))
+	}
 	return n
 }
 
@@ -726,6 +740,25 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JitProtectionBlocks", wireType)
+			}
+			m.JitProtectionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JitProtectionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package concentrated_liquidity
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -200,3 +201,383 @@ func (server msgServer) CollectIncentives(goCtx context.Context, msg *types.MsgC
 
 	return &types.MsgCollectIncentivesResponse{CollectedIncentives: totalCollectedIncentives, ForfeitedIncentives: totalForefeitedIncentives}, nil
 }
+
+// TransferPositions transfers ownership of the given positions from the sender to the new owner.
+// Outstanding spread rewards and incentives are collected and sent to the sender prior to the transfer.
+func (server msgServer) TransferPositions(goCtx context.Context, msg *types.MsgTransferPositions) (*types.MsgTransferPositionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.transferPositions(ctx, msg.PositionIds, sender, newOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: transfer positions event is emitted in keeper.transferPositions(...)
+
+	return &types.MsgTransferPositionsResponse{}, nil
+}
+
+func (server msgServer) CompoundPosition(goCtx context.Context, msg *types.MsgCompoundPosition) (*types.MsgCompoundPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.TokenMinAmount0.IsNil() {
+		msg.TokenMinAmount0 = sdk.ZeroInt()
+	}
+	if msg.TokenMinAmount1.IsNil() {
+		msg.TokenMinAmount1 = sdk.ZeroInt()
+	}
+
+	positionId, actualAmount0, actualAmount1, err := server.keeper.compoundPosition(ctx, sender, msg.PositionId, msg.TokenMinAmount0, msg.TokenMinAmount1)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: compound position event is emitted in keeper.compoundPosition(...)
+
+	return &types.MsgCompoundPositionResponse{PositionId: positionId, Amount0: actualAmount0, Amount1: actualAmount1}, nil
+}
+
+func (server msgServer) SetPositionAutoCompound(goCtx context.Context, msg *types.MsgSetPositionAutoCompound) (*types.MsgSetPositionAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.setPositionAutoCompoundForOwner(ctx, sender, msg.PositionId, msg.AutoCompound)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSetPositionAutoCompoundResponse{}, nil
+}
+
+func (server msgServer) SetPositionOperator(goCtx context.Context, msg *types.MsgSetPositionOperator) (*types.MsgSetPositionOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.setPositionOperator(ctx, sender, msg.PositionId, operator, msg.Approved); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSetPositionOperatorResponse{}, nil
+}
+
+func (server msgServer) RerangePosition(goCtx context.Context, msg *types.MsgRerangePosition) (*types.MsgRerangePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.TokenMinAmount0.IsNil() {
+		msg.TokenMinAmount0 = sdk.ZeroInt()
+	}
+	if msg.TokenMinAmount1.IsNil() {
+		msg.TokenMinAmount1 = sdk.ZeroInt()
+	}
+
+	positionId, actualAmount0, actualAmount1, liquidityCreated, lowerTick, upperTick, err := server.keeper.rerangePosition(ctx, sender, msg.PositionId, msg.LowerTick, msg.UpperTick, msg.TokenMinAmount0, msg.TokenMinAmount1)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgRerangePositionResponse{PositionId: positionId, Amount0: actualAmount0, Amount1: actualAmount1, LiquidityCreated: liquidityCreated, LowerTick: lowerTick, UpperTick: upperTick}, nil
+}
+
+func (server msgServer) IncreaseObservationCardinality(goCtx context.Context, msg *types.MsgIncreaseObservationCardinality) (*types.MsgIncreaseObservationCardinalityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	cardinalityNextOld, cardinalityNextNew, err := server.keeper.increaseObservationCardinality(ctx, sender, msg.PoolId, msg.CardinalityNext)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgIncreaseObservationCardinalityResponse{CardinalityNextOld: cardinalityNextOld, CardinalityNextNew: cardinalityNextNew}, nil
+}
+
+func (server msgServer) SplitPosition(goCtx context.Context, msg *types.MsgSplitPosition) (*types.MsgSplitPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionId1, positionId2, err := server.keeper.splitPosition(ctx, sender, msg.PositionId, msg.Liquidity)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: split position event is emitted in keeper.splitPosition(...)
+
+	return &types.MsgSplitPositionResponse{FirstPositionId: positionId1, SecondPositionId: positionId2}, nil
+}
+
+func (server msgServer) MergePositions(goCtx context.Context, msg *types.MsgMergePositions) (*types.MsgMergePositionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	newPositionId, err := server.keeper.mergePositions(ctx, sender, msg.PositionIds)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: merge positions event is emitted in keeper.mergePositions(...)
+
+	return &types.MsgMergePositionsResponse{NewPositionId: newPositionId}, nil
+}
+
+func (server msgServer) CreateIncentive(goCtx context.Context, msg *types.MsgCreateIncentive) (*types.MsgCreateIncentiveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	incentiveRecord, err := server.keeper.createIncentiveForSender(ctx, msg.PoolId, sender, msg.IncentiveCoin, msg.EmissionRate, msg.EmissionSchedule, msg.StartTime, msg.MinUptime)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtCreateIncentive,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyIncentiveId, strconv.FormatUint(incentiveRecord.IncentiveId, 10)),
+		sdk.NewAttribute(types.AttributeIncentiveCoin, msg.IncentiveCoin.String()),
+		sdk.NewAttribute(types.AttributeIncentiveEmissionRate, msg.EmissionRate.String()),
+		sdk.NewAttribute(types.AttributeIncentiveStartTime, msg.StartTime.String()),
+		sdk.NewAttribute(types.AttributeIncentiveMinUptime, msg.MinUptime.String()),
+	))
+
+	return &types.MsgCreateIncentiveResponse{IncentiveId: incentiveRecord.IncentiveId}, nil
+}
+
+func (server msgServer) AddToIncentive(goCtx context.Context, msg *types.MsgAddToIncentive) (*types.MsgAddToIncentiveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	incentiveRecord, err := server.keeper.addToIncentive(ctx, sender, msg.PoolId, msg.IncentiveId, msg.IncentiveCoin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: add to incentive event is emitted in keeper.addToIncentive(...)
+
+	return &types.MsgAddToIncentiveResponse{RemainingCoin: incentiveRecord.IncentiveRecordBody.RemainingCoin}, nil
+}
+
+func (server msgServer) ReduceIncentiveEmissionRate(goCtx context.Context, msg *types.MsgReduceIncentiveEmissionRate) (*types.MsgReduceIncentiveEmissionRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.reduceIncentiveEmissionRate(ctx, sender, msg.PoolId, msg.IncentiveId, msg.EmissionRate)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: reduce incentive emission rate event is emitted in keeper.reduceIncentiveEmissionRate(...)
+
+	return &types.MsgReduceIncentiveEmissionRateResponse{}, nil
+}
+
+func (server msgServer) CancelIncentive(goCtx context.Context, msg *types.MsgCancelIncentive) (*types.MsgCancelIncentiveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	refundedCoin, err := server.keeper.cancelIncentive(ctx, sender, msg.PoolId, msg.IncentiveId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: cancel incentive event is emitted in keeper.cancelIncentive(...)
+
+	return &types.MsgCancelIncentiveResponse{RefundedCoin: refundedCoin}, nil
+}
+
+func (server msgServer) CreatePositionFromSingleAsset(goCtx context.Context, msg *types.MsgCreatePositionFromSingleAsset) (*types.MsgCreatePositionFromSingleAssetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.TokenMinAmount0.IsNil() {
+		msg.TokenMinAmount0 = sdk.ZeroInt()
+	}
+	if msg.TokenMinAmount1.IsNil() {
+		msg.TokenMinAmount1 = sdk.ZeroInt()
+	}
+
+	positionId, actualAmount0, actualAmount1, liquidityCreated, lowerTick, upperTick, dust, err := server.keeper.createPositionFromSingleAsset(ctx, sender, msg.PoolId, msg.TokenIn, msg.Routes, msg.TokenMinAmount0, msg.TokenMinAmount1, msg.LowerTick, msg.UpperTick)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: create position from single asset event is emitted in keeper.createPositionFromSingleAsset(...)
+
+	return &types.MsgCreatePositionFromSingleAssetResponse{
+		PositionId:       positionId,
+		Amount0:          actualAmount0,
+		Amount1:          actualAmount1,
+		LiquidityCreated: liquidityCreated,
+		LowerTick:        lowerTick,
+		UpperTick:        upperTick,
+		Dust:             dust,
+	}, nil
+}
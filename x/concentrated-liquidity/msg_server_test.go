@@ -457,6 +457,78 @@ func (s *KeeperTestSuite) TestCollectIncentives_Events() {
 	}
 }
 
+func (s *KeeperTestSuite) TestTransferPositions_Events() {
+	testcases := map[string]struct {
+		numPositionsToCreate           int
+		positionIds                    []uint64
+		shouldSetupUnownedPosition     bool
+		expectedTransferPositionsEvent int
+		expectedMessageEvents          int
+		expectedError                  error
+	}{
+		"single position ID": {
+			numPositionsToCreate:           1,
+			positionIds:                    []uint64{DefaultPositionId},
+			expectedTransferPositionsEvent: 1,
+			expectedMessageEvents:          2, // 1 for transfer positions, 1 for the spread rewards send
+		},
+		"two position IDs": {
+			numPositionsToCreate:           2,
+			positionIds:                    []uint64{DefaultPositionId, DefaultPositionId + 1},
+			expectedTransferPositionsEvent: 1,
+			expectedMessageEvents:          3, // 1 for transfer positions, 2 for the spread rewards sends
+		},
+		"error: attempt to transfer a position with a different owner": {
+			numPositionsToCreate:       1,
+			positionIds:                []uint64{DefaultPositionId, DefaultPositionId + 1},
+			shouldSetupUnownedPosition: true,
+			expectedError:              types.PositionOwnerMismatchError{},
+		},
+	}
+
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.SetupTest()
+			ctx := s.Ctx
+
+			pool := s.PrepareConcentratedPool()
+			for i := 0; i < tc.numPositionsToCreate; i++ {
+				s.SetupDefaultPosition(pool.GetId())
+			}
+
+			if tc.shouldSetupUnownedPosition {
+				// Position from another account.
+				s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[1])
+			}
+
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+
+			// Reset event counts to 0 by creating a new manager.
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			s.Equal(0, len(ctx.EventManager().Events()))
+
+			msg := &types.MsgTransferPositions{
+				Sender:      s.TestAccs[0].String(),
+				NewOwner:    s.TestAccs[2].String(),
+				PositionIds: tc.positionIds,
+			}
+
+			response, err := msgServer.TransferPositions(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expectedError == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(response)
+				s.AssertEventEmitted(ctx, types.TypeEvtTransferPositions, tc.expectedTransferPositionsEvent)
+				s.AssertEventEmitted(ctx, sdk.EventTypeMessage, tc.expectedMessageEvents)
+			} else {
+				s.Require().Error(err)
+				s.Require().ErrorAs(err, &tc.expectedError)
+				s.Require().Nil(response)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestFungify_Events() {
 
 	s.T().Skip("TODO: re-enable fungify test if message is restored")
@@ -0,0 +1,309 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+// initializeObservations sets up the observation ring buffer of the given pool with a single
+// observation at the current block time and zero cumulative values.
+// It must be called once the pool's price is set, so that no time is attributed to the tick of a pool without liquidity.
+// The slots already allocated for the pool are kept, but any previous observations are discarded.
+func (k Keeper) initializeObservations(ctx sdk.Context, poolId uint64) types.ObservationState {
+	cardinalityNext := uint32(1)
+	if state, found := k.getObservationState(ctx, poolId); found && state.CardinalityNext > cardinalityNext {
+		cardinalityNext = state.CardinalityNext
+	}
+
+	k.setObservation(ctx, poolId, 0, types.Observation{
+		BlockTime:                     ctx.BlockTime(),
+		TickCumulative:                sdk.ZeroDec(),
+		SecondsPerLiquidityCumulative: sdk.ZeroDec(),
+		Initialized:                   true,
+	})
+	for i := uint32(1); i < cardinalityNext; i++ {
+		k.setObservation(ctx, poolId, i, types.Observation{
+			TickCumulative:                sdk.ZeroDec(),
+			SecondsPerLiquidityCumulative: sdk.ZeroDec(),
+		})
+	}
+
+	state := types.ObservationState{Index: 0, Cardinality: 1, CardinalityNext: cardinalityNext}
+	k.setObservationState(ctx, poolId, state)
+	return state
+}
+
+// writeObservation records the cumulative values of the given pool as of the current block time.
+// It must be called with the pool state from before the tick or the active liquidity is changed,
+// so that the elapsed time since the last observation is attributed to the previous values.
+// At most one observation is written per block. Pools that have no observations yet are initialized.
+func (k Keeper) writeObservation(ctx sdk.Context, pool types.ConcentratedPoolExtension) {
+	poolId := pool.GetId()
+	state, found := k.getObservationState(ctx, poolId)
+	if !found {
+		k.initializeObservations(ctx, poolId)
+		return
+	}
+
+	last := k.getObservation(ctx, poolId, state.Index)
+	if !ctx.BlockTime().After(last.BlockTime) {
+		return
+	}
+
+	// Grow the ring buffer once the last slot in use is written.
+	cardinality := state.Cardinality
+	if state.CardinalityNext > cardinality && state.Index == cardinality-1 {
+		cardinality = state.CardinalityNext
+	}
+
+	state.Index = (state.Index + 1) % cardinality
+	state.Cardinality = cardinality
+	k.setObservation(ctx, poolId, state.Index, transformObservation(last, ctx.BlockTime(), pool.GetCurrentTick(), pool.GetLiquidity()))
+	k.setObservationState(ctx, poolId, state)
+}
+
+// transformObservation returns the observation at blockTime given the last observation and
+// the tick and active liquidity that were current since it was written.
+func transformObservation(last types.Observation, blockTime time.Time, tick int64, liquidity sdk.Dec) types.Observation {
+	secondsElapsed := sdk.NewDec(int64(blockTime.Sub(last.BlockTime))).Quo(dec1e9)
+
+	secondsPerLiquidityCumulative := last.SecondsPerLiquidityCumulative
+	if liquidity.IsPositive() {
+		secondsPerLiquidityCumulative = secondsPerLiquidityCumulative.Add(secondsElapsed.Quo(liquidity))
+	}
+
+	return types.Observation{
+		BlockTime:                     blockTime,
+		TickCumulative:                last.TickCumulative.Add(secondsElapsed.MulInt64(tick)),
+		SecondsPerLiquidityCumulative: secondsPerLiquidityCumulative,
+		Initialized:                   true,
+	}
+}
+
+// increaseObservationCardinality grows the number of observation slots of the given pool to cardinalityNext.
+// The new slots are allocated right away so that the sender pays for the storage rather than the swappers
+// that later write to them. Does nothing if the pool already has at least cardinalityNext slots.
+// Returns the previous and the new number of allocated slots.
+// Returns error if the pool does not exist or cardinalityNext exceeds types.MaxObservationCardinality.
+func (k Keeper) increaseObservationCardinality(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, cardinalityNext uint32) (uint32, uint32, error) {
+	if cardinalityNext == 0 || cardinalityNext > types.MaxObservationCardinality {
+		return 0, 0, types.InvalidObservationCardinalityError{Cardinality: cardinalityNext, MaxCardinality: types.MaxObservationCardinality}
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	state, found := k.getObservationState(ctx, poolId)
+	if !found {
+		// Pools created before observations were introduced are initialized lazily. Pools without a position yet
+		// are initialized again once their price is set.
+		k.writeObservation(ctx, pool)
+		state, _ = k.getObservationState(ctx, poolId)
+	}
+
+	cardinalityNextOld := state.CardinalityNext
+	if cardinalityNext > cardinalityNextOld {
+		for i := cardinalityNextOld; i < cardinalityNext; i++ {
+			k.setObservation(ctx, poolId, i, types.Observation{
+				TickCumulative:                sdk.ZeroDec(),
+				SecondsPerLiquidityCumulative: sdk.ZeroDec(),
+			})
+		}
+		state.CardinalityNext = cardinalityNext
+		k.setObservationState(ctx, poolId, state)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtIncreaseObservationCardinality,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyCardinalityNextOld, strconv.FormatUint(uint64(cardinalityNextOld), 10)),
+			sdk.NewAttribute(types.AttributeKeyCardinalityNextNew, strconv.FormatUint(uint64(state.CardinalityNext), 10)),
+		),
+	})
+
+	return cardinalityNextOld, state.CardinalityNext, nil
+}
+
+// Observe returns the tick cumulative and seconds per liquidity cumulative values of the given pool
+// as of each of the given number of seconds before the current block time, along with the current
+// state of the pool's observation ring buffer.
+// Values between two observations are linearly interpolated. Values after the most recent observation
+// are extrapolated from the pool's current tick and active liquidity.
+// Returns error if any of the given number of seconds exceeds types.MaxObserveSecondsAgo, the pool has no
+// observations or any of the targets is older than the oldest observation.
+func (k Keeper) Observe(ctx sdk.Context, poolId uint64, secondsAgos []uint64) ([]sdk.Dec, []sdk.Dec, types.ObservationState, error) {
+	for _, secondsAgo := range secondsAgos {
+		if secondsAgo > types.MaxObserveSecondsAgo {
+			return nil, nil, types.ObservationState{}, types.ObserveSecondsAgoTooLargeError{SecondsAgo: secondsAgo, MaxSecondsAgo: types.MaxObserveSecondsAgo}
+		}
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, nil, types.ObservationState{}, err
+	}
+
+	state, found := k.getObservationState(ctx, poolId)
+	if !found {
+		return nil, nil, types.ObservationState{}, types.ObservationsNotInitializedError{PoolId: poolId}
+	}
+
+	tickCumulatives := make([]sdk.Dec, len(secondsAgos))
+	secondsPerLiquidityCumulatives := make([]sdk.Dec, len(secondsAgos))
+	for i, secondsAgo := range secondsAgos {
+		target := ctx.BlockTime().Add(-time.Duration(secondsAgo) * time.Second)
+		observation, err := k.observeSingle(ctx, pool, state, target)
+		if err != nil {
+			return nil, nil, types.ObservationState{}, err
+		}
+		tickCumulatives[i] = observation.TickCumulative
+		secondsPerLiquidityCumulatives[i] = observation.SecondsPerLiquidityCumulative
+	}
+
+	return tickCumulatives, secondsPerLiquidityCumulatives, state, nil
+}
+
+// getTwapPrice returns the time weighted average price of token0 denominated in token1 of the given pool over
+// the given duration before the current block time, derived from the pool's tick cumulative observations.
+// Since observations are written with the pool state from before it changes, the price is not affected by
+// swaps made earlier in the current block.
+// The duration is truncated to whole seconds.
+// Returns error if the duration is shorter than one second, the pool has no observations or they do not cover
+// the duration.
+func (k Keeper) getTwapPrice(ctx sdk.Context, poolId uint64, duration time.Duration) (sdk.Dec, error) {
+	if duration < time.Second {
+		return sdk.Dec{}, types.InvalidTwapDurationError{Duration: duration}
+	}
+	seconds := uint64(duration / time.Second)
+	tickCumulatives, _, _, err := k.Observe(ctx, poolId, []uint64{seconds, 0})
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	averageTick := tickCumulatives[1].Sub(tickCumulatives[0]).QuoInt64(int64(seconds)).RoundInt64()
+	return math.TickToPrice(averageTick)
+}
+
+// observeSingle returns the observation of the given pool at the target time.
+func (k Keeper) observeSingle(ctx sdk.Context, pool types.ConcentratedPoolExtension, state types.ObservationState, target time.Time) (types.Observation, error) {
+	poolId := pool.GetId()
+
+	newest := k.getObservation(ctx, poolId, state.Index)
+	if !target.Before(newest.BlockTime) {
+		if target.Equal(newest.BlockTime) {
+			return newest, nil
+		}
+		return transformObservation(newest, target, pool.GetCurrentTick(), pool.GetLiquidity()), nil
+	}
+
+	// The slot after the newest observation is the oldest one, unless the ring buffer has not wrapped around yet.
+	oldest := k.getObservation(ctx, poolId, (state.Index+1)%state.Cardinality)
+	if !oldest.Initialized {
+		oldest = k.getObservation(ctx, poolId, 0)
+	}
+	if target.Before(oldest.BlockTime) {
+		return types.Observation{}, types.ObservationTooOldError{PoolId: poolId, TargetTime: target, OldestTime: oldest.BlockTime}
+	}
+
+	beforeOrAt, atOrAfter := k.binarySearchObservations(ctx, poolId, state, target)
+	if target.Equal(beforeOrAt.BlockTime) {
+		return beforeOrAt, nil
+	}
+	if target.Equal(atOrAfter.BlockTime) {
+		return atOrAfter, nil
+	}
+
+	// Linearly interpolate between the surrounding observations.
+	observationTimeDelta := sdk.NewDec(int64(atOrAfter.BlockTime.Sub(beforeOrAt.BlockTime)))
+	targetDelta := sdk.NewDec(int64(target.Sub(beforeOrAt.BlockTime)))
+	return types.Observation{
+		BlockTime:                     target,
+		TickCumulative:                beforeOrAt.TickCumulative.Add(atOrAfter.TickCumulative.Sub(beforeOrAt.TickCumulative).Mul(targetDelta).Quo(observationTimeDelta)),
+		SecondsPerLiquidityCumulative: beforeOrAt.SecondsPerLiquidityCumulative.Add(atOrAfter.SecondsPerLiquidityCumulative.Sub(beforeOrAt.SecondsPerLiquidityCumulative).Mul(targetDelta).Quo(observationTimeDelta)),
+		Initialized:                   true,
+	}, nil
+}
+
+// binarySearchObservations returns the observations immediately before or at and at or after the target time.
+// The target must be within the time range covered by the pool's observations.
+func (k Keeper) binarySearchObservations(ctx sdk.Context, poolId uint64, state types.ObservationState, target time.Time) (types.Observation, types.Observation) {
+	// Slots are searched in chronological order, starting from the oldest observation.
+	l := state.Index + 1
+	r := l + state.Cardinality - 1
+	for {
+		i := (l + r) / 2
+
+		beforeOrAt := k.getObservation(ctx, poolId, i%state.Cardinality)
+		// Uninitialized slots have not been reached by the ring buffer yet, so the oldest observation is further ahead.
+		if !beforeOrAt.Initialized {
+			l = i + 1
+			continue
+		}
+
+		atOrAfter := k.getObservation(ctx, poolId, (i+1)%state.Cardinality)
+		targetAtOrAfter := !target.Before(beforeOrAt.BlockTime)
+		if targetAtOrAfter && !target.After(atOrAfter.BlockTime) {
+			return beforeOrAt, atOrAfter
+		}
+
+		if !targetAtOrAfter {
+			r = i - 1
+		} else {
+			l = i + 1
+		}
+	}
+}
+
+// getObservationState returns the observation ring buffer state of the given pool and whether it exists.
+func (k Keeper) getObservationState(ctx sdk.Context, poolId uint64) (types.ObservationState, bool) {
+	state := types.ObservationState{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyObservationState(poolId), &state)
+	if err != nil {
+		panic(err)
+	}
+	return state, found
+}
+
+// setObservationState sets the observation ring buffer state of the given pool.
+func (k Keeper) setObservationState(ctx sdk.Context, poolId uint64, state types.ObservationState) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyObservationState(poolId), &state)
+}
+
+// getObservation returns the observation stored in the given slot of the pool's ring buffer.
+// Slots that were never allocated are returned as uninitialized observations.
+func (k Keeper) getObservation(ctx sdk.Context, poolId uint64, index uint32) types.Observation {
+	observation := types.Observation{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyObservation(poolId, index), &observation)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return types.Observation{TickCumulative: sdk.ZeroDec(), SecondsPerLiquidityCumulative: sdk.ZeroDec()}
+	}
+	return observation
+}
+
+// setObservation sets the observation in the given slot of the pool's ring buffer.
+func (k Keeper) setObservation(ctx sdk.Context, poolId uint64, index uint32, observation types.Observation) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyObservation(poolId, index), &observation)
+}
+
+// getAllObservations returns all allocated observation slots of the given pool ordered by slot index.
+func (k Keeper) getAllObservations(ctx sdk.Context, poolId uint64, state types.ObservationState) []types.Observation {
+	observations := make([]types.Observation, 0, state.CardinalityNext)
+	for i := uint32(0); i < state.CardinalityNext; i++ {
+		observations = append(observations, k.getObservation(ctx, poolId, i))
+	}
+	return observations
+}
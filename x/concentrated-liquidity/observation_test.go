@@ -0,0 +1,274 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestObserve() {
+	type observeCase struct {
+		secondsAgo                            uint64
+		expectedTickCumulative                sdk.Dec
+		expectedSecondsPerLiquidityCumulative sdk.Dec
+		expectedError                         error
+	}
+
+	// Observations are written at t0 (pool creation), t0+10s and t0+20s, and the current block time is t0+30s.
+	// - t0 to t0+10s: tick 100, liquidity 10
+	// - t0+10s to t0+20s: tick -50, liquidity 20
+	// - t0+20s to t0+30s: tick 200, no liquidity
+	extrapolated := observeCase{secondsAgo: 0, expectedTickCumulative: sdk.NewDec(2500), expectedSecondsPerLiquidityCumulative: sdk.MustNewDecFromStr("1.5")}
+	atNewest := observeCase{secondsAgo: 10, expectedTickCumulative: sdk.NewDec(500), expectedSecondsPerLiquidityCumulative: sdk.MustNewDecFromStr("1.5")}
+	interpolated := observeCase{secondsAgo: 15, expectedTickCumulative: sdk.NewDec(750), expectedSecondsPerLiquidityCumulative: sdk.MustNewDecFromStr("1.25")}
+	atSecond := observeCase{secondsAgo: 20, expectedTickCumulative: sdk.NewDec(1000), expectedSecondsPerLiquidityCumulative: sdk.OneDec()}
+	atOldest := observeCase{secondsAgo: 30, expectedTickCumulative: sdk.ZeroDec(), expectedSecondsPerLiquidityCumulative: sdk.ZeroDec()}
+
+	tests := []struct {
+		name          string
+		cardinality   uint32
+		expectedState types.ObservationState
+		cases         []observeCase
+	}{
+		{
+			name:          "all observations are kept",
+			cardinality:   3,
+			expectedState: types.ObservationState{Index: 2, Cardinality: 3, CardinalityNext: 3},
+			cases:         []observeCase{extrapolated, atNewest, interpolated, atSecond, atOldest},
+		},
+		{
+			name:          "allocated but unused slots are ignored",
+			cardinality:   10,
+			expectedState: types.ObservationState{Index: 2, Cardinality: 10, CardinalityNext: 10},
+			cases: []observeCase{extrapolated, atNewest, interpolated, atSecond, atOldest, {
+				secondsAgo:    31,
+				expectedError: types.ObservationTooOldError{},
+			}, {
+				secondsAgo:    types.MaxObserveSecondsAgo + 1,
+				expectedError: types.ObserveSecondsAgoTooLargeError{},
+			}},
+		},
+		{
+			name:          "oldest observation is overwritten",
+			cardinality:   2,
+			expectedState: types.ObservationState{Index: 0, Cardinality: 2, CardinalityNext: 2},
+			cases: []observeCase{extrapolated, atNewest, interpolated, atSecond, {
+				secondsAgo:    30,
+				expectedError: types.ObservationTooOldError{},
+			}},
+		},
+		{
+			name:          "single observation only allows extrapolation",
+			cardinality:   1,
+			expectedState: types.ObservationState{Index: 0, Cardinality: 1, CardinalityNext: 1},
+			cases: []observeCase{extrapolated, atNewest, {
+				secondsAgo:    15,
+				expectedError: types.ObservationTooOldError{},
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(DefaultJoinTime)
+			pool := s.PrepareConcentratedPool()
+			poolId := pool.GetId()
+
+			_, _, err := s.clk.IncreaseObservationCardinality(s.Ctx, s.TestAccs[0], poolId, tc.cardinality)
+			s.Require().NoError(err)
+
+			for _, step := range []struct {
+				tick      int64
+				liquidity sdk.Dec
+			}{
+				{tick: 100, liquidity: sdk.NewDec(10)},
+				{tick: -50, liquidity: sdk.NewDec(20)},
+				{tick: 200, liquidity: sdk.ZeroDec()},
+			} {
+				s.clk.WriteObservation(s.Ctx, pool)
+				pool.SetCurrentTick(step.tick)
+				pool.UpdateLiquidity(step.liquidity.Sub(pool.GetLiquidity()))
+				s.Require().NoError(s.clk.SetPool(s.Ctx, pool))
+				s.AddBlockTime(10 * time.Second)
+			}
+
+			state, found := s.clk.GetObservationState(s.Ctx, poolId)
+			s.Require().True(found)
+			s.Require().Equal(tc.expectedState, state)
+
+			for _, observeCase := range tc.cases {
+				// System under test
+				tickCumulatives, secondsPerLiquidityCumulatives, _, err := s.clk.Observe(s.Ctx, poolId, []uint64{observeCase.secondsAgo})
+				if observeCase.expectedError != nil {
+					s.Require().IsType(observeCase.expectedError, err, "seconds ago: %d", observeCase.secondsAgo)
+					continue
+				}
+				s.Require().NoError(err)
+				s.Require().Equal(observeCase.expectedTickCumulative, tickCumulatives[0], "seconds ago: %d", observeCase.secondsAgo)
+				s.Require().Equal(observeCase.expectedSecondsPerLiquidityCumulative, secondsPerLiquidityCumulatives[0], "seconds ago: %d", observeCase.secondsAgo)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestWriteObservation() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(DefaultJoinTime)
+	pool := s.PrepareConcentratedPool()
+	poolId := pool.GetId()
+
+	// Observations are not initialized before the pool's price is set.
+	_, found := s.clk.GetObservationState(s.Ctx, poolId)
+	s.Require().False(found)
+
+	_, _, err := s.clk.IncreaseObservationCardinality(s.Ctx, s.TestAccs[0], poolId, 5)
+	s.Require().NoError(err)
+
+	// Creating the first position initializes the observations at the initial tick, keeping the allocated slots,
+	// and writes no additional observation in the same block.
+	s.AddBlockTime(time.Minute)
+	s.SetupDefaultPosition(poolId)
+	state, found := s.clk.GetObservationState(s.Ctx, poolId)
+	s.Require().True(found)
+	s.Require().Equal(types.ObservationState{Index: 0, Cardinality: 1, CardinalityNext: 5}, state)
+	tickCumulatives, _, _, err := s.clk.Observe(s.Ctx, poolId, []uint64{0})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.ZeroDec(), tickCumulatives[0])
+	_, _, _, err = s.clk.Observe(s.Ctx, poolId, []uint64{1})
+	s.Require().ErrorAs(err, &types.ObservationTooOldError{})
+
+	// The first swap of a later block writes an observation.
+	s.AddBlockTime(time.Minute)
+	s.swapSmallAmount(poolId)
+	state, _ = s.clk.GetObservationState(s.Ctx, poolId)
+	s.Require().Equal(types.ObservationState{Index: 1, Cardinality: 5, CardinalityNext: 5}, state)
+
+	// Subsequent swaps in the same block do not.
+	s.swapSmallAmount(poolId)
+	state, _ = s.clk.GetObservationState(s.Ctx, poolId)
+	s.Require().Equal(uint32(1), state.Index)
+
+	// Changing the liquidity in the active range writes an observation.
+	s.AddBlockTime(time.Minute)
+	s.SetupDefaultPosition(poolId)
+	state, _ = s.clk.GetObservationState(s.Ctx, poolId)
+	s.Require().Equal(uint32(2), state.Index)
+
+	// Observations are exported and imported in genesis.
+	genesis := s.clk.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.PoolData, 1)
+	s.Require().Equal(state, *genesis.PoolData[0].ObservationState)
+	s.Require().Len(genesis.PoolData[0].Observations, 5)
+	tickCumulatives, _, _, err = s.clk.Observe(s.Ctx, poolId, []uint64{0, 60, 120})
+	s.Require().NoError(err)
+
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(DefaultJoinTime.Add(3 * time.Minute))
+	s.clk.InitGenesis(s.Ctx, *genesis)
+	importedState, found := s.clk.GetObservationState(s.Ctx, poolId)
+	s.Require().True(found)
+	s.Require().Equal(state, importedState)
+	importedTickCumulatives, _, _, err := s.clk.Observe(s.Ctx, poolId, []uint64{0, 60, 120})
+	s.Require().NoError(err)
+	s.Require().Equal(tickCumulatives, importedTickCumulatives)
+}
+
+func (s *KeeperTestSuite) TestGetTwapPrice() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(DefaultJoinTime)
+	pool := s.PrepareConcentratedPool()
+	poolId := pool.GetId()
+	s.SetupDefaultPosition(poolId)
+	s.AddBlockTime(time.Minute)
+
+	// Durations are truncated to whole seconds.
+	twapPrice, err := s.clk.GetTwapPrice(s.Ctx, poolId, time.Second+time.Millisecond)
+	s.Require().NoError(err)
+	pool, err = s.clk.GetPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	expectedPrice, err := math.TickToPrice(pool.GetCurrentTick())
+	s.Require().NoError(err)
+	s.Require().Equal(expectedPrice, twapPrice)
+
+	// Durations shorter than one second would truncate to zero seconds.
+	_, err = s.clk.GetTwapPrice(s.Ctx, poolId, time.Millisecond)
+	s.Require().ErrorIs(err, types.InvalidTwapDurationError{Duration: time.Millisecond})
+	_, err = s.clk.GetTwapPrice(s.Ctx, poolId, 0)
+	s.Require().ErrorIs(err, types.InvalidTwapDurationError{Duration: 0})
+}
+
+func (s *KeeperTestSuite) TestIncreaseObservationCardinality() {
+	tests := []struct {
+		name                    string
+		cardinalityNext         uint32
+		poolId                  uint64
+		expectedCardinalityNext uint32
+		expectedError           error
+	}{
+		{
+			name:                    "increase cardinality",
+			cardinalityNext:         10,
+			expectedCardinalityNext: 10,
+		},
+		{
+			name:                    "cardinality is never decreased",
+			cardinalityNext:         1,
+			expectedCardinalityNext: 5,
+		},
+		{
+			name:            "error: cardinality above maximum",
+			cardinalityNext: types.MaxObservationCardinality + 1,
+			expectedError:   types.InvalidObservationCardinalityError{Cardinality: types.MaxObservationCardinality + 1, MaxCardinality: types.MaxObservationCardinality},
+		},
+		{
+			name:            "error: pool does not exist",
+			cardinalityNext: 10,
+			poolId:          2,
+			expectedError:   types.PoolNotFoundError{PoolId: 2},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			_, _, err := s.clk.IncreaseObservationCardinality(s.Ctx, s.TestAccs[0], pool.GetId(), 5)
+			s.Require().NoError(err)
+
+			poolId := pool.GetId()
+			if tc.poolId != 0 {
+				poolId = tc.poolId
+			}
+
+			// System under test
+			cardinalityNextOld, cardinalityNextNew, err := s.clk.IncreaseObservationCardinality(s.Ctx, s.TestAccs[0], poolId, tc.cardinalityNext)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(uint32(5), cardinalityNextOld)
+			s.Require().Equal(tc.expectedCardinalityNext, cardinalityNextNew)
+
+			// The ring buffer only grows into the new slots once the last slot in use is written.
+			state, _ := s.clk.GetObservationState(s.Ctx, poolId)
+			s.Require().Equal(types.ObservationState{Index: 0, Cardinality: 1, CardinalityNext: tc.expectedCardinalityNext}, state)
+		})
+	}
+}
+
+// swapSmallAmount swaps a small amount through the given pool.
+func (s *KeeperTestSuite) swapSmallAmount(poolId uint64) {
+	pool, err := s.clk.GetPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	tokenIn := sdk.NewCoin(pool.GetToken0(), sdk.NewInt(1000))
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+	_, err = s.clk.SwapExactAmountIn(s.Ctx, s.TestAccs[0], pool, tokenIn, pool.GetToken1(), sdk.ZeroInt(), pool.GetSpreadFactor(s.Ctx))
+	s.Require().NoError(err)
+}
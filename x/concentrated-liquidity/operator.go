@@ -0,0 +1,147 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+// setPositionOperator approves or revokes the operator for the given position of the owner.
+// An approved operator may withdraw from, add to, collect rewards from and re-range the position.
+// Any tokens leaving the position as a result of an operator action are always sent to the owner.
+// The approval is kept when the position is compounded in place, and is passed on to the new positions when the
+// position is replaced by adding to, re-ranging or splitting it. When positions are merged, the merged position
+// keeps the approvals that all of them have in common. The approval is removed when the position is transferred
+// or withdrawn in full.
+// Returns error if the position does not exist or the owner does not own it.
+func (k Keeper) setPositionOperator(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, operator sdk.AccAddress, approved bool) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if position.Address != owner.String() {
+		return types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	k.setPositionOperatorApproval(ctx, owner, positionId, operator, approved)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSetPositionOperator,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+			sdk.NewAttribute(types.AttributeKeyApproved, strconv.FormatBool(approved)),
+		),
+	})
+	return nil
+}
+
+// setPositionOperatorApproval writes or removes the approval of the operator for the owner's position.
+func (k Keeper) setPositionOperatorApproval(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, operator sdk.AccAddress, approved bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyPositionOperator(positionId, operator)
+	if !approved {
+		store.Delete(key)
+		return
+	}
+	osmoutils.MustSet(store, key, &types.PositionOperatorApproval{Owner: owner.String(), Operator: operator.String(), PositionId: positionId})
+}
+
+// isPositionOperator returns true if the operator is approved to manage the given position.
+// Approvals are granted by the owner of the position at the time, so they only hold while the position has the same owner.
+func (k Keeper) isPositionOperator(ctx sdk.Context, position model.Position, operator sdk.AccAddress) bool {
+	approval := types.PositionOperatorApproval{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPositionOperator(position.PositionId, operator), &approval)
+	if err != nil {
+		panic(err)
+	}
+	return found && approval.Owner == position.Address
+}
+
+// isPositionOwnerOrOperator returns true if the sender is either the owner of the given position
+// or an operator approved by the owner.
+func (k Keeper) isPositionOwnerOrOperator(ctx sdk.Context, position model.Position, sender sdk.AccAddress) bool {
+	if position.Address == sender.String() {
+		return true
+	}
+	return k.isPositionOperator(ctx, position, sender)
+}
+
+// validatePositionOwnerOrOperator returns error if the sender is neither the owner of the given position nor an
+// operator approved by the owner. Since operators choose the ranges and minimum amounts of the actions they take on
+// behalf of the owner, operator actions also require the spot price of the pool to be close to its time weighted
+// average price, so that an operator cannot manipulate the price to extract value from the owner's position.
+func (k Keeper) validatePositionOwnerOrOperator(ctx sdk.Context, position model.Position, sender sdk.AccAddress) error {
+	if position.Address == sender.String() {
+		return nil
+	}
+	if !k.isPositionOperator(ctx, position, sender) {
+		return types.NotPositionOwnerError{PositionId: position.PositionId, Address: sender.String()}
+	}
+
+	pool, err := k.GetConcentratedPoolById(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+	_, err = k.validateSpotPriceNearTwap(ctx, pool)
+	return err
+}
+
+// getPositionOperatorApprovals returns the operator approvals of the given position.
+func (k Keeper) getPositionOperatorApprovals(ctx sdk.Context, positionId uint64) ([]types.PositionOperatorApproval, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPositionOperatorPrefix(positionId), ParsePositionOperatorApprovalFromBz)
+}
+
+// deletePositionOperatorApprovals removes all operator approvals of the given position.
+func (k Keeper) deletePositionOperatorApprovals(ctx sdk.Context, positionId uint64) error {
+	approvals, err := k.getPositionOperatorApprovals(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, approval := range approvals {
+		store.Delete(types.KeyPositionOperator(positionId, sdk.MustAccAddressFromBech32(approval.Operator)))
+	}
+	return nil
+}
+
+// getCommonPositionOperatorApprovals returns the operator approvals of the first of the given positions whose
+// operators are also approved for all the other given positions.
+func (k Keeper) getCommonPositionOperatorApprovals(ctx sdk.Context, positionIds []uint64) ([]types.PositionOperatorApproval, error) {
+	approvals, err := k.getPositionOperatorApprovals(ctx, positionIds[0])
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	commonApprovals := []types.PositionOperatorApproval{}
+	for _, approval := range approvals {
+		operator := sdk.MustAccAddressFromBech32(approval.Operator)
+		isCommon := true
+		for _, positionId := range positionIds[1:] {
+			isCommon = isCommon && store.Has(types.KeyPositionOperator(positionId, operator))
+		}
+		if isCommon {
+			commonApprovals = append(commonApprovals, approval)
+		}
+	}
+	return commonApprovals, nil
+}
+
+// setPositionOperatorApprovals grants the given operator approvals, e.g. of a position being replaced, for the new position.
+func (k Keeper) setPositionOperatorApprovals(ctx sdk.Context, newPositionId uint64, approvals []types.PositionOperatorApproval) {
+	for _, approval := range approvals {
+		k.setPositionOperatorApproval(ctx, sdk.MustAccAddressFromBech32(approval.Owner), newPositionId, sdk.MustAccAddressFromBech32(approval.Operator), true)
+	}
+}
+
+// getAllPositionOperatorApprovals returns all position operator approvals for export genesis.
+func (k Keeper) getAllPositionOperatorApprovals(ctx sdk.Context) ([]types.PositionOperatorApproval, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.PositionOperatorPrefix, ParsePositionOperatorApprovalFromBz)
+}
@@ -0,0 +1,295 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestSetPositionOperator() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(DefaultJoinTime)
+	owner, operator, newOwner := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+	pool := s.PrepareConcentratedPool()
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	otherPositionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+
+	s.Require().False(s.clk.IsPositionOperator(s.Ctx, positionId, operator))
+
+	s.Require().NoError(s.clk.SetPositionOperator(s.Ctx, owner, positionId, operator, true))
+	s.Require().True(s.clk.IsPositionOperator(s.Ctx, positionId, operator))
+	// Approvals only cover the approved position.
+	s.Require().False(s.clk.IsPositionOperator(s.Ctx, otherPositionId, operator))
+
+	// Only the owner of a position can approve an operator for it.
+	err := s.clk.SetPositionOperator(s.Ctx, operator, otherPositionId, operator, true)
+	s.Require().ErrorIs(err, types.NotPositionOwnerError{PositionId: otherPositionId, Address: operator.String()})
+
+	// Approvals are exported in genesis.
+	genesis := s.clk.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.PositionOperatorApprovals, 1)
+	s.Require().Equal(types.PositionOperatorApproval{Owner: owner.String(), Operator: operator.String(), PositionId: positionId}, genesis.PositionOperatorApprovals[0])
+
+	s.Require().NoError(s.clk.SetPositionOperator(s.Ctx, owner, positionId, operator, false))
+	s.Require().False(s.clk.IsPositionOperator(s.Ctx, positionId, operator))
+
+	// Approvals are removed when the position is transferred.
+	s.Require().NoError(s.clk.SetPositionOperator(s.Ctx, owner, positionId, operator, true))
+	s.Require().NoError(s.clk.TransferPositions(s.Ctx, []uint64{positionId}, owner, newOwner))
+	s.Require().False(s.clk.IsPositionOperator(s.Ctx, positionId, operator))
+
+	// Approvals are imported from genesis.
+	s.SetupTest()
+	s.clk.InitGenesis(s.Ctx, *genesis)
+	s.Require().True(s.clk.IsPositionOperator(s.Ctx, positionId, operator))
+	s.Require().False(s.clk.IsPositionOperator(s.Ctx, otherPositionId, operator))
+}
+
+func (s *KeeperTestSuite) TestPositionOperatorActions() {
+	spreadRewardsPerLiquidity := sdk.NewDecCoinFromDec(ETH, sdk.NewDecWithPrec(1, 4))
+
+	type action func(sender sdk.AccAddress, positionId uint64) error
+
+	withdraw := func(sender sdk.AccAddress, positionId uint64) error {
+		position, err := s.clk.GetPosition(s.Ctx, positionId)
+		s.Require().NoError(err)
+		_, _, err = s.clk.WithdrawPosition(s.Ctx, sender, positionId, position.Liquidity.QuoInt64(2))
+		return err
+	}
+	addTo := func(sender sdk.AccAddress, positionId uint64) error {
+		s.FundAcc(sender, DefaultCoins)
+		_, _, _, err := s.clk.AddToPosition(s.Ctx, sender, positionId, DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt())
+		return err
+	}
+	collectSpreadRewards := func(sender sdk.AccAddress, positionId uint64) error {
+		_, err := s.clk.CollectSpreadRewards(s.Ctx, sender, positionId)
+		return err
+	}
+	collectIncentives := func(sender sdk.AccAddress, positionId uint64) error {
+		_, _, err := s.clk.CollectIncentives(s.Ctx, sender, positionId)
+		return err
+	}
+	rerange := func(sender sdk.AccAddress, positionId uint64) error {
+		_, _, _, _, _, _, err := s.clk.RerangePosition(s.Ctx, sender, positionId, DefaultLowerTick-100, DefaultUpperTick+100, sdk.ZeroInt(), sdk.ZeroInt())
+		return err
+	}
+
+	tests := map[string]struct {
+		action               action
+		approveOperator      bool
+		approveOtherPosition bool
+		moveSpotPrice        bool
+		expectOwnerGains     bool
+		expectedError        error
+	}{
+		"operator withdraws": {
+			action:           withdraw,
+			approveOperator:  true,
+			expectOwnerGains: true,
+		},
+		"operator adds to position": {
+			action:          addTo,
+			approveOperator: true,
+		},
+		"operator collects spread rewards": {
+			action:           collectSpreadRewards,
+			approveOperator:  true,
+			expectOwnerGains: true,
+		},
+		"operator collects incentives": {
+			action:          collectIncentives,
+			approveOperator: true,
+		},
+		"operator re-ranges position": {
+			action:           rerange,
+			approveOperator:  true,
+			expectOwnerGains: true,
+		},
+		"error: unapproved operator withdraws": {
+			action:        withdraw,
+			expectedError: types.NotPositionOwnerError{},
+		},
+		"error: unapproved operator adds to position": {
+			action:        addTo,
+			expectedError: types.NotPositionOwnerError{},
+		},
+		"error: unapproved operator collects spread rewards": {
+			action:        collectSpreadRewards,
+			expectedError: types.NotPositionOwnerError{},
+		},
+		"error: unapproved operator collects incentives": {
+			action:        collectIncentives,
+			expectedError: types.NotPositionOwnerError{},
+		},
+		"error: unapproved operator re-ranges position": {
+			action:        rerange,
+			expectedError: types.NotPositionOwnerError{},
+		},
+		"error: operator approved for another position withdraws": {
+			action:               withdraw,
+			approveOtherPosition: true,
+			expectedError:        types.NotPositionOwnerError{},
+		},
+		"operator collects spread rewards after the spot price moved": {
+			action:           collectSpreadRewards,
+			approveOperator:  true,
+			moveSpotPrice:    true,
+			expectOwnerGains: true,
+		},
+		"error: operator withdraws after the spot price moved": {
+			action:          withdraw,
+			approveOperator: true,
+			moveSpotPrice:   true,
+			expectedError:   types.SpotPriceDeviatesFromTwapError{},
+		},
+		"error: operator re-ranges position after the spot price moved": {
+			action:          rerange,
+			approveOperator: true,
+			moveSpotPrice:   true,
+			expectedError:   types.SpotPriceDeviatesFromTwapError{},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(DefaultJoinTime)
+			owner, operator := s.TestAccs[0], s.TestAccs[1]
+			pool := s.PrepareConcentratedPool()
+
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			// Second position so that the operated position is not the last one in the pool.
+			otherPositionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[2])
+
+			s.AddToSpreadRewardAccumulator(pool.GetId(), spreadRewardsPerLiquidity)
+			s.FundAcc(pool.GetSpreadRewardsAddress(), sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(1_000_000_000))))
+			s.AddBlockTime(time.Hour)
+
+			if tc.approveOperator {
+				s.Require().NoError(s.clk.SetPositionOperator(s.Ctx, owner, positionId, operator, true))
+			}
+			if tc.approveOtherPosition {
+				s.Require().NoError(s.clk.SetPositionOperator(s.Ctx, s.TestAccs[2], otherPositionId, operator, true))
+			}
+
+			if tc.moveSpotPrice {
+				// Keep the observation preceding the swap, so that the time weighted average price can be computed.
+				_, _, err := s.clk.IncreaseObservationCardinality(s.Ctx, owner, pool.GetId(), types.AutoCompoundObservationCardinality)
+				s.Require().NoError(err)
+
+				// Moves the spot price by about 3% within the current block, which does not affect the time weighted average price.
+				tokenIn := sdk.NewCoin(USDC, sdk.NewInt(3_000_000_000))
+				s.FundAcc(s.TestAccs[2], sdk.NewCoins(tokenIn))
+				pool, err := s.clk.GetPoolById(s.Ctx, pool.GetId())
+				s.Require().NoError(err)
+				_, err = s.clk.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool, tokenIn, ETH, sdk.ZeroInt(), pool.GetSpreadFactor(s.Ctx))
+				s.Require().NoError(err)
+			}
+
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			// System under test
+			err := tc.action(operator, positionId)
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Operators never receive any tokens.
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, operator).IsZero())
+
+			ownerBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			s.Require().True(ownerBalanceAfter.IsAllGTE(ownerBalanceBefore))
+			if tc.expectOwnerGains {
+				s.Require().NotEqual(ownerBalanceBefore.String(), ownerBalanceAfter.String())
+			}
+
+			// All of the owner's positions remain owned by the owner.
+			ownerPositions, err := s.clk.GetUserPositions(s.Ctx, owner, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Len(ownerPositions, 1)
+			// The approval follows the position when it is replaced by a new one.
+			s.Require().True(s.clk.IsPositionOperator(s.Ctx, ownerPositions[0].PositionId, operator))
+			operatorPositions, err := s.clk.GetUserPositions(s.Ctx, operator, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Empty(operatorPositions)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRerangePosition() {
+	tests := []struct {
+		name           string
+		newLowerTick   int64
+		newUpperTick   int64
+		isLastPosition bool
+		autoCompound   bool
+		expectedError  error
+	}{
+		{
+			name:         "re-range to a wider range",
+			newLowerTick: DefaultLowerTick - 100,
+			newUpperTick: DefaultUpperTick + 100,
+		},
+		{
+			name:         "re-range keeps the auto-compound flag",
+			newLowerTick: DefaultLowerTick - 100,
+			newUpperTick: DefaultUpperTick + 100,
+			autoCompound: true,
+		},
+		{
+			name:           "error: last position in pool",
+			newLowerTick:   DefaultLowerTick - 100,
+			newUpperTick:   DefaultUpperTick + 100,
+			isLastPosition: true,
+			expectedError:  types.RerangeLastPositionInPoolError{PoolId: 1, PositionId: DefaultPositionId},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(DefaultJoinTime)
+			owner := s.TestAccs[0]
+			pool := s.PrepareConcentratedPool()
+
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			if !tc.isLastPosition {
+				s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[1])
+			}
+			if tc.autoCompound {
+				s.Require().NoError(s.clk.SetPositionAutoCompoundForOwner(s.Ctx, owner, positionId, true))
+			}
+
+			// System under test
+			newPositionId, amount0, amount1, liquidity, lowerTick, upperTick, err := s.clk.RerangePosition(s.Ctx, owner, positionId, tc.newLowerTick, tc.newUpperTick, sdk.ZeroInt(), sdk.ZeroInt())
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			_, err = s.clk.GetPosition(s.Ctx, positionId)
+			s.Require().Error(err)
+
+			newPosition, err := s.clk.GetPosition(s.Ctx, newPositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(owner.String(), newPosition.Address)
+			s.Require().Equal(tc.newLowerTick, lowerTick)
+			s.Require().Equal(tc.newUpperTick, upperTick)
+			s.Require().Equal(lowerTick, newPosition.LowerTick)
+			s.Require().Equal(upperTick, newPosition.UpperTick)
+			s.Require().Equal(liquidity, newPosition.Liquidity)
+			s.Require().True(amount0.IsPositive())
+			s.Require().True(amount1.IsPositive())
+			s.Require().Equal(tc.autoCompound, s.clk.IsPositionAutoCompound(s.Ctx, newPositionId))
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package concentrated_liquidity
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+// PositionPerformance returns the lifetime performance of the given position along with the current
+// amounts of token0 and token1 underlying it.
+// Positions created before their performance was tracked only reflect the flows since then.
+// Returns error if the position or its pool does not exist.
+func (k Keeper) PositionPerformance(ctx sdk.Context, positionId uint64) (types.PositionPerformance, sdk.Coin, sdk.Coin, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return types.PositionPerformance{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	pool, err := k.GetConcentratedPoolById(ctx, position.PoolId)
+	if err != nil {
+		return types.PositionPerformance{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	asset0, asset1, err := CalculateUnderlyingAssetsFromPosition(ctx, position, pool)
+	if err != nil {
+		return types.PositionPerformance{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	performance, found := k.getPositionPerformance(ctx, positionId)
+	if !found {
+		performance = types.PositionPerformance{PositionId: positionId}
+	}
+	return performance, asset0, asset1, nil
+}
+
+// getPositionPerformance returns the performance record of the given position and whether it exists.
+func (k Keeper) getPositionPerformance(ctx sdk.Context, positionId uint64) (types.PositionPerformance, bool) {
+	performance := types.PositionPerformance{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPositionPerformance(positionId), &performance)
+	if err != nil {
+		panic(err)
+	}
+	return performance, found
+}
+
+// setPositionPerformance sets the performance record of the position it refers to.
+func (k Keeper) setPositionPerformance(ctx sdk.Context, performance types.PositionPerformance) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPositionPerformance(performance.PositionId), &performance)
+}
+
+// deletePositionPerformance deletes the performance record of the given position, if any.
+func (k Keeper) deletePositionPerformance(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPositionPerformance(positionId))
+}
+
+// getOrInitPositionPerformance returns the performance record of the given position.
+// If the position has none yet, an empty record created at the current block time is returned.
+func (k Keeper) getOrInitPositionPerformance(ctx sdk.Context, positionId uint64) types.PositionPerformance {
+	performance, found := k.getPositionPerformance(ctx, positionId)
+	if !found {
+		performance = types.PositionPerformance{PositionId: positionId, CreatedAt: ctx.BlockTime()}
+	}
+	return performance
+}
+
+// recordPositionDeposit adds the given tokens to the amount deposited into the position.
+func (k Keeper) recordPositionDeposit(ctx sdk.Context, positionId uint64, deposited sdk.Coins) {
+	performance := k.getOrInitPositionPerformance(ctx, positionId)
+	performance.Deposited = performance.Deposited.Add(deposited...)
+	performance.LastDepositTime = ctx.BlockTime()
+	k.setPositionPerformance(ctx, performance)
+}
+
+// recordPositionWithdrawal adds the given tokens to the amount withdrawn from the position.
+func (k Keeper) recordPositionWithdrawal(ctx sdk.Context, positionId uint64, withdrawn sdk.Coins) {
+	performance := k.getOrInitPositionPerformance(ctx, positionId)
+	performance.Withdrawn = performance.Withdrawn.Add(withdrawn...)
+	performance.LastWithdrawalTime = ctx.BlockTime()
+	k.setPositionPerformance(ctx, performance)
+}
+
+// recordPositionRewardsCollected adds the given spread rewards and incentives to the amounts collected by the position.
+// Nothing is written if both are zero.
+func (k Keeper) recordPositionRewardsCollected(ctx sdk.Context, positionId uint64, spreadRewards, incentives sdk.Coins) {
+	if spreadRewards.IsZero() && incentives.IsZero() {
+		return
+	}
+	performance := k.getOrInitPositionPerformance(ctx, positionId)
+	performance.SpreadRewardsCollected = performance.SpreadRewardsCollected.Add(spreadRewards...)
+	performance.IncentivesCollected = performance.IncentivesCollected.Add(incentives...)
+	k.setPositionPerformance(ctx, performance)
+}
+
+// inheritPositionPerformance combines the performance records of the given old positions, deletes them, and adds
+// the result to the records of the given new positions. When there are several new positions, the combined amounts
+// are divided between them in proportion to the given liquidities, and the first new position receives the rounding
+// remainder. The new positions keep the earliest creation time and the latest deposit and withdrawal times.
+// Nothing is inherited if none of the old positions has a performance record.
+func (k Keeper) inheritPositionPerformance(ctx sdk.Context, oldPositionIds, newPositionIds []uint64, newLiquidities []sdk.Dec) {
+	combined := types.PositionPerformance{}
+	foundAny := false
+	for _, oldPositionId := range oldPositionIds {
+		performance, found := k.getPositionPerformance(ctx, oldPositionId)
+		if !found {
+			continue
+		}
+		if foundAny {
+			combined = combinePositionPerformance(combined, performance)
+		} else {
+			combined = performance
+		}
+		foundAny = true
+		k.deletePositionPerformance(ctx, oldPositionId)
+	}
+	if !foundAny {
+		return
+	}
+
+	totalLiquidity := sdk.ZeroDec()
+	for _, liquidity := range newLiquidities {
+		totalLiquidity = totalLiquidity.Add(liquidity)
+	}
+
+	remainder := combined
+	shares := make([]types.PositionPerformance, len(newPositionIds))
+	for i := len(newPositionIds) - 1; i > 0; i-- {
+		fraction := newLiquidities[i].Quo(totalLiquidity)
+		shares[i] = combined
+		shares[i].Deposited = mulCoinsTruncate(combined.Deposited, fraction)
+		shares[i].Withdrawn = mulCoinsTruncate(combined.Withdrawn, fraction)
+		shares[i].SpreadRewardsCollected = mulCoinsTruncate(combined.SpreadRewardsCollected, fraction)
+		shares[i].IncentivesCollected = mulCoinsTruncate(combined.IncentivesCollected, fraction)
+
+		remainder.Deposited = remainder.Deposited.Sub(shares[i].Deposited)
+		remainder.Withdrawn = remainder.Withdrawn.Sub(shares[i].Withdrawn)
+		remainder.SpreadRewardsCollected = remainder.SpreadRewardsCollected.Sub(shares[i].SpreadRewardsCollected)
+		remainder.IncentivesCollected = remainder.IncentivesCollected.Sub(shares[i].IncentivesCollected)
+	}
+	shares[0] = remainder
+
+	for i, newPositionId := range newPositionIds {
+		performance := shares[i]
+		if existing, found := k.getPositionPerformance(ctx, newPositionId); found {
+			performance = combinePositionPerformance(performance, existing)
+		}
+		performance.PositionId = newPositionId
+		k.setPositionPerformance(ctx, performance)
+	}
+}
+
+// replacePositionPerformance moves the performance record of the given old position, withdrawn in full, on to the
+// given new position funded with the tokens withdrawn from it. The withdrawal from the old position and the deposit
+// into the new position are internal to the replacement, so they are netted out: only the tokens added on top of the
+// withdrawn tokens are recorded as deposited, and only the withdrawn tokens left out of the new position are recorded
+// as withdrawn. lastWithdrawalTime is the last withdrawal time of the old position before it was withdrawn, which is
+// kept unless tokens are left out of the new position. The new position keeps the creation time of the old position.
+func (k Keeper) replacePositionPerformance(ctx sdk.Context, oldPositionId, newPositionId uint64, lastWithdrawalTime time.Time, withdrawn, deposited sdk.Coins) {
+	// The old record holds the withdrawal recorded when the old position was withdrawn, along with the rewards
+	// collected as part of it. The new record only holds the deposit recorded when the new position was created.
+	performance := k.getOrInitPositionPerformance(ctx, oldPositionId)
+	performance.Withdrawn = performance.Withdrawn.Sub(withdrawn)
+	performance.LastWithdrawalTime = lastWithdrawalTime
+	k.deletePositionPerformance(ctx, oldPositionId)
+	k.deletePositionPerformance(ctx, newPositionId)
+
+	for _, coin := range withdrawn.Add(deposited...) {
+		netDeposited := deposited.AmountOf(coin.Denom).Sub(withdrawn.AmountOf(coin.Denom))
+		if netDeposited.IsPositive() {
+			performance.Deposited = performance.Deposited.Add(sdk.NewCoin(coin.Denom, netDeposited))
+			performance.LastDepositTime = ctx.BlockTime()
+		} else if netDeposited.IsNegative() {
+			performance.Withdrawn = performance.Withdrawn.Add(sdk.NewCoin(coin.Denom, netDeposited.Neg()))
+			performance.LastWithdrawalTime = ctx.BlockTime()
+		}
+	}
+
+	performance.PositionId = newPositionId
+	k.setPositionPerformance(ctx, performance)
+}
+
+// combinePositionPerformance returns the sum of the amounts of the given performance records, along with the
+// earliest creation time and the latest deposit and withdrawal times. The position id of base is kept.
+func combinePositionPerformance(base, other types.PositionPerformance) types.PositionPerformance {
+	if other.CreatedAt.Before(base.CreatedAt) {
+		base.CreatedAt = other.CreatedAt
+	}
+	base.Deposited = base.Deposited.Add(other.Deposited...)
+	base.Withdrawn = base.Withdrawn.Add(other.Withdrawn...)
+	base.SpreadRewardsCollected = base.SpreadRewardsCollected.Add(other.SpreadRewardsCollected...)
+	base.IncentivesCollected = base.IncentivesCollected.Add(other.IncentivesCollected...)
+	base.LastDepositTime = latestTime(base.LastDepositTime, other.LastDepositTime)
+	base.LastWithdrawalTime = latestTime(base.LastWithdrawalTime, other.LastWithdrawalTime)
+	return base
+}
+
+// mulCoinsTruncate returns the given coins multiplied by the given fraction, truncated.
+func mulCoinsTruncate(coins sdk.Coins, fraction sdk.Dec) sdk.Coins {
+	result := sdk.NewCoins()
+	for _, coin := range coins {
+		result = result.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(fraction).TruncateInt()))
+	}
+	return result
+}
+
+// latestTime returns the later of the two given times.
+func latestTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
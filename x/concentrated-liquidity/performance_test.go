@@ -0,0 +1,175 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cl "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestPositionPerformance() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(defaultBlockTime)
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, sdk.MustNewDecFromStr("0.003"))
+	poolId := pool.GetId()
+	owner := s.TestAccs[0]
+
+	// Create the tracked position and a second position so that the tracked one is never the last in the pool.
+	s.FundAcc(owner, DefaultCoins)
+	positionId, amount0, amount1, _, _, _, err := s.clk.CreatePosition(s.Ctx, poolId, owner, DefaultCoins, sdk.ZeroInt(), sdk.ZeroInt(), DefaultLowerTick, DefaultUpperTick)
+	s.Require().NoError(err)
+	s.SetupFullRangePositionAcc(poolId, s.TestAccs[2])
+
+	incentiveCoin := sdk.NewCoin(testDenomOne, sdk.NewInt(1_000_000))
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(incentiveCoin))
+	_, err = s.clk.CreateIncentive(s.Ctx, poolId, s.TestAccs[1], s.TestAccs[1], incentiveCoin, sdk.NewDec(100), s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+	s.Require().NoError(err)
+
+	expected := types.PositionPerformance{
+		PositionId:      positionId,
+		CreatedAt:       defaultBlockTime,
+		Deposited:       sdk.NewCoins(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1)),
+		LastDepositTime: defaultBlockTime,
+	}
+	s.requirePositionPerformance(expected)
+
+	// Collecting spread rewards and incentives adds them to the collected amounts.
+	s.AddBlockTime(time.Hour)
+	s.swapAtCurrentSpreadFactor(poolId, sdk.NewCoin(USDC, sdk.NewInt(1_000_000)))
+	spreadRewards, err := s.clk.CollectSpreadRewards(s.Ctx, owner, positionId)
+	s.Require().NoError(err)
+	s.Require().False(spreadRewards.IsZero())
+	incentives, _, err := s.clk.CollectIncentives(s.Ctx, owner, positionId)
+	s.Require().NoError(err)
+	s.Require().False(incentives.IsZero())
+
+	expected.SpreadRewardsCollected = spreadRewards
+	expected.IncentivesCollected = incentives
+	s.requirePositionPerformance(expected)
+
+	// Withdrawing adds to the withdrawn amount. It also collects the incentives accrued since the last collection.
+	s.AddBlockTime(time.Hour)
+	liquidity, err := s.clk.GetPositionLiquidity(s.Ctx, positionId)
+	s.Require().NoError(err)
+	incentives, _, err = s.clk.GetClaimableIncentives(s.Ctx, positionId)
+	s.Require().NoError(err)
+	withdrawn0, withdrawn1, err := s.clk.WithdrawPosition(s.Ctx, owner, positionId, liquidity.QuoInt64(2))
+	s.Require().NoError(err)
+
+	expected.Withdrawn = sdk.NewCoins(sdk.NewCoin(ETH, withdrawn0), sdk.NewCoin(USDC, withdrawn1))
+	expected.IncentivesCollected = expected.IncentivesCollected.Add(incentives...)
+	expected.LastWithdrawalTime = s.Ctx.BlockTime()
+	s.requirePositionPerformance(expected)
+
+	// Adding to the position moves the performance to the new position. The amounts moved between the old and the
+	// new position are not recorded, so only the amounts taken from the owner's balance count as deposited.
+	s.AddBlockTime(time.Hour)
+	added := sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(1_000)), sdk.NewCoin(USDC, sdk.NewInt(5_000_000)))
+	s.FundAcc(owner, added)
+	balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	newPositionId, _, _, err := s.clk.AddToPosition(s.Ctx, owner, positionId, added.AmountOf(ETH), added.AmountOf(USDC), sdk.ZeroInt(), sdk.ZeroInt())
+	s.Require().NoError(err)
+	balanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+	_, found := s.clk.GetPositionPerformance(s.Ctx, positionId)
+	s.Require().False(found)
+	performance, found := s.clk.GetPositionPerformance(s.Ctx, newPositionId)
+	s.Require().True(found)
+	s.Require().Equal(defaultBlockTime, performance.CreatedAt)
+	s.Require().Equal(s.Ctx.BlockTime(), performance.LastDepositTime)
+	s.Require().Equal(expected.LastWithdrawalTime, performance.LastWithdrawalTime)
+	s.Require().Equal(expected.SpreadRewardsCollected, performance.SpreadRewardsCollected)
+	s.Require().True(performance.IncentivesCollected.IsAllGT(expected.IncentivesCollected))
+	s.Require().Equal(expected.Withdrawn, performance.Withdrawn)
+	for _, denom := range []string{ETH, USDC} {
+		depositsAdded := performance.Deposited.AmountOf(denom).Sub(expected.Deposited.AmountOf(denom))
+		s.Require().Equal(balanceBefore.AmountOf(denom).Sub(balanceAfter.AmountOf(denom)), depositsAdded)
+	}
+
+	// Splitting the position divides its performance between the new positions in proportion to their liquidity.
+	newLiquidity, err := s.clk.GetPositionLiquidity(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	firstPositionId, secondPositionId, err := s.clk.SplitPosition(s.Ctx, owner, newPositionId, newLiquidity.QuoInt64(4))
+	s.Require().NoError(err)
+
+	_, found = s.clk.GetPositionPerformance(s.Ctx, newPositionId)
+	s.Require().False(found)
+	first, found := s.clk.GetPositionPerformance(s.Ctx, firstPositionId)
+	s.Require().True(found)
+	second, found := s.clk.GetPositionPerformance(s.Ctx, secondPositionId)
+	s.Require().True(found)
+	s.Require().Equal(defaultBlockTime, first.CreatedAt)
+	s.Require().Equal(defaultBlockTime, second.CreatedAt)
+	s.Require().Equal(performance.Deposited, first.Deposited.Add(second.Deposited...))
+	s.Require().Equal(performance.Withdrawn, first.Withdrawn.Add(second.Withdrawn...))
+	s.Require().Equal(performance.SpreadRewardsCollected, first.SpreadRewardsCollected.Add(second.SpreadRewardsCollected...))
+	s.Require().Equal(performance.IncentivesCollected, first.IncentivesCollected.Add(second.IncentivesCollected...))
+	// The split liquidity is a quarter of the liquidity, up to rounding.
+	s.Require().True(performance.Deposited.AmountOf(USDC).QuoRaw(4).Sub(second.Deposited.AmountOf(USDC)).Abs().LTE(sdk.OneInt()))
+
+	// The performance is exported in genesis.
+	genesis := s.clk.ExportGenesis(s.Ctx)
+	exported := 0
+	for _, positionData := range genesis.PoolData[0].PositionData {
+		if positionData.Position.PositionId == firstPositionId {
+			s.Require().Equal(first, *positionData.Performance)
+			exported++
+		}
+	}
+	s.Require().Equal(1, exported)
+
+	// Reranging the position moves the performance to the new position. The amounts moved between the old and the
+	// new position are not recorded, so only the net amounts moved in or out of the owner's balance are recorded.
+	// Most of one token does not fit the new range and counts as withdrawn, while rounding may take a unit of the
+	// other token from the owner's balance.
+	s.AddBlockTime(time.Hour)
+	balanceBefore = s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	rerangedPositionId, _, _, _, _, _, err := s.clk.RerangePosition(s.Ctx, owner, secondPositionId, DefaultLowerTick, DefaultUpperTick+100*int64(DefaultTickSpacing), sdk.ZeroInt(), sdk.ZeroInt())
+	s.Require().NoError(err)
+	balanceAfter = s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+	_, found = s.clk.GetPositionPerformance(s.Ctx, secondPositionId)
+	s.Require().False(found)
+	reranged, found := s.clk.GetPositionPerformance(s.Ctx, rerangedPositionId)
+	s.Require().True(found)
+	s.Require().Equal(defaultBlockTime, reranged.CreatedAt)
+	s.Require().Equal(s.Ctx.BlockTime(), reranged.LastWithdrawalTime)
+	for _, denom := range []string{ETH, USDC} {
+		netDepositsAdded := reranged.Deposited.AmountOf(denom).Sub(reranged.Withdrawn.AmountOf(denom)).
+			Sub(second.Deposited.AmountOf(denom).Sub(second.Withdrawn.AmountOf(denom)))
+		s.Require().Equal(balanceBefore.AmountOf(denom).Sub(balanceAfter.AmountOf(denom)), netDepositsAdded)
+		s.Require().True(reranged.Deposited.AmountOf(denom).Sub(second.Deposited.AmountOf(denom)).LTE(sdk.OneInt()))
+	}
+	s.Require().True(reranged.Withdrawn.IsAllGTE(second.Withdrawn))
+	s.Require().False(reranged.Withdrawn.IsEqual(second.Withdrawn))
+
+	// Withdrawing a position in full deletes its performance.
+	rerangedLiquidity, err := s.clk.GetPositionLiquidity(s.Ctx, rerangedPositionId)
+	s.Require().NoError(err)
+	_, _, err = s.clk.WithdrawPosition(s.Ctx, owner, rerangedPositionId, rerangedLiquidity)
+	s.Require().NoError(err)
+	_, found = s.clk.GetPositionPerformance(s.Ctx, rerangedPositionId)
+	s.Require().False(found)
+
+	_, _, _, err = s.clk.PositionPerformance(s.Ctx, rerangedPositionId)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: rerangedPositionId})
+}
+
+// requirePositionPerformance requires the PositionPerformance query to return the expected performance
+// along with the current underlying assets of the position.
+func (s *KeeperTestSuite) requirePositionPerformance(expected types.PositionPerformance) {
+	performance, asset0, asset1, err := s.clk.PositionPerformance(s.Ctx, expected.PositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, performance)
+
+	position, err := s.clk.GetPosition(s.Ctx, expected.PositionId)
+	s.Require().NoError(err)
+	pool, err := s.clk.GetConcentratedPoolById(s.Ctx, position.PoolId)
+	s.Require().NoError(err)
+	expectedAsset0, expectedAsset1, err := cl.CalculateUnderlyingAssetsFromPosition(s.Ctx, position, pool)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedAsset0, asset0)
+	s.Require().Equal(expectedAsset1, asset1)
+}
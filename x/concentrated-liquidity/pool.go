@@ -3,6 +3,7 @@ package concentrated_liquidity
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -265,17 +266,171 @@ func (k Keeper) DecreaseConcentratedPoolTickSpacing(ctx sdk.Context, poolIdToTic
 		if err != nil {
 			return err
 		}
-		params := k.GetParams(ctx)
 
-		if !k.validateTickSpacingUpdate(ctx, pool, params, poolIdToTickSpacingRecord.NewTickSpacing) {
+		if poolIdToTickSpacingRecord.NewTickSpacing >= pool.GetTickSpacing() {
 			return fmt.Errorf("tick spacing %d is not valid", poolIdToTickSpacingRecord.NewTickSpacing)
 		}
+	}
+	return k.UpdateConcentratedPoolTickSpacing(ctx, poolIdToTickSpacingRecord)
+}
+
+// UpdateConcentratedPoolTickSpacing updates the tick spacing of the given pools to the given tick spacings.
+// Decreasing the tick spacing increases the number of initializable ticks in the pool. Increasing it reduces the number
+// of ticks that swaps cross, which lowers their gas cost.
+// The ticks of the existing positions are always divisible by the current tick spacing. If they are not all divisible by
+// the new tick spacing, the positions are migrated to it in batches as described in migratePoolPositionsToTickSpacing.
+// It returns an error if the tick spacing is not one of the authorized tick spacings or is equal to the current tick spacing of the respective pool.
+func (k Keeper) UpdateConcentratedPoolTickSpacing(ctx sdk.Context, poolIdToTickSpacingRecord []types.PoolIdToTickSpacingRecord) error {
+	params := k.GetParams(ctx)
+	for _, poolIdToTickSpacingRecord := range poolIdToTickSpacingRecord {
+		pool, err := k.GetConcentratedPoolById(ctx, poolIdToTickSpacingRecord.PoolId)
+		if err != nil {
+			return err
+		}
+
+		newTickSpacing := poolIdToTickSpacingRecord.NewTickSpacing
+		if !k.validateTickSpacingUpdate(ctx, pool, params, newTickSpacing) {
+			return fmt.Errorf("tick spacing %d is not valid", newTickSpacing)
+		}
 
-		pool.SetTickSpacing(poolIdToTickSpacingRecord.NewTickSpacing)
+		oldTickSpacing := pool.GetTickSpacing()
+		pool.SetTickSpacing(newTickSpacing)
 		err = k.setPool(ctx, pool)
 		if err != nil {
 			return err
 		}
+
+		if oldTickSpacing%newTickSpacing != 0 {
+			// Restart the migration from the first position, in case one from a previous update is still pending.
+			k.setTickSpacingMigrationCursor(ctx, pool.GetId(), 0)
+			if err := k.migratePoolPositionsToTickSpacing(ctx, pool.GetId()); err != nil {
+				return err
+			}
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtChangeTickSpacing,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyOldTickSpacing, strconv.FormatUint(oldTickSpacing, 10)),
+			sdk.NewAttribute(types.AttributeKeyTickSpacing, strconv.FormatUint(newTickSpacing, 10)),
+		))
+	}
+	return nil
+}
+
+// migratePoolPositionsToTickSpacing migrates up to types.MaxTickSpacingMigrationPositionsPerBlock positions of the
+// given pool to the pool's tick spacing, resuming after the last position migrated by the previous batch, see
+// migratePositionToTickSpacing. Once all positions are migrated, the migration of the pool is complete. Otherwise,
+// the remaining positions are migrated by the following blocks, see MigratePendingTickSpacings.
+// Each position is migrated in a cached context so that a failure does not affect the other positions. Failures are
+// logged and the position is left at its old ticks.
+// Returns error if the pool does not exist.
+func (k Keeper) migratePoolPositionsToTickSpacing(ctx sdk.Context, poolId uint64) error {
+	cursor, found := k.getTickSpacingMigrationCursor(ctx, poolId)
+	if !found {
+		return nil
+	}
+
+	// Update the uptime accumulators and record the pool's cumulative values before the ticks and the active
+	// liquidity change.
+	if err := k.updatePoolUptimeAccumulatorsToNow(ctx, poolId); err != nil {
+		return err
+	}
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	k.writeObservation(ctx, pool)
+
+	positionIds := k.getPositionIdsForPoolFrom(ctx, poolId, cursor+1, types.MaxTickSpacingMigrationPositionsPerBlock)
+	for _, positionId := range positionIds {
+		positionId := positionId
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.migratePositionToTickSpacing(cacheCtx, positionId, pool.GetTickSpacing())
+		})
+		if err != nil {
+			ctx.Logger().Error("failed to migrate position to tick spacing", "pool_id", poolId, "position_id", positionId, "error", err.Error())
+		}
+	}
+
+	if len(positionIds) < types.MaxTickSpacingMigrationPositionsPerBlock {
+		ctx.KVStore(k.storeKey).Delete(types.KeyTickSpacingMigration(poolId))
+		return nil
+	}
+	k.setTickSpacingMigrationCursor(ctx, poolId, positionIds[len(positionIds)-1])
+	return nil
+}
+
+// MigratePendingTickSpacings migrates the next batch of positions of every pool whose migration to a new tick spacing
+// is still pending. It is called at the beginning of every block.
+func (k Keeper) MigratePendingTickSpacings(ctx sdk.Context) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TickSpacingMigrationPrefix)
+	poolIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iterator.Key()[len(types.TickSpacingMigrationPrefix):]))
+	}
+	iterator.Close()
+
+	for _, poolId := range poolIds {
+		if err := k.migratePoolPositionsToTickSpacing(ctx, poolId); err != nil {
+			ctx.Logger().Error("failed to migrate pool to tick spacing", "pool_id", poolId, "error", err.Error())
+		}
+	}
+}
+
+// getTickSpacingMigrationCursor returns the id of the last position migrated by the pending tick spacing migration of
+// the given pool, and false if no migration is pending.
+func (k Keeper) getTickSpacingMigrationCursor(ctx sdk.Context, poolId uint64) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyTickSpacingMigration(poolId))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// setTickSpacingMigrationCursor marks the tick spacing migration of the given pool as pending, with the given id of
+// the last migrated position.
+func (k Keeper) setTickSpacingMigrationCursor(ctx sdk.Context, poolId uint64, positionId uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyTickSpacingMigration(poolId), sdk.Uint64ToBigEndian(positionId))
+}
+
+// ChangeConcentratedPoolSpreadFactor changes the spread factor of each of the given pools to the new spread factor.
+// Spread rewards are added to the pool's spread reward accumulator at the end of every swap, so the accumulator
+// already holds all spread rewards charged at the old spread factor and its growth is left untouched. The new spread
+// factor applies to every swap executed after the change.
+// Returns error if a pool does not exist, or if the new spread factor is not one of the authorized spread factors
+// or is equal to the current spread factor of the pool.
+func (k Keeper) ChangeConcentratedPoolSpreadFactor(ctx sdk.Context, poolIdToSpreadFactorRecords []types.PoolIdToSpreadFactorRecord) error {
+	params := k.GetParams(ctx)
+	for _, poolIdToSpreadFactorRecord := range poolIdToSpreadFactorRecords {
+		pool, err := k.GetConcentratedPoolById(ctx, poolIdToSpreadFactorRecord.PoolId)
+		if err != nil {
+			return err
+		}
+
+		newSpreadFactor := poolIdToSpreadFactorRecord.NewSpreadFactor
+		if !k.validateSpreadFactor(ctx, params, newSpreadFactor) {
+			return types.UnauthorizedSpreadFactorError{ProvidedSpreadFactor: newSpreadFactor, AuthorizedSpreadFactors: params.AuthorizedSpreadFactors}
+		}
+
+		oldSpreadFactor := pool.GetSpreadFactor(ctx)
+		if newSpreadFactor.Equal(oldSpreadFactor) {
+			return types.SpreadFactorUnchangedError{PoolId: pool.GetId(), SpreadFactor: oldSpreadFactor}
+		}
+
+		pool.SetSpreadFactor(newSpreadFactor)
+		if err := k.setPool(ctx, pool); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtChangeSpreadFactor,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyOldSpreadFactor, oldSpreadFactor.String()),
+			sdk.NewAttribute(types.AttributeKeySpreadFactor, newSpreadFactor.String()),
+		))
 	}
 	return nil
 }
@@ -292,12 +447,12 @@ func (k Keeper) validateTickSpacing(ctx sdk.Context, params types.Params, tickSp
 }
 
 // validateTickSpacingUpdate returns true if the given tick spacing is one of the authorized tick spacings set in the
-// params and is less than the current tick spacing. False otherwise.
+// params and differs from the current tick spacing. False otherwise.
 func (k Keeper) validateTickSpacingUpdate(ctx sdk.Context, pool types.ConcentratedPoolExtension, params types.Params, newTickSpacing uint64) bool {
 	currentTickSpacing := pool.GetTickSpacing()
 	for _, authorizedTick := range params.AuthorizedTickSpacing {
-		// New tick spacing must be one of the authorized tick spacings and must be less than the current tick spacing
-		if newTickSpacing == authorizedTick && newTickSpacing < currentTickSpacing {
+		// New tick spacing must be one of the authorized tick spacings and must differ from the current tick spacing
+		if newTickSpacing == authorizedTick && newTickSpacing != currentTickSpacing {
 			return true
 		}
 	}
@@ -3,6 +3,7 @@ package concentrated_liquidity_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -492,6 +493,330 @@ func (s *KeeperTestSuite) TestDecreaseConcentratedPoolTickSpacing() {
 	}
 }
 
+func (s *KeeperTestSuite) TestUpdateConcentratedPoolTickSpacing() {
+	type positionRange struct {
+		lowerTick int64
+		upperTick int64
+	}
+
+	// Positions set up in addition to the default position, which is divisible by every authorized tick spacing.
+	inRangePosition := positionRange{lowerTick: DefaultLowerTick + 5, upperTick: DefaultUpperTick - 5}
+	aboveRangePosition := positionRange{lowerTick: DefaultUpperTick + 15, upperTick: DefaultUpperTick + 1005}
+
+	tests := []struct {
+		name                   string
+		initialTickSpacing     uint64
+		newTickSpacing         uint64
+		positions              []positionRange
+		expectedPositionRanges []positionRange
+		expectedErr            error
+	}{
+		{
+			name:                   "increase tick spacing 1 -> 10: positions snapped outward",
+			initialTickSpacing:     1,
+			newTickSpacing:         10,
+			positions:              []positionRange{inRangePosition, aboveRangePosition},
+			expectedPositionRanges: []positionRange{{DefaultLowerTick, DefaultUpperTick}, {DefaultUpperTick + 10, DefaultUpperTick + 1010}},
+		},
+		{
+			name:                   "increase tick spacing 1 -> 1000: positions snapped outward",
+			initialTickSpacing:     1,
+			newTickSpacing:         1000,
+			positions:              []positionRange{inRangePosition, aboveRangePosition},
+			expectedPositionRanges: []positionRange{{DefaultLowerTick, DefaultUpperTick}, {DefaultUpperTick, DefaultUpperTick + 2000}},
+		},
+		{
+			name:                   "increase tick spacing with aligned positions: positions untouched",
+			initialTickSpacing:     10,
+			newTickSpacing:         100,
+			positions:              []positionRange{{DefaultLowerTick - 100, DefaultUpperTick + 100}},
+			expectedPositionRanges: []positionRange{{DefaultLowerTick - 100, DefaultUpperTick + 100}},
+		},
+		{
+			name:                   "decrease tick spacing 100 -> 10: positions untouched",
+			initialTickSpacing:     100,
+			newTickSpacing:         10,
+			positions:              []positionRange{{DefaultLowerTick - 100, DefaultUpperTick + 100}},
+			expectedPositionRanges: []positionRange{{DefaultLowerTick - 100, DefaultUpperTick + 100}},
+		},
+		{
+			name:               "error: new tick spacing not authorized",
+			initialTickSpacing: 1,
+			newTickSpacing:     50,
+			expectedErr:        fmt.Errorf("tick spacing %d is not valid", 50),
+		},
+		{
+			name:               "error: new tick spacing equal to current",
+			initialTickSpacing: 1,
+			newTickSpacing:     1,
+			expectedErr:        fmt.Errorf("tick spacing %d is not valid", 1),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			owner := s.TestAccs[1]
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, test.initialTickSpacing, DefaultSpreadFactor)
+			s.SetupDefaultPosition(pool.GetId())
+
+			positionIds := make([]uint64, len(test.positions))
+			for i, position := range test.positions {
+				_, positionIds[i] = s.SetupPosition(pool.GetId(), owner, DefaultCoins, position.lowerTick, position.upperTick, false)
+			}
+
+			// Accrue spread rewards and incentives to the positions.
+			incentiveCoin := sdk.NewCoin(USDC, sdk.NewInt(1_000_000))
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(incentiveCoin))
+			_, err := s.clk.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[0], s.TestAccs[0], incentiveCoin, sdk.OneDec(), s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+			s.Require().NoError(err)
+			s.swapAtCurrentSpreadFactor(pool.GetId(), sdk.NewCoin(ETH, sdk.NewInt(100_000)))
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+
+			pool, err = s.clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			expectedSqrtPrice := pool.GetCurrentSqrtPrice()
+
+			expectedSpreadRewards := make([]sdk.Coins, len(positionIds))
+			expectedIncentives := make([]sdk.Coins, len(positionIds))
+			positionAssets := make([]sdk.Coins, len(positionIds))
+			for i, positionId := range positionIds {
+				expectedSpreadRewards[i], err = s.clk.GetClaimableSpreadRewards(s.Ctx, positionId)
+				s.Require().NoError(err)
+				expectedIncentives[i], _, err = s.clk.GetClaimableIncentives(s.Ctx, positionId)
+				s.Require().NoError(err)
+				positionAssets[i] = s.getPositionAssets(positionId)
+			}
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			// System under test
+			proposal := &types.TickSpacingUpdateProposal{
+				Title:                      "title",
+				Description:                "description",
+				PoolIdToTickSpacingRecords: []types.PoolIdToTickSpacingRecord{{PoolId: pool.GetId(), NewTickSpacing: test.newTickSpacing}},
+			}
+			err = cl.NewConcentratedLiquidityProposalHandler(*s.clk)(s.Ctx, proposal)
+			if test.expectedErr != nil {
+				s.Require().ErrorContains(err, test.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			pool, err = s.clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(test.newTickSpacing, pool.GetTickSpacing())
+			s.Require().Equal(expectedSqrtPrice, pool.GetCurrentSqrtPrice())
+
+			// The positions are snapped outward, keep their rewards, and the tokens that do not fit into the new range are
+			// returned to the owner.
+			activeLiquidity := sdk.ZeroDec()
+			totalWithdrawn := sdk.NewCoins()
+			for i, positionId := range positionIds {
+				position, err := s.clk.GetPosition(s.Ctx, positionId)
+				s.Require().NoError(err)
+				s.Require().Equal(test.expectedPositionRanges[i].lowerTick, position.LowerTick)
+				s.Require().Equal(test.expectedPositionRanges[i].upperTick, position.UpperTick)
+
+				spreadRewards, err := s.clk.GetClaimableSpreadRewards(s.Ctx, positionId)
+				s.Require().NoError(err)
+				s.Require().Equal(expectedSpreadRewards[i], spreadRewards)
+				incentives, _, err := s.clk.GetClaimableIncentives(s.Ctx, positionId)
+				s.Require().NoError(err)
+				s.Require().Equal(expectedIncentives[i], incentives)
+
+				if position.LowerTick <= pool.GetCurrentTick() && pool.GetCurrentTick() < position.UpperTick {
+					activeLiquidity = activeLiquidity.Add(position.Liquidity)
+				}
+				totalWithdrawn = totalWithdrawn.Add(positionAssets[i].Sub(s.getPositionAssets(positionId))...)
+			}
+
+			// The amounts required by the new range are rounded up in favor of the pool, so the refund may fall short of
+			// the amounts withdrawn from the positions by at most one unit per position.
+			totalRefund := s.App.BankKeeper.GetAllBalances(s.Ctx, owner).Sub(ownerBalanceBefore)
+			s.Require().True(totalRefund.IsAllLTE(totalWithdrawn))
+			for _, coin := range totalWithdrawn {
+				s.Require().True(coin.Amount.Sub(totalRefund.AmountOf(coin.Denom)).LTE(sdk.NewInt(int64(len(positionIds)))))
+			}
+
+			// The default position is divisible by every tick spacing and is always active.
+			defaultPosition, err := s.clk.GetPosition(s.Ctx, DefaultPositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(DefaultLowerTick, defaultPosition.LowerTick)
+			s.Require().Equal(DefaultUpperTick, defaultPosition.UpperTick)
+			activeLiquidity = activeLiquidity.Add(defaultPosition.Liquidity)
+			s.Require().Equal(activeLiquidity, pool.GetLiquidity())
+
+			// Only the ticks divisible by the new tick spacing remain initialized.
+			ticks, err := s.clk.GetAllInitializedTicksForPoolWithoutPoolId(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			for _, tick := range ticks {
+				s.Require().Zero(tick.TickIndex%int64(test.newTickSpacing), "tick %d", tick.TickIndex)
+			}
+
+			s.assertGlobalInvariants()
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMigratePendingTickSpacings() {
+	s.SetupTest()
+	owner := s.TestAccs[1]
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, 1, DefaultSpreadFactor)
+	s.SetupDefaultPosition(pool.GetId())
+	oldLowerTick, oldUpperTick := DefaultLowerTick+5, DefaultUpperTick-5
+
+	// A position with an active underlying lock cannot be migrated.
+	liquidity, lockedPositionId := s.SetupPosition(pool.GetId(), owner, DefaultCoins, oldLowerTick, oldUpperTick, false)
+	lockCoins := sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(1_000)))
+	s.FundAcc(owner, lockCoins)
+	lock, err := s.App.LockupKeeper.CreateLock(s.Ctx, owner, lockCoins, time.Hour)
+	s.Require().NoError(err)
+	lockedPosition, err := s.clk.GetPosition(s.Ctx, lockedPositionId)
+	s.Require().NoError(err)
+	s.Require().NoError(s.clk.SetPosition(s.Ctx, pool.GetId(), owner, oldLowerTick, oldUpperTick, lockedPosition.JoinTime, liquidity, lockedPositionId, lock.ID))
+
+	// Enough positions so that the migration does not fit into a single block.
+	positionIds := []uint64{}
+	for i := 0; i < types.MaxTickSpacingMigrationPositionsPerBlock; i++ {
+		_, positionId := s.SetupPosition(pool.GetId(), owner, DefaultCoins, oldLowerTick, oldUpperTick, false)
+		positionIds = append(positionIds, positionId)
+	}
+	lastPositionId := positionIds[len(positionIds)-1]
+
+	// The proposal succeeds despite the locked position and migrates the first batch of positions.
+	proposal := &types.TickSpacingUpdateProposal{
+		Title:                      "title",
+		Description:                "description",
+		PoolIdToTickSpacingRecords: []types.PoolIdToTickSpacingRecord{{PoolId: pool.GetId(), NewTickSpacing: 10}},
+	}
+	s.Require().NoError(cl.NewConcentratedLiquidityProposalHandler(*s.clk)(s.Ctx, proposal))
+
+	cursor, found := s.clk.GetTickSpacingMigrationCursor(s.Ctx, pool.GetId())
+	s.Require().True(found)
+	// Position ids start at one, so the first batch ends at the position with the id equal to its size.
+	s.Require().Equal(uint64(types.MaxTickSpacingMigrationPositionsPerBlock), cursor)
+	s.assertPositionTicks(positionIds[0], DefaultLowerTick, DefaultUpperTick)
+	s.assertPositionTicks(lastPositionId, oldLowerTick, oldUpperTick)
+
+	// System under test
+	s.clk.MigratePendingTickSpacings(s.Ctx)
+
+	_, found = s.clk.GetTickSpacingMigrationCursor(s.Ctx, pool.GetId())
+	s.Require().False(found)
+	for _, positionId := range positionIds {
+		s.assertPositionTicks(positionId, DefaultLowerTick, DefaultUpperTick)
+	}
+
+	// The locked position is left at its old ticks, which remain initialized. All other ticks that are not divisible
+	// by the new tick spacing are removed.
+	s.assertPositionTicks(lockedPositionId, oldLowerTick, oldUpperTick)
+	ticks, err := s.clk.GetAllInitializedTicksForPoolWithoutPoolId(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	for _, tick := range ticks {
+		if tick.TickIndex == oldLowerTick || tick.TickIndex == oldUpperTick {
+			s.Require().Equal(liquidity, tick.Info.LiquidityGross)
+			continue
+		}
+		s.Require().Zero(tick.TickIndex%10, "tick %d", tick.TickIndex)
+	}
+
+	// Once its lock matured, the position left behind can be withdrawn.
+	_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, lock.ID, nil)
+	s.Require().NoError(err)
+	s.AddBlockTime(time.Hour + time.Second)
+	s.assertGlobalInvariants()
+}
+
+// assertPositionTicks asserts that the given position spans the given ticks.
+func (s *KeeperTestSuite) assertPositionTicks(positionId uint64, lowerTick, upperTick int64) {
+	position, err := s.clk.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(lowerTick, position.LowerTick)
+	s.Require().Equal(upperTick, position.UpperTick)
+}
+
+// getPositionAssets returns the assets underlying the given position.
+func (s *KeeperTestSuite) getPositionAssets(positionId uint64) sdk.Coins {
+	position, err := s.clk.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	pool, err := s.clk.GetConcentratedPoolById(s.Ctx, position.PoolId)
+	s.Require().NoError(err)
+	asset0, asset1, err := cl.CalculateUnderlyingAssetsFromPosition(s.Ctx, position, pool)
+	s.Require().NoError(err)
+	return sdk.NewCoins(asset0, asset1)
+}
+
+func (s *KeeperTestSuite) TestChangeConcentratedPoolSpreadFactor() {
+	oldSpreadFactor := sdk.MustNewDecFromStr("0.003")
+	newSpreadFactor := sdk.MustNewDecFromStr("0.0005")
+
+	tests := []struct {
+		name                        string
+		poolIdToSpreadFactorRecords []types.PoolIdToSpreadFactorRecord
+		expectedErr                 error
+	}{
+		{
+			name:                        "happy path: spread factor 0.003 -> 0.0005",
+			poolIdToSpreadFactorRecords: []types.PoolIdToSpreadFactorRecord{{PoolId: 1, NewSpreadFactor: newSpreadFactor}},
+		},
+		{
+			name:                        "error: new spread factor not authorized",
+			poolIdToSpreadFactorRecords: []types.PoolIdToSpreadFactorRecord{{PoolId: 1, NewSpreadFactor: sdk.MustNewDecFromStr("0.004")}},
+			expectedErr:                 types.UnauthorizedSpreadFactorError{ProvidedSpreadFactor: sdk.MustNewDecFromStr("0.004"), AuthorizedSpreadFactors: types.DefaultParams().AuthorizedSpreadFactors},
+		},
+		{
+			name:                        "error: new spread factor equal to current",
+			poolIdToSpreadFactorRecords: []types.PoolIdToSpreadFactorRecord{{PoolId: 1, NewSpreadFactor: oldSpreadFactor}},
+			expectedErr:                 types.SpreadFactorUnchangedError{PoolId: 1, SpreadFactor: oldSpreadFactor},
+		},
+		{
+			name:                        "error: pool does not exist",
+			poolIdToSpreadFactorRecords: []types.PoolIdToSpreadFactorRecord{{PoolId: 2, NewSpreadFactor: newSpreadFactor}},
+			expectedErr:                 types.PoolNotFoundError{PoolId: 2},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, oldSpreadFactor)
+			s.SetupDefaultPosition(pool.GetId())
+
+			// Swap at the old spread factor.
+			tokenIn := sdk.NewCoin(ETH, sdk.NewInt(100_000))
+			s.swapAtCurrentSpreadFactor(pool.GetId(), tokenIn)
+
+			// System under test
+			proposal := &types.SpreadFactorChangeProposal{
+				Title:                       "title",
+				Description:                 "description",
+				PoolIdToSpreadFactorRecords: test.poolIdToSpreadFactorRecords,
+			}
+			err := cl.NewConcentratedLiquidityProposalHandler(*s.clk)(s.Ctx, proposal)
+			if test.expectedErr != nil {
+				s.Require().ErrorContains(err, test.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			pool, err = s.clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(newSpreadFactor, pool.GetSpreadFactor(s.Ctx))
+
+			// Swap at the new spread factor.
+			s.swapAtCurrentSpreadFactor(pool.GetId(), tokenIn)
+
+			// Spread rewards charged before the change are kept at the old spread factor, and only the swap after
+			// the change is charged at the new one. Spread reward growth is rounded down in favor of the pool.
+			expectedSpreadRewards := tokenIn.Amount.ToDec().Mul(oldSpreadFactor.Add(newSpreadFactor)).TruncateInt()
+			claimableSpreadRewards, err := s.clk.GetClaimableSpreadRewards(s.Ctx, 1)
+			s.Require().NoError(err)
+			s.Require().True(claimableSpreadRewards.AmountOf(ETH).LTE(expectedSpreadRewards))
+			s.Require().True(claimableSpreadRewards.AmountOf(ETH).GTE(expectedSpreadRewards.SubRaw(1)))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGetTotalPoolLiquidity() {
 	var (
 		defaultPoolCoinOne = sdk.NewCoin(USDC, sdk.OneInt())
@@ -585,10 +910,20 @@ func (s *KeeperTestSuite) TestValidateTickSpacingUpdate() {
 			expectedValidationResult: false,
 		},
 		{
-			name:                     "validation fail: try increasing tick spacing",
+			name:                     "happy case: increase tick spacing to larger tick",
+			newTickSpacing:           1000,
+			expectedValidationResult: true,
+		},
+		{
+			name:                     "validation fail: try increasing to unauthorized tick spacing",
 			newTickSpacing:           500,
 			expectedValidationResult: false,
 		},
+		{
+			name:                     "validation fail: tick spacing equal to current",
+			newTickSpacing:           DefaultTickSpacing,
+			expectedValidationResult: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -610,3 +945,16 @@ func (s *KeeperTestSuite) TestValidateTickSpacingUpdate() {
 		})
 	}
 }
+
+// swapAtCurrentSpreadFactor swaps tokenIn for the other token of the given pool at the pool's current spread factor.
+func (s *KeeperTestSuite) swapAtCurrentSpreadFactor(poolId uint64, tokenIn sdk.Coin) {
+	pool, err := s.clk.GetPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	tokenOutDenom := pool.GetToken1()
+	if tokenIn.Denom == tokenOutDenom {
+		tokenOutDenom = pool.GetToken0()
+	}
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+	_, err = s.clk.SwapExactAmountIn(s.Ctx, s.TestAccs[1], pool, tokenIn, tokenOutDenom, sdk.ZeroInt(), pool.GetSpreadFactor(s.Ctx))
+	s.Require().NoError(err)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
@@ -99,6 +100,37 @@ func (k Keeper) HasAnyPositionForPool(ctx sdk.Context, poolId uint64) (bool, err
 	return osmoutils.HasAnyAtPrefix(store, poolPositionKey, parse)
 }
 
+// getPositionIdsForPool returns the IDs of all positions in the given pool in ascending order.
+func (k Keeper) getPositionIdsForPool(ctx sdk.Context, poolId uint64) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	poolPositionKey := types.KeyPoolPosition(poolId)
+	iterator := sdk.KVStorePrefixIterator(store, poolPositionKey)
+	defer iterator.Close()
+
+	positionIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		// The pool position key is followed by the separator and the big endian encoded position id.
+		positionIdBz := iterator.Key()[len(poolPositionKey)+len(types.KeySeparator):]
+		positionIds = append(positionIds, sdk.BigEndianToUint64(positionIdBz))
+	}
+	return positionIds
+}
+
+// getPositionIdsForPoolFrom returns the ids of up to limit positions of the given pool, in ascending order starting at
+// the given position id.
+func (k Keeper) getPositionIdsForPoolFrom(ctx sdk.Context, poolId uint64, startPositionId uint64, limit int) []uint64 {
+	poolPositionKey := types.KeyPoolPosition(poolId)
+	iterator := ctx.KVStore(k.storeKey).Iterator(types.KeyPoolPositionPositionId(poolId, startPositionId), sdk.PrefixEndBytes(poolPositionKey))
+	defer iterator.Close()
+
+	positionIds := []uint64{}
+	for ; iterator.Valid() && len(positionIds) < limit; iterator.Next() {
+		positionIdBz := iterator.Key()[len(poolPositionKey)+len(types.KeySeparator):]
+		positionIds = append(positionIds, sdk.BigEndianToUint64(positionIdBz))
+	}
+	return positionIds
+}
+
 // GetAllPositionsForPoolId gets all the position for a specific poolId and store prefix.
 func (k Keeper) GetAllPositionIdsForPoolId(ctx sdk.Context, prefix []byte, poolId uint64) ([]uint64, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -388,6 +420,115 @@ func (k Keeper) deletePosition(ctx sdk.Context,
 		store.Delete(lockIdPositionKey)
 	}
 
+	// Remove the auto-compound flag (if it exists)
+	store.Delete(types.KeyAutoCompoundPosition(positionId))
+
+	// Remove the operator approvals (if any)
+	if err := k.deletePositionOperatorApprovals(ctx, positionId); err != nil {
+		return err
+	}
+
+	// Remove the creation height (if it exists)
+	k.deletePositionCreationHeight(ctx, positionId)
+
+	return nil
+}
+
+// transferPositions transfers ownership of the given positions from sender to newOwner.
+// Prior to the transfer, any outstanding spread rewards and incentives are collected and sent to the sender.
+// Since the position ID, tick range, liquidity and join time are preserved, the position keeps accruing
+// uptime incentives as if it had never been moved.
+// Returns error if:
+// - duplicate position IDs are provided
+// - any of the positions does not exist
+// - sender is not the owner of any of the positions
+// - any of the positions has an active underlying lock
+// - collecting spread rewards or incentives fails
+func (k Keeper) transferPositions(ctx sdk.Context, positionIds []uint64, sender sdk.AccAddress, newOwner sdk.AccAddress) error {
+	if osmoutils.ContainsDuplicate(positionIds) {
+		return types.DuplicatePositionIdsError{PositionIds: positionIds}
+	}
+
+	for _, positionId := range positionIds {
+		position, err := k.GetPosition(ctx, positionId)
+		if err != nil {
+			return err
+		}
+
+		if position.Address != sender.String() {
+			return types.PositionOwnerMismatchError{PositionOwner: position.Address, Sender: sender.String()}
+		}
+
+		// Positions with an active underlying lock (superfluid staked or locked) cannot be transferred
+		// since the lock is owned by the sender. If the lock has matured, the link between the position
+		// and the lock is removed and the position can be transferred freely.
+		positionHasActiveUnderlyingLock, lockId, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+		if err != nil {
+			return err
+		}
+		if positionHasActiveUnderlyingLock {
+			return types.LockNotMatureError{PositionId: positionId, LockId: lockId}
+		}
+
+		// Collect any outstanding spread rewards and incentives for the sender so that
+		// the new owner only receives rewards accrued after the transfer.
+		if _, err := k.collectSpreadRewards(ctx, sender, positionId); err != nil {
+			return err
+		}
+		if _, _, err := k.collectIncentives(ctx, sender, positionId); err != nil {
+			return err
+		}
+
+		if err := k.setPositionOwner(ctx, position, newOwner); err != nil {
+			return err
+		}
+
+		// Auto-compounding and operators are opted into by the owner, so they do not carry over to the new owner.
+		k.setPositionAutoCompound(ctx, positionId, false)
+		if err := k.deletePositionOperatorApprovals(ctx, positionId); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTransferPositions,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner.String()),
+			sdk.NewAttribute(types.AttributeInputPositionIds, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(positionIds)), ","), "[]")),
+		),
+	})
+
+	return nil
+}
+
+// setPositionOwner rewrites the owner of the given position to newOwner.
+// It updates the position ID to position mapping and moves the
+// address-pool-position ID mapping from the old owner to the new owner.
+// All other state entries (ticks, accumulators, full range liquidity) are keyed by
+// position ID and are left untouched.
+// Returns error if the old owner's address-pool-position ID mapping does not exist.
+func (k Keeper) setPositionOwner(ctx sdk.Context, position model.Position, newOwner sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+
+	oldOwner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return err
+	}
+
+	oldAddressPoolIdPositionIdKey := types.KeyAddressPoolIdPositionId(oldOwner, position.PoolId, position.PositionId)
+	if !store.Has(oldAddressPoolIdPositionIdKey) {
+		return types.AddressPoolPositionIdNotFoundError{Owner: position.Address, PoolId: position.PoolId, PositionId: position.PositionId}
+	}
+	store.Delete(oldAddressPoolIdPositionIdKey)
+
+	position.Address = newOwner.String()
+	osmoutils.MustSet(store, types.KeyPositionId(position.PositionId), &position)
+
+	newAddressPoolIdPositionIdKey := types.KeyAddressPoolIdPositionId(newOwner, position.PoolId, position.PositionId)
+	store.Set(newAddressPoolIdPositionIdKey, []byte{1})
+
 	return nil
 }
 
@@ -421,6 +562,59 @@ func (k Keeper) CreateFullRangePosition(ctx sdk.Context, poolId uint64, owner sd
 	return positionId, amount0, amount1, liquidity, nil
 }
 
+// CreatePositionAroundPrice creates a concentrated liquidity position for the given pool ID, owner, and coins, whose range
+// spans from price * (1 - rangeRatio) to price * (1 + rangeRatio), rounded outwards to the pool's tick spacing and capped at
+// the min and max ticks. The price is the price of token 0 in terms of token 1.
+// The function returns the amounts of token 0 and token 1, and the liquidity created from the position.
+// Returns error if the price is not positive, or if the range ratio is not between zero and one exclusive.
+func (k Keeper) CreatePositionAroundPrice(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, coins sdk.Coins, price, rangeRatio sdk.Dec) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, err error) {
+	if !price.IsPositive() {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, fmt.Errorf("price (%s) must be positive", price)
+	}
+	if !rangeRatio.IsPositive() || rangeRatio.GTE(sdk.OneDec()) {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, fmt.Errorf("range ratio (%s) must be between zero and one exclusive", rangeRatio)
+	}
+
+	concentratedPool, err := k.GetConcentratedPoolById(ctx, poolId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	tickSpacing := concentratedPool.GetTickSpacing()
+
+	lowerTick, err := priceToTickRoundDownSpacing(price.Mul(sdk.OneDec().Sub(rangeRatio)), tickSpacing)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	upperTick, err := priceToTickRoundDownSpacing(price.Mul(sdk.OneDec().Add(rangeRatio)), tickSpacing)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	upperTick += int64(tickSpacing)
+
+	if lowerTick < types.MinTick {
+		lowerTick = types.MinTick
+	}
+	if upperTick > types.MaxTick {
+		upperTick = types.MaxTick
+	}
+
+	positionId, amount0, amount1, liquidity, _, _, err = k.createPosition(ctx, poolId, owner, coins, sdk.ZeroInt(), sdk.ZeroInt(), lowerTick, upperTick)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	return positionId, amount0, amount1, liquidity, nil
+}
+
+// priceToTickRoundDownSpacing converts the given price to its corresponding tick rounded down to the given tick spacing.
+func priceToTickRoundDownSpacing(price sdk.Dec, tickSpacing uint64) (int64, error) {
+	sqrtPrice, err := price.ApproxSqrt()
+	if err != nil {
+		return 0, err
+	}
+	return math.SqrtPriceToTickRoundDownSpacing(sqrtPrice, tickSpacing)
+}
+
 // CreateFullRangePositionLocked creates a full range (min to max tick) concentrated liquidity position for the given pool ID, owner, and coins.
 // CL shares are minted which represent the underlying liquidity and are locked for the given duration.
 // State entries are also created to map the position ID to the underlying lock ID.
@@ -562,92 +756,507 @@ func (k Keeper) fungifyChargedPosition(ctx sdk.Context, owner sdk.AccAddress, po
 	// The new position's timestamp is the current block time minus the fully charged duration.
 	joinTime := ctx.BlockTime().Add(-fullyChargedDuration)
 
-	// Get the next position ID and increment the global counter.
-	newPositionId := k.getNextPositionIdAndIncrement(ctx)
+	newPositionIds, err := k.replacePositions(ctx, owner, poolId, lowerTick, upperTick, positionIds, []sdk.Dec{combinedLiquidityOfAllPositions}, joinTime)
+	if err != nil {
+		return 0, err
+	}
+	newPositionId := newPositionIds[0]
 
-	// Update pool uptime accumulators to now.
-	if err := k.updatePoolUptimeAccumulatorsToNow(ctx, poolId); err != nil {
+	// Query claimable incentives for events.
+	claimableIncentives, _, err := k.GetClaimableIncentives(ctx, newPositionId)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtFungifyChargedPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeInputPositionIds, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(positionIds)), ","), "[]")),
+			sdk.NewAttribute(types.AttributeOutputPositionId, strconv.FormatUint(newPositionId, 10)),
+			sdk.NewAttribute(types.AttributeClaimableIncentives, claimableIncentives.String()),
+		),
+	})
+
+	return newPositionId, nil
+}
+
+// splitPosition divides the given position into two new positions over the same pool and tick range.
+// The second new position receives the given liquidity and the first one keeps the rest.
+// Both new positions keep the join time of the original position, so they remain as charged as it was.
+// Both new positions also keep the auto-compound flag and the operator approvals of the original position.
+// The unclaimed spread rewards and incentives of the original position are moved to the first new position,
+// and the original position is deleted. No tokens move in or out of the pool.
+// Returns the IDs of the two new positions.
+// Returns error if:
+// - the position does not exist
+// - the sender is not the owner of the position
+// - the position has an active underlying lock
+// - the given liquidity is not positive or is not less than the liquidity of the position
+func (k Keeper) splitPosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, liquidity sdk.Dec) (uint64, uint64, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if position.Address != sender.String() {
+		return 0, 0, types.NotPositionOwnerError{PositionId: positionId, Address: sender.String()}
+	}
+
+	positionHasActiveUnderlyingLock, lockId, err := k.PositionHasActiveUnderlyingLock(ctx, positionId)
+	if err != nil {
+		return 0, 0, err
+	}
+	if positionHasActiveUnderlyingLock {
+		return 0, 0, types.LockNotMatureError{PositionId: positionId, LockId: lockId}
+	}
+
+	if !liquidity.IsPositive() || liquidity.GTE(position.Liquidity) {
+		return 0, 0, types.InvalidSplitLiquidityError{PositionId: positionId, Liquidity: liquidity, PositionLiquidity: position.Liquidity}
+	}
+
+	// Both new positions keep compounding if the original position did.
+	autoCompound := k.isPositionAutoCompound(ctx, positionId)
+
+	// The operator approvals of the position are removed with it, so they are passed on to both new positions.
+	operatorApprovals, err := k.getPositionOperatorApprovals(ctx, positionId)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	newPositionIds, err := k.replacePositions(ctx, sender, position.PoolId, position.LowerTick, position.UpperTick, []uint64{positionId}, []sdk.Dec{position.Liquidity.Sub(liquidity), liquidity}, position.JoinTime)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, newPositionId := range newPositionIds {
+		if autoCompound {
+			k.setPositionAutoCompound(ctx, newPositionId, true)
+		}
+		k.setPositionOperatorApprovals(ctx, newPositionId, operatorApprovals)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSplitPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeOutputPositionIds, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(newPositionIds)), ","), "[]")),
+			sdk.NewAttribute(types.AttributeLiquidity, liquidity.String()),
+		),
+	})
+
+	return newPositionIds[0], newPositionIds[1], nil
+}
+
+// mergePositions combines the given positions into a single new position over the same pool and tick range
+// whose liquidity is the sum of the liquidities of the given positions.
+// Unlike fungifyChargedPosition, the positions do not need to be fully charged. Instead, their join times must be
+// compatible: once capped at the fully charged duration before the current block time, they must all be equal.
+// That is, the positions are either all fully charged or joined at the same time, so the merged position is
+// never more or less charged than any of the positions it replaces.
+// The unclaimed spread rewards and incentives of all the given positions are moved to the new position, and the
+// given positions are deleted. No tokens move in or out of the pool.
+// The new position keeps the auto-compound flag and the operator approvals that all the given positions have in
+// common, so that merging never extends an operator's approval to liquidity it was not approved for.
+// Returns the ID of the new position.
+// Returns error if:
+// - fewer than two or duplicate position IDs are given
+// - any of the positions does not exist
+// - the sender is not the owner of all the positions
+// - any of the positions has an active underlying lock
+// - the positions are not in the same pool and tick range
+// - the join times of the positions are not compatible
+func (k Keeper) mergePositions(ctx sdk.Context, sender sdk.AccAddress, positionIds []uint64) (uint64, error) {
+	if osmoutils.ContainsDuplicate(positionIds) {
+		return 0, types.DuplicatePositionIdsError{PositionIds: positionIds}
+	}
+
+	// Merging does not require the positions to be fully charged, so no minimum charge duration is enforced here.
+	poolId, lowerTick, upperTick, combinedLiquidityOfAllPositions, err := k.validatePositionsAndGetTotalLiquidity(ctx, sender, positionIds, 0)
+	if err != nil {
 		return 0, err
 	}
 
-	// Create the new position in the pool based on the provided tick range and liquidity delta.
-	// This also initializes the spread reward accumulator and the uptime accumulators for the new position.
-	_, _, err = k.UpdatePosition(ctx, poolId, owner, lowerTick, upperTick, combinedLiquidityOfAllPositions, joinTime, newPositionId)
+	joinTime, err := k.getCompatibleJoinTime(ctx, positionIds)
 	if err != nil {
 		return 0, err
 	}
 
-	// Get the new position's uptime accum name and the pool's uptime accumulators.
-	newPositionUptimeAccName := string(types.KeyPositionId(newPositionId))
-	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, poolId)
+	// The merged position keeps compounding only if every merged position did.
+	autoCompound := true
+	for _, positionId := range positionIds {
+		autoCompound = autoCompound && k.isPositionAutoCompound(ctx, positionId)
+	}
+
+	// The merged position keeps the operators approved for every merged position.
+	operatorApprovals, err := k.getCommonPositionOperatorApprovals(ctx, positionIds)
 	if err != nil {
 		return 0, err
 	}
 
-	// Get the new position's spread reward accum name and the pool's spread reward accumulator.
-	newPositionSpreadRewardAccName := types.KeySpreadRewardPositionAccumulator(newPositionId)
-	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, poolId)
+	newPositionIds, err := k.replacePositions(ctx, sender, poolId, lowerTick, upperTick, positionIds, []sdk.Dec{combinedLiquidityOfAllPositions}, joinTime)
 	if err != nil {
 		return 0, err
 	}
+	newPositionId := newPositionIds[0]
+
+	if autoCompound {
+		k.setPositionAutoCompound(ctx, newPositionId, true)
+	}
+	k.setPositionOperatorApprovals(ctx, newPositionId, operatorApprovals)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtMergePositions,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeInputPositionIds, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(positionIds)), ","), "[]")),
+			sdk.NewAttribute(types.AttributeOutputPositionId, strconv.FormatUint(newPositionId, 10)),
+		),
+	})
+
+	return newPositionId, nil
+}
+
+// getCompatibleJoinTime returns the join time of the position that results from merging the given positions.
+// Each join time is capped at the fully charged duration before the current block time, since a position
+// cannot become more charged than that. All capped join times must be equal.
+// Returns error if any of the positions does not exist or the capped join times differ.
+func (k Keeper) getCompatibleJoinTime(ctx sdk.Context, positionIds []uint64) (time.Time, error) {
+	fullyChargedJoinTime := ctx.BlockTime().Add(-k.getLargestAuthorizedUptimeDuration(ctx))
+
+	var joinTime time.Time
+	for i, positionId := range positionIds {
+		position, err := k.GetPosition(ctx, positionId)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		positionJoinTime := position.JoinTime
+		if positionJoinTime.Before(fullyChargedJoinTime) {
+			positionJoinTime = fullyChargedJoinTime
+		}
+
+		if i == 0 {
+			joinTime = positionJoinTime
+		} else if !positionJoinTime.Equal(joinTime) {
+			return time.Time{}, types.IncompatibleJoinTimesError{PositionId: positionId, JoinTime: positionJoinTime, ExpectedJoinTime: joinTime}
+		}
+	}
+	return joinTime, nil
+}
+
+// replacePositions deletes the given old positions and creates new positions owned by owner over the same pool and
+// tick range, with the given liquidities and join time. The old and new positions must hold the same total liquidity,
+// which is why the ticks and the pool's active liquidity are left untouched.
+// The unclaimed spread rewards and incentives of all old positions are moved to the first new position, while their
+// performance records are divided between the new positions in proportion to their liquidities.
+// Returns the IDs of the new positions in the order of the given liquidities.
+func (k Keeper) replacePositions(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, lowerTick, upperTick int64, oldPositionIds []uint64, newLiquidities []sdk.Dec, joinTime time.Time) ([]uint64, error) {
+	// Update pool uptime accumulators to now.
+	if err := k.updatePoolUptimeAccumulatorsToNow(ctx, poolId); err != nil {
+		return nil, err
+	}
+
+	// Create the new positions and initialize their spread reward and uptime accumulator records.
+	// The liquidity is already accounted for in the ticks and the pool by the old positions.
+	newPositionIds := make([]uint64, len(newLiquidities))
+	for i, liquidity := range newLiquidities {
+		newPositionIds[i] = k.getNextPositionIdAndIncrement(ctx)
+		if err := k.initOrUpdatePosition(ctx, poolId, owner, lowerTick, upperTick, liquidity, joinTime, newPositionIds[i]); err != nil {
+			return nil, err
+		}
+		if err := k.initOrUpdatePositionSpreadRewardAccumulator(ctx, poolId, lowerTick, upperTick, newPositionIds[i], liquidity); err != nil {
+			return nil, err
+		}
+	}
+
+	// Get the first new position's uptime accum name and the pool's uptime accumulators.
+	newPositionUptimeAccName := string(types.KeyPositionId(newPositionIds[0]))
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the first new position's spread reward accum name and the pool's spread reward accumulator.
+	newPositionSpreadRewardAccName := types.KeySpreadRewardPositionAccumulator(newPositionIds[0])
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
 
 	// Compute uptime growth outside of the range between lower tick and upper tick
 	uptimeGrowthOutside, err := k.GetUptimeGrowthOutsideRange(ctx, poolId, lowerTick, upperTick)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	// Compute the spread reward growth outside of the range between lower tick and upper tick
 	spreadRewardGrowthOutside, err := k.getSpreadRewardGrowthOutside(ctx, poolId, lowerTick, upperTick)
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+
+	// The new positions inherit the most recent creation height of the old positions, if any, so that splitting or
+	// merging positions does not cut their JIT protection window short.
+	latestCreationHeight, hasCreationHeight := int64(0), false
+	for _, oldPositionId := range oldPositionIds {
+		if creationHeight, found := k.getPositionCreationHeight(ctx, oldPositionId); found && (!hasCreationHeight || creationHeight > latestCreationHeight) {
+			latestCreationHeight, hasCreationHeight = creationHeight, true
+		}
+	}
+	if hasCreationHeight {
+		for _, newPositionId := range newPositionIds {
+			k.setPositionCreationHeight(ctx, newPositionId, latestCreationHeight)
+		}
 	}
 
-	// Move unclaimed rewards from the old positions to the new position.
+	// Move unclaimed rewards from the old positions to the first new position.
 	// Also, delete the old positions from state.
 
 	// Loop through each of the old position IDs.
-	for _, oldPositionId := range positionIds {
+	for _, oldPositionId := range oldPositionIds {
 		// Loop through each uptime accumulator for the pool.
 		for uptimeIndex, uptimeAccum := range uptimeAccumulators {
 			// Move rewards into the new uptime accumulator and delete the old uptime accumulator.
 			oldPositionName := string(types.KeyPositionId(oldPositionId))
 			if err := moveRewardsToNewPositionAndDeleteOldAcc(uptimeAccum, oldPositionName, newPositionUptimeAccName, uptimeGrowthOutside[uptimeIndex]); err != nil {
-				return 0, err
+				return nil, err
 			}
 		}
 
 		// Move spread rewards into the new spread reward accumulator and delete the old spread reward accumulator.
 		oldPositionSpreadRewardName := types.KeySpreadRewardPositionAccumulator(oldPositionId)
 		if err := moveRewardsToNewPositionAndDeleteOldAcc(spreadRewardAccumulator, oldPositionSpreadRewardName, newPositionSpreadRewardAccName, spreadRewardGrowthOutside); err != nil {
-			return 0, err
+			return nil, err
 		}
 
 		// Remove the old position from state.
-		err = k.deletePosition(ctx, oldPositionId, owner, poolId)
+		if err := k.deletePosition(ctx, oldPositionId, owner, poolId); err != nil {
+			return nil, err
+		}
+	}
+
+	k.inheritPositionPerformance(ctx, oldPositionIds, newPositionIds, newLiquidities)
+
+	return newPositionIds, nil
+}
+
+// migratePositionToTickSpacing moves the ticks of the given position outward to the nearest ticks that are divisible by
+// the given tick spacing. Positions whose ticks are already divisible are left untouched.
+// The position keeps its ID and join time, so it remains as charged as it was. Since a wider range requires more tokens
+// for the same liquidity, the liquidity of the position is recomputed from the amounts it held over its old range, and
+// the amounts that do not fit into the new range are returned to the owner. Prior to moving the range, the unclaimed
+// spread rewards and incentives accrued over the old range are settled in the position's accumulator records, which
+// are then reset to the growth inside the new range.
+// Positions with an active underlying lock are left at their old ticks, since their liquidity cannot be changed while
+// locked. Their ticks remain initialized until they are withdrawn.
+// The old ticks of a migrated position are removed from state once no other position refers to them, so swaps no
+// longer cross them.
+// CONTRACT: the pool uptime accumulators are updated to the current block time.
+// Returns error if the position does not exist.
+func (k Keeper) migratePositionToTickSpacing(ctx sdk.Context, positionId uint64, tickSpacing uint64) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	oldLowerTick, oldUpperTick := position.LowerTick, position.UpperTick
+	if oldLowerTick%int64(tickSpacing) == 0 && oldUpperTick%int64(tickSpacing) == 0 {
+		return nil
+	}
+
+	positionHasActiveUnderlyingLock, _, err := k.PositionHasActiveUnderlyingLock(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if positionHasActiveUnderlyingLock {
+		return nil
+	}
+
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return err
+	}
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+	poolId := pool.GetId()
+
+	// Snap the ticks outward to the nearest divisible ticks, and then to the canonical ticks of their prices.
+	newLowerTick, newUpperTick := roundTickDownToTickSpacing(oldLowerTick, tickSpacing), roundTickUpToTickSpacing(oldUpperTick, tickSpacing)
+	_, _, sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(newLowerTick, newUpperTick)
+	if err != nil {
+		return err
+	}
+	newLowerTick, newUpperTick, err = roundTickToCanonicalPriceTick(newLowerTick, newUpperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, tickSpacing)
+	if err != nil {
+		return err
+	}
+	_, _, sqrtPriceLowerTick, sqrtPriceUpperTick, err = math.TicksToSqrtPrice(newLowerTick, newUpperTick)
+	if err != nil {
+		return err
+	}
+
+	// The amounts held by the position over the old range, rounded down as on withdrawal.
+	oldAmount0, oldAmount1, err := pool.CalcActualAmounts(ctx, oldLowerTick, oldUpperTick, position.Liquidity.Neg())
+	if err != nil {
+		return err
+	}
+	amount0, amount1 := oldAmount0.Abs().TruncateInt(), oldAmount1.Abs().TruncateInt()
+
+	newLiquidity := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0, amount1)
+	if !newLiquidity.IsPositive() {
+		// The position is too small to hold any liquidity over the new range, so it is withdrawn entirely instead.
+		_, _, err := k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
+		return err
+	}
+
+	// Settle the rewards accrued over the old range and remove the position's accumulator records.
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	spreadRewardGrowthOutside, err := k.getSpreadRewardGrowthOutside(ctx, poolId, oldLowerTick, oldUpperTick)
+	if err != nil {
+		return err
+	}
+	uptimeGrowthOutside, err := k.GetUptimeGrowthOutsideRange(ctx, poolId, oldLowerTick, oldUpperTick)
+	if err != nil {
+		return err
+	}
+
+	spreadRewardPositionName := types.KeySpreadRewardPositionAccumulator(positionId)
+	unclaimedSpreadRewards, err := settleAndDeletePositionAccumulator(spreadRewardAccumulator, spreadRewardPositionName, spreadRewardGrowthOutside)
+	if err != nil {
+		return err
+	}
+	uptimePositionName := string(types.KeyPositionId(positionId))
+	unclaimedIncentives := make([]sdk.DecCoins, len(uptimeAccumulators))
+	for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+		unclaimedIncentives[uptimeIndex], err = settleAndDeletePositionAccumulator(uptimeAccum, uptimePositionName, uptimeGrowthOutside[uptimeIndex])
 		if err != nil {
-			return 0, err
+			return err
 		}
 	}
 
-	// Query claimable incentives for events.
-	claimableIncentives, _, err := k.GetClaimableIncentives(ctx, newPositionId)
+	// Move the liquidity from the old ticks to the new ones.
+	currentTick := pool.GetCurrentTick()
+	if err := k.initOrUpdateTick(ctx, poolId, currentTick, oldLowerTick, position.Liquidity.Neg(), false); err != nil {
+		return err
+	}
+	if err := k.initOrUpdateTick(ctx, poolId, currentTick, oldUpperTick, position.Liquidity.Neg(), true); err != nil {
+		return err
+	}
+	if err := k.initOrUpdateTick(ctx, poolId, currentTick, newLowerTick, newLiquidity, false); err != nil {
+		return err
+	}
+	if err := k.initOrUpdateTick(ctx, poolId, currentTick, newUpperTick, newLiquidity, true); err != nil {
+		return err
+	}
+	if err := k.removeTickIfEmpty(ctx, poolId, oldLowerTick); err != nil {
+		return err
+	}
+	if err := k.removeTickIfEmpty(ctx, poolId, oldUpperTick); err != nil {
+		return err
+	}
+
+	// Refetch the pool since initializing ticks may have updated it.
+	pool, err = k.getPoolById(ctx, poolId)
 	if err != nil {
-		return 0, err
+		return err
+	}
+	pool.UpdateLiquidityIfActivePosition(ctx, oldLowerTick, oldUpperTick, position.Liquidity.Neg())
+	pool.UpdateLiquidityIfActivePosition(ctx, newLowerTick, newUpperTick, newLiquidity)
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.TypeEvtFungifyChargedPosition,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
-			sdk.NewAttribute(types.AttributeInputPositionIds, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(positionIds)), ","), "[]")),
-			sdk.NewAttribute(types.AttributeOutputPositionId, strconv.FormatUint(newPositionId, 10)),
-			sdk.NewAttribute(types.AttributeClaimableIncentives, claimableIncentives.String()),
-		),
-	})
+	if err := k.SetPosition(ctx, poolId, owner, newLowerTick, newUpperTick, position.JoinTime, newLiquidity, positionId, 0); err != nil {
+		return err
+	}
 
-	return newPositionId, nil
+	// Recreate the position's accumulator records over the new range, carrying over the settled rewards.
+	spreadRewardGrowthOutside, err = k.getSpreadRewardGrowthOutside(ctx, poolId, newLowerTick, newUpperTick)
+	if err != nil {
+		return err
+	}
+	spreadRewardGrowthInside := spreadRewardAccumulator.GetValue().Sub(spreadRewardGrowthOutside)
+	if err := recreatePositionAccumulator(spreadRewardAccumulator, spreadRewardPositionName, newLiquidity, spreadRewardGrowthInside, unclaimedSpreadRewards, nil); err != nil {
+		return err
+	}
+	uptimeGrowthInside, err := k.GetUptimeGrowthInsideRange(ctx, poolId, newLowerTick, newUpperTick)
+	if err != nil {
+		return err
+	}
+	for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+		if err := recreatePositionAccumulator(uptimeAccum, uptimePositionName, newLiquidity, uptimeGrowthInside[uptimeIndex], unclaimedIncentives[uptimeIndex], emptyOptions); err != nil {
+			return err
+		}
+	}
+
+	// Return the amounts that do not fit into the new range to the owner. The amounts required by the new range are
+	// rounded up in favor of the pool.
+	newAmount0, newAmount1, err := pool.CalcActualAmounts(ctx, newLowerTick, newUpperTick, newLiquidity)
+	if err != nil {
+		return err
+	}
+	refund := sdk.NewCoins()
+	if refund0 := amount0.Sub(newAmount0.Ceil().TruncateInt()); refund0.IsPositive() {
+		refund = refund.Add(sdk.NewCoin(pool.GetToken0(), refund0))
+	}
+	if refund1 := amount1.Sub(newAmount1.Ceil().TruncateInt()); refund1.IsPositive() {
+		refund = refund.Add(sdk.NewCoin(pool.GetToken1(), refund1))
+	}
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), owner, refund); err != nil {
+			return err
+		}
+		k.recordPositionWithdrawal(ctx, positionId, refund)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtMigratePositionTicks,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(newLowerTick, 10)),
+		sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(newUpperTick, 10)),
+		sdk.NewAttribute(types.AttributeLiquidity, newLiquidity.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, refund.String()),
+	))
+
+	return nil
+}
+
+// settleAndDeletePositionAccumulator deletes the given position record from the accumulator and returns its unclaimed
+// rewards, including the rewards accrued inside the position's range since its last update. The given growth outside
+// the position's range is used for the accounting.
+func settleAndDeletePositionAccumulator(accumulator accum.AccumulatorObject, positionName string, growthOutside sdk.DecCoins) (sdk.DecCoins, error) {
+	if err := updatePositionToInitValuePlusGrowthOutside(accumulator, positionName, growthOutside); err != nil {
+		return nil, err
+	}
+	return accumulator.DeletePosition(positionName)
+}
+
+// recreatePositionAccumulator creates the given position record in the accumulator with the given shares, growth
+// inside the position's range and options, and adds the given unclaimed rewards to it.
+func recreatePositionAccumulator(accumulator accum.AccumulatorObject, positionName string, shares sdk.Dec, growthInside, unclaimedRewards sdk.DecCoins, options *accum.Options) error {
+	if err := accumulator.NewPositionIntervalAccumulation(positionName, shares, growthInside, options); err != nil {
+		return err
+	}
+	if unclaimedRewards.IsZero() {
+		return nil
+	}
+	return accumulator.AddToUnclaimedRewards(positionName, unclaimedRewards)
 }
 
 // validatePositionsAndGetTotalLiquidity validates a list of positions owned by the caller and returns their total liquidity.
@@ -658,8 +1267,6 @@ func (k Keeper) fungifyChargedPosition(ctx sdk.Context, owner sdk.AccAddress, po
 // - positions are all not fully charged
 // - positions are not in the same tick range
 // - all positions are unlocked
-// NOTE: It is only used by fungifyChargedPosition which we disabled for launch.
-// nolint: unused
 func (k Keeper) validatePositionsAndGetTotalLiquidity(ctx sdk.Context, owner sdk.AccAddress, positionIds []uint64, fullyChargedDuration time.Duration) (uint64, int64, int64, sdk.Dec, error) {
 	totalLiquidity := sdk.ZeroDec()
 
@@ -11,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
 	cl "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
 )
@@ -1272,10 +1273,18 @@ func (s *KeeperTestSuite) TestFungifyChargedPositions_SwapAndClaimSpreadRewards(
 	// Increase block time by the fully charged duration
 	s.AddBlockTime(DefaultFungifyFullChargeDuration)
 
+	poolBefore, err := s.clk.GetPoolById(s.Ctx, defaultPoolId)
+	s.Require().NoError(err)
+
 	// First run non mutative validation and check results
 	newPositionId, err := s.clk.FungifyChargedPosition(s.Ctx, defaultAddress, expectedPositionIds)
 	s.Require().NoError(err)
 
+	// The pool's active liquidity is not double counted.
+	poolAfter, err := s.clk.GetPoolById(s.Ctx, defaultPoolId)
+	s.Require().NoError(err)
+	s.Require().Equal(poolBefore.GetLiquidity(), poolAfter.GetLiquidity())
+
 	// Claim spread rewards
 	collected, err := s.clk.CollectSpreadRewards(s.Ctx, defaultAddress, newPositionId)
 	s.Require().NoError(err)
@@ -1703,6 +1712,97 @@ func (s *KeeperTestSuite) TestCreateFullRangePosition() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCreatePositionAroundPrice() {
+	tests := []struct {
+		name string
+		// priceMultiplier multiplies the pool's current price into the price the position ranges around.
+		priceMultiplier sdk.Dec
+		rangeRatio      sdk.Dec
+		onlyHoldsToken0 bool
+		expectedErr     string
+	}{
+		{
+			name:            "range around current price",
+			priceMultiplier: sdk.OneDec(),
+			rangeRatio:      sdk.NewDecWithPrec(1, 2),
+		},
+		{
+			name:            "wide range around current price",
+			priceMultiplier: sdk.OneDec(),
+			rangeRatio:      sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name:            "range above current price",
+			priceMultiplier: sdk.NewDec(2),
+			rangeRatio:      sdk.NewDecWithPrec(1, 2),
+			onlyHoldsToken0: true,
+		},
+		{
+			name:            "err: price is zero",
+			priceMultiplier: sdk.ZeroDec(),
+			rangeRatio:      sdk.NewDecWithPrec(1, 2),
+			expectedErr:     "must be positive",
+		},
+		{
+			name:            "err: range ratio is zero",
+			priceMultiplier: sdk.OneDec(),
+			rangeRatio:      sdk.ZeroDec(),
+			expectedErr:     "must be between zero and one exclusive",
+		},
+		{
+			name:            "err: range ratio is one",
+			priceMultiplier: sdk.OneDec(),
+			rangeRatio:      sdk.OneDec(),
+			expectedErr:     "must be between zero and one exclusive",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		s.Run(test.name, func() {
+			s.SetupTest()
+			clPool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+			clPool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, clPool.GetId())
+			s.Require().NoError(err)
+			tickSpacing := int64(clPool.GetTickSpacing())
+			defaultAddress := s.TestAccs[0]
+			s.FundAcc(defaultAddress, DefaultCoins)
+			price := clPool.GetCurrentSqrtPrice().Power(2).Mul(test.priceMultiplier)
+
+			positionId, amount0, amount1, liquidity, err := s.App.ConcentratedLiquidityKeeper.CreatePositionAroundPrice(s.Ctx, clPool.GetId(), defaultAddress, DefaultCoins, price, test.rangeRatio)
+			if test.expectedErr != "" {
+				s.Require().ErrorContains(err, test.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(liquidity, position.Liquidity)
+
+			// The range is the closest one on the tick spacing that covers the requested prices.
+			lowerPrice := price.Mul(sdk.OneDec().Sub(test.rangeRatio))
+			upperPrice := price.Mul(sdk.OneDec().Add(test.rangeRatio))
+			s.Require().Zero(position.LowerTick % tickSpacing)
+			s.Require().Zero(position.UpperTick % tickSpacing)
+			s.Require().True(s.tickToPrice(position.LowerTick).LTE(lowerPrice))
+			s.Require().True(s.tickToPrice(position.LowerTick + tickSpacing).GT(lowerPrice))
+			s.Require().True(s.tickToPrice(position.UpperTick).GT(upperPrice))
+			s.Require().True(s.tickToPrice(position.UpperTick - tickSpacing).LTE(upperPrice))
+
+			// A range above the current price only holds token 0.
+			s.Require().True(amount0.IsPositive())
+			s.Require().Equal(test.onlyHoldsToken0, amount1.IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) tickToPrice(tick int64) sdk.Dec {
+	price, err := math.TickToPrice(tick)
+	s.Require().NoError(err)
+	return price
+}
+
 func (s *KeeperTestSuite) TestMintSharesAndLock() {
 	var (
 		defaultPositionCoins = sdk.NewCoins(DefaultCoin0, DefaultCoin1)
@@ -2475,3 +2575,474 @@ func (s *KeeperTestSuite) TestMultipleRanges() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestTransferPositions() {
+	spreadRewardsPerLiquidity := sdk.NewDecCoinFromDec(ETH, sdk.NewDecWithPrec(1, 3))
+
+	tests := []struct {
+		name                 string
+		positionsToCreate    int
+		positionsToTransfer  []uint64
+		setupUnownedPosition bool
+		lockPositions        bool
+		expectedError        error
+	}{
+		{
+			name:                "single position",
+			positionsToCreate:   1,
+			positionsToTransfer: []uint64{DefaultPositionId},
+		},
+		{
+			name:                "multiple positions, transfer a subset",
+			positionsToCreate:   3,
+			positionsToTransfer: []uint64{DefaultPositionId, DefaultPositionId + 2},
+		},
+		{
+			name:                "error: position does not exist",
+			positionsToCreate:   1,
+			positionsToTransfer: []uint64{DefaultPositionId + 1},
+			expectedError:       types.PositionIdNotFoundError{PositionId: DefaultPositionId + 1},
+		},
+		{
+			name:                "error: duplicate position ids",
+			positionsToCreate:   2,
+			positionsToTransfer: []uint64{DefaultPositionId, DefaultPositionId},
+			expectedError:       types.DuplicatePositionIdsError{PositionIds: []uint64{DefaultPositionId, DefaultPositionId}},
+		},
+		{
+			name:                 "error: sender does not own one of the positions",
+			positionsToCreate:    1,
+			positionsToTransfer:  []uint64{DefaultPositionId, DefaultPositionId + 1},
+			setupUnownedPosition: true,
+			expectedError:        types.PositionOwnerMismatchError{},
+		},
+		{
+			name:                "error: position has an active underlying lock",
+			positionsToCreate:   1,
+			positionsToTransfer: []uint64{DefaultPositionId},
+			lockPositions:       true,
+			expectedError:       types.LockNotMatureError{PositionId: DefaultPositionId, LockId: 1},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(DefaultJoinTime)
+			oldOwner, newOwner := s.TestAccs[0], s.TestAccs[1]
+			pool := s.PrepareConcentratedPool()
+
+			for i := 0; i < tc.positionsToCreate; i++ {
+				if tc.lockPositions {
+					s.FundAcc(oldOwner, DefaultCoins)
+					_, _, _, _, _, err := s.clk.CreateFullRangePositionLocked(s.Ctx, pool.GetId(), oldOwner, DefaultCoins, time.Hour)
+					s.Require().NoError(err)
+					continue
+				}
+				s.SetupDefaultPositionAcc(pool.GetId(), oldOwner)
+			}
+
+			if tc.setupUnownedPosition {
+				s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[2])
+			}
+
+			// Accrue spread rewards and fund the spread rewards address so that they can be claimed.
+			s.AddToSpreadRewardAccumulator(pool.GetId(), spreadRewardsPerLiquidity)
+			s.FundAcc(pool.GetSpreadRewardsAddress(), sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(1_000_000_000))))
+			s.AddBlockTime(time.Hour)
+
+			expectedSpreadRewards := sdk.NewCoins()
+			positionsBefore := map[uint64]model.Position{}
+			for _, positionId := range tc.positionsToTransfer {
+				position, err := s.clk.GetPosition(s.Ctx, positionId)
+				if err != nil {
+					continue
+				}
+				positionsBefore[positionId] = position
+
+				claimable, err := s.clk.GetClaimableSpreadRewards(s.Ctx, positionId)
+				s.Require().NoError(err)
+				expectedSpreadRewards = expectedSpreadRewards.Add(claimable...)
+			}
+
+			oldOwnerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, oldOwner)
+			newOwnerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, newOwner)
+
+			expectedError := tc.expectedError
+			if tc.setupUnownedPosition {
+				expectedError = types.PositionOwnerMismatchError{PositionOwner: s.TestAccs[2].String(), Sender: oldOwner.String()}
+			}
+
+			// System under test
+			err := s.clk.TransferPositions(s.Ctx, tc.positionsToTransfer, oldOwner, newOwner)
+			if expectedError != nil {
+				s.Require().Error(err)
+				s.Require().ErrorContains(err, expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			// Outstanding spread rewards went to the old owner, the new owner's balance is unchanged.
+			s.Require().False(expectedSpreadRewards.IsZero())
+			s.Require().Equal(oldOwnerBalanceBefore.Add(expectedSpreadRewards...).String(), s.App.BankKeeper.GetAllBalances(s.Ctx, oldOwner).String())
+			s.Require().Equal(newOwnerBalanceBefore.String(), s.App.BankKeeper.GetAllBalances(s.Ctx, newOwner).String())
+
+			newOwnerPositions, err := s.clk.GetUserPositions(s.Ctx, newOwner, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Len(newOwnerPositions, len(tc.positionsToTransfer))
+
+			oldOwnerPositions, err := s.clk.GetUserPositions(s.Ctx, oldOwner, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Len(oldOwnerPositions, tc.positionsToCreate-len(tc.positionsToTransfer))
+
+			for _, positionId := range tc.positionsToTransfer {
+				position, err := s.clk.GetPosition(s.Ctx, positionId)
+				s.Require().NoError(err)
+
+				// Everything but the owner is preserved, including the join time.
+				expectedPosition := positionsBefore[positionId]
+				expectedPosition.Address = newOwner.String()
+				s.Require().Equal(expectedPosition, position)
+
+				// Rewards were claimed prior to the transfer.
+				claimable, err := s.clk.GetClaimableSpreadRewards(s.Ctx, positionId)
+				s.Require().NoError(err)
+				s.Require().True(claimable.IsZero())
+
+				// The old owner can no longer withdraw.
+				_, _, err = s.clk.WithdrawPosition(s.Ctx, oldOwner, positionId, position.Liquidity)
+				s.Require().Error(err)
+			}
+
+			// The new owner is able to withdraw the transferred positions.
+			for _, positionId := range tc.positionsToTransfer {
+				position, err := s.clk.GetPosition(s.Ctx, positionId)
+				s.Require().NoError(err)
+				_, _, err = s.clk.WithdrawPosition(s.Ctx, newOwner, positionId, position.Liquidity)
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSplitPosition() {
+	defaultTestIncentiveRecord := types.IncentiveRecord{
+		PoolId: 1,
+		IncentiveRecordBody: types.IncentiveRecordBody{
+			RemainingCoin: sdk.NewDecCoinFromDec(USDC, sdk.NewDec(1000000000000000000)),
+			EmissionRate:  sdk.NewDec(1), // 1 per second
+			StartTime:     defaultBlockTime,
+		},
+		MinUptime: time.Nanosecond,
+	}
+
+	tests := []struct {
+		name            string
+		positionId      uint64
+		notOwner        bool
+		splitLiquidity  func(positionLiquidity sdk.Dec) sdk.Dec
+		setAutoCompound bool
+		approveOperator bool
+		expectedError   error
+	}{
+		{
+			name:           "split position in half",
+			positionId:     1,
+			splitLiquidity: func(positionLiquidity sdk.Dec) sdk.Dec { return positionLiquidity.QuoInt64(2) },
+		},
+		{
+			name:            "split position with an approved operator",
+			positionId:      1,
+			splitLiquidity:  func(positionLiquidity sdk.Dec) sdk.Dec { return positionLiquidity.QuoInt64(2) },
+			approveOperator: true,
+		},
+		{
+			name:            "split off a small share of an auto-compounding position",
+			positionId:      1,
+			splitLiquidity:  func(positionLiquidity sdk.Dec) sdk.Dec { return sdk.OneDec() },
+			setAutoCompound: true,
+		},
+		{
+			name:           "error: sender is not the owner",
+			positionId:     1,
+			notOwner:       true,
+			splitLiquidity: func(positionLiquidity sdk.Dec) sdk.Dec { return positionLiquidity.QuoInt64(2) },
+			expectedError:  types.NotPositionOwnerError{},
+		},
+		{
+			name:           "error: split liquidity equals position liquidity",
+			positionId:     1,
+			splitLiquidity: func(positionLiquidity sdk.Dec) sdk.Dec { return positionLiquidity },
+			expectedError:  types.InvalidSplitLiquidityError{},
+		},
+		{
+			name:           "error: zero split liquidity",
+			positionId:     1,
+			splitLiquidity: func(positionLiquidity sdk.Dec) sdk.Dec { return sdk.ZeroDec() },
+			expectedError:  types.InvalidSplitLiquidityError{},
+		},
+		{
+			name:           "error: position does not exist",
+			positionId:     5,
+			splitLiquidity: func(positionLiquidity sdk.Dec) sdk.Dec { return sdk.OneDec() },
+			expectedError:  types.PositionIdNotFoundError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			owner := s.TestAccs[0]
+			pool, _, _ := s.runFungifySetup(owner, 2, DefaultFungifyFullChargeDuration, DefaultSpreadFactor, []types.IncentiveRecord{defaultTestIncentiveRecord})
+			s.FundAcc(pool.GetIncentivesAddress(), sdk.NewCoins(sdk.NewCoin(USDC, sdk.NewInt(1_000_000))))
+			if tc.setAutoCompound {
+				s.Require().NoError(s.clk.SetPositionAutoCompoundForOwner(s.Ctx, owner, tc.positionId, true))
+			}
+			operator := s.TestAccs[2]
+			if tc.approveOperator {
+				s.Require().NoError(s.clk.SetPositionOperator(s.Ctx, owner, tc.positionId, operator, true))
+			}
+
+			// Earn spread rewards and incentives.
+			swapAmountIn := sdk.NewCoin(ETH, sdk.NewInt(1_000_000))
+			s.FundAcc(owner, sdk.NewCoins(swapAmountIn))
+			s.swapAndTrackXTimesInARow(pool.GetId(), swapAmountIn, USDC, types.MinSpotPrice, 1)
+			s.AddBlockTime(time.Hour)
+
+			positionLiquidity := sdk.OneDec()
+			originalPosition, err := s.clk.GetPosition(s.Ctx, tc.positionId)
+			if err == nil {
+				positionLiquidity = originalPosition.Liquidity
+			}
+			poolBefore, err := s.clk.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			lowerTickBefore, err := s.clk.GetTickInfo(s.Ctx, pool.GetId(), DefaultLowerTick)
+			s.Require().NoError(err)
+
+			// The rewards the original position would have collected.
+			cacheCtx, _ := s.Ctx.CacheContext()
+			expectedSpreadRewards, err := s.clk.CollectSpreadRewards(cacheCtx, owner, tc.positionId)
+			if tc.expectedError == nil {
+				s.Require().NoError(err)
+			}
+			expectedIncentives, _, err := s.clk.CollectIncentives(cacheCtx, owner, tc.positionId)
+			if tc.expectedError == nil {
+				s.Require().NoError(err)
+			}
+
+			sender := owner
+			if tc.notOwner {
+				sender = s.TestAccs[1]
+			}
+
+			// System under test
+			firstPositionId, secondPositionId, err := s.clk.SplitPosition(s.Ctx, sender, tc.positionId, tc.splitLiquidity(positionLiquidity))
+			if tc.expectedError != nil {
+				s.Require().IsType(tc.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(3), firstPositionId)
+			s.Require().Equal(uint64(4), secondPositionId)
+
+			// The original position is deleted.
+			s.Require().False(s.clk.HasPosition(s.Ctx, tc.positionId))
+
+			// The new positions share the original range and join time and hold its liquidity.
+			firstPosition, err := s.clk.GetPosition(s.Ctx, firstPositionId)
+			s.Require().NoError(err)
+			secondPosition, err := s.clk.GetPosition(s.Ctx, secondPositionId)
+			s.Require().NoError(err)
+			for _, position := range []model.Position{firstPosition, secondPosition} {
+				s.Require().Equal(owner.String(), position.Address)
+				s.Require().Equal(originalPosition.LowerTick, position.LowerTick)
+				s.Require().Equal(originalPosition.UpperTick, position.UpperTick)
+				s.Require().Equal(originalPosition.JoinTime, position.JoinTime)
+				s.Require().Equal(tc.setAutoCompound, s.clk.IsPositionAutoCompound(s.Ctx, position.PositionId))
+				s.Require().Equal(tc.approveOperator, s.clk.IsPositionOperator(s.Ctx, position.PositionId, operator))
+			}
+			s.Require().Equal(tc.splitLiquidity(positionLiquidity), secondPosition.Liquidity)
+			s.Require().Equal(originalPosition.Liquidity, firstPosition.Liquidity.Add(secondPosition.Liquidity))
+
+			// The ticks and the pool's active liquidity are unchanged.
+			poolAfter, err := s.clk.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(poolBefore.GetLiquidity(), poolAfter.GetLiquidity())
+			lowerTickAfter, err := s.clk.GetTickInfo(s.Ctx, pool.GetId(), DefaultLowerTick)
+			s.Require().NoError(err)
+			s.Require().Equal(lowerTickBefore.LiquidityGross, lowerTickAfter.LiquidityGross)
+			s.Require().Equal(lowerTickBefore.LiquidityNet, lowerTickAfter.LiquidityNet)
+
+			// The unclaimed rewards of the original position are moved to the first new position.
+			roundingTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.OneDec(), RoundingDir: osmomath.RoundDown}
+			spreadRewards, err := s.clk.CollectSpreadRewards(s.Ctx, owner, firstPositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(0, roundingTolerance.Compare(expectedSpreadRewards.AmountOf(ETH), spreadRewards.AmountOf(ETH)), "expected: %s, got: %s", expectedSpreadRewards, spreadRewards)
+			incentives, _, err := s.clk.CollectIncentives(s.Ctx, owner, firstPositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(0, roundingTolerance.Compare(expectedIncentives.AmountOf(USDC), incentives.AmountOf(USDC)), "expected: %s, got: %s", expectedIncentives, incentives)
+
+			spreadRewards, err = s.clk.CollectSpreadRewards(s.Ctx, owner, secondPositionId)
+			s.Require().NoError(err)
+			s.Require().True(spreadRewards.IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMergePositions() {
+	tests := []struct {
+		name                 string
+		secondPositionDelay  time.Duration
+		timeElapsed          time.Duration
+		positionIds          []uint64
+		notOwner             bool
+		differentRange       bool
+		approveOperators     bool
+		expectedJoinTimeDiff time.Duration
+		expectedError        error
+	}{
+		{
+			name:                 "positions are fully charged",
+			secondPositionDelay:  time.Hour,
+			timeElapsed:          DefaultFungifyFullChargeDuration + time.Hour,
+			positionIds:          []uint64{1, 2},
+			expectedJoinTimeDiff: -DefaultFungifyFullChargeDuration,
+		},
+		{
+			name:                 "positions joined at the same time and are not fully charged",
+			timeElapsed:          time.Hour,
+			positionIds:          []uint64{1, 2},
+			expectedJoinTimeDiff: -time.Hour,
+		},
+		{
+			name:                 "operators approved for all positions are kept",
+			timeElapsed:          time.Hour,
+			positionIds:          []uint64{1, 2},
+			approveOperators:     true,
+			expectedJoinTimeDiff: -time.Hour,
+		},
+		{
+			name:                "error: positions joined at different times and are not fully charged",
+			secondPositionDelay: time.Hour,
+			timeElapsed:         DefaultFungifyFullChargeDuration,
+			positionIds:         []uint64{1, 2},
+			expectedError:       types.IncompatibleJoinTimesError{},
+		},
+		{
+			name:           "error: positions are in different ranges",
+			timeElapsed:    time.Hour,
+			positionIds:    []uint64{1, 2},
+			differentRange: true,
+			expectedError:  types.PositionsNotInSameTickRangeError{},
+		},
+		{
+			name:          "error: sender is not the owner",
+			timeElapsed:   time.Hour,
+			positionIds:   []uint64{1, 2},
+			notOwner:      true,
+			expectedError: types.PositionOwnerMismatchError{},
+		},
+		{
+			name:          "error: single position",
+			timeElapsed:   time.Hour,
+			positionIds:   []uint64{1},
+			expectedError: types.PositionQuantityTooLowError{},
+		},
+		{
+			name:          "error: duplicate positions",
+			timeElapsed:   time.Hour,
+			positionIds:   []uint64{1, 1},
+			expectedError: types.DuplicatePositionIdsError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			owner := s.TestAccs[0]
+			pool, _, _ := s.runFungifySetup(owner, 1, DefaultFungifyFullChargeDuration, DefaultSpreadFactor, nil)
+
+			s.AddBlockTime(tc.secondPositionDelay)
+			upperTick := DefaultUpperTick
+			if tc.differentRange {
+				upperTick += int64(DefaultTickSpacing)
+			}
+			s.FundAcc(owner, DefaultCoins)
+			_, _, _, _, _, _, err := s.clk.CreatePosition(s.Ctx, pool.GetId(), owner, DefaultCoins, sdk.ZeroInt(), sdk.ZeroInt(), DefaultLowerTick, upperTick)
+			s.Require().NoError(err)
+
+			// The common operator is approved for both positions, the other operator only for the first one.
+			commonOperator, otherOperator := s.TestAccs[2], s.TestAccs[1]
+			if tc.approveOperators {
+				s.Require().NoError(s.clk.SetPositionOperator(s.Ctx, owner, 1, commonOperator, true))
+				s.Require().NoError(s.clk.SetPositionOperator(s.Ctx, owner, 2, commonOperator, true))
+				s.Require().NoError(s.clk.SetPositionOperator(s.Ctx, owner, 1, otherOperator, true))
+			}
+
+			// Earn spread rewards.
+			swapAmountIn := sdk.NewCoin(ETH, sdk.NewInt(1_000_000))
+			s.FundAcc(owner, sdk.NewCoins(swapAmountIn))
+			s.swapAndTrackXTimesInARow(pool.GetId(), swapAmountIn, USDC, types.MinSpotPrice, 1)
+			s.AddBlockTime(tc.timeElapsed - tc.secondPositionDelay)
+
+			poolBefore, err := s.clk.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			// The rewards the merged positions would have collected.
+			cacheCtx, _ := s.Ctx.CacheContext()
+			expectedSpreadRewards := sdk.NewCoins()
+			expectedLiquidity := sdk.ZeroDec()
+			for _, positionId := range tc.positionIds {
+				if tc.expectedError != nil {
+					break
+				}
+				position, err := s.clk.GetPosition(cacheCtx, positionId)
+				s.Require().NoError(err)
+				expectedLiquidity = expectedLiquidity.Add(position.Liquidity)
+				spreadRewards, err := s.clk.CollectSpreadRewards(cacheCtx, owner, positionId)
+				s.Require().NoError(err)
+				expectedSpreadRewards = expectedSpreadRewards.Add(spreadRewards...)
+			}
+
+			sender := owner
+			if tc.notOwner {
+				sender = s.TestAccs[1]
+			}
+
+			// System under test
+			newPositionId, err := s.clk.MergePositions(s.Ctx, sender, tc.positionIds)
+			if tc.expectedError != nil {
+				s.Require().IsType(tc.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(3), newPositionId)
+
+			for _, positionId := range tc.positionIds {
+				s.Require().False(s.clk.HasPosition(s.Ctx, positionId))
+			}
+
+			newPosition, err := s.clk.GetPosition(s.Ctx, newPositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(owner.String(), newPosition.Address)
+			s.Require().Equal(expectedLiquidity, newPosition.Liquidity)
+			s.Require().Equal(s.Ctx.BlockTime().Add(tc.expectedJoinTimeDiff), newPosition.JoinTime)
+			s.Require().Equal(tc.approveOperators, s.clk.IsPositionOperator(s.Ctx, newPositionId, commonOperator))
+			s.Require().False(s.clk.IsPositionOperator(s.Ctx, newPositionId, otherOperator))
+
+			// The pool's active liquidity is unchanged.
+			poolAfter, err := s.clk.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(poolBefore.GetLiquidity(), poolAfter.GetLiquidity())
+
+			// The unclaimed rewards of the merged positions are moved to the new position.
+			roundingTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.NewDec(int64(len(tc.positionIds))), RoundingDir: osmomath.RoundDown}
+			spreadRewards, err := s.clk.CollectSpreadRewards(s.Ctx, owner, newPositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(0, roundingTolerance.Compare(expectedSpreadRewards.AmountOf(ETH), spreadRewards.AmountOf(ETH)), "expected: %s, got: %s", expectedSpreadRewards, spreadRewards)
+		})
+	}
+}
@@ -163,6 +163,9 @@ func (k Keeper) getInitialSpreadRewardGrowthOppositeDirectionOfLastTraversalForT
 }
 
 // collectSpreadRewards collects the spread reward earned by a position and sends them to the owner's account.
+// The sender must be either the owner of the position or an operator approved by the owner.
+// If the position was created within the pool's JIT protection blocks, the spread rewards are forfeited to the
+// in-range LPs and no spread rewards are returned.
 // Returns error if the position with the given id does not exist or if fails to get the spread reward accumulator.
 func (k Keeper) collectSpreadRewards(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (sdk.Coins, error) {
 	position, err := k.GetPosition(ctx, positionId)
@@ -170,13 +173,17 @@ func (k Keeper) collectSpreadRewards(ctx sdk.Context, sender sdk.AccAddress, pos
 		return sdk.Coins{}, err
 	}
 
-	// Spread reward collector must be the owner of the position.
-	if sender.String() != position.Address {
+	// Spread reward collector must be the owner of the position or an approved operator.
+	if !k.isPositionOwnerOrOperator(ctx, position, sender) {
 		return sdk.Coins{}, types.NotPositionOwnerError{
 			PositionId: positionId,
 			Address:    sender.String(),
 		}
 	}
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
 
 	// Get the amount of spread rewards that the position is eligible to claim.
 	// This also mutates the internal state of the spread reward accumulator.
@@ -185,14 +192,25 @@ func (k Keeper) collectSpreadRewards(ctx sdk.Context, sender sdk.AccAddress, pos
 		return sdk.Coins{}, err
 	}
 
-	// Send the claimed spread rewards from the pool's address to the owner's address.
 	pool, err := k.getPoolById(ctx, position.PoolId)
 	if err != nil {
 		return sdk.Coins{}, err
 	}
-	if err := k.bankKeeper.SendCoins(ctx, pool.GetSpreadRewardsAddress(), sender, spreadRewardsClaimed); err != nil {
+
+	// Spread rewards claimed within the pool's JIT protection window are forfeited to the in-range LPs.
+	// This also applies when collecting before withdrawing, which would otherwise bypass the protection.
+	if k.isWithinJITProtectionWindow(ctx, pool, positionId) {
+		if err := k.forfeitSpreadRewards(ctx, pool, positionId, spreadRewardsClaimed); err != nil {
+			return sdk.Coins{}, err
+		}
+		return sdk.Coins{}, nil
+	}
+
+	// Send the claimed spread rewards from the pool's address to the owner's address.
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetSpreadRewardsAddress(), owner, spreadRewardsClaimed); err != nil {
 		return sdk.Coins{}, err
 	}
+	k.recordPositionRewardsCollected(ctx, positionId, spreadRewardsClaimed, sdk.Coins{})
 
 	// Emit an event for the spread rewards collected.
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -47,6 +47,17 @@ func ParsePositionFromBz(value []byte) (model.Position, error) {
 	return position, nil
 }
 
+// ParsePositionOperatorApprovalFromBz parses and returns a position operator approval from a byte array.
+// Returns an error if fails to unmarshal.
+func ParsePositionOperatorApprovalFromBz(value []byte) (types.PositionOperatorApproval, error) {
+	approval := types.PositionOperatorApproval{}
+	err := proto.Unmarshal(value, &approval)
+	if err != nil {
+		return types.PositionOperatorApproval{}, err
+	}
+	return approval, nil
+}
+
 // ParseTickFromBz takes a byte slice representing the serialized tick data and
 // attempts to parse it into a TickInfo struct using the protobuf Unmarshal function.
 // If the byte slice is empty or the unmarshalling fails, an appropriate error is returned.
@@ -156,15 +167,9 @@ func ParseFullIncentiveRecordFromBz(key []byte, value []byte) (incentiveRecord t
 		return types.IncentiveRecord{}, err
 	}
 
-	incentiveRecordBody := types.IncentiveRecordBody{
-		RemainingCoin: incentiveBody.RemainingCoin,
-		EmissionRate:  incentiveBody.EmissionRate,
-		StartTime:     incentiveBody.StartTime,
-	}
-
 	return types.IncentiveRecord{
 		PoolId:              poolId,
-		IncentiveRecordBody: incentiveRecordBody,
+		IncentiveRecordBody: incentiveBody,
 		MinUptime:           types.SupportedUptimes[minUptimeIndex],
 		IncentiveId:         incentiveRecordId,
 	}, nil
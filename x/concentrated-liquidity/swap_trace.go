@@ -0,0 +1,45 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/client/queryproto"
+)
+
+// SwapTraceExactAmountIn estimates a swap of tokenIn for tokenOutDenom in the given pool at the pool's spread factor,
+// exactly as CalcOutAmtGivenIn does, and returns every step of the swap alongside the estimated tokens in and out.
+// The swap is computed on a cache context, so no state is modified.
+// Returns error if the pool does not exist, the denoms are invalid, or the pool runs out of liquidity.
+func (k Keeper) SwapTraceExactAmountIn(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) (sdk.Coin, sdk.Coin, []queryproto.SwapStep, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	swapSteps := []queryproto.SwapStep{}
+	tokenIn, tokenOut, _, _, err := k.computeOutAmtGivenIn(cacheCtx, poolId, tokenIn, tokenOutDenom, pool.GetSpreadFactor(ctx), sdk.ZeroDec(), &swapSteps)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, nil, err
+	}
+	return tokenIn, tokenOut, swapSteps, nil
+}
+
+// SwapTraceExactAmountOut estimates a swap of tokenInDenom for tokenOut in the given pool at the pool's spread factor,
+// exactly as CalcInAmtGivenOut does, and returns every step of the swap alongside the estimated tokens in and out.
+// The swap is computed on a cache context, so no state is modified.
+// Returns error if the pool does not exist, the denoms are invalid, or the pool runs out of liquidity.
+func (k Keeper) SwapTraceExactAmountOut(ctx sdk.Context, poolId uint64, tokenOut sdk.Coin, tokenInDenom string) (sdk.Coin, sdk.Coin, []queryproto.SwapStep, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	swapSteps := []queryproto.SwapStep{}
+	tokenIn, tokenOut, _, _, err := k.computeInAmtGivenOut(cacheCtx, tokenOut, tokenInDenom, pool.GetSpreadFactor(ctx), sdk.ZeroDec(), poolId, &swapSteps)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, nil, err
+	}
+	return tokenIn, tokenOut, swapSteps, nil
+}
@@ -0,0 +1,143 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestSwapTrace() {
+	spreadFactor := sdk.MustNewDecFromStr("0.003")
+
+	tests := []struct {
+		name                 string
+		exactAmountOut       bool
+		poolId               uint64
+		tokenIn              sdk.Coin
+		tokenOut             sdk.Coin
+		expectedTicksCrossed int
+		expectedError        error
+	}{
+		{
+			name:     "exact amount in: swap within the default position's range",
+			poolId:   1,
+			tokenIn:  sdk.NewCoin(USDC, sdk.NewInt(1_000_000)),
+			tokenOut: sdk.NewCoin(ETH, sdk.ZeroInt()),
+		},
+		{
+			name:                 "exact amount in: crosses the lower tick of the default position into the full range position",
+			poolId:               1,
+			tokenIn:              sdk.NewCoin(ETH, sdk.NewInt(2_000_000)),
+			tokenOut:             sdk.NewCoin(USDC, sdk.ZeroInt()),
+			expectedTicksCrossed: 1,
+		},
+		{
+			name:           "exact amount out: swap within the default position's range",
+			exactAmountOut: true,
+			poolId:         1,
+			tokenIn:        sdk.NewCoin(ETH, sdk.ZeroInt()),
+			tokenOut:       sdk.NewCoin(USDC, sdk.NewInt(1_000_000)),
+		},
+		{
+			name:                 "exact amount out: crosses the lower tick of the default position into the full range position",
+			exactAmountOut:       true,
+			poolId:               1,
+			tokenIn:              sdk.NewCoin(ETH, sdk.ZeroInt()),
+			tokenOut:             sdk.NewCoin(USDC, sdk.NewInt(6_000_000_000)),
+			expectedTicksCrossed: 1,
+		},
+		{
+			name:          "error: pool does not exist",
+			poolId:        2,
+			tokenIn:       sdk.NewCoin(ETH, sdk.NewInt(1_000_000)),
+			tokenOut:      sdk.NewCoin(USDC, sdk.ZeroInt()),
+			expectedError: types.PoolNotFoundError{PoolId: 2},
+		},
+		{
+			name:          "error: denom not in pool",
+			poolId:        1,
+			tokenIn:       sdk.NewCoin("uosmo", sdk.NewInt(1_000_000)),
+			tokenOut:      sdk.NewCoin(USDC, sdk.ZeroInt()),
+			expectedError: types.TokenInDenomNotInPoolError{TokenInDenom: "uosmo"},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, spreadFactor)
+			s.SetupDefaultPosition(pool.GetId())
+			s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+			pool, err := s.clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			// System under test
+			var (
+				tokenIn, tokenOut sdk.Coin
+				steps             []queryproto.SwapStep
+			)
+			if test.exactAmountOut {
+				tokenIn, tokenOut, steps, err = s.clk.SwapTraceExactAmountOut(s.Ctx, test.poolId, test.tokenOut, test.tokenIn.Denom)
+			} else {
+				tokenIn, tokenOut, steps, err = s.clk.SwapTraceExactAmountIn(s.Ctx, test.poolId, test.tokenIn, test.tokenOut.Denom)
+			}
+			if test.expectedError != nil {
+				s.Require().ErrorContains(err, test.expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			// The trace does not modify state.
+			poolAfterTrace, err := s.clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(pool, poolAfterTrace)
+
+			// The steps are contiguous, start at the pool's state, and add up to the estimated amounts.
+			s.Require().NotEmpty(steps)
+			s.Require().Equal(pool.GetCurrentSqrtPrice(), steps[0].SqrtPriceStart)
+			s.Require().Equal(pool.GetLiquidity(), steps[0].Liquidity)
+			totalAmountIn, totalAmountOut, ticksCrossed := sdk.ZeroDec(), sdk.ZeroDec(), 0
+			for i, step := range steps {
+				if i > 0 {
+					s.Require().Equal(steps[i-1].SqrtPriceEnd, step.SqrtPriceStart)
+					if steps[i-1].TickCrossed {
+						s.Require().NotEqual(steps[i-1].Liquidity, step.Liquidity)
+					}
+				}
+				if step.TickCrossed {
+					ticksCrossed++
+				}
+				totalAmountIn = totalAmountIn.Add(step.AmountIn).Add(step.SpreadRewardCharge)
+				totalAmountOut = totalAmountOut.Add(step.AmountOut)
+			}
+			s.Require().Equal(test.expectedTicksCrossed, ticksCrossed)
+			s.Require().Equal(totalAmountIn.Ceil().TruncateInt(), tokenIn.Amount)
+			s.Require().Equal(totalAmountOut.TruncateInt(), tokenOut.Amount)
+
+			// The trace matches the estimate and the swap itself.
+			s.FundAcc(s.TestAccs[2], sdk.NewCoins(sdk.NewCoin(tokenIn.Denom, tokenIn.Amount)))
+			if test.exactAmountOut {
+				expectedTokenIn, err := s.clk.CalcInAmtGivenOut(s.Ctx, pool, test.tokenOut, test.tokenIn.Denom, spreadFactor)
+				s.Require().NoError(err)
+				s.Require().Equal(expectedTokenIn, tokenIn)
+
+				_, err = s.clk.SwapExactAmountOut(s.Ctx, s.TestAccs[2], pool, tokenIn.Denom, tokenIn.Amount, test.tokenOut, spreadFactor)
+				s.Require().NoError(err)
+			} else {
+				expectedTokenOut, err := s.clk.CalcOutAmtGivenIn(s.Ctx, pool, test.tokenIn, test.tokenOut.Denom, spreadFactor)
+				s.Require().NoError(err)
+				s.Require().Equal(expectedTokenOut, tokenOut)
+
+				_, err = s.clk.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool, test.tokenIn, tokenOut.Denom, tokenOut.Amount, spreadFactor)
+				s.Require().NoError(err)
+			}
+
+			poolAfterSwap, err := s.clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			lastStep := steps[len(steps)-1]
+			s.Require().Equal(lastStep.SqrtPriceEnd, poolAfterSwap.GetCurrentSqrtPrice())
+			s.Require().Equal(lastStep.TickEnd, poolAfterSwap.GetCurrentTick())
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	events "github.com/osmosis-labs/osmosis/v16/x/poolmanager/events"
 
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/swapstrategy"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
@@ -195,7 +196,7 @@ func (k Keeper) swapOutAmtGivenIn(
 	spreadFactor sdk.Dec,
 	priceLimit sdk.Dec,
 ) (calcTokenIn, calcTokenOut sdk.Coin, poolUpdates PoolUpdates, err error) {
-	tokenIn, tokenOut, poolUpdates, totalSpreadFactors, err := k.computeOutAmtGivenIn(ctx, pool.GetId(), tokenIn, tokenOutDenom, spreadFactor, priceLimit)
+	tokenIn, tokenOut, poolUpdates, totalSpreadFactors, err := k.computeOutAmtGivenIn(ctx, pool.GetId(), tokenIn, tokenOutDenom, spreadFactor, priceLimit, nil)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
@@ -224,7 +225,7 @@ func (k *Keeper) swapInAmtGivenOut(
 	spreadFactor sdk.Dec,
 	priceLimit sdk.Dec,
 ) (calcTokenIn, calcTokenOut sdk.Coin, poolUpdates PoolUpdates, err error) {
-	tokenIn, tokenOut, poolUpdates, totalSpreadFactors, err := k.computeInAmtGivenOut(ctx, desiredTokenOut, tokenInDenom, spreadFactor, priceLimit, pool.GetId())
+	tokenIn, tokenOut, poolUpdates, totalSpreadFactors, err := k.computeInAmtGivenOut(ctx, desiredTokenOut, tokenInDenom, spreadFactor, priceLimit, pool.GetId(), nil)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
@@ -251,7 +252,7 @@ func (k Keeper) CalcOutAmtGivenIn(
 	spreadFactor sdk.Dec,
 ) (tokenOut sdk.Coin, err error) {
 	cacheCtx, _ := ctx.CacheContext()
-	_, tokenOut, _, _, err = k.computeOutAmtGivenIn(cacheCtx, poolI.GetId(), tokenIn, tokenOutDenom, spreadFactor, sdk.ZeroDec())
+	_, tokenOut, _, _, err = k.computeOutAmtGivenIn(cacheCtx, poolI.GetId(), tokenIn, tokenOutDenom, spreadFactor, sdk.ZeroDec(), nil)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
@@ -266,7 +267,7 @@ func (k Keeper) CalcInAmtGivenOut(
 	spreadFactor sdk.Dec,
 ) (tokenIn sdk.Coin, err error) {
 	cacheCtx, _ := ctx.CacheContext()
-	tokenIn, _, _, _, err = k.computeInAmtGivenOut(cacheCtx, tokenOut, tokenInDenom, spreadFactor, sdk.ZeroDec(), poolI.GetId())
+	tokenIn, _, _, _, err = k.computeInAmtGivenOut(cacheCtx, tokenOut, tokenInDenom, spreadFactor, sdk.ZeroDec(), poolI.GetId(), nil)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
@@ -278,6 +279,7 @@ func (k Keeper) CalcInAmtGivenOut(
 // Note this method is mutative, some of the tick and accumulator updates get written to store.
 // However, there are no token transfers or pool updates done in this method. These mutations are performed in swapInAmtGivenOut.
 // Note that passing in 0 for `priceLimit` will result in the price limit being set to the max/min value based on swap direction
+// If `swapSteps` is not nil, every step of the swap is appended to it.
 func (k Keeper) computeOutAmtGivenIn(
 	ctx sdk.Context,
 	poolId uint64,
@@ -285,6 +287,7 @@ func (k Keeper) computeOutAmtGivenIn(
 	tokenOutDenom string,
 	spreadFactor sdk.Dec,
 	priceLimit sdk.Dec,
+	swapSteps *[]queryproto.SwapStep,
 ) (tokenIn, tokenOut sdk.Coin, poolUpdates PoolUpdates, totalSpreadFactors sdk.Dec, err error) {
 	// Get pool and asset info
 	p, err := k.getPoolForSwap(ctx, poolId)
@@ -296,7 +299,7 @@ func (k Keeper) computeOutAmtGivenIn(
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, err
 	}
 
-	swapStrategy, sqrtPriceLimit, err := k.setupSwapStrategy(p, spreadFactor, tokenInMin.Denom, priceLimit)
+	swapStrategy, sqrtPriceLimit, err := k.setupSwapStrategy(ctx, p, spreadFactor, tokenInMin.Denom, priceLimit)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, err
 	}
@@ -358,6 +361,7 @@ func (k Keeper) computeOutAmtGivenIn(
 
 		ctx.Logger().Debug("cl calc out given in")
 		emitSwapDebugLogs(ctx, swapState, computedSqrtPrice, amountIn, amountOut, spreadRewardCharge)
+		swapStep := newSwapStep(swapState, nextTick, computedSqrtPrice, amountIn, amountOut, spreadRewardCharge)
 
 		// Update the swapState with the new sqrtPrice from the above swap
 		swapState.sqrtPrice = computedSqrtPrice
@@ -376,6 +380,7 @@ func (k Keeper) computeOutAmtGivenIn(
 			}
 			// Update the swapState's tick with the tick we retrieved liquidity from
 			swapState.tick = swapStrategy.UpdateTickAfterCrossing(nextTick)
+			swapStep.TickCrossed = true
 		} else if !sqrtPriceStart.Equal(computedSqrtPrice) {
 			// Otherwise if the sqrtPrice calculated from ComputeSwapWithinBucketOutGivenIn(...) does not equal the sqrtPriceStart we started with at the
 			// beginning of this iteration, we set the swapState tick to the corresponding tick of the computedSqrtPrice calculated from ComputeSwapWithinBucketOutGivenIn(...)
@@ -399,6 +404,8 @@ func (k Keeper) computeOutAmtGivenIn(
 				}
 			}
 		}
+
+		recordSwapStep(swapSteps, swapStep, swapState.tick)
 	}
 
 	// Add spread reward growth per share to the pool-global spread reward accumulator.
@@ -424,6 +431,7 @@ func (k Keeper) computeOutAmtGivenIn(
 // what the updated tick, liquidity, and currentSqrtPrice for the pool would be after this swap.
 // Note this method is mutative, some of the tick and accumulator updates get written to store.
 // However, there are no token transfers or pool updates done in this method. These mutations are performed in swapOutAmtGivenIn.
+// If `swapSteps` is not nil, every step of the swap is appended to it.
 func (k Keeper) computeInAmtGivenOut(
 	ctx sdk.Context,
 	desiredTokenOut sdk.Coin,
@@ -431,6 +439,7 @@ func (k Keeper) computeInAmtGivenOut(
 	spreadFactor sdk.Dec,
 	priceLimit sdk.Dec,
 	poolId uint64,
+	swapSteps *[]queryproto.SwapStep,
 ) (tokenIn, tokenOut sdk.Coin, poolUpdates PoolUpdates, totalSpreadFactors sdk.Dec, err error) {
 	p, err := k.getPoolForSwap(ctx, poolId)
 	if err != nil {
@@ -441,7 +450,7 @@ func (k Keeper) computeInAmtGivenOut(
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, err
 	}
 
-	swapStrategy, sqrtPriceLimit, err := k.setupSwapStrategy(p, spreadFactor, tokenInDenom, priceLimit)
+	swapStrategy, sqrtPriceLimit, err := k.setupSwapStrategy(ctx, p, spreadFactor, tokenInDenom, priceLimit)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, err
 	}
@@ -500,6 +509,7 @@ func (k Keeper) computeInAmtGivenOut(
 
 		ctx.Logger().Debug("cl calc in given out")
 		emitSwapDebugLogs(ctx, swapState, computedSqrtPrice, amountIn, amountOut, spreadRewardChargeTotal)
+		swapStep := newSwapStep(swapState, nextInitializedTick, computedSqrtPrice, amountIn, amountOut, spreadRewardChargeTotal)
 
 		// Update the swapState with the new sqrtPrice from the above swap
 		swapState.sqrtPrice = computedSqrtPrice
@@ -514,6 +524,7 @@ func (k Keeper) computeInAmtGivenOut(
 			if err != nil {
 				return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, err
 			}
+			swapStep.TickCrossed = true
 		} else if !sqrtPriceStart.Equal(computedSqrtPrice) {
 			// Otherwise, if the computedSqrtPrice calculated from ComputeSwapWithinBucketInGivenOut(...) does not equal the sqrtPriceStart we started with at the
 			// beginning of this iteration, we set the swapState tick to the corresponding tick of the computedSqrtPrice calculated from ComputeSwapWithinBucketInGivenOut(...)
@@ -522,6 +533,8 @@ func (k Keeper) computeInAmtGivenOut(
 				return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, err
 			}
 		}
+
+		recordSwapStep(swapSteps, swapStep, swapState.tick)
 	}
 
 	// Add spread reward growth per share to the pool-global spread reward accumulator.
@@ -551,6 +564,29 @@ func emitSwapDebugLogs(ctx sdk.Context, swapState SwapState, reachedPrice, amoun
 	ctx.Logger().Debug("spreadRewardChargeTotal", spreadCharge)
 }
 
+// newSwapStep returns the step of a swap that starts at the given swap state, swaps towards the given next initialized
+// tick and reaches the given sqrt price. The liquidity is cloned since crossing a tick mutates the swap state's liquidity.
+func newSwapStep(swapState SwapState, nextInitializedTick int64, reachedSqrtPrice, amountIn, amountOut, spreadCharge sdk.Dec) queryproto.SwapStep {
+	return queryproto.SwapStep{
+		SqrtPriceStart:      swapState.sqrtPrice,
+		SqrtPriceEnd:        reachedSqrtPrice,
+		Liquidity:           swapState.liquidity.Clone(),
+		AmountIn:            amountIn,
+		AmountOut:           amountOut,
+		SpreadRewardCharge:  spreadCharge,
+		NextInitializedTick: nextInitializedTick,
+	}
+}
+
+// recordSwapStep appends the given swap step, ending at the given tick, to swapSteps. No-op if swapSteps is nil.
+func recordSwapStep(swapSteps *[]queryproto.SwapStep, swapStep queryproto.SwapStep, tickEnd int64) {
+	if swapSteps == nil {
+		return
+	}
+	swapStep.TickEnd = tickEnd
+	*swapSteps = append(*swapSteps, swapStep)
+}
+
 // logic for crossing a tick during a swap
 func (k Keeper) swapCrossTickLogic(ctx sdk.Context,
 	swapState SwapState,
@@ -641,6 +677,9 @@ func (k Keeper) updatePoolForSwap(
 		return types.InsufficientPoolBalanceError{Err: err}
 	}
 
+	// Record the pool's cumulative values before the swap changes its tick and liquidity.
+	k.writeObservation(ctx, pool)
+
 	err = pool.ApplySwap(poolUpdates.NewLiquidity, poolUpdates.NewCurrentTick, poolUpdates.NewSqrtPrice)
 	if err != nil {
 		return fmt.Errorf("error applying swap: %w", err)
@@ -679,7 +718,10 @@ func checkDenomValidity(inDenom, outDenom, asset0, asset1 string) error {
 	return nil
 }
 
-func (k Keeper) setupSwapStrategy(p types.ConcentratedPoolExtension, spreadFactor sdk.Dec, tokenInDenom string, priceLimit sdk.Dec) (strategy swapstrategy.SwapStrategy, sqrtPriceLimit sdk.Dec, err error) {
+func (k Keeper) setupSwapStrategy(ctx sdk.Context, p types.ConcentratedPoolExtension, spreadFactor sdk.Dec, tokenInDenom string, priceLimit sdk.Dec) (strategy swapstrategy.SwapStrategy, sqrtPriceLimit sdk.Dec, err error) {
+	// charge the pool's dynamic spread factor instead of its static one if it opted into one
+	spreadFactor = k.applyDynamicSpreadFactor(ctx, p, spreadFactor)
+
 	zeroForOne := getZeroForOne(tokenInDenom, p.GetToken0())
 
 	// take provided price limit and turn this into a sqrt price limit since formulas use sqrtPrice
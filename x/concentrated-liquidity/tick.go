@@ -187,6 +187,38 @@ func validateTickRangeIsValid(tickSpacing uint64, lowerTick int64, upperTick int
 	return nil
 }
 
+// roundTickDownToTickSpacing returns the largest tick that is divisible by the given tick spacing and is not greater
+// than the given tick.
+func roundTickDownToTickSpacing(tick int64, tickSpacing uint64) int64 {
+	remainder := tick % int64(tickSpacing)
+	if remainder < 0 {
+		remainder += int64(tickSpacing)
+	}
+	return tick - remainder
+}
+
+// roundTickUpToTickSpacing returns the smallest tick that is divisible by the given tick spacing and is not less
+// than the given tick.
+func roundTickUpToTickSpacing(tick int64, tickSpacing uint64) int64 {
+	roundedDown := roundTickDownToTickSpacing(tick, tickSpacing)
+	if roundedDown == tick {
+		return tick
+	}
+	return roundedDown + int64(tickSpacing)
+}
+
+// removeTickIfEmpty removes the given tick of the given pool from state if no position refers to it anymore.
+func (k Keeper) removeTickIfEmpty(ctx sdk.Context, poolId uint64, tickIndex int64) error {
+	tickInfo, err := k.GetTickInfo(ctx, poolId, tickIndex)
+	if err != nil {
+		return err
+	}
+	if tickInfo.LiquidityGross.IsZero() {
+		ctx.KVStore(k.storeKey).Delete(types.KeyTick(poolId, tickIndex))
+	}
+	return nil
+}
+
 // roundTickToCanonicalPriceTick takes a tick and determines if multiple ticks can represent the same price as the provided tick. If so, it
 // rounds that tick up to the largest tick that can represent the same price that the original tick corresponded to. If one of
 // the two ticks happen to be rounded, we re-validate the tick range to ensure that the tick range is still valid.
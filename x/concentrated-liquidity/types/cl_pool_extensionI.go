@@ -22,9 +22,12 @@ type ConcentratedPoolExtension interface {
 	GetTickSpacing() uint64
 	GetLiquidity() sdk.Dec
 	GetLastLiquidityUpdate() time.Time
+	GetJITProtectionBlocks() uint64
 	SetCurrentSqrtPrice(newSqrtPrice sdk.Dec)
 	SetCurrentTick(newTick int64)
 	SetTickSpacing(newTickSpacing uint64)
+	SetSpreadFactor(newSpreadFactor sdk.Dec)
+	SetJITProtectionBlocks(newJITProtectionBlocks uint64)
 	SetLastLiquidityUpdate(newTime time.Time)
 
 	UpdateLiquidity(newLiquidity sdk.Dec)
@@ -19,10 +19,27 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCollectSpreadRewards{}, "osmosis/cl-col-sp-rewards", nil)
 	cdc.RegisterConcrete(&MsgCollectIncentives{}, "osmosis/cl-collect-incentives", nil)
 	cdc.RegisterConcrete(&MsgFungifyChargedPositions{}, "osmosis/cl-fungify-charged-positions", nil)
+	cdc.RegisterConcrete(&MsgTransferPositions{}, "osmosis/cl-transfer-positions", nil)
+	cdc.RegisterConcrete(&MsgCompoundPosition{}, "osmosis/cl-compound-position", nil)
+	cdc.RegisterConcrete(&MsgSetPositionAutoCompound{}, "osmosis/cl-set-auto-compound", nil)
+	cdc.RegisterConcrete(&MsgSetPositionOperator{}, "osmosis/cl-set-position-operator", nil)
+	cdc.RegisterConcrete(&MsgRerangePosition{}, "osmosis/cl-rerange-position", nil)
+	cdc.RegisterConcrete(&MsgIncreaseObservationCardinality{}, "osmosis/cl-increase-obs-cardinality", nil)
+	cdc.RegisterConcrete(&MsgSplitPosition{}, "osmosis/cl-split-position", nil)
+	cdc.RegisterConcrete(&MsgMergePositions{}, "osmosis/cl-merge-positions", nil)
+	cdc.RegisterConcrete(&MsgCreateIncentive{}, "osmosis/cl-create-incentive", nil)
+	cdc.RegisterConcrete(&MsgAddToIncentive{}, "osmosis/cl-add-to-incentive", nil)
+	cdc.RegisterConcrete(&MsgReduceIncentiveEmissionRate{}, "osmosis/cl-reduce-incentive-rate", nil)
+	cdc.RegisterConcrete(&MsgCancelIncentive{}, "osmosis/cl-cancel-incentive", nil)
+	cdc.RegisterConcrete(&MsgCreatePositionFromSingleAsset{}, "osmosis/cl-create-pos-single-asset", nil)
 
 	// gov proposals
 	cdc.RegisterConcrete(&CreateConcentratedLiquidityPoolsProposal{}, "osmosis/create-cl-pools-proposal", nil)
 	cdc.RegisterConcrete(&TickSpacingDecreaseProposal{}, "osmosis/cl-tick-spacing-dec-prop", nil)
+	cdc.RegisterConcrete(&TickSpacingUpdateProposal{}, "osmosis/cl-tick-spacing-update-prop", nil)
+	cdc.RegisterConcrete(&SpreadFactorChangeProposal{}, "osmosis/cl-spread-factor-change-prop", nil)
+	cdc.RegisterConcrete(&DynamicSpreadFactorProposal{}, "osmosis/cl-dynamic-spread-factor-prop", nil)
+	cdc.RegisterConcrete(&JITProtectionChangeProposal{}, "osmosis/cl-jit-protection-change-prop", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -39,12 +56,29 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCollectSpreadRewards{},
 		&MsgCollectIncentives{},
 		&MsgFungifyChargedPositions{},
+		&MsgTransferPositions{},
+		&MsgCompoundPosition{},
+		&MsgSetPositionAutoCompound{},
+		&MsgSetPositionOperator{},
+		&MsgRerangePosition{},
+		&MsgIncreaseObservationCardinality{},
+		&MsgSplitPosition{},
+		&MsgMergePositions{},
+		&MsgCreateIncentive{},
+		&MsgAddToIncentive{},
+		&MsgReduceIncentiveEmissionRate{},
+		&MsgCancelIncentive{},
+		&MsgCreatePositionFromSingleAsset{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&CreateConcentratedLiquidityPoolsProposal{},
 		&TickSpacingDecreaseProposal{},
+		&TickSpacingUpdateProposal{},
+		&SpreadFactorChangeProposal{},
+		&DynamicSpreadFactorProposal{},
+		&JITProtectionChangeProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
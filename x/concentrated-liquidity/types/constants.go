@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,6 +14,52 @@ const (
 	ConcentratedGasFeeForSwap           = 10_000
 	BaseGasFeeForNewIncentive           = 10_000
 	BaseGasFeeForInitializingTick       = 10_000
+	// AutoCompoundEpochIdentifier is the epoch at the end of which
+	// positions that opted into auto-compounding are compounded.
+	AutoCompoundEpochIdentifier = "day"
+	// MaxAutoCompoundPositionsPerEpoch is the maximum number of positions compounded at the end of each
+	// auto-compound epoch. The remaining positions are compounded in the following epochs.
+	MaxAutoCompoundPositionsPerEpoch = 100
+	// MaxTickSpacingMigrationPositionsPerBlock is the maximum number of positions of a pool migrated to a new tick
+	// spacing per block. The remaining positions are migrated in the following blocks.
+	MaxTickSpacingMigrationPositionsPerBlock = 100
+	// AutoCompoundObservationCardinality is the number of observation slots a pool is grown to when one of its
+	// positions opts into auto-compounding, so that its observations cover RebalanceTwapDuration.
+	AutoCompoundObservationCardinality uint32 = 100
+	// RebalanceTwapDuration is the duration of the time weighted average price that internal swaps rebalancing
+	// tokens into a position's ratio, e.g. when compounding, are bounded by.
+	RebalanceTwapDuration = 5 * time.Minute
+	// MaxObservationCardinality is the maximum number of observations
+	// a pool's observation ring buffer can hold.
+	MaxObservationCardinality uint32 = 65535
+	// MaxObserveSecondsAgo is the maximum number of seconds before the current block time that a pool's
+	// observations can be queried at, so that it fits into a time.Duration.
+	MaxObserveSecondsAgo = uint64(math.MaxInt64 / int64(time.Second))
+	// DynamicSpreadFactorVolatilityIntervals is the number of intervals the lookback duration of a
+	// dynamic spread factor is split into to measure the volatility of the pool's price.
+	DynamicSpreadFactorVolatilityIntervals = 10
+	// MinDynamicSpreadFactorLookbackDuration is the minimum lookback duration of a dynamic spread factor,
+	// so that each volatility interval spans at least one second.
+	MinDynamicSpreadFactorLookbackDuration = DynamicSpreadFactorVolatilityIntervals * time.Second
+	// ObservationMinInterval is the minimum time assumed between two observations of a pool when sizing its
+	// observation ring buffer, as at most one observation is written per block.
+	ObservationMinInterval = time.Second
+	// MaxDynamicSpreadFactorLookbackDuration is the maximum lookback duration of a dynamic spread factor,
+	// so that the observations over it fit into MaxObservationCardinality slots.
+	MaxDynamicSpreadFactorLookbackDuration = time.Duration(MaxObservationCardinality-1) * ObservationMinInterval
+	// MaxEmissionScheduleSegments is the maximum number of segments in the emission schedule of an incentive,
+	// bounding the work done per incentive record on every uptime accumulator update.
+	MaxEmissionScheduleSegments = 20
+	// MaxIncentiveRecordsPerPool is the maximum number of incentive records a pool can have for an account to
+	// create another one directly, bounding the incentive records updated on every uptime accumulator update.
+	// Incentive records created from x/incentives gauges are not subject to it.
+	MaxIncentiveRecordsPerPool = 100
+	// MaxPositionAmountsIterations is the maximum number of times the amounts a position is created from are reduced
+	// by the rounding excess of the amounts its liquidity requires.
+	MaxPositionAmountsIterations = 10
+	// MaxJITProtectionBlocks is the maximum number of blocks after their creation during which positions forfeit
+	// their spread rewards, so that long-term LPs collecting shortly after joining do not lose much.
+	MaxJITProtectionBlocks = 1000
 )
 
 var (
@@ -35,4 +82,11 @@ var (
 	DefaultBalancerSharesDiscount = sdk.MustNewDecFromStr("0.05")
 	// By default, we only authorize one nanosecond (one block) uptime as an option
 	DefaultAuthorizedUptimes = []time.Duration{time.Nanosecond}
+	// RebalanceMaxTwapDeviation is the maximum relative deviation of the spot price from the time weighted
+	// average price over RebalanceTwapDuration, and of the price of internal rebalancing swaps net of the
+	// spread factor from that time weighted average price.
+	RebalanceMaxTwapDeviation = sdk.MustNewDecFromStr("0.01")
+	// DefaultMinIncentiveAmounts are the denoms and minimum amounts of incentive records created directly by an
+	// account, so that dust incentive records cannot take up the incentive records of a pool.
+	DefaultMinIncentiveAmounts = sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(1_000_000)))
 )
@@ -0,0 +1,575 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/dynamic_spread_factor.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DynamicSpreadFactor is the dynamic spread factor configuration of a pool
+// that opted into it by governance. At the beginning of every block, the
+// volatility of the pool's price over the lookback duration is measured from
+// the pool's observations, and the spread factor applied to swaps is set to
+// the volatility scaled by the volatility multiplier, bounded by the min and
+// max spread factors.
+type DynamicSpreadFactor struct {
+	PoolId          uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	MinSpreadFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_spread_factor,json=minSpreadFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_spread_factor" yaml:"min_spread_factor"`
+	MaxSpreadFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_spread_factor,json=maxSpreadFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_spread_factor" yaml:"max_spread_factor"`
+	// volatility_multiplier is the factor by which the measured volatility is
+	// multiplied to obtain the spread factor.
+	VolatilityMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=volatility_multiplier,json=volatilityMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volatility_multiplier" yaml:"volatility_multiplier"`
+	// lookback_duration is the period over which the volatility is measured.
+	LookbackDuration time.Duration `protobuf:"bytes,5,opt,name=lookback_duration,json=lookbackDuration,proto3,stdduration" json:"lookback_duration" yaml:"lookback_duration"`
+	// current_spread_factor is the spread factor currently applied to swaps in
+	// the pool in place of the pool's static spread factor.
+	CurrentSpreadFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=current_spread_factor,json=currentSpreadFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_spread_factor" yaml:"current_spread_factor"`
+}
+
+func (m *DynamicSpreadFactor) Reset()         { *m = DynamicSpreadFactor{} }
+func (m *DynamicSpreadFactor) String() string { return proto.CompactTextString(m) }
+func (*DynamicSpreadFactor) ProtoMessage()    {}
+func (*DynamicSpreadFactor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75d93e63dfa62695, []int{0}
+}
+func (m *DynamicSpreadFactor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSpreadFactor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactor.Merge(m, src)
+}
+func (m *DynamicSpreadFactor) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactor) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSpreadFactor proto.InternalMessageInfo
+
+func (m *DynamicSpreadFactor) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *DynamicSpreadFactor) GetLookbackDuration() time.Duration {
+	if m != nil {
+		return m.LookbackDuration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DynamicSpreadFactor)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactor")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/dynamic_spread_factor.proto", fileDescriptor_75d93e63dfa62695)
+}
+
+var fileDescriptor_75d93e63dfa62695 = []byte{
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0xba, 0x5b, 0x31, 0x82, 0xba, 0xd9, 0x5d, 0x88, 0x8b, 0x24, 0x25, 0xa8, 0x14,
+	0xa4, 0x19, 0xaa, 0xe0, 0x61, 0x8f, 0xa1, 0x08, 0x0a, 0x7a, 0x88, 0x37, 0x11, 0xc2, 0x64, 0x32,
+	0x1b, 0x87, 0x4e, 0xf2, 0xe2, 0x64, 0x52, 0x9a, 0xab, 0x7e, 0x01, 0x8f, 0x7e, 0x07, 0xbf, 0xc8,
+	0x1e, 0xf7, 0x28, 0x1e, 0xaa, 0xb4, 0xdf, 0x60, 0x3f, 0x81, 0x74, 0x92, 0xec, 0xb6, 0xdb, 0xf5,
+	0xb0, 0xf4, 0x94, 0x99, 0x37, 0xf9, 0xbf, 0xdf, 0xff, 0xbd, 0xc7, 0x33, 0x8e, 0xa1, 0x48, 0xa1,
+	0xe0, 0x05, 0xa6, 0x90, 0x51, 0x96, 0x29, 0x49, 0x14, 0x8b, 0x07, 0x82, 0x7f, 0x29, 0x79, 0xcc,
+	0x55, 0x85, 0xe3, 0x2a, 0x23, 0x29, 0xa7, 0x61, 0x91, 0x4b, 0x46, 0xe2, 0xf0, 0x84, 0x50, 0x05,
+	0xd2, 0xcb, 0x25, 0x28, 0x30, 0x9f, 0x36, 0x5a, 0x6f, 0x55, 0x7b, 0x21, 0xf5, 0x26, 0xc3, 0x88,
+	0x29, 0x32, 0x3c, 0x3a, 0x48, 0x20, 0x01, 0xad, 0xc0, 0xcb, 0x53, 0x2d, 0x3e, 0xb2, 0x13, 0x80,
+	0x44, 0x30, 0xac, 0x6f, 0x51, 0x79, 0x82, 0xe3, 0x52, 0x12, 0xc5, 0x21, 0xab, 0xdf, 0xdd, 0x9f,
+	0xbb, 0xc6, 0xfe, 0xa8, 0x86, 0x7f, 0xd0, 0xec, 0xd7, 0x1a, 0x6d, 0x3e, 0x37, 0xee, 0xe4, 0x00,
+	0x22, 0xe4, 0xb1, 0x85, 0x7a, 0xa8, 0xbf, 0xe3, 0x9b, 0xe7, 0x33, 0xe7, 0x7e, 0x45, 0x52, 0x71,
+	0xec, 0x36, 0x0f, 0x6e, 0xd0, 0x5d, 0x9e, 0xde, 0xc4, 0xe6, 0xc4, 0xd8, 0x4b, 0x79, 0xb6, 0x6e,
+	0xde, 0xba, 0xd5, 0x43, 0xfd, 0xbb, 0xfe, 0xdb, 0xd3, 0x99, 0xd3, 0xf9, 0x3d, 0x73, 0x9e, 0x25,
+	0x5c, 0x7d, 0x2e, 0x23, 0x8f, 0x42, 0x8a, 0xa9, 0x2e, 0xa8, 0xf9, 0x0c, 0x8a, 0x78, 0x8c, 0x55,
+	0x95, 0xb3, 0xc2, 0x1b, 0x31, 0x7a, 0x3e, 0x73, 0xac, 0x1a, 0xb2, 0x91, 0xd0, 0x0d, 0x1e, 0xa4,
+	0x3c, 0x5b, 0x33, 0xb9, 0xe4, 0x92, 0xe9, 0x15, 0xee, 0xed, 0x2d, 0xb9, 0x64, 0xba, 0xc9, 0x25,
+	0xd3, 0x35, 0xee, 0x37, 0x64, 0x1c, 0x4e, 0x40, 0x10, 0xc5, 0x05, 0x57, 0x55, 0x98, 0x96, 0x42,
+	0xf1, 0x5c, 0x70, 0x26, 0xad, 0x1d, 0x0d, 0x7f, 0x7f, 0x63, 0xf8, 0xe3, 0x1a, 0x7e, 0x6d, 0x52,
+	0x37, 0x38, 0xb8, 0x8c, 0xbf, 0xbb, 0x08, 0x9b, 0xc2, 0xd8, 0x13, 0x00, 0xe3, 0x88, 0xd0, 0x71,
+	0xd8, 0x4e, 0xd5, 0xda, 0xed, 0xa1, 0xfe, 0xbd, 0x17, 0x8f, 0xbc, 0x7a, 0xec, 0x5e, 0x3b, 0x76,
+	0x6f, 0xd4, 0xfc, 0xe0, 0x3f, 0x59, 0x7a, 0xbb, 0x2c, 0x77, 0x23, 0x83, 0xfb, 0xe3, 0x8f, 0x83,
+	0x82, 0x87, 0x6d, 0xbc, 0xd5, 0x99, 0x5f, 0x91, 0x71, 0x48, 0x4b, 0x29, 0x59, 0xa6, 0xae, 0x34,
+	0xbc, 0xbb, 0x5d, 0xcd, 0xd7, 0x26, 0x75, 0x83, 0xfd, 0x26, 0xbe, 0xda, 0x78, 0xff, 0xd3, 0xe9,
+	0xdc, 0x46, 0x67, 0x73, 0x1b, 0xfd, 0x9d, 0xdb, 0xe8, 0xfb, 0xc2, 0xee, 0x9c, 0x2d, 0xec, 0xce,
+	0xaf, 0x85, 0xdd, 0xf9, 0xe8, 0xaf, 0x60, 0x9b, 0x7d, 0x19, 0x08, 0x12, 0x15, 0xed, 0x05, 0x4f,
+	0x86, 0xaf, 0xf0, 0xf4, 0x7f, 0xeb, 0xa7, 0x6d, 0x45, 0x5d, 0xdd, 0xad, 0x97, 0xff, 0x06, 0x00,
+	0xae, 0xde, 0x07, 0xd4, 0xad, 0x03, 0x00, 0x00,
+}
+
+func (m *DynamicSpreadFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentSpreadFactor.Size()
+		i -= size
+		if _, err := m.CurrentSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LookbackDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LookbackDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.VolatilityMultiplier.Size()
+		i -= size
+		if _, err := m.VolatilityMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxSpreadFactor.Size()
+		i -= size
+		if _, err := m.MaxSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinSpreadFactor.Size()
+		i -= size
+		if _, err := m.MinSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDynamicSpreadFactor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDynamicSpreadFactor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DynamicSpreadFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovDynamicSpreadFactor(uint64(m.PoolId))
+	}
+	l = m.MinSpreadFactor.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.MaxSpreadFactor.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.VolatilityMultiplier.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LookbackDuration)
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.CurrentSpreadFactor.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	return n
+}
+
+func sovDynamicSpreadFactor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDynamicSpreadFactor(x uint64) (n int) {
+	return sovDynamicSpreadFactor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DynamicSpreadFactor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicSpreadFactor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSpreadFactor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSpreadFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolatilityMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LookbackDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicSpreadFactor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDynamicSpreadFactor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDynamicSpreadFactor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDynamicSpreadFactor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDynamicSpreadFactor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDynamicSpreadFactor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDynamicSpreadFactor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDynamicSpreadFactor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDynamicSpreadFactor = fmt.Errorf("proto: unexpected end of group")
+)
@@ -366,6 +366,45 @@ func (e BalancerRecordNotClearedError) Error() string {
 	return fmt.Sprintf("balancer record was not cleared after reward claiming. CL pool id (%d), Balancer pool ID (%d), Uptime index (%d)", e.ClPoolId, e.BalancerPoolId, e.UptimeIndex)
 }
 
+type PositionAmountsExceededError struct {
+	LowerTick int64
+	UpperTick int64
+	Amount0   sdk.Int
+	Amount1   sdk.Int
+}
+
+func (e PositionAmountsExceededError) Error() string {
+	return fmt.Sprintf("the amounts required by a position in the range (%d, %d) exceed the amounts provided. Amount0 (%s), amount1 (%s)", e.LowerTick, e.UpperTick, e.Amount0, e.Amount1)
+}
+
+type IncentiveAmountTooLowError struct {
+	PoolId        uint64
+	IncentiveCoin sdk.Coin
+	MinAmount     sdk.Int
+}
+
+func (e IncentiveAmountTooLowError) Error() string {
+	return fmt.Sprintf("incentive coin amount must be at least (%s). Pool id (%d), incentive coin (%s)", e.MinAmount, e.PoolId, e.IncentiveCoin)
+}
+
+type IncentiveDenomNotAllowedError struct {
+	PoolId        uint64
+	IncentiveCoin sdk.Coin
+}
+
+func (e IncentiveDenomNotAllowedError) Error() string {
+	return fmt.Sprintf("incentive coin denom is not in the min incentive amounts param. Pool id (%d), incentive coin (%s)", e.PoolId, e.IncentiveCoin)
+}
+
+type MaxIncentiveRecordsReachedError struct {
+	PoolId              uint64
+	MaxIncentiveRecords int
+}
+
+func (e MaxIncentiveRecordsReachedError) Error() string {
+	return fmt.Sprintf("pool (%d) already has the maximum number of incentive records (%d)", e.PoolId, e.MaxIncentiveRecords)
+}
+
 type InvalidIncentiveCoinError struct {
 	PoolId        uint64
 	IncentiveCoin sdk.Coin
@@ -384,6 +423,35 @@ func (e NonPositiveEmissionRateError) Error() string {
 	return fmt.Sprintf("emission rate must be position (nonzero and nonnegative). Pool id (%d), emission rate (%s)", e.PoolId, e.EmissionRate)
 }
 
+type NegativeEmissionRateError struct {
+	PoolId       uint64
+	EmissionRate sdk.Dec
+}
+
+func (e NegativeEmissionRateError) Error() string {
+	return fmt.Sprintf("emission rate after the emission schedule must be non-negative. Pool id (%d), emission rate (%s)", e.PoolId, e.EmissionRate)
+}
+
+type TooManyEmissionSegmentsError struct {
+	NumSegments int
+	MaxSegments int
+}
+
+func (e TooManyEmissionSegmentsError) Error() string {
+	return fmt.Sprintf("emission schedule has (%d) segments, at most (%d) are allowed", e.NumSegments, e.MaxSegments)
+}
+
+type InvalidEmissionSegmentError struct {
+	Index             int
+	Duration          time.Duration
+	StartEmissionRate sdk.Dec
+	EndEmissionRate   sdk.Dec
+}
+
+func (e InvalidEmissionSegmentError) Error() string {
+	return fmt.Sprintf("emission segment (%d) must have a positive duration and non-negative emission rates. Duration (%s), start emission rate (%s), end emission rate (%s)", e.Index, e.Duration, e.StartEmissionRate, e.EndEmissionRate)
+}
+
 type InvalidMinUptimeError struct {
 	PoolId            uint64
 	MinUptime         time.Duration
@@ -696,7 +764,7 @@ type UnauthorizedSpreadFactorError struct {
 }
 
 func (e UnauthorizedSpreadFactorError) Error() string {
-	return fmt.Sprintf("attempted to create pool with unauthorized spread factor (%s), must be one of the following: (%s)", e.ProvidedSpreadFactor, e.AuthorizedSpreadFactors)
+	return fmt.Sprintf("attempted to set unauthorized spread factor (%s), must be one of the following: (%s)", e.ProvidedSpreadFactor, e.AuthorizedSpreadFactors)
 }
 
 type UnauthorizedTickSpacingError struct {
@@ -840,3 +908,222 @@ type TickToSqrtPriceConversionError struct {
 func (e TickToSqrtPriceConversionError) Error() string {
 	return fmt.Sprintf("could not convert next tick  to nextSqrtPrice (%v)", e.NextTick)
 }
+
+type DuplicatePositionIdsError struct {
+	PositionIds []uint64
+}
+
+func (e DuplicatePositionIdsError) Error() string {
+	return fmt.Sprintf("duplicate position ids provided (%v)", e.PositionIds)
+}
+
+type NoRewardsToCompoundError struct {
+	PositionId uint64
+}
+
+func (e NoRewardsToCompoundError) Error() string {
+	return fmt.Sprintf("position id (%d) has no spread rewards or incentives in the pool's denoms to compound", e.PositionId)
+}
+
+type SpotPriceDeviatesFromTwapError struct {
+	PoolId    uint64
+	SpotPrice sdk.Dec
+	TwapPrice sdk.Dec
+}
+
+func (e SpotPriceDeviatesFromTwapError) Error() string {
+	return fmt.Sprintf("spot price (%s) of pool (%d) deviates from its time weighted average price (%s) by more than the allowed deviation", e.SpotPrice, e.PoolId, e.TwapPrice)
+}
+
+type RerangeLastPositionInPoolError struct {
+	PoolId     uint64
+	PositionId uint64
+}
+
+func (e RerangeLastPositionInPoolError) Error() string {
+	return fmt.Sprintf("Cannot re-range a position if it is the last position in the pool. Pool id (%d), position ID (%d).", e.PoolId, e.PositionId)
+}
+
+type InvalidObservationCardinalityError struct {
+	Cardinality    uint32
+	MaxCardinality uint32
+}
+
+func (e InvalidObservationCardinalityError) Error() string {
+	return fmt.Sprintf("observation cardinality must be between 1 and %d, got (%d)", e.MaxCardinality, e.Cardinality)
+}
+
+type ObservationsNotInitializedError struct {
+	PoolId uint64
+}
+
+func (e ObservationsNotInitializedError) Error() string {
+	return fmt.Sprintf("no observations have been recorded for pool id (%d)", e.PoolId)
+}
+
+type ObservationTooOldError struct {
+	PoolId     uint64
+	TargetTime time.Time
+	OldestTime time.Time
+}
+
+func (e ObservationTooOldError) Error() string {
+	return fmt.Sprintf("target time (%s) is before the oldest observation (%s) of pool id (%d)", e.TargetTime, e.OldestTime, e.PoolId)
+}
+
+type ObserveSecondsAgoTooLargeError struct {
+	SecondsAgo    uint64
+	MaxSecondsAgo uint64
+}
+
+func (e ObserveSecondsAgoTooLargeError) Error() string {
+	return fmt.Sprintf("seconds ago (%d) must be at most (%d)", e.SecondsAgo, e.MaxSecondsAgo)
+}
+
+type InvalidTwapDurationError struct {
+	Duration time.Duration
+}
+
+func (e InvalidTwapDurationError) Error() string {
+	return fmt.Sprintf("time weighted average price duration (%s) must be at least one second", e.Duration)
+}
+
+type InvalidObservationStateError struct {
+	Index           uint32
+	Cardinality     uint32
+	CardinalityNext uint32
+}
+
+func (e InvalidObservationStateError) Error() string {
+	return fmt.Sprintf("invalid observation state: index (%d), cardinality (%d), cardinality next (%d)", e.Index, e.Cardinality, e.CardinalityNext)
+}
+
+type InvalidPriceChangeError struct {
+	PriceChange sdk.Dec
+}
+
+func (e InvalidPriceChangeError) Error() string {
+	return fmt.Sprintf("price change must be greater than zero and less than one, got (%s)", e.PriceChange)
+}
+
+type InvalidSplitLiquidityError struct {
+	PositionId        uint64
+	Liquidity         sdk.Dec
+	PositionLiquidity sdk.Dec
+}
+
+func (e InvalidSplitLiquidityError) Error() string {
+	return fmt.Sprintf("liquidity to split off position id (%d) must be positive and less than its liquidity (%s), got (%s)", e.PositionId, e.PositionLiquidity, e.Liquidity)
+}
+
+type IncompatibleJoinTimesError struct {
+	PositionId       uint64
+	JoinTime         time.Time
+	ExpectedJoinTime time.Time
+}
+
+func (e IncompatibleJoinTimesError) Error() string {
+	return fmt.Sprintf("position id (%d) has join time (%s) which is incompatible with (%s), positions must either be fully charged or have joined at the same time to be merged", e.PositionId, e.JoinTime, e.ExpectedJoinTime)
+}
+
+type SpreadFactorUnchangedError struct {
+	PoolId       uint64
+	SpreadFactor sdk.Dec
+}
+
+func (e SpreadFactorUnchangedError) Error() string {
+	return fmt.Sprintf("pool id (%d) already has spread factor (%s)", e.PoolId, e.SpreadFactor)
+}
+
+type JITProtectionBlocksUnchangedError struct {
+	PoolId              uint64
+	JITProtectionBlocks uint64
+}
+
+func (e JITProtectionBlocksUnchangedError) Error() string {
+	return fmt.Sprintf("pool id (%d) already has JIT protection blocks (%d)", e.PoolId, e.JITProtectionBlocks)
+}
+
+type IncentiveRecordIdNotFoundError struct {
+	PoolId            uint64
+	IncentiveRecordId uint64
+}
+
+func (e IncentiveRecordIdNotFoundError) Error() string {
+	return fmt.Sprintf("incentive record not found. pool id (%d), incentive record id (%d)", e.PoolId, e.IncentiveRecordId)
+}
+
+type NotIncentiveCreatorError struct {
+	IncentiveRecordId uint64
+	Creator           string
+	Sender            string
+}
+
+func (e NotIncentiveCreatorError) Error() string {
+	return fmt.Sprintf("incentive record id (%d) was created by (%s), not by sender (%s)", e.IncentiveRecordId, e.Creator, e.Sender)
+}
+
+type IncentiveDenomMismatchError struct {
+	IncentiveRecordId uint64
+	IncentiveDenom    string
+	ProvidedDenom     string
+}
+
+func (e IncentiveDenomMismatchError) Error() string {
+	return fmt.Sprintf("incentive record id (%d) emits (%s), got (%s)", e.IncentiveRecordId, e.IncentiveDenom, e.ProvidedDenom)
+}
+
+type EmissionRateNotReducedError struct {
+	IncentiveRecordId   uint64
+	CurrentEmissionRate sdk.Dec
+	EmissionRate        sdk.Dec
+}
+
+func (e EmissionRateNotReducedError) Error() string {
+	return fmt.Sprintf("new emission rate (%s) of incentive record id (%d) must be less than its current emission rate (%s)", e.EmissionRate, e.IncentiveRecordId, e.CurrentEmissionRate)
+}
+
+type EmissionScheduleNotOverError struct {
+	IncentiveRecordId uint64
+	ScheduleEndTime   time.Time
+}
+
+func (e EmissionScheduleNotOverError) Error() string {
+	return fmt.Sprintf("emission rate of incentive record id (%d) cannot be reduced before its emission schedule is over at (%s)", e.IncentiveRecordId, e.ScheduleEndTime)
+}
+
+type SwapRouteTokenOutMismatchError struct {
+	RouteTokenOutDenom string
+	ExpectedDenom      string
+}
+
+func (e SwapRouteTokenOutMismatchError) Error() string {
+	return fmt.Sprintf("swap route ends in (%s), expected (%s)", e.RouteTokenOutDenom, e.ExpectedDenom)
+}
+
+type LookbackDurationTooLongError struct {
+	PoolId              uint64
+	LookbackDuration    time.Duration
+	MaxLookbackDuration time.Duration
+}
+
+func (e LookbackDurationTooLongError) Error() string {
+	return fmt.Sprintf("lookback duration (%s) of pool (%d) exceeds the maximum lookback duration (%s)", e.LookbackDuration, e.PoolId, e.MaxLookbackDuration)
+}
+
+type InvalidDynamicSpreadFactorBoundsError struct {
+	MinSpreadFactor sdk.Dec
+	MaxSpreadFactor sdk.Dec
+}
+
+func (e InvalidDynamicSpreadFactorBoundsError) Error() string {
+	return fmt.Sprintf("min spread factor (%s) must not be greater than max spread factor (%s)", e.MinSpreadFactor, e.MaxSpreadFactor)
+}
+
+type DynamicSpreadFactorNotFoundError struct {
+	PoolId uint64
+}
+
+func (e DynamicSpreadFactorNotFoundError) Error() string {
+	return fmt.Sprintf("pool (%d) did not opt into a dynamic spread factor", e.PoolId)
+}
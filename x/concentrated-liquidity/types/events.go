@@ -1,17 +1,36 @@
 package types
 
 const (
-	TypeEvtCreatePosition            = "create_position"
-	TypeEvtWithdrawPosition          = "withdraw_position"
-	TypeEvtAddToPosition             = "add_to_position"
-	TypeEvtTotalCollectSpreadRewards = "total_collect_spread_rewards"
-	TypeEvtCollectSpreadRewards      = "collect_spread_rewards"
-	TypeEvtTotalCollectIncentives    = "total_collect_incentives"
-	TypeEvtCollectIncentives         = "collect_incentives"
-	TypeEvtCreateIncentive           = "create_incentive"
-	TypeEvtFungifyChargedPosition    = "fungify_charged_position"
-	TypeEvtMoveRewards               = "move_rewards"
-	TypeEvtCrossTick                 = "cross_tick"
+	TypeEvtCreatePosition                 = "create_position"
+	TypeEvtWithdrawPosition               = "withdraw_position"
+	TypeEvtAddToPosition                  = "add_to_position"
+	TypeEvtTotalCollectSpreadRewards      = "total_collect_spread_rewards"
+	TypeEvtCollectSpreadRewards           = "collect_spread_rewards"
+	TypeEvtTotalCollectIncentives         = "total_collect_incentives"
+	TypeEvtCollectIncentives              = "collect_incentives"
+	TypeEvtCreateIncentive                = "create_incentive"
+	TypeEvtFungifyChargedPosition         = "fungify_charged_position"
+	TypeEvtMoveRewards                    = "move_rewards"
+	TypeEvtCrossTick                      = "cross_tick"
+	TypeEvtTransferPositions              = "transfer_positions"
+	TypeEvtCompoundPosition               = "compound_position"
+	TypeEvtSetPositionAutoCompound        = "set_position_auto_compound"
+	TypeEvtSetPositionOperator            = "set_position_operator"
+	TypeEvtRerangePosition                = "rerange_position"
+	TypeEvtIncreaseObservationCardinality = "increase_observation_cardinality"
+	TypeEvtSplitPosition                  = "split_position"
+	TypeEvtMergePositions                 = "merge_positions"
+	TypeEvtChangeSpreadFactor             = "change_spread_factor"
+	TypeEvtAddToIncentive                 = "add_to_incentive"
+	TypeEvtReduceIncentiveEmissionRate    = "reduce_incentive_emission_rate"
+	TypeEvtCancelIncentive                = "cancel_incentive"
+	TypeEvtCreatePositionFromSingleAsset  = "create_position_from_single_asset"
+	TypeEvtChangeTickSpacing              = "change_tick_spacing"
+	TypeEvtMigratePositionTicks           = "migrate_position_ticks"
+	TypeEvtSetDynamicSpreadFactor         = "set_dynamic_spread_factor"
+	TypeEvtUpdateDynamicSpreadFactor      = "update_dynamic_spread_factor"
+	TypeEvtChangeJITProtectionBlocks      = "change_jit_protection_blocks"
+	TypeEvtForfeitSpreadRewards           = "forfeit_spread_rewards"
 
 	AttributeValueCategory                                         = ModuleName
 	AttributeKeyPositionId                                         = "position_id"
@@ -20,6 +39,7 @@ const (
 	AttributeAmount0                                               = "amount0"
 	AttributeAmount1                                               = "amount1"
 	AttributeKeySpreadFactor                                       = "spread_factor"
+	AttributeKeyOldSpreadFactor                                    = "old_spread_factor"
 	AttributeKeyTokensIn                                           = "tokens_in"
 	AttributeKeyTokensOut                                          = "tokens_out"
 	AttributeKeyForfeitedTokens                                    = "forfeited_tokens"
@@ -34,8 +54,11 @@ const (
 	AttributeIncentiveEmissionRate                                 = "incentive_emission_rate"
 	AttributeIncentiveStartTime                                    = "incentive_start_time"
 	AttributeIncentiveMinUptime                                    = "incentive_min_uptime"
+	AttributeKeyIncentiveId                                        = "incentive_id"
+	AttributeKeyRefundedCoin                                       = "refunded_coin"
 	AttributeInputPositionIds                                      = "input_position_ids"
 	AttributeOutputPositionId                                      = "output_position_id"
+	AttributeOutputPositionIds                                     = "output_position_ids"
 	AttributePoolAccumName                                         = "pool_accum_name"
 	AttributeOldPositionAccumName                                  = "old_position_accum_name"
 	AttributeNewPositionAccumName                                  = "new_position_accum_name"
@@ -46,4 +69,19 @@ const (
 	AttributeKeyTickIndex                                          = "tick_idx"
 	AttributeKeySpreadRewardGrowthOppositeDirectionOfLastTraversal = "spread_reward_growth"
 	AttributeKeyUptimeGrowthOppositeDirectionOfLastTraversal       = "uptime_growth"
+	AttributeKeyNewOwner                                           = "new_owner"
+	AttributeKeyAutoCompound                                       = "auto_compound"
+	AttributeKeyOperator                                           = "operator"
+	AttributeKeyApproved                                           = "approved"
+	AttributeKeyCardinalityNextOld                                 = "cardinality_next_old"
+	AttributeKeyCardinalityNextNew                                 = "cardinality_next_new"
+	AttributeKeyDust                                               = "dust"
+	AttributeKeyTickSpacing                                        = "tick_spacing"
+	AttributeKeyOldTickSpacing                                     = "old_tick_spacing"
+	AttributeKeyEnabled                                            = "enabled"
+	AttributeKeyMinSpreadFactor                                    = "min_spread_factor"
+	AttributeKeyMaxSpreadFactor                                    = "max_spread_factor"
+	AttributeKeyVolatility                                         = "volatility"
+	AttributeKeyJITProtectionBlocks                                = "jit_protection_blocks"
+	AttributeKeyOldJITProtectionBlocks                             = "old_jit_protection_blocks"
 )
@@ -1,6 +1,8 @@
 package genesis
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
 )
 
@@ -25,5 +27,25 @@ func (gs GenesisState) Validate() error {
 	if gs.NextIncentiveRecordId == 0 {
 		return types.InvalidNextIncentiveRecordIdError{NextIncentiveRecordId: gs.NextIncentiveRecordId}
 	}
+	for _, approval := range gs.PositionOperatorApprovals {
+		if _, err := sdk.AccAddressFromBech32(approval.Owner); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(approval.Operator); err != nil {
+			return err
+		}
+	}
+	for _, poolData := range gs.PoolData {
+		if poolData.ObservationState == nil {
+			continue
+		}
+		state := poolData.ObservationState
+		if state.Cardinality == 0 || state.Cardinality > state.CardinalityNext || state.CardinalityNext > types.MaxObservationCardinality || state.Index >= state.Cardinality {
+			return types.InvalidObservationStateError{Index: state.Index, Cardinality: state.Cardinality, CardinalityNext: state.CardinalityNext}
+		}
+		if len(poolData.Observations) != int(state.CardinalityNext) {
+			return types.InvalidObservationStateError{Index: state.Index, Cardinality: state.Cardinality, CardinalityNext: state.CardinalityNext}
+		}
+	}
 	return nil
 }
@@ -102,6 +102,22 @@ type GenesisPoolData struct {
 	// incentive records to be set
 	IncentiveRecords []types1.IncentiveRecord `protobuf:"bytes,5,rep,name=incentive_records,json=incentiveRecords,proto3" json:"incentive_records"`
 	PositionData     []PositionData           `protobuf:"bytes,6,rep,name=position_data,json=positionData,proto3" json:"position_data"`
+	// observation_state is the state of the pool's observation ring buffer.
+	// It is nil if no observation was written for the pool yet.
+	ObservationState *types1.ObservationState `protobuf:"bytes,7,opt,name=observation_state,json=observationState,proto3" json:"observation_state,omitempty" yaml:"observation_state"`
+	// observations are all allocated observation slots of the pool, ordered by
+	// slot index.
+	Observations []types1.Observation `protobuf:"bytes,8,rep,name=observations,proto3" json:"observations" yaml:"observations"`
+	// dynamic_spread_factor is the dynamic spread factor configuration of the
+	// pool. It is nil if the pool did not opt into a dynamic spread factor.
+	DynamicSpreadFactor *types1.DynamicSpreadFactor `protobuf:"bytes,9,opt,name=dynamic_spread_factor,json=dynamicSpreadFactor,proto3" json:"dynamic_spread_factor,omitempty" yaml:"dynamic_spread_factor"`
+	// tick_spacing_migration_pending indicates whether the migration of the
+	// pool's positions to a new tick spacing is still in progress.
+	TickSpacingMigrationPending bool `protobuf:"varint,10,opt,name=tick_spacing_migration_pending,json=tickSpacingMigrationPending,proto3" json:"tick_spacing_migration_pending,omitempty" yaml:"tick_spacing_migration_pending"`
+	// tick_spacing_migration_cursor is the id of the last position migrated by
+	// the pending tick spacing migration of the pool. It is only meaningful if
+	// tick_spacing_migration_pending is true.
+	TickSpacingMigrationCursor uint64 `protobuf:"varint,11,opt,name=tick_spacing_migration_cursor,json=tickSpacingMigrationCursor,proto3" json:"tick_spacing_migration_cursor,omitempty" yaml:"tick_spacing_migration_cursor"`
 }
 
 func (m *GenesisPoolData) Reset()         { *m = GenesisPoolData{} }
@@ -179,11 +195,56 @@ func (m *GenesisPoolData) GetPositionData() []PositionData {
 	return nil
 }
 
+func (m *GenesisPoolData) GetObservationState() *types1.ObservationState {
+	if m != nil {
+		return m.ObservationState
+	}
+	return nil
+}
+
+func (m *GenesisPoolData) GetObservations() []types1.Observation {
+	if m != nil {
+		return m.Observations
+	}
+	return nil
+}
+
+func (m *GenesisPoolData) GetDynamicSpreadFactor() *types1.DynamicSpreadFactor {
+	if m != nil {
+		return m.DynamicSpreadFactor
+	}
+	return nil
+}
+
+func (m *GenesisPoolData) GetTickSpacingMigrationPending() bool {
+	if m != nil {
+		return m.TickSpacingMigrationPending
+	}
+	return false
+}
+
+func (m *GenesisPoolData) GetTickSpacingMigrationCursor() uint64 {
+	if m != nil {
+		return m.TickSpacingMigrationCursor
+	}
+	return 0
+}
+
 type PositionData struct {
 	Position                *PositionWithoutPoolId `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 	LockId                  uint64                 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
 	SpreadRewardAccumRecord accum.Record           `protobuf:"bytes,3,opt,name=spread_reward_accum_record,json=spreadRewardAccumRecord,proto3" json:"spread_reward_accum_record"`
 	UptimeAccumRecords      []accum.Record         `protobuf:"bytes,4,rep,name=uptime_accum_records,json=uptimeAccumRecords,proto3" json:"uptime_accum_records"`
+	// auto_compound indicates whether the position opted into being compounded
+	// at the end of every day epoch.
+	AutoCompound bool `protobuf:"varint,5,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty" yaml:"auto_compound"`
+	// performance is the lifetime performance of the position. It is nil if
+	// the position has no performance record.
+	Performance *types1.PositionPerformance `protobuf:"bytes,6,opt,name=performance,proto3" json:"performance,omitempty" yaml:"performance"`
+	// creation_height is the block height at which the position was created.
+	// It is zero if the height was not recorded, i.e. the position was created
+	// while its pool's JIT protection was disabled.
+	CreationHeight uint64 `protobuf:"varint,7,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
 }
 
 func (m *PositionData) Reset()         { *m = PositionData{} }
@@ -247,6 +308,27 @@ func (m *PositionData) GetUptimeAccumRecords() []accum.Record {
 	return nil
 }
 
+func (m *PositionData) GetAutoCompound() bool {
+	if m != nil {
+		return m.AutoCompound
+	}
+	return false
+}
+
+func (m *PositionData) GetPerformance() *types1.PositionPerformance {
+	if m != nil {
+		return m.Performance
+	}
+	return nil
+}
+
+func (m *PositionData) GetCreationHeight() uint64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
 type PositionWithoutPoolId struct {
 	PositionId uint64                                 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
 	Address    string                                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
@@ -332,6 +414,12 @@ type GenesisState struct {
 	PoolData              []GenesisPoolData `protobuf:"bytes,2,rep,name=pool_data,json=poolData,proto3" json:"pool_data"`
 	NextPositionId        uint64            `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
 	NextIncentiveRecordId uint64            `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
+	// position operator approvals granted by position owners.
+	PositionOperatorApprovals []types1.PositionOperatorApproval `protobuf:"bytes,6,rep,name=position_operator_approvals,json=positionOperatorApprovals,proto3" json:"position_operator_approvals" yaml:"position_operator_approvals"`
+	// auto_compound_cursor is the id of the last position compounded by the
+	// previous auto-compounding epoch. It is zero if auto-compounding starts
+	// from the first opted in position.
+	AutoCompoundCursor uint64 `protobuf:"varint,7,opt,name=auto_compound_cursor,json=autoCompoundCursor,proto3" json:"auto_compound_cursor,omitempty" yaml:"auto_compound_cursor"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -395,6 +483,20 @@ func (m *GenesisState) GetNextIncentiveRecordId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetPositionOperatorApprovals() []types1.PositionOperatorApproval {
+	if m != nil {
+		return m.PositionOperatorApprovals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundCursor() uint64 {
+	if m != nil {
+		return m.AutoCompoundCursor
+	}
+	return 0
+}
+
 type AccumObject struct {
 	// Accumulator's name (pulled from AccumulatorContent)
 	Name         string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
@@ -462,72 +564,96 @@ func init() {
 }
 
 var fileDescriptor_5c140d686ee6724a = []byte{
-	// 1027 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x3a, 0x76, 0x1a, 0x4f, 0xd2, 0x7f, 0xa3, 0xa4, 0xd9, 0x06, 0xe2, 0x0d, 0x53, 0x05,
-	0x05, 0xb5, 0xd9, 0x55, 0x12, 0x28, 0x12, 0xe2, 0x92, 0x6d, 0x01, 0x99, 0x03, 0x44, 0x43, 0x2a,
-	0xfe, 0xe3, 0x8e, 0x77, 0x27, 0xee, 0x34, 0xeb, 0x9d, 0x65, 0x67, 0x9c, 0x26, 0x57, 0x3e, 0x41,
-	0xc5, 0x89, 0x13, 0x7c, 0x09, 0x3e, 0x44, 0xc5, 0x01, 0x55, 0xe2, 0x82, 0x38, 0x18, 0x94, 0x7c,
-	0x03, 0x4b, 0xdc, 0xd1, 0xfc, 0x59, 0x7b, 0xe3, 0x26, 0x4a, 0xc2, 0xc9, 0x9e, 0xf9, 0xbd, 0xf7,
-	0x7b, 0x6f, 0xde, 0xfb, 0xcd, 0x9b, 0x05, 0xf7, 0xb8, 0xe8, 0x72, 0xc1, 0x44, 0x10, 0xf1, 0x34,
-	0xa2, 0xa9, 0xcc, 0x89, 0xa4, 0xf1, 0x5a, 0xc2, 0xbe, 0xef, 0xb1, 0x98, 0xc9, 0xc3, 0xa0, 0x43,
-	0x53, 0x2a, 0x98, 0xf0, 0xb3, 0x9c, 0x4b, 0x0e, 0x57, 0xac, 0xb5, 0x5f, 0xb6, 0x1e, 0x1a, 0xfb,
-	0xfb, 0xeb, 0x6d, 0x2a, 0xc9, 0xfa, 0xe2, 0x5c, 0x87, 0x77, 0xb8, 0xf6, 0x08, 0xd4, 0x3f, 0xe3,
-	0xbc, 0x78, 0x3b, 0xd2, 0xde, 0x2d, 0x03, 0x98, 0x85, 0x85, 0x1a, 0x66, 0x15, 0xb4, 0x89, 0xa0,
-	0x81, 0x65, 0x09, 0x22, 0xce, 0xd2, 0xc2, 0xb5, 0xc3, 0x79, 0x27, 0xa1, 0x81, 0x5e, 0xb5, 0x7b,
-	0xbb, 0x01, 0x49, 0x0f, 0x2d, 0xe4, 0x8d, 0x43, 0x92, 0x75, 0xa9, 0x90, 0xa4, 0x9b, 0x59, 0x83,
-	0x37, 0x8a, 0x13, 0x92, 0x28, 0xea, 0x75, 0x87, 0xec, 0x7a, 0x65, 0x4d, 0xee, 0x9e, 0x53, 0x84,
-	0x8c, 0xe4, 0xa4, 0x5b, 0xe4, 0xba, 0x76, 0x8e, 0xb1, 0x64, 0xd1, 0x5e, 0x33, 0xdd, 0x2d, 0x4e,
-	0xfd, 0xce, 0x39, 0xe6, 0x4c, 0xef, 0xb2, 0x7d, 0xda, 0xca, 0x69, 0xc4, 0xf3, 0xd8, 0xb8, 0xa1,
-	0x5f, 0x1c, 0x30, 0xfd, 0x61, 0x2f, 0x49, 0x76, 0x58, 0xb4, 0x07, 0xdf, 0x06, 0x40, 0xb1, 0xb6,
-	0x58, 0x1a, 0xd3, 0x03, 0xd7, 0x59, 0x76, 0x56, 0x27, 0xc3, 0xf9, 0x41, 0xdf, 0xbb, 0x79, 0x48,
-	0xba, 0xc9, 0x7b, 0x68, 0x84, 0x21, 0x5c, 0x37, 0xe1, 0x63, 0x7a, 0x00, 0xbf, 0x05, 0x55, 0x96,
-	0xee, 0x72, 0xb7, 0xb2, 0xec, 0xac, 0xce, 0x6c, 0x04, 0xfe, 0x85, 0x7a, 0xe7, 0xef, 0xd8, 0xf4,
-	0x43, 0xf7, 0x45, 0xdf, 0x9b, 0x18, 0xf4, 0xbd, 0x1b, 0x27, 0x82, 0xec, 0x72, 0x84, 0x35, 0x2d,
-	0xfa, 0xb9, 0x06, 0xae, 0x7f, 0x64, 0xd4, 0xb1, 0xcd, 0x79, 0xf2, 0x90, 0x48, 0x02, 0x37, 0x41,
-	0x35, 0xe3, 0x3c, 0xd1, 0x29, 0xce, 0x6c, 0xcc, 0xf9, 0xa6, 0x37, 0x7e, 0xd1, 0x1b, 0x7f, 0x2b,
-	0x3d, 0x0c, 0xeb, 0xbf, 0xfd, 0xba, 0x56, 0x53, 0x1e, 0x4d, 0xac, 0x8d, 0xe1, 0xd7, 0xa0, 0xa6,
-	0xc8, 0x85, 0x5b, 0x59, 0x9e, 0xbc, 0x44, 0xa2, 0x45, 0x75, 0xc2, 0x39, 0x9b, 0xe8, 0xec, 0x28,
-	0x51, 0x81, 0xb0, 0xe1, 0x84, 0x3f, 0x39, 0xe0, 0xb6, 0xc8, 0x72, 0x4a, 0xe2, 0x56, 0x4e, 0x9f,
-	0x91, 0x3c, 0x6e, 0xe9, 0xc6, 0xf7, 0x12, 0x22, 0x79, 0xee, 0x4e, 0xea, 0x3c, 0x37, 0x2e, 0x18,
-	0x71, 0x4b, 0x79, 0x7e, 0xda, 0x7e, 0x4a, 0x23, 0x19, 0xae, 0xda, 0xa0, 0xcb, 0x26, 0xe8, 0x99,
-	0x21, 0x10, 0x5e, 0x30, 0x18, 0xd6, 0xd0, 0xd6, 0x08, 0x81, 0x3f, 0x3a, 0x60, 0x61, 0xd8, 0x7d,
-	0x51, 0x76, 0x12, 0x6e, 0x55, 0x97, 0xe2, 0xff, 0x24, 0xb6, 0x62, 0x13, 0x5b, 0x32, 0x89, 0x9d,
-	0x1e, 0x00, 0xe1, 0x5b, 0x23, 0xa0, 0x94, 0x93, 0x80, 0x0c, 0xdc, 0x1c, 0x57, 0xa4, 0x70, 0x6b,
-	0x3a, 0x9b, 0xfb, 0x17, 0xcc, 0xa6, 0x59, 0xf8, 0x63, 0xed, 0x1e, 0x56, 0x55, 0x46, 0xf8, 0x06,
-	0x3b, 0xb9, 0x2d, 0xe0, 0x77, 0xe0, 0x6a, 0xc6, 0x05, 0x93, 0x8c, 0xa7, 0xad, 0x98, 0x48, 0xe2,
-	0x4e, 0xe9, 0x30, 0x9b, 0x17, 0x0c, 0xb3, 0x6d, 0x7d, 0x95, 0xf0, 0x6c, 0x8c, 0xd9, 0xac, 0xb4,
-	0x87, 0x7e, 0xaf, 0x80, 0xd9, 0xb2, 0x11, 0xfc, 0x02, 0x4c, 0x17, 0x06, 0x56, 0xa1, 0xef, 0x5f,
-	0x32, 0xd6, 0xe7, 0x4c, 0x3e, 0xe1, 0x3d, 0xa9, 0xd5, 0x1b, 0xe3, 0x21, 0x1b, 0xbc, 0x0b, 0xae,
-	0x24, 0x5c, 0xdd, 0x8f, 0x58, 0xdf, 0xb6, 0x6a, 0x08, 0x07, 0x7d, 0xef, 0x9a, 0xe9, 0x80, 0x05,
-	0x10, 0x9e, 0x52, 0xff, 0x9a, 0x31, 0x7c, 0x0c, 0x16, 0x4f, 0x91, 0x8b, 0x2d, 0xb6, 0x95, 0xe4,
-	0xd2, 0x30, 0x31, 0x33, 0xa7, 0x8a, 0x44, 0x4e, 0x94, 0xf4, 0x55, 0x65, 0x19, 0x18, 0x3e, 0x02,
-	0x73, 0xbd, 0x4c, 0xcd, 0xc1, 0x13, 0xd4, 0x85, 0xaa, 0x2e, 0xc4, 0x0d, 0x0d, 0x41, 0x89, 0x55,
-	0xa0, 0x7f, 0x2b, 0x60, 0xfe, 0xd4, 0x4a, 0xc0, 0x77, 0xc1, 0xcc, 0xb0, 0x95, 0x2c, 0xd6, 0xc5,
-	0xad, 0x86, 0xb7, 0x06, 0x7d, 0x0f, 0x9a, 0x1a, 0x94, 0x40, 0x84, 0x41, 0xb1, 0x6a, 0xc6, 0xf0,
-	0x1e, 0xb8, 0x42, 0xe2, 0x38, 0xa7, 0x42, 0xe8, 0xc2, 0xd5, 0xcb, 0x85, 0xb3, 0x00, 0xc2, 0x85,
-	0x09, 0x5c, 0x02, 0x20, 0xe1, 0xcf, 0x68, 0xde, 0x52, 0x77, 0x5b, 0x57, 0x6a, 0x12, 0xd7, 0xf5,
-	0x8e, 0x1e, 0x93, 0x4b, 0x00, 0xf4, 0xb2, 0xac, 0x80, 0xab, 0x06, 0xd6, 0x3b, 0x1a, 0x7e, 0x04,
-	0xea, 0x4f, 0x39, 0x4b, 0x5b, 0xea, 0x5c, 0x6e, 0x4d, 0x97, 0x79, 0xf1, 0x95, 0x09, 0xb5, 0x53,
-	0xbc, 0x1e, 0xe1, 0xeb, 0x27, 0xe7, 0xdf, 0xd0, 0x15, 0x3d, 0xff, 0xdb, 0x73, 0xf0, 0xb4, 0x5a,
-	0x2b, 0x63, 0xf8, 0x18, 0xd4, 0x87, 0x82, 0x71, 0xa7, 0xf4, 0x21, 0x42, 0xe5, 0xfa, 0x57, 0xdf,
-	0x7b, 0xb3, 0xc3, 0xe4, 0x93, 0x5e, 0xdb, 0x8f, 0x78, 0xd7, 0xbe, 0x77, 0xf6, 0x67, 0x4d, 0xc4,
-	0x7b, 0x81, 0x3c, 0xcc, 0xa8, 0xf0, 0x1f, 0xd2, 0x68, 0x14, 0x64, 0x48, 0x84, 0xf0, 0x88, 0x14,
-	0xfd, 0x51, 0x01, 0xb3, 0x76, 0xd2, 0x7e, 0x26, 0x89, 0xa4, 0xf0, 0x01, 0x98, 0x32, 0x4f, 0x92,
-	0x95, 0xf1, 0xca, 0x39, 0x32, 0xde, 0xd6, 0xc6, 0xb6, 0xb3, 0xd6, 0x15, 0x7e, 0x09, 0xea, 0x6a,
-	0xfc, 0x9a, 0xab, 0x57, 0xb9, 0xd4, 0x0d, 0x1f, 0x1b, 0xfb, 0x96, 0x78, 0x3a, 0x2b, 0x9e, 0x81,
-	0x0f, 0xc0, 0x8d, 0x94, 0x1e, 0xc8, 0x56, 0x59, 0x13, 0x55, 0xad, 0x89, 0xd7, 0x06, 0x7d, 0x6f,
-	0xc1, 0x9c, 0x75, 0xdc, 0x02, 0xe1, 0x6b, 0x6a, 0x6b, 0x7b, 0x24, 0x8e, 0x6f, 0x80, 0xab, 0x8d,
-	0xc6, 0x07, 0x92, 0xa2, 0xab, 0x69, 0xba, 0x3b, 0x83, 0xbe, 0xe7, 0x95, 0xe8, 0x4e, 0xb1, 0x44,
-	0x78, 0x5e, 0x41, 0x63, 0x43, 0xa9, 0x19, 0xa3, 0x1f, 0x1c, 0x30, 0x53, 0x1a, 0x9c, 0xf0, 0x0e,
-	0xa8, 0xa6, 0xa4, 0x4b, 0x75, 0x49, 0xeb, 0xe1, 0xf5, 0x41, 0xdf, 0x9b, 0xb1, 0xcc, 0xa4, 0x4b,
-	0x11, 0xd6, 0x20, 0xfc, 0x04, 0x5c, 0x35, 0x57, 0x2a, 0xe2, 0xa9, 0xa4, 0xa9, 0xb4, 0x8f, 0xeb,
-	0x5b, 0x67, 0x5c, 0xa9, 0xd2, 0x68, 0x7d, 0x60, 0x1c, 0xf0, 0xac, 0xb6, 0xb0, 0xab, 0x30, 0x7e,
-	0x71, 0xd4, 0x70, 0x5e, 0x1e, 0x35, 0x9c, 0x7f, 0x8e, 0x1a, 0xce, 0xf3, 0xe3, 0xc6, 0xc4, 0xcb,
-	0xe3, 0xc6, 0xc4, 0x9f, 0xc7, 0x8d, 0x89, 0xaf, 0x3e, 0x2e, 0x69, 0xc7, 0x92, 0xaf, 0x25, 0xa4,
-	0x2d, 0x8a, 0x45, 0xb0, 0xbf, 0x7e, 0x3f, 0x38, 0x38, 0xf3, 0x23, 0x44, 0x69, 0xab, 0xf8, 0x78,
-	0x6b, 0x4f, 0x69, 0x79, 0x6f, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x81, 0xb5, 0x85, 0xed,
-	0x09, 0x00, 0x00,
+	// 1417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x73, 0xdb, 0xc4,
+	0x17, 0x8f, 0x12, 0x27, 0x8d, 0xd7, 0x6e, 0x9b, 0x6e, 0x93, 0x56, 0x4d, 0x1a, 0xcb, 0x5f, 0xf5,
+	0x1b, 0x26, 0xa5, 0x8d, 0x4d, 0x53, 0x68, 0x87, 0x0e, 0x0c, 0x13, 0xa5, 0x14, 0xc2, 0x0c, 0x34,
+	0x6c, 0xdb, 0xe1, 0x37, 0xea, 0x5a, 0xda, 0x38, 0xdb, 0x58, 0x5a, 0xa1, 0x95, 0xd3, 0xe4, 0xda,
+	0x13, 0xc7, 0x0e, 0x27, 0x6e, 0xfc, 0x01, 0x5c, 0xf9, 0x23, 0x3a, 0x9c, 0x7a, 0xe0, 0xc0, 0x70,
+	0x10, 0x4c, 0xf3, 0x1f, 0x68, 0x86, 0x3b, 0xa3, 0xdd, 0x95, 0x2d, 0xff, 0x08, 0x76, 0x38, 0x25,
+	0xbb, 0xef, 0x7d, 0x3e, 0xef, 0x69, 0xf7, 0xf3, 0xde, 0x3e, 0x83, 0xeb, 0x8c, 0x7b, 0x8c, 0x53,
+	0x5e, 0x77, 0x98, 0xef, 0x10, 0x3f, 0x0a, 0x71, 0x44, 0xdc, 0xb5, 0x16, 0xfd, 0xae, 0x4d, 0x5d,
+	0x1a, 0x1d, 0xd6, 0x9b, 0xc4, 0x27, 0x9c, 0xf2, 0x5a, 0x10, 0xb2, 0x88, 0xc1, 0x15, 0xe5, 0x5d,
+	0xcb, 0x7b, 0x77, 0x9c, 0x6b, 0xfb, 0x37, 0x1a, 0x24, 0xc2, 0x37, 0x16, 0xe7, 0x9b, 0xac, 0xc9,
+	0x04, 0xa2, 0x9e, 0xfe, 0x27, 0xc1, 0x8b, 0x97, 0x1c, 0x81, 0xb6, 0xa5, 0x41, 0x2e, 0x94, 0xa9,
+	0x22, 0x57, 0xf5, 0x06, 0xe6, 0xa4, 0xae, 0x58, 0xea, 0x0e, 0xa3, 0x7e, 0x06, 0x6d, 0x32, 0xd6,
+	0x6c, 0x91, 0xba, 0x58, 0x35, 0xda, 0x3b, 0x75, 0xec, 0x1f, 0x2a, 0x93, 0xd1, 0x6f, 0x8a, 0xa8,
+	0x47, 0x78, 0x84, 0xbd, 0x40, 0x39, 0xfc, 0x2f, 0xfb, 0x42, 0xec, 0x38, 0x6d, 0xaf, 0xc3, 0x2e,
+	0x56, 0xca, 0xe5, 0xda, 0x88, 0x43, 0x08, 0x70, 0x88, 0xbd, 0x2c, 0xd7, 0xb5, 0x11, 0xce, 0x11,
+	0x75, 0xf6, 0xb6, 0xfc, 0x9d, 0xec, 0xab, 0xdf, 0x1a, 0xe1, 0x4e, 0xc5, 0x2e, 0xdd, 0x27, 0x76,
+	0x48, 0x1c, 0x16, 0xba, 0x0a, 0x76, 0x6b, 0x54, 0x4a, 0x8c, 0xd3, 0x88, 0x32, 0xdf, 0x66, 0x01,
+	0x09, 0x71, 0xc4, 0x42, 0x85, 0x7b, 0x63, 0x04, 0x8e, 0x35, 0x38, 0x09, 0xf7, 0x71, 0x0a, 0x55,
+	0x88, 0xb7, 0xc7, 0x8d, 0x14, 0x90, 0x70, 0x87, 0x85, 0x1e, 0xf6, 0x1d, 0xa2, 0xa0, 0x77, 0x46,
+	0x40, 0xdd, 0x43, 0x1f, 0x7b, 0xd4, 0xb1, 0x79, 0x10, 0x12, 0xec, 0xda, 0x3b, 0xd8, 0xe9, 0x24,
+	0x6a, 0xfe, 0xa4, 0x81, 0xd9, 0x7b, 0xed, 0x56, 0xeb, 0x21, 0x75, 0xf6, 0xe0, 0x9b, 0x00, 0xa4,
+	0xc7, 0x66, 0x53, 0xdf, 0x25, 0x07, 0xba, 0x56, 0xd5, 0x56, 0xa7, 0xac, 0x85, 0x24, 0x36, 0xce,
+	0x1d, 0x62, 0xaf, 0x75, 0xc7, 0xec, 0xda, 0x4c, 0x54, 0x94, 0xe7, 0xeb, 0x92, 0x03, 0xf8, 0x0d,
+	0x28, 0x50, 0x7f, 0x87, 0xe9, 0x93, 0x55, 0x6d, 0xb5, 0xb4, 0x5e, 0xaf, 0x8d, 0x25, 0xce, 0xda,
+	0x43, 0x75, 0x3f, 0x96, 0xfe, 0x22, 0x36, 0x26, 0x92, 0xd8, 0x98, 0xeb, 0x09, 0xb2, 0xc3, 0x4c,
+	0x24, 0x68, 0xcd, 0xef, 0x01, 0x38, 0xfb, 0x81, 0x94, 0xff, 0x36, 0x63, 0xad, 0xbb, 0x38, 0xc2,
+	0xf0, 0x26, 0x28, 0x04, 0x8c, 0xb5, 0x44, 0x8a, 0xa5, 0xf5, 0xf9, 0x9a, 0x14, 0x5f, 0x2d, 0x13,
+	0x5f, 0x6d, 0xc3, 0x3f, 0xb4, 0x8a, 0xbf, 0xfe, 0xb2, 0x36, 0x9d, 0x22, 0xb6, 0x90, 0x70, 0x86,
+	0x5f, 0x81, 0xe9, 0x94, 0x9c, 0xeb, 0x93, 0xd5, 0xa9, 0x13, 0x24, 0x9a, 0x9d, 0x8e, 0x35, 0xaf,
+	0x12, 0x2d, 0x77, 0x13, 0xe5, 0x26, 0x92, 0x9c, 0xf0, 0x47, 0x0d, 0x5c, 0x52, 0xe7, 0x1b, 0x92,
+	0xa7, 0x38, 0x74, 0x6d, 0xa1, 0xec, 0x76, 0x2b, 0x15, 0x85, 0x3e, 0x25, 0xf2, 0x5c, 0x1f, 0x33,
+	0xe2, 0x46, 0x8a, 0xbc, 0xdf, 0x78, 0x42, 0x9c, 0xc8, 0x5a, 0x55, 0x41, 0xab, 0x32, 0xe8, 0xb1,
+	0x21, 0x4c, 0x74, 0x51, 0xda, 0x90, 0x30, 0x6d, 0x74, 0x2d, 0xf0, 0x07, 0x0d, 0x5c, 0xec, 0xc8,
+	0x9b, 0xe7, 0x41, 0x5c, 0x2f, 0x54, 0xa7, 0xfe, 0x63, 0x62, 0x2b, 0x2a, 0xb1, 0x65, 0x99, 0xd8,
+	0xf0, 0x00, 0x26, 0xba, 0xd0, 0x35, 0xe4, 0x72, 0xe2, 0x90, 0x82, 0x73, 0xfd, 0x25, 0xc7, 0xf5,
+	0x69, 0x91, 0xcd, 0xad, 0x31, 0xb3, 0xd9, 0xca, 0xf0, 0x48, 0xc0, 0xad, 0x42, 0x9a, 0x11, 0x9a,
+	0xa3, 0xbd, 0xdb, 0x1c, 0x7e, 0x0b, 0x4e, 0x77, 0x8a, 0xc7, 0xc5, 0x11, 0xd6, 0x67, 0x44, 0x98,
+	0x9b, 0x63, 0x86, 0xd9, 0x56, 0xd8, 0x54, 0x78, 0x2a, 0x46, 0x39, 0xc8, 0xed, 0xc1, 0x67, 0x1a,
+	0x38, 0x97, 0xab, 0x67, 0x9b, 0x47, 0x38, 0x22, 0xfa, 0x29, 0x71, 0xe5, 0xb7, 0xc7, 0x0c, 0x72,
+	0xbf, 0x8b, 0x7f, 0x90, 0xc2, 0xad, 0xcb, 0x49, 0x6c, 0xe8, 0xf2, 0x68, 0x07, 0xb8, 0x4d, 0x34,
+	0xc7, 0xfa, 0xfc, 0x21, 0x07, 0xe5, 0xdc, 0x1e, 0xd7, 0x67, 0x4f, 0x74, 0xb1, 0xb9, 0xf0, 0xd6,
+	0x92, 0xba, 0xd8, 0xf3, 0x03, 0xd1, 0xb9, 0x89, 0x7a, 0x82, 0xc0, 0xe7, 0x1a, 0x58, 0x18, 0xda,
+	0x5c, 0xf4, 0xa2, 0xf8, 0xfa, 0x3b, 0x63, 0x86, 0xbf, 0x2b, 0x39, 0x1e, 0x08, 0x8a, 0x7b, 0x82,
+	0xc1, 0xaa, 0x26, 0xb1, 0x71, 0x59, 0xa6, 0x30, 0x34, 0x84, 0x89, 0xce, 0xbb, 0x83, 0x30, 0xe8,
+	0x83, 0x8a, 0xe8, 0x20, 0x3c, 0xc0, 0x0e, 0xf5, 0x9b, 0xb6, 0x47, 0x9b, 0x21, 0x56, 0x7d, 0xd3,
+	0x77, 0xa9, 0xdf, 0xd4, 0x41, 0x55, 0x5b, 0x9d, 0xb5, 0xae, 0x26, 0xb1, 0xb1, 0x92, 0xeb, 0x38,
+	0xc7, 0xfa, 0x9b, 0x68, 0x29, 0x75, 0x78, 0x20, 0xed, 0x1f, 0x67, 0xe6, 0x6d, 0x69, 0x85, 0x7b,
+	0x60, 0xf9, 0x18, 0xbc, 0xd3, 0x0e, 0x39, 0x0b, 0xf5, 0x52, 0x55, 0x5b, 0x2d, 0x58, 0xab, 0x49,
+	0x6c, 0xfc, 0xff, 0x5f, 0xc3, 0x49, 0x77, 0x13, 0x2d, 0x0e, 0x8b, 0xb6, 0x29, 0x8d, 0x2f, 0x0a,
+	0xa0, 0x9c, 0x97, 0x23, 0xfc, 0x1c, 0xcc, 0x66, 0x52, 0x54, 0xbd, 0xf0, 0x9d, 0x13, 0xaa, 0xfa,
+	0x33, 0x1a, 0xed, 0xb2, 0x76, 0x24, 0xfa, 0xa4, 0x8b, 0x3a, 0x6c, 0xf0, 0x1a, 0x38, 0xd5, 0x62,
+	0x69, 0x27, 0x76, 0x45, 0x5f, 0x2f, 0x58, 0x30, 0x89, 0x8d, 0x33, 0xf2, 0x0b, 0x94, 0xc1, 0x44,
+	0x33, 0xe9, 0x7f, 0x5b, 0x2e, 0x7c, 0x0c, 0x16, 0x87, 0x34, 0x26, 0x55, 0xd6, 0xaa, 0xf9, 0x2d,
+	0x77, 0x12, 0x13, 0xc6, 0x4e, 0x22, 0x3d, 0xc5, 0x3b, 0xd8, 0xc3, 0xa4, 0x19, 0x3e, 0x02, 0xf3,
+	0xed, 0x20, 0xa2, 0x1e, 0xe9, 0xa1, 0xce, 0xfa, 0xd7, 0x58, 0xdc, 0x50, 0x12, 0xe4, 0x58, 0x39,
+	0x7c, 0x17, 0x9c, 0xc6, 0xed, 0x88, 0xd9, 0x0e, 0xf3, 0x02, 0xd6, 0xf6, 0x5d, 0x7d, 0x5a, 0x88,
+	0x43, 0x4f, 0x62, 0x63, 0x5e, 0x7e, 0x6b, 0x8f, 0xd9, 0x44, 0xe5, 0x74, 0xbd, 0xa9, 0x96, 0x30,
+	0x02, 0xa5, 0xdc, 0x6b, 0xac, 0xcf, 0x9c, 0x48, 0xf4, 0xd9, 0x0d, 0x6c, 0x77, 0x19, 0xac, 0x0b,
+	0x49, 0x6c, 0x40, 0x19, 0x38, 0x47, 0x6c, 0xa2, 0x7c, 0x18, 0xb8, 0x09, 0xce, 0x3a, 0x21, 0x91,
+	0xaa, 0xd9, 0x25, 0xb4, 0xb9, 0x1b, 0x89, 0x66, 0x53, 0xb0, 0x16, 0x93, 0xd8, 0xb8, 0x20, 0xd1,
+	0x7d, 0x0e, 0x26, 0x3a, 0x93, 0xed, 0x7c, 0x28, 0x37, 0xfe, 0x9e, 0x04, 0x0b, 0x43, 0x35, 0x00,
+	0x6f, 0x83, 0x52, 0xa7, 0x5d, 0x52, 0x57, 0xc8, 0xaa, 0xd0, 0x93, 0x58, 0xd7, 0x68, 0x22, 0x90,
+	0xad, 0xb6, 0x5c, 0x78, 0x1d, 0x9c, 0xc2, 0xae, 0x1b, 0x12, 0xce, 0x85, 0x64, 0x8a, 0x79, 0xc9,
+	0x28, 0x83, 0x89, 0x32, 0x17, 0xb8, 0x0c, 0x40, 0x8b, 0x3d, 0x25, 0xa1, 0x9d, 0xea, 0x5d, 0x68,
+	0x64, 0x0a, 0x15, 0xc5, 0x8e, 0x18, 0x45, 0x96, 0x01, 0x68, 0x07, 0x41, 0x66, 0x2e, 0x48, 0xb3,
+	0xd8, 0x11, 0xe6, 0x47, 0xa0, 0xf8, 0x84, 0x51, 0xdf, 0x4e, 0x6f, 0x54, 0x5c, 0x5a, 0x69, 0x7d,
+	0x71, 0x60, 0x0a, 0x78, 0x98, 0x8d, 0xa0, 0xd6, 0xe5, 0xde, 0x19, 0xa3, 0x03, 0x35, 0x9f, 0xff,
+	0x69, 0x68, 0x68, 0x36, 0x5d, 0xa7, 0xce, 0xf0, 0x31, 0x28, 0x76, 0x2e, 0x4a, 0x5c, 0x67, 0xd1,
+	0xb2, 0x52, 0xe8, 0x1f, 0xb1, 0xf1, 0x5a, 0x93, 0x46, 0xbb, 0xed, 0x46, 0xcd, 0x61, 0x9e, 0x1a,
+	0x9a, 0xd5, 0x9f, 0x35, 0xee, 0xee, 0xd5, 0xa3, 0xc3, 0x80, 0xf0, 0xda, 0x5d, 0xe2, 0x74, 0x83,
+	0x74, 0x88, 0x4c, 0xd4, 0x25, 0x35, 0x7f, 0x2b, 0x80, 0xb2, 0x9a, 0x66, 0x64, 0xe3, 0xde, 0x04,
+	0x33, 0x72, 0xae, 0x55, 0x05, 0xbc, 0x32, 0x42, 0x3e, 0xdb, 0xc2, 0x59, 0x69, 0x5a, 0x41, 0xe1,
+	0x17, 0xa0, 0x98, 0x8e, 0x38, 0xf2, 0x79, 0x9b, 0x3c, 0xd1, 0x2b, 0xda, 0x37, 0x5a, 0x29, 0xe2,
+	0xd9, 0x40, 0xad, 0xe1, 0xfb, 0x60, 0xce, 0x27, 0x07, 0x91, 0x9d, 0xd7, 0x44, 0x41, 0x68, 0x62,
+	0x29, 0x89, 0x8d, 0x8b, 0xf2, 0x5b, 0xfb, 0x3d, 0x4c, 0x74, 0x26, 0xdd, 0xda, 0xee, 0x8a, 0xe3,
+	0x6b, 0xa0, 0x0b, 0xa7, 0xfe, 0x47, 0x3f, 0xa5, 0x9b, 0x16, 0x74, 0x57, 0x92, 0xd8, 0x30, 0x72,
+	0x74, 0x43, 0x3c, 0x4d, 0xb4, 0x90, 0x9a, 0xfa, 0x1e, 0xfe, 0x2d, 0x17, 0xfe, 0xac, 0x81, 0xa5,
+	0x81, 0x51, 0xdc, 0xc6, 0x41, 0x10, 0xb2, 0x7d, 0xdc, 0xe2, 0xea, 0xc5, 0x7f, 0xef, 0x84, 0x95,
+	0x79, 0x5f, 0x11, 0x6d, 0x28, 0x1e, 0xeb, 0x75, 0x25, 0x23, 0xb3, 0xaf, 0x12, 0x06, 0x23, 0x9a,
+	0xe8, 0x52, 0x70, 0x0c, 0x0b, 0x87, 0x9f, 0x82, 0xf9, 0x9e, 0xb6, 0x92, 0x3d, 0x15, 0xb2, 0x8a,
+	0x8d, 0x24, 0x36, 0x96, 0x86, 0x34, 0x9f, 0xce, 0x0b, 0x01, 0xf3, 0x3d, 0x48, 0xbd, 0x0c, 0xcf,
+	0x34, 0x50, 0xca, 0x4d, 0x67, 0xf0, 0x0a, 0x28, 0xf8, 0xd8, 0x23, 0x42, 0x53, 0x45, 0xeb, 0x6c,
+	0x12, 0x1b, 0x25, 0x75, 0xb4, 0xd8, 0x23, 0x26, 0x12, 0x46, 0xf8, 0x09, 0x38, 0x2d, 0xbb, 0xa9,
+	0xc3, 0xfc, 0x88, 0xf8, 0x91, 0x9a, 0xe0, 0xaf, 0x1e, 0xd3, 0x4d, 0x73, 0xf3, 0xdb, 0xa6, 0x04,
+	0xa0, 0xb2, 0xf0, 0x50, 0x2b, 0xcb, 0xfd, 0xf2, 0xa3, 0x5c, 0x91, 0x28, 0x92, 0xb5, 0x16, 0x6e,
+	0xf0, 0x6c, 0x51, 0xdf, 0xbf, 0x71, 0xab, 0x7e, 0x70, 0xec, 0x4f, 0xb6, 0xb4, 0x88, 0xb2, 0x9f,
+	0xba, 0x2f, 0x5e, 0x55, 0xb4, 0x97, 0xaf, 0x2a, 0xda, 0x5f, 0xaf, 0x2a, 0xda, 0xf3, 0xa3, 0xca,
+	0xc4, 0xcb, 0xa3, 0xca, 0xc4, 0xef, 0x47, 0x95, 0x89, 0xc6, 0x8c, 0xa8, 0xef, 0x9b, 0xff, 0x0c,
+	0x00, 0xb4, 0xdb, 0x65, 0x86, 0x33, 0x0f, 0x00, 0x00,
 }
 
 func (m *FullTick) Marshal() (dAtA []byte, err error) {
@@ -588,6 +714,59 @@ func (m *GenesisPoolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TickSpacingMigrationCursor != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TickSpacingMigrationCursor))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TickSpacingMigrationPending {
+		i--
+		if m.TickSpacingMigrationPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DynamicSpreadFactor != nil {
+		{
+			size, err := m.DynamicSpreadFactor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Observations) > 0 {
+		for iNdEx := len(m.Observations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Observations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ObservationState != nil {
+		{
+			size, err := m.ObservationState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.PositionData) > 0 {
 		for iNdEx := len(m.PositionData) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -689,6 +868,33 @@ func (m *PositionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CreationHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Performance != nil {
+		{
+			size, err := m.Performance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AutoCompound {
+		i--
+		if m.AutoCompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.UptimeAccumRecords) > 0 {
 		for iNdEx := len(m.UptimeAccumRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -763,12 +969,12 @@ func (m *PositionWithoutPoolId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x32
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JoinTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime):])
-	if err6 != nil {
-		return 0, err6
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JoinTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n6
-	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i -= n9
+	i = encodeVarintGenesis(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x2a
 	if m.UpperTick != 0 {
@@ -816,6 +1022,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundCursor != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AutoCompoundCursor))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PositionOperatorApprovals) > 0 {
+		for iNdEx := len(m.PositionOperatorApprovals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionOperatorApprovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.NextIncentiveRecordId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextIncentiveRecordId))
 		i--
@@ -956,6 +1181,26 @@ func (m *GenesisPoolData) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.ObservationState != nil {
+		l = m.ObservationState.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Observations) > 0 {
+		for _, e := range m.Observations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DynamicSpreadFactor != nil {
+		l = m.DynamicSpreadFactor.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TickSpacingMigrationPending {
+		n += 2
+	}
+	if m.TickSpacingMigrationCursor != 0 {
+		n += 1 + sovGenesis(uint64(m.TickSpacingMigrationCursor))
+	}
 	return n
 }
 
@@ -980,6 +1225,16 @@ func (m *PositionData) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.AutoCompound {
+		n += 2
+	}
+	if m.Performance != nil {
+		l = m.Performance.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.CreationHeight))
+	}
 	return n
 }
 
@@ -1029,6 +1284,15 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextIncentiveRecordId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextIncentiveRecordId))
 	}
+	if len(m.PositionOperatorApprovals) > 0 {
+		for _, e := range m.PositionOperatorApprovals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AutoCompoundCursor != 0 {
+		n += 1 + sovGenesis(uint64(m.AutoCompoundCursor))
+	}
 	return n
 }
 
@@ -1391,6 +1655,151 @@ func (m *GenesisPoolData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObservationState == nil {
+				m.ObservationState = &types1.ObservationState{}
+			}
+			if err := m.ObservationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Observations = append(m.Observations, types1.Observation{})
+			if err := m.Observations[len(m.Observations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicSpreadFactor == nil {
+				m.DynamicSpreadFactor = &types1.DynamicSpreadFactor{}
+			}
+			if err := m.DynamicSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacingMigrationPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TickSpacingMigrationPending = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacingMigrationCursor", wireType)
+			}
+			m.TickSpacingMigrationCursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacingMigrationCursor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1563,6 +1972,81 @@ func (m *PositionData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompound = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Performance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Performance == nil {
+				m.Performance = &types1.PositionPerformance{}
+			}
+			if err := m.Performance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1924,6 +2408,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionOperatorApprovals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionOperatorApprovals = append(m.PositionOperatorApprovals, types1.PositionOperatorApproval{})
+			if err := m.PositionOperatorApprovals[len(m.PositionOperatorApprovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundCursor", wireType)
+			}
+			m.AutoCompoundCursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundCursor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -11,6 +11,10 @@ import (
 const (
 	ProposalTypeCreateConcentratedLiquidityPool = "CreateConcentratedLiquidityPool"
 	ProposalTypeTickSpacingDecrease             = "TickSpacingDecrease"
+	ProposalTypeTickSpacingUpdate               = "TickSpacingUpdate"
+	ProposalTypeSpreadFactorChange              = "SpreadFactorChange"
+	ProposalTypeDynamicSpreadFactor             = "DynamicSpreadFactor"
+	ProposalTypeJITProtectionChange             = "JITProtectionChange"
 )
 
 func init() {
@@ -18,11 +22,23 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(&CreateConcentratedLiquidityPoolsProposal{}, "osmosis/CreateCLPoolsProposal")
 	govtypes.RegisterProposalType(ProposalTypeTickSpacingDecrease)
 	govtypes.RegisterProposalTypeCodec(&TickSpacingDecreaseProposal{}, "osmosis/TickSpacingDecreaseProposal")
+	govtypes.RegisterProposalType(ProposalTypeTickSpacingUpdate)
+	govtypes.RegisterProposalTypeCodec(&TickSpacingUpdateProposal{}, "osmosis/TickSpacingUpdateProposal")
+	govtypes.RegisterProposalType(ProposalTypeSpreadFactorChange)
+	govtypes.RegisterProposalTypeCodec(&SpreadFactorChangeProposal{}, "osmosis/SpreadFactorChangeProposal")
+	govtypes.RegisterProposalType(ProposalTypeDynamicSpreadFactor)
+	govtypes.RegisterProposalTypeCodec(&DynamicSpreadFactorProposal{}, "osmosis/DynamicSpreadFactorProposal")
+	govtypes.RegisterProposalType(ProposalTypeJITProtectionChange)
+	govtypes.RegisterProposalTypeCodec(&JITProtectionChangeProposal{}, "osmosis/JITProtectionChangeProposal")
 }
 
 var (
 	_ govtypes.Content = &CreateConcentratedLiquidityPoolsProposal{}
 	_ govtypes.Content = &TickSpacingDecreaseProposal{}
+	_ govtypes.Content = &TickSpacingUpdateProposal{}
+	_ govtypes.Content = &SpreadFactorChangeProposal{}
+	_ govtypes.Content = &DynamicSpreadFactorProposal{}
+	_ govtypes.Content = &JITProtectionChangeProposal{}
 )
 
 // NewCreateConcentratedLiquidityPoolsProposal returns a new instance of a create concentrated liquidity pool proposal struct.
@@ -154,3 +170,294 @@ Records:     %s
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+func NewTickSpacingUpdateProposal(title, description string, records []PoolIdToTickSpacingRecord) govtypes.Content {
+	return &TickSpacingUpdateProposal{
+		Title:                      title,
+		Description:                description,
+		PoolIdToTickSpacingRecords: records,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *TickSpacingUpdateProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *TickSpacingUpdateProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *TickSpacingUpdateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *TickSpacingUpdateProposal) ProposalType() string {
+	return ProposalTypeTickSpacingUpdate
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *TickSpacingUpdateProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.PoolIdToTickSpacingRecords) == 0 {
+		return fmt.Errorf("empty proposal records")
+	}
+
+	seenPoolIds := make(map[uint64]bool, len(p.PoolIdToTickSpacingRecords))
+	for _, poolIdToTickSpacingRecord := range p.PoolIdToTickSpacingRecords {
+		if poolIdToTickSpacingRecord.PoolId <= uint64(0) {
+			return fmt.Errorf("Pool Id cannot be negative")
+		}
+
+		if seenPoolIds[poolIdToTickSpacingRecord.PoolId] {
+			return fmt.Errorf("duplicate pool id %d", poolIdToTickSpacingRecord.PoolId)
+		}
+		seenPoolIds[poolIdToTickSpacingRecord.PoolId] = true
+
+		if poolIdToTickSpacingRecord.NewTickSpacing <= uint64(0) {
+			return fmt.Errorf("tick spacing must be positive")
+		}
+	}
+	return nil
+}
+
+// String returns a string containing the update tick spacing proposal.
+func (p TickSpacingUpdateProposal) String() string {
+	recordsStr := ""
+	for _, record := range p.PoolIdToTickSpacingRecords {
+		recordsStr = recordsStr + fmt.Sprintf("(PoolID: %d, NewTickSpacing: %d) ", record.PoolId, record.NewTickSpacing)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Pools Tick Spacing Proposal:
+Title:       %s
+Description: %s
+Records:     %s
+`, p.Title, p.Description, recordsStr))
+	return b.String()
+}
+
+func NewSpreadFactorChangeProposal(title, description string, records []PoolIdToSpreadFactorRecord) govtypes.Content {
+	return &SpreadFactorChangeProposal{
+		Title:                       title,
+		Description:                 description,
+		PoolIdToSpreadFactorRecords: records,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SpreadFactorChangeProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SpreadFactorChangeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SpreadFactorChangeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SpreadFactorChangeProposal) ProposalType() string {
+	return ProposalTypeSpreadFactorChange
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *SpreadFactorChangeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.PoolIdToSpreadFactorRecords) == 0 {
+		return fmt.Errorf("empty proposal records")
+	}
+
+	seenPoolIds := make(map[uint64]bool, len(p.PoolIdToSpreadFactorRecords))
+	for _, poolIdToSpreadFactorRecord := range p.PoolIdToSpreadFactorRecords {
+		if poolIdToSpreadFactorRecord.PoolId <= uint64(0) {
+			return fmt.Errorf("Pool Id cannot be negative")
+		}
+
+		if seenPoolIds[poolIdToSpreadFactorRecord.PoolId] {
+			return fmt.Errorf("duplicate pool id %d", poolIdToSpreadFactorRecord.PoolId)
+		}
+		seenPoolIds[poolIdToSpreadFactorRecord.PoolId] = true
+
+		spreadFactor := poolIdToSpreadFactorRecord.NewSpreadFactor
+		if spreadFactor.IsNil() || spreadFactor.IsNegative() || spreadFactor.GTE(sdk.OneDec()) {
+			return InvalidSpreadFactorError{ActualSpreadFactor: spreadFactor}
+		}
+	}
+	return nil
+}
+
+// String returns a string containing the spread factor change proposal.
+func (p SpreadFactorChangeProposal) String() string {
+	recordsStr := ""
+	for _, record := range p.PoolIdToSpreadFactorRecords {
+		recordsStr = recordsStr + fmt.Sprintf("(PoolID: %d, NewSpreadFactor: %s) ", record.PoolId, record.NewSpreadFactor)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Change Pools Spread Factor Proposal:
+Title:       %s
+Description: %s
+Records:     %s
+`, p.Title, p.Description, recordsStr))
+	return b.String()
+}
+
+func NewDynamicSpreadFactorProposal(title, description string, records []PoolIdToDynamicSpreadFactorRecord) govtypes.Content {
+	return &DynamicSpreadFactorProposal{
+		Title:                              title,
+		Description:                        description,
+		PoolIdToDynamicSpreadFactorRecords: records,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *DynamicSpreadFactorProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *DynamicSpreadFactorProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *DynamicSpreadFactorProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *DynamicSpreadFactorProposal) ProposalType() string {
+	return ProposalTypeDynamicSpreadFactor
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+// The bounds, multiplier and lookback duration are only validated for records that opt pools in.
+func (p *DynamicSpreadFactorProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.PoolIdToDynamicSpreadFactorRecords) == 0 {
+		return fmt.Errorf("empty proposal records")
+	}
+
+	seenPoolIds := make(map[uint64]bool, len(p.PoolIdToDynamicSpreadFactorRecords))
+	for _, record := range p.PoolIdToDynamicSpreadFactorRecords {
+		if record.PoolId <= uint64(0) {
+			return fmt.Errorf("Pool Id cannot be negative")
+		}
+
+		if seenPoolIds[record.PoolId] {
+			return fmt.Errorf("duplicate pool id %d", record.PoolId)
+		}
+		seenPoolIds[record.PoolId] = true
+
+		if !record.Enabled {
+			continue
+		}
+
+		for _, spreadFactor := range []sdk.Dec{record.MinSpreadFactor, record.MaxSpreadFactor} {
+			if spreadFactor.IsNil() || spreadFactor.IsNegative() || spreadFactor.GTE(sdk.OneDec()) {
+				return InvalidSpreadFactorError{ActualSpreadFactor: spreadFactor}
+			}
+		}
+		if record.MinSpreadFactor.GT(record.MaxSpreadFactor) {
+			return InvalidDynamicSpreadFactorBoundsError{MinSpreadFactor: record.MinSpreadFactor, MaxSpreadFactor: record.MaxSpreadFactor}
+		}
+
+		if record.VolatilityMultiplier.IsNil() || record.VolatilityMultiplier.IsNegative() {
+			return fmt.Errorf("volatility multiplier must be non-negative")
+		}
+
+		if record.LookbackDuration < MinDynamicSpreadFactorLookbackDuration {
+			return fmt.Errorf("lookback duration %s must be at least %s", record.LookbackDuration, MinDynamicSpreadFactorLookbackDuration)
+		}
+		if record.LookbackDuration > MaxDynamicSpreadFactorLookbackDuration {
+			return fmt.Errorf("lookback duration %s must be at most %s", record.LookbackDuration, MaxDynamicSpreadFactorLookbackDuration)
+		}
+	}
+	return nil
+}
+
+// String returns a string containing the dynamic spread factor proposal.
+func (p DynamicSpreadFactorProposal) String() string {
+	recordsStr := ""
+	for _, record := range p.PoolIdToDynamicSpreadFactorRecords {
+		if !record.Enabled {
+			recordsStr = recordsStr + fmt.Sprintf("(PoolID: %d, Enabled: false) ", record.PoolId)
+			continue
+		}
+		recordsStr = recordsStr + fmt.Sprintf("(PoolID: %d, Enabled: true, MinSpreadFactor: %s, MaxSpreadFactor: %s, VolatilityMultiplier: %s, LookbackDuration: %s) ",
+			record.PoolId, record.MinSpreadFactor, record.MaxSpreadFactor, record.VolatilityMultiplier, record.LookbackDuration)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Pools Dynamic Spread Factor Proposal:
+Title:       %s
+Description: %s
+Records:     %s
+`, p.Title, p.Description, recordsStr))
+	return b.String()
+}
+
+func NewJITProtectionChangeProposal(title, description string, records []PoolIdToJITProtectionBlocksRecord) govtypes.Content {
+	return &JITProtectionChangeProposal{
+		Title:                              title,
+		Description:                        description,
+		PoolIdToJitProtectionBlocksRecords: records,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *JITProtectionChangeProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *JITProtectionChangeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *JITProtectionChangeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *JITProtectionChangeProposal) ProposalType() string {
+	return ProposalTypeJITProtectionChange
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *JITProtectionChangeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.PoolIdToJitProtectionBlocksRecords) == 0 {
+		return fmt.Errorf("empty proposal records")
+	}
+
+	seenPoolIds := make(map[uint64]bool, len(p.PoolIdToJitProtectionBlocksRecords))
+	for _, record := range p.PoolIdToJitProtectionBlocksRecords {
+		if record.PoolId <= uint64(0) {
+			return fmt.Errorf("Pool Id cannot be negative")
+		}
+
+		if seenPoolIds[record.PoolId] {
+			return fmt.Errorf("duplicate pool id %d", record.PoolId)
+		}
+		seenPoolIds[record.PoolId] = true
+
+		if record.NewJitProtectionBlocks > MaxJITProtectionBlocks {
+			return fmt.Errorf("JIT protection blocks %d must be at most %d", record.NewJitProtectionBlocks, MaxJITProtectionBlocks)
+		}
+	}
+	return nil
+}
+
+// String returns a string containing the JIT protection change proposal.
+func (p JITProtectionChangeProposal) String() string {
+	recordsStr := ""
+	for _, record := range p.PoolIdToJitProtectionBlocksRecords {
+		recordsStr = recordsStr + fmt.Sprintf("(PoolID: %d, NewJITProtectionBlocks: %d) ", record.PoolId, record.NewJitProtectionBlocks)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Change Pools JIT Protection Proposal:
+Title:       %s
+Description: %s
+Records:     %s
+`, p.Title, p.Description, recordsStr))
+	return b.String()
+}
@@ -8,15 +8,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -109,6 +113,50 @@ func (m *TickSpacingDecreaseProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TickSpacingDecreaseProposal proto.InternalMessageInfo
 
+// TickSpacingUpdateProposal is a gov Content type for proposing a tick spacing
+// increase or decrease for a pool. When the tick spacing is increased, the
+// ticks of existing positions that are not divisible by the new tick spacing
+// are moved outward to the nearest divisible ticks. The proposal will fail if
+// one of the pools do not exist, or if the new tick spacing is not one of the
+// authorized tick spacings or is equal to the current tick spacing.
+type TickSpacingUpdateProposal struct {
+	Title                      string                      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description                string                      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolIdToTickSpacingRecords []PoolIdToTickSpacingRecord `protobuf:"bytes,3,rep,name=pool_id_to_tick_spacing_records,json=poolIdToTickSpacingRecords,proto3" json:"pool_id_to_tick_spacing_records"`
+}
+
+func (m *TickSpacingUpdateProposal) Reset()      { *m = TickSpacingUpdateProposal{} }
+func (*TickSpacingUpdateProposal) ProtoMessage() {}
+func (*TickSpacingUpdateProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6d167276ceeedc2, []int{2}
+}
+func (m *TickSpacingUpdateProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickSpacingUpdateProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickSpacingUpdateProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickSpacingUpdateProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickSpacingUpdateProposal.Merge(m, src)
+}
+func (m *TickSpacingUpdateProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickSpacingUpdateProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickSpacingUpdateProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickSpacingUpdateProposal proto.InternalMessageInfo
+
 // PoolIdToTickSpacingRecord is a struct that contains a pool id to new tick
 // spacing pair.
 type PoolIdToTickSpacingRecord struct {
@@ -120,7 +168,7 @@ func (m *PoolIdToTickSpacingRecord) Reset()         { *m = PoolIdToTickSpacingRe
 func (m *PoolIdToTickSpacingRecord) String() string { return proto.CompactTextString(m) }
 func (*PoolIdToTickSpacingRecord) ProtoMessage()    {}
 func (*PoolIdToTickSpacingRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6d167276ceeedc2, []int{2}
+	return fileDescriptor_e6d167276ceeedc2, []int{3}
 }
 func (m *PoolIdToTickSpacingRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,6 +211,299 @@ func (m *PoolIdToTickSpacingRecord) GetNewTickSpacing() uint64 {
 	return 0
 }
 
+// SpreadFactorChangeProposal is a gov Content type for changing the spread
+// factor of existing pools. The proposal will fail if one of the pools do not
+// exist, or if the new spread factor is not one of the authorized spread
+// factors or is equal to the current spread factor.
+type SpreadFactorChangeProposal struct {
+	Title                       string                       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description                 string                       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolIdToSpreadFactorRecords []PoolIdToSpreadFactorRecord `protobuf:"bytes,3,rep,name=pool_id_to_spread_factor_records,json=poolIdToSpreadFactorRecords,proto3" json:"pool_id_to_spread_factor_records"`
+}
+
+func (m *SpreadFactorChangeProposal) Reset()      { *m = SpreadFactorChangeProposal{} }
+func (*SpreadFactorChangeProposal) ProtoMessage() {}
+func (*SpreadFactorChangeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6d167276ceeedc2, []int{4}
+}
+func (m *SpreadFactorChangeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpreadFactorChangeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpreadFactorChangeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpreadFactorChangeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpreadFactorChangeProposal.Merge(m, src)
+}
+func (m *SpreadFactorChangeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpreadFactorChangeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpreadFactorChangeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpreadFactorChangeProposal proto.InternalMessageInfo
+
+// PoolIdToSpreadFactorRecord is a struct that contains a pool id to new spread
+// factor pair.
+type PoolIdToSpreadFactorRecord struct {
+	PoolId          uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	NewSpreadFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=new_spread_factor,json=newSpreadFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_spread_factor" yaml:"new_spread_factor"`
+}
+
+func (m *PoolIdToSpreadFactorRecord) Reset()         { *m = PoolIdToSpreadFactorRecord{} }
+func (m *PoolIdToSpreadFactorRecord) String() string { return proto.CompactTextString(m) }
+func (*PoolIdToSpreadFactorRecord) ProtoMessage()    {}
+func (*PoolIdToSpreadFactorRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6d167276ceeedc2, []int{5}
+}
+func (m *PoolIdToSpreadFactorRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolIdToSpreadFactorRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolIdToSpreadFactorRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolIdToSpreadFactorRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolIdToSpreadFactorRecord.Merge(m, src)
+}
+func (m *PoolIdToSpreadFactorRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolIdToSpreadFactorRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolIdToSpreadFactorRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolIdToSpreadFactorRecord proto.InternalMessageInfo
+
+func (m *PoolIdToSpreadFactorRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// JITProtectionChangeProposal is a gov Content type for changing the number
+// of blocks after their creation during which positions in existing pools
+// forfeit the spread rewards they claim. The proposal will fail if one of the
+// pools does not exist, or if the new number of blocks is equal to the current
+// one.
+type JITProtectionChangeProposal struct {
+	Title                              string                              `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description                        string                              `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolIdToJitProtectionBlocksRecords []PoolIdToJITProtectionBlocksRecord `protobuf:"bytes,3,rep,name=pool_id_to_jit_protection_blocks_records,json=poolIdToJitProtectionBlocksRecords,proto3" json:"pool_id_to_jit_protection_blocks_records"`
+}
+
+func (m *JITProtectionChangeProposal) Reset()      { *m = JITProtectionChangeProposal{} }
+func (*JITProtectionChangeProposal) ProtoMessage() {}
+func (*JITProtectionChangeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6d167276ceeedc2, []int{6}
+}
+func (m *JITProtectionChangeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JITProtectionChangeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JITProtectionChangeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JITProtectionChangeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JITProtectionChangeProposal.Merge(m, src)
+}
+func (m *JITProtectionChangeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *JITProtectionChangeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_JITProtectionChangeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JITProtectionChangeProposal proto.InternalMessageInfo
+
+// PoolIdToJITProtectionBlocksRecord is a struct that contains a pool id to new
+// JIT protection blocks pair. Zero disables the protection.
+type PoolIdToJITProtectionBlocksRecord struct {
+	PoolId                 uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	NewJitProtectionBlocks uint64 `protobuf:"varint,2,opt,name=new_jit_protection_blocks,json=newJitProtectionBlocks,proto3" json:"new_jit_protection_blocks,omitempty"`
+}
+
+func (m *PoolIdToJITProtectionBlocksRecord) Reset()         { *m = PoolIdToJITProtectionBlocksRecord{} }
+func (m *PoolIdToJITProtectionBlocksRecord) String() string { return proto.CompactTextString(m) }
+func (*PoolIdToJITProtectionBlocksRecord) ProtoMessage()    {}
+func (*PoolIdToJITProtectionBlocksRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6d167276ceeedc2, []int{7}
+}
+func (m *PoolIdToJITProtectionBlocksRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolIdToJITProtectionBlocksRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolIdToJITProtectionBlocksRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolIdToJITProtectionBlocksRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolIdToJITProtectionBlocksRecord.Merge(m, src)
+}
+func (m *PoolIdToJITProtectionBlocksRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolIdToJITProtectionBlocksRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolIdToJITProtectionBlocksRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolIdToJITProtectionBlocksRecord proto.InternalMessageInfo
+
+func (m *PoolIdToJITProtectionBlocksRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolIdToJITProtectionBlocksRecord) GetNewJitProtectionBlocks() uint64 {
+	if m != nil {
+		return m.NewJitProtectionBlocks
+	}
+	return 0
+}
+
+// DynamicSpreadFactorProposal is a gov Content type for opting pools into or
+// out of a dynamic spread factor. The proposal will fail if one of the pools
+// does not exist, or if a pool to opt out did not opt in.
+type DynamicSpreadFactorProposal struct {
+	Title                              string                              `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description                        string                              `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolIdToDynamicSpreadFactorRecords []PoolIdToDynamicSpreadFactorRecord `protobuf:"bytes,3,rep,name=pool_id_to_dynamic_spread_factor_records,json=poolIdToDynamicSpreadFactorRecords,proto3" json:"pool_id_to_dynamic_spread_factor_records"`
+}
+
+func (m *DynamicSpreadFactorProposal) Reset()      { *m = DynamicSpreadFactorProposal{} }
+func (*DynamicSpreadFactorProposal) ProtoMessage() {}
+func (*DynamicSpreadFactorProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6d167276ceeedc2, []int{8}
+}
+func (m *DynamicSpreadFactorProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactorProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactorProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSpreadFactorProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactorProposal.Merge(m, src)
+}
+func (m *DynamicSpreadFactorProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactorProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactorProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSpreadFactorProposal proto.InternalMessageInfo
+
+// PoolIdToDynamicSpreadFactorRecord is a struct that contains a pool id and
+// its dynamic spread factor configuration. If enabled is false, the pool opts
+// out of the dynamic spread factor and the other fields are ignored.
+type PoolIdToDynamicSpreadFactorRecord struct {
+	PoolId               uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Enabled              bool                                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	MinSpreadFactor      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_spread_factor,json=minSpreadFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_spread_factor" yaml:"min_spread_factor"`
+	MaxSpreadFactor      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_spread_factor,json=maxSpreadFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_spread_factor" yaml:"max_spread_factor"`
+	VolatilityMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=volatility_multiplier,json=volatilityMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volatility_multiplier" yaml:"volatility_multiplier"`
+	LookbackDuration     time.Duration                          `protobuf:"bytes,6,opt,name=lookback_duration,json=lookbackDuration,proto3,stdduration" json:"lookback_duration" yaml:"lookback_duration"`
+}
+
+func (m *PoolIdToDynamicSpreadFactorRecord) Reset()         { *m = PoolIdToDynamicSpreadFactorRecord{} }
+func (m *PoolIdToDynamicSpreadFactorRecord) String() string { return proto.CompactTextString(m) }
+func (*PoolIdToDynamicSpreadFactorRecord) ProtoMessage()    {}
+func (*PoolIdToDynamicSpreadFactorRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6d167276ceeedc2, []int{9}
+}
+func (m *PoolIdToDynamicSpreadFactorRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolIdToDynamicSpreadFactorRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolIdToDynamicSpreadFactorRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolIdToDynamicSpreadFactorRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolIdToDynamicSpreadFactorRecord.Merge(m, src)
+}
+func (m *PoolIdToDynamicSpreadFactorRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolIdToDynamicSpreadFactorRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolIdToDynamicSpreadFactorRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolIdToDynamicSpreadFactorRecord proto.InternalMessageInfo
+
+func (m *PoolIdToDynamicSpreadFactorRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolIdToDynamicSpreadFactorRecord) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *PoolIdToDynamicSpreadFactorRecord) GetLookbackDuration() time.Duration {
+	if m != nil {
+		return m.LookbackDuration
+	}
+	return 0
+}
+
 type PoolRecord struct {
 	Denom0             string                                 `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
 	Denom1             string                                 `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
@@ -175,7 +516,7 @@ func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
 func (m *PoolRecord) String() string { return proto.CompactTextString(m) }
 func (*PoolRecord) ProtoMessage()    {}
 func (*PoolRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6d167276ceeedc2, []int{3}
+	return fileDescriptor_e6d167276ceeedc2, []int{10}
 }
 func (m *PoolRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,7 +569,14 @@ func (m *PoolRecord) GetTickSpacing() uint64 {
 func init() {
 	proto.RegisterType((*CreateConcentratedLiquidityPoolsProposal)(nil), "osmosis.concentratedliquidity.v1beta1.CreateConcentratedLiquidityPoolsProposal")
 	proto.RegisterType((*TickSpacingDecreaseProposal)(nil), "osmosis.concentratedliquidity.v1beta1.TickSpacingDecreaseProposal")
+	proto.RegisterType((*TickSpacingUpdateProposal)(nil), "osmosis.concentratedliquidity.v1beta1.TickSpacingUpdateProposal")
 	proto.RegisterType((*PoolIdToTickSpacingRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToTickSpacingRecord")
+	proto.RegisterType((*SpreadFactorChangeProposal)(nil), "osmosis.concentratedliquidity.v1beta1.SpreadFactorChangeProposal")
+	proto.RegisterType((*PoolIdToSpreadFactorRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToSpreadFactorRecord")
+	proto.RegisterType((*JITProtectionChangeProposal)(nil), "osmosis.concentratedliquidity.v1beta1.JITProtectionChangeProposal")
+	proto.RegisterType((*PoolIdToJITProtectionBlocksRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToJITProtectionBlocksRecord")
+	proto.RegisterType((*DynamicSpreadFactorProposal)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactorProposal")
+	proto.RegisterType((*PoolIdToDynamicSpreadFactorRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToDynamicSpreadFactorRecord")
 	proto.RegisterType((*PoolRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolRecord")
 }
 
@@ -237,44 +585,67 @@ func init() {
 }
 
 var fileDescriptor_e6d167276ceeedc2 = []byte{
-	// 584 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0x4e, 0x6c, 0xb7, 0xe2, 0xb4, 0x2b, 0x1a, 0x2b, 0x5b, 0x77, 0x25, 0x29, 0x01, 0xa5, 0x1e,
-	0x9a, 0x18, 0x05, 0x0f, 0x3d, 0x69, 0x77, 0x59, 0x58, 0x10, 0x2d, 0x71, 0x4f, 0x22, 0x84, 0x74,
-	0x32, 0xd6, 0xa1, 0xe9, 0x4c, 0x36, 0x33, 0xdb, 0xdd, 0x1e, 0xbd, 0x09, 0x5e, 0xbc, 0x08, 0x1e,
-	0xfb, 0x73, 0xf6, 0xb8, 0x47, 0xf1, 0x10, 0xa4, 0xbd, 0x78, 0xb5, 0xbf, 0x40, 0x32, 0x93, 0xb6,
-	0xe9, 0x6a, 0xc1, 0x65, 0x4f, 0xc9, 0x7b, 0xf3, 0xbd, 0xf7, 0x7d, 0xdf, 0x7b, 0xc3, 0x80, 0x06,
-	0x65, 0x03, 0xca, 0x30, 0xb3, 0x21, 0x25, 0x10, 0x11, 0x1e, 0xfb, 0x1c, 0x05, 0xcd, 0x10, 0x1f,
-	0x1d, 0xe3, 0x00, 0xf3, 0x91, 0xdd, 0xa3, 0x43, 0x2b, 0x8a, 0x29, 0xa7, 0xda, 0x83, 0x0c, 0x69,
-	0xe5, 0x91, 0x0b, 0xa0, 0x35, 0x74, 0xba, 0x88, 0xfb, 0xce, 0x76, 0xb5, 0x47, 0x7b, 0x54, 0x54,
-	0xd8, 0xe9, 0x9f, 0x2c, 0x36, 0xa7, 0x2a, 0x68, 0xec, 0xc6, 0xc8, 0xe7, 0x68, 0x37, 0x57, 0xfd,
-	0x72, 0x5e, 0xdd, 0xa1, 0x34, 0x64, 0x9d, 0x98, 0x46, 0x94, 0xf9, 0xa1, 0x56, 0x05, 0x1b, 0x1c,
-	0xf3, 0x10, 0xd5, 0xd4, 0xba, 0xda, 0xb8, 0xe1, 0xca, 0x40, 0xab, 0x83, 0x72, 0x80, 0x18, 0x8c,
-	0x71, 0xc4, 0x31, 0x25, 0xb5, 0x6b, 0xe2, 0x2c, 0x9f, 0xd2, 0x8e, 0x40, 0x25, 0xa2, 0x34, 0xf4,
-	0x62, 0x04, 0x69, 0x1c, 0xb0, 0x5a, 0xa1, 0x5e, 0x68, 0x94, 0x9f, 0x38, 0xd6, 0x7f, 0x09, 0xb7,
-	0x52, 0x0d, 0xae, 0xa8, 0x6c, 0xef, 0x9c, 0x25, 0x86, 0x32, 0x4b, 0x8c, 0x3b, 0x23, 0x7f, 0x10,
-	0xb6, 0xcc, 0x7c, 0x53, 0xd3, 0x2d, 0x47, 0x0b, 0x20, 0x6b, 0x55, 0x3e, 0x8d, 0x0d, 0xe5, 0xdb,
-	0xd8, 0x50, 0x7e, 0x8d, 0x0d, 0xd5, 0xfc, 0xad, 0x82, 0x9d, 0x43, 0x0c, 0xfb, 0x6f, 0x22, 0x1f,
-	0x62, 0xd2, 0xdb, 0x43, 0x30, 0x46, 0x3e, 0x43, 0x57, 0x36, 0xf6, 0x59, 0x05, 0x86, 0x10, 0x81,
-	0x03, 0x8f, 0x53, 0x8f, 0x63, 0xd8, 0xf7, 0x98, 0xe4, 0xb8, 0x60, 0xf6, 0xf9, 0x25, 0xcc, 0x1e,
-	0x04, 0x87, 0x34, 0xa7, 0x36, 0xf3, 0x5e, 0x4c, 0xbd, 0xbb, 0xdb, 0xd1, 0x3a, 0xc0, 0x45, 0xcf,
-	0x01, 0xb8, 0xb7, 0xb6, 0x99, 0xb6, 0x05, 0xae, 0x67, 0xba, 0x85, 0xe5, 0xa2, 0x5b, 0x92, 0x7d,
-	0xb5, 0x06, 0xb8, 0x45, 0xd0, 0xc9, 0x8a, 0x13, 0x61, 0xbc, 0xe8, 0xde, 0x24, 0xe8, 0x24, 0xd7,
-	0xa8, 0x55, 0x14, 0x2c, 0x5f, 0x0b, 0x00, 0x2c, 0x17, 0xa4, 0x3d, 0x02, 0xa5, 0x00, 0x11, 0x3a,
-	0x78, 0x2c, 0x27, 0xd9, 0xbe, 0x3d, 0x4b, 0x8c, 0x4d, 0xb9, 0x2c, 0x99, 0x37, 0xdd, 0x0c, 0xb0,
-	0x80, 0x3a, 0x72, 0xb0, 0x7f, 0x41, 0x9d, 0x39, 0xd4, 0xd1, 0x5a, 0xa0, 0xb2, 0x22, 0xa8, 0x90,
-	0x0a, 0x6a, 0x6f, 0x2d, 0x2f, 0x42, 0xfe, 0xd4, 0x74, 0xcb, 0x7c, 0x29, 0x53, 0xfb, 0xa8, 0x82,
-	0xbb, 0xe8, 0x34, 0xa2, 0x04, 0x11, 0xee, 0xf9, 0xdc, 0x8b, 0x62, 0x0c, 0x91, 0x47, 0x09, 0xaa,
-	0x15, 0x05, 0xed, 0xab, 0x74, 0xac, 0x3f, 0x12, 0xe3, 0x61, 0x0f, 0xf3, 0x0f, 0xc7, 0x5d, 0x0b,
-	0xd2, 0x81, 0x0d, 0xc5, 0xae, 0xb2, 0x4f, 0x93, 0x05, 0x7d, 0x9b, 0x8f, 0x22, 0xc4, 0xac, 0x03,
-	0xc2, 0x67, 0x89, 0x71, 0x5f, 0x72, 0xfe, 0xb3, 0xa9, 0xe9, 0x6a, 0xf3, 0xfc, 0x0b, 0xde, 0x49,
-	0xb3, 0xaf, 0x09, 0xd2, 0xfa, 0x60, 0x93, 0x45, 0x31, 0xf2, 0x03, 0xef, 0xbd, 0x0f, 0x39, 0x8d,
-	0x6b, 0x1b, 0x82, 0x7a, 0xff, 0x12, 0xd4, 0x7b, 0x08, 0xce, 0x12, 0xa3, 0x2a, 0xa9, 0x57, 0x9a,
-	0x99, 0x6e, 0x45, 0xc6, 0xfb, 0x22, 0x94, 0x7b, 0x69, 0xbf, 0x3b, 0x9b, 0xe8, 0xea, 0xf9, 0x44,
-	0x57, 0x7f, 0x4e, 0x74, 0xf5, 0xcb, 0x54, 0x57, 0xce, 0xa7, 0xba, 0xf2, 0x7d, 0xaa, 0x2b, 0x6f,
-	0xdb, 0x39, 0xb6, 0xec, 0x4e, 0x36, 0x43, 0xbf, 0xcb, 0xe6, 0x81, 0x3d, 0x74, 0x9e, 0xd9, 0xa7,
-	0xeb, 0x9e, 0x1d, 0xa1, 0xa6, 0x5b, 0x12, 0x8f, 0xc7, 0xd3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x35, 0x64, 0x88, 0x0b, 0xa5, 0x04, 0x00, 0x00,
+	// 956 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xce, 0xb4, 0xd9, 0x2e, 0x4c, 0xba, 0xec, 0xd6, 0x74, 0x59, 0x37, 0x45, 0x71, 0xb0, 0x00,
+	0x85, 0x43, 0x6d, 0xb2, 0x48, 0x48, 0xf4, 0xc4, 0xa6, 0xd5, 0x8a, 0x56, 0xfc, 0xa8, 0xbc, 0xe5,
+	0x82, 0x90, 0xac, 0xc9, 0x78, 0x36, 0x3b, 0xc4, 0x99, 0xf1, 0xda, 0x93, 0x34, 0x39, 0x70, 0x40,
+	0x5c, 0x10, 0x48, 0x88, 0x0b, 0xd2, 0x1e, 0x7b, 0xe0, 0x3f, 0xe0, 0x1f, 0xe0, 0xb8, 0xc7, 0x3d,
+	0x22, 0x0e, 0x01, 0xb5, 0x17, 0x6e, 0x88, 0xf2, 0x0f, 0x20, 0x8f, 0xed, 0x64, 0xe2, 0xc4, 0x40,
+	0xd4, 0x9e, 0x38, 0xb5, 0xf3, 0xfc, 0xe6, 0xfb, 0xde, 0xf7, 0xbe, 0x79, 0xf6, 0x04, 0x36, 0x78,
+	0xd4, 0xe3, 0x11, 0x8d, 0x6c, 0xcc, 0x19, 0x26, 0x4c, 0x84, 0x48, 0x10, 0x6f, 0xc7, 0xa7, 0x8f,
+	0xfb, 0xd4, 0xa3, 0x62, 0x64, 0x77, 0xf8, 0xc0, 0x0a, 0x42, 0x2e, 0xb8, 0xf6, 0x5a, 0x9a, 0x69,
+	0xa9, 0x99, 0x93, 0x44, 0x6b, 0xd0, 0x6c, 0x13, 0x81, 0x9a, 0xd5, 0xcd, 0x0e, 0xef, 0x70, 0xb9,
+	0xc3, 0x8e, 0xff, 0x4b, 0x36, 0x57, 0x6b, 0x1d, 0xce, 0x3b, 0x3e, 0xb1, 0xe5, 0xaa, 0xdd, 0x7f,
+	0x68, 0x7b, 0xfd, 0x10, 0x09, 0xca, 0x59, 0xf2, 0xdc, 0x3c, 0x07, 0xb0, 0xb1, 0x17, 0x12, 0x24,
+	0xc8, 0x9e, 0x82, 0xfe, 0x7e, 0x86, 0x7e, 0xc4, 0xb9, 0x1f, 0x1d, 0x85, 0x3c, 0xe0, 0x11, 0xf2,
+	0xb5, 0x4d, 0x78, 0x4d, 0x50, 0xe1, 0x13, 0x1d, 0xd4, 0x41, 0xe3, 0x79, 0x27, 0x59, 0x68, 0x75,
+	0x58, 0xf1, 0x48, 0x84, 0x43, 0x1a, 0xc4, 0xb8, 0xfa, 0x8a, 0x7c, 0xa6, 0x86, 0xb4, 0xc7, 0x70,
+	0x3d, 0xe0, 0xdc, 0x77, 0x43, 0x82, 0x79, 0xe8, 0x45, 0xfa, 0x6a, 0x7d, 0xb5, 0x51, 0xb9, 0xdb,
+	0xb4, 0xfe, 0x93, 0x30, 0x2b, 0xae, 0xc1, 0x91, 0x3b, 0x5b, 0xdb, 0x4f, 0xc7, 0x46, 0xe9, 0x62,
+	0x6c, 0xbc, 0x38, 0x42, 0x3d, 0x7f, 0xd7, 0x54, 0x41, 0x4d, 0xa7, 0x12, 0x4c, 0x12, 0xa3, 0xdd,
+	0xf5, 0xaf, 0x4e, 0x8d, 0xd2, 0x93, 0x53, 0xa3, 0xf4, 0xfb, 0xa9, 0x01, 0xcc, 0x3f, 0x01, 0xdc,
+	0x3e, 0xa6, 0xb8, 0xfb, 0x20, 0x40, 0x98, 0xb2, 0xce, 0x3e, 0xc1, 0x21, 0x41, 0x11, 0xb9, 0xb4,
+	0xb0, 0x6f, 0x00, 0x34, 0x64, 0x11, 0xd4, 0x73, 0x05, 0x77, 0x05, 0xc5, 0x5d, 0x37, 0x4a, 0x38,
+	0x72, 0x62, 0xdf, 0x5d, 0x42, 0xec, 0x81, 0x77, 0xcc, 0x95, 0x6a, 0x53, 0xed, 0xe5, 0x58, 0xbb,
+	0x53, 0x0d, 0x8a, 0x12, 0xf2, 0x9a, 0xff, 0x00, 0x70, 0x4b, 0x49, 0xfa, 0x38, 0xf0, 0x90, 0xf8,
+	0x7f, 0x2b, 0xf6, 0xe0, 0x56, 0x21, 0x98, 0x76, 0x07, 0x5e, 0x4f, 0xeb, 0x96, 0x92, 0xcb, 0xce,
+	0x5a, 0x82, 0xab, 0x35, 0xe0, 0x2d, 0x46, 0x4e, 0x66, 0x94, 0x48, 0xe1, 0x65, 0xe7, 0x05, 0x46,
+	0x4e, 0x14, 0xa0, 0xdd, 0xb2, 0x64, 0xf9, 0x0b, 0xc0, 0xea, 0x83, 0x20, 0x24, 0xc8, 0xbb, 0x8f,
+	0xb0, 0xe0, 0xe1, 0xde, 0x23, 0xc4, 0x3a, 0x97, 0x6f, 0xec, 0xb7, 0x00, 0xd6, 0x95, 0xc6, 0x46,
+	0x92, 0xc1, 0x7d, 0x28, 0x29, 0x72, 0x9d, 0xbd, 0xb7, 0x64, 0x67, 0xd5, 0x6a, 0x67, 0x5a, 0xbb,
+	0x1d, 0x14, 0x66, 0xe4, 0x7b, 0xfb, 0x23, 0x80, 0xd5, 0x62, 0xbc, 0xe2, 0xee, 0x0e, 0xe0, 0x46,
+	0xdc, 0xdd, 0x19, 0x39, 0x89, 0xfc, 0xd6, 0x61, 0x5c, 0xc3, 0x2f, 0x63, 0xe3, 0xf5, 0x0e, 0x15,
+	0x8f, 0xfa, 0x6d, 0x0b, 0xf3, 0x9e, 0x8d, 0xa5, 0xb2, 0xf4, 0xcf, 0x4e, 0xe4, 0x75, 0x6d, 0x31,
+	0x0a, 0x48, 0x64, 0xed, 0x13, 0x7c, 0x31, 0x36, 0xf4, 0x64, 0xec, 0xe7, 0x00, 0x4d, 0xe7, 0x26,
+	0x23, 0x27, 0x6a, 0x59, 0xa9, 0x57, 0x5f, 0xaf, 0xc0, 0xed, 0xc3, 0x83, 0xe3, 0xa3, 0x90, 0x0b,
+	0x82, 0xe3, 0x36, 0x5f, 0x91, 0x59, 0x3f, 0x00, 0xd8, 0x50, 0xcc, 0xfa, 0x8c, 0x0a, 0x37, 0x98,
+	0x70, 0xb8, 0x6d, 0x9f, 0xe3, 0x6e, 0x94, 0x33, 0xed, 0xbd, 0x25, 0x4d, 0x9b, 0x29, 0xbb, 0x25,
+	0x11, 0x67, 0xbc, 0x33, 0x33, 0xef, 0x0e, 0xa9, 0x58, 0x9c, 0x98, 0xb7, 0xf0, 0x73, 0xf8, 0xca,
+	0xbf, 0x82, 0x17, 0x1b, 0xf9, 0x0e, 0xdc, 0x8a, 0xfb, 0xbe, 0x50, 0x6a, 0x3a, 0x2f, 0x2f, 0x31,
+	0x72, 0xb2, 0xa0, 0x1a, 0xc5, 0x8b, 0xfd, 0x11, 0x43, 0x3d, 0x8a, 0x55, 0xa7, 0xae, 0xda, 0x0b,
+	0x2f, 0xa1, 0xf8, 0xc7, 0x01, 0x5a, 0xd6, 0x8b, 0x05, 0x65, 0x2f, 0xf6, 0xa2, 0x30, 0x31, 0xef,
+	0xc5, 0x4f, 0xe5, 0xa9, 0x19, 0x85, 0x9b, 0x8a, 0xcd, 0xd0, 0xe1, 0x75, 0xc2, 0x50, 0xdb, 0x27,
+	0x9e, 0xec, 0xc8, 0x73, 0x4e, 0xb6, 0x8c, 0xe7, 0xad, 0x47, 0x59, 0x6e, 0xde, 0x56, 0x2f, 0x37,
+	0x6f, 0x73, 0x80, 0xa6, 0x73, 0xb3, 0x47, 0x99, 0x5a, 0xb0, 0xe4, 0x45, 0xc3, 0x1c, 0x6f, 0xf9,
+	0x92, 0xbc, 0x68, 0x38, 0xcf, 0x8b, 0x86, 0x33, 0xbc, 0x5f, 0x02, 0x78, 0x7b, 0xc0, 0x7d, 0x24,
+	0xa8, 0x4f, 0xc5, 0xc8, 0xed, 0xf5, 0x7d, 0x41, 0x03, 0x9f, 0x92, 0x50, 0xbf, 0x26, 0xc9, 0x3f,
+	0x5c, 0x9a, 0xfc, 0xe5, 0x84, 0x7c, 0x21, 0xa8, 0xe9, 0x6c, 0x4e, 0xe3, 0x1f, 0x4c, 0xc2, 0x9a,
+	0x0f, 0x37, 0x7c, 0xce, 0xbb, 0x6d, 0x84, 0xbb, 0x6e, 0x76, 0xc1, 0xd2, 0xd7, 0xea, 0xa0, 0x51,
+	0xb9, 0xbb, 0x65, 0x25, 0x37, 0x30, 0x2b, 0xbb, 0x81, 0x59, 0xfb, 0x69, 0x42, 0xeb, 0xd5, 0xf4,
+	0x36, 0x93, 0xca, 0x9d, 0x43, 0x30, 0x9f, 0xfc, 0x6a, 0x00, 0xe7, 0x56, 0x16, 0xcf, 0xf6, 0xa5,
+	0xf3, 0xf4, 0xfd, 0x2a, 0x84, 0xd3, 0xab, 0x91, 0xf6, 0x06, 0x5c, 0xf3, 0x08, 0xe3, 0xbd, 0x37,
+	0x93, 0xf9, 0x69, 0x6d, 0x5c, 0x8c, 0x8d, 0x1b, 0x09, 0x70, 0x12, 0x37, 0x9d, 0x34, 0x61, 0x92,
+	0xda, 0xd4, 0x57, 0x16, 0xa6, 0x36, 0xb3, 0xd4, 0xa6, 0xb6, 0x0b, 0xd7, 0x67, 0x3e, 0x8c, 0xf1,
+	0x49, 0x2a, 0xb7, 0xee, 0x4c, 0xaf, 0x60, 0xea, 0x53, 0xd3, 0xa9, 0x88, 0xe9, 0xe7, 0x52, 0xfb,
+	0x02, 0xc0, 0xdb, 0x64, 0x18, 0x70, 0x46, 0x98, 0x70, 0x51, 0xfc, 0xda, 0xa0, 0x98, 0xb8, 0x9c,
+	0x11, 0xbd, 0xbc, 0xb4, 0x35, 0x07, 0x4c, 0x4c, 0xad, 0x59, 0x08, 0x6a, 0x3a, 0x5a, 0x16, 0xbf,
+	0x27, 0x8e, 0xe2, 0xe8, 0x47, 0x8c, 0x68, 0x5d, 0x78, 0x63, 0xf6, 0x48, 0x26, 0xa7, 0xe2, 0xfe,
+	0xd2, 0xa7, 0x62, 0x33, 0xa1, 0xce, 0x1d, 0xc7, 0xf5, 0x68, 0xee, 0x9b, 0xd3, 0xfa, 0xf4, 0xe9,
+	0x59, 0x0d, 0x3c, 0x3b, 0xab, 0x81, 0xdf, 0xce, 0x6a, 0xe0, 0xbb, 0xf3, 0x5a, 0xe9, 0xd9, 0x79,
+	0xad, 0xf4, 0xf3, 0x79, 0xad, 0xf4, 0x49, 0x4b, 0x61, 0x4b, 0x5f, 0x40, 0x3b, 0x3e, 0x6a, 0x47,
+	0xd9, 0xc2, 0x1e, 0x34, 0xdf, 0xb6, 0x87, 0x45, 0x3f, 0x08, 0x64, 0x35, 0xed, 0x35, 0x79, 0x8c,
+	0xde, 0xfa, 0x7b, 0x00, 0xb2, 0x28, 0x55, 0x19, 0x3f, 0x0c, 0x00, 0x00,
 }
 
 func (this *CreateConcentratedLiquidityPoolsProposal) Equal(that interface{}) bool {
@@ -347,6 +718,41 @@ func (this *TickSpacingDecreaseProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TickSpacingUpdateProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TickSpacingUpdateProposal)
+	if !ok {
+		that2, ok := that.(TickSpacingUpdateProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.PoolIdToTickSpacingRecords) != len(that1.PoolIdToTickSpacingRecords) {
+		return false
+	}
+	for i := range this.PoolIdToTickSpacingRecords {
+		if !this.PoolIdToTickSpacingRecords[i].Equal(&that1.PoolIdToTickSpacingRecords[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *PoolIdToTickSpacingRecord) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -374,14 +780,14 @@ func (this *PoolIdToTickSpacingRecord) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *PoolRecord) Equal(that interface{}) bool {
+func (this *SpreadFactorChangeProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PoolRecord)
+	that1, ok := that.(*SpreadFactorChangeProposal)
 	if !ok {
-		that2, ok := that.(PoolRecord)
+		that2, ok := that.(SpreadFactorChangeProposal)
 		if ok {
 			that1 = &that2
 		} else {
@@ -393,29 +799,227 @@ func (this *PoolRecord) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Denom0 != that1.Denom0 {
+	if this.Title != that1.Title {
 		return false
 	}
-	if this.Denom1 != that1.Denom1 {
+	if this.Description != that1.Description {
 		return false
 	}
-	if this.TickSpacing != that1.TickSpacing {
+	if len(this.PoolIdToSpreadFactorRecords) != len(that1.PoolIdToSpreadFactorRecords) {
 		return false
 	}
-	if !this.ExponentAtPriceOne.Equal(that1.ExponentAtPriceOne) {
+	for i := range this.PoolIdToSpreadFactorRecords {
+		if !this.PoolIdToSpreadFactorRecords[i].Equal(&that1.PoolIdToSpreadFactorRecords[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PoolIdToSpreadFactorRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolIdToSpreadFactorRecord)
+	if !ok {
+		that2, ok := that.(PoolIdToSpreadFactorRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if !this.SpreadFactor.Equal(that1.SpreadFactor) {
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.NewSpreadFactor.Equal(that1.NewSpreadFactor) {
 		return false
 	}
 	return true
 }
-func (m *CreateConcentratedLiquidityPoolsProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *JITProtectionChangeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JITProtectionChangeProposal)
+	if !ok {
+		that2, ok := that.(JITProtectionChangeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.PoolIdToJitProtectionBlocksRecords) != len(that1.PoolIdToJitProtectionBlocksRecords) {
+		return false
+	}
+	for i := range this.PoolIdToJitProtectionBlocksRecords {
+		if !this.PoolIdToJitProtectionBlocksRecords[i].Equal(&that1.PoolIdToJitProtectionBlocksRecords[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PoolIdToJITProtectionBlocksRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolIdToJITProtectionBlocksRecord)
+	if !ok {
+		that2, ok := that.(PoolIdToJITProtectionBlocksRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.NewJitProtectionBlocks != that1.NewJitProtectionBlocks {
+		return false
+	}
+	return true
+}
+func (this *DynamicSpreadFactorProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicSpreadFactorProposal)
+	if !ok {
+		that2, ok := that.(DynamicSpreadFactorProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.PoolIdToDynamicSpreadFactorRecords) != len(that1.PoolIdToDynamicSpreadFactorRecords) {
+		return false
+	}
+	for i := range this.PoolIdToDynamicSpreadFactorRecords {
+		if !this.PoolIdToDynamicSpreadFactorRecords[i].Equal(&that1.PoolIdToDynamicSpreadFactorRecords[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PoolIdToDynamicSpreadFactorRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolIdToDynamicSpreadFactorRecord)
+	if !ok {
+		that2, ok := that.(PoolIdToDynamicSpreadFactorRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if !this.MinSpreadFactor.Equal(that1.MinSpreadFactor) {
+		return false
+	}
+	if !this.MaxSpreadFactor.Equal(that1.MaxSpreadFactor) {
+		return false
+	}
+	if !this.VolatilityMultiplier.Equal(that1.VolatilityMultiplier) {
+		return false
+	}
+	if this.LookbackDuration != that1.LookbackDuration {
+		return false
+	}
+	return true
+}
+func (this *PoolRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolRecord)
+	if !ok {
+		that2, ok := that.(PoolRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom0 != that1.Denom0 {
+		return false
+	}
+	if this.Denom1 != that1.Denom1 {
+		return false
+	}
+	if this.TickSpacing != that1.TickSpacing {
+		return false
+	}
+	if !this.ExponentAtPriceOne.Equal(that1.ExponentAtPriceOne) {
+		return false
+	}
+	if !this.SpreadFactor.Equal(that1.SpreadFactor) {
+		return false
+	}
+	return true
+}
+func (m *CreateConcentratedLiquidityPoolsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -512,6 +1116,57 @@ func (m *TickSpacingDecreaseProposal) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *TickSpacingUpdateProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickSpacingUpdateProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickSpacingUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIdToTickSpacingRecords) > 0 {
+		for iNdEx := len(m.PoolIdToTickSpacingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolIdToTickSpacingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolIdToTickSpacingRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -545,7 +1200,7 @@ func (m *PoolIdToTickSpacingRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolRecord) Marshal() (dAtA []byte, err error) {
+func (m *SpreadFactorChangeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -555,161 +1210,1388 @@ func (m *PoolRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpreadFactorChangeProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SpreadFactorChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.SpreadFactor.Size()
-		i -= size
-		if _, err := m.SpreadFactor.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGov(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.ExponentAtPriceOne.Size()
-		i -= size
-		if _, err := m.ExponentAtPriceOne.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.PoolIdToSpreadFactorRecords) > 0 {
+		for iNdEx := len(m.PoolIdToSpreadFactorRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolIdToSpreadFactorRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i = encodeVarintGov(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.TickSpacing != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.TickSpacing))
-		i--
-		dAtA[i] = 0x18
 	}
-	if len(m.Denom1) > 0 {
-		i -= len(m.Denom1)
-		copy(dAtA[i:], m.Denom1)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Denom1)))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Denom0) > 0 {
-		i -= len(m.Denom0)
-		copy(dAtA[i:], m.Denom0)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Denom0)))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PoolIdToSpreadFactorRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CreateConcentratedLiquidityPoolsProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PoolIdToSpreadFactorRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolIdToSpreadFactorRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	{
+		size := m.NewSpreadFactor.Size()
+		i -= size
+		if _, err := m.NewSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.PoolRecords) > 0 {
-		for _, e := range m.PoolRecords {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *JITProtectionChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JITProtectionChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JITProtectionChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIdToJitProtectionBlocksRecords) > 0 {
+		for iNdEx := len(m.PoolIdToJitProtectionBlocksRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolIdToJitProtectionBlocksRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TickSpacingDecreaseProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolIdToJITProtectionBlocksRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolIdToJITProtectionBlocksRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolIdToJITProtectionBlocksRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.NewJitProtectionBlocks != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.NewJitProtectionBlocks))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.PoolIdToTickSpacingRecords) > 0 {
-		for _, e := range m.PoolIdToTickSpacingRecords {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicSpreadFactorProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactorProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIdToDynamicSpreadFactorRecords) > 0 {
+		for iNdEx := len(m.PoolIdToDynamicSpreadFactorRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolIdToDynamicSpreadFactorRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolIdToTickSpacingRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolIdToDynamicSpreadFactorRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolIdToDynamicSpreadFactorRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolIdToDynamicSpreadFactorRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovGov(uint64(m.PoolId))
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LookbackDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LookbackDuration):])
+	if err1 != nil {
+		return 0, err1
 	}
-	if m.NewTickSpacing != 0 {
-		n += 1 + sovGov(uint64(m.NewTickSpacing))
+	i -= n1
+	i = encodeVarintGov(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.VolatilityMultiplier.Size()
+		i -= size
+		if _, err := m.VolatilityMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxSpreadFactor.Size()
+		i -= size
+		if _, err := m.MaxSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinSpreadFactor.Size()
+		i -= size
+		if _, err := m.MinSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom0)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	{
+		size := m.SpreadFactor.Size()
+		i -= size
+		if _, err := m.SpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	l = len(m.Denom1)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ExponentAtPriceOne.Size()
+		i -= size
+		if _, err := m.ExponentAtPriceOne.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TickSpacing != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreateConcentratedLiquidityPoolsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PoolRecords) > 0 {
+		for _, e := range m.PoolRecords {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TickSpacingDecreaseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PoolIdToTickSpacingRecords) > 0 {
+		for _, e := range m.PoolIdToTickSpacingRecords {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TickSpacingUpdateProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PoolIdToTickSpacingRecords) > 0 {
+		for _, e := range m.PoolIdToTickSpacingRecords {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolIdToTickSpacingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	if m.NewTickSpacing != 0 {
+		n += 1 + sovGov(uint64(m.NewTickSpacing))
+	}
+	return n
+}
+
+func (m *SpreadFactorChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PoolIdToSpreadFactorRecords) > 0 {
+		for _, e := range m.PoolIdToSpreadFactorRecords {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolIdToSpreadFactorRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	l = m.NewSpreadFactor.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *JITProtectionChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PoolIdToJitProtectionBlocksRecords) > 0 {
+		for _, e := range m.PoolIdToJitProtectionBlocksRecords {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolIdToJITProtectionBlocksRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	if m.NewJitProtectionBlocks != 0 {
+		n += 1 + sovGov(uint64(m.NewJitProtectionBlocks))
+	}
+	return n
+}
+
+func (m *DynamicSpreadFactorProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PoolIdToDynamicSpreadFactorRecords) > 0 {
+		for _, e := range m.PoolIdToDynamicSpreadFactorRecords {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolIdToDynamicSpreadFactorRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = m.MinSpreadFactor.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxSpreadFactor.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.VolatilityMultiplier.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LookbackDuration)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *PoolRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
 	}
 	if m.TickSpacing != 0 {
 		n += 1 + sovGov(uint64(m.TickSpacing))
 	}
-	l = m.ExponentAtPriceOne.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.SpreadFactor.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
-}
+	l = m.ExponentAtPriceOne.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.SpreadFactor.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreateConcentratedLiquidityPoolsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateConcentratedLiquidityPoolsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateConcentratedLiquidityPoolsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRecords = append(m.PoolRecords, PoolRecord{})
+			if err := m.PoolRecords[len(m.PoolRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickSpacingDecreaseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickSpacingDecreaseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickSpacingDecreaseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdToTickSpacingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolIdToTickSpacingRecords = append(m.PoolIdToTickSpacingRecords, PoolIdToTickSpacingRecord{})
+			if err := m.PoolIdToTickSpacingRecords[len(m.PoolIdToTickSpacingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickSpacingUpdateProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickSpacingUpdateProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickSpacingUpdateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdToTickSpacingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolIdToTickSpacingRecords = append(m.PoolIdToTickSpacingRecords, PoolIdToTickSpacingRecord{})
+			if err := m.PoolIdToTickSpacingRecords[len(m.PoolIdToTickSpacingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolIdToTickSpacingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolIdToTickSpacingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolIdToTickSpacingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTickSpacing", wireType)
+			}
+			m.NewTickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewTickSpacing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpreadFactorChangeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpreadFactorChangeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpreadFactorChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdToSpreadFactorRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolIdToSpreadFactorRecords = append(m.PoolIdToSpreadFactorRecords, PoolIdToSpreadFactorRecord{})
+			if err := m.PoolIdToSpreadFactorRecords[len(m.PoolIdToSpreadFactorRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolIdToSpreadFactorRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolIdToSpreadFactorRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolIdToSpreadFactorRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *CreateConcentratedLiquidityPoolsProposal) Unmarshal(dAtA []byte) error {
+func (m *JITProtectionChangeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -732,10 +2614,10 @@ func (m *CreateConcentratedLiquidityPoolsProposal) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateConcentratedLiquidityPoolsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: JITProtectionChangeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateConcentratedLiquidityPoolsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JITProtectionChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -804,7 +2686,7 @@ func (m *CreateConcentratedLiquidityPoolsProposal) Unmarshal(dAtA []byte) error
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdToJitProtectionBlocksRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -831,8 +2713,8 @@ func (m *CreateConcentratedLiquidityPoolsProposal) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolRecords = append(m.PoolRecords, PoolRecord{})
-			if err := m.PoolRecords[len(m.PoolRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolIdToJitProtectionBlocksRecords = append(m.PoolIdToJitProtectionBlocksRecords, PoolIdToJITProtectionBlocksRecord{})
+			if err := m.PoolIdToJitProtectionBlocksRecords[len(m.PoolIdToJitProtectionBlocksRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -857,7 +2739,7 @@ func (m *CreateConcentratedLiquidityPoolsProposal) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *TickSpacingDecreaseProposal) Unmarshal(dAtA []byte) error {
+func (m *PoolIdToJITProtectionBlocksRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -880,10 +2762,98 @@ func (m *TickSpacingDecreaseProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickSpacingDecreaseProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolIdToJITProtectionBlocksRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickSpacingDecreaseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolIdToJITProtectionBlocksRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewJitProtectionBlocks", wireType)
+			}
+			m.NewJitProtectionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewJitProtectionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicSpreadFactorProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSpreadFactorProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSpreadFactorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -952,7 +2922,7 @@ func (m *TickSpacingDecreaseProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdToTickSpacingRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdToDynamicSpreadFactorRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -979,8 +2949,8 @@ func (m *TickSpacingDecreaseProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolIdToTickSpacingRecords = append(m.PoolIdToTickSpacingRecords, PoolIdToTickSpacingRecord{})
-			if err := m.PoolIdToTickSpacingRecords[len(m.PoolIdToTickSpacingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolIdToDynamicSpreadFactorRecords = append(m.PoolIdToDynamicSpreadFactorRecords, PoolIdToDynamicSpreadFactorRecord{})
+			if err := m.PoolIdToDynamicSpreadFactorRecords[len(m.PoolIdToDynamicSpreadFactorRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1005,7 +2975,7 @@ func (m *TickSpacingDecreaseProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolIdToTickSpacingRecord) Unmarshal(dAtA []byte) error {
+func (m *PoolIdToDynamicSpreadFactorRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1028,10 +2998,10 @@ func (m *PoolIdToTickSpacingRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolIdToTickSpacingRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolIdToDynamicSpreadFactorRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolIdToTickSpacingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolIdToDynamicSpreadFactorRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1055,9 +3025,9 @@ func (m *PoolIdToTickSpacingRecord) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewTickSpacing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.NewTickSpacing = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1067,11 +3037,147 @@ func (m *PoolIdToTickSpacingRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewTickSpacing |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolatilityMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LookbackDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/gogo/protobuf/proto"
@@ -109,6 +110,198 @@ func TestTickSpacingDecreaseProposalMarshalUnmarshal(t *testing.T) {
 	}
 }
 
+func TestSpreadFactorChangeProposalMarshalUnmarshal(t *testing.T) {
+	tests := []struct {
+		proposal *types.SpreadFactorChangeProposal
+	}{
+		{ // empty title
+			proposal: &types.SpreadFactorChangeProposal{
+				Title:       "",
+				Description: "proposal to change spread factors",
+			},
+		},
+		{ // empty description
+			proposal: &types.SpreadFactorChangeProposal{
+				Title:       "title",
+				Description: "",
+			},
+		},
+		{ // happy path
+			proposal: &types.SpreadFactorChangeProposal{
+				Title:       "title",
+				Description: "proposal to change spread factors",
+				PoolIdToSpreadFactorRecords: []types.PoolIdToSpreadFactorRecord{
+					{
+						PoolId:          1,
+						NewSpreadFactor: sdk.MustNewDecFromStr("0.003"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		bz, err := proto.Marshal(test.proposal)
+		require.NoError(t, err)
+		decoded := types.SpreadFactorChangeProposal{}
+		err = proto.Unmarshal(bz, &decoded)
+		require.NoError(t, err)
+		require.Equal(t, *test.proposal, decoded)
+	}
+}
+
+func TestSpreadFactorChangeProposal_ValidateBasic(t *testing.T) {
+	baseRecord := types.PoolIdToSpreadFactorRecord{
+		PoolId:          1,
+		NewSpreadFactor: sdk.MustNewDecFromStr("0.003"),
+	}
+
+	tests := []struct {
+		name       string
+		records    []types.PoolIdToSpreadFactorRecord
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			records:    []types.PoolIdToSpreadFactorRecord{baseRecord, {PoolId: 2, NewSpreadFactor: sdk.ZeroDec()}},
+			expectPass: true,
+		},
+		{
+			name:       "empty records",
+			records:    []types.PoolIdToSpreadFactorRecord{},
+			expectPass: false,
+		},
+		{
+			name:       "zero pool id",
+			records:    []types.PoolIdToSpreadFactorRecord{{PoolId: 0, NewSpreadFactor: baseRecord.NewSpreadFactor}},
+			expectPass: false,
+		},
+		{
+			name:       "duplicate pool id",
+			records:    []types.PoolIdToSpreadFactorRecord{baseRecord, baseRecord},
+			expectPass: false,
+		},
+		{
+			name:       "negative spread factor",
+			records:    []types.PoolIdToSpreadFactorRecord{{PoolId: 1, NewSpreadFactor: sdk.MustNewDecFromStr("-0.01")}},
+			expectPass: false,
+		},
+		{
+			name:       "spread factor of one",
+			records:    []types.PoolIdToSpreadFactorRecord{{PoolId: 1, NewSpreadFactor: sdk.OneDec()}},
+			expectPass: false,
+		},
+		{
+			name:       "nil spread factor",
+			records:    []types.PoolIdToSpreadFactorRecord{{PoolId: 1}},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		spreadFactorChangeProposal := types.NewSpreadFactorChangeProposal("title", "description", test.records)
+
+		if test.expectPass {
+			require.NoError(t, spreadFactorChangeProposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, spreadFactorChangeProposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestTickSpacingUpdateProposalMarshalUnmarshal(t *testing.T) {
+	tests := []struct {
+		proposal *types.TickSpacingUpdateProposal
+	}{
+		{ // empty title
+			proposal: &types.TickSpacingUpdateProposal{
+				Title:       "",
+				Description: "proposal to update tick spacing",
+			},
+		},
+		{ // empty description
+			proposal: &types.TickSpacingUpdateProposal{
+				Title:       "title",
+				Description: "",
+			},
+		},
+		{ // happy path
+			proposal: &types.TickSpacingUpdateProposal{
+				Title:       "title",
+				Description: "proposal to update tick spacing",
+				PoolIdToTickSpacingRecords: []types.PoolIdToTickSpacingRecord{
+					{
+						PoolId:         1,
+						NewTickSpacing: 1000,
+					},
+					{
+						PoolId:         2,
+						NewTickSpacing: 1,
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		bz, err := proto.Marshal(test.proposal)
+		require.NoError(t, err)
+		decoded := types.TickSpacingUpdateProposal{}
+		err = proto.Unmarshal(bz, &decoded)
+		require.NoError(t, err)
+		require.Equal(t, *test.proposal, decoded)
+	}
+}
+
+func TestTickSpacingUpdateProposal_ValidateBasic(t *testing.T) {
+	baseRecord := types.PoolIdToTickSpacingRecord{
+		PoolId:         1,
+		NewTickSpacing: 1000,
+	}
+
+	tests := []struct {
+		name       string
+		records    []types.PoolIdToTickSpacingRecord
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			records:    []types.PoolIdToTickSpacingRecord{baseRecord, {PoolId: 2, NewTickSpacing: 1}},
+			expectPass: true,
+		},
+		{
+			name:       "empty records",
+			records:    []types.PoolIdToTickSpacingRecord{},
+			expectPass: false,
+		},
+		{
+			name:       "zero pool id",
+			records:    []types.PoolIdToTickSpacingRecord{{PoolId: 0, NewTickSpacing: baseRecord.NewTickSpacing}},
+			expectPass: false,
+		},
+		{
+			name:       "duplicate pool id",
+			records:    []types.PoolIdToTickSpacingRecord{baseRecord, baseRecord},
+			expectPass: false,
+		},
+		{
+			name:       "zero tick spacing",
+			records:    []types.PoolIdToTickSpacingRecord{{PoolId: 1, NewTickSpacing: 0}},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		tickSpacingUpdateProposal := types.NewTickSpacingUpdateProposal("title", "description", test.records)
+
+		if test.expectPass {
+			require.NoError(t, tickSpacingUpdateProposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, tickSpacingUpdateProposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestCreateConcentratedLiquidityPoolsProposal_ValidateBasic(t *testing.T) {
 	baseRecord := types.PoolRecord{
 		Denom0:             "uion",
@@ -192,3 +385,242 @@ func TestCreateConcentratedLiquidityPoolsProposal_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestDynamicSpreadFactorProposalMarshalUnmarshal(t *testing.T) {
+	tests := []struct {
+		proposal *types.DynamicSpreadFactorProposal
+	}{
+		{ // empty title
+			proposal: &types.DynamicSpreadFactorProposal{
+				Title:       "",
+				Description: "proposal to set dynamic spread factors",
+			},
+		},
+		{ // empty description
+			proposal: &types.DynamicSpreadFactorProposal{
+				Title:       "title",
+				Description: "",
+			},
+		},
+		{ // happy path
+			proposal: &types.DynamicSpreadFactorProposal{
+				Title:       "title",
+				Description: "proposal to set dynamic spread factors",
+				PoolIdToDynamicSpreadFactorRecords: []types.PoolIdToDynamicSpreadFactorRecord{
+					{
+						PoolId:               1,
+						Enabled:              true,
+						MinSpreadFactor:      sdk.MustNewDecFromStr("0.0005"),
+						MaxSpreadFactor:      sdk.MustNewDecFromStr("0.01"),
+						VolatilityMultiplier: sdk.MustNewDecFromStr("2"),
+						LookbackDuration:     time.Hour,
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		bz, err := proto.Marshal(test.proposal)
+		require.NoError(t, err)
+		decoded := types.DynamicSpreadFactorProposal{}
+		err = proto.Unmarshal(bz, &decoded)
+		require.NoError(t, err)
+		require.Equal(t, *test.proposal, decoded)
+	}
+}
+
+func TestDynamicSpreadFactorProposal_ValidateBasic(t *testing.T) {
+	baseRecord := types.PoolIdToDynamicSpreadFactorRecord{
+		PoolId:               1,
+		Enabled:              true,
+		MinSpreadFactor:      sdk.MustNewDecFromStr("0.0005"),
+		MaxSpreadFactor:      sdk.MustNewDecFromStr("0.01"),
+		VolatilityMultiplier: sdk.MustNewDecFromStr("2"),
+		LookbackDuration:     time.Hour,
+	}
+	withRecord := func(modify func(record *types.PoolIdToDynamicSpreadFactorRecord)) []types.PoolIdToDynamicSpreadFactorRecord {
+		record := baseRecord
+		modify(&record)
+		return []types.PoolIdToDynamicSpreadFactorRecord{record}
+	}
+
+	tests := []struct {
+		name       string
+		records    []types.PoolIdToDynamicSpreadFactorRecord
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			records:    []types.PoolIdToDynamicSpreadFactorRecord{baseRecord, {PoolId: 2}},
+			expectPass: true,
+		},
+		{
+			name:       "equal bounds",
+			records:    withRecord(func(record *types.PoolIdToDynamicSpreadFactorRecord) { record.MaxSpreadFactor = record.MinSpreadFactor }),
+			expectPass: true,
+		},
+		{
+			name:       "empty records",
+			records:    []types.PoolIdToDynamicSpreadFactorRecord{},
+			expectPass: false,
+		},
+		{
+			name:       "zero pool id",
+			records:    withRecord(func(record *types.PoolIdToDynamicSpreadFactorRecord) { record.PoolId = 0 }),
+			expectPass: false,
+		},
+		{
+			name:       "duplicate pool id",
+			records:    []types.PoolIdToDynamicSpreadFactorRecord{baseRecord, baseRecord},
+			expectPass: false,
+		},
+		{
+			name:       "nil min spread factor",
+			records:    withRecord(func(record *types.PoolIdToDynamicSpreadFactorRecord) { record.MinSpreadFactor = sdk.Dec{} }),
+			expectPass: false,
+		},
+		{
+			name: "negative min spread factor",
+			records: withRecord(func(record *types.PoolIdToDynamicSpreadFactorRecord) {
+				record.MinSpreadFactor = sdk.MustNewDecFromStr("-0.01")
+			}),
+			expectPass: false,
+		},
+		{
+			name:       "max spread factor of one",
+			records:    withRecord(func(record *types.PoolIdToDynamicSpreadFactorRecord) { record.MaxSpreadFactor = sdk.OneDec() }),
+			expectPass: false,
+		},
+		{
+			name: "min spread factor above max spread factor",
+			records: withRecord(func(record *types.PoolIdToDynamicSpreadFactorRecord) {
+				record.MinSpreadFactor = sdk.MustNewDecFromStr("0.02")
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative volatility multiplier",
+			records: withRecord(func(record *types.PoolIdToDynamicSpreadFactorRecord) {
+				record.VolatilityMultiplier = sdk.MustNewDecFromStr("-1")
+			}),
+			expectPass: false,
+		},
+		{
+			name:       "lookback duration too short",
+			records:    withRecord(func(record *types.PoolIdToDynamicSpreadFactorRecord) { record.LookbackDuration = time.Second }),
+			expectPass: false,
+		},
+		{
+			name: "lookback duration too long",
+			records: withRecord(func(record *types.PoolIdToDynamicSpreadFactorRecord) {
+				record.LookbackDuration = types.MaxDynamicSpreadFactorLookbackDuration + time.Second
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		dynamicSpreadFactorProposal := types.NewDynamicSpreadFactorProposal("title", "description", test.records)
+
+		if test.expectPass {
+			require.NoError(t, dynamicSpreadFactorProposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, dynamicSpreadFactorProposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestJITProtectionChangeProposalMarshalUnmarshal(t *testing.T) {
+	tests := []struct {
+		proposal *types.JITProtectionChangeProposal
+	}{
+		{ // empty title
+			proposal: &types.JITProtectionChangeProposal{
+				Title:       "",
+				Description: "proposal to change JIT protection",
+			},
+		},
+		{ // empty description
+			proposal: &types.JITProtectionChangeProposal{
+				Title:       "title",
+				Description: "",
+			},
+		},
+		{ // happy path
+			proposal: &types.JITProtectionChangeProposal{
+				Title:       "title",
+				Description: "proposal to change JIT protection",
+				PoolIdToJitProtectionBlocksRecords: []types.PoolIdToJITProtectionBlocksRecord{
+					{
+						PoolId:                 1,
+						NewJitProtectionBlocks: 10,
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		bz, err := proto.Marshal(test.proposal)
+		require.NoError(t, err)
+		decoded := types.JITProtectionChangeProposal{}
+		err = proto.Unmarshal(bz, &decoded)
+		require.NoError(t, err)
+		require.Equal(t, *test.proposal, decoded)
+	}
+}
+
+func TestJITProtectionChangeProposal_ValidateBasic(t *testing.T) {
+	baseRecord := types.PoolIdToJITProtectionBlocksRecord{
+		PoolId:                 1,
+		NewJitProtectionBlocks: 10,
+	}
+
+	tests := []struct {
+		name       string
+		records    []types.PoolIdToJITProtectionBlocksRecord
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			records:    []types.PoolIdToJITProtectionBlocksRecord{baseRecord, {PoolId: 2, NewJitProtectionBlocks: 0}},
+			expectPass: true,
+		},
+		{
+			name:       "max JIT protection blocks",
+			records:    []types.PoolIdToJITProtectionBlocksRecord{{PoolId: 1, NewJitProtectionBlocks: types.MaxJITProtectionBlocks}},
+			expectPass: true,
+		},
+		{
+			name:       "empty records",
+			records:    []types.PoolIdToJITProtectionBlocksRecord{},
+			expectPass: false,
+		},
+		{
+			name:       "zero pool id",
+			records:    []types.PoolIdToJITProtectionBlocksRecord{{PoolId: 0, NewJitProtectionBlocks: 10}},
+			expectPass: false,
+		},
+		{
+			name:       "duplicate pool id",
+			records:    []types.PoolIdToJITProtectionBlocksRecord{baseRecord, baseRecord},
+			expectPass: false,
+		},
+		{
+			name:       "too many JIT protection blocks",
+			records:    []types.PoolIdToJITProtectionBlocksRecord{{PoolId: 1, NewJitProtectionBlocks: types.MaxJITProtectionBlocks + 1}},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		jitProtectionChangeProposal := types.NewJITProtectionChangeProposal("title", "description", test.records)
+
+		if test.expectPass {
+			require.NoError(t, jitProtectionChangeProposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, jitProtectionChangeProposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
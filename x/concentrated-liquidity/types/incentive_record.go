@@ -0,0 +1,100 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var nanosPerSecond = sdk.NewDec(int64(time.Second))
+
+// ValidateEmission validates the emission rate and emission schedule of an incentive on the given pool.
+// Without an emission schedule, the emission rate must be positive. With one, the schedule must have at most
+// MaxEmissionScheduleSegments segments, each of positive duration and with non-negative emission rates, the emission
+// rate once the schedule is over must be non-negative, and the incentive must emit at some point.
+func ValidateEmission(poolId uint64, emissionRate sdk.Dec, emissionSchedule []EmissionSegment) error {
+	if len(emissionSchedule) == 0 {
+		if emissionRate.IsNil() || !emissionRate.IsPositive() {
+			return NonPositiveEmissionRateError{PoolId: poolId, EmissionRate: emissionRate}
+		}
+		return nil
+	}
+
+	if len(emissionSchedule) > MaxEmissionScheduleSegments {
+		return TooManyEmissionSegmentsError{NumSegments: len(emissionSchedule), MaxSegments: MaxEmissionScheduleSegments}
+	}
+
+	if emissionRate.IsNil() || emissionRate.IsNegative() {
+		return NegativeEmissionRateError{PoolId: poolId, EmissionRate: emissionRate}
+	}
+
+	emits := emissionRate.IsPositive()
+	for i, segment := range emissionSchedule {
+		if segment.Duration <= 0 ||
+			segment.StartEmissionRate.IsNil() || segment.StartEmissionRate.IsNegative() ||
+			segment.EndEmissionRate.IsNil() || segment.EndEmissionRate.IsNegative() {
+			return InvalidEmissionSegmentError{Index: i, Duration: segment.Duration, StartEmissionRate: segment.StartEmissionRate, EndEmissionRate: segment.EndEmissionRate}
+		}
+		emits = emits || segment.StartEmissionRate.IsPositive() || segment.EndEmissionRate.IsPositive()
+	}
+	if !emits {
+		return NonPositiveEmissionRateError{PoolId: poolId, EmissionRate: emissionRate}
+	}
+
+	return nil
+}
+
+// GetScheduledEmission returns the amount the incentive emits along its emission schedule between from and to.
+// Nothing is emitted before the start time. Once the schedule is over, the incentive emits at its emission rate.
+// The remaining coin is not taken into account.
+func (b IncentiveRecordBody) GetScheduledEmission(from, to time.Time) sdk.Dec {
+	if !to.After(b.StartTime) || !to.After(from) {
+		return sdk.ZeroDec()
+	}
+
+	// Offsets are in seconds since the start time.
+	fromOffset := sdk.ZeroDec()
+	if from.After(b.StartTime) {
+		fromOffset = durationToSeconds(from.Sub(b.StartTime))
+	}
+	toOffset := durationToSeconds(to.Sub(b.StartTime))
+
+	emitted := sdk.ZeroDec()
+	segmentStart := sdk.ZeroDec()
+	for _, segment := range b.EmissionSchedule {
+		segmentDuration := durationToSeconds(segment.Duration)
+		segmentEnd := segmentStart.Add(segmentDuration)
+
+		overlapStart := sdk.MaxDec(fromOffset, segmentStart).Sub(segmentStart)
+		overlapEnd := sdk.MinDec(toOffset, segmentEnd).Sub(segmentStart)
+		if overlapStart.LT(overlapEnd) {
+			// The emission rate is linear over the segment, so the amount emitted over the overlap is its length times
+			// the emission rate at its midpoint.
+			rateChange := segment.EndEmissionRate.Sub(segment.StartEmissionRate)
+			midpointRate := segment.StartEmissionRate.Add(rateChange.Mul(overlapStart.Add(overlapEnd)).QuoInt64(2).Quo(segmentDuration))
+			emitted = emitted.Add(overlapEnd.Sub(overlapStart).Mul(midpointRate))
+		}
+
+		segmentStart = segmentEnd
+	}
+
+	if toOffset.GT(segmentStart) {
+		emitted = emitted.Add(toOffset.Sub(sdk.MaxDec(fromOffset, segmentStart)).Mul(b.EmissionRate))
+	}
+
+	return emitted
+}
+
+// GetScheduleEndTime returns the time at which the emission schedule of the incentive is over. For an incentive without
+// an emission schedule, this is its start time.
+func (b IncentiveRecordBody) GetScheduleEndTime() time.Time {
+	endTime := b.StartTime
+	for _, segment := range b.EmissionSchedule {
+		endTime = endTime.Add(segment.Duration)
+	}
+	return endTime
+}
+
+func durationToSeconds(duration time.Duration) sdk.Dec {
+	return sdk.NewDec(int64(duration)).Quo(nanosPerSecond)
+}
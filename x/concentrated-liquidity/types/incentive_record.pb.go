@@ -112,10 +112,18 @@ func (m *IncentiveRecord) GetMinUptime() time.Duration {
 type IncentiveRecordBody struct {
 	// remaining_coin is the total amount of incentives to be distributed
 	RemainingCoin types1.DecCoin `protobuf:"bytes,1,opt,name=remaining_coin,json=remainingCoin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoin" json:"remaining_coin" yaml:"remaining_coins"`
-	// emission_rate is the incentive emission rate per second
+	// emission_rate is the incentive emission rate per second. If the record has
+	// an emission schedule, it is the emission rate once the schedule is over.
 	EmissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=emission_rate,json=emissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"emission_rate" yaml:"emission_rate"`
 	// start_time is the time when the incentive starts distributing
 	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// creator is the address that funded the incentive. It is the only address
+	// allowed to top up, reduce the emission rate of or cancel the incentive.
+	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	// emission_schedule is the list of consecutive segments the incentive emits
+	// along, starting at start_time. If empty, the incentive emits at the
+	// constant emission_rate.
+	EmissionSchedule []EmissionSegment `protobuf:"bytes,5,rep,name=emission_schedule,json=emissionSchedule,proto3" json:"emission_schedule" yaml:"emission_schedule"`
 }
 
 func (m *IncentiveRecordBody) Reset()         { *m = IncentiveRecordBody{} }
@@ -165,9 +173,74 @@ func (m *IncentiveRecordBody) GetStartTime() time.Time {
 	return time.Time{}
 }
 
+func (m *IncentiveRecordBody) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *IncentiveRecordBody) GetEmissionSchedule() []EmissionSegment {
+	if m != nil {
+		return m.EmissionSchedule
+	}
+	return nil
+}
+
+// EmissionSegment is a segment of an incentive emission schedule. Over the
+// segment's duration, the emission rate per second moves linearly from
+// start_emission_rate to end_emission_rate. Equal rates make a step, a zero
+// rate makes a cliff.
+type EmissionSegment struct {
+	Duration          time.Duration                          `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	StartEmissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=start_emission_rate,json=startEmissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"start_emission_rate" yaml:"start_emission_rate"`
+	EndEmissionRate   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=end_emission_rate,json=endEmissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"end_emission_rate" yaml:"end_emission_rate"`
+}
+
+func (m *EmissionSegment) Reset()         { *m = EmissionSegment{} }
+func (m *EmissionSegment) String() string { return proto.CompactTextString(m) }
+func (*EmissionSegment) ProtoMessage()    {}
+func (*EmissionSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d38bf94e42ee434, []int{2}
+}
+func (m *EmissionSegment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionSegment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionSegment.Merge(m, src)
+}
+func (m *EmissionSegment) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionSegment proto.InternalMessageInfo
+
+func (m *EmissionSegment) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IncentiveRecord)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecord")
 	proto.RegisterType((*IncentiveRecordBody)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordBody")
+	proto.RegisterType((*EmissionSegment)(nil), "osmosis.concentratedliquidity.v1beta1.EmissionSegment")
 }
 
 func init() {
@@ -175,42 +248,51 @@ func init() {
 }
 
 var fileDescriptor_9d38bf94e42ee434 = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xd1, 0x6a, 0x13, 0x41,
-	0x14, 0xcd, 0xc6, 0x5a, 0xe9, 0xa4, 0x55, 0xdc, 0x54, 0x9b, 0x86, 0xba, 0x5b, 0x16, 0x95, 0x22,
-	0x64, 0x86, 0x54, 0xf4, 0x21, 0x8f, 0x6b, 0x11, 0x02, 0x3e, 0x2d, 0x8a, 0x22, 0xc2, 0x32, 0xbb,
-	0x33, 0xae, 0x43, 0xb3, 0x3b, 0x71, 0x67, 0x12, 0xcc, 0x5f, 0x54, 0xf0, 0xc1, 0x6f, 0xf0, 0x4b,
-	0xfa, 0xd8, 0x27, 0x11, 0x1f, 0x52, 0x49, 0x7e, 0x40, 0xf2, 0x05, 0x32, 0xb3, 0xb3, 0x49, 0x9a,
-	0x5a, 0xe8, 0x53, 0x72, 0x66, 0xee, 0xb9, 0xf7, 0x9c, 0x33, 0x77, 0xc1, 0x33, 0x2e, 0x52, 0x2e,
-	0x98, 0x40, 0x31, 0xcf, 0x62, 0x9a, 0xc9, 0x1c, 0x4b, 0x4a, 0x5a, 0x3d, 0xf6, 0x79, 0xc0, 0x08,
-	0x93, 0x23, 0xc4, 0xf4, 0x29, 0x1b, 0xd2, 0x30, 0xa7, 0x31, 0xcf, 0x09, 0xec, 0xe7, 0x5c, 0x72,
-	0xfb, 0x91, 0xa1, 0xc1, 0x65, 0xda, 0x9c, 0x05, 0x87, 0xed, 0x88, 0x4a, 0xdc, 0x6e, 0xee, 0xc6,
-	0xba, 0x2e, 0xd4, 0x24, 0x54, 0x80, 0xa2, 0x43, 0x73, 0x3b, 0xe1, 0x09, 0x2f, 0xce, 0xd5, 0x3f,
-	0x73, 0xea, 0x26, 0x9c, 0x27, 0x3d, 0x8a, 0x34, 0x8a, 0x06, 0x1f, 0x91, 0x64, 0x29, 0x15, 0x12,
-	0xa7, 0x7d, 0x53, 0xe0, 0xac, 0x16, 0x90, 0x41, 0x8e, 0x25, 0xe3, 0x59, 0x79, 0x5f, 0x0c, 0x41,
-	0x11, 0x16, 0x14, 0x19, 0x19, 0x28, 0xe6, 0xcc, 0xdc, 0x7b, 0x3f, 0xab, 0xe0, 0x4e, 0xb7, 0xf4,
-	0x14, 0x68, 0x4b, 0x76, 0x07, 0x6c, 0x2e, 0x6c, 0x32, 0xd2, 0xb0, 0xf6, 0xad, 0x83, 0x35, 0x7f,
-	0x67, 0x36, 0x76, 0xeb, 0x23, 0x9c, 0xf6, 0x3a, 0xde, 0xf2, 0xad, 0x17, 0xd4, 0xe6, 0xb0, 0x4b,
-	0xec, 0x1d, 0x70, 0xab, 0xcf, 0x79, 0x4f, 0xd1, 0xaa, 0x8a, 0x16, 0xac, 0x2b, 0xd8, 0x25, 0xf6,
-	0x37, 0x0b, 0xdc, 0x5b, 0x0d, 0x2f, 0x8c, 0x38, 0x19, 0x35, 0xd6, 0xf6, 0xad, 0x83, 0xda, 0x61,
-	0x07, 0x5e, 0x2b, 0x42, 0xb8, 0x22, 0xd6, 0xe7, 0x64, 0xe4, 0x3f, 0x3c, 0x1d, 0xbb, 0x95, 0xd9,
-	0xd8, 0xdd, 0x5b, 0x95, 0xb7, 0x34, 0xc6, 0x0b, 0xea, 0xec, 0x32, 0xd5, 0x7e, 0x0b, 0x40, 0xca,
-	0xb2, 0x70, 0xd0, 0x57, 0xc1, 0x36, 0x6e, 0x6a, 0x29, 0xbb, 0xb0, 0x08, 0x15, 0x96, 0xa1, 0xc2,
-	0x23, 0x13, 0xaa, 0xff, 0xc0, 0x4c, 0xba, 0x5b, 0x4c, 0x5a, 0x50, 0xbd, 0xef, 0xe7, 0xae, 0x15,
-	0x6c, 0xa4, 0x2c, 0x7b, 0x53, 0xe0, 0xbf, 0x55, 0x50, 0xff, 0x8f, 0x56, 0xfb, 0xab, 0x05, 0x6e,
-	0xe7, 0x34, 0xc5, 0x2c, 0x63, 0x59, 0x12, 0xaa, 0x97, 0xd0, 0xf9, 0xd6, 0x0e, 0xf7, 0xa0, 0xd9,
-	0x07, 0xf5, 0x54, 0x73, 0xbb, 0x47, 0x34, 0x7e, 0xc1, 0x59, 0xe6, 0xbf, 0x32, 0x83, 0xef, 0x17,
-	0x83, 0x2f, 0x76, 0x10, 0xde, 0x8f, 0x73, 0xf7, 0x49, 0xc2, 0xe4, 0xa7, 0x41, 0x04, 0x63, 0x9e,
-	0x9a, 0xcd, 0x32, 0x3f, 0x2d, 0x41, 0x8e, 0x91, 0x1c, 0xf5, 0xa9, 0x28, 0xbb, 0x05, 0x5b, 0x73,
-	0xbe, 0x82, 0xf6, 0x31, 0xd8, 0xa2, 0x29, 0x13, 0x82, 0xf1, 0x2c, 0x54, 0xb1, 0xeb, 0xa7, 0xdb,
-	0xf0, 0x5f, 0xaa, 0x99, 0xbf, 0xc7, 0xee, 0xe3, 0xeb, 0x75, 0x9e, 0x8d, 0xdd, 0xed, 0x42, 0xdd,
-	0x85, 0x66, 0x5e, 0xb0, 0x59, 0xe2, 0x00, 0x4b, 0x6a, 0xbf, 0x03, 0x40, 0x48, 0x9c, 0xcb, 0x50,
-	0x27, 0x7e, 0x43, 0x7b, 0x6f, 0x5e, 0x4a, 0xfc, 0x75, 0xb9, 0xe7, 0xab, 0x91, 0x2f, 0xb8, 0xde,
-	0x89, 0x8e, 0x5c, 0x1f, 0xa8, 0x72, 0xff, 0xc3, 0xe9, 0xc4, 0xb1, 0xce, 0x26, 0x8e, 0xf5, 0x67,
-	0xe2, 0x58, 0x27, 0x53, 0xa7, 0x72, 0x36, 0x75, 0x2a, 0xbf, 0xa6, 0x4e, 0xe5, 0xbd, 0xbf, 0xe4,
-	0xc0, 0xac, 0x59, 0xab, 0x87, 0x23, 0x51, 0x02, 0x34, 0x6c, 0x3f, 0x47, 0x5f, 0xae, 0xfa, 0xe6,
-	0xb5, 0xc3, 0x68, 0x5d, 0x6b, 0x7b, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x8b, 0xa1, 0x6b, 0x93,
-	0x22, 0x04, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x81, 0x0b, 0x97, 0x09, 0x90, 0x1b, 0x87, 0x7b, 0x09, 0xb9, 0xd4, 0x46, 0x56, 0x5b,
+	0xa1, 0xaa, 0xd8, 0x82, 0xaa, 0x2c, 0x58, 0xba, 0x50, 0x89, 0x8a, 0x95, 0x69, 0xd5, 0xaa, 0xaa,
+	0x64, 0xd9, 0x9e, 0xa9, 0x19, 0x11, 0x7b, 0x52, 0xcf, 0x24, 0x6a, 0xa4, 0x6e, 0xbb, 0xa7, 0x52,
+	0x17, 0x7d, 0x82, 0x2e, 0xfa, 0x24, 0x2c, 0x59, 0x55, 0x55, 0x2b, 0x85, 0x0a, 0xde, 0x20, 0x4f,
+	0x50, 0x79, 0x7e, 0x82, 0x31, 0x54, 0x85, 0x55, 0x72, 0xce, 0x9c, 0xef, 0x7c, 0xe7, 0x7c, 0xdf,
+	0x89, 0x02, 0x1e, 0x12, 0x9a, 0x10, 0x8a, 0xa9, 0x13, 0x91, 0x34, 0x42, 0x29, 0xcb, 0x02, 0x86,
+	0xe0, 0x6a, 0x1b, 0xbf, 0xe9, 0x62, 0x88, 0x59, 0xdf, 0xc1, 0x3c, 0x8b, 0x7b, 0xc8, 0xcf, 0x50,
+	0x44, 0x32, 0x68, 0x77, 0x32, 0xc2, 0x88, 0x7e, 0x47, 0xc2, 0xec, 0x22, 0x6c, 0x84, 0xb2, 0x7b,
+	0x6b, 0x21, 0x62, 0xc1, 0x5a, 0x6b, 0x31, 0xe2, 0x75, 0x3e, 0x07, 0x39, 0x22, 0x10, 0x1d, 0x5a,
+	0xf3, 0x31, 0x89, 0x89, 0xc8, 0xe7, 0xdf, 0x64, 0xd6, 0x8c, 0x09, 0x89, 0xdb, 0xc8, 0xe1, 0x51,
+	0xd8, 0x7d, 0xed, 0x30, 0x9c, 0x20, 0xca, 0x82, 0xa4, 0x23, 0x0b, 0x8c, 0x72, 0x01, 0xec, 0x66,
+	0x01, 0xc3, 0x24, 0x55, 0xef, 0x82, 0xc4, 0x09, 0x03, 0x8a, 0x1c, 0x39, 0x86, 0x13, 0x11, 0x2c,
+	0xdf, 0xad, 0xaf, 0x63, 0xa0, 0xb6, 0xa3, 0x76, 0xf2, 0xf8, 0x4a, 0xfa, 0x26, 0x98, 0x39, 0x5f,
+	0x13, 0xc3, 0xa6, 0xb6, 0xac, 0xad, 0x4c, 0xb8, 0x0b, 0xc3, 0x81, 0xd9, 0xe8, 0x07, 0x49, 0x7b,
+	0xd3, 0x2a, 0xbe, 0x5a, 0x5e, 0x75, 0x14, 0xee, 0x40, 0x7d, 0x01, 0x4c, 0x75, 0x08, 0x69, 0xe7,
+	0xb0, 0xb1, 0x1c, 0xe6, 0x4d, 0xe6, 0xe1, 0x0e, 0xd4, 0x3f, 0x6a, 0xe0, 0xdf, 0xb2, 0x78, 0x7e,
+	0x48, 0x60, 0xbf, 0x39, 0xb1, 0xac, 0xad, 0x54, 0xd7, 0x37, 0xed, 0x6b, 0x49, 0x68, 0x97, 0x86,
+	0x75, 0x09, 0xec, 0xbb, 0xb7, 0x8f, 0x06, 0x66, 0x65, 0x38, 0x30, 0x97, 0xca, 0xe3, 0x15, 0x68,
+	0x2c, 0xaf, 0x81, 0x2f, 0x43, 0xf5, 0xe7, 0x00, 0x24, 0x38, 0xf5, 0xbb, 0x9d, 0x5c, 0xd8, 0xe6,
+	0x5f, 0x7c, 0x94, 0x45, 0x5b, 0x88, 0x6a, 0x2b, 0x51, 0xed, 0x2d, 0x29, 0xaa, 0x7b, 0x4b, 0x32,
+	0xd5, 0x05, 0xd3, 0x39, 0xd4, 0xfa, 0x74, 0x62, 0x6a, 0xde, 0x74, 0x82, 0xd3, 0x67, 0x22, 0xfe,
+	0x3c, 0x01, 0x1a, 0x57, 0xcc, 0xaa, 0x7f, 0xd0, 0xc0, 0x5c, 0x86, 0x92, 0x00, 0xa7, 0x38, 0x8d,
+	0xfd, 0xdc, 0x09, 0xae, 0x6f, 0x75, 0x7d, 0xc9, 0x96, 0xf7, 0x90, 0x5b, 0x35, 0x5a, 0x77, 0x0b,
+	0x45, 0x8f, 0x08, 0x4e, 0xdd, 0x5d, 0x49, 0xfc, 0x9f, 0x20, 0xbe, 0xd8, 0x81, 0x5a, 0x5f, 0x4e,
+	0xcc, 0x7b, 0x31, 0x66, 0xfb, 0xdd, 0xd0, 0x8e, 0x48, 0x22, 0x2f, 0x4b, 0x7e, 0xac, 0x52, 0x78,
+	0xe0, 0xb0, 0x7e, 0x07, 0x51, 0xd5, 0xcd, 0x9b, 0x1d, 0xe1, 0xf3, 0x50, 0x3f, 0x00, 0xb3, 0x28,
+	0xc1, 0x94, 0x62, 0x92, 0xfa, 0xb9, 0xec, 0xdc, 0xba, 0x69, 0xf7, 0x71, 0xce, 0xf9, 0x7d, 0x60,
+	0xde, 0xbd, 0x5e, 0xe7, 0xe1, 0xc0, 0x9c, 0x17, 0xd3, 0x5d, 0x68, 0x66, 0x79, 0x33, 0x2a, 0xf6,
+	0x02, 0x86, 0xf4, 0x17, 0x00, 0x50, 0x16, 0x64, 0xcc, 0xe7, 0x8a, 0x8f, 0xf3, 0xdd, 0x5b, 0x97,
+	0x14, 0x7f, 0xaa, 0xee, 0xbc, 0x2c, 0xf9, 0x39, 0xd6, 0x3a, 0xe4, 0x92, 0xf3, 0x44, 0x5e, 0xae,
+	0xdf, 0x07, 0x53, 0x51, 0x86, 0x02, 0x46, 0x32, 0x7e, 0x53, 0xd3, 0xae, 0x3e, 0x1c, 0x98, 0x73,
+	0x02, 0x26, 0x1f, 0x2c, 0x4f, 0x95, 0xe8, 0xef, 0x35, 0x50, 0x1f, 0x0d, 0x4a, 0xa3, 0x7d, 0x04,
+	0xbb, 0xed, 0xfc, 0x02, 0xc6, 0x57, 0xaa, 0xeb, 0x1b, 0xd7, 0x3c, 0xc6, 0x6d, 0x89, 0xdf, 0x43,
+	0x71, 0x82, 0x52, 0xe6, 0x2e, 0xcb, 0x59, 0x9b, 0x25, 0x1d, 0x54, 0x7b, 0xcb, 0xfb, 0x47, 0xe5,
+	0xf6, 0x54, 0xea, 0xc7, 0x18, 0xa8, 0x95, 0xfa, 0xe8, 0x1e, 0xf8, 0x5b, 0xfd, 0x8e, 0x9b, 0xda,
+	0x9f, 0x6e, 0xf2, 0x7f, 0x49, 0x5a, 0x13, 0xa4, 0x0a, 0x28, 0x2e, 0x72, 0xd4, 0x47, 0x7f, 0x07,
+	0x1a, 0x42, 0xbb, 0xab, 0xac, 0xde, 0xbd, 0xb1, 0xd5, 0xad, 0xa2, 0x1d, 0x25, 0xc3, 0xeb, 0x3c,
+	0xbb, 0x5d, 0x74, 0xbd, 0x07, 0xea, 0x28, 0x85, 0x25, 0xee, 0x71, 0xce, 0xfd, 0xe4, 0xc6, 0xdc,
+	0x4a, 0xde, 0x72, 0x43, 0xcb, 0xab, 0xa1, 0x14, 0x16, 0x79, 0xdd, 0x57, 0x47, 0xa7, 0x86, 0x76,
+	0x7c, 0x6a, 0x68, 0x3f, 0x4f, 0x0d, 0xed, 0xf0, 0xcc, 0xa8, 0x1c, 0x9f, 0x19, 0x95, 0x6f, 0x67,
+	0x46, 0xe5, 0xa5, 0x5b, 0xa0, 0x93, 0x6e, 0xaf, 0xb6, 0x83, 0x90, 0xaa, 0xc0, 0xe9, 0xad, 0x6d,
+	0x38, 0x6f, 0x7f, 0xf7, 0x3f, 0xc0, 0xc7, 0x09, 0x27, 0xb9, 0x1b, 0x0f, 0x7e, 0x0d, 0x00, 0x0d,
+	0xfe, 0x48, 0x2c, 0x36, 0x06, 0x00, 0x00,
 }
 
 func (m *IncentiveRecord) Marshal() (dAtA []byte, err error) {
@@ -284,6 +366,27 @@ func (m *IncentiveRecordBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EmissionSchedule) > 0 {
+		for iNdEx := len(m.EmissionSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmissionSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentiveRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
 	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
 	if err3 != nil {
 		return 0, err3
@@ -315,6 +418,57 @@ func (m *IncentiveRecordBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EmissionSegment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionSegment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EndEmissionRate.Size()
+		i -= size
+		if _, err := m.EndEmissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StartEmissionRate.Size()
+		i -= size
+		if _, err := m.StartEmissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintIncentiveRecord(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIncentiveRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIncentiveRecord(v)
 	base := offset
@@ -357,6 +511,31 @@ func (m *IncentiveRecordBody) Size() (n int) {
 	n += 1 + l + sovIncentiveRecord(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
 	n += 1 + l + sovIncentiveRecord(uint64(l))
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovIncentiveRecord(uint64(l))
+	}
+	if len(m.EmissionSchedule) > 0 {
+		for _, e := range m.EmissionSchedule {
+			l = e.Size()
+			n += 1 + l + sovIncentiveRecord(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmissionSegment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovIncentiveRecord(uint64(l))
+	l = m.StartEmissionRate.Size()
+	n += 1 + l + sovIncentiveRecord(uint64(l))
+	l = m.EndEmissionRate.Size()
+	n += 1 + l + sovIncentiveRecord(uint64(l))
 	return n
 }
 
@@ -649,6 +828,223 @@ func (m *IncentiveRecordBody) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmissionSchedule = append(m.EmissionSchedule, EmissionSegment{})
+			if err := m.EmissionSchedule[len(m.EmissionSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentiveRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionSegment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentiveRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionSegment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEmissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartEmissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEmissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndEmissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentiveRecord(dAtA[iNdEx:])
@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+func TestGetScheduledEmission(t *testing.T) {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	// Nothing for 10 seconds, then 4 per second decaying linearly to 2 per second over 10 seconds, then 1 per second.
+	body := types.IncentiveRecordBody{
+		EmissionRate: sdk.OneDec(),
+		StartTime:    startTime,
+		EmissionSchedule: []types.EmissionSegment{
+			{Duration: 10 * time.Second, StartEmissionRate: sdk.ZeroDec(), EndEmissionRate: sdk.ZeroDec()},
+			{Duration: 10 * time.Second, StartEmissionRate: sdk.NewDec(4), EndEmissionRate: sdk.NewDec(2)},
+		},
+	}
+	at := func(seconds int64) time.Time {
+		return startTime.Add(time.Duration(seconds) * time.Second)
+	}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		expected sdk.Dec
+	}{
+		{
+			name:     "before start time",
+			from:     at(-20),
+			to:       at(-10),
+			expected: sdk.ZeroDec(),
+		},
+		{
+			name:     "cliff",
+			from:     at(-10),
+			to:       at(10),
+			expected: sdk.ZeroDec(),
+		},
+		{
+			name:     "whole linear segment",
+			from:     at(10),
+			to:       at(20),
+			expected: sdk.NewDec(30),
+		},
+		{
+			name:     "second half of linear segment",
+			from:     at(15),
+			to:       at(20),
+			expected: sdk.MustNewDecFromStr("12.5"),
+		},
+		{
+			name:     "after the schedule",
+			from:     at(25),
+			to:       at(35),
+			expected: sdk.NewDec(10),
+		},
+		{
+			name:     "across all segments",
+			from:     at(-10),
+			to:       at(30),
+			expected: sdk.NewDec(40),
+		},
+		{
+			name:     "empty interval",
+			from:     at(15),
+			to:       at(15),
+			expected: sdk.ZeroDec(),
+		},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.expected, body.GetScheduledEmission(test.from, test.to), "test: %v", test.name)
+	}
+}
@@ -47,6 +47,16 @@ var (
 
 	KeyNextGlobalIncentiveRecordId = []byte{0x12}
 
+	AutoCompoundPositionPrefix   = []byte{0x13}
+	PositionOperatorPrefix       = []byte{0x14}
+	ObservationStatePrefix       = []byte{0x15}
+	ObservationPrefix            = []byte{0x16}
+	PositionPerformancePrefix    = []byte{0x17}
+	DynamicSpreadFactorPrefix    = []byte{0x18}
+	PositionCreationHeightPrefix = []byte{0x19}
+	KeyAutoCompoundCursor        = []byte{0x1A}
+	TickSpacingMigrationPrefix   = []byte{0x1B}
+
 	// TickPrefix + pool id
 	KeyTickPrefixByPoolIdLengthBytes = len(TickPrefix) + uint64ByteSize
 	// TickPrefix + pool id + sign byte(negative / positive prefix) + tick index: 18bytes in total
@@ -172,6 +182,51 @@ func KeyPositionId(positionId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d", PositionIdPrefix, positionId))
 }
 
+// KeyAutoCompoundPosition returns the key consisted of (AutoCompoundPositionPrefix | position Id)
+func KeyAutoCompoundPosition(positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", AutoCompoundPositionPrefix, positionId))
+}
+
+// KeyPositionOperatorPrefix returns the key prefix consisted of (PositionOperatorPrefix | position Id)
+func KeyPositionOperatorPrefix(positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d%s", PositionOperatorPrefix, KeySeparator, positionId, KeySeparator))
+}
+
+// KeyPositionOperator returns the key consisted of (PositionOperatorPrefix | position Id | operator)
+func KeyPositionOperator(positionId uint64, operator sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("%s%x", KeyPositionOperatorPrefix(positionId), operator.Bytes()))
+}
+
+// KeyObservationState returns the key consisted of (ObservationStatePrefix | pool Id)
+func KeyObservationState(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", ObservationStatePrefix, poolId))
+}
+
+// KeyObservation returns the key consisted of (ObservationPrefix | pool Id | slot index)
+func KeyObservation(poolId uint64, index uint32) []byte {
+	return []byte(fmt.Sprintf("%s%s%d%s%d", ObservationPrefix, KeySeparator, poolId, KeySeparator, index))
+}
+
+// KeyPositionPerformance returns the key consisted of (PositionPerformancePrefix | position Id)
+func KeyPositionPerformance(positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", PositionPerformancePrefix, positionId))
+}
+
+// KeyDynamicSpreadFactor returns the key consisted of (DynamicSpreadFactorPrefix | pool Id)
+func KeyDynamicSpreadFactor(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", DynamicSpreadFactorPrefix, poolId))
+}
+
+// KeyPositionCreationHeight returns the key consisted of (PositionCreationHeightPrefix | position Id)
+func KeyPositionCreationHeight(positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", PositionCreationHeightPrefix, positionId))
+}
+
+// KeyTickSpacingMigration returns the key consisted of (TickSpacingMigrationPrefix | big endian pool Id)
+func KeyTickSpacingMigration(poolId uint64) []byte {
+	return append(append([]byte{}, TickSpacingMigrationPrefix...), sdk.Uint64ToBigEndian(poolId)...)
+}
+
 // Position Prefix Keys
 
 // KeyAddressPoolIdPositionId returns the full key needed to store the position id for given addr + pool id + position id combination.
@@ -5,16 +5,31 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
 )
 
 // constants.
 const (
-	TypeMsgCreatePosition          = "create-position"
-	TypeAddToPosition              = "add-to-position"
-	TypeMsgWithdrawPosition        = "withdraw-position"
-	TypeMsgCollectSpreadRewards    = "collect-spread-rewards"
-	TypeMsgCollectIncentives       = "collect-incentives"
-	TypeMsgFungifyChargedPositions = "fungify-charged-positions"
+	TypeMsgCreatePosition                 = "create-position"
+	TypeAddToPosition                     = "add-to-position"
+	TypeMsgWithdrawPosition               = "withdraw-position"
+	TypeMsgCollectSpreadRewards           = "collect-spread-rewards"
+	TypeMsgCollectIncentives              = "collect-incentives"
+	TypeMsgFungifyChargedPositions        = "fungify-charged-positions"
+	TypeMsgTransferPositions              = "transfer-positions"
+	TypeMsgCompoundPosition               = "compound-position"
+	TypeMsgSetPositionAutoCompound        = "set-position-auto-compound"
+	TypeMsgSetPositionOperator            = "set-position-operator"
+	TypeMsgRerangePosition                = "rerange-position"
+	TypeMsgIncreaseObservationCardinality = "increase-observation-cardinality"
+	TypeMsgSplitPosition                  = "split-position"
+	TypeMsgMergePositions                 = "merge-positions"
+	TypeMsgCreateIncentive                = "create-incentive"
+	TypeMsgAddToIncentive                 = "add-to-incentive"
+	TypeMsgReduceIncentiveEmissionRate    = "reduce-incentive-emission-rate"
+	TypeMsgCancelIncentive                = "cancel-incentive"
+	TypeMsgCreatePositionFromSingleAsset  = "create-position-from-single-asset"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -221,3 +236,478 @@ func (msg MsgFungifyChargedPositions) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgTransferPositions{}
+
+func (msg MsgTransferPositions) Route() string { return RouterKey }
+func (msg MsgTransferPositions) Type() string  { return TypeMsgTransferPositions }
+func (msg MsgTransferPositions) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return fmt.Errorf("Invalid new owner address (%s)", err)
+	}
+
+	if sender.Equals(newOwner) {
+		return fmt.Errorf("Sender and new owner must be different (%s)", msg.Sender)
+	}
+
+	if len(msg.PositionIds) == 0 {
+		return fmt.Errorf("Must provide at least 1 position id")
+	}
+
+	if osmoutils.ContainsDuplicate(msg.PositionIds) {
+		return DuplicatePositionIdsError{PositionIds: msg.PositionIds}
+	}
+
+	return nil
+}
+
+func (msg MsgTransferPositions) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgTransferPositions) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCompoundPosition{}
+
+func (msg MsgCompoundPosition) Route() string { return RouterKey }
+func (msg MsgCompoundPosition) Type() string  { return TypeMsgCompoundPosition }
+func (msg MsgCompoundPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId <= 0 {
+		return fmt.Errorf("Invalid position id (%s)", strconv.FormatUint(msg.PositionId, 10))
+	}
+
+	if !msg.TokenMinAmount0.IsNil() && msg.TokenMinAmount0.IsNegative() {
+		return fmt.Errorf("Amount 0 cannot be negative, given token min amount: %s", msg.TokenMinAmount0.String())
+	}
+	if !msg.TokenMinAmount1.IsNil() && msg.TokenMinAmount1.IsNegative() {
+		return fmt.Errorf("Amount 1 cannot be negative, given token min amount: %s", msg.TokenMinAmount1.String())
+	}
+
+	return nil
+}
+
+func (msg MsgCompoundPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCompoundPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPositionAutoCompound{}
+
+func (msg MsgSetPositionAutoCompound) Route() string { return RouterKey }
+func (msg MsgSetPositionAutoCompound) Type() string  { return TypeMsgSetPositionAutoCompound }
+func (msg MsgSetPositionAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId <= 0 {
+		return fmt.Errorf("Invalid position id (%s)", strconv.FormatUint(msg.PositionId, 10))
+	}
+
+	return nil
+}
+
+func (msg MsgSetPositionAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPositionAutoCompound) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPositionOperator{}
+
+func (msg MsgSetPositionOperator) Route() string { return RouterKey }
+func (msg MsgSetPositionOperator) Type() string  { return TypeMsgSetPositionOperator }
+func (msg MsgSetPositionOperator) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return fmt.Errorf("Invalid operator address (%s)", err)
+	}
+
+	if sender.Equals(operator) {
+		return fmt.Errorf("Sender and operator must be different (%s)", msg.Sender)
+	}
+
+	if msg.PositionId <= 0 {
+		return fmt.Errorf("Invalid position id (%s)", strconv.FormatUint(msg.PositionId, 10))
+	}
+
+	return nil
+}
+
+func (msg MsgSetPositionOperator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPositionOperator) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRerangePosition{}
+
+func (msg MsgRerangePosition) Route() string { return RouterKey }
+func (msg MsgRerangePosition) Type() string  { return TypeMsgRerangePosition }
+func (msg MsgRerangePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId <= 0 {
+		return fmt.Errorf("Invalid position id (%s)", strconv.FormatUint(msg.PositionId, 10))
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	if !msg.TokenMinAmount0.IsNil() && msg.TokenMinAmount0.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount0.String()}
+	}
+
+	if !msg.TokenMinAmount1.IsNil() && msg.TokenMinAmount1.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount1.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgRerangePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRerangePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgIncreaseObservationCardinality{}
+
+func (msg MsgIncreaseObservationCardinality) Route() string { return RouterKey }
+func (msg MsgIncreaseObservationCardinality) Type() string {
+	return TypeMsgIncreaseObservationCardinality
+}
+func (msg MsgIncreaseObservationCardinality) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolId == 0 {
+		return fmt.Errorf("Invalid pool id (%s)", strconv.FormatUint(msg.PoolId, 10))
+	}
+
+	if msg.CardinalityNext == 0 || msg.CardinalityNext > MaxObservationCardinality {
+		return InvalidObservationCardinalityError{Cardinality: msg.CardinalityNext, MaxCardinality: MaxObservationCardinality}
+	}
+
+	return nil
+}
+
+func (msg MsgIncreaseObservationCardinality) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgIncreaseObservationCardinality) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSplitPosition{}
+
+func (msg MsgSplitPosition) Route() string { return RouterKey }
+func (msg MsgSplitPosition) Type() string  { return TypeMsgSplitPosition }
+func (msg MsgSplitPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId <= 0 {
+		return fmt.Errorf("Invalid position id (%s)", strconv.FormatUint(msg.PositionId, 10))
+	}
+
+	if msg.Liquidity.IsNil() || !msg.Liquidity.IsPositive() {
+		return NotPositiveRequireAmountError{Amount: msg.Liquidity.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgSplitPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSplitPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMergePositions{}
+
+func (msg MsgMergePositions) Route() string { return RouterKey }
+func (msg MsgMergePositions) Type() string  { return TypeMsgMergePositions }
+func (msg MsgMergePositions) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if len(msg.PositionIds) < 2 {
+		return fmt.Errorf("Must provide at least 2 positions, got %d", len(msg.PositionIds))
+	}
+
+	if osmoutils.ContainsDuplicate(msg.PositionIds) {
+		return DuplicatePositionIdsError{PositionIds: msg.PositionIds}
+	}
+
+	return nil
+}
+
+func (msg MsgMergePositions) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgMergePositions) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCreateIncentive{}
+
+func (msg MsgCreateIncentive) Route() string { return RouterKey }
+func (msg MsgCreateIncentive) Type() string  { return TypeMsgCreateIncentive }
+func (msg MsgCreateIncentive) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolId <= 0 {
+		return fmt.Errorf("Invalid pool id (%s)", strconv.FormatUint(msg.PoolId, 10))
+	}
+
+	if !msg.IncentiveCoin.IsValid() || msg.IncentiveCoin.IsZero() {
+		return InvalidIncentiveCoinError{PoolId: msg.PoolId, IncentiveCoin: msg.IncentiveCoin}
+	}
+
+	if err := ValidateEmission(msg.PoolId, msg.EmissionRate, msg.EmissionSchedule); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (msg MsgCreateIncentive) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateIncentive) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgAddToIncentive{}
+
+func (msg MsgAddToIncentive) Route() string { return RouterKey }
+func (msg MsgAddToIncentive) Type() string  { return TypeMsgAddToIncentive }
+func (msg MsgAddToIncentive) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolId <= 0 {
+		return fmt.Errorf("Invalid pool id (%s)", strconv.FormatUint(msg.PoolId, 10))
+	}
+
+	if !msg.IncentiveCoin.IsValid() || msg.IncentiveCoin.IsZero() {
+		return InvalidIncentiveCoinError{PoolId: msg.PoolId, IncentiveCoin: msg.IncentiveCoin}
+	}
+
+	return nil
+}
+
+func (msg MsgAddToIncentive) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddToIncentive) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgReduceIncentiveEmissionRate{}
+
+func (msg MsgReduceIncentiveEmissionRate) Route() string { return RouterKey }
+func (msg MsgReduceIncentiveEmissionRate) Type() string  { return TypeMsgReduceIncentiveEmissionRate }
+func (msg MsgReduceIncentiveEmissionRate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolId <= 0 {
+		return fmt.Errorf("Invalid pool id (%s)", strconv.FormatUint(msg.PoolId, 10))
+	}
+
+	if msg.EmissionRate.IsNil() || !msg.EmissionRate.IsPositive() {
+		return NonPositiveEmissionRateError{PoolId: msg.PoolId, EmissionRate: msg.EmissionRate}
+	}
+
+	return nil
+}
+
+func (msg MsgReduceIncentiveEmissionRate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgReduceIncentiveEmissionRate) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelIncentive{}
+
+func (msg MsgCancelIncentive) Route() string { return RouterKey }
+func (msg MsgCancelIncentive) Type() string  { return TypeMsgCancelIncentive }
+func (msg MsgCancelIncentive) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolId <= 0 {
+		return fmt.Errorf("Invalid pool id (%s)", strconv.FormatUint(msg.PoolId, 10))
+	}
+
+	return nil
+}
+
+func (msg MsgCancelIncentive) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelIncentive) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCreatePositionFromSingleAsset{}
+
+func (msg MsgCreatePositionFromSingleAsset) Route() string { return RouterKey }
+func (msg MsgCreatePositionFromSingleAsset) Type() string {
+	return TypeMsgCreatePositionFromSingleAsset
+}
+func (msg MsgCreatePositionFromSingleAsset) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolId <= 0 {
+		return fmt.Errorf("Invalid pool id (%s)", strconv.FormatUint(msg.PoolId, 10))
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return fmt.Errorf("Invalid token in (%s)", msg.TokenIn.String())
+	}
+
+	for _, route := range msg.Routes {
+		if route.PoolId <= 0 {
+			return fmt.Errorf("Invalid pool id (%s)", strconv.FormatUint(route.PoolId, 10))
+		}
+		if err := sdk.ValidateDenom(route.TokenOutDenom); err != nil {
+			return err
+		}
+	}
+
+	if !msg.TokenMinAmount0.IsNil() && msg.TokenMinAmount0.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount0.String()}
+	}
+
+	if !msg.TokenMinAmount1.IsNil() && msg.TokenMinAmount1.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount1.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgCreatePositionFromSingleAsset) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePositionFromSingleAsset) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
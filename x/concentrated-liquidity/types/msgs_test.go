@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 )
 
 type extMsg interface {
@@ -275,6 +277,734 @@ func TestMsgFungifyChargedPositions(t *testing.T) {
 	}
 }
 
+func TestMsgTransferPositions(t *testing.T) {
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address()).String()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgTransferPositions
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgTransferPositions{
+				Sender:      addr1,
+				NewOwner:    addr2,
+				PositionIds: []uint64{1, 2},
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgTransferPositions{
+				Sender:      invalidAddr.String(),
+				NewOwner:    addr2,
+				PositionIds: []uint64{1},
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid new owner",
+			msg: types.MsgTransferPositions{
+				Sender:      addr1,
+				NewOwner:    invalidAddr.String(),
+				PositionIds: []uint64{1},
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: sender is the new owner",
+			msg: types.MsgTransferPositions{
+				Sender:      addr1,
+				NewOwner:    addr1,
+				PositionIds: []uint64{1},
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: no position ids",
+			msg: types.MsgTransferPositions{
+				Sender:   addr1,
+				NewOwner: addr2,
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: duplicate position ids",
+			msg: types.MsgTransferPositions{
+				Sender:      addr1,
+				NewOwner:    addr2,
+				PositionIds: []uint64{1, 1},
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgTransferPositions)
+	}
+}
+
+func TestMsgCompoundPosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgCompoundPosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCompoundPosition{
+				PositionId:      1,
+				Sender:          addr1,
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.ZeroInt(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper msg, no minimum amounts",
+			msg: types.MsgCompoundPosition{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgCompoundPosition{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid position id",
+			msg: types.MsgCompoundPosition{
+				PositionId: 0,
+				Sender:     addr1,
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: negative token min amount",
+			msg: types.MsgCompoundPosition{
+				PositionId:      1,
+				Sender:          addr1,
+				TokenMinAmount0: sdk.ZeroInt(),
+				TokenMinAmount1: sdk.NewInt(-1),
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgCompoundPosition)
+	}
+}
+
+func TestMsgSetPositionAutoCompound(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgSetPositionAutoCompound
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgSetPositionAutoCompound{
+				PositionId:   1,
+				Sender:       addr1,
+				AutoCompound: true,
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgSetPositionAutoCompound{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid position id",
+			msg: types.MsgSetPositionAutoCompound{
+				PositionId: 0,
+				Sender:     addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgSetPositionAutoCompound)
+	}
+}
+
+func TestMsgSetPositionOperator(t *testing.T) {
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address()).String()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSetPositionOperator
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgSetPositionOperator{
+				Sender:     addr1,
+				Operator:   addr2,
+				Approved:   true,
+				PositionId: 1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgSetPositionOperator{
+				Sender:     invalidAddr.String(),
+				Operator:   addr2,
+				PositionId: 1,
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid operator",
+			msg: types.MsgSetPositionOperator{
+				Sender:     addr1,
+				Operator:   invalidAddr.String(),
+				PositionId: 1,
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: sender is the operator",
+			msg: types.MsgSetPositionOperator{
+				Sender:     addr1,
+				Operator:   addr1,
+				PositionId: 1,
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid position id",
+			msg: types.MsgSetPositionOperator{
+				Sender:   addr1,
+				Operator: addr2,
+				Approved: true,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgSetPositionOperator)
+	}
+}
+
+func TestMsgRerangePosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgRerangePosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgRerangePosition{
+				PositionId:      1,
+				Sender:          addr1,
+				LowerTick:       -10,
+				UpperTick:       10,
+				TokenMinAmount0: sdk.ZeroInt(),
+				TokenMinAmount1: sdk.OneInt(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgRerangePosition{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+				LowerTick:  -10,
+				UpperTick:  10,
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid position id",
+			msg: types.MsgRerangePosition{
+				PositionId: 0,
+				Sender:     addr1,
+				LowerTick:  -10,
+				UpperTick:  10,
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: lower tick is not below upper tick",
+			msg: types.MsgRerangePosition{
+				PositionId: 1,
+				Sender:     addr1,
+				LowerTick:  10,
+				UpperTick:  10,
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: negative token min amount",
+			msg: types.MsgRerangePosition{
+				PositionId:      1,
+				Sender:          addr1,
+				LowerTick:       -10,
+				UpperTick:       10,
+				TokenMinAmount0: sdk.NewInt(-1),
+				TokenMinAmount1: sdk.ZeroInt(),
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgRerangePosition)
+	}
+}
+
+func TestMsgIncreaseObservationCardinality(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgIncreaseObservationCardinality
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgIncreaseObservationCardinality{
+				Sender:          addr1,
+				PoolId:          1,
+				CardinalityNext: 100,
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgIncreaseObservationCardinality{
+				Sender:          invalidAddr.String(),
+				PoolId:          1,
+				CardinalityNext: 100,
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid pool id",
+			msg: types.MsgIncreaseObservationCardinality{
+				Sender:          addr1,
+				PoolId:          0,
+				CardinalityNext: 100,
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: zero cardinality",
+			msg: types.MsgIncreaseObservationCardinality{
+				Sender: addr1,
+				PoolId: 1,
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: cardinality above maximum",
+			msg: types.MsgIncreaseObservationCardinality{
+				Sender:          addr1,
+				PoolId:          1,
+				CardinalityNext: types.MaxObservationCardinality + 1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgIncreaseObservationCardinality)
+	}
+}
+
+func TestMsgSplitPosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgSplitPosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgSplitPosition{
+				PositionId: 1,
+				Sender:     addr1,
+				Liquidity:  sdk.OneDec(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgSplitPosition{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+				Liquidity:  sdk.OneDec(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid position id",
+			msg: types.MsgSplitPosition{
+				PositionId: 0,
+				Sender:     addr1,
+				Liquidity:  sdk.OneDec(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: zero liquidity",
+			msg: types.MsgSplitPosition{
+				PositionId: 1,
+				Sender:     addr1,
+				Liquidity:  sdk.ZeroDec(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: nil liquidity",
+			msg: types.MsgSplitPosition{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgSplitPosition)
+	}
+}
+
+func TestMsgMergePositions(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgMergePositions
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgMergePositions{
+				PositionIds: []uint64{1, 2},
+				Sender:      addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgMergePositions{
+				PositionIds: []uint64{1, 2},
+				Sender:      invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: single position",
+			msg: types.MsgMergePositions{
+				PositionIds: []uint64{1},
+				Sender:      addr1,
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: duplicate positions",
+			msg: types.MsgMergePositions{
+				PositionIds: []uint64{1, 2, 1},
+				Sender:      addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgMergePositions)
+	}
+}
+
+func TestMsgCreateIncentive(t *testing.T) {
+	validMsg := types.MsgCreateIncentive{
+		PoolId:        1,
+		Sender:        addr1,
+		IncentiveCoin: sdk.NewCoin("uion", sdk.NewInt(1000)),
+		EmissionRate:  sdk.OneDec(),
+		StartTime:     time.Unix(1, 0).UTC(),
+		MinUptime:     time.Nanosecond,
+	}
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgCreateIncentive
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        func() types.MsgCreateIncentive { return validMsg },
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: func() types.MsgCreateIncentive {
+				msg := validMsg
+				msg.Sender = invalidAddr.String()
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid pool id",
+			msg: func() types.MsgCreateIncentive {
+				msg := validMsg
+				msg.PoolId = 0
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: zero incentive coin",
+			msg: func() types.MsgCreateIncentive {
+				msg := validMsg
+				msg.IncentiveCoin = sdk.NewCoin("uion", sdk.ZeroInt())
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: zero emission rate",
+			msg: func() types.MsgCreateIncentive {
+				msg := validMsg
+				msg.EmissionRate = sdk.ZeroDec()
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: nil emission rate",
+			msg: func() types.MsgCreateIncentive {
+				msg := validMsg
+				msg.EmissionRate = sdk.Dec{}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "emission schedule with zero emission rate",
+			msg: func() types.MsgCreateIncentive {
+				msg := validMsg
+				msg.EmissionRate = sdk.ZeroDec()
+				msg.EmissionSchedule = []types.EmissionSegment{{Duration: time.Hour, StartEmissionRate: sdk.OneDec(), EndEmissionRate: sdk.ZeroDec()}}
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: emission schedule that emits nothing",
+			msg: func() types.MsgCreateIncentive {
+				msg := validMsg
+				msg.EmissionRate = sdk.ZeroDec()
+				msg.EmissionSchedule = []types.EmissionSegment{{Duration: time.Hour, StartEmissionRate: sdk.ZeroDec(), EndEmissionRate: sdk.ZeroDec()}}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: emission schedule with negative emission rate",
+			msg: func() types.MsgCreateIncentive {
+				msg := validMsg
+				msg.EmissionRate = sdk.NewDec(-1)
+				msg.EmissionSchedule = []types.EmissionSegment{{Duration: time.Hour, StartEmissionRate: sdk.OneDec(), EndEmissionRate: sdk.ZeroDec()}}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: emission segment with zero duration",
+			msg: func() types.MsgCreateIncentive {
+				msg := validMsg
+				msg.EmissionSchedule = []types.EmissionSegment{{Duration: 0, StartEmissionRate: sdk.OneDec(), EndEmissionRate: sdk.ZeroDec()}}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: emission segment with negative emission rate",
+			msg: func() types.MsgCreateIncentive {
+				msg := validMsg
+				msg.EmissionSchedule = []types.EmissionSegment{{Duration: time.Hour, StartEmissionRate: sdk.OneDec(), EndEmissionRate: sdk.NewDec(-1)}}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: too many emission segments",
+			msg: func() types.MsgCreateIncentive {
+				msg := validMsg
+				msg.EmissionSchedule = make([]types.EmissionSegment, types.MaxEmissionScheduleSegments+1)
+				for i := range msg.EmissionSchedule {
+					msg.EmissionSchedule[i] = types.EmissionSegment{Duration: time.Hour, StartEmissionRate: sdk.OneDec(), EndEmissionRate: sdk.OneDec()}
+				}
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		msg := test.msg()
+		runValidateBasicTest(t, test.name, &msg, test.expectPass, types.TypeMsgCreateIncentive)
+	}
+}
+
+func TestMsgAddToIncentive(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgAddToIncentive
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgAddToIncentive{
+				PoolId:        1,
+				IncentiveId:   1,
+				Sender:        addr1,
+				IncentiveCoin: sdk.NewCoin("uion", sdk.NewInt(1000)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgAddToIncentive{
+				PoolId:        1,
+				IncentiveId:   1,
+				Sender:        invalidAddr.String(),
+				IncentiveCoin: sdk.NewCoin("uion", sdk.NewInt(1000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid pool id",
+			msg: types.MsgAddToIncentive{
+				PoolId:        0,
+				IncentiveId:   1,
+				Sender:        addr1,
+				IncentiveCoin: sdk.NewCoin("uion", sdk.NewInt(1000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: zero incentive coin",
+			msg: types.MsgAddToIncentive{
+				PoolId:        1,
+				IncentiveId:   1,
+				Sender:        addr1,
+				IncentiveCoin: sdk.NewCoin("uion", sdk.ZeroInt()),
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgAddToIncentive)
+	}
+}
+
+func TestMsgReduceIncentiveEmissionRate(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgReduceIncentiveEmissionRate
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgReduceIncentiveEmissionRate{
+				PoolId:       1,
+				IncentiveId:  1,
+				Sender:       addr1,
+				EmissionRate: sdk.OneDec(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgReduceIncentiveEmissionRate{
+				PoolId:       1,
+				IncentiveId:  1,
+				Sender:       invalidAddr.String(),
+				EmissionRate: sdk.OneDec(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid pool id",
+			msg: types.MsgReduceIncentiveEmissionRate{
+				PoolId:       0,
+				IncentiveId:  1,
+				Sender:       addr1,
+				EmissionRate: sdk.OneDec(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: zero emission rate",
+			msg: types.MsgReduceIncentiveEmissionRate{
+				PoolId:       1,
+				IncentiveId:  1,
+				Sender:       addr1,
+				EmissionRate: sdk.ZeroDec(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: nil emission rate",
+			msg: types.MsgReduceIncentiveEmissionRate{
+				PoolId:      1,
+				IncentiveId: 1,
+				Sender:      addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgReduceIncentiveEmissionRate)
+	}
+}
+
+func TestMsgCancelIncentive(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgCancelIncentive
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCancelIncentive{
+				PoolId:      1,
+				IncentiveId: 1,
+				Sender:      addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgCancelIncentive{
+				PoolId:      1,
+				IncentiveId: 1,
+				Sender:      invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid pool id",
+			msg: types.MsgCancelIncentive{
+				PoolId:      0,
+				IncentiveId: 1,
+				Sender:      addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgCancelIncentive)
+	}
+}
+
 func TestMsgWithdrawPosition(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -305,6 +1035,123 @@ func TestMsgWithdrawPosition(t *testing.T) {
 	}
 }
 
+func TestMsgCreatePositionFromSingleAsset(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgCreatePositionFromSingleAsset
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCreatePositionFromSingleAsset{
+				PoolId:          1,
+				Sender:          addr1,
+				LowerTick:       -10,
+				UpperTick:       10,
+				TokenIn:         sdk.NewCoin("foo", sdk.NewInt(1000)),
+				TokenMinAmount0: sdk.ZeroInt(),
+				TokenMinAmount1: sdk.ZeroInt(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper msg with route",
+			msg: types.MsgCreatePositionFromSingleAsset{
+				PoolId:    1,
+				Sender:    addr1,
+				LowerTick: -10,
+				UpperTick: 10,
+				TokenIn:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+				Routes:    []poolmanagertypes.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "bar"}},
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgCreatePositionFromSingleAsset{
+				PoolId:    1,
+				Sender:    invalidAddr.String(),
+				LowerTick: -10,
+				UpperTick: 10,
+				TokenIn:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid pool id",
+			msg: types.MsgCreatePositionFromSingleAsset{
+				PoolId:    0,
+				Sender:    addr1,
+				LowerTick: -10,
+				UpperTick: 10,
+				TokenIn:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: lower tick is not below upper tick",
+			msg: types.MsgCreatePositionFromSingleAsset{
+				PoolId:    1,
+				Sender:    addr1,
+				LowerTick: 10,
+				UpperTick: 10,
+				TokenIn:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: zero token in",
+			msg: types.MsgCreatePositionFromSingleAsset{
+				PoolId:    1,
+				Sender:    addr1,
+				LowerTick: -10,
+				UpperTick: 10,
+				TokenIn:   sdk.NewCoin("foo", sdk.ZeroInt()),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid route pool id",
+			msg: types.MsgCreatePositionFromSingleAsset{
+				PoolId:    1,
+				Sender:    addr1,
+				LowerTick: -10,
+				UpperTick: 10,
+				TokenIn:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+				Routes:    []poolmanagertypes.SwapAmountInRoute{{PoolId: 0, TokenOutDenom: "bar"}},
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: invalid route denom",
+			msg: types.MsgCreatePositionFromSingleAsset{
+				PoolId:    1,
+				Sender:    addr1,
+				LowerTick: -10,
+				UpperTick: 10,
+				TokenIn:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+				Routes:    []poolmanagertypes.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "1"}},
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: negative token min amount",
+			msg: types.MsgCreatePositionFromSingleAsset{
+				PoolId:          1,
+				Sender:          addr1,
+				LowerTick:       -10,
+				UpperTick:       10,
+				TokenIn:         sdk.NewCoin("foo", sdk.NewInt(1000)),
+				TokenMinAmount0: sdk.NewInt(-1),
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgCreatePositionFromSingleAsset)
+	}
+}
+
 func TestConcentratedLiquiditySerialization(t *testing.T) {
 	defaultPoolId := uint64(1)
 
@@ -348,6 +1195,124 @@ func TestConcentratedLiquiditySerialization(t *testing.T) {
 				PositionIds: []uint64{1, 2},
 			},
 		},
+		{
+			name: "MsgTransferPositions",
+			clMsg: &types.MsgTransferPositions{
+				Sender:      addr1,
+				NewOwner:    addr1,
+				PositionIds: []uint64{1, 2},
+			},
+		},
+		{
+			name: "MsgCompoundPosition",
+			clMsg: &types.MsgCompoundPosition{
+				PositionId:      1,
+				Sender:          addr1,
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.OneInt(),
+			},
+		},
+		{
+			name: "MsgSetPositionAutoCompound",
+			clMsg: &types.MsgSetPositionAutoCompound{
+				PositionId:   1,
+				Sender:       addr1,
+				AutoCompound: true,
+			},
+		},
+		{
+			name: "MsgSetPositionOperator",
+			clMsg: &types.MsgSetPositionOperator{
+				Sender:     addr1,
+				Operator:   addr1,
+				Approved:   true,
+				PositionId: 1,
+			},
+		},
+		{
+			name: "MsgRerangePosition",
+			clMsg: &types.MsgRerangePosition{
+				PositionId:      1,
+				Sender:          addr1,
+				LowerTick:       -10,
+				UpperTick:       10,
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.OneInt(),
+			},
+		},
+		{
+			name: "MsgIncreaseObservationCardinality",
+			clMsg: &types.MsgIncreaseObservationCardinality{
+				Sender:          addr1,
+				PoolId:          1,
+				CardinalityNext: 100,
+			},
+		},
+		{
+			name: "MsgSplitPosition",
+			clMsg: &types.MsgSplitPosition{
+				PositionId: 1,
+				Sender:     addr1,
+				Liquidity:  sdk.OneDec(),
+			},
+		},
+		{
+			name: "MsgMergePositions",
+			clMsg: &types.MsgMergePositions{
+				PositionIds: []uint64{1, 2},
+				Sender:      addr1,
+			},
+		},
+		{
+			name: "MsgCreateIncentive",
+			clMsg: &types.MsgCreateIncentive{
+				PoolId:        1,
+				Sender:        addr1,
+				IncentiveCoin: sdk.NewCoin("uion", sdk.NewInt(1000)),
+				EmissionRate:  sdk.OneDec(),
+				StartTime:     time.Unix(1, 0).UTC(),
+				MinUptime:     time.Nanosecond,
+			},
+		},
+		{
+			name: "MsgAddToIncentive",
+			clMsg: &types.MsgAddToIncentive{
+				PoolId:        1,
+				IncentiveId:   1,
+				Sender:        addr1,
+				IncentiveCoin: sdk.NewCoin("uion", sdk.NewInt(1000)),
+			},
+		},
+		{
+			name: "MsgReduceIncentiveEmissionRate",
+			clMsg: &types.MsgReduceIncentiveEmissionRate{
+				PoolId:       1,
+				IncentiveId:  1,
+				Sender:       addr1,
+				EmissionRate: sdk.OneDec(),
+			},
+		},
+		{
+			name: "MsgCancelIncentive",
+			clMsg: &types.MsgCancelIncentive{
+				PoolId:      1,
+				IncentiveId: 1,
+				Sender:      addr1,
+			},
+		},
+		{
+			name: "MsgCreatePositionFromSingleAsset",
+			clMsg: &types.MsgCreatePositionFromSingleAsset{
+				PoolId:          defaultPoolId,
+				Sender:          addr1,
+				LowerTick:       int64(10000),
+				UpperTick:       int64(20000),
+				TokenIn:         sdk.NewCoin("foo", sdk.NewInt(1000)),
+				Routes:          []poolmanagertypes.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "bar"}},
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.OneInt(),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,721 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/observation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Observation is a single entry of a pool's observation ring buffer. It is
+// written on the first swap of a block and whenever the liquidity in the
+// active range changes. The cumulative values only ever increase over time,
+// so the difference between two observations divided by the seconds elapsed
+// between them yields the time-weighted average over that period.
+type Observation struct {
+	// block_time is the time of the block in which the observation was written.
+	BlockTime time.Time `protobuf:"bytes,1,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time" yaml:"block_time"`
+	// tick_cumulative is the sum of the current tick multiplied by the seconds
+	// elapsed while it was the current tick, since the observations of the pool
+	// were initialized.
+	TickCumulative github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tick_cumulative,json=tickCumulative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_cumulative" yaml:"tick_cumulative"`
+	// seconds_per_liquidity_cumulative is the sum of the seconds elapsed divided
+	// by the active liquidity over that time, since the observations of the pool
+	// were initialized. Periods with no active liquidity are not accumulated.
+	SecondsPerLiquidityCumulative github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=seconds_per_liquidity_cumulative,json=secondsPerLiquidityCumulative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"seconds_per_liquidity_cumulative" yaml:"seconds_per_liquidity_cumulative"`
+	// initialized is false for slots that were allocated by increasing the
+	// cardinality but have not been written yet.
+	Initialized bool `protobuf:"varint,4,opt,name=initialized,proto3" json:"initialized,omitempty" yaml:"initialized"`
+}
+
+func (m *Observation) Reset()         { *m = Observation{} }
+func (m *Observation) String() string { return proto.CompactTextString(m) }
+func (*Observation) ProtoMessage()    {}
+func (*Observation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d3b195bf9c2f74e, []int{0}
+}
+func (m *Observation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Observation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Observation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Observation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Observation.Merge(m, src)
+}
+func (m *Observation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Observation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Observation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Observation proto.InternalMessageInfo
+
+func (m *Observation) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *Observation) GetInitialized() bool {
+	if m != nil {
+		return m.Initialized
+	}
+	return false
+}
+
+// ObservationState tracks the position of a pool's observation ring buffer.
+type ObservationState struct {
+	// index is the slot of the most recently written observation.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty" yaml:"index"`
+	// cardinality is the number of slots currently in use by the ring buffer.
+	Cardinality uint32 `protobuf:"varint,2,opt,name=cardinality,proto3" json:"cardinality,omitempty" yaml:"cardinality"`
+	// cardinality_next is the number of allocated slots. The ring buffer grows
+	// to this size once the last slot in use is written.
+	CardinalityNext uint32 `protobuf:"varint,3,opt,name=cardinality_next,json=cardinalityNext,proto3" json:"cardinality_next,omitempty" yaml:"cardinality_next"`
+}
+
+func (m *ObservationState) Reset()         { *m = ObservationState{} }
+func (m *ObservationState) String() string { return proto.CompactTextString(m) }
+func (*ObservationState) ProtoMessage()    {}
+func (*ObservationState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d3b195bf9c2f74e, []int{1}
+}
+func (m *ObservationState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObservationState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObservationState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObservationState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObservationState.Merge(m, src)
+}
+func (m *ObservationState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObservationState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObservationState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObservationState proto.InternalMessageInfo
+
+func (m *ObservationState) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ObservationState) GetCardinality() uint32 {
+	if m != nil {
+		return m.Cardinality
+	}
+	return 0
+}
+
+func (m *ObservationState) GetCardinalityNext() uint32 {
+	if m != nil {
+		return m.CardinalityNext
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Observation)(nil), "osmosis.concentratedliquidity.v1beta1.Observation")
+	proto.RegisterType((*ObservationState)(nil), "osmosis.concentratedliquidity.v1beta1.ObservationState")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/observation.proto", fileDescriptor_6d3b195bf9c2f74e)
+}
+
+var fileDescriptor_6d3b195bf9c2f74e = []byte{
+	// 492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xd1, 0x8a, 0xd3, 0x40,
+	0x14, 0xed, 0xb8, 0x2a, 0xee, 0xd4, 0xba, 0x35, 0xc8, 0x5a, 0x2a, 0x9b, 0x94, 0x01, 0xd7, 0xbe,
+	0x34, 0xb1, 0x0a, 0x22, 0x3e, 0x46, 0x11, 0x1f, 0x44, 0x25, 0xfa, 0xa0, 0x22, 0x84, 0xc9, 0x64,
+	0x8c, 0x43, 0x93, 0x4c, 0x37, 0x33, 0x29, 0xa9, 0x5f, 0xb1, 0xdf, 0xe0, 0x5f, 0x08, 0x7e, 0xc0,
+	0x3e, 0xee, 0xa3, 0xf8, 0x10, 0xa5, 0xfd, 0x83, 0x7c, 0x81, 0x64, 0xd2, 0x74, 0x07, 0x45, 0x64,
+	0x9f, 0x92, 0x7b, 0xef, 0x39, 0xe7, 0x9e, 0xcc, 0x99, 0xc0, 0xbb, 0x5c, 0x24, 0x5c, 0x30, 0xe1,
+	0x10, 0x9e, 0x12, 0x9a, 0xca, 0x0c, 0x4b, 0x1a, 0x4e, 0x62, 0x76, 0x94, 0xb3, 0x90, 0xc9, 0xa5,
+	0xc3, 0x03, 0x41, 0xb3, 0x05, 0x96, 0x8c, 0xa7, 0xf6, 0x3c, 0xe3, 0x92, 0x1b, 0xb7, 0x37, 0x0c,
+	0x5b, 0x67, 0x6c, 0x09, 0xf6, 0x62, 0x1a, 0x50, 0x89, 0xa7, 0xc3, 0x1b, 0x11, 0x8f, 0xb8, 0x62,
+	0x38, 0xf5, 0x5b, 0x43, 0x1e, 0x5a, 0x11, 0xe7, 0x51, 0x4c, 0x1d, 0x55, 0x05, 0xf9, 0x47, 0x47,
+	0xb2, 0x84, 0x0a, 0x89, 0x93, 0x79, 0x03, 0x40, 0x5f, 0x77, 0x60, 0xf7, 0xe5, 0xd9, 0x4e, 0xe3,
+	0x2d, 0x84, 0x41, 0xcc, 0xc9, 0xcc, 0xaf, 0x81, 0x03, 0x30, 0x02, 0xe3, 0xee, 0xbd, 0xa1, 0xdd,
+	0xa8, 0xd8, 0xad, 0x8a, 0xfd, 0xa6, 0x55, 0x71, 0x0f, 0x4e, 0x4a, 0xab, 0x53, 0x95, 0xd6, 0xf5,
+	0x25, 0x4e, 0xe2, 0x47, 0xe8, 0x8c, 0x8b, 0x8e, 0x7f, 0x5a, 0xc0, 0xdb, 0x55, 0x8d, 0x1a, 0x6e,
+	0x1c, 0xc1, 0x3d, 0xc9, 0xc8, 0xcc, 0x27, 0x79, 0x92, 0xc7, 0x58, 0xb2, 0x05, 0x1d, 0x5c, 0x18,
+	0x81, 0xf1, 0xae, 0xfb, 0xac, 0x96, 0xf8, 0x51, 0x5a, 0x87, 0x11, 0x93, 0x9f, 0xf2, 0xc0, 0x26,
+	0x3c, 0x71, 0x88, 0xfa, 0xe8, 0xcd, 0x63, 0x22, 0xc2, 0x99, 0x23, 0x97, 0x73, 0x2a, 0xec, 0x27,
+	0x94, 0x54, 0xa5, 0xb5, 0xdf, 0x2c, 0xfb, 0x43, 0x0e, 0x79, 0xd7, 0xea, 0xce, 0xe3, 0x6d, 0xc3,
+	0xf8, 0x02, 0xe0, 0x48, 0x50, 0xc2, 0xd3, 0x50, 0xf8, 0x73, 0x9a, 0xf9, 0xdb, 0x53, 0xd3, 0x4d,
+	0xec, 0x28, 0x13, 0xef, 0xce, 0x6d, 0xe2, 0x4e, 0x63, 0xe2, 0x7f, 0xfa, 0xc8, 0x3b, 0xd8, 0x40,
+	0x5e, 0xd1, 0xec, 0x79, 0x0b, 0xd0, 0x4c, 0x3e, 0x84, 0x5d, 0x96, 0x32, 0xc9, 0x70, 0xcc, 0x3e,
+	0xd3, 0x70, 0x70, 0x71, 0x04, 0xc6, 0x57, 0xdc, 0xfd, 0xaa, 0xb4, 0x8c, 0x66, 0x81, 0x36, 0x44,
+	0x9e, 0x0e, 0x45, 0xdf, 0x00, 0xec, 0x6b, 0xd9, 0xbd, 0x96, 0x58, 0x52, 0xe3, 0x10, 0x5e, 0x62,
+	0x69, 0x48, 0x0b, 0x95, 0x5d, 0xcf, 0xed, 0x57, 0xa5, 0x75, 0xb5, 0x15, 0x0a, 0x69, 0x81, 0xbc,
+	0x66, 0x5c, 0xaf, 0x25, 0x38, 0x0b, 0x59, 0x8a, 0x63, 0x26, 0x97, 0x2a, 0x8a, 0x9e, 0xbe, 0x56,
+	0x1b, 0x22, 0x4f, 0x87, 0x1a, 0x4f, 0x61, 0x5f, 0x2b, 0xfd, 0x94, 0x16, 0x52, 0x1d, 0x62, 0xcf,
+	0xbd, 0x55, 0x95, 0xd6, 0xcd, 0xbf, 0xe8, 0x0a, 0x81, 0xbc, 0x3d, 0xad, 0xf5, 0x82, 0x16, 0xd2,
+	0xfd, 0x70, 0xb2, 0x32, 0xc1, 0xe9, 0xca, 0x04, 0xbf, 0x56, 0x26, 0x38, 0x5e, 0x9b, 0x9d, 0xd3,
+	0xb5, 0xd9, 0xf9, 0xbe, 0x36, 0x3b, 0xef, 0x5d, 0x2d, 0x84, 0xcd, 0xed, 0x9f, 0xc4, 0x38, 0x10,
+	0x6d, 0xe1, 0x2c, 0xa6, 0x0f, 0x9c, 0xe2, 0x5f, 0xbf, 0x90, 0x0a, 0x29, 0xb8, 0xac, 0x2e, 0xeb,
+	0xfd, 0xdf, 0x03, 0x00, 0x88, 0x7d, 0x2e, 0xa1, 0x71, 0x03, 0x00, 0x00,
+}
+
+func (m *Observation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Observation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Observation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Initialized {
+		i--
+		if m.Initialized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.SecondsPerLiquidityCumulative.Size()
+		i -= size
+		if _, err := m.SecondsPerLiquidityCumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintObservation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TickCumulative.Size()
+		i -= size
+		if _, err := m.TickCumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintObservation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintObservation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ObservationState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObservationState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObservationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CardinalityNext != 0 {
+		i = encodeVarintObservation(dAtA, i, uint64(m.CardinalityNext))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Cardinality != 0 {
+		i = encodeVarintObservation(dAtA, i, uint64(m.Cardinality))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintObservation(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintObservation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovObservation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Observation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovObservation(uint64(l))
+	l = m.TickCumulative.Size()
+	n += 1 + l + sovObservation(uint64(l))
+	l = m.SecondsPerLiquidityCumulative.Size()
+	n += 1 + l + sovObservation(uint64(l))
+	if m.Initialized {
+		n += 2
+	}
+	return n
+}
+
+func (m *ObservationState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovObservation(uint64(m.Index))
+	}
+	if m.Cardinality != 0 {
+		n += 1 + sovObservation(uint64(m.Cardinality))
+	}
+	if m.CardinalityNext != 0 {
+		n += 1 + sovObservation(uint64(m.CardinalityNext))
+	}
+	return n
+}
+
+func sovObservation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozObservation(x uint64) (n int) {
+	return sovObservation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Observation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObservation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Observation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Observation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObservation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickCumulative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObservation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsPerLiquidityCumulative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObservation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecondsPerLiquidityCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initialized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Initialized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObservation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObservationState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObservation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObservationState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObservationState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cardinality", wireType)
+			}
+			m.Cardinality = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cardinality |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CardinalityNext", wireType)
+			}
+			m.CardinalityNext = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CardinalityNext |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObservation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipObservation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowObservation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthObservation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupObservation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthObservation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthObservation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowObservation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupObservation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -16,6 +16,7 @@ var (
 	KeyAuthorizedQuoteDenoms              = []byte("AuthorizedQuoteDenoms")
 	KeyAuthorizedUptimes                  = []byte("AuthorizedUptimes")
 	KeyIsPermisionlessPoolCreationEnabled = []byte("IsPermisionlessPoolCreationEnabled")
+	KeyMinIncentiveAmounts                = []byte("MinIncentiveAmounts")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -25,7 +26,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []sdk.Dec, discountRate sdk.Dec, authorizedQuoteDenoms []string, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool) Params {
+func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []sdk.Dec, discountRate sdk.Dec, authorizedQuoteDenoms []string, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, minIncentiveAmounts sdk.Coins) Params {
 	return Params{
 		AuthorizedTickSpacing:               authorizedTickSpacing,
 		AuthorizedSpreadFactors:             authorizedSpreadFactors,
@@ -33,6 +34,7 @@ func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []sdk.Dec
 		BalancerSharesRewardDiscount:        discountRate,
 		AuthorizedUptimes:                   authorizedUptimes,
 		IsPermissionlessPoolCreationEnabled: isPermissionlessPoolCreationEnabled,
+		MinIncentiveAmounts:                 minIncentiveAmounts,
 	}
 }
 
@@ -50,6 +52,7 @@ func DefaultParams() Params {
 		BalancerSharesRewardDiscount:        DefaultBalancerSharesDiscount,
 		AuthorizedUptimes:                   DefaultAuthorizedUptimes,
 		IsPermissionlessPoolCreationEnabled: false,
+		MinIncentiveAmounts:                 DefaultMinIncentiveAmounts,
 	}
 }
 
@@ -73,6 +76,9 @@ func (p Params) Validate() error {
 	if err := validateAuthorizedUptimes(p.AuthorizedUptimes); err != nil {
 		return err
 	}
+	if err := validateMinIncentiveAmounts(p.MinIncentiveAmounts); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -85,6 +91,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyIsPermisionlessPoolCreationEnabled, &p.IsPermissionlessPoolCreationEnabled, validateIsPermissionLessPoolCreationEnabled),
 		paramtypes.NewParamSetPair(KeyDiscountRate, &p.BalancerSharesRewardDiscount, validateBalancerSharesDiscount),
 		paramtypes.NewParamSetPair(KeyAuthorizedUptimes, &p.AuthorizedUptimes, validateAuthorizedUptimes),
+		paramtypes.NewParamSetPair(KeyMinIncentiveAmounts, &p.MinIncentiveAmounts, validateMinIncentiveAmounts),
 	}
 }
 
@@ -233,3 +240,21 @@ func validateAuthorizedUptimes(i interface{}) error {
 
 	return nil
 }
+
+// validateMinIncentiveAmounts validates the minimum amounts of incentive records created directly by an account.
+//
+// Parameters:
+// - i: The parameter to validate.
+//
+// Returns:
+// - An error if given type is not sdk.Coins.
+// - An error if the coins are not sorted, contain duplicate or invalid denoms, or non-positive amounts.
+func validateMinIncentiveAmounts(i interface{}) error {
+	minIncentiveAmounts, ok := i.(sdk.Coins)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return minIncentiveAmounts.Validate()
+}
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
@@ -56,6 +57,12 @@ type Params struct {
 	// allowing permissionless pool creation by switching this flag to true
 	// with a governance proposal.
 	IsPermissionlessPoolCreationEnabled bool `protobuf:"varint,6,opt,name=is_permissionless_pool_creation_enabled,json=isPermissionlessPoolCreationEnabled,proto3" json:"is_permissionless_pool_creation_enabled,omitempty" yaml:"is_permissionless_pool_creation_enabled"`
+	// min_incentive_amounts are the denoms that incentive records created
+	// directly by an account via MsgCreateIncentive can be denominated in,
+	// along with the minimum amount of each. Expressing the minimum per denom
+	// accounts for the decimals and value of each denom. Incentive records
+	// created from x/incentives gauges are not subject to these minimums.
+	MinIncentiveAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=min_incentive_amounts,json=minIncentiveAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_incentive_amounts" yaml:"min_incentive_amounts"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -119,6 +126,13 @@ func (m *Params) GetIsPermissionlessPoolCreationEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMinIncentiveAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinIncentiveAmounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.concentratedliquidity.Params")
 }
@@ -128,42 +142,47 @@ func init() {
 }
 
 var fileDescriptor_cd3784445b6f6ba7 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x52, 0x22, 0x6a, 0x26, 0x2c, 0x10, 0x4e, 0x05, 0xb6, 0x65, 0xa4, 0x12, 0x09,
-	0x6a, 0x8b, 0x22, 0x31, 0xc0, 0x66, 0x02, 0x73, 0x71, 0x40, 0x42, 0x15, 0xd2, 0xe9, 0x7c, 0xbe,
-	0x3a, 0xa7, 0xd8, 0x7e, 0xee, 0xdd, 0x19, 0x08, 0x3b, 0x88, 0x09, 0x31, 0xb2, 0xf1, 0x75, 0x3a,
-	0x76, 0x44, 0x0c, 0x06, 0x25, 0x1b, 0x63, 0x3e, 0x01, 0xca, 0xd9, 0xa1, 0x0e, 0xa5, 0x02, 0x26,
-	0xfb, 0xbd, 0xff, 0xef, 0xbd, 0x7b, 0xf7, 0xde, 0x3b, 0xfd, 0x16, 0x88, 0x0c, 0x04, 0x13, 0x3e,
-	0x81, 0x9c, 0xd0, 0x5c, 0x72, 0x2c, 0x69, 0xbc, 0x93, 0xb2, 0xc3, 0x92, 0xc5, 0x4c, 0x4e, 0xfd,
-	0x02, 0x73, 0x9c, 0x09, 0xaf, 0xe0, 0x20, 0xc1, 0xb8, 0xde, 0xc0, 0x5e, 0x1b, 0xfe, 0xc5, 0x6e,
-	0x5d, 0x4e, 0x20, 0x01, 0x45, 0xfa, 0xcb, 0xbf, 0x3a, 0x68, 0xab, 0x4f, 0x54, 0x14, 0xaa, 0x85,
-	0xda, 0x68, 0x24, 0x2b, 0x01, 0x48, 0x52, 0xea, 0x2b, 0x2b, 0x2a, 0x0f, 0xfc, 0xb8, 0xe4, 0x58,
-	0x32, 0xc8, 0x6b, 0xdd, 0x7d, 0xd7, 0xd3, 0x7b, 0x7b, 0xaa, 0x00, 0x63, 0x5f, 0xbf, 0x8a, 0x4b,
-	0x39, 0x06, 0xce, 0xde, 0xd0, 0x18, 0x49, 0x46, 0x26, 0x48, 0x14, 0x98, 0xb0, 0x3c, 0x31, 0x35,
-	0xa7, 0x3b, 0xd8, 0x08, 0xdc, 0x45, 0x65, 0x5b, 0x53, 0x9c, 0xa5, 0xf7, 0xdd, 0x33, 0x40, 0x37,
-	0xbc, 0x72, 0xa2, 0x3c, 0x65, 0x64, 0x32, 0xaa, 0xfd, 0xc6, 0x07, 0x4d, 0xef, 0xb7, 0x62, 0x44,
-	0xc1, 0x29, 0x8e, 0xd1, 0x01, 0x26, 0x12, 0xb8, 0x30, 0xcf, 0x39, 0xdd, 0xc1, 0x66, 0x10, 0x1e,
-	0x55, 0x76, 0xe7, 0x6b, 0x65, 0x6f, 0x27, 0x4c, 0x8e, 0xcb, 0xc8, 0x23, 0x90, 0x35, 0x77, 0x69,
-	0x3e, 0x3b, 0x22, 0x9e, 0xf8, 0x72, 0x5a, 0x50, 0xe1, 0x0d, 0x29, 0x59, 0x54, 0xb6, 0x73, 0xaa,
-	0x98, 0xf5, 0xc4, 0x6e, 0xd8, 0xba, 0xd1, 0x48, 0x49, 0x8f, 0x6b, 0xc5, 0xf8, 0xac, 0xe9, 0x76,
-	0x84, 0x53, 0x9c, 0x13, 0xca, 0x91, 0x18, 0x63, 0x4e, 0x05, 0xe2, 0xf4, 0x15, 0xe6, 0x31, 0x8a,
-	0x99, 0x20, 0x50, 0xe6, 0xd2, 0xec, 0x3a, 0xda, 0x60, 0x33, 0x78, 0xfe, 0xdf, 0x65, 0x6d, 0xd7,
-	0x65, 0xfd, 0x25, 0xbd, 0x1b, 0x5e, 0x5b, 0x11, 0x23, 0x05, 0x84, 0x4a, 0x1f, 0x36, 0xf2, 0x6f,
-	0xe3, 0x38, 0x2c, 0x41, 0x52, 0x14, 0xd3, 0x1c, 0x32, 0x61, 0x6e, 0xa8, 0x7e, 0xfd, 0x79, 0x1c,
-	0x6d, 0x70, 0x6d, 0x1c, 0x4f, 0x96, 0xc2, 0x50, 0xf9, 0x8d, 0xb7, 0x9a, 0x6e, 0xb4, 0x62, 0xca,
-	0x42, 0xb2, 0x8c, 0x0a, 0xf3, 0xbc, 0xd3, 0x1d, 0x5c, 0xdc, 0xed, 0x7b, 0xf5, 0xce, 0x78, 0xab,
-	0x9d, 0xf1, 0x86, 0xcd, 0xce, 0x04, 0x0f, 0x96, 0xbd, 0xf8, 0x51, 0xd9, 0xc6, 0x6a, 0x8b, 0x6e,
-	0x43, 0xc6, 0x24, 0xcd, 0x0a, 0x39, 0x5d, 0x54, 0x76, 0xff, 0x54, 0x31, 0x4d, 0x62, 0xf7, 0xd3,
-	0x37, 0x5b, 0x0b, 0x2f, 0x9d, 0x08, 0xcf, 0x6a, 0xbf, 0xf1, 0x5e, 0xd3, 0x6f, 0x32, 0x81, 0x0a,
-	0xca, 0x33, 0x26, 0x04, 0x83, 0x3c, 0xa5, 0x42, 0xa0, 0x02, 0x20, 0x45, 0x84, 0x53, 0x75, 0x02,
-	0xa2, 0x39, 0x8e, 0x52, 0x1a, 0x9b, 0x3d, 0x47, 0x1b, 0x5c, 0x08, 0x76, 0x17, 0x95, 0xed, 0xd5,
-	0xe7, 0xfc, 0x63, 0xa0, 0x1b, 0xde, 0x60, 0x62, 0x6f, 0x0d, 0xdc, 0x03, 0x48, 0x1f, 0x36, 0xd8,
-	0xa3, 0x9a, 0x0a, 0x5e, 0x1c, 0xcd, 0x2c, 0xed, 0x78, 0x66, 0x69, 0xdf, 0x67, 0x96, 0xf6, 0x71,
-	0x6e, 0x75, 0x8e, 0xe7, 0x56, 0xe7, 0xcb, 0xdc, 0xea, 0xec, 0x07, 0xad, 0xc1, 0x37, 0xaf, 0x73,
-	0x27, 0xc5, 0x91, 0x58, 0x19, 0xfe, 0xcb, 0x3b, 0xf7, 0xfc, 0xd7, 0x67, 0xbd, 0x6e, 0xb5, 0x18,
-	0x51, 0x4f, 0xf5, 0xf2, 0xee, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x88, 0x11, 0x1a, 0x0c,
-	0x04, 0x00, 0x00,
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xbf, 0x6f, 0xd4, 0x30,
+	0x14, 0xc7, 0x2f, 0x5c, 0x69, 0x69, 0x98, 0x08, 0x54, 0xe4, 0xaa, 0x92, 0x9c, 0x82, 0x54, 0x4e,
+	0x82, 0x26, 0x6a, 0x91, 0x18, 0x60, 0x22, 0x3d, 0x90, 0xd8, 0x8e, 0x14, 0x24, 0x54, 0x21, 0x59,
+	0x8e, 0xe3, 0x5e, 0xad, 0x26, 0x71, 0x6a, 0x3b, 0x85, 0x63, 0x47, 0x62, 0x42, 0x8c, 0x6c, 0xac,
+	0x88, 0xbf, 0xa4, 0x63, 0x47, 0xc4, 0x90, 0xa2, 0x76, 0x63, 0xbc, 0xbf, 0x00, 0xc5, 0xf6, 0xd1,
+	0x1c, 0x6d, 0x45, 0x99, 0x12, 0xfb, 0xfb, 0x79, 0x7e, 0xcf, 0xef, 0x87, 0xcd, 0xbb, 0x94, 0x67,
+	0x94, 0x13, 0x1e, 0x20, 0x9a, 0x23, 0x9c, 0x0b, 0x06, 0x05, 0x4e, 0x56, 0x52, 0xb2, 0x5b, 0x92,
+	0x84, 0x88, 0x51, 0x50, 0x40, 0x06, 0x33, 0xee, 0x17, 0x8c, 0x0a, 0x6a, 0xdd, 0xd2, 0xb0, 0xdf,
+	0x84, 0xff, 0xb0, 0x8b, 0x37, 0x86, 0x74, 0x48, 0x25, 0x19, 0xd4, 0x7f, 0xca, 0x68, 0xb1, 0x83,
+	0xa4, 0x15, 0x50, 0x82, 0x5a, 0x68, 0xc9, 0x19, 0x52, 0x3a, 0x4c, 0x71, 0x20, 0x57, 0x71, 0xb9,
+	0x15, 0x24, 0x25, 0x83, 0x82, 0xd0, 0x7c, 0xa2, 0x2b, 0x3a, 0x88, 0x21, 0xc7, 0xc1, 0xde, 0x6a,
+	0x8c, 0x05, 0x5c, 0x0d, 0x10, 0x25, 0x5a, 0xf7, 0xbe, 0xce, 0x99, 0xb3, 0x03, 0x19, 0xa0, 0xb5,
+	0x69, 0xde, 0x84, 0xa5, 0xd8, 0xa6, 0x8c, 0xbc, 0xc3, 0x09, 0x10, 0x04, 0xed, 0x00, 0x5e, 0x40,
+	0x44, 0xf2, 0xa1, 0x6d, 0x74, 0xdb, 0xbd, 0x99, 0xd0, 0x1b, 0x57, 0xae, 0x33, 0x82, 0x59, 0xfa,
+	0xd0, 0x3b, 0x07, 0xf4, 0xa2, 0x85, 0x13, 0xe5, 0x05, 0x41, 0x3b, 0x1b, 0x6a, 0xdf, 0xfa, 0x68,
+	0x98, 0x9d, 0x86, 0x0d, 0x2f, 0x18, 0x86, 0x09, 0xd8, 0x82, 0x48, 0x50, 0xc6, 0xed, 0x4b, 0xdd,
+	0x76, 0x6f, 0x3e, 0x8c, 0xf6, 0x2b, 0xb7, 0xf5, 0xa3, 0x72, 0x97, 0x87, 0x44, 0x6c, 0x97, 0xb1,
+	0x8f, 0x68, 0xa6, 0xef, 0xaa, 0x3f, 0x2b, 0x3c, 0xd9, 0x09, 0xc4, 0xa8, 0xc0, 0xdc, 0xef, 0x63,
+	0x34, 0xae, 0xdc, 0xee, 0xa9, 0x60, 0xa6, 0x0f, 0xf6, 0xa2, 0xc6, 0x8d, 0x36, 0xa4, 0xf4, 0x54,
+	0x29, 0xd6, 0x17, 0xc3, 0x74, 0x63, 0x98, 0xc2, 0x1c, 0x61, 0x06, 0xf8, 0x36, 0x64, 0x98, 0x03,
+	0x86, 0xdf, 0x40, 0x96, 0x80, 0x84, 0x70, 0x44, 0xcb, 0x5c, 0xd8, 0xed, 0xae, 0xd1, 0x9b, 0x0f,
+	0x5f, 0xfd, 0x77, 0x58, 0xcb, 0x2a, 0xac, 0x7f, 0x1c, 0xef, 0x45, 0x4b, 0x13, 0x62, 0x43, 0x02,
+	0x91, 0xd4, 0xfb, 0x5a, 0xfe, 0xab, 0x1c, 0xbb, 0x25, 0x15, 0x18, 0x24, 0x38, 0xa7, 0x19, 0xb7,
+	0x67, 0x64, 0xbe, 0xce, 0x2e, 0x47, 0x13, 0x9c, 0x2a, 0xc7, 0xf3, 0x5a, 0xe8, 0xcb, 0x7d, 0xeb,
+	0xbd, 0x61, 0x5a, 0x0d, 0x9b, 0xb2, 0x10, 0x24, 0xc3, 0xdc, 0xbe, 0xdc, 0x6d, 0xf7, 0xae, 0xae,
+	0x75, 0x7c, 0xd5, 0x53, 0xfe, 0xa4, 0xa7, 0xfc, 0xbe, 0xee, 0xa9, 0xf0, 0x51, 0x9d, 0x8b, 0x5f,
+	0x95, 0x6b, 0x4d, 0xba, 0xec, 0x1e, 0xcd, 0x88, 0xc0, 0x59, 0x21, 0x46, 0xe3, 0xca, 0xed, 0x9c,
+	0x0a, 0x46, 0x1f, 0xec, 0x7d, 0x3e, 0x74, 0x8d, 0xe8, 0xda, 0x89, 0xf0, 0x52, 0xed, 0x5b, 0x1f,
+	0x0c, 0xf3, 0x0e, 0xe1, 0xa0, 0xc0, 0x2c, 0x23, 0x9c, 0x13, 0x9a, 0xa7, 0x98, 0x73, 0x50, 0x50,
+	0x9a, 0x02, 0xc4, 0xb0, 0xf4, 0x00, 0x70, 0x0e, 0xe3, 0x14, 0x27, 0xf6, 0x6c, 0xd7, 0xe8, 0x5d,
+	0x09, 0xd7, 0xc6, 0x95, 0xeb, 0x2b, 0x3f, 0x17, 0x34, 0xf4, 0xa2, 0xdb, 0x84, 0x0f, 0xa6, 0xc0,
+	0x01, 0xa5, 0xe9, 0xba, 0xc6, 0x9e, 0x28, 0xaa, 0x6e, 0x88, 0x85, 0x8c, 0xe4, 0x80, 0xc8, 0xb1,
+	0x24, 0x7b, 0x18, 0xc0, 0xac, 0xae, 0x03, 0xb7, 0xe7, 0x74, 0x56, 0xf4, 0xdc, 0xd5, 0x93, 0xe4,
+	0xeb, 0x49, 0xf2, 0xd7, 0x29, 0xc9, 0xc3, 0xc1, 0xb7, 0x43, 0xb7, 0x77, 0x81, 0xee, 0xa8, 0x61,
+	0x3e, 0xae, 0xdc, 0x25, 0x15, 0xff, 0x99, 0xde, 0xbc, 0x3a, 0xc3, 0xd1, 0xf5, 0x8c, 0xe4, 0xcf,
+	0x26, 0xd2, 0x63, 0xa5, 0x84, 0xaf, 0x37, 0xc3, 0x86, 0x13, 0xfd, 0x8e, 0xac, 0xa4, 0x30, 0xe6,
+	0x93, 0x45, 0xb0, 0xb7, 0xfa, 0x20, 0x78, 0x7b, 0xde, 0x3b, 0x24, 0x83, 0xd8, 0x3f, 0x72, 0x8c,
+	0x83, 0x23, 0xc7, 0xf8, 0x79, 0xe4, 0x18, 0x9f, 0x8e, 0x9d, 0xd6, 0xc1, 0xb1, 0xd3, 0xfa, 0x7e,
+	0xec, 0xb4, 0xe2, 0x59, 0x59, 0xed, 0xfb, 0xbf, 0x07, 0x00, 0xf7, 0xe3, 0x60, 0xe2, 0xce, 0x04,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -186,6 +205,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinIncentiveAmounts) > 0 {
+		for iNdEx := len(m.MinIncentiveAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinIncentiveAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.IsPermissionlessPoolCreationEnabled {
 		i--
 		if m.IsPermissionlessPoolCreationEnabled {
@@ -309,6 +342,12 @@ func (m *Params) Size() (n int) {
 	if m.IsPermissionlessPoolCreationEnabled {
 		n += 2
 	}
+	if len(m.MinIncentiveAmounts) > 0 {
+		for _, e := range m.MinIncentiveAmounts {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -579,6 +618,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsPermissionlessPoolCreationEnabled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIncentiveAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinIncentiveAmounts = append(m.MinIncentiveAmounts, types.Coin{})
+			if err := m.MinIncentiveAmounts[len(m.MinIncentiveAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
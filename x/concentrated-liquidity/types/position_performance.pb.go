@@ -0,0 +1,750 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/position_performance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PositionPerformance tracks the lifetime token flows of a position. When a
+// position is replaced by new positions (e.g. by adding to it, reranging,
+// splitting or merging), the new positions inherit the performance of the
+// positions they replace. Tokens moved between the replaced and the new
+// positions count as both withdrawn and deposited, so the net deposits always
+// equal deposited minus withdrawn.
+type PositionPerformance struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	// created_at is the time at which the position, or the earliest position it
+	// replaced, was created.
+	CreatedAt time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at" yaml:"created_at"`
+	// deposited is the cumulative amount of tokens deposited into the position.
+	Deposited github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=deposited,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposited" yaml:"deposited"`
+	// withdrawn is the cumulative amount of tokens withdrawn from the position.
+	Withdrawn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=withdrawn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn" yaml:"withdrawn"`
+	// spread_rewards_collected is the cumulative amount of spread rewards
+	// collected by the position.
+	SpreadRewardsCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spread_rewards_collected,json=spreadRewardsCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spread_rewards_collected" yaml:"spread_rewards_collected"`
+	// incentives_collected is the cumulative amount of incentives collected by
+	// the position. Forfeited incentives are not included.
+	IncentivesCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=incentives_collected,json=incentivesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"incentives_collected" yaml:"incentives_collected"`
+	// last_deposit_time is the time of the last deposit into the position.
+	LastDepositTime time.Time `protobuf:"bytes,7,opt,name=last_deposit_time,json=lastDepositTime,proto3,stdtime" json:"last_deposit_time" yaml:"last_deposit_time"`
+	// last_withdrawal_time is the time of the last withdrawal from the position.
+	// It is the zero time if nothing was ever withdrawn.
+	LastWithdrawalTime time.Time `protobuf:"bytes,8,opt,name=last_withdrawal_time,json=lastWithdrawalTime,proto3,stdtime" json:"last_withdrawal_time" yaml:"last_withdrawal_time"`
+}
+
+func (m *PositionPerformance) Reset()         { *m = PositionPerformance{} }
+func (m *PositionPerformance) String() string { return proto.CompactTextString(m) }
+func (*PositionPerformance) ProtoMessage()    {}
+func (*PositionPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7925cb002ae28ef0, []int{0}
+}
+func (m *PositionPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionPerformance.Merge(m, src)
+}
+func (m *PositionPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionPerformance proto.InternalMessageInfo
+
+func (m *PositionPerformance) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *PositionPerformance) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *PositionPerformance) GetDeposited() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposited
+	}
+	return nil
+}
+
+func (m *PositionPerformance) GetWithdrawn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return nil
+}
+
+func (m *PositionPerformance) GetSpreadRewardsCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpreadRewardsCollected
+	}
+	return nil
+}
+
+func (m *PositionPerformance) GetIncentivesCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.IncentivesCollected
+	}
+	return nil
+}
+
+func (m *PositionPerformance) GetLastDepositTime() time.Time {
+	if m != nil {
+		return m.LastDepositTime
+	}
+	return time.Time{}
+}
+
+func (m *PositionPerformance) GetLastWithdrawalTime() time.Time {
+	if m != nil {
+		return m.LastWithdrawalTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*PositionPerformance)(nil), "osmosis.concentratedliquidity.v1beta1.PositionPerformance")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/position_performance.proto", fileDescriptor_7925cb002ae28ef0)
+}
+
+var fileDescriptor_7925cb002ae28ef0 = []byte{
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x1b, 0x36, 0x36, 0xe6, 0x1e, 0x60, 0x59, 0x35, 0x85, 0x22, 0x92, 0xaa, 0x02, 0xd1,
+	0x4b, 0x6d, 0x75, 0x48, 0x20, 0xb8, 0xd1, 0xed, 0xc2, 0x89, 0xa9, 0x20, 0x81, 0x10, 0x52, 0xe4,
+	0xc4, 0x5e, 0x67, 0x91, 0xc4, 0x21, 0x76, 0x5b, 0x7a, 0xe0, 0x1d, 0xf6, 0x02, 0xdc, 0x11, 0x4f,
+	0xb2, 0xe3, 0x4e, 0x88, 0x53, 0x87, 0xda, 0x37, 0xd8, 0x13, 0x20, 0xc7, 0x4e, 0x52, 0xc4, 0xa6,
+	0xaa, 0xa7, 0xd6, 0xfe, 0xfb, 0xfb, 0xbe, 0x9f, 0xff, 0x76, 0x0c, 0x5e, 0x70, 0x11, 0x73, 0xc1,
+	0x04, 0x0a, 0x79, 0x12, 0xd2, 0x44, 0x66, 0x58, 0x52, 0xd2, 0x8d, 0xd8, 0x97, 0x11, 0x23, 0x4c,
+	0x4e, 0x51, 0xca, 0x05, 0x93, 0x8c, 0x27, 0x7e, 0x4a, 0xb3, 0x13, 0x9e, 0xc5, 0x38, 0x09, 0x29,
+	0x4c, 0x33, 0x2e, 0xb9, 0xfd, 0xd8, 0x48, 0xe1, 0xb2, 0xb4, 0x54, 0xc2, 0x71, 0x2f, 0xa0, 0x12,
+	0xf7, 0x9a, 0x8d, 0x21, 0x1f, 0xf2, 0x5c, 0x81, 0xd4, 0x3f, 0x2d, 0x6e, 0x7a, 0x43, 0xce, 0x87,
+	0x11, 0x45, 0xf9, 0x28, 0x18, 0x9d, 0x20, 0xc9, 0x62, 0x2a, 0x24, 0x8e, 0x53, 0xb3, 0xc0, 0x0d,
+	0x73, 0x7b, 0x14, 0x60, 0x41, 0x91, 0xf1, 0x42, 0x21, 0x67, 0x89, 0xae, 0xb7, 0x7f, 0x6d, 0x83,
+	0xbd, 0x63, 0x03, 0x77, 0x5c, 0xb1, 0xd9, 0xcf, 0x41, 0xbd, 0x64, 0x66, 0xc4, 0xb1, 0x5a, 0x56,
+	0x67, 0xb3, 0xbf, 0x7f, 0x35, 0xf3, 0xec, 0x29, 0x8e, 0xa3, 0x97, 0xed, 0xa5, 0x62, 0x7b, 0x00,
+	0x8a, 0xd1, 0x6b, 0x62, 0x7f, 0x00, 0x20, 0xcc, 0xa8, 0xda, 0x83, 0x8f, 0xa5, 0x73, 0xab, 0x65,
+	0x75, 0xea, 0x07, 0x4d, 0xa8, 0x31, 0x61, 0x81, 0x09, 0xdf, 0x15, 0x98, 0xfd, 0x87, 0xe7, 0x33,
+	0xaf, 0x76, 0x35, 0xf3, 0x76, 0xb5, 0x6f, 0xa5, 0x6d, 0x9f, 0x5d, 0x7a, 0xd6, 0x60, 0xc7, 0x4c,
+	0xbc, 0x92, 0xf6, 0x37, 0xb0, 0x43, 0x68, 0x9e, 0x44, 0x89, 0xb3, 0xd1, 0xda, 0xe8, 0xd4, 0x0f,
+	0xee, 0x43, 0xbd, 0x3d, 0xa8, 0xb6, 0x57, 0xb4, 0x0a, 0x1e, 0x72, 0x96, 0xf4, 0x8f, 0x8c, 0xef,
+	0x3d, 0xed, 0x5b, 0x2a, 0xdb, 0x3f, 0x2f, 0xbd, 0xce, 0x90, 0xc9, 0xd3, 0x51, 0x00, 0x43, 0x1e,
+	0x23, 0xd3, 0x1f, 0xfd, 0xd3, 0x15, 0xe4, 0x33, 0x92, 0xd3, 0x94, 0x8a, 0xdc, 0x44, 0x0c, 0xaa,
+	0x44, 0x15, 0x3f, 0x61, 0xf2, 0x94, 0x64, 0x78, 0x92, 0x38, 0x9b, 0x6b, 0xc6, 0x97, 0xca, 0x35,
+	0xe3, 0x4b, 0x9d, 0xfd, 0xc3, 0x02, 0x8e, 0x48, 0x33, 0x8a, 0x89, 0x9f, 0xd1, 0x09, 0xce, 0x88,
+	0xf0, 0x43, 0x1e, 0x45, 0x34, 0x54, 0xdd, 0xb8, 0xbd, 0x0a, 0xe7, 0xad, 0xc1, 0xf1, 0x34, 0xce,
+	0x4d, 0x46, 0xeb, 0xd1, 0xed, 0x6b, 0x9b, 0x81, 0x76, 0x39, 0x2c, 0x4c, 0xec, 0xef, 0x16, 0x68,
+	0xb0, 0xfc, 0x2e, 0xb3, 0x31, 0x5d, 0xc6, 0xdc, 0x5a, 0x85, 0xf9, 0xc6, 0x60, 0x3e, 0xd0, 0x98,
+	0xd7, 0x99, 0xac, 0x87, 0xb8, 0x57, 0x59, 0x54, 0x7c, 0x11, 0xd8, 0x8d, 0xb0, 0x90, 0xbe, 0x39,
+	0x5b, 0x5f, 0x7d, 0x33, 0xce, 0xf6, 0xca, 0x9b, 0xfa, 0xc8, 0xc0, 0x39, 0x1a, 0xee, 0x3f, 0x0b,
+	0x7d, 0x61, 0xef, 0xaa, 0xf9, 0x23, 0x3d, 0xad, 0xb4, 0xf6, 0x08, 0x34, 0xf2, 0xa5, 0xc5, 0x51,
+	0xe2, 0x48, 0x07, 0xde, 0x59, 0x19, 0xf8, 0xe4, 0xdf, 0x6e, 0x5c, 0xe7, 0xa2, 0x33, 0x6d, 0x55,
+	0x7a, 0x5f, 0x56, 0x94, 0x43, 0xff, 0xd3, 0xf9, 0xdc, 0xb5, 0x2e, 0xe6, 0xae, 0xf5, 0x67, 0xee,
+	0x5a, 0x67, 0x0b, 0xb7, 0x76, 0xb1, 0x70, 0x6b, 0xbf, 0x17, 0x6e, 0xed, 0x63, 0x7f, 0xa9, 0x7b,
+	0xe6, 0xed, 0xe9, 0x46, 0x38, 0x10, 0xc5, 0x00, 0x8d, 0x7b, 0xcf, 0xd0, 0xd7, 0x9b, 0x5e, 0xb2,
+	0xbc, 0xbb, 0xc1, 0x56, 0x8e, 0xfb, 0xf4, 0xef, 0x00, 0x14, 0x2f, 0x8b, 0x0d, 0xf8, 0x04, 0x00,
+	0x00,
+}
+
+func (m *PositionPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastWithdrawalTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastWithdrawalTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPositionPerformance(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastDepositTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastDepositTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPositionPerformance(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.IncentivesCollected) > 0 {
+		for iNdEx := len(m.IncentivesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPositionPerformance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SpreadRewardsCollected) > 0 {
+		for iNdEx := len(m.SpreadRewardsCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadRewardsCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPositionPerformance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Withdrawn) > 0 {
+		for iNdEx := len(m.Withdrawn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPositionPerformance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Deposited) > 0 {
+		for iNdEx := len(m.Deposited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposited[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPositionPerformance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintPositionPerformance(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.PositionId != 0 {
+		i = encodeVarintPositionPerformance(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPositionPerformance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPositionPerformance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PositionPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovPositionPerformance(uint64(m.PositionId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovPositionPerformance(uint64(l))
+	if len(m.Deposited) > 0 {
+		for _, e := range m.Deposited {
+			l = e.Size()
+			n += 1 + l + sovPositionPerformance(uint64(l))
+		}
+	}
+	if len(m.Withdrawn) > 0 {
+		for _, e := range m.Withdrawn {
+			l = e.Size()
+			n += 1 + l + sovPositionPerformance(uint64(l))
+		}
+	}
+	if len(m.SpreadRewardsCollected) > 0 {
+		for _, e := range m.SpreadRewardsCollected {
+			l = e.Size()
+			n += 1 + l + sovPositionPerformance(uint64(l))
+		}
+	}
+	if len(m.IncentivesCollected) > 0 {
+		for _, e := range m.IncentivesCollected {
+			l = e.Size()
+			n += 1 + l + sovPositionPerformance(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastDepositTime)
+	n += 1 + l + sovPositionPerformance(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastWithdrawalTime)
+	n += 1 + l + sovPositionPerformance(uint64(l))
+	return n
+}
+
+func sovPositionPerformance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPositionPerformance(x uint64) (n int) {
+	return sovPositionPerformance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PositionPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPositionPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposited", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposited = append(m.Deposited, types1.Coin{})
+			if err := m.Deposited[len(m.Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawn = append(m.Withdrawn, types1.Coin{})
+			if err := m.Withdrawn[len(m.Withdrawn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardsCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpreadRewardsCollected = append(m.SpreadRewardsCollected, types1.Coin{})
+			if err := m.SpreadRewardsCollected[len(m.SpreadRewardsCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivesCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivesCollected = append(m.IncentivesCollected, types1.Coin{})
+			if err := m.IncentivesCollected[len(m.IncentivesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDepositTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastDepositTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWithdrawalTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastWithdrawalTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPositionPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPositionPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPositionPerformance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPositionPerformance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPositionPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPositionPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPositionPerformance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPositionPerformance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPositionPerformance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPositionPerformance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPositionPerformance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPositionPerformance = fmt.Errorf("proto: unexpected end of group")
+)
@@ -303,6 +303,60 @@ func TestNewExitSwapShareAmountInCmd(t *testing.T) {
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestNewJoinPoolWithAnyTokenCmd(t *testing.T) {
+	desc, _ := cli.NewJoinPoolWithAnyTokenCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgJoinPoolWithAnyToken]{
+		"with routes": {
+			Cmd: "10stake 1 --pool-id=1 --swap-route-pool-ids=2,3 --swap-route-denoms=node0token,uosmo --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgJoinPoolWithAnyToken{
+				Sender:            testAddresses[0].String(),
+				PoolId:            1,
+				TokenIn:           sdk.NewInt64Coin("stake", 10),
+				Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "node0token"}, {PoolId: 3, TokenOutDenom: "uosmo"}},
+				ShareOutMinAmount: sdk.NewIntFromUint64(1),
+			},
+		},
+		"without routes": {
+			Cmd: "10stake 1 --pool-id=1 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgJoinPoolWithAnyToken{
+				Sender:            testAddresses[0].String(),
+				PoolId:            1,
+				TokenIn:           sdk.NewInt64Coin("stake", 10),
+				ShareOutMinAmount: sdk.NewIntFromUint64(1),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewExitPoolToSingleTokenCmd(t *testing.T) {
+	desc, _ := cli.NewExitPoolToSingleTokenCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgExitPoolToSingleToken]{
+		"with routes": {
+			Cmd: "10 stake 1 --pool-id=1 --swap-route-pool-ids=2 --swap-route-denoms=node0token --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgExitPoolToSingleToken{
+				Sender:            testAddresses[0].String(),
+				PoolId:            1,
+				ShareInAmount:     sdk.NewIntFromUint64(10),
+				PoolAssetDenom:    "stake",
+				Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "node0token"}},
+				TokenOutMinAmount: sdk.NewIntFromUint64(1),
+			},
+		},
+		"without routes": {
+			Cmd: "10 stake 1 --pool-id=1 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgExitPoolToSingleToken{
+				Sender:            testAddresses[0].String(),
+				PoolId:            1,
+				ShareInAmount:     sdk.NewIntFromUint64(10),
+				PoolAssetDenom:    "stake",
+				TokenOutMinAmount: sdk.NewIntFromUint64(1),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestGetCmdPools(t *testing.T) {
 	desc, _ := cli.GetCmdPools()
 	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryPoolsRequest]{
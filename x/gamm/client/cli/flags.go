@@ -42,6 +42,11 @@ const (
 	FlagScalingFactors = "scaling-factors"
 
 	FlagMigrationRecords = "migration-records"
+
+	// FlagWeight represents the flag name for the weight of an asset added to a balancer pool.
+	FlagWeight = "weight"
+	// FlagScalingFactor represents the flag name for the scaling factor of an asset added to a stableswap pool.
+	FlagScalingFactor = "scaling-factor"
 )
 
 type createBalancerPoolInputs struct {
@@ -60,6 +65,7 @@ type createStableswapPoolInputs struct {
 	FutureGovernor          string `json:"future-governor"`
 	ScalingFactorController string `json:"scaling-factor-controller"`
 	ScalingFactors          string `json:"scaling-factors"`
+	Amplification           string `json:"amplification"`
 }
 
 type smoothWeightChangeParamsInputs struct {
@@ -30,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetConcentratedPoolIdLinkFromCFMMRequest)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdScalingFactorRateSource)
 	cmd.AddCommand(
 		GetCmdNumPools(),
 		GetCmdPoolParams(),
@@ -336,6 +337,16 @@ func GetConcentratedPoolIdLinkFromCFMMRequest() (*osmocli.QueryDescriptor, *type
 	}, &types.QueryConcentratedPoolIdLinkFromCFMMRequest{}
 }
 
+// GetCmdScalingFactorRateSource returns the rate source the scaling factors of the given stableswap pool are delegated to.
+func GetCmdScalingFactorRateSource() (*osmocli.QueryDescriptor, *types.QueryScalingFactorRateSourceRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "scaling-factor-rate-source [poolID]",
+		Short: "Query the scaling factor rate source of a stableswap pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} scaling-factor-rate-source 1`,
+	}, &types.QueryScalingFactorRateSourceRequest{}
+}
+
 // GetCmdTotalPoolLiquidity returns total liquidity in pool.
 // Deprecated: please use the alternative in x/poolmanager
 // nolint: staticcheck
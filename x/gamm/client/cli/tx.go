@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -33,9 +34,14 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewJoinSwapShareAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapExternAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapShareAmountIn)
+	osmocli.AddTxCmd(txCmd, NewJoinPoolWithAnyTokenCmd)
+	osmocli.AddTxCmd(txCmd, NewExitPoolToSingleTokenCmd)
+	osmocli.AddTxCmd(txCmd, NewStableSwapRampAmplificationCmd)
 	txCmd.AddCommand(
 		NewCreatePoolCmd().BuildCommandCustomFn(),
 		NewStableSwapAdjustScalingFactorsCmd(),
+		NewStableSwapSetScalingFactorRateSourceCmd(),
+		NewStableSwapRemoveScalingFactorRateSourceCmd(),
 	)
 	return txCmd
 }
@@ -66,6 +72,15 @@ For stableswap (demonstrating need for a 1:1000 scaling factor, see doc)
 	"future-governor": "168h",
 	"scaling-factors": "1000,1"
 }
+
+For stableswap using the Curve StableSwap invariant, with an amplification coefficient of 200
+{
+	"initial-deposit": "1000000uusdc,1000000uusdt",
+	"swap-fee": "0.0005",
+	"exit-fee": "0",
+	"future-governor": "168h",
+	"amplification": "200"
+}
 `,
 		NumArgs:          0,
 		ParseAndBuildMsg: BuildCreatePoolCmd,
@@ -165,6 +180,42 @@ func NewExitSwapShareAmountIn() (*osmocli.TxCliDesc, *types.MsgExitSwapShareAmou
 	}, &types.MsgExitSwapShareAmountIn{}
 }
 
+func NewJoinPoolWithAnyTokenCmd() (*osmocli.TxCliDesc, *types.MsgJoinPoolWithAnyToken) {
+	return &osmocli.TxCliDesc{
+		Use:   "join-pool-with-any-token [token-in] [share-out-min-amount]",
+		Short: "swap token in along the swap routes into a pool asset and join the pool with it",
+		Long: `Swap token in along the swap routes into the pool asset output by the last route, and join the pool with it.
+Without swap routes, token in must already be a pool asset.`,
+		Example:             "osmosisd tx gamm join-pool-with-any-token 1000000uatom 1 --pool-id=1 --swap-route-pool-ids=2 --swap-route-denoms=uosmo",
+		CustomFlagOverrides: poolIdFlagOverride,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes": osmocli.FlagOnlyParser(optionalSwapAmountInRoutes),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()},
+			OptionalFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+		},
+	}, &types.MsgJoinPoolWithAnyToken{}
+}
+
+func NewExitPoolToSingleTokenCmd() (*osmocli.TxCliDesc, *types.MsgExitPoolToSingleToken) {
+	return &osmocli.TxCliDesc{
+		Use:   "exit-pool-to-single-token [share-in-amount] [pool-asset-denom] [token-out-min-amount]",
+		Short: "exit the pool to one of its assets and swap it along the swap routes",
+		Long: `Exit the pool to the pool asset of the given denom, and swap it along the swap routes into the denom output by the last route.
+Without swap routes, the pool asset is the token out.`,
+		Example:             "osmosisd tx gamm exit-pool-to-single-token 1000000000000000000 uosmo 1 --pool-id=1 --swap-route-pool-ids=2 --swap-route-denoms=uatom",
+		CustomFlagOverrides: poolIdFlagOverride,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes": osmocli.FlagOnlyParser(optionalSwapAmountInRoutes),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()},
+			OptionalFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+		},
+	}, &types.MsgExitPoolToSingleToken{}
+}
+
 // TODO: Change these flags to args. Required flags don't make that much sense.
 func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	cmd := osmocli.TxCliDesc{
@@ -181,6 +232,48 @@ func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewStableSwapRampAmplificationCmd() (*osmocli.TxCliDesc, *stableswap.MsgStableSwapRampAmplification) {
+	return &osmocli.TxCliDesc{
+		Use:     "ramp-amplification [pool-id] [future-amplification] [future-time]",
+		Short:   "ramp the amplification coefficient of a stableswap pool using the Curve StableSwap invariant",
+		Long:    "ramp the amplification coefficient of a stableswap pool linearly from its current value to future-amplification at future-time, given as a unix timestamp or in the sortable time format",
+		Example: "osmosisd tx gamm ramp-amplification 1 500 1700000000",
+	}, &stableswap.MsgStableSwapRampAmplification{}
+}
+
+func NewStableSwapSetScalingFactorRateSourceCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:   "set-scaling-factor-rate-source [pool-id] [rate-source-file]",
+		Short: "delegate the scaling factors of a stableswap pool to rate sources",
+		Long: `Delegate the scaling factors of a stableswap pool to rate sources, which update them automatically.
+Must provide the path to a JSON file describing the rate source. Each non-reference asset must have either a twap
+or a contract rate source. Sample rate source JSON file contents, updating every day epoch:
+{
+	"pool_id": "1",
+	"reference_denom": "uosmo",
+	"denom_rate_sources": [
+		{"denom": "stuosmo", "twap": {"pool_id": "2", "duration": "3600s"}},
+		{"denom": "stkuosmo", "contract_address": "osmo14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sq2r9g9"}
+	],
+	"max_change_per_update": "0.001",
+	"epoch_identifier": "day"
+}`,
+		Example:          "osmosisd tx gamm set-scaling-factor-rate-source 1 rate_source.json",
+		NumArgs:          2,
+		ParseAndBuildMsg: NewStableSwapSetScalingFactorRateSourceMsg,
+	}.BuildCommandCustomFn()
+}
+
+func NewStableSwapRemoveScalingFactorRateSourceCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:              "remove-scaling-factor-rate-source [pool-id]",
+		Short:            "remove the rate source of the scaling factors of a stableswap pool, giving their control back to the scaling factor controller",
+		Example:          "osmosisd tx gamm remove-scaling-factor-rate-source 1",
+		NumArgs:          1,
+		ParseAndBuildMsg: NewStableSwapRemoveScalingFactorRateSourceMsg,
+	}.BuildCommandCustomFn()
+}
+
 // NewCmdSubmitReplaceMigrationRecordsProposal implements a command handler for replace migration records proposal
 func NewCmdSubmitReplaceMigrationRecordsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -294,6 +387,173 @@ Ex) 2,4,1,5 -> [(Balancer 2, CL 4), (Balancer 1, CL 5)]
 	return cmd
 }
 
+// NewCmdSubmitAddPoolAssetProposal implements a command handler for add pool asset proposal
+func NewCmdSubmitAddPoolAssetProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-pool-asset-proposal [pool-id] [token] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit an add pool asset proposal",
+		Long: strings.TrimSpace(`Submit an add pool asset proposal.
+
+The token is paid from the community pool, and the pool shares minted for it are sent to the community pool.
+Balancer pools require the --weight flag, and stableswap pools the --scaling-factor flag.
+Ex) add-pool-asset-proposal 1 1000000uatom --weight=100
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parseAddPoolAssetArgsToContent(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().String(FlagWeight, "", "The weight of the added asset, for balancer pools")
+	cmd.Flags().Uint64(FlagScalingFactor, 0, "The scaling factor of the added asset, for stableswap pools")
+
+	return cmd
+}
+
+// NewCmdSubmitRemovePoolAssetProposal implements a command handler for remove pool asset proposal
+func NewCmdSubmitRemovePoolAssetProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-pool-asset-proposal [pool-id] [denom] [exchange-pool-id] [exchange-denom] [exchange-min-amount] [flags]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit a remove pool asset proposal",
+		Long: strings.TrimSpace(`Submit a remove pool asset proposal.
+
+The removed liquidity is swapped in the exchange pool into the exchange denom, another asset of the pool, for at least
+the exchange min amount, and the proceeds are added to the pool's reserves. The pool shares are unchanged.
+Ex) remove-pool-asset-proposal 1 uatom 2 uosmo 1000000
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parseRemovePoolAssetArgsToContent(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+
+	return cmd
+}
+
+// NewCmdSubmitRampAmplificationProposal implements a command handler for ramp amplification proposal
+func NewCmdSubmitRampAmplificationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ramp-amplification-proposal [pool-id] [future-amplification] [ramp-duration] [flags]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a ramp stableswap amplification proposal",
+		Long: strings.TrimSpace(`Submit a ramp stableswap amplification proposal.
+
+The amplification is ramped from its current value to the future amplification over the ramp duration,
+starting when the proposal is executed. The pool's scaling factor controller does not need to approve the ramp.
+Ex) ramp-amplification-proposal 1 200 72h
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parseRampAmplificationArgsToContent(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+
+	return cmd
+}
+
 func BuildCreatePoolCmd(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
 	poolType, err := fs.GetString(FlagPoolType)
 	if err != nil {
@@ -446,6 +706,14 @@ func NewBuildCreateStableswapPoolMsg(clientCtx client.Context, fs *flag.FlagSet)
 		ExitFee: exitFee,
 	}
 
+	amplification := uint64(0)
+	if len(flags.Amplification) > 0 {
+		amplification, err = strconv.ParseUint(flags.Amplification, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	scalingFactors := []uint64{}
 	trimmedSfString := strings.Trim(flags.ScalingFactors, "[] {}")
 	if len(trimmedSfString) > 0 {
@@ -469,6 +737,7 @@ func NewBuildCreateStableswapPoolMsg(clientCtx client.Context, fs *flag.FlagSet)
 		ScalingFactors:          scalingFactors,
 		ScalingFactorController: flags.ScalingFactorController,
 		FuturePoolGovernor:      flags.FutureGovernor,
+		Amplification:           amplification,
 	}, nil
 }
 
@@ -528,6 +797,19 @@ func swapAmountInRoutes(fs *flag.FlagSet) ([]poolmanagertypes.SwapAmountInRoute,
 	return routes, nil
 }
 
+// optionalSwapAmountInRoutes parses the swap routes like swapAmountInRoutes, but returns no routes
+// if the swap route pool ids are not set.
+func optionalSwapAmountInRoutes(fs *flag.FlagSet) ([]poolmanagertypes.SwapAmountInRoute, error) {
+	swapRoutePoolIds, err := fs.GetString(FlagSwapRoutePoolIds)
+	if err != nil {
+		return nil, err
+	}
+	if swapRoutePoolIds == "" {
+		return nil, nil
+	}
+	return swapAmountInRoutes(fs)
+}
+
 func swapAmountOutRoutes(fs *flag.FlagSet) ([]poolmanagertypes.SwapAmountOutRoute, error) {
 	swapRoutePoolIds, err := fs.GetString(FlagSwapRoutePoolIds)
 	swapRoutePoolIdsArray := strings.Split(swapRoutePoolIds, ",")
@@ -614,6 +896,45 @@ func NewStableSwapAdjustScalingFactorsMsg(clientCtx client.Context, _args []stri
 	return msg, nil
 }
 
+func NewStableSwapSetScalingFactorRateSourceMsg(clientCtx client.Context, args []string, _ *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := os.ReadFile(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	rateSource := types.ScalingFactorRateSource{}
+	if err := clientCtx.Codec.UnmarshalJSON(contents, &rateSource); err != nil {
+		return nil, err
+	}
+
+	msg := &stableswap.MsgStableSwapSetScalingFactorRateSource{
+		Sender:     clientCtx.GetFromAddress().String(),
+		PoolID:     poolID,
+		RateSource: &rateSource,
+	}
+
+	return msg, nil
+}
+
+func NewStableSwapRemoveScalingFactorRateSourceMsg(clientCtx client.Context, args []string, _ *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &stableswap.MsgStableSwapSetScalingFactorRateSource{
+		Sender: clientCtx.GetFromAddress().String(),
+		PoolID: poolID,
+	}
+
+	return msg, nil
+}
+
 // ParseCoinsNoSort parses coins from coinsStr but does not sort them.
 // Returns error if parsing fails.
 func ParseCoinsNoSort(coinsStr string) (sdk.Coins, error) {
@@ -713,3 +1034,127 @@ func parseUpdateMigrationRecordsArgsToContent(cmd *cobra.Command) (govtypes.Cont
 	}
 	return content, nil
 }
+
+func parseAddPoolAssetArgsToContent(cmd *cobra.Command, args []string) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := sdk.ParseCoinNormalized(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	weight := sdk.ZeroInt()
+	weightStr, err := cmd.Flags().GetString(FlagWeight)
+	if err != nil {
+		return nil, err
+	}
+	if weightStr != "" {
+		var ok bool
+		weight, ok = sdk.NewIntFromString(weightStr)
+		if !ok {
+			return nil, fmt.Errorf("invalid weight: %s", weightStr)
+		}
+	}
+
+	scalingFactor, err := cmd.Flags().GetUint64(FlagScalingFactor)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.AddPoolAssetProposal{
+		Title:         title,
+		Description:   description,
+		PoolId:        poolId,
+		Token:         token,
+		Weight:        weight,
+		ScalingFactor: scalingFactor,
+	}
+	return content, nil
+}
+
+func parseRemovePoolAssetArgsToContent(cmd *cobra.Command, args []string) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	exchangePoolId, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeMinAmount, ok := sdk.NewIntFromString(args[4])
+	if !ok {
+		return nil, fmt.Errorf("invalid exchange min amount %s", args[4])
+	}
+
+	content := &types.RemovePoolAssetProposal{
+		Title:             title,
+		Description:       description,
+		PoolId:            poolId,
+		Denom:             args[1],
+		ExchangePoolId:    exchangePoolId,
+		ExchangeDenom:     args[3],
+		ExchangeMinAmount: exchangeMinAmount,
+	}
+	return content, nil
+}
+
+func parseRampAmplificationArgsToContent(cmd *cobra.Command, args []string) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	futureAmplification, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	rampDuration, err := time.ParseDuration(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.RampAmplificationProposal{
+		Title:               title,
+		Description:         description,
+		PoolId:              poolId,
+		FutureAmplification: futureAmplification,
+		RampDuration:        rampDuration,
+	}
+	return content, nil
+}
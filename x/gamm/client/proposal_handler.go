@@ -10,4 +10,7 @@ import (
 var (
 	ReplaceMigrationRecordsProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitReplaceMigrationRecordsProposal, rest.ProposalReplaceMigrationRecordsRESTHandler)
 	UpdateMigrationRecordsProposalHandler  = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateMigrationRecordsProposal, rest.ProposalUpdateMigrationRecordsRESTHandler)
+	AddPoolAssetProposalHandler            = govclient.NewProposalHandler(cli.NewCmdSubmitAddPoolAssetProposal, rest.ProposalAddPoolAssetRESTHandler)
+	RemovePoolAssetProposalHandler         = govclient.NewProposalHandler(cli.NewCmdSubmitRemovePoolAssetProposal, rest.ProposalRemovePoolAssetRESTHandler)
+	RampAmplificationProposalHandler       = govclient.NewProposalHandler(cli.NewCmdSubmitRampAmplificationProposal, rest.ProposalRampAmplificationRESTHandler)
 )
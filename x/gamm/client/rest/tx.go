@@ -21,6 +21,27 @@ func ProposalUpdateMigrationRecordsRESTHandler(clientCtx client.Context) govrest
 	}
 }
 
+func ProposalAddPoolAssetRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add-pool-asset",
+		Handler:  emptyHandler(clientCtx),
+	}
+}
+
+func ProposalRemovePoolAssetRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove-pool-asset",
+		Handler:  emptyHandler(clientCtx),
+	}
+}
+
+func ProposalRampAmplificationRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "ramp-amplification",
+		Handler:  emptyHandler(clientCtx),
+	}
+}
+
 func emptyHandler(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 	}
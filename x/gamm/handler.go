@@ -19,6 +19,12 @@ func NewMigrationRecordHandler(k keeper.Keeper) govtypes.Handler {
 			return handleUpdateMigrationRecordsProposal(ctx, k, c)
 		case *types.ReplaceMigrationRecordsProposal:
 			return handleReplaceMigrationRecordsProposal(ctx, k, c)
+		case *types.AddPoolAssetProposal:
+			return handleAddPoolAssetProposal(ctx, k, c)
+		case *types.RemovePoolAssetProposal:
+			return handleRemovePoolAssetProposal(ctx, k, c)
+		case *types.RampAmplificationProposal:
+			return handleRampAmplificationProposal(ctx, k, c)
 
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized migration record proposal content type: %T", c)
@@ -35,3 +41,18 @@ func handleReplaceMigrationRecordsProposal(ctx sdk.Context, k keeper.Keeper, p *
 func handleUpdateMigrationRecordsProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateMigrationRecordsProposal) error {
 	return k.HandleUpdateMigrationRecordsProposal(ctx, p)
 }
+
+// handleAddPoolAssetProposal is a handler for adding pool assets governance proposals
+func handleAddPoolAssetProposal(ctx sdk.Context, k keeper.Keeper, p *types.AddPoolAssetProposal) error {
+	return k.HandleAddPoolAssetProposal(ctx, p)
+}
+
+// handleRemovePoolAssetProposal is a handler for removing pool assets governance proposals
+func handleRemovePoolAssetProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemovePoolAssetProposal) error {
+	return k.HandleRemovePoolAssetProposal(ctx, p)
+}
+
+// handleRampAmplificationProposal is a handler for ramping stableswap amplification governance proposals
+func handleRampAmplificationProposal(ctx sdk.Context, k keeper.Keeper, p *types.RampAmplificationProposal) error {
+	return k.HandleRampAmplificationProposal(ctx, p)
+}
@@ -37,3 +37,7 @@ func GetMaximalNoSwapLPAmount(ctx sdk.Context, pool types.CFMMPoolI, shareOutAmo
 func (k Keeper) RedirectDistributionRecord(ctx sdk.Context, cfmmPoolId, clPoolId uint64) error {
 	return k.redirectDistributionRecord(ctx, cfmmPoolId, clPoolId)
 }
+
+func (k Keeper) SetScalingFactorRateSource(ctx sdk.Context, poolId uint64, rateSource *types.ScalingFactorRateSource, sender string) error {
+	return k.setScalingFactorRateSource(ctx, poolId, rateSource, sender)
+}
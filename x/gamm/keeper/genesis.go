@@ -40,6 +40,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 	} else {
 		k.SetMigrationRecords(ctx, *genState.MigrationRecords)
 	}
+
+	for _, rateSource := range genState.ScalingFactorRateSources {
+		k.setScalingFactorRateSourceUnsafe(ctx, rateSource)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -60,10 +64,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		}
 		poolAnys = append(poolAnys, any)
 	}
+	rateSources, err := k.GetAllScalingFactorRateSources(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return &types.GenesisState{
-		NextPoolNumber:   k.GetNextPoolId(ctx),
-		Pools:            poolAnys,
-		Params:           k.GetParams(ctx),
-		MigrationRecords: &migrationInfo,
+		NextPoolNumber:           k.GetNextPoolId(ctx),
+		Pools:                    poolAnys,
+		Params:                   k.GetParams(ctx),
+		MigrationRecords:         &migrationInfo,
+		ScalingFactorRateSources: rateSources,
 	}
 }
@@ -156,3 +156,21 @@ func (s *KeeperTestSuite) TestMarshalUnmarshalGenesis() {
 		s.App.GAMMKeeper.InitGenesis(s.Ctx, *genesis, s.App.AppCodec())
 	})
 }
+
+func (s *KeeperTestSuite) TestScalingFactorRateSourcesGenesis() {
+	s.SetupTest()
+	twapPoolId, poolId := s.setupRateSourcePools()
+
+	rateSource := twapRateSource(poolId, twapPoolId, sdk.NewDecWithPrec(1, 2), "day")
+	s.Require().NoError(s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, &rateSource, s.TestAccs[0].String()))
+
+	genesis := s.App.GAMMKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal([]types.ScalingFactorRateSource{rateSource}, genesis.ScalingFactorRateSources)
+	s.Require().NoError(genesis.Validate())
+
+	s.SetupTest()
+	s.App.GAMMKeeper.InitGenesis(s.Ctx, *genesis, s.App.AppCodec())
+	importedRateSource, err := s.App.GAMMKeeper.GetScalingFactorRateSource(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(rateSource, importedRateSource)
+}
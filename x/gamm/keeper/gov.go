@@ -1,9 +1,14 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 )
 
 func (k Keeper) HandleReplaceMigrationRecordsProposal(ctx sdk.Context, p *types.ReplaceMigrationRecordsProposal) error {
@@ -13,3 +18,48 @@ func (k Keeper) HandleReplaceMigrationRecordsProposal(ctx sdk.Context, p *types.
 func (k Keeper) HandleUpdateMigrationRecordsProposal(ctx sdk.Context, p *types.UpdateMigrationRecordsProposal) error {
 	return k.UpdateMigrationRecords(ctx, p.Records)
 }
+
+// HandleAddPoolAssetProposal adds the proposal's token to the proposal's pool, paying it from the community pool.
+// The weight is used for balancer pools, and the scaling factor for stableswap pools.
+func (k Keeper) HandleAddPoolAssetProposal(ctx sdk.Context, p *types.AddPoolAssetProposal) error {
+	pool, err := k.GetPoolAndPoke(ctx, p.PoolId)
+	if err != nil {
+		return err
+	}
+	switch pool.(type) {
+	case *balancer.Pool:
+		_, err = k.addBalancerPoolAsset(ctx, p.PoolId, balancer.PoolAsset{Token: p.Token, Weight: p.Weight})
+	case *stableswap.Pool:
+		_, err = k.addStableSwapPoolAsset(ctx, p.PoolId, p.Token, p.ScalingFactor)
+	default:
+		err = fmt.Errorf("pool id %d does not support adding assets", p.PoolId)
+	}
+	return err
+}
+
+// HandleRemovePoolAssetProposal removes the proposal's denom from the proposal's pool, exchanging its liquidity
+// in the proposal's exchange pool into the proposal's exchange denom, another pool asset, which is added to the pool.
+func (k Keeper) HandleRemovePoolAssetProposal(ctx sdk.Context, p *types.RemovePoolAssetProposal) error {
+	pool, err := k.GetPoolAndPoke(ctx, p.PoolId)
+	if err != nil {
+		return err
+	}
+	exchangeRoute := poolmanagertypes.SwapAmountInRoute{PoolId: p.ExchangePoolId, TokenOutDenom: p.ExchangeDenom}
+	switch pool.(type) {
+	case *balancer.Pool:
+		_, _, err = k.removeBalancerPoolAsset(ctx, p.PoolId, p.Denom, exchangeRoute, p.ExchangeMinAmount)
+	case *stableswap.Pool:
+		_, _, err = k.removeStableSwapPoolAsset(ctx, p.PoolId, p.Denom, exchangeRoute, p.ExchangeMinAmount)
+	default:
+		err = fmt.Errorf("pool id %d does not support removing assets", p.PoolId)
+	}
+	return err
+}
+
+// HandleRampAmplificationProposal ramps the amplification coefficient of the proposal's stableswap pool to the
+// proposal's future amplification over the proposal's ramp duration, starting at the current block time.
+// The pool's scaling factor controller is not required to approve the ramp.
+func (k Keeper) HandleRampAmplificationProposal(ctx sdk.Context, p *types.RampAmplificationProposal) error {
+	futureTime := ctx.BlockTime().Add(p.RampDuration)
+	return k.rampStableSwapAmplificationByGovernance(ctx, p.PoolId, p.FutureAmplification, futureTime)
+}
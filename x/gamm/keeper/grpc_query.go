@@ -499,3 +499,21 @@ func (q Querier) ConcentratedPoolIdLinkFromCFMM(ctx context.Context, req *types.
 		ConcentratedPoolId: poolIdEntering,
 	}, nil
 }
+
+// ScalingFactorRateSource queries the rate source the scaling factors of a stableswap pool are delegated to.
+func (q Querier) ScalingFactorRateSource(ctx context.Context, req *types.QueryScalingFactorRateSourceRequest) (*types.QueryScalingFactorRateSourceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid pool id")
+	}
+	rateSource, err := q.Keeper.GetScalingFactorRateSource(sdk.UnwrapSDKContext(ctx), req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryScalingFactorRateSourceResponse{
+		RateSource: rateSource,
+	}, nil
+}
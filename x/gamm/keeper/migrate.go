@@ -6,6 +6,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 
@@ -13,46 +14,82 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// MigrateUnlockedPositionFromBalancerToConcentrated migrates unlocked lp tokens from a balancer pool to a concentrated liquidity pool.
+// MigrateUnlockedPositionFromBalancerToConcentrated migrates unlocked lp tokens from a balancer or stableswap pool to a concentrated liquidity pool.
+// Balancer shares migrate to a full range position, and stableswap shares migrate to a position ranging tightly around the peg price.
+// The exited coins the position does not use stay with the sender, and are returned as leftover coins.
 // Fails if the lp tokens are locked (must instead utilize UnlockAndMigrate function in the superfluid module)
 func (k Keeper) MigrateUnlockedPositionFromBalancerToConcentrated(ctx sdk.Context,
 	sender sdk.AccAddress, sharesToMigrate sdk.Coin,
 	tokenOutMins sdk.Coins,
-) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, poolIdLeaving, poolIdEntering uint64, err error) {
+) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, poolIdLeaving, poolIdEntering uint64, leftoverCoins sdk.Coins, err error) {
 	// Get the balancer poolId by parsing the gamm share denom.
 	poolIdLeaving, err = types.GetPoolIdFromShareDenom(sharesToMigrate.Denom)
 	if err != nil {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, nil, err
 	}
 
 	// Find the governance sanctioned link between the balancer pool and a concentrated pool.
 	poolIdEntering, err = k.GetLinkedConcentratedPoolID(ctx, poolIdLeaving)
 	if err != nil {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, nil, err
 	}
 
 	// Get the concentrated pool from the message and type cast it to ConcentratedPoolExtension.
 	concentratedPool, err := k.concentratedLiquidityKeeper.GetConcentratedPoolById(ctx, poolIdEntering)
 	if err != nil {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, nil, err
 	}
 
 	// Exit the balancer pool position.
 	exitCoins, err := k.ExitPool(ctx, sender, poolIdLeaving, sharesToMigrate.Amount, tokenOutMins)
 	if err != nil {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, nil, err
 	}
 	// Defense in depth, ensuring we are returning exactly two coins.
 	if len(exitCoins) != 2 {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, fmt.Errorf("Balancer pool must have exactly two tokens")
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, nil, fmt.Errorf("Balancer pool must have exactly two tokens")
 	}
 
-	// Create a full range (min to max tick) concentrated liquidity position.
-	positionId, amount0, amount1, liquidity, err = k.concentratedLiquidityKeeper.CreateFullRangePosition(ctx, concentratedPool.GetId(), sender, exitCoins)
+	positionId, amount0, amount1, liquidity, err = k.createMigrationPosition(ctx, sender, poolIdLeaving, concentratedPool, exitCoins)
 	if err != nil {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, nil, err
 	}
-	return positionId, amount0, amount1, liquidity, poolIdLeaving, poolIdEntering, nil
+	leftoverCoins = types.MigrationLeftoverCoins(exitCoins, concentratedPool.GetToken0(), concentratedPool.GetToken1(), amount0, amount1)
+	return positionId, amount0, amount1, liquidity, poolIdLeaving, poolIdEntering, leftoverCoins, nil
+}
+
+// createMigrationPosition creates the concentrated liquidity position the coins exited from the given pool migrate to.
+// For a stableswap pool, the position ranges StableswapMigrationRangeRatio around the peg price implied by its scaling factors,
+// and the concentrated pool's spot price must be within that range, so that the position is in range and uses both coins.
+// The spot price of a concentrated pool without liquidity is the one the exited coins would initialize it with.
+// Otherwise, the position is full range (min to max tick).
+func (k Keeper) createMigrationPosition(ctx sdk.Context, sender sdk.AccAddress, poolIdLeaving uint64, concentratedPool cltypes.ConcentratedPoolExtension, exitCoins sdk.Coins) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, err error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolIdLeaving)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return k.concentratedLiquidityKeeper.CreateFullRangePosition(ctx, concentratedPool.GetId(), sender, exitCoins)
+	}
+
+	// One scaled unit of each asset is worth the same at peg, so the peg price of token 0 in terms of token 1
+	// is the ratio of their scaling factors.
+	scalingFactor0 := stableswapPool.GetScalingFactorByDenom(concentratedPool.GetToken0())
+	scalingFactor1 := stableswapPool.GetScalingFactorByDenom(concentratedPool.GetToken1())
+	pegPrice := sdk.NewDecFromInt(sdk.NewIntFromUint64(scalingFactor1)).QuoInt(sdk.NewIntFromUint64(scalingFactor0))
+
+	spotPrice := concentratedPool.GetCurrentSqrtPrice().Power(2)
+	if spotPrice.IsZero() && exitCoins.AmountOf(concentratedPool.GetToken0()).IsPositive() {
+		spotPrice = sdk.NewDecFromInt(exitCoins.AmountOf(concentratedPool.GetToken1())).QuoInt(exitCoins.AmountOf(concentratedPool.GetToken0()))
+	}
+	lowerPrice := pegPrice.Mul(sdk.OneDec().Sub(types.StableswapMigrationRangeRatio))
+	upperPrice := pegPrice.Mul(sdk.OneDec().Add(types.StableswapMigrationRangeRatio))
+	if spotPrice.LT(lowerPrice) || spotPrice.GT(upperPrice) {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.StableswapMigrationSpotPriceOutOfRangeError{PoolId: concentratedPool.GetId(), SpotPrice: spotPrice, LowerPrice: lowerPrice, UpperPrice: upperPrice}
+	}
+
+	return k.concentratedLiquidityKeeper.CreatePositionAroundPrice(ctx, concentratedPool.GetId(), sender, exitCoins, pegPrice, types.StableswapMigrationRangeRatio)
 }
 
 // GetAllMigrationInfo gets all existing links between Balancer Pool and Concentrated Pool,
@@ -194,7 +231,7 @@ func (k Keeper) redirectDistributionRecord(ctx sdk.Context, cfmmPoolId, clPoolId
 
 // validateRecords validates a list of BalancerToConcentratedPoolLink records to ensure that:
 // 1) there are no duplicates
-// 2) both the balancer and gamm pool IDs are valid
+// 2) both the balancer and gamm pool IDs are valid, where the balancer pool may also be a stableswap pool
 // 3) the balancer pool has exactly two tokens
 // 4) the denoms of the tokens in the balancer pool match the denoms of the tokens in the gamm pool
 // It also reorders records from lowest to highest balancer pool ID if they are not provided in order already.
@@ -233,14 +270,14 @@ func (k Keeper) validateRecords(ctx sdk.Context, records []types.BalancerToConce
 			)
 		}
 
-		// Ensure the provided balancerPoolId exists and that it is of type balancer
+		// Ensure the provided balancerPoolId exists and that it is of type balancer or stableswap
 		balancerPool, err := k.GetPool(ctx, record.BalancerPoolId)
 		if err != nil {
 			return err
 		}
 		poolType := balancerPool.GetType()
-		if poolType != poolmanagertypes.Balancer {
-			return fmt.Errorf("Balancer pool ID #%d is not of type balancer", record.BalancerPoolId)
+		if poolType != poolmanagertypes.Balancer && poolType != poolmanagertypes.Stableswap {
+			return fmt.Errorf("Balancer pool ID #%d is not of type balancer or stableswap", record.BalancerPoolId)
 		}
 
 		// If clPoolID is 0, this signals a removal, so we skip this check.
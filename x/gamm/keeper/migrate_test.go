@@ -8,6 +8,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	clmath "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v16/x/pool-incentives/types"
 )
@@ -191,7 +195,7 @@ func (s *KeeperTestSuite) TestMigrate() {
 
 		// Migrate the user's gamm shares to a full range concentrated liquidity position
 		userBalancesBeforeMigration := s.App.BankKeeper.GetAllBalances(s.Ctx, test.param.sender)
-		positionId, amount0, amount1, _, poolIdLeaving, poolIdEntering, err := keeper.MigrateUnlockedPositionFromBalancerToConcentrated(s.Ctx, test.param.sender, sharesToMigrate, test.tokenOutMins)
+		positionId, amount0, amount1, _, poolIdLeaving, poolIdEntering, leftoverCoins, err := keeper.MigrateUnlockedPositionFromBalancerToConcentrated(s.Ctx, test.param.sender, sharesToMigrate, test.tokenOutMins)
 		userBalancesAfterMigration := s.App.BankKeeper.GetAllBalances(s.Ctx, test.param.sender)
 		if test.expectedErr != nil {
 			s.Require().Error(err)
@@ -230,6 +234,8 @@ func (s *KeeperTestSuite) TestMigrate() {
 		expectedUserFinalUsdcBalanceDiff := expectedCoinsOut.AmountOf(USDC).Sub(amount1)
 		s.Require().Equal(userBalancesBeforeMigration.AmountOf(ETH).Add(expectedUserFinalEthBalanceDiff).String(), userBalancesAfterMigration.AmountOf(ETH).String())
 		s.Require().Equal(userBalancesBeforeMigration.AmountOf(USDC).Add(expectedUserFinalUsdcBalanceDiff).String(), userBalancesAfterMigration.AmountOf(USDC).String())
+		s.Require().Equal(expectedUserFinalEthBalanceDiff.String(), leftoverCoins.AmountOf(ETH).String())
+		s.Require().Equal(expectedUserFinalUsdcBalanceDiff.String(), leftoverCoins.AmountOf(USDC).String())
 
 		// Assure the expected position was created.
 		// TODO: When we implement lock breaking, we need to change time.Time{} to the lock's end time.
@@ -261,6 +267,84 @@ func (s *KeeperTestSuite) TestMigrate() {
 	}
 }
 
+// prepareStableswapPoolWithCoins creates a stableswap pool of the given coins and scaling factors,
+// funding the creator with the coins first.
+func (s *KeeperTestSuite) prepareStableswapPoolWithCoins(scalingFactors []uint64, coins ...sdk.Coin) uint64 {
+	s.FundAcc(s.TestAccs[0], apptesting.DefaultAcctFunds.Add(coins...))
+	msg := stableswap.NewMsgCreateStableswapPool(s.TestAccs[0], stableswap.PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec()}, sdk.NewCoins(coins...), scalingFactors, "")
+	poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, msg)
+	s.Require().NoError(err)
+	return poolId
+}
+
+func (s *KeeperTestSuite) TestMigrateStableswap() {
+	s.SetupTest()
+	keeper := s.App.GAMMKeeper
+	sender := s.TestAccs[0]
+
+	// One scaled unit of usdc is worth one scaled unit of eth, so the peg price of eth in usdc is 2.
+	stableswapPoolId := s.prepareStableswapPoolWithCoins([]uint64{1, 2}, sdk.NewCoin(ETH, sdk.NewInt(1000000000)), sdk.NewCoin(USDC, sdk.NewInt(2000000000)))
+	clPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, defaultTickSpacing, sdk.ZeroDec())
+	err := keeper.ReplaceMigrationRecords(s.Ctx, []types.BalancerToConcentratedPoolLink{{BalancerPoolId: stableswapPoolId, ClPoolId: clPool.GetId()}})
+	s.Require().NoError(err)
+
+	// Migrate half of the creator's shares
+	shareDenom := types.GetPoolShareDenom(stableswapPoolId)
+	sharesBefore := s.App.BankKeeper.GetBalance(s.Ctx, sender, shareDenom)
+	sharesToMigrate := sdk.NewCoin(shareDenom, sharesBefore.Amount.QuoRaw(2))
+	balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+	positionId, amount0, amount1, _, poolIdLeaving, poolIdEntering, leftoverCoins, err := keeper.MigrateUnlockedPositionFromBalancerToConcentrated(s.Ctx, sender, sharesToMigrate, sdk.Coins{})
+	s.Require().NoError(err)
+	s.Require().Equal(stableswapPoolId, poolIdLeaving)
+	s.Require().Equal(clPool.GetId(), poolIdEntering)
+	s.Require().True(amount0.IsPositive())
+	s.Require().True(amount1.IsPositive())
+	s.Require().Equal(sharesBefore.Sub(sharesToMigrate), s.App.BankKeeper.GetBalance(s.Ctx, sender, shareDenom))
+
+	// The exited coins the position did not use stay with the sender.
+	balancesAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+	s.Require().Equal(balancesBefore.AmountOf(ETH).Add(leftoverCoins.AmountOf(ETH)), balancesAfter.AmountOf(ETH))
+	s.Require().Equal(balancesBefore.AmountOf(USDC).Add(leftoverCoins.AmountOf(USDC)), balancesAfter.AmountOf(USDC))
+
+	// The position ranges StableswapMigrationRangeRatio around the peg price, up to one tick spacing.
+	position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Greater(position.LowerTick, cltypes.MinTick)
+	s.Require().Less(position.UpperTick, cltypes.MaxTick)
+
+	pegPrice := sdk.NewDec(2)
+	lowerPrice, err := clmath.TickToPrice(position.LowerTick)
+	s.Require().NoError(err)
+	upperPrice, err := clmath.TickToPrice(position.UpperTick)
+	s.Require().NoError(err)
+	s.Require().True(lowerPrice.LTE(pegPrice.Mul(sdk.OneDec().Sub(types.StableswapMigrationRangeRatio))))
+	s.Require().True(upperPrice.GTE(pegPrice.Mul(sdk.OneDec().Add(types.StableswapMigrationRangeRatio))))
+	oneSpacingAboveLowerPrice, err := clmath.TickToPrice(position.LowerTick + int64(defaultTickSpacing))
+	s.Require().NoError(err)
+	s.Require().True(oneSpacingAboveLowerPrice.GT(pegPrice.Mul(sdk.OneDec().Sub(types.StableswapMigrationRangeRatio))))
+}
+
+func (s *KeeperTestSuite) TestMigrateStableswap_SpotPriceOutOfRange() {
+	s.SetupTest()
+	keeper := s.App.GAMMKeeper
+	sender := s.TestAccs[0]
+
+	// The peg price of eth in usdc is 2, while the concentrated pool trades eth at 3 usdc.
+	stableswapPoolId := s.prepareStableswapPoolWithCoins([]uint64{1, 2}, sdk.NewCoin(ETH, sdk.NewInt(1000000000)), sdk.NewCoin(USDC, sdk.NewInt(2000000000)))
+	clPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, defaultTickSpacing, sdk.ZeroDec())
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(1000000)), sdk.NewCoin(USDC, sdk.NewInt(3000000))))
+	_, _, _, _, err := s.App.ConcentratedLiquidityKeeper.CreateFullRangePosition(s.Ctx, clPool.GetId(), s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(1000000)), sdk.NewCoin(USDC, sdk.NewInt(3000000))))
+	s.Require().NoError(err)
+	err = keeper.ReplaceMigrationRecords(s.Ctx, []types.BalancerToConcentratedPoolLink{{BalancerPoolId: stableswapPoolId, ClPoolId: clPool.GetId()}})
+	s.Require().NoError(err)
+
+	shareDenom := types.GetPoolShareDenom(stableswapPoolId)
+	sharesBefore := s.App.BankKeeper.GetBalance(s.Ctx, sender, shareDenom)
+	sharesToMigrate := sdk.NewCoin(shareDenom, sharesBefore.Amount.QuoRaw(2))
+	_, _, _, _, _, _, _, err = keeper.MigrateUnlockedPositionFromBalancerToConcentrated(s.Ctx, sender, sharesToMigrate, sdk.Coins{})
+	s.Require().ErrorAs(err, &types.StableswapMigrationSpotPriceOutOfRangeError{})
+}
+
 func (s *KeeperTestSuite) TestReplaceMigrationRecords() {
 	tests := []struct {
 		name                        string
@@ -268,6 +352,7 @@ func (s *KeeperTestSuite) TestReplaceMigrationRecords() {
 		overwriteBalancerDenom0     string
 		overwriteBalancerDenom1     string
 		createFourAssetBalancerPool bool
+		createStableswapPool        bool
 		expectErr                   bool
 	}{
 		{
@@ -385,6 +470,17 @@ func (s *KeeperTestSuite) TestReplaceMigrationRecords() {
 			createFourAssetBalancerPool: true,
 			expectErr:                   true,
 		},
+		{
+			name: "Stableswap pool",
+			testingMigrationRecords: []types.BalancerToConcentratedPoolLink{
+				{
+					BalancerPoolId: 5,
+					ClPoolId:       3,
+				},
+			},
+			createStableswapPool: true,
+			expectErr:            false,
+		},
 	}
 
 	for _, test := range tests {
@@ -417,6 +513,10 @@ func (s *KeeperTestSuite) TestReplaceMigrationRecords() {
 			if test.createFourAssetBalancerPool {
 				s.PrepareBalancerPool()
 			}
+			// Stableswap pool ID if created: 5
+			if test.createStableswapPool {
+				s.prepareStableswapPoolWithCoins([]uint64{1, 1}, defaultBalancerCoin0, defaultBalancerCoin1)
+			}
 
 			err := keeper.ReplaceMigrationRecords(s.Ctx, test.testingMigrationRecords)
 			if test.expectErr {
@@ -63,6 +63,26 @@ func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, ms
 	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
 }
 
+func (server msgServer) StableSwapRampAmplification(goCtx context.Context, msg *stableswap.MsgStableSwapRampAmplification) (*stableswap.MsgStableSwapRampAmplificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.rampStableSwapAmplification(ctx, msg.PoolID, msg.FutureAmplification, msg.FutureTime, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapRampAmplificationResponse{}, nil
+}
+
+func (server msgServer) StableSwapSetScalingFactorRateSource(goCtx context.Context, msg *stableswap.MsgStableSwapSetScalingFactorRateSource) (*stableswap.MsgStableSwapSetScalingFactorRateSourceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.setScalingFactorRateSource(ctx, msg.PoolID, msg.RateSource, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapSetScalingFactorRateSourceResponse{}, nil
+}
+
 // CreatePool attempts to create a pool returning the newly created pool ID or an error upon failure.
 // The pool creation fee is used to fund the community pool.
 // It will create a dedicated module account for the pool and sends the initial liquidity to the created module account.
@@ -296,3 +316,53 @@ func (server msgServer) ExitSwapShareAmountIn(goCtx context.Context, msg *types.
 
 	return &types.MsgExitSwapShareAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
 }
+
+func (server msgServer) JoinPoolWithAnyToken(goCtx context.Context, msg *types.MsgJoinPoolWithAnyToken) (*types.MsgJoinPoolWithAnyTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	shareOutAmount, err := server.keeper.JoinPoolWithAnyToken(ctx, sender, msg.PoolId, msg.TokenIn, msg.Routes, msg.ShareOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap and LP events are handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgJoinPoolWithAnyTokenResponse{ShareOutAmount: shareOutAmount}, nil
+}
+
+func (server msgServer) ExitPoolToSingleToken(goCtx context.Context, msg *types.MsgExitPoolToSingleToken) (*types.MsgExitPoolToSingleTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOut, err := server.keeper.ExitPoolToSingleToken(ctx, sender, msg.PoolId, msg.ShareInAmount, msg.PoolAssetDenom, msg.Routes, msg.TokenOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap and LP events are handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgExitPoolToSingleTokenResponse{TokenOut: tokenOut}, nil
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -312,6 +314,10 @@ func (k Keeper) GetTotalPoolShares(ctx sdk.Context, poolId uint64) (sdk.Int, err
 // errors if the pool does not exist, the sender is not the scaling factor controller, or due to other
 // internal errors.
 func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scalingFactors []uint64, sender string) error {
+	if k.hasScalingFactorRateSource(ctx, poolId) {
+		return types.ScalingFactorsDelegatedError{PoolId: poolId}
+	}
+
 	pool, err := k.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
 		return err
@@ -327,6 +333,56 @@ func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 	return k.setPool(ctx, stableswapPool)
 }
 
+// rampStableSwapAmplification ramps the amplification coefficient of the stable swap pool to the given
+// future amplification at the given future time.
+// errors if the pool does not exist, the sender is not the scaling factor controller, the pool does not use
+// the Curve StableSwap invariant, or the ramp is invalid.
+func (k Keeper) rampStableSwapAmplification(ctx sdk.Context, poolId uint64, futureAmplification uint64, futureTime time.Time, sender string) error {
+	return k.applyStableSwapAmplificationRamp(ctx, poolId, futureAmplification, futureTime, func(pool *stableswap.Pool) error {
+		return pool.RampAmplification(ctx, futureAmplification, futureTime, sender)
+	})
+}
+
+// rampStableSwapAmplificationByGovernance ramps the amplification coefficient of the stable swap pool to the given
+// future amplification at the given future time, regardless of the pool's scaling factor controller.
+// errors if the pool does not exist, the pool does not use the Curve StableSwap invariant, or the ramp is invalid.
+func (k Keeper) rampStableSwapAmplificationByGovernance(ctx sdk.Context, poolId uint64, futureAmplification uint64, futureTime time.Time) error {
+	return k.applyStableSwapAmplificationRamp(ctx, poolId, futureAmplification, futureTime, func(pool *stableswap.Pool) error {
+		return pool.RampAmplificationByGovernance(ctx, futureAmplification, futureTime)
+	})
+}
+
+// applyStableSwapAmplificationRamp applies the given ramp to the stable swap pool, then stores the pool
+// and emits the ramp amplification event.
+func (k Keeper) applyStableSwapAmplificationRamp(ctx sdk.Context, poolId uint64, futureAmplification uint64, futureTime time.Time, ramp func(*stableswap.Pool) error) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	initialAmplification := stableswapPool.GetAmplification(ctx)
+	if err := ramp(stableswapPool); err != nil {
+		return err
+	}
+
+	if err := k.setPool(ctx, stableswapPool); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtRampAmplification,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyInitialAmplification, initialAmplification.String()),
+		sdk.NewAttribute(types.AttributeKeyFutureAmplification, strconv.FormatUint(futureAmplification, 10)),
+		sdk.NewAttribute(types.AttributeKeyFutureTime, futureTime.String()),
+	))
+	return nil
+}
+
 // asCFMMPool converts PoolI to CFMMPoolI by casting the input.
 // Returns the pool of the CFMMPoolI or error if the given pool does not implement
 // CFMMPoolI.
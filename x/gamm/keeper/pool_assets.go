@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+// addBalancerPoolAsset adds the given asset to the balancer pool with the given id.
+// The community pool deposits the asset's token, and receives the shares it is worth.
+// Returns error if the pool is not a balancer pool, if it is linked to a concentrated pool for migration,
+// if the pool fails to add the asset, or if the community pool lacks the token.
+func (k Keeper) addBalancerPoolAsset(ctx sdk.Context, poolId uint64, poolAsset balancer.PoolAsset) (sdk.Int, error) {
+	pool, err := k.getBalancerPoolForAssetsChange(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	sharesOut, err := pool.AddPoolAsset(poolAsset)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return sharesOut, k.applyAddPoolAsset(ctx, pool, poolAsset.Token, sharesOut)
+}
+
+// removeBalancerPoolAsset removes the asset with the given denom from the balancer pool with the given id.
+// Its whole balance is exchanged along the given route into another pool asset, see exchangeRemovedPoolAsset,
+// and the proceeds are added to the pool's reserves, so that the LPs keep its value.
+// Returns error if the pool is not a balancer pool, if it is linked to a concentrated pool for migration,
+// if the exchange fails, or if the pool fails to remove the asset.
+func (k Keeper) removeBalancerPoolAsset(ctx sdk.Context, poolId uint64, denom string, exchangeRoute poolmanagertypes.SwapAmountInRoute, exchangeMinAmount sdk.Int) (sdk.Coin, sdk.Int, error) {
+	pool, err := k.getBalancerPoolForAssetsChange(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	tokenIn, err := k.exchangeRemovedPoolAsset(ctx, pool, denom, exchangeRoute, exchangeMinAmount)
+	if err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	tokenOut, sharesValue, err := pool.RemovePoolAsset(denom, tokenIn)
+	if err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	return tokenOut, sharesValue, k.applyRemovePoolAsset(ctx, pool, tokenOut, tokenIn, sharesValue)
+}
+
+// addStableSwapPoolAsset adds the given token to the stableswap pool with the given id, with the given scaling factor.
+// The community pool deposits the token, and receives the shares it is worth.
+// Returns error if the pool is not a stableswap pool, if its scaling factors are delegated to a rate source,
+// if it is linked to a concentrated pool for migration, if the pool fails to add the asset,
+// or if the community pool lacks the token.
+func (k Keeper) addStableSwapPoolAsset(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, scalingFactor uint64) (sdk.Int, error) {
+	pool, err := k.getStableswapPoolForAssetsChange(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	sharesOut, err := pool.AddPoolAsset(tokenIn, scalingFactor)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return sharesOut, k.applyAddPoolAsset(ctx, pool, tokenIn, sharesOut)
+}
+
+// removeStableSwapPoolAsset removes the asset with the given denom from the stableswap pool with the given id.
+// Its whole balance is exchanged along the given route into another pool asset, see exchangeRemovedPoolAsset,
+// and the proceeds are added to the pool's reserves, so that the LPs keep its value.
+// Returns error if the pool is not a stableswap pool, if its scaling factors are delegated to a rate source,
+// if it is linked to a concentrated pool for migration, if the exchange fails, or if the pool fails to remove the asset.
+func (k Keeper) removeStableSwapPoolAsset(ctx sdk.Context, poolId uint64, denom string, exchangeRoute poolmanagertypes.SwapAmountInRoute, exchangeMinAmount sdk.Int) (sdk.Coin, sdk.Int, error) {
+	pool, err := k.getStableswapPoolForAssetsChange(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	tokenIn, err := k.exchangeRemovedPoolAsset(ctx, pool, denom, exchangeRoute, exchangeMinAmount)
+	if err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	tokenOut, sharesValue, err := pool.RemovePoolAsset(ctx, denom, tokenIn)
+	if err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	return tokenOut, sharesValue, k.applyRemovePoolAsset(ctx, pool, tokenOut, tokenIn, sharesValue)
+}
+
+func (k Keeper) getBalancerPoolForAssetsChange(ctx sdk.Context, poolId uint64) (*balancer.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return nil, fmt.Errorf("pool id %d is not of type balancer pool", poolId)
+	}
+	if err := k.validateNotLinkedToConcentratedPool(ctx, poolId); err != nil {
+		return nil, err
+	}
+	return balancerPool, nil
+}
+
+func (k Keeper) getStableswapPoolForAssetsChange(ctx sdk.Context, poolId uint64) (*stableswap.Pool, error) {
+	// The rate source covers exactly the pool assets, so it must be removed before changing them.
+	if k.hasScalingFactorRateSource(ctx, poolId) {
+		return nil, types.ScalingFactorsDelegatedError{PoolId: poolId}
+	}
+	if err := k.validateNotLinkedToConcentratedPool(ctx, poolId); err != nil {
+		return nil, err
+	}
+	return k.getStableswapPool(ctx, poolId)
+}
+
+// validateNotLinkedToConcentratedPool returns error if the pool is linked to a concentrated pool for migration,
+// as the migration assumes the pool assets match the concentrated pool ones.
+func (k Keeper) validateNotLinkedToConcentratedPool(ctx sdk.Context, poolId uint64) error {
+	if concentratedPoolId, err := k.GetLinkedConcentratedPoolID(ctx, poolId); err == nil {
+		return types.PoolLinkedToConcentratedPoolError{PoolId: poolId, ConcentratedPoolId: concentratedPoolId}
+	}
+	return nil
+}
+
+// applyAddPoolAsset applies the state changes of a pool that added the given token in for the given shares out.
+// The token in is paid from the community pool, and the shares out are sent to the community pool.
+// The pool's address is used as an intermediary, as module accounts can not receive funds.
+func (k Keeper) applyAddPoolAsset(ctx sdk.Context, pool types.CFMMPoolI, tokenIn sdk.Coin, sharesOut sdk.Int) error {
+	poolAddress := pool.GetAddress()
+	if err := k.communityPoolKeeper.DistributeFromFeePool(ctx, sdk.NewCoins(tokenIn), poolAddress); err != nil {
+		return err
+	}
+	if err := k.MintPoolShareToAccount(ctx, pool, poolAddress, sharesOut); err != nil {
+		return err
+	}
+	if err := k.communityPoolKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(pool.GetId()), sharesOut)), poolAddress); err != nil {
+		return err
+	}
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+	k.RecordTotalLiquidityIncrease(ctx, sdk.NewCoins(tokenIn))
+	k.hooks.AfterCFMMPoolAssetsChanged(ctx, pool.GetId())
+
+	emitPoolAssetsChangeEvent(ctx, types.TypeEvtAddPoolAsset, pool.GetId(), tokenIn, sharesOut)
+	return nil
+}
+
+// exchangeRemovedPoolAsset swaps the pool's whole balance of the given denom along the given route,
+// from the pool's address, for at least exchangeMinAmount of the route's token out denom, and returns the proceeds.
+// The route must be through another pool, and its token out denom must be another asset of the pool.
+func (k Keeper) exchangeRemovedPoolAsset(ctx sdk.Context, pool types.CFMMPoolI, denom string, exchangeRoute poolmanagertypes.SwapAmountInRoute, exchangeMinAmount sdk.Int) (sdk.Coin, error) {
+	if exchangeRoute.PoolId == pool.GetId() {
+		return sdk.Coin{}, fmt.Errorf("pool id %d can not exchange its own removed asset", pool.GetId())
+	}
+	poolLiquidity := pool.GetTotalPoolLiquidity(ctx)
+	tokenOut := sdk.NewCoin(denom, poolLiquidity.AmountOf(denom))
+	if !tokenOut.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrDenomNotFoundInPool, "denom %s is not an asset of pool %d", denom, pool.GetId())
+	}
+	if exchangeRoute.TokenOutDenom == denom || !poolLiquidity.AmountOf(exchangeRoute.TokenOutDenom).IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrDenomNotFoundInPool, "denom %s is not another asset of pool %d", exchangeRoute.TokenOutDenom, pool.GetId())
+	}
+
+	tokenInAmount, err := k.poolManager.RouteExactAmountIn(ctx, pool.GetAddress(), []poolmanagertypes.SwapAmountInRoute{exchangeRoute}, tokenOut, exchangeMinAmount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(exchangeRoute.TokenOutDenom, tokenInAmount), nil
+}
+
+// applyRemovePoolAsset applies the state changes of a pool that removed the given token out in exchange for
+// the given token in, which was worth the given shares. The tokens were already exchanged from the pool's address,
+// and the total shares are unchanged.
+func (k Keeper) applyRemovePoolAsset(ctx sdk.Context, pool types.CFMMPoolI, tokenOut, tokenIn sdk.Coin, sharesValue sdk.Int) error {
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+	k.RecordTotalLiquidityDecrease(ctx, sdk.NewCoins(tokenOut))
+	k.RecordTotalLiquidityIncrease(ctx, sdk.NewCoins(tokenIn))
+	k.hooks.AfterCFMMPoolAssetsChanged(ctx, pool.GetId())
+
+	emitPoolAssetsChangeEvent(ctx, types.TypeEvtRemovePoolAsset, pool.GetId(), tokenOut, sharesValue,
+		sdk.NewAttribute(types.AttributeKeyTokensIn, tokenIn.String()))
+	return nil
+}
+
+func emitPoolAssetsChangeEvent(ctx sdk.Context, eventType string, poolId uint64, token sdk.Coin, shares sdk.Int, attributes ...sdk.Attribute) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, token.String()),
+			sdk.NewAttribute(types.AttributeKeyShareAmount, shares.String()),
+		}, attributes...)...,
+	))
+}
@@ -0,0 +1,421 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+const newPoolAssetDenom = "qux"
+
+// prepareBalancerPoolForAssetsChange creates a balancer pool of the default pool assets, and funds the community
+// pool with the denom of the asset to add and with all the pool's shares.
+func (s *KeeperTestSuite) prepareBalancerPoolForAssetsChange() uint64 {
+	s.fundAllAccountsWith(apptesting.DefaultAcctFunds.Add(sdk.NewInt64Coin(newPoolAssetDenom, 10_000_000_000)))
+	msg := balancer.NewMsgCreateBalancerPool(s.TestAccs[0], balancer.PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec()}, apptesting.DefaultPoolAssets, "")
+	poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, msg)
+	s.Require().NoError(err)
+	s.fundCommunityPoolForAssetsChange(poolId)
+	return poolId
+}
+
+// prepareStableswapPoolForAssetsChange creates a stableswap pool of the default stableswap liquidity, and funds the
+// community pool with the denom of the asset to add and with all the pool's shares.
+func (s *KeeperTestSuite) prepareStableswapPoolForAssetsChange() uint64 {
+	s.fundAllAccountsWith(apptesting.DefaultAcctFunds.Add(sdk.NewInt64Coin(newPoolAssetDenom, 10_000_000_000)))
+	msg := stableswap.NewMsgCreateStableswapPool(s.TestAccs[0], stableswap.PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec()}, apptesting.DefaultStableswapLiquidity, []uint64{1, 1, 1}, "")
+	msg.ScalingFactorController = s.TestAccs[0].String()
+	poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, msg)
+	s.Require().NoError(err)
+	s.fundCommunityPoolForAssetsChange(poolId)
+	return poolId
+}
+
+// fundCommunityPoolForAssetsChange funds the community pool with the denom of the asset to add,
+// and with all the shares of the given pool held by the first test account.
+func (s *KeeperTestSuite) fundCommunityPoolForAssetsChange(poolId uint64) {
+	shareDenom := types.GetPoolShareDenom(poolId)
+	funds := sdk.NewCoins(
+		sdk.NewInt64Coin(newPoolAssetDenom, 1_000_000_000),
+		s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], shareDenom),
+	)
+	s.Require().NoError(s.App.DistrKeeper.FundCommunityPool(s.Ctx, funds, s.TestAccs[0]))
+}
+
+// assertPoolAssetsChanged asserts that the pool liquidity and the community pool reflect the tokens exchanged,
+// and that the twap records track exactly the pairs of the pool's new assets.
+func (s *KeeperTestSuite) assertPoolAssetsChanged(poolId uint64, liquidityBefore, expectedCommunityPool sdk.Coins, tokenIn, tokenOut sdk.Coins, eventType string) {
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	expectedLiquidity := liquidityBefore.Add(tokenIn...).Sub(tokenOut)
+	s.Require().Equal(expectedLiquidity, pool.GetTotalPoolLiquidity(s.Ctx))
+	s.Require().Equal(expectedLiquidity, s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress()))
+
+	s.Require().Equal(expectedCommunityPool, s.communityPoolBalances())
+	s.Require().Equal(s.App.BankKeeper.GetSupply(s.Ctx, types.GetPoolShareDenom(poolId)).Amount, pool.GetTotalShares())
+
+	records, err := s.App.TwapKeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	numAssets := len(expectedLiquidity)
+	s.Require().Len(records, numAssets*(numAssets-1)/2)
+	for _, record := range records {
+		s.Require().True(expectedLiquidity.AmountOf(record.Asset0Denom).IsPositive())
+		s.Require().True(expectedLiquidity.AmountOf(record.Asset1Denom).IsPositive())
+	}
+
+	s.AssertEventEmitted(s.Ctx, eventType, 1)
+}
+
+// assertRemovePoolAssetEvent asserts that the remove pool asset event reports the given token in and shares value.
+func (s *KeeperTestSuite) assertRemovePoolAssetEvent(tokenIn sdk.Coin, expectedSharesValue sdk.Int) {
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != types.TypeEvtRemovePoolAsset {
+			continue
+		}
+		attributes := map[string]string{}
+		for _, attribute := range event.Attributes {
+			attributes[string(attribute.Key)] = string(attribute.Value)
+		}
+		s.Require().Equal(tokenIn.String(), attributes[types.AttributeKeyTokensIn])
+		s.Require().Equal(expectedSharesValue.String(), attributes[types.AttributeKeyShareAmount])
+		return
+	}
+	s.Fail("remove pool asset event not emitted")
+}
+
+func (s *KeeperTestSuite) communityPoolBalances() sdk.Coins {
+	coins, _ := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx).TruncateDecimal()
+	return coins
+}
+
+func (s *KeeperTestSuite) TestHandleAddPoolAssetProposal_Balancer() {
+	// priced like foo, which has a balance of 5_000_000 for a weight of 100
+	poolAsset := balancer.PoolAsset{Weight: sdk.NewInt(250), Token: sdk.NewInt64Coin(newPoolAssetDenom, 12_500_000)}
+
+	tests := map[string]struct {
+		poolAsset         balancer.PoolAsset
+		linkedToCLPool    bool
+		expectedSharesOut sdk.Int
+		expectedErr       error
+	}{
+		"add asset": {
+			poolAsset: poolAsset,
+			// the new weight is a quarter of the previous total weight
+			expectedSharesOut: types.InitPoolSharesSupply.QuoRaw(4),
+		},
+		"error: community pool lacks the token": {
+			poolAsset:   balancer.PoolAsset{Weight: sdk.NewInt(250), Token: sdk.NewInt64Coin(newPoolAssetDenom, 1_000_000_001)},
+			expectedErr: distributiontypes.ErrBadDistribution,
+		},
+		"error: pool linked to a concentrated pool": {
+			poolAsset:      poolAsset,
+			linkedToCLPool: true,
+			expectedErr:    types.PoolLinkedToConcentratedPoolError{PoolId: 1, ConcentratedPoolId: 2},
+		},
+		"error: denom already in pool": {
+			poolAsset:   balancer.PoolAsset{Weight: sdk.NewInt(250), Token: sdk.NewInt64Coin(apptesting.FOO, 1_250_000)},
+			expectedErr: types.ErrDenomAlreadyInPool,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId := s.prepareBalancerPoolForAssetsChange()
+			if tc.linkedToCLPool {
+				s.App.GAMMKeeper.SetMigrationRecords(s.Ctx, types.MigrationRecords{BalancerToConcentratedPoolLinks: []types.BalancerToConcentratedPoolLink{
+					{BalancerPoolId: poolId, ClPoolId: 2},
+				}})
+			}
+			pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().NoError(err)
+			liquidityBefore := pool.GetTotalPoolLiquidity(s.Ctx)
+			communityPoolBefore := s.communityPoolBalances()
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			err = s.App.GAMMKeeper.HandleAddPoolAssetProposal(s.Ctx, &types.AddPoolAssetProposal{
+				Title:       "add pool asset",
+				Description: "add pool asset",
+				PoolId:      poolId,
+				Token:       tc.poolAsset.Token,
+				Weight:      tc.poolAsset.Weight,
+			})
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			expectedCommunityPool := communityPoolBefore.Sub(sdk.NewCoins(tc.poolAsset.Token)).Add(sdk.NewCoin(types.GetPoolShareDenom(poolId), tc.expectedSharesOut))
+			s.assertPoolAssetsChanged(poolId, liquidityBefore, expectedCommunityPool, sdk.NewCoins(tc.poolAsset.Token), sdk.NewCoins(), types.TypeEvtAddPoolAsset)
+
+			spotPrice, err := s.App.GAMMKeeper.CalculateSpotPrice(s.Ctx, poolId, apptesting.FOO, newPoolAssetDenom)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.OneDec(), spotPrice)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestHandleRemovePoolAssetProposal_Balancer() {
+	// the weight is three tenths of the total weight
+	expectedSharesValue := types.InitPoolSharesSupply.MulRaw(3).QuoRaw(10)
+
+	tests := map[string]struct {
+		denom             string
+		exchangeDenom     string
+		exchangeMinAmount sdk.Int
+		selfExchange      bool
+		expectedErr       string
+	}{
+		"remove asset": {
+			denom:             apptesting.BAZ,
+			exchangeDenom:     apptesting.FOO,
+			exchangeMinAmount: sdk.OneInt(),
+		},
+		"error: exchange proceeds below min amount": {
+			denom:             apptesting.BAZ,
+			exchangeDenom:     apptesting.FOO,
+			exchangeMinAmount: sdk.NewInt(1_000_000_000),
+			expectedErr:       types.ErrLimitMinAmount.Error(),
+		},
+		"error: exchange denom not in pool": {
+			denom:             apptesting.BAZ,
+			exchangeDenom:     newPoolAssetDenom,
+			exchangeMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrDenomNotFoundInPool.Error(),
+		},
+		"error: exchange in the pool itself": {
+			denom:             apptesting.BAZ,
+			exchangeDenom:     apptesting.FOO,
+			exchangeMinAmount: sdk.OneInt(),
+			selfExchange:      true,
+			expectedErr:       "can not exchange its own removed asset",
+		},
+		"error: denom not in pool": {
+			denom:             newPoolAssetDenom,
+			exchangeDenom:     apptesting.FOO,
+			exchangeMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrDenomNotFoundInPool.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId := s.prepareBalancerPoolForAssetsChange()
+			exchangePoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(apptesting.BAZ, 1_000_000_000), sdk.NewInt64Coin(apptesting.FOO, 1_000_000_000))
+			if tc.selfExchange {
+				exchangePoolId = poolId
+			}
+			pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().NoError(err)
+			liquidityBefore := pool.GetTotalPoolLiquidity(s.Ctx)
+			exchangeLiquidityBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, poolmanagertypes.NewPoolAddress(exchangePoolId))
+			communityPoolBefore := s.communityPoolBalances()
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			err = s.App.GAMMKeeper.HandleRemovePoolAssetProposal(s.Ctx, &types.RemovePoolAssetProposal{
+				Title:             "remove pool asset",
+				Description:       "remove pool asset",
+				PoolId:            poolId,
+				Denom:             tc.denom,
+				ExchangePoolId:    exchangePoolId,
+				ExchangeDenom:     tc.exchangeDenom,
+				ExchangeMinAmount: tc.exchangeMinAmount,
+			})
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the removed reserve is exchanged for the exchange pool's proceeds, without touching the community pool
+			tokenOut := sdk.NewCoin(tc.denom, liquidityBefore.AmountOf(tc.denom))
+			exchangeLiquidityAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, poolmanagertypes.NewPoolAddress(exchangePoolId))
+			s.Require().Equal(exchangeLiquidityBefore.AmountOf(tc.denom).Add(tokenOut.Amount), exchangeLiquidityAfter.AmountOf(tc.denom))
+			tokenIn := sdk.NewCoin(tc.exchangeDenom, exchangeLiquidityBefore.AmountOf(tc.exchangeDenom).Sub(exchangeLiquidityAfter.AmountOf(tc.exchangeDenom)))
+			s.Require().True(tokenIn.Amount.GTE(tc.exchangeMinAmount))
+			s.assertPoolAssetsChanged(poolId, liquidityBefore, communityPoolBefore, sdk.NewCoins(tokenIn), sdk.NewCoins(tokenOut), types.TypeEvtRemovePoolAsset)
+
+			pool, err = s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(types.InitPoolSharesSupply, pool.GetTotalShares())
+			s.assertRemovePoolAssetEvent(tokenIn, expectedSharesValue)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestHandleAddPoolAssetProposal_StableSwap() {
+	tokenIn := sdk.NewInt64Coin(newPoolAssetDenom, 10_000_000)
+
+	tests := map[string]struct {
+		tokenIn           sdk.Coin
+		delegatedFactors  bool
+		linkedToCLPool    bool
+		expectedSharesOut sdk.Int
+		expectedErr       error
+	}{
+		"add asset": {
+			tokenIn: tokenIn,
+			// the token in is a third of the previous scaled liquidity
+			expectedSharesOut: types.InitPoolSharesSupply.QuoRaw(3),
+		},
+		"error: community pool lacks the token": {
+			tokenIn:     sdk.NewInt64Coin(newPoolAssetDenom, 1_000_000_001),
+			expectedErr: distributiontypes.ErrBadDistribution,
+		},
+		"error: scaling factors delegated to a rate source": {
+			tokenIn:          tokenIn,
+			delegatedFactors: true,
+			expectedErr:      types.ScalingFactorsDelegatedError{PoolId: 2},
+		},
+		"error: pool linked to a concentrated pool": {
+			tokenIn:        tokenIn,
+			linkedToCLPool: true,
+			expectedErr:    types.PoolLinkedToConcentratedPoolError{PoolId: 1, ConcentratedPoolId: 2},
+		},
+		"error: denom already in pool": {
+			tokenIn:     sdk.NewInt64Coin(apptesting.FOO, 10_000_000),
+			expectedErr: types.ErrDenomAlreadyInPool,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			var poolId uint64
+			if tc.delegatedFactors {
+				var twapPoolId uint64
+				twapPoolId, poolId = s.setupRateSourcePools()
+				rateSource := twapRateSource(poolId, twapPoolId, sdk.NewDecWithPrec(1, 1), "")
+				s.Require().NoError(s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, &rateSource, s.TestAccs[0].String()))
+			} else {
+				poolId = s.prepareStableswapPoolForAssetsChange()
+			}
+			if tc.linkedToCLPool {
+				s.App.GAMMKeeper.SetMigrationRecords(s.Ctx, types.MigrationRecords{BalancerToConcentratedPoolLinks: []types.BalancerToConcentratedPoolLink{
+					{BalancerPoolId: poolId, ClPoolId: 2},
+				}})
+			}
+			pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().NoError(err)
+			liquidityBefore := pool.GetTotalPoolLiquidity(s.Ctx)
+			communityPoolBefore := s.communityPoolBalances()
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			err = s.App.GAMMKeeper.HandleAddPoolAssetProposal(s.Ctx, &types.AddPoolAssetProposal{
+				Title:         "add pool asset",
+				Description:   "add pool asset",
+				PoolId:        poolId,
+				Token:         tc.tokenIn,
+				ScalingFactor: 1,
+			})
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			expectedCommunityPool := communityPoolBefore.Sub(sdk.NewCoins(tc.tokenIn)).Add(sdk.NewCoin(types.GetPoolShareDenom(poolId), tc.expectedSharesOut))
+			s.assertPoolAssetsChanged(poolId, liquidityBefore, expectedCommunityPool, sdk.NewCoins(tc.tokenIn), sdk.NewCoins(), types.TypeEvtAddPoolAsset)
+
+			pool, err = s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal([]uint64{1, 1, 1, 1}, pool.(*stableswap.Pool).GetScalingFactors())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestHandleRemovePoolAssetProposal_StableSwap() {
+	// the invariant of even reserves is their sum, so that the asset is a third of it, rounded up
+	expectedSharesValue := types.InitPoolSharesSupply.QuoRaw(3).AddRaw(1)
+
+	tests := map[string]struct {
+		denom             string
+		exchangeDenom     string
+		exchangeMinAmount sdk.Int
+		selfExchange      bool
+		expectedErr       string
+	}{
+		"remove asset": {
+			denom:             apptesting.BAZ,
+			exchangeDenom:     apptesting.FOO,
+			exchangeMinAmount: sdk.OneInt(),
+		},
+		"error: exchange proceeds below min amount": {
+			denom:             apptesting.BAZ,
+			exchangeDenom:     apptesting.FOO,
+			exchangeMinAmount: sdk.NewInt(1_000_000_000),
+			expectedErr:       types.ErrLimitMinAmount.Error(),
+		},
+		"error: exchange denom not in pool": {
+			denom:             apptesting.BAZ,
+			exchangeDenom:     newPoolAssetDenom,
+			exchangeMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrDenomNotFoundInPool.Error(),
+		},
+		"error: exchange in the pool itself": {
+			denom:             apptesting.BAZ,
+			exchangeDenom:     apptesting.FOO,
+			exchangeMinAmount: sdk.OneInt(),
+			selfExchange:      true,
+			expectedErr:       "can not exchange its own removed asset",
+		},
+		"error: denom not in pool": {
+			denom:             newPoolAssetDenom,
+			exchangeDenom:     apptesting.FOO,
+			exchangeMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrDenomNotFoundInPool.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId := s.prepareStableswapPoolForAssetsChange()
+			exchangePoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(apptesting.BAZ, 1_000_000_000), sdk.NewInt64Coin(apptesting.FOO, 1_000_000_000))
+			if tc.selfExchange {
+				exchangePoolId = poolId
+			}
+			pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().NoError(err)
+			liquidityBefore := pool.GetTotalPoolLiquidity(s.Ctx)
+			exchangeLiquidityBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, poolmanagertypes.NewPoolAddress(exchangePoolId))
+			communityPoolBefore := s.communityPoolBalances()
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			err = s.App.GAMMKeeper.HandleRemovePoolAssetProposal(s.Ctx, &types.RemovePoolAssetProposal{
+				Title:             "remove pool asset",
+				Description:       "remove pool asset",
+				PoolId:            poolId,
+				Denom:             tc.denom,
+				ExchangePoolId:    exchangePoolId,
+				ExchangeDenom:     tc.exchangeDenom,
+				ExchangeMinAmount: tc.exchangeMinAmount,
+			})
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the removed reserve is exchanged for the exchange pool's proceeds, without touching the community pool
+			tokenOut := sdk.NewCoin(tc.denom, liquidityBefore.AmountOf(tc.denom))
+			exchangeLiquidityAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, poolmanagertypes.NewPoolAddress(exchangePoolId))
+			s.Require().Equal(exchangeLiquidityBefore.AmountOf(tc.denom).Add(tokenOut.Amount), exchangeLiquidityAfter.AmountOf(tc.denom))
+			tokenIn := sdk.NewCoin(tc.exchangeDenom, exchangeLiquidityBefore.AmountOf(tc.exchangeDenom).Sub(exchangeLiquidityAfter.AmountOf(tc.exchangeDenom)))
+			s.Require().True(tokenIn.Amount.GTE(tc.exchangeMinAmount))
+			s.assertPoolAssetsChanged(poolId, liquidityBefore, communityPoolBefore, sdk.NewCoins(tokenIn), sdk.NewCoins(tokenOut), types.TypeEvtRemovePoolAsset)
+
+			pool, err = s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(types.InitPoolSharesSupply, pool.GetTotalShares())
+			s.assertRemovePoolAssetEvent(tokenIn, expectedSharesValue)
+		})
+	}
+}
@@ -518,6 +518,83 @@ func (s *KeeperTestSuite) TestSetStableSwapScalingFactors() {
 	}
 }
 
+func (s *KeeperTestSuite) TestHandleRampAmplificationProposal() {
+	tests := map[string]struct {
+		amplification       uint64
+		futureAmplification uint64
+		isBalancerPool      bool
+		expectedErr         error
+	}{
+		"ramp amplification without the scaling factor controller": {
+			amplification:       100,
+			futureAmplification: 500,
+		},
+		"error: pool does not use the Curve StableSwap invariant": {
+			futureAmplification: 500,
+			expectedErr:         types.NotAmplifiedPoolError{PoolId: defaultPoolId},
+		},
+		"error: amplification change too large": {
+			amplification:       100,
+			futureAmplification: 1001,
+			expectedErr:         types.AmplificationChangeTooLargeError{CurrentAmplification: sdk.NewDec(100), FutureAmplification: 1001},
+		},
+		"error: pool id is not of type stableswap pool": {
+			futureAmplification: 500,
+			isBalancerPool:      true,
+			expectedErr:         fmt.Errorf("pool id %d is not of type stableswap pool", defaultPoolId),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			if tc.isBalancerPool {
+				s.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+			} else {
+				s.FundAcc(s.TestAccs[0], defaultAcctFunds)
+				liquidity := sdk.NewCoins(sdk.NewCoin(defaultAcctFunds[0].Denom, defaultAcctFunds[0].Amount.QuoRaw(2)), sdk.NewCoin(defaultAcctFunds[1].Denom, defaultAcctFunds[1].Amount.QuoRaw(2)))
+				msg := stableswap.NewMsgCreateStableswapPool(s.TestAccs[0], defaultPoolParamsStableSwap, liquidity, []uint64{1, 1}, "")
+				// the controller is not the governance module account, and does not take part in the proposal
+				msg.ScalingFactorController = s.TestAccs[1].String()
+				msg.Amplification = tc.amplification
+				_, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, msg)
+				s.Require().NoError(err)
+			}
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(types.StableswapMinAmplificationRampDuration)).WithEventManager(sdk.NewEventManager())
+
+			rampDuration := 2 * types.StableswapMinAmplificationRampDuration
+			err := s.App.GAMMKeeper.HandleRampAmplificationProposal(s.Ctx, &types.RampAmplificationProposal{
+				Title:               "ramp amplification",
+				Description:         "ramp amplification",
+				PoolId:              defaultPoolId,
+				FutureAmplification: tc.futureAmplification,
+				RampDuration:        rampDuration,
+			})
+			if tc.expectedErr != nil {
+				s.Require().EqualError(err, tc.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtRampAmplification, 1)
+
+			// the ramp starts when the proposal is executed and lasts the ramp duration
+			rampStart := s.Ctx.BlockTime()
+			for elapsed, expectedAmplification := range map[time.Duration]sdk.Dec{
+				0:                sdk.NewDec(100),
+				rampDuration / 2: sdk.NewDec(300),
+				rampDuration:     sdk.NewDec(500),
+			} {
+				ctx := s.Ctx.WithBlockTime(rampStart.Add(elapsed))
+				pool, err := s.App.GAMMKeeper.GetPoolAndPoke(ctx, defaultPoolId)
+				s.Require().NoError(err)
+				stableswapPool, ok := pool.(*stableswap.Pool)
+				s.Require().True(ok)
+				s.Require().Equal(expectedAmplification, stableswapPool.GetAmplification(ctx))
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGetMaximalNoSwapLPAmount() {
 	tests := map[string]struct {
 		poolId              uint64
@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/cosmwasm"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+)
+
+// scalingFactorRateMsg is the query sent to the contract of a contract rate source.
+type scalingFactorRateMsg struct {
+	ScalingFactorRate scalingFactorRate `json:"scaling_factor_rate"`
+}
+
+type scalingFactorRate struct {
+	Denom          string `json:"denom"`
+	ReferenceDenom string `json:"reference_denom"`
+}
+
+// scalingFactorRateResponse is the response expected from the contract of a contract rate source.
+// Rate is the price of one unit of the denom in units of the reference denom.
+type scalingFactorRateResponse struct {
+	Rate sdk.Dec `json:"rate"`
+}
+
+// GetScalingFactorRateSource returns the rate source the scaling factors of the given stableswap pool are delegated to.
+// Returns error if the pool has no rate source.
+func (k Keeper) GetScalingFactorRateSource(ctx sdk.Context, poolId uint64) (types.ScalingFactorRateSource, error) {
+	rateSource := types.ScalingFactorRateSource{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.GetKeyScalingFactorRateSource(poolId), &rateSource)
+	if err != nil {
+		return types.ScalingFactorRateSource{}, err
+	}
+	if !found {
+		return types.ScalingFactorRateSource{}, types.ScalingFactorRateSourceNotFoundError{PoolId: poolId}
+	}
+	return rateSource, nil
+}
+
+// GetAllScalingFactorRateSources returns the scaling factor rate sources of all stableswap pools, ordered by pool id.
+func (k Keeper) GetAllScalingFactorRateSources(ctx sdk.Context) ([]types.ScalingFactorRateSource, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixScalingFactorRateSource, func(bz []byte) (types.ScalingFactorRateSource, error) {
+		rateSource := types.ScalingFactorRateSource{}
+		err := k.cdc.Unmarshal(bz, &rateSource)
+		return rateSource, err
+	})
+}
+
+func (k Keeper) hasScalingFactorRateSource(ctx sdk.Context, poolId uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetKeyScalingFactorRateSource(poolId))
+}
+
+func (k Keeper) setScalingFactorRateSourceUnsafe(ctx sdk.Context, rateSource types.ScalingFactorRateSource) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.GetKeyScalingFactorRateSource(rateSource.PoolId), &rateSource)
+}
+
+// setScalingFactorRateSource delegates the scaling factors of the given stableswap pool to the given rate source,
+// replacing the current one if any, and updates them right away. A nil rate source removes the current one.
+// Returns error if the sender is not the pool's scaling factor controller, if the rate source is invalid or does not
+// cover exactly the pool assets other than its reference denom, or if the scaling factors fail to update.
+func (k Keeper) setScalingFactorRateSource(ctx sdk.Context, poolId uint64, rateSource *types.ScalingFactorRateSource, sender string) error {
+	pool, err := k.getStableswapPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if sender != pool.ScalingFactorController {
+		return types.ErrNotScalingFactorGovernor
+	}
+
+	if rateSource == nil {
+		if !k.hasScalingFactorRateSource(ctx, poolId) {
+			return types.ScalingFactorRateSourceNotFoundError{PoolId: poolId}
+		}
+		ctx.KVStore(k.storeKey).Delete(types.GetKeyScalingFactorRateSource(poolId))
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtRemoveScalingFactorRateSource,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		))
+		return nil
+	}
+
+	if err := validateScalingFactorRateSource(pool, *rateSource); err != nil {
+		return err
+	}
+	k.setScalingFactorRateSourceUnsafe(ctx, *rateSource)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSetScalingFactorRateSource,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+	))
+
+	return k.updateScalingFactorsFromRateSourceWithGasLimit(ctx, *rateSource)
+}
+
+// validateScalingFactorRateSource validates the given rate source, and that its reference denom and the denoms of
+// its denom rate sources are exactly the assets of the given pool.
+func validateScalingFactorRateSource(pool *stableswap.Pool, rateSource types.ScalingFactorRateSource) error {
+	if err := rateSource.Validate(); err != nil {
+		return err
+	}
+	if rateSource.PoolId != pool.GetId() {
+		return fmt.Errorf("rate source pool id (%d) does not match pool id (%d)", rateSource.PoolId, pool.GetId())
+	}
+
+	denomsMismatchErr := types.RateSourceDenomsMismatchError{PoolId: pool.GetId(), ReferenceDenom: rateSource.ReferenceDenom}
+	if len(rateSource.DenomRateSources) != len(pool.PoolLiquidity)-1 {
+		return denomsMismatchErr
+	}
+	// Denoms are unique by the stateless validation, so it suffices that each of them is in the pool.
+	if !pool.PoolLiquidity.AmountOf(rateSource.ReferenceDenom).IsPositive() {
+		return denomsMismatchErr
+	}
+	for _, denomRateSource := range rateSource.DenomRateSources {
+		if !pool.PoolLiquidity.AmountOf(denomRateSource.Denom).IsPositive() {
+			return denomsMismatchErr
+		}
+	}
+	return nil
+}
+
+// UpdateScalingFactorsFromRateSources updates the scaling factors of every stableswap pool whose rate source updates
+// at the end of the epochs with the given identifier, or every block if the identifier is empty.
+// A failed update, including one running out of gas, is logged and leaves the pool's scaling factors unchanged,
+// without affecting the other pools.
+func (k Keeper) UpdateScalingFactorsFromRateSources(ctx sdk.Context, epochIdentifier string) {
+	rateSources, err := k.GetAllScalingFactorRateSources(ctx)
+	if err != nil {
+		ctx.Logger().Error(err.Error())
+		return
+	}
+
+	for _, rateSource := range rateSources {
+		if rateSource.EpochIdentifier != epochIdentifier {
+			continue
+		}
+		rateSource := rateSource
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.updateScalingFactorsFromRateSourceWithGasLimit(cacheCtx, rateSource)
+		})
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to update the scaling factors of pool %d from its rate source: %s", rateSource.PoolId, err))
+		}
+	}
+}
+
+// updateScalingFactorsFromRateSourceWithGasLimit runs updateScalingFactorsFromRateSource with a gas meter limited to
+// ScalingFactorRateSourceUpdateGasLimit, so that a rate source cannot consume unbounded gas, e.g. in the end blocker.
+// The gas consumed is charged to the given context.
+// Returns error if the update runs out of gas.
+func (k Keeper) updateScalingFactorsFromRateSourceWithGasLimit(ctx sdk.Context, rateSource types.ScalingFactorRateSource) (err error) {
+	gasMeter := sdk.NewGasMeter(types.ScalingFactorRateSourceUpdateGasLimit)
+	defer func() {
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "scaling factor rate source update")
+		if recoveryError := recover(); recoveryError != nil {
+			if isOutOfGas, _ := osmoutils.IsOutOfGasError(recoveryError); !isOutOfGas {
+				panic(recoveryError)
+			}
+			err = types.RateSourceOutOfGasError{PoolId: rateSource.PoolId, GasLimit: types.ScalingFactorRateSourceUpdateGasLimit}
+		}
+	}()
+	return k.updateScalingFactorsFromRateSource(ctx.WithGasMeter(gasMeter), rateSource)
+}
+
+// updateScalingFactorsFromRateSource sets the scaling factor of every asset of the rate source's pool, other than its
+// reference denom, to the scaling factor of the reference denom divided by the asset's rate. Each scaling factor is
+// clamped to change by at most the rate source's maximum change per update, so that a faulty or manipulated rate
+// can only move the pool off peg gradually.
+func (k Keeper) updateScalingFactorsFromRateSource(ctx sdk.Context, rateSource types.ScalingFactorRateSource) error {
+	pool, err := k.getStableswapPool(ctx, rateSource.PoolId)
+	if err != nil {
+		return err
+	}
+
+	oldScalingFactors := pool.GetScalingFactors()
+	referenceScalingFactor := sdk.NewDecFromInt(sdk.NewIntFromUint64(pool.GetScalingFactorByDenom(rateSource.ReferenceDenom)))
+	newScalingFactors := make([]uint64, len(oldScalingFactors))
+	copy(newScalingFactors, oldScalingFactors)
+	changed := false
+
+	for _, denomRateSource := range rateSource.DenomRateSources {
+		rate, err := k.getScalingFactorRate(ctx, rateSource.ReferenceDenom, denomRateSource)
+		if err != nil {
+			return err
+		}
+		if rate.IsNil() || !rate.IsPositive() {
+			return types.NonPositiveRateError{Denom: denomRateSource.Denom, Rate: rate}
+		}
+
+		for i, coin := range pool.PoolLiquidity {
+			if coin.Denom != denomRateSource.Denom {
+				continue
+			}
+			oldScalingFactor := sdk.NewDecFromInt(sdk.NewIntFromUint64(oldScalingFactors[i]))
+			maxChange := oldScalingFactor.Mul(rateSource.MaxChangePerUpdate)
+			newScalingFactor := referenceScalingFactor.Quo(rate)
+			newScalingFactor = sdk.MaxDec(newScalingFactor, oldScalingFactor.Sub(maxChange))
+			newScalingFactor = sdk.MinDec(newScalingFactor, oldScalingFactor.Add(maxChange))
+
+			newScalingFactorInt := sdk.MaxInt(newScalingFactor.RoundInt(), sdk.OneInt())
+			if !newScalingFactorInt.IsUint64() {
+				return types.ErrInvalidScalingFactors
+			}
+			newScalingFactors[i] = newScalingFactorInt.Uint64()
+			changed = changed || newScalingFactors[i] != oldScalingFactors[i]
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	// The rate source acts on behalf of the scaling factor controller, so that the new scaling factors
+	// go through the same validation as the ones set manually.
+	if err := pool.SetScalingFactors(ctx, newScalingFactors, pool.ScalingFactorController); err != nil {
+		return err
+	}
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtUpdateScalingFactors,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.GetId(), 10)),
+		sdk.NewAttribute(types.AttributeKeyOldScalingFactors, fmt.Sprint(oldScalingFactors)),
+		sdk.NewAttribute(types.AttributeKeyScalingFactors, fmt.Sprint(newScalingFactors)),
+	))
+	return nil
+}
+
+// getScalingFactorRate returns the price of one unit of the denom of the given denom rate source in units of the
+// reference denom, read from either a twap or a contract.
+func (k Keeper) getScalingFactorRate(ctx sdk.Context, referenceDenom string, denomRateSource types.DenomRateSource) (sdk.Dec, error) {
+	if denomRateSource.Twap != nil {
+		startTime := ctx.BlockTime().Add(-denomRateSource.Twap.Duration)
+		return k.twapKeeper.GetArithmeticTwapToNow(ctx, denomRateSource.Twap.PoolId, denomRateSource.Denom, referenceDenom, startTime)
+	}
+
+	response, err := cosmwasm.Query[scalingFactorRateMsg, scalingFactorRateResponse](ctx, k.wasmKeeper, denomRateSource.ContractAddress, scalingFactorRateMsg{
+		ScalingFactorRate: scalingFactorRate{
+			Denom:          denomRateSource.Denom,
+			ReferenceDenom: referenceDenom,
+		},
+	})
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return response.Rate, nil
+}
+
+func (k Keeper) getStableswapPool(ctx sdk.Context, poolId uint64) (*stableswap.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return nil, fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	return stableswapPool, nil
+}
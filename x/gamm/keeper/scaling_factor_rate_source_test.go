@@ -0,0 +1,264 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+)
+
+// mockWasmKeeper answers scaling factor rate queries with a fixed rate, consuming the given gas.
+type mockWasmKeeper struct {
+	rate string
+	gas  uint64
+}
+
+func (m mockWasmKeeper) QuerySmart(ctx sdk.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error) {
+	ctx.GasMeter().ConsumeGas(m.gas, "mock contract query")
+	query := map[string]map[string]string{}
+	if err := json.Unmarshal(queryMsg, &query); err != nil {
+		return nil, err
+	}
+	if query["scaling_factor_rate"]["denom"] != "foo" || query["scaling_factor_rate"]["reference_denom"] != "bar" {
+		return nil, errors.New("unexpected query")
+	}
+	return []byte(fmt.Sprintf(`{"rate":"%s"}`, m.rate)), nil
+}
+
+var defaultRateSourceScalingFactors = []uint64{1_000_000, 1_000_000}
+
+// setupRateSourcePools creates a balancer pool pricing foo at 1.05 bar, and a stableswap pool of foo and bar whose
+// scaling factor controller is the first test account. It then moves the block time forward, so that the balancer
+// pool has two hours of twap history. Returns the ids of the balancer and stableswap pools.
+func (s *KeeperTestSuite) setupRateSourcePools() (uint64, uint64) {
+	twapPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 1_000_000_000), sdk.NewInt64Coin("bar", 1_050_000_000))
+
+	poolLiquidity := sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000_000), sdk.NewInt64Coin("bar", 1_000_000_000))
+	s.fundAllAccountsWith(poolLiquidity)
+	msg := stableswap.NewMsgCreateStableswapPool(s.TestAccs[0], stableswap.PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec()}, poolLiquidity, defaultRateSourceScalingFactors, "")
+	msg.ScalingFactorController = s.TestAccs[0].String()
+	stableswapPoolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, msg)
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(2 * time.Hour))
+	return twapPoolId, stableswapPoolId
+}
+
+func twapRateSource(poolId, twapPoolId uint64, maxChangePerUpdate sdk.Dec, epochIdentifier string) types.ScalingFactorRateSource {
+	return types.ScalingFactorRateSource{
+		PoolId:         poolId,
+		ReferenceDenom: "bar",
+		DenomRateSources: []types.DenomRateSource{
+			{Denom: "foo", Twap: &types.TwapRateSource{PoolId: twapPoolId, Duration: time.Hour}},
+		},
+		MaxChangePerUpdate: maxChangePerUpdate,
+		EpochIdentifier:    epochIdentifier,
+	}
+}
+
+func (s *KeeperTestSuite) TestSetScalingFactorRateSource() {
+	contractAddress := s.TestAccs[2].String()
+
+	tests := map[string]struct {
+		rateSource             func(poolId, twapPoolId uint64) *types.ScalingFactorRateSource
+		sender                 func() string
+		wasmRate               string
+		wasmGas                uint64
+		expectedScalingFactors []uint64
+		expectedErr            error
+	}{
+		"twap rate source": {
+			rateSource: func(poolId, twapPoolId uint64) *types.ScalingFactorRateSource {
+				rateSource := twapRateSource(poolId, twapPoolId, sdk.NewDecWithPrec(1, 1), "")
+				return &rateSource
+			},
+			// the scaling factor of foo is 1_000_000 / 1.05, rounded
+			expectedScalingFactors: []uint64{1_000_000, 952_381},
+		},
+		"twap rate source, clamped to the max change": {
+			rateSource: func(poolId, twapPoolId uint64) *types.ScalingFactorRateSource {
+				rateSource := twapRateSource(poolId, twapPoolId, sdk.NewDecWithPrec(1, 2), "")
+				return &rateSource
+			},
+			expectedScalingFactors: []uint64{1_000_000, 990_000},
+		},
+		"contract rate source": {
+			rateSource: func(poolId, twapPoolId uint64) *types.ScalingFactorRateSource {
+				rateSource := twapRateSource(poolId, twapPoolId, sdk.NewDecWithPrec(2, 1), "day")
+				rateSource.DenomRateSources[0] = types.DenomRateSource{Denom: "foo", ContractAddress: contractAddress}
+				return &rateSource
+			},
+			// the mock contract returns a rate of 1.1
+			expectedScalingFactors: []uint64{1_000_000, 909_091},
+		},
+		"error: sender is not the scaling factor controller": {
+			rateSource: func(poolId, twapPoolId uint64) *types.ScalingFactorRateSource {
+				rateSource := twapRateSource(poolId, twapPoolId, sdk.NewDecWithPrec(1, 1), "")
+				return &rateSource
+			},
+			sender:      func() string { return s.TestAccs[1].String() },
+			expectedErr: types.ErrNotScalingFactorGovernor,
+		},
+		"error: reference denom not in pool": {
+			rateSource: func(poolId, twapPoolId uint64) *types.ScalingFactorRateSource {
+				rateSource := twapRateSource(poolId, twapPoolId, sdk.NewDecWithPrec(1, 1), "")
+				rateSource.ReferenceDenom = "baz"
+				return &rateSource
+			},
+			expectedErr: types.RateSourceDenomsMismatchError{PoolId: 2, ReferenceDenom: "baz"},
+		},
+		"error: twap history shorter than the twap duration": {
+			rateSource: func(poolId, twapPoolId uint64) *types.ScalingFactorRateSource {
+				rateSource := twapRateSource(poolId, twapPoolId, sdk.NewDecWithPrec(1, 1), "")
+				rateSource.DenomRateSources[0].Twap.Duration = 3 * time.Hour
+				return &rateSource
+			},
+			expectedErr: errors.New("looking for a time thats too old"),
+		},
+		"error: twap pool does not have the denoms": {
+			rateSource: func(poolId, twapPoolId uint64) *types.ScalingFactorRateSource {
+				rateSource := twapRateSource(poolId, twapPoolId+2, sdk.NewDecWithPrec(1, 1), "")
+				return &rateSource
+			},
+			expectedErr: errors.New("querying for assets bar foo that are not in pool id 3"),
+		},
+		"error: non-positive contract rate": {
+			rateSource: func(poolId, twapPoolId uint64) *types.ScalingFactorRateSource {
+				rateSource := twapRateSource(poolId, twapPoolId, sdk.NewDecWithPrec(2, 1), "day")
+				rateSource.DenomRateSources[0] = types.DenomRateSource{Denom: "foo", ContractAddress: contractAddress}
+				return &rateSource
+			},
+			wasmRate:    "-1",
+			expectedErr: types.NonPositiveRateError{Denom: "foo", Rate: sdk.NewDec(-1)},
+		},
+		"error: contract rate source updating every block": {
+			rateSource: func(poolId, twapPoolId uint64) *types.ScalingFactorRateSource {
+				rateSource := twapRateSource(poolId, twapPoolId, sdk.NewDecWithPrec(2, 1), "")
+				rateSource.DenomRateSources[0] = types.DenomRateSource{Denom: "foo", ContractAddress: contractAddress}
+				return &rateSource
+			},
+			expectedErr: types.ContractRateSourceEveryBlockError{PoolId: 2, Denom: "foo"},
+		},
+		"error: contract query exceeds the gas limit": {
+			rateSource: func(poolId, twapPoolId uint64) *types.ScalingFactorRateSource {
+				rateSource := twapRateSource(poolId, twapPoolId, sdk.NewDecWithPrec(2, 1), "day")
+				rateSource.DenomRateSources[0] = types.DenomRateSource{Denom: "foo", ContractAddress: contractAddress}
+				return &rateSource
+			},
+			wasmGas:     types.ScalingFactorRateSourceUpdateGasLimit + 1,
+			expectedErr: types.RateSourceOutOfGasError{PoolId: 2, GasLimit: types.ScalingFactorRateSourceUpdateGasLimit},
+		},
+		"error: no rate source to remove": {
+			rateSource: func(poolId, twapPoolId uint64) *types.ScalingFactorRateSource {
+				return nil
+			},
+			expectedErr: types.ScalingFactorRateSourceNotFoundError{PoolId: 2},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			wasmRate := "1.1"
+			if tc.wasmRate != "" {
+				wasmRate = tc.wasmRate
+			}
+			s.App.GAMMKeeper.SetWasmKeeper(mockWasmKeeper{rate: wasmRate, gas: tc.wasmGas})
+			twapPoolId, poolId := s.setupRateSourcePools()
+
+			sender := s.TestAccs[0].String()
+			if tc.sender != nil {
+				sender = tc.sender()
+			}
+
+			rateSource := tc.rateSource(poolId, twapPoolId)
+			err := s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, rateSource, sender)
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			storedRateSource, err := s.App.GAMMKeeper.GetScalingFactorRateSource(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(*rateSource, storedRateSource)
+
+			pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedScalingFactors, pool.(*stableswap.Pool).GetScalingFactors())
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtUpdateScalingFactors, 1)
+
+			// the scaling factors can no longer be adjusted manually
+			err = s.App.GAMMKeeper.SetStableSwapScalingFactors(s.Ctx, poolId, defaultRateSourceScalingFactors, sender)
+			s.Require().ErrorIs(err, types.ScalingFactorsDelegatedError{PoolId: poolId})
+
+			// removing the rate source gives the control of the scaling factors back to the controller
+			s.Require().NoError(s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, nil, sender))
+			_, err = s.App.GAMMKeeper.GetScalingFactorRateSource(s.Ctx, poolId)
+			s.Require().ErrorIs(err, types.ScalingFactorRateSourceNotFoundError{PoolId: poolId})
+			s.Require().NoError(s.App.GAMMKeeper.SetStableSwapScalingFactors(s.Ctx, poolId, defaultRateSourceScalingFactors, sender))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateScalingFactorsFromRateSources() {
+	s.SetupTest()
+	twapPoolId, poolId := s.setupRateSourcePools()
+	controller := s.TestAccs[0].String()
+
+	rateSource := twapRateSource(poolId, twapPoolId, sdk.NewDecWithPrec(1, 2), "day")
+	s.Require().NoError(s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, &rateSource, controller))
+
+	getScalingFactors := func() []uint64 {
+		pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+		s.Require().NoError(err)
+		return pool.(*stableswap.Pool).GetScalingFactors()
+	}
+	s.Require().Equal([]uint64{1_000_000, 990_000}, getScalingFactors())
+
+	// the rate source does not update every block, nor at the end of other epochs
+	s.App.GAMMKeeper.UpdateScalingFactorsFromRateSources(s.Ctx, "")
+	s.Require().NoError(s.App.GAMMKeeper.EpochHooks().AfterEpochEnd(s.Ctx, "week", 1))
+	s.Require().Equal([]uint64{1_000_000, 990_000}, getScalingFactors())
+
+	// the scaling factor of foo moves by at most 1% per epoch towards 1_000_000 / 1.05, and then stays there
+	for _, expectedScalingFactor := range []uint64{980_100, 970_299, 960_596, 952_381, 952_381} {
+		s.Require().NoError(s.App.GAMMKeeper.EpochHooks().AfterEpochEnd(s.Ctx, "day", 1))
+		s.Require().Equal([]uint64{1_000_000, expectedScalingFactor}, getScalingFactors())
+	}
+
+	// a failed update is skipped, leaving the scaling factors unchanged
+	s.App.GAMMKeeper.SetWasmKeeper(mockWasmKeeper{rate: "1.05"})
+	rateSource.DenomRateSources[0] = types.DenomRateSource{Denom: "foo", ContractAddress: s.TestAccs[2].String()}
+	s.Require().NoError(s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, &rateSource, controller))
+	s.App.GAMMKeeper.SetWasmKeeper(mockWasmKeeper{rate: "0"})
+	s.Require().NoError(s.App.GAMMKeeper.EpochHooks().AfterEpochEnd(s.Ctx, "day", 1))
+	s.Require().Equal([]uint64{1_000_000, 952_381}, getScalingFactors())
+
+	// so is an update running out of gas, which consumes at most the gas limit, besides reading the rate sources
+	s.App.GAMMKeeper.SetWasmKeeper(mockWasmKeeper{rate: "1.1", gas: 10 * types.ScalingFactorRateSourceUpdateGasLimit})
+	gasBefore := s.Ctx.GasMeter().GasConsumed()
+	s.Require().NoError(s.App.GAMMKeeper.EpochHooks().AfterEpochEnd(s.Ctx, "day", 1))
+	s.Require().Equal([]uint64{1_000_000, 952_381}, getScalingFactors())
+	s.Require().Less(s.Ctx.GasMeter().GasConsumed()-gasBefore, uint64(2*types.ScalingFactorRateSourceUpdateGasLimit))
+}
+
+func (s *KeeperTestSuite) TestQueryScalingFactorRateSource() {
+	s.SetupTest()
+	twapPoolId, poolId := s.setupRateSourcePools()
+
+	_, err := s.queryClient.ScalingFactorRateSource(s.Ctx.Context(), &types.QueryScalingFactorRateSourceRequest{PoolId: poolId})
+	s.Require().Error(err)
+
+	rateSource := twapRateSource(poolId, twapPoolId, sdk.NewDecWithPrec(1, 2), "")
+	s.Require().NoError(s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, &rateSource, s.TestAccs[0].String()))
+
+	res, err := s.queryClient.ScalingFactorRateSource(s.Ctx.Context(), &types.QueryScalingFactorRateSourceRequest{PoolId: poolId})
+	s.Require().NoError(err)
+	s.Require().Equal(rateSource, res.RateSource)
+}
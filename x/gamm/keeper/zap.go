@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+// JoinPoolWithAnyToken swaps tokenIn along the given routes into the pool asset output by the last route,
+// splits it across the pool assets in the ratio of their value in the pool, and joins the pool with them via
+// JoinPoolNoSwap. With no routes, tokenIn must already be a pool asset.
+// If the split join fails, e.g. because the amount is too small to split, it falls back to joining the pool
+// with the whole routed token via JoinSwapExactAmountIn.
+// Any remainder of the split that the no-swap join can not use stays with the sender.
+// Slippage is only bounded by shareOutMinAmount, so the swap and the join either both succeed or the whole
+// message fails.
+// Returns error if the routed denom is not a pool asset, if the swap fails,
+// or if the shares out are less than shareOutMinAmount.
+func (k Keeper) JoinPoolWithAnyToken(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	routes []poolmanagertypes.SwapAmountInRoute,
+	shareOutMinAmount sdk.Int,
+) (sharesOut sdk.Int, err error) {
+	joinDenom := tokenIn.Denom
+	if len(routes) > 0 {
+		joinDenom = routes[len(routes)-1].TokenOutDenom
+	}
+	if err := k.validatePoolAssetDenom(ctx, poolId, joinDenom); err != nil {
+		return sdk.Int{}, err
+	}
+
+	joinCoin := tokenIn
+	if len(routes) > 0 {
+		joinAmount, err := k.poolManager.RouteExactAmountIn(ctx, sender, routes, tokenIn, sdk.OneInt())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		joinCoin = sdk.NewCoin(joinDenom, joinAmount)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	sharesOut, err = k.joinPoolWithSplitToken(cacheCtx, sender, poolId, joinCoin)
+	if err != nil {
+		ctx.Logger().Debug(fmt.Sprintf("split join of pool %d with %s failed, joining with the whole token: %s", poolId, joinCoin, err))
+		return k.JoinSwapExactAmountIn(ctx, sender, poolId, sdk.NewCoins(joinCoin), shareOutMinAmount)
+	}
+	if sharesOut.LT(shareOutMinAmount) {
+		return sdk.Int{}, errorsmod.Wrapf(types.ErrLimitMinAmount, "too much slippage; needed a minimum of %s shares to pass, got %s", shareOutMinAmount, sharesOut)
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return sharesOut, nil
+}
+
+// joinPoolWithSplitToken swaps the share of joinCoin matching each other pool asset's value in the pool into
+// that asset, within the pool itself, and joins the pool with the swapped tokens and the rest of joinCoin
+// via JoinPoolNoSwap, for the maximal number of shares they are worth.
+func (k Keeper) joinPoolWithSplitToken(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, joinCoin sdk.Coin) (sdk.Int, error) {
+	pool, err := k.GetCFMMPool(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	// the value of each pool asset, in joinCoin's denom
+	poolLiquidity := pool.GetTotalPoolLiquidity(ctx)
+	assetValues := make([]sdk.Dec, len(poolLiquidity))
+	totalValue := sdk.ZeroDec()
+	for i, asset := range poolLiquidity {
+		assetValues[i] = asset.Amount.ToDec()
+		if asset.Denom != joinCoin.Denom {
+			spotPrice, err := pool.SpotPrice(ctx, joinCoin.Denom, asset.Denom)
+			if err != nil {
+				return sdk.Int{}, err
+			}
+			assetValues[i] = assetValues[i].Mul(spotPrice)
+		}
+		totalValue = totalValue.Add(assetValues[i])
+	}
+
+	tokensIn := sdk.NewCoins()
+	remaining := joinCoin.Amount
+	for i, asset := range poolLiquidity {
+		if asset.Denom == joinCoin.Denom {
+			continue
+		}
+		swapAmount := joinCoin.Amount.ToDec().Mul(assetValues[i]).Quo(totalValue).TruncateInt()
+		tokenOutAmount, err := k.SwapExactAmountIn(ctx, sender, pool, sdk.NewCoin(joinCoin.Denom, swapAmount), asset.Denom, sdk.OneInt(), pool.GetSpreadFactor(ctx))
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokensIn = tokensIn.Add(sdk.NewCoin(asset.Denom, tokenOutAmount))
+		remaining = remaining.Sub(swapAmount)
+	}
+	tokensIn = tokensIn.Add(sdk.NewCoin(joinCoin.Denom, remaining))
+
+	pool, err = k.GetCFMMPool(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	numShares, _, err := pool.CalcJoinPoolNoSwapShares(ctx, tokensIn, pool.GetSpreadFactor(ctx))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	_, sharesOut, err := k.JoinPoolNoSwap(ctx, sender, poolId, numShares, tokensIn)
+	return sharesOut, err
+}
+
+// ExitPoolToSingleToken exits the pool to its asset of denom poolAssetDenom via ExitSwapShareAmountIn,
+// and swaps it along the given routes into the denom output by the last route.
+// With no routes, the pool asset is the token out.
+// Slippage is only bounded by tokenOutMinAmount, so the exit and the swap either both succeed or the whole
+// message fails.
+// Returns error if poolAssetDenom is not a pool asset, if the exit or the swap fails,
+// or if the token out amount is less than tokenOutMinAmount.
+func (k Keeper) ExitPoolToSingleToken(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	shareInAmount sdk.Int,
+	poolAssetDenom string,
+	routes []poolmanagertypes.SwapAmountInRoute,
+	tokenOutMinAmount sdk.Int,
+) (tokenOut sdk.Coin, err error) {
+	if err := k.validatePoolAssetDenom(ctx, poolId, poolAssetDenom); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if len(routes) == 0 {
+		tokenOutAmount, err := k.ExitSwapShareAmountIn(ctx, sender, poolId, poolAssetDenom, shareInAmount, tokenOutMinAmount)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		return sdk.NewCoin(poolAssetDenom, tokenOutAmount), nil
+	}
+
+	exitAmount, err := k.ExitSwapShareAmountIn(ctx, sender, poolId, poolAssetDenom, shareInAmount, sdk.ZeroInt())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	tokenOutAmount, err := k.poolManager.RouteExactAmountIn(ctx, sender, routes, sdk.NewCoin(poolAssetDenom, exitAmount), tokenOutMinAmount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(routes[len(routes)-1].TokenOutDenom, tokenOutAmount), nil
+}
+
+func (k Keeper) validatePoolAssetDenom(ctx sdk.Context, poolId uint64, denom string) error {
+	pool, err := k.GetCFMMPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if !pool.GetTotalPoolLiquidity(ctx).AmountOf(denom).IsPositive() {
+		return errorsmod.Wrapf(types.ErrDenomNotFoundInPool, "denom %s is not an asset of pool %d", denom, poolId)
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+const zapDenom = "uatom"
+
+// prepareZapPools creates a balancer pool of the default pool assets, and a balancer pool of zapDenom and foo
+// to route through, and funds the test accounts with zapDenom.
+func (s *KeeperTestSuite) prepareZapPools() (poolId, routePoolId uint64) {
+	poolId = s.PrepareBalancerPool()
+	routePoolId = s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(zapDenom, 10_000_000), sdk.NewInt64Coin(apptesting.FOO, 10_000_000))
+	s.fundAllAccountsWith(apptesting.DefaultAcctFunds.Add(sdk.NewInt64Coin(zapDenom, 10_000_000_000)))
+	return poolId, routePoolId
+}
+
+func (s *KeeperTestSuite) TestJoinPoolWithAnyToken() {
+	tests := map[string]struct {
+		tokenIn           sdk.Coin
+		routeDenoms       []string
+		shareOutMinAmount sdk.Int
+		expectFallback    bool
+		expectedErr       error
+	}{
+		"join with routed token": {
+			tokenIn:           sdk.NewInt64Coin(zapDenom, 100_000),
+			routeDenoms:       []string{apptesting.FOO},
+			shareOutMinAmount: sdk.OneInt(),
+		},
+		"join with pool asset, no routes": {
+			tokenIn:           sdk.NewInt64Coin(apptesting.BAR, 100_000),
+			shareOutMinAmount: sdk.OneInt(),
+		},
+		"fallback to single asset join: token in too small to split": {
+			tokenIn:           sdk.NewInt64Coin(apptesting.BAR, 2),
+			shareOutMinAmount: sdk.OneInt(),
+			expectFallback:    true,
+		},
+		"error: routed denom is not a pool asset": {
+			tokenIn:           sdk.NewInt64Coin(apptesting.FOO, 100_000),
+			routeDenoms:       []string{zapDenom},
+			shareOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrDenomNotFoundInPool,
+		},
+		"error: token in is not a pool asset, no routes": {
+			tokenIn:           sdk.NewInt64Coin(zapDenom, 100_000),
+			shareOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrDenomNotFoundInPool,
+		},
+		"error: shares out less than min": {
+			tokenIn:           sdk.NewInt64Coin(zapDenom, 100_000),
+			routeDenoms:       []string{apptesting.FOO},
+			shareOutMinAmount: types.InitPoolSharesSupply,
+			expectedErr:       types.ErrLimitMinAmount,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId, routePoolId := s.prepareZapPools()
+			sender := s.TestAccs[1]
+			routes := []poolmanagertypes.SwapAmountInRoute{}
+			for _, denom := range tc.routeDenoms {
+				routes = append(routes, poolmanagertypes.SwapAmountInRoute{PoolId: routePoolId, TokenOutDenom: denom})
+			}
+
+			// the same swap and single asset join as separate messages
+			cacheCtx, _ := s.Ctx.CacheContext()
+			joinCoin := tc.tokenIn
+			if len(routes) > 0 {
+				joinAmount, err := s.App.PoolManagerKeeper.RouteExactAmountIn(cacheCtx, sender, routes, tc.tokenIn, sdk.OneInt())
+				s.Require().NoError(err)
+				joinCoin = sdk.NewCoin(routes[len(routes)-1].TokenOutDenom, joinAmount)
+			}
+			singleAssetSharesOut, _ := s.App.GAMMKeeper.JoinSwapExactAmountIn(cacheCtx, sender, poolId, sdk.NewCoins(joinCoin), sdk.OneInt())
+
+			pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().NoError(err)
+			poolLiquidity := pool.GetTotalPoolLiquidity(s.Ctx)
+			balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			msgServer := keeper.NewMsgServerImpl(s.App.GAMMKeeper)
+			res, err := msgServer.JoinPoolWithAnyToken(sdk.WrapSDKContext(s.Ctx), &types.MsgJoinPoolWithAnyToken{
+				Sender:            sender.String(),
+				PoolId:            poolId,
+				TokenIn:           tc.tokenIn,
+				Routes:            routes,
+				ShareOutMinAmount: tc.shareOutMinAmount,
+			})
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			balancesAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+			shareDenom := types.GetPoolShareDenom(poolId)
+			s.Require().Equal(res.ShareOutAmount, balancesAfter.AmountOf(shareDenom).Sub(balancesBefore.AmountOf(shareDenom)))
+			if tc.expectFallback {
+				// only the token in is spent, for the shares out of a single asset join
+				s.Require().Equal(singleAssetSharesOut, res.ShareOutAmount)
+				expectedBalances := balancesBefore.Sub(sdk.NewCoins(tc.tokenIn)).Add(sdk.NewCoin(shareDenom, res.ShareOutAmount))
+				s.Require().Equal(expectedBalances, balancesAfter)
+				return
+			}
+
+			// the routed token is swapped into every other pool asset, and the split join only leaves
+			// a small remainder of the pool assets
+			s.Require().True(res.ShareOutAmount.IsPositive())
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtTokenSwapped, len(routes)+len(poolLiquidity)-1)
+			if len(routes) > 0 {
+				s.Require().Equal(balancesBefore.AmountOf(tc.tokenIn.Denom).Sub(tc.tokenIn.Amount), balancesAfter.AmountOf(tc.tokenIn.Denom))
+			}
+			for _, asset := range poolLiquidity {
+				remainder := balancesAfter.AmountOf(asset.Denom).Sub(balancesBefore.AmountOf(asset.Denom))
+				if asset.Denom == tc.tokenIn.Denom {
+					remainder = remainder.Add(tc.tokenIn.Amount)
+				}
+				s.Require().False(remainder.IsNegative(), asset.Denom)
+				s.Require().True(remainder.LT(joinCoin.Amount.QuoRaw(100)), "%s remainder %s", asset.Denom, remainder)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestExitPoolToSingleToken() {
+	shareInAmount := types.InitPoolSharesSupply.QuoRaw(100)
+
+	tests := map[string]struct {
+		poolAssetDenom    string
+		routeDenoms       []string
+		tokenOutMinAmount sdk.Int
+		expectedDenom     string
+		expectedErr       error
+	}{
+		"exit to routed token": {
+			poolAssetDenom:    apptesting.FOO,
+			routeDenoms:       []string{zapDenom},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedDenom:     zapDenom,
+		},
+		"exit to pool asset, no routes": {
+			poolAssetDenom:    apptesting.BAR,
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedDenom:     apptesting.BAR,
+		},
+		"error: pool asset denom is not a pool asset": {
+			poolAssetDenom:    zapDenom,
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrDenomNotFoundInPool,
+		},
+		"error: token out less than min, no routes": {
+			poolAssetDenom:    apptesting.BAR,
+			tokenOutMinAmount: sdk.NewInt(10_000_000),
+			expectedErr:       types.ErrLimitMinAmount,
+		},
+		"error: routed token out less than min": {
+			poolAssetDenom:    apptesting.FOO,
+			routeDenoms:       []string{zapDenom},
+			tokenOutMinAmount: sdk.NewInt(10_000_000),
+			expectedErr:       types.ErrLimitMinAmount,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId, routePoolId := s.prepareZapPools()
+			// the pool creator holds all the shares
+			sender := s.TestAccs[0]
+			routes := []poolmanagertypes.SwapAmountInRoute{}
+			for _, denom := range tc.routeDenoms {
+				routes = append(routes, poolmanagertypes.SwapAmountInRoute{PoolId: routePoolId, TokenOutDenom: denom})
+			}
+
+			// the same exit and swap as separate messages
+			cacheCtx, _ := s.Ctx.CacheContext()
+			expectedAmount, _ := s.App.GAMMKeeper.ExitSwapShareAmountIn(cacheCtx, sender, poolId, tc.poolAssetDenom, shareInAmount, sdk.ZeroInt())
+			if len(routes) > 0 {
+				var err error
+				expectedAmount, err = s.App.PoolManagerKeeper.RouteExactAmountIn(cacheCtx, sender, routes, sdk.NewCoin(tc.poolAssetDenom, expectedAmount), sdk.OneInt())
+				s.Require().NoError(err)
+			}
+
+			balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+			msgServer := keeper.NewMsgServerImpl(s.App.GAMMKeeper)
+			res, err := msgServer.ExitPoolToSingleToken(sdk.WrapSDKContext(s.Ctx), &types.MsgExitPoolToSingleToken{
+				Sender:            sender.String(),
+				PoolId:            poolId,
+				ShareInAmount:     shareInAmount,
+				PoolAssetDenom:    tc.poolAssetDenom,
+				Routes:            routes,
+				TokenOutMinAmount: tc.tokenOutMinAmount,
+			})
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewCoin(tc.expectedDenom, expectedAmount), res.TokenOut)
+
+			// only the shares in are spent, for the token out
+			expectedBalances := balancesBefore.Sub(sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(poolId), shareInAmount))).Add(res.TokenOut)
+			s.Require().Equal(expectedBalances, s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+		})
+	}
+}
@@ -161,9 +161,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // BeginBlock performs a no-op.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock returns the end blocker for the gamm module. It returns no validator
-// updates.
+// EndBlock returns the end blocker for the gamm module. It updates the scaling
+// factors of the stableswap pools whose rate source updates every block, and
+// returns no validator updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.UpdateScalingFactorsFromRateSources(ctx, "")
 	return []abci.ValidatorUpdate{}
 }
 
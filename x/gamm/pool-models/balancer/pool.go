@@ -981,3 +981,86 @@ func (p *Pool) ExitSwapExactAmountOut(
 func (p *Pool) AsSerializablePool() poolmanagertypes.PoolI {
 	return p
 }
+
+// AddPoolAsset adds the given asset to the pool, with its weight scaled by GuaranteedWeightPrecision,
+// and returns the number of shares the asset is worth. The new asset is worth its weight's share of
+// the pool, so that the shares out are the total shares scaled by the ratio of the asset's weight
+// to the previous total weight. The spot prices between the previous assets are unchanged.
+// As the asset's weight and token amount together set its price against the previous assets,
+// this must only be called through governance, see keeper.HandleAddPoolAssetProposal.
+// Returns error if a weight change is in progress, if the asset is invalid or already in the pool,
+// or if the pool would have more than types.MaxNumOfAssetsInPool assets.
+func (p *Pool) AddPoolAsset(poolAsset PoolAsset) (sharesOut sdk.Int, err error) {
+	if err := p.validateAssetsChange(); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := ValidateUserSpecifiedWeight(poolAsset.Weight); err != nil {
+		return sdk.Int{}, err
+	}
+	if _, _, err := p.getPoolAssetAndIndex(poolAsset.Token.Denom); err == nil {
+		return sdk.Int{}, types.ErrDenomAlreadyInPool
+	}
+	if p.NumAssets() >= types.MaxNumOfAssetsInPool {
+		return sdk.Int{}, errorsmod.Wrapf(types.ErrTooManyPoolAssets, "%d", p.NumAssets()+1)
+	}
+
+	oldTotalWeight := p.TotalWeight
+	if err := p.SetInitialPoolAssets([]PoolAsset{poolAsset}); err != nil {
+		return sdk.Int{}, err
+	}
+	scaledWeight := poolAsset.Weight.MulRaw(GuaranteedWeightPrecision)
+
+	sharesOut = p.GetTotalShares().Mul(scaledWeight).Quo(oldTotalWeight)
+	if !sharesOut.IsPositive() {
+		return sdk.Int{}, errorsmod.Wrapf(types.ErrInvalidMathApprox, "share out amount must be positive")
+	}
+	p.AddTotalShares(sharesOut)
+	return sharesOut, nil
+}
+
+// RemovePoolAsset removes the asset with the given denom from the pool in exchange for tokenIn, the proceeds of
+// swapping the asset's whole balance into another pool asset, which are added to that asset's balance.
+// It returns the asset's whole balance with the number of shares it was worth. The asset was worth its weight's
+// share of the pool, so that these are the total shares scaled by the ratio of the asset's weight to the total
+// weight, rounded up. The total shares are unchanged, as the LPs keep the value of the asset through tokenIn.
+// The spot prices between the remaining assets other than tokenIn's are unchanged.
+// As tokenIn sets the price the asset is exchanged for, this must only be called through governance,
+// see keeper.HandleRemovePoolAssetProposal.
+// Returns error if a weight change is in progress, if the denom or tokenIn's denom is not in the pool,
+// if tokenIn is the asset removed or is not positive, or if the pool would have less than
+// types.MinNumOfAssetsInPool assets.
+func (p *Pool) RemovePoolAsset(denom string, tokenIn sdk.Coin) (tokenOut sdk.Coin, sharesValue sdk.Int, err error) {
+	if err := p.validateAssetsChange(); err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	index, poolAsset, err := p.getPoolAssetAndIndex(denom)
+	if err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	if p.NumAssets() <= types.MinNumOfAssetsInPool {
+		return sdk.Coin{}, sdk.Int{}, types.ErrTooFewPoolAssets
+	}
+	if !tokenIn.IsValid() || !tokenIn.IsPositive() || tokenIn.Denom == denom {
+		return sdk.Coin{}, sdk.Int{}, fmt.Errorf("token in (%s) must be valid, positive and not the removed asset", tokenIn)
+	}
+	if _, _, err := p.getPoolAssetAndIndex(tokenIn.Denom); err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+
+	sharesValue = sdk.NewDecFromInt(p.GetTotalShares()).MulInt(poolAsset.Weight).QuoInt(p.TotalWeight).Ceil().TruncateInt()
+
+	p.PoolAssets = append(p.PoolAssets[:index], p.PoolAssets[index+1:]...)
+	p.TotalWeight = p.TotalWeight.Sub(poolAsset.Weight)
+	if err := p.addToPoolAssetBalances(sdk.NewCoins(tokenIn)); err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	return poolAsset.Token, sharesValue, nil
+}
+
+// validateAssetsChange returns error if the pool's weights are changing.
+func (p Pool) validateAssetsChange() error {
+	if p.PoolParams.SmoothWeightChangeParams != nil {
+		return types.WeightChangeInProgressError{PoolId: p.Id}
+	}
+	return nil
+}
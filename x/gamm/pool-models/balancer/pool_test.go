@@ -1363,3 +1363,154 @@ func TestCalcJoinPoolNoSwapShares(t *testing.T) {
 		})
 	}
 }
+
+func TestBalancerPoolAddAndRemovePoolAsset(t *testing.T) {
+	initialPoolAssets := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("asset1", 1000)},
+		{Weight: sdk.NewInt(3), Token: sdk.NewInt64Coin("asset2", 3000)},
+		{Weight: sdk.NewInt(4), Token: sdk.NewInt64Coin("asset3", 2000)},
+	}
+	newPool := func(t *testing.T, poolParams balancer.PoolParams) balancer.Pool {
+		pool, err := balancer.NewBalancerPool(defaultPoolId, poolParams, initialPoolAssets, "", defaultCurBlockTime)
+		require.NoError(t, err)
+		return pool
+	}
+
+	t.Run("add pool asset", func(t *testing.T) {
+		tests := map[string]struct {
+			poolParams        balancer.PoolParams
+			poolAsset         balancer.PoolAsset
+			expectedSharesOut sdk.Int
+			expectedErr       error
+		}{
+			"add asset": {
+				poolAsset: balancer.PoolAsset{Weight: sdk.NewInt(2), Token: sdk.NewInt64Coin("asset0", 500)},
+				// the new weight is a quarter of the previous total weight
+				expectedSharesOut: types.InitPoolSharesSupply.QuoRaw(4),
+			},
+			"error: weight change in progress": {
+				poolParams: balancer.PoolParams{
+					SwapFee: defaultSpreadFactor,
+					ExitFee: defaultZeroExitFee,
+					SmoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
+						Duration: time.Hour,
+						TargetPoolWeights: []balancer.PoolAsset{
+							{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("asset1", 0)},
+							{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("asset2", 0)},
+							{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("asset3", 0)},
+						},
+					},
+				},
+				poolAsset:   balancer.PoolAsset{Weight: sdk.NewInt(2), Token: sdk.NewInt64Coin("asset0", 500)},
+				expectedErr: types.WeightChangeInProgressError{PoolId: defaultPoolId},
+			},
+			"error: denom already in pool": {
+				poolAsset:   balancer.PoolAsset{Weight: sdk.NewInt(2), Token: sdk.NewInt64Coin("asset2", 500)},
+				expectedErr: types.ErrDenomAlreadyInPool,
+			},
+			"error: weight too large": {
+				poolAsset:   balancer.PoolAsset{Weight: balancer.MaxUserSpecifiedWeight, Token: sdk.NewInt64Coin("asset0", 500)},
+				expectedErr: types.ErrWeightTooLarge,
+			},
+		}
+
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				poolParams := defaultBalancerPoolParams
+				if tc.poolParams.SmoothWeightChangeParams != nil {
+					poolParams = tc.poolParams
+				}
+				pool := newPool(t, poolParams)
+				spotPriceBefore, err := pool.SpotPrice(sdk.Context{}, "asset1", "asset2")
+				require.NoError(t, err)
+
+				sharesOut, err := pool.AddPoolAsset(tc.poolAsset)
+				if tc.expectedErr != nil {
+					require.ErrorIs(t, err, tc.expectedErr)
+					require.Equal(t, 3, pool.NumAssets())
+					return
+				}
+				require.NoError(t, err)
+
+				require.Equal(t, tc.expectedSharesOut, sharesOut)
+				require.Equal(t, types.InitPoolSharesSupply.Add(sharesOut), pool.GetTotalShares())
+				require.Equal(t, 4, pool.NumAssets())
+				testTotalWeight(t, sdk.NewInt(8).Add(tc.poolAsset.Weight), pool)
+
+				poolAsset, err := pool.GetPoolAsset(tc.poolAsset.Token.Denom)
+				require.NoError(t, err)
+				require.Equal(t, tc.poolAsset.Token, poolAsset.Token)
+				require.Equal(t, tc.poolAsset.Weight.MulRaw(balancer.GuaranteedWeightPrecision), poolAsset.Weight)
+
+				// the spot prices between the previous assets are unchanged
+				spotPriceAfter, err := pool.SpotPrice(sdk.Context{}, "asset1", "asset2")
+				require.NoError(t, err)
+				require.Equal(t, spotPriceBefore, spotPriceAfter)
+			})
+		}
+	})
+
+	t.Run("remove pool asset", func(t *testing.T) {
+		tests := map[string]struct {
+			removeFirst         string
+			denom               string
+			tokenIn             sdk.Coin
+			expectedSharesValue sdk.Int
+			expectedErr         error
+		}{
+			"remove asset": {
+				denom:   "asset2",
+				tokenIn: sdk.NewInt64Coin("asset1", 1500),
+				// the weight is three eighths of the total weight
+				expectedSharesValue: types.InitPoolSharesSupply.MulRaw(3).QuoRaw(8),
+			},
+			"error: denom not in pool": {
+				denom:       "asset0",
+				tokenIn:     sdk.NewInt64Coin("asset1", 1500),
+				expectedErr: types.ErrDenomNotFoundInPool,
+			},
+			"error: token in not in pool": {
+				denom:       "asset2",
+				tokenIn:     sdk.NewInt64Coin("asset0", 1500),
+				expectedErr: types.ErrDenomNotFoundInPool,
+			},
+			"error: too few assets": {
+				removeFirst: "asset2",
+				denom:       "asset1",
+				tokenIn:     sdk.NewInt64Coin("asset3", 1500),
+				expectedErr: types.ErrTooFewPoolAssets,
+			},
+		}
+
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				pool := newPool(t, defaultBalancerPoolParams)
+				if tc.removeFirst != "" {
+					_, _, err := pool.RemovePoolAsset(tc.removeFirst, sdk.NewInt64Coin("asset1", 1500))
+					require.NoError(t, err)
+				}
+				numAssets := pool.NumAssets()
+				totalWeight := pool.GetTotalWeight()
+				liquidityBefore := pool.GetTotalPoolLiquidity(sdk.Context{})
+
+				tokenOut, sharesValue, err := pool.RemovePoolAsset(tc.denom, tc.tokenIn)
+				if tc.expectedErr != nil {
+					require.ErrorIs(t, err, tc.expectedErr)
+					require.Equal(t, numAssets, pool.NumAssets())
+					return
+				}
+				require.NoError(t, err)
+
+				require.Equal(t, sdk.NewInt64Coin("asset2", 3000), tokenOut)
+				require.Equal(t, tc.expectedSharesValue, sharesValue)
+				// the LPs keep the value of the removed asset through the token in
+				require.Equal(t, types.InitPoolSharesSupply, pool.GetTotalShares())
+				require.Equal(t, numAssets-1, pool.NumAssets())
+				require.Equal(t, totalWeight.Sub(sdk.NewInt(3).MulRaw(balancer.GuaranteedWeightPrecision)), pool.GetTotalWeight())
+				_, err = pool.GetPoolAsset(tc.denom)
+				require.ErrorIs(t, err, types.ErrDenomNotFoundInPool)
+				require.Equal(t, liquidityBefore.Sub(sdk.NewCoins(tokenOut)).Add(tc.tokenIn), pool.GetTotalPoolLiquidity(sdk.Context{}))
+			})
+		}
+	})
+}
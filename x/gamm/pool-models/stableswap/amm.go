@@ -3,6 +3,8 @@ package stableswap
 import (
 	"errors"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -155,7 +157,159 @@ func solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn osmomath.Bi
 	return xOut
 }
 
-func (p Pool) spotPrice(quoteDenom, baseDenom string) (spotPrice sdk.Dec, err error) {
+// solveInvariant returns how many units of x are taken out of the pool for an addition of yIn units of y,
+// using the Curve StableSwap invariant if the pool has amplification parameters, and the Solidly CFMM otherwise.
+func (p Pool) solveInvariant(ctx sdk.Context, xReserve, yReserve osmomath.BigDec, remReserves []osmomath.BigDec, yIn osmomath.BigDec) (osmomath.BigDec, error) {
+	if !p.IsAmplified() {
+		return solveCfmm(xReserve, yReserve, remReserves, yIn), nil
+	}
+	amplification := osmomath.BigDecFromSDKDec(p.GetAmplification(ctx))
+	return solveCurve(xReserve, yReserve, remReserves, yIn, amplification)
+}
+
+// poolInvariant returns the invariant of the given scaled reserves, normalized s.t. it equals the sum of the reserves
+// when they are all equal, so that it measures the value of the reserves at the peg and can be compared between
+// different numbers of assets. It is the Curve StableSwap invariant D if the pool has amplification parameters, and
+// the multi-asset CFMM prod(x_i) sum(x_i^2) = k normalized as n (k / n)^(1 / (n + 2)) otherwise.
+func (p Pool) poolInvariant(ctx sdk.Context, reserves []osmomath.BigDec) (osmomath.BigDec, error) {
+	if p.IsAmplified() {
+		return curveInvariant(reserves, osmomath.BigDecFromSDKDec(p.GetAmplification(ctx)))
+	}
+	return cfmmInvariant(reserves)
+}
+
+// cfmmInvariant returns n (k / n)^(1 / (n + 2)) for the multi-asset CFMM k = prod(x_i) sum(x_i^2).
+// The reserves are first divided by their mean, so that the root is taken of a value close to one,
+// and the result is multiplied back, as the normalized invariant is homogeneous of degree one.
+func cfmmInvariant(reserves []osmomath.BigDec) (osmomath.BigDec, error) {
+	n := int64(len(reserves))
+	sum := osmomath.ZeroDec()
+	for _, reserve := range reserves {
+		if !reserve.IsPositive() {
+			return osmomath.BigDec{}, errors.New("invalid input: reserves must be positive")
+		}
+		sum = sum.Add(reserve)
+	}
+	mean := sum.QuoInt64(n)
+
+	product := osmomath.OneDec()
+	sumSquares := osmomath.ZeroDec()
+	for _, reserve := range reserves {
+		normalized := reserve.Quo(mean)
+		product = product.Mul(normalized)
+		sumSquares = sumSquares.Add(normalized.Mul(normalized))
+	}
+	root, err := product.Mul(sumSquares).QuoInt64(n).ApproxRoot(uint64(n + 2))
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+	return root.MulInt64(n).Mul(mean), nil
+}
+
+// maxCurveIterations is the maximum number of Newton iterations used to solve the Curve StableSwap invariant.
+const maxCurveIterations = 255
+
+// curveConvergenceTolerance is the change between two consecutive Newton iterations, in scaled units,
+// below which the Curve StableSwap invariant is considered solved.
+var curveConvergenceTolerance = osmomath.NewDecWithPrec(1, 18)
+
+// curveInvariant returns the Curve StableSwap invariant D of the given scaled reserves x_i and amplification A,
+// namely the D satisfying A n^n sum(x_i) + D = A n^n D + D^(n+1) / (n^n prod(x_i)).
+// It is solved by Newton's method starting from D = sum(x_i), and rounded up.
+func curveInvariant(reserves []osmomath.BigDec, amplification osmomath.BigDec) (osmomath.BigDec, error) {
+	n := osmomath.NewBigDec(int64(len(reserves)))
+	ann := amplification.Mul(n.PowerInteger(uint64(len(reserves))))
+	sum := osmomath.ZeroDec()
+	for _, reserve := range reserves {
+		sum = sum.Add(reserve)
+	}
+
+	d := sum
+	for i := 0; i < maxCurveIterations; i++ {
+		// dP = D^(n+1) / (n^n prod(x_i))
+		dP := d
+		for _, reserve := range reserves {
+			dP = dP.Mul(d).Quo(reserve.Mul(n))
+		}
+		prevD := d
+		// D = (A n^n sum(x_i) + n dP) D / ((A n^n - 1) D + (n + 1) dP)
+		numerator := ann.Mul(sum).Add(dP.Mul(n)).Mul(d)
+		denominator := ann.Sub(one).Mul(d).Add(n.Add(one).Mul(dP))
+		d = numerator.QuoRoundUp(denominator)
+		if d.Sub(prevD).Abs().LTE(curveConvergenceTolerance) {
+			return d, nil
+		}
+	}
+	return osmomath.BigDec{}, errorsmod.Wrap(types.ErrInvalidMathApprox, "curve invariant did not converge")
+}
+
+// curveSolveReserve returns the scaled reserve of the asset at index j s.t. the Curve StableSwap invariant
+// of the given scaled reserves is d, given the reserves of every other asset. The reserve at index j is ignored.
+// With S' and P' the sum and product of the other reserves, it solves y^2 + (b - D) y = c for
+// b = S' + D / (A n^n) and c = D^(n+1) / (n^n P' A n^n) by Newton's method starting from y = D.
+// Newton's method on this convex function approaches the solution from above after the first iteration,
+// and every division rounds up, so that the returned reserve is never under-estimated.
+func curveSolveReserve(reserves []osmomath.BigDec, j int, d, amplification osmomath.BigDec) (osmomath.BigDec, error) {
+	n := osmomath.NewBigDec(int64(len(reserves)))
+	ann := amplification.Mul(n.PowerInteger(uint64(len(reserves))))
+
+	c := d
+	sumOthers := osmomath.ZeroDec()
+	for k, reserve := range reserves {
+		if k == j {
+			continue
+		}
+		sumOthers = sumOthers.Add(reserve)
+		c = c.Mul(d).QuoRoundUp(reserve.Mul(n))
+	}
+	c = c.Mul(d).QuoRoundUp(ann.Mul(n))
+	b := sumOthers.Add(d.Quo(ann))
+
+	y := d
+	for i := 0; i < maxCurveIterations; i++ {
+		prevY := y
+		// y = (y^2 + c) / (2y + b - D)
+		y = y.Mul(y).Add(c).QuoRoundUp(y.MulInt64(2).Add(b).Sub(d))
+		if y.Sub(prevY).Abs().LTE(curveConvergenceTolerance) {
+			return y, nil
+		}
+	}
+	return osmomath.BigDec{}, errorsmod.Wrap(types.ErrInvalidMathApprox, "curve reserve did not converge")
+}
+
+// solveCurve is the Curve StableSwap analogue of solveCfmm: for a given addition of `yIn` units of y into the pool,
+// it returns how many units of x are taken out of the pool s.t. the invariant D of the pool is unchanged.
+// As in solveCfmm, x_final is rounded up, so that x_out is under-estimated if yIn is positive, and |x_out|
+// is over-estimated if yIn is negative.
+func solveCurve(xReserve, yReserve osmomath.BigDec, remReserves []osmomath.BigDec, yIn, amplification osmomath.BigDec) (osmomath.BigDec, error) {
+	if !xReserve.IsPositive() || !yReserve.IsPositive() {
+		panic("invalid input: reserves must be positive")
+	} else if yIn.Abs().GTE(yReserve) {
+		panic("cannot input more than pool reserves")
+	}
+
+	reserves := append([]osmomath.BigDec{xReserve, yReserve}, remReserves...)
+	d, err := curveInvariant(reserves, amplification)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	reserves[1] = yReserve.Add(yIn)
+	xFinal, err := curveSolveReserve(reserves, 0, d, amplification)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	xOut := xReserve.Sub(xFinal)
+	// As in solveCFMMBinarySearchMulti, swaps cannot more than double the input token's pool supply
+	// nor output more than the output token's pool supply.
+	if xOut.Abs().GTE(xReserve) {
+		panic("invalid output: greater than full pool reserves")
+	}
+	return xOut, nil
+}
+
+func (p Pool) spotPrice(ctx sdk.Context, quoteDenom, baseDenom string) (spotPrice sdk.Dec, err error) {
 	// Define f_{y -> x}(a) as the function that outputs the amount of tokens X you'd get by
 	// trading "a" units of Y against the pool, assuming 0 spread factor, at the current liquidity.
 	// The spot price of the pool is then lim a -> 0, f_{y -> x}(a) / a
@@ -171,7 +325,7 @@ func (p Pool) spotPrice(quoteDenom, baseDenom string) (spotPrice sdk.Dec, err er
 	// xReserve & yReserve.
 	a := sdk.OneInt()
 
-	res, err := p.calcOutAmtGivenIn(sdk.NewCoin(baseDenom, a), quoteDenom, sdk.ZeroDec())
+	res, err := p.calcOutAmtGivenIn(ctx, sdk.NewCoin(baseDenom, a), quoteDenom, sdk.ZeroDec())
 	// fmt.Println("spot price res", res)
 	return res, err
 }
@@ -181,7 +335,7 @@ func oneMinus(spreadFactor sdk.Dec) osmomath.BigDec {
 }
 
 // calcOutAmtGivenIn calculate amount of specified denom to output from a pool in sdk.Dec given the input `tokenIn`
-func (p Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, spreadFactor sdk.Dec) (sdk.Dec, error) {
+func (p Pool) calcOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, spreadFactor sdk.Dec) (sdk.Dec, error) {
 	// round liquidity down, and round token in down
 	reserves, err := p.scaledSortedPoolReserves(tokenIn.Denom, tokenOutDenom, osmomath.RoundDown)
 	if err != nil {
@@ -197,14 +351,17 @@ func (p Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, spreadFa
 	ammIn := tokenInDec.Mul(oneMinus(spreadFactor))
 	// We are solving for the amount of token out, hence x = tokenOutSupply, y = tokenInSupply
 	// fmt.Printf("outSupply %s, inSupply %s, remReservs %s, ammIn %s\n ", tokenOutSupply, tokenInSupply, remReserves, ammIn)
-	cfmmOut := solveCfmm(tokenOutSupply, tokenInSupply, remReserves, ammIn)
+	cfmmOut, err := p.solveInvariant(ctx, tokenOutSupply, tokenInSupply, remReserves, ammIn)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
 	// fmt.Println("cfmmout ", cfmmOut)
 	outAmt := p.getDescaledPoolAmt(tokenOutDenom, cfmmOut)
 	return outAmt, nil
 }
 
 // calcInAmtGivenOut calculates exact input amount given the desired output and return as a decimal
-func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, spreadFactor sdk.Dec) (sdk.Dec, error) {
+func (p *Pool) calcInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coin, tokenInDenom string, spreadFactor sdk.Dec) (sdk.Dec, error) {
 	// round liquidity down, and round token out up
 	reserves, err := p.scaledSortedPoolReserves(tokenInDenom, tokenOut.Denom, osmomath.RoundDown)
 	if err != nil {
@@ -218,7 +375,10 @@ func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, spreadF
 
 	// We are solving for the amount of token in, cfmm(x,y) = cfmm(x + x_in, y - y_out)
 	// x = tokenInSupply, y = tokenOutSupply, yIn = -tokenOutAmount
-	cfmmIn := solveCfmm(tokenInSupply, tokenOutSupply, remReserves, tokenOutAmount.Neg())
+	cfmmIn, err := p.solveInvariant(ctx, tokenInSupply, tokenOutSupply, remReserves, tokenOutAmount.Neg())
+	if err != nil {
+		return sdk.Dec{}, err
+	}
 	// returned cfmmIn is negative, representing we need to add this many tokens to pool.
 	// We invert that negative here.
 	cfmmIn = cfmmIn.Neg()
@@ -230,7 +390,7 @@ func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, spreadF
 
 // calcSingleAssetJoinShares calculates the number of LP shares that
 // should be granted given the passed in single-token input (non-mutative)
-func (p *Pool) calcSingleAssetJoinShares(tokenIn sdk.Coin, spreadFactor sdk.Dec) (sdk.Int, error) {
+func (p *Pool) calcSingleAssetJoinShares(ctx sdk.Context, tokenIn sdk.Coin, spreadFactor sdk.Dec) (sdk.Int, error) {
 	poolWithAddedLiquidityAndShares := func(newLiquidity sdk.Coin, newShares sdk.Int) types.CFMMPoolI {
 		paCopy := p.Copy()
 		paCopy.updatePoolForJoin(sdk.NewCoins(newLiquidity), newShares)
@@ -249,7 +409,7 @@ func (p *Pool) calcSingleAssetJoinShares(tokenIn sdk.Coin, spreadFactor sdk.Dec)
 	oneMinusSpreadFactor := sdk.OneDec().Sub(spreadFactor.Mul(spreadFactorApplicableRatio))
 	tokenInAmtAfterFee := tokenIn.Amount.ToDec().Mul(oneMinusSpreadFactor).TruncateInt()
 
-	return cfmm_common.BinarySearchSingleAssetJoin(p, sdk.NewCoin(tokenIn.Denom, tokenInAmtAfterFee), poolWithAddedLiquidityAndShares)
+	return cfmm_common.BinarySearchSingleAssetJoin(ctx, p, sdk.NewCoin(tokenIn.Denom, tokenInAmtAfterFee), poolWithAddedLiquidityAndShares)
 }
 
 // returns the ratio of input asset liquidity, to total liquidity in pool, post-scaling.
@@ -288,7 +448,7 @@ func (p *Pool) joinPoolSharesInternal(ctx sdk.Context, tokensIn sdk.Coins, sprea
 	}
 
 	if len(tokensIn) == 1 && tokensIn[0].Amount.GT(sdk.OneInt()) {
-		numShares, err = p.calcSingleAssetJoinShares(tokensIn[0], spreadFactor)
+		numShares, err = p.calcSingleAssetJoinShares(ctx, tokensIn[0], spreadFactor)
 		if err != nil {
 			return sdk.ZeroInt(), sdk.NewCoins(), err
 		}
@@ -1059,7 +1059,7 @@ func TestCalcSingleAssetJoinShares(t *testing.T) {
 			ctx := sdk.Context{}
 			p := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
 
-			shares, err := p.calcSingleAssetJoinShares(tc.tokenIn, tc.spreadFactor)
+			shares, err := p.calcSingleAssetJoinShares(ctx, tc.tokenIn, tc.spreadFactor)
 			require.NoError(t, err, "test: %s", name)
 
 			p.updatePoolForJoin(sdk.Coins{tc.tokenIn}, shares)
@@ -1246,3 +1246,120 @@ func TestSingleAssetJoinSpreadFactorRatio(t *testing.T) {
 		})
 	}
 }
+
+func TestCurveInvariant(t *testing.T) {
+	tests := map[string]struct {
+		reserves      []osmomath.BigDec
+		amplification osmomath.BigDec
+	}{
+		"even two-asset pool": {
+			reserves:      []osmomath.BigDec{osmomath.NewBigDec(1_000_000), osmomath.NewBigDec(1_000_000)},
+			amplification: osmomath.NewBigDec(100),
+		},
+		"uneven two-asset pool": {
+			reserves:      []osmomath.BigDec{osmomath.NewBigDec(1_000_000), osmomath.NewBigDec(3_000_000)},
+			amplification: osmomath.NewBigDec(100),
+		},
+		"very uneven two-asset pool, low amplification": {
+			reserves:      []osmomath.BigDec{osmomath.NewBigDec(1), osmomath.NewBigDec(1_000_000_000)},
+			amplification: osmomath.NewBigDec(1),
+		},
+		"uneven three-asset pool, high amplification": {
+			reserves:      []osmomath.BigDec{osmomath.NewBigDec(1_000_000), osmomath.NewBigDec(2_000_000), osmomath.NewBigDec(3_000_000)},
+			amplification: osmomath.NewBigDec(types.StableswapMaxAmplification),
+		},
+		"even eight-asset pool at max scaled reserves": {
+			reserves: []osmomath.BigDec{
+				osmomath.NewDecFromBigInt(types.StableswapMaxScaledAmtPerAsset.BigInt()), osmomath.NewDecFromBigInt(types.StableswapMaxScaledAmtPerAsset.BigInt()),
+				osmomath.NewDecFromBigInt(types.StableswapMaxScaledAmtPerAsset.BigInt()), osmomath.NewDecFromBigInt(types.StableswapMaxScaledAmtPerAsset.BigInt()),
+				osmomath.NewDecFromBigInt(types.StableswapMaxScaledAmtPerAsset.BigInt()), osmomath.NewDecFromBigInt(types.StableswapMaxScaledAmtPerAsset.BigInt()),
+				osmomath.NewDecFromBigInt(types.StableswapMaxScaledAmtPerAsset.BigInt()), osmomath.NewDecFromBigInt(types.StableswapMaxScaledAmtPerAsset.BigInt()),
+			},
+			amplification: osmomath.NewBigDec(100),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			d, err := curveInvariant(tc.reserves, tc.amplification)
+			require.NoError(t, err)
+
+			// D satisfies A n^n sum(x_i) + D = A n^n D + D^(n+1) / (n^n prod(x_i))
+			n := osmomath.NewBigDec(int64(len(tc.reserves)))
+			ann := tc.amplification.Mul(n.PowerInteger(uint64(len(tc.reserves))))
+			sum := osmomath.ZeroDec()
+			dP := d
+			for _, reserve := range tc.reserves {
+				sum = sum.Add(reserve)
+				dP = dP.Mul(d).Quo(reserve.Mul(n))
+			}
+			lhs := ann.Mul(sum).Add(d)
+			rhs := ann.Mul(d).Add(dP)
+			osmomath.DecApproxEq(t, lhs, rhs, lhs.Mul(osmomath.NewDecWithPrec(1, 24)))
+
+			// D is the sum of the reserves when they are even, and less than it otherwise
+			if tc.reserves[0].Equal(tc.reserves[1]) {
+				require.Equal(t, sum, d)
+			} else {
+				require.True(t, d.LT(sum))
+			}
+		})
+	}
+}
+
+func TestSolveCurve(t *testing.T) {
+	dErrTolerance := osmomath.NewDecWithPrec(1, 12)
+	amplifications := []osmomath.BigDec{osmomath.NewBigDec(1), osmomath.NewBigDec(100), osmomath.NewBigDec(types.StableswapMaxAmplification)}
+
+	tests := map[string]CFMMTestCase{}
+	for name, test := range twoAssetCFMMTestCases {
+		tests[name] = test
+	}
+	for name, test := range multiAssetCFMMTestCases {
+		tests["multi-asset: "+name] = test
+	}
+
+	for name, test := range tests {
+		for _, amplification := range amplifications {
+			test, amplification := test, amplification
+			t.Run(fmt.Sprintf("%s, amplification %s", name, amplification), func(t *testing.T) {
+				sut := func() {
+					d0, err := curveInvariant(append([]osmomath.BigDec{test.xReserve, test.yReserve}, test.remReserves...), amplification)
+					require.NoError(t, err)
+
+					xOut, err := solveCurve(test.xReserve, test.yReserve, test.remReserves, test.yIn, amplification)
+					require.NoError(t, err)
+
+					d1, err := curveInvariant(append([]osmomath.BigDec{test.xReserve.Sub(xOut), test.yReserve.Add(test.yIn)}, test.remReserves...), amplification)
+					require.NoError(t, err)
+					osmomath.DecApproxEq(t, d0, d1, dErrTolerance)
+				}
+
+				osmoassert.ConditionalPanic(t, test.expectPanic, sut)
+			})
+		}
+	}
+}
+
+func TestSolveCurveDepthAroundPeg(t *testing.T) {
+	reserve := osmomath.NewBigDec(1_000_000_000)
+	yIn := osmomath.NewBigDec(300_000_000)
+
+	solidlyOut := solveCfmm(reserve, reserve, []osmomath.BigDec{}, yIn)
+
+	prevOut := osmomath.ZeroDec()
+	for _, amplification := range []int64{1, 10, 100, 1000, types.StableswapMaxAmplification} {
+		xOut, err := solveCurve(reserve, reserve, []osmomath.BigDec{}, yIn, osmomath.NewBigDec(amplification))
+		require.NoError(t, err)
+
+		// a higher amplification gives less slippage around the peg, never more than the constant sum
+		require.True(t, xOut.GT(prevOut), "amplification %d", amplification)
+		require.True(t, xOut.LT(yIn), "amplification %d", amplification)
+		prevOut = xOut
+
+		// the Solidly CFMM cannot be tuned, and is shallower than a high enough amplification for large trades
+		if amplification >= 100 {
+			require.True(t, xOut.GT(solidlyOut), "amplification %d", amplification)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package stableswap
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+)
+
+// newAmplificationParameters returns amplification parameters with a constant amplification coefficient,
+// as if a ramp to the given amplification had ended at the given time.
+func newAmplificationParameters(amplification uint64, blockTime time.Time) *AmplificationParameters {
+	amplificationDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(amplification))
+	return &AmplificationParameters{
+		InitialAmplification: amplificationDec,
+		FutureAmplification:  amplificationDec,
+		RampStartTime:        blockTime,
+		RampEndTime:          blockTime,
+	}
+}
+
+func validateAmplification(amplification uint64) error {
+	if amplification < types.StableswapMinAmplification || amplification > types.StableswapMaxAmplification {
+		return types.InvalidAmplificationError{Amplification: amplification}
+	}
+	return nil
+}
+
+// IsAmplified returns true if the pool uses the Curve StableSwap invariant rather than the Solidly CFMM.
+func (p Pool) IsAmplified() bool {
+	return p.AmplificationParameters != nil
+}
+
+// GetAmplification returns the amplification coefficient of the pool at the current block time.
+// Returns a nil Dec if the pool does not use the Curve StableSwap invariant.
+func (p Pool) GetAmplification(ctx sdk.Context) sdk.Dec {
+	if !p.IsAmplified() {
+		return sdk.Dec{}
+	}
+	return p.AmplificationParameters.amplificationAt(ctx.BlockTime())
+}
+
+// amplificationAt returns the amplification coefficient at the given time, linearly interpolated
+// between the initial amplification at the ramp start time and the future amplification at the ramp end time.
+func (a AmplificationParameters) amplificationAt(t time.Time) sdk.Dec {
+	if !t.Before(a.RampEndTime) {
+		return a.FutureAmplification
+	}
+	if !t.After(a.RampStartTime) {
+		return a.InitialAmplification
+	}
+
+	elapsed := sdk.NewDec(int64(t.Sub(a.RampStartTime)))
+	rampDuration := sdk.NewDec(int64(a.RampEndTime.Sub(a.RampStartTime)))
+	change := a.FutureAmplification.Sub(a.InitialAmplification)
+	return a.InitialAmplification.Add(change.Mul(elapsed).Quo(rampDuration))
+}
+
+// RampAmplification ramps the amplification coefficient of the pool linearly from its current value
+// to futureAmplification at futureTime.
+// It should only be able to be successfully called by the pool's ScalingFactorController.
+// Returns error if the sender is not the ScalingFactorController, or if the ramp is invalid
+// as described in RampAmplificationByGovernance.
+func (p *Pool) RampAmplification(ctx sdk.Context, futureAmplification uint64, futureTime time.Time, sender string) error {
+	if sender != p.ScalingFactorController {
+		return types.ErrNotScalingFactorGovernor
+	}
+	return p.RampAmplificationByGovernance(ctx, futureAmplification, futureTime)
+}
+
+// RampAmplificationByGovernance ramps the amplification coefficient of the pool linearly from its current value
+// to futureAmplification at futureTime, regardless of the pool's ScalingFactorController.
+// It should only be called when executing a governance proposal.
+// Returns error if the pool does not use the Curve StableSwap invariant, if the previous ramp started less than
+// types.StableswapMinAmplificationRampDuration ago, if the ramp is shorter than that duration, or if the future
+// amplification is out of bounds or more than types.StableswapMaxAmplificationChange times larger or smaller
+// than the current amplification.
+func (p *Pool) RampAmplificationByGovernance(ctx sdk.Context, futureAmplification uint64, futureTime time.Time) error {
+	if !p.IsAmplified() {
+		return types.NotAmplifiedPoolError{PoolId: p.Id}
+	}
+
+	if err := validateAmplification(futureAmplification); err != nil {
+		return err
+	}
+
+	blockTime := ctx.BlockTime()
+	earliestRampTime := p.AmplificationParameters.RampStartTime.Add(types.StableswapMinAmplificationRampDuration)
+	if blockTime.Before(earliestRampTime) {
+		return types.AmplificationRampTooEarlyError{PoolId: p.Id, EarliestTime: earliestRampTime}
+	}
+
+	earliestFutureTime := blockTime.Add(types.StableswapMinAmplificationRampDuration)
+	if futureTime.Before(earliestFutureTime) {
+		return types.AmplificationRampTooShortError{FutureTime: futureTime, EarliestFutureTime: earliestFutureTime}
+	}
+
+	currentAmplification := p.AmplificationParameters.amplificationAt(blockTime)
+	futureAmplificationDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(futureAmplification))
+	maxChange := sdk.NewDec(types.StableswapMaxAmplificationChange)
+	if futureAmplificationDec.GT(currentAmplification.Mul(maxChange)) || futureAmplificationDec.Mul(maxChange).LT(currentAmplification) {
+		return types.AmplificationChangeTooLargeError{CurrentAmplification: currentAmplification, FutureAmplification: futureAmplification}
+	}
+
+	p.AmplificationParameters = &AmplificationParameters{
+		InitialAmplification: currentAmplification,
+		FutureAmplification:  futureAmplificationDec,
+		RampStartTime:        blockTime,
+		RampEndTime:          futureTime,
+	}
+	return nil
+}
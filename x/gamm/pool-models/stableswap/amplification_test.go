@@ -0,0 +1,259 @@
+package stableswap
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+)
+
+var (
+	defaultRampStartTime = time.Unix(1_700_000_000, 0).UTC()
+	defaultRampEndTime   = defaultRampStartTime.Add(4 * types.StableswapMinAmplificationRampDuration)
+	defaultRamp          = AmplificationParameters{
+		InitialAmplification: sdk.NewDec(100),
+		FutureAmplification:  sdk.NewDec(500),
+		RampStartTime:        defaultRampStartTime,
+		RampEndTime:          defaultRampEndTime,
+	}
+)
+
+func amplifiedPoolStructFromAssets(assets sdk.Coins, scalingFactors []uint64, amplificationParameters AmplificationParameters) Pool {
+	p := poolStructFromAssets(assets, scalingFactors)
+	p.AmplificationParameters = &amplificationParameters
+	return p
+}
+
+func TestAmplificationAt(t *testing.T) {
+	tests := map[string]struct {
+		time                  time.Time
+		expectedAmplification sdk.Dec
+	}{
+		"before ramp start": {
+			time:                  defaultRampStartTime.Add(-time.Hour),
+			expectedAmplification: sdk.NewDec(100),
+		},
+		"at ramp start": {
+			time:                  defaultRampStartTime,
+			expectedAmplification: sdk.NewDec(100),
+		},
+		"quarter of the ramp": {
+			time:                  defaultRampStartTime.Add(types.StableswapMinAmplificationRampDuration),
+			expectedAmplification: sdk.NewDec(200),
+		},
+		"half of the ramp": {
+			time:                  defaultRampStartTime.Add(2 * types.StableswapMinAmplificationRampDuration),
+			expectedAmplification: sdk.NewDec(300),
+		},
+		"at ramp end": {
+			time:                  defaultRampEndTime,
+			expectedAmplification: sdk.NewDec(500),
+		},
+		"after ramp end": {
+			time:                  defaultRampEndTime.Add(time.Hour),
+			expectedAmplification: sdk.NewDec(500),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedAmplification, defaultRamp.amplificationAt(tc.time))
+
+			pool := amplifiedPoolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors, defaultRamp)
+			require.Equal(t, tc.expectedAmplification, pool.GetAmplification(sdk.Context{}.WithBlockTime(tc.time)))
+		})
+	}
+
+	t.Run("decreasing ramp", func(t *testing.T) {
+		ramp := defaultRamp
+		ramp.InitialAmplification, ramp.FutureAmplification = ramp.FutureAmplification, ramp.InitialAmplification
+		require.Equal(t, sdk.NewDec(400), ramp.amplificationAt(defaultRampStartTime.Add(types.StableswapMinAmplificationRampDuration)))
+	})
+
+	t.Run("pool not amplified", func(t *testing.T) {
+		pool := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+		require.False(t, pool.IsAmplified())
+		require.True(t, pool.GetAmplification(sdk.Context{}.WithBlockTime(defaultRampStartTime)).IsNil())
+	})
+}
+
+func TestRampAmplification(t *testing.T) {
+	controller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	otherAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	// at half of the default ramp, the amplification is 300
+	halfRampTime := defaultRampStartTime.Add(2 * types.StableswapMinAmplificationRampDuration)
+
+	tests := map[string]struct {
+		notAmplified        bool
+		blockTime           time.Time
+		futureAmplification uint64
+		futureTime          time.Time
+		sender              string
+		expectedErr         error
+	}{
+		"ramp during previous ramp": {
+			blockTime:           halfRampTime,
+			futureAmplification: 1000,
+			futureTime:          halfRampTime.Add(types.StableswapMinAmplificationRampDuration),
+		},
+		"ramp down after previous ramp": {
+			blockTime:           defaultRampEndTime,
+			futureAmplification: 50,
+			futureTime:          defaultRampEndTime.Add(7 * types.StableswapMinAmplificationRampDuration),
+		},
+		"max increase": {
+			blockTime:           halfRampTime,
+			futureAmplification: 3000,
+			futureTime:          halfRampTime.Add(types.StableswapMinAmplificationRampDuration),
+		},
+		"max decrease": {
+			blockTime:           halfRampTime,
+			futureAmplification: 30,
+			futureTime:          halfRampTime.Add(types.StableswapMinAmplificationRampDuration),
+		},
+		"error: sender is not the scaling factor controller": {
+			blockTime:           halfRampTime,
+			futureAmplification: 1000,
+			futureTime:          halfRampTime.Add(types.StableswapMinAmplificationRampDuration),
+			sender:              otherAddr,
+			expectedErr:         types.ErrNotScalingFactorGovernor,
+		},
+		"error: pool not amplified": {
+			notAmplified:        true,
+			blockTime:           halfRampTime,
+			futureAmplification: 1000,
+			futureTime:          halfRampTime.Add(types.StableswapMinAmplificationRampDuration),
+			expectedErr:         types.NotAmplifiedPoolError{PoolId: defaultPoolId},
+		},
+		"error: future amplification out of bounds": {
+			blockTime:           defaultRampEndTime,
+			futureAmplification: types.StableswapMaxAmplification + 1,
+			futureTime:          defaultRampEndTime.Add(types.StableswapMinAmplificationRampDuration),
+			expectedErr:         types.InvalidAmplificationError{Amplification: types.StableswapMaxAmplification + 1},
+		},
+		"error: previous ramp started too recently": {
+			blockTime:           defaultRampStartTime.Add(types.StableswapMinAmplificationRampDuration - time.Second),
+			futureAmplification: 1000,
+			futureTime:          defaultRampEndTime,
+			expectedErr: types.AmplificationRampTooEarlyError{
+				PoolId:       defaultPoolId,
+				EarliestTime: defaultRampStartTime.Add(types.StableswapMinAmplificationRampDuration),
+			},
+		},
+		"error: ramp too short": {
+			blockTime:           halfRampTime,
+			futureAmplification: 1000,
+			futureTime:          halfRampTime.Add(types.StableswapMinAmplificationRampDuration - time.Second),
+			expectedErr: types.AmplificationRampTooShortError{
+				FutureTime:         halfRampTime.Add(types.StableswapMinAmplificationRampDuration - time.Second),
+				EarliestFutureTime: halfRampTime.Add(types.StableswapMinAmplificationRampDuration),
+			},
+		},
+		"error: increase too large": {
+			blockTime:           halfRampTime,
+			futureAmplification: 3001,
+			futureTime:          halfRampTime.Add(types.StableswapMinAmplificationRampDuration),
+			expectedErr:         types.AmplificationChangeTooLargeError{CurrentAmplification: sdk.NewDec(300), FutureAmplification: 3001},
+		},
+		"error: decrease too large": {
+			blockTime:           halfRampTime,
+			futureAmplification: 29,
+			futureTime:          halfRampTime.Add(types.StableswapMinAmplificationRampDuration),
+			expectedErr:         types.AmplificationChangeTooLargeError{CurrentAmplification: sdk.NewDec(300), FutureAmplification: 29},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockTime(tc.blockTime)
+			pool := amplifiedPoolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors, defaultRamp)
+			if tc.notAmplified {
+				pool.AmplificationParameters = nil
+			}
+			pool.ScalingFactorController = controller
+			sender := controller
+			if tc.sender != "" {
+				sender = tc.sender
+			}
+
+			currentAmplification := pool.GetAmplification(ctx)
+			err := pool.RampAmplification(ctx, tc.futureAmplification, tc.futureTime, sender)
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// the ramp starts from the current amplification, so that it does not jump
+			require.Equal(t, AmplificationParameters{
+				InitialAmplification: currentAmplification,
+				FutureAmplification:  sdk.NewDecFromInt(sdk.NewIntFromUint64(tc.futureAmplification)),
+				RampStartTime:        tc.blockTime,
+				RampEndTime:          tc.futureTime,
+			}, *pool.AmplificationParameters)
+			require.Equal(t, currentAmplification, pool.GetAmplification(ctx))
+		})
+	}
+}
+
+func TestAmplifiedPoolSwaps(t *testing.T) {
+	tokenIn := sdk.NewInt64Coin("bar", 100_000_000)
+
+	tests := map[string]struct {
+		poolAssets     sdk.Coins
+		scalingFactors []uint64
+	}{
+		"even two-asset pool": {
+			poolAssets:     twoEvenStablePoolAssets,
+			scalingFactors: defaultTwoAssetScalingFactors,
+		},
+		"uneven two-asset pool": {
+			poolAssets:     twoUnevenStablePoolAssets,
+			scalingFactors: defaultTwoAssetScalingFactors,
+		},
+		"two-asset pool with scaling factors": {
+			poolAssets:     sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000_000), sdk.NewInt64Coin("foo", 1_000_000_000_000)),
+			scalingFactors: []uint64{1, 1000},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := amplifiedPoolStructFromAssets(tc.poolAssets, tc.scalingFactors, defaultRamp)
+
+			// the swap uses the amplification at the block time, which increases along the ramp
+			prevTokenOut := sdk.ZeroInt()
+			for _, blockTime := range []time.Time{defaultRampStartTime, defaultRampStartTime.Add(2 * types.StableswapMinAmplificationRampDuration), defaultRampEndTime} {
+				ctx := sdk.Context{}.WithBlockTime(blockTime)
+				tokenOut, err := pool.CalcOutAmtGivenIn(ctx, sdk.NewCoins(tokenIn), "foo", sdk.ZeroDec())
+				require.NoError(t, err)
+
+				reserves, err := pool.scaledSortedPoolReserves("foo", "bar", osmomath.RoundDown)
+				require.NoError(t, err)
+				scaledTokenIn, err := pool.scaleCoin(tokenIn, osmomath.RoundDown)
+				require.NoError(t, err)
+				expectedOut, err := solveCurve(reserves[0], reserves[1], reserves[2:], scaledTokenIn, osmomath.BigDecFromSDKDec(pool.GetAmplification(ctx)))
+				require.NoError(t, err)
+				require.Equal(t, pool.getDescaledPoolAmt("foo", expectedOut).TruncateInt(), tokenOut.Amount)
+
+				if tc.poolAssets[0].Amount.Equal(tc.poolAssets[1].Amount.QuoRaw(int64(tc.scalingFactors[1]))) {
+					require.True(t, tokenOut.Amount.GT(prevTokenOut))
+				}
+				prevTokenOut = tokenOut.Amount
+
+				// swapping the output back in requires at least the input
+				tokenInBack, err := pool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), "bar", sdk.ZeroDec())
+				require.NoError(t, err)
+				require.True(t, tokenInBack.Amount.LTE(tokenIn.Amount))
+				require.True(t, tokenIn.Amount.Sub(tokenInBack.Amount).LTE(sdk.OneInt()))
+			}
+		})
+	}
+}
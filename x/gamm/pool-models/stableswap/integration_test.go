@@ -2,10 +2,12 @@
 // We expect tests for:
 // * MsgCreatePool creating correct pool as expected
 // * MsgStableSwapAdjustScalingFactors works as expected
+// * MsgStableSwapRampAmplification works as expected
 package stableswap_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,6 +15,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 )
 
 type TestSuite struct {
@@ -57,3 +60,47 @@ func (s *TestSuite) TestSetScalingFactors() {
 		})
 	}
 }
+
+func (s *TestSuite) TestRampAmplification() {
+	s.SetupTest()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	nextPoolId := s.App.GAMMKeeper.GetNextPoolId(s.Ctx)
+	createPoolMsg := *baseCreatePoolMsgGen(addr1)
+	createPoolMsg.ScalingFactorController = createPoolMsg.Sender
+	createPoolMsg.Amplification = 100
+
+	s.FundAcc(addr1, s.App.GAMMKeeper.GetParams(s.Ctx).PoolCreationFee)
+	s.FundAcc(addr1, createPoolMsg.InitialPoolLiquidity.Sort())
+	_, err := s.RunMsg(&createPoolMsg)
+	s.Require().NoError(err)
+
+	// the pool was created with a constant amplification, as if a ramp had just ended,
+	// so it cannot be ramped before the minimum ramp duration
+	rampMsg := stableswap.NewMsgStableSwapRampAmplification(createPoolMsg.Sender, nextPoolId, 500, s.Ctx.BlockTime().Add(2*types.StableswapMinAmplificationRampDuration))
+	_, err = s.RunMsg(&rampMsg)
+	s.Require().ErrorAs(err, &types.AmplificationRampTooEarlyError{})
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(types.StableswapMinAmplificationRampDuration))
+	res, err := s.RunMsg(&rampMsg)
+	s.Require().NoError(err)
+	emitted := false
+	for _, event := range res.GetEvents() {
+		emitted = emitted || event.Type == types.TypeEvtRampAmplification
+	}
+	s.Require().True(emitted)
+
+	// halfway through the ramp
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(types.StableswapMinAmplificationRampDuration / 2))
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, nextPoolId)
+	s.Require().NoError(err)
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	s.Require().True(ok)
+	s.Require().Equal(sdk.NewDec(300), stableswapPool.GetAmplification(s.Ctx))
+
+	// only the scaling factor controller can ramp the amplification
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour * 24))
+	otherRampMsg := stableswap.NewMsgStableSwapRampAmplification(sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), nextPoolId, 500, s.Ctx.BlockTime().Add(types.StableswapMinAmplificationRampDuration))
+	_, err = s.RunMsg(&otherRampMsg)
+	s.Require().ErrorIs(err, types.ErrNotScalingFactorGovernor)
+}
@@ -1,6 +1,9 @@
 package stableswap
 
 import (
+	"fmt"
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -10,8 +13,10 @@ import (
 )
 
 const (
-	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
-	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
+	TypeMsgCreateStableswapPool                 = "create_stableswap_pool"
+	TypeMsgStableSwapAdjustScalingFactors       = "stable_swap_adjust_scaling_factors"
+	TypeMsgStableSwapRampAmplification          = "stable_swap_ramp_amplification"
+	TypeMsgStableSwapSetScalingFactorRateSource = "stable_swap_set_scaling_factor_rate_source"
 )
 
 var (
@@ -77,6 +82,14 @@ func (msg MsgCreateStableswapPool) ValidateBasic() error {
 		return err
 	}
 
+	// validation for amplification
+	// The message's amplification must be zero for the Solidly CFMM, or within bounds for the Curve StableSwap invariant
+	if msg.Amplification != 0 {
+		if err = validateAmplification(msg.Amplification); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -117,6 +130,10 @@ func (msg MsgCreateStableswapPool) CreatePool(ctx sdk.Context, poolId uint64) (p
 		return nil, err
 	}
 
+	if msg.Amplification != 0 {
+		stableswapPool.AmplificationParameters = newAmplificationParameters(msg.Amplification, ctx.BlockTime())
+	}
+
 	return &stableswapPool, nil
 }
 
@@ -169,3 +186,101 @@ func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{scalingFactorGovernor}
 }
+
+var _ sdk.Msg = &MsgStableSwapRampAmplification{}
+
+// Implement sdk.Msg
+func NewMsgStableSwapRampAmplification(
+	sender string,
+	poolID uint64,
+	futureAmplification uint64,
+	futureTime time.Time,
+) MsgStableSwapRampAmplification {
+	return MsgStableSwapRampAmplification{
+		Sender:              sender,
+		PoolID:              poolID,
+		FutureAmplification: futureAmplification,
+		FutureTime:          futureTime,
+	}
+}
+
+func (msg MsgStableSwapRampAmplification) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgStableSwapRampAmplification) Type() string { return TypeMsgStableSwapRampAmplification }
+func (msg MsgStableSwapRampAmplification) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return validateAmplification(msg.FutureAmplification)
+}
+
+func (msg MsgStableSwapRampAmplification) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapRampAmplification) GetSigners() []sdk.AccAddress {
+	scalingFactorController, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorController}
+}
+
+var _ sdk.Msg = &MsgStableSwapSetScalingFactorRateSource{}
+
+// Implement sdk.Msg
+func NewMsgStableSwapSetScalingFactorRateSource(
+	sender string,
+	poolID uint64,
+	rateSource *types.ScalingFactorRateSource,
+) MsgStableSwapSetScalingFactorRateSource {
+	return MsgStableSwapSetScalingFactorRateSource{
+		Sender:     sender,
+		PoolID:     poolID,
+		RateSource: rateSource,
+	}
+}
+
+func (msg MsgStableSwapSetScalingFactorRateSource) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgStableSwapSetScalingFactorRateSource) Type() string {
+	return TypeMsgStableSwapSetScalingFactorRateSource
+}
+
+func (msg MsgStableSwapSetScalingFactorRateSource) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	// A nil rate source removes the current one
+	if msg.RateSource == nil {
+		return nil
+	}
+
+	if msg.RateSource.PoolId != msg.PoolID {
+		return fmt.Errorf("rate source pool id (%d) does not match pool id (%d)", msg.RateSource.PoolId, msg.PoolID)
+	}
+
+	return msg.RateSource.Validate()
+}
+
+func (msg MsgStableSwapSetScalingFactorRateSource) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapSetScalingFactorRateSource) GetSigners() []sdk.AccAddress {
+	scalingFactorController, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorController}
+}
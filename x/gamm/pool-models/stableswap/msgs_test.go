@@ -1,10 +1,13 @@
 package stableswap_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
 	appParams "github.com/osmosis-labs/osmosis/v16/app/params"
@@ -73,6 +76,30 @@ func TestMsgCreateStableswapPoolValidateBasic(t *testing.T) {
 			}),
 			expectPass: true,
 		},
+		{
+			name: "valid amplification",
+			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.Amplification = 100
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "max amplification",
+			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.Amplification = types.StableswapMaxAmplification
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "amplification above max",
+			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.Amplification = types.StableswapMaxAmplification + 1
+				return msg
+			}),
+			expectPass: false,
+		},
 		{
 			name: "invalid sender",
 			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
@@ -296,6 +323,160 @@ func TestMsgCreateStableswapPoolValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgStableSwapRampAmplificationValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	futureTime := time.Unix(1_700_000_000, 0).UTC()
+
+	msg := stableswap.NewMsgStableSwapRampAmplification(addr1.String(), 1, 100, futureTime)
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "stable_swap_ramp_amplification")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := map[string]struct {
+		msg         stableswap.MsgStableSwapRampAmplification
+		expectedErr error
+	}{
+		"proper msg": {
+			msg: msg,
+		},
+		"invalid sender": {
+			msg:         stableswap.NewMsgStableSwapRampAmplification(sdk.AccAddress("invalid").String(), 1, 100, futureTime),
+			expectedErr: sdkerrors.ErrInvalidAddress,
+		},
+		"zero amplification": {
+			msg:         stableswap.NewMsgStableSwapRampAmplification(addr1.String(), 1, 0, futureTime),
+			expectedErr: types.InvalidAmplificationError{Amplification: 0},
+		},
+		"amplification above max": {
+			msg:         stableswap.NewMsgStableSwapRampAmplification(addr1.String(), 1, types.StableswapMaxAmplification+1, futureTime),
+			expectedErr: types.InvalidAmplificationError{Amplification: types.StableswapMaxAmplification + 1},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgStableSwapSetScalingFactorRateSourceValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	contractAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	validRateSource := func(modify func(*types.ScalingFactorRateSource)) *types.ScalingFactorRateSource {
+		rateSource := types.ScalingFactorRateSource{
+			PoolId:         1,
+			ReferenceDenom: "bar",
+			DenomRateSources: []types.DenomRateSource{
+				{Denom: "foo", Twap: &types.TwapRateSource{PoolId: 2, Duration: time.Hour}},
+				{Denom: "baz", ContractAddress: contractAddr.String()},
+			},
+			MaxChangePerUpdate: sdk.NewDecWithPrec(1, 3),
+			EpochIdentifier:    "day",
+		}
+		if modify != nil {
+			modify(&rateSource)
+		}
+		return &rateSource
+	}
+
+	msg := stableswap.NewMsgStableSwapSetScalingFactorRateSource(addr1.String(), 1, validRateSource(nil))
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "stable_swap_set_scaling_factor_rate_source")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := map[string]struct {
+		msg         stableswap.MsgStableSwapSetScalingFactorRateSource
+		expectedErr error
+	}{
+		"proper msg": {
+			msg: msg,
+		},
+		"removal": {
+			msg: stableswap.NewMsgStableSwapSetScalingFactorRateSource(addr1.String(), 1, nil),
+		},
+		"invalid sender": {
+			msg:         stableswap.NewMsgStableSwapSetScalingFactorRateSource(sdk.AccAddress("invalid").String(), 1, validRateSource(nil)),
+			expectedErr: sdkerrors.ErrInvalidAddress,
+		},
+		"rate source of another pool": {
+			msg:         stableswap.NewMsgStableSwapSetScalingFactorRateSource(addr1.String(), 2, validRateSource(nil)),
+			expectedErr: fmt.Errorf("rate source pool id (1) does not match pool id (2)"),
+		},
+		"zero max change": {
+			msg: stableswap.NewMsgStableSwapSetScalingFactorRateSource(addr1.String(), 1, validRateSource(func(rs *types.ScalingFactorRateSource) {
+				rs.MaxChangePerUpdate = sdk.ZeroDec()
+			})),
+			expectedErr: types.InvalidMaxScalingFactorChangeError{MaxChangePerUpdate: sdk.ZeroDec()},
+		},
+		"max change of 1": {
+			msg: stableswap.NewMsgStableSwapSetScalingFactorRateSource(addr1.String(), 1, validRateSource(func(rs *types.ScalingFactorRateSource) {
+				rs.MaxChangePerUpdate = sdk.OneDec()
+			})),
+			expectedErr: types.InvalidMaxScalingFactorChangeError{MaxChangePerUpdate: sdk.OneDec()},
+		},
+		"no denom rate sources": {
+			msg: stableswap.NewMsgStableSwapSetScalingFactorRateSource(addr1.String(), 1, validRateSource(func(rs *types.ScalingFactorRateSource) {
+				rs.DenomRateSources = nil
+			})),
+			expectedErr: types.RateSourceDenomsMismatchError{PoolId: 1, ReferenceDenom: "bar"},
+		},
+		"contract rate source updating every block": {
+			msg: stableswap.NewMsgStableSwapSetScalingFactorRateSource(addr1.String(), 1, validRateSource(func(rs *types.ScalingFactorRateSource) {
+				rs.EpochIdentifier = ""
+			})),
+			expectedErr: types.ContractRateSourceEveryBlockError{PoolId: 1, Denom: "baz"},
+		},
+		"denom rate source with both twap and contract": {
+			msg: stableswap.NewMsgStableSwapSetScalingFactorRateSource(addr1.String(), 1, validRateSource(func(rs *types.ScalingFactorRateSource) {
+				rs.DenomRateSources[0].ContractAddress = contractAddr.String()
+			})),
+			expectedErr: types.InvalidDenomRateSourceError{Denom: "foo"},
+		},
+		"denom rate source with neither twap nor contract": {
+			msg: stableswap.NewMsgStableSwapSetScalingFactorRateSource(addr1.String(), 1, validRateSource(func(rs *types.ScalingFactorRateSource) {
+				rs.DenomRateSources[1].ContractAddress = ""
+			})),
+			expectedErr: types.InvalidDenomRateSourceError{Denom: "baz"},
+		},
+		"rate source for the reference denom": {
+			msg: stableswap.NewMsgStableSwapSetScalingFactorRateSource(addr1.String(), 1, validRateSource(func(rs *types.ScalingFactorRateSource) {
+				rs.DenomRateSources[1].Denom = "bar"
+			})),
+			expectedErr: types.DuplicateDenomRateSourceError{Denom: "bar"},
+		},
+		"twap of the pool itself": {
+			msg: stableswap.NewMsgStableSwapSetScalingFactorRateSource(addr1.String(), 1, validRateSource(func(rs *types.ScalingFactorRateSource) {
+				rs.DenomRateSources[0].Twap.PoolId = 1
+			})),
+			expectedErr: types.TwapRateSourceSamePoolError{PoolId: 1},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func (suite *TestSuite) TestMsgCreateStableswapPool() {
 	suite.SetupTest()
 
@@ -322,6 +503,18 @@ func (suite *TestSuite) TestMsgCreateStableswapPool() {
 			},
 			poolId: 1,
 		},
+		"amplified pool": {
+			msg: stableswap.MsgCreateStableswapPool{
+				Sender:                  suite.TestAccs[0].String(),
+				PoolParams:              validParams,
+				InitialPoolLiquidity:    validInitialLiquidity,
+				ScalingFactors:          validScalingFactors,
+				FuturePoolGovernor:      "",
+				ScalingFactorController: "",
+				Amplification:           200,
+			},
+			poolId: 1,
+		},
 		"error test - more scaling factors than initial liquidity": {
 			msg: stableswap.MsgCreateStableswapPool{
 				Sender:                  suite.TestAccs[0].String(),
@@ -353,6 +546,13 @@ func (suite *TestSuite) TestMsgCreateStableswapPool() {
 
 			suite.Require().Equal(tc.msg.InitialPoolLiquidity, cfmmPool.GetTotalPoolLiquidity(suite.Ctx))
 			suite.Require().Equal(types.InitPoolSharesSupply, cfmmPool.GetTotalShares())
+
+			stableswapPool, ok := pool.(*stableswap.Pool)
+			suite.Require().True(ok)
+			suite.Require().Equal(tc.msg.Amplification != 0, stableswapPool.IsAmplified())
+			if tc.msg.Amplification != 0 {
+				suite.Require().Equal(sdk.NewDec(int64(tc.msg.Amplification)), stableswapPool.GetAmplification(suite.Ctx))
+			}
 		})
 	}
 }
@@ -243,7 +243,7 @@ func (p Pool) CalcOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDeno
 	if tokenIn.Len() != 1 {
 		return sdk.Coin{}, errors.New("stableswap CalcOutAmtGivenIn: tokenIn is of wrong length")
 	}
-	outAmtDec, err := p.calcOutAmtGivenIn(tokenIn[0], tokenOutDenom, spreadFactor)
+	outAmtDec, err := p.calcOutAmtGivenIn(ctx, tokenIn[0], tokenOutDenom, spreadFactor)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
@@ -279,7 +279,7 @@ func (p Pool) CalcInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDeno
 		return sdk.Coin{}, errors.New("stableswap CalcInAmtGivenOut: tokenOut is of wrong length")
 	}
 
-	amt, err := p.calcInAmtGivenOut(tokenOut[0], tokenInDenom, spreadFactor)
+	amt, err := p.calcInAmtGivenOut(ctx, tokenOut[0], tokenInDenom, spreadFactor)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
@@ -313,7 +313,7 @@ func (p *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDen
 // SpotPrice calculates the approximate amount of `baseDenom` one would receive for
 // an input dx of `quoteDenom` (to simplify calculations, we approximate dx = 1)
 func (p Pool) SpotPrice(ctx sdk.Context, quoteAssetDenom string, baseAssetDenom string) (sdk.Dec, error) {
-	return p.spotPrice(quoteAssetDenom, baseAssetDenom)
+	return p.spotPrice(ctx, quoteAssetDenom, baseAssetDenom)
 }
 
 func (p Pool) Copy() Pool {
@@ -488,3 +488,136 @@ func applyScalingFactorMultiplier(scalingFactors []uint64) ([]uint64, error) {
 func (p *Pool) AsSerializablePool() poolmanagertypes.PoolI {
 	return p
 }
+
+// AddPoolAsset adds the given token to the pool with the given scaling factor, scaled by
+// types.ScalingFactorMultiplier, and returns the number of shares the token is worth.
+// As the pool assets are pegged, the token is worth its share of the pool's scaled liquidity,
+// so that the shares out are the total shares scaled by the ratio of the scaled token amount
+// to the previous scaled liquidity.
+// As the scaling factor sets the token's price against the previous assets, this must only be
+// called through governance, see keeper.HandleAddPoolAssetProposal.
+// Returns error if the denom is already in the pool, if the pool would have more than
+// types.MaxNumOfAssetsInPool assets, or if the scaling factor or the scaled amount is invalid.
+func (p *Pool) AddPoolAsset(tokenIn sdk.Coin, scalingFactor uint64) (sharesOut sdk.Int, err error) {
+	if !tokenIn.IsValid() || !tokenIn.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("token in (%s) must be valid and positive", tokenIn)
+	}
+	if p.PoolLiquidity.AmountOf(tokenIn.Denom).IsPositive() {
+		return sdk.Int{}, types.ErrDenomAlreadyInPool
+	}
+
+	scalingFactors, err := applyScalingFactorMultiplier([]uint64{scalingFactor})
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	newLiquidity := p.PoolLiquidity.Add(tokenIn)
+	newScalingFactors := make([]uint64, 0, len(newLiquidity))
+	for _, coin := range newLiquidity {
+		if coin.Denom == tokenIn.Denom {
+			newScalingFactors = append(newScalingFactors, scalingFactors[0])
+			continue
+		}
+		newScalingFactors = append(newScalingFactors, p.GetScalingFactorByDenom(coin.Denom))
+	}
+	if err := validateScalingFactors(newScalingFactors, len(newLiquidity)); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := validatePoolLiquidity(newLiquidity, newScalingFactors); err != nil {
+		return sdk.Int{}, err
+	}
+
+	scaledTokenIn := sdk.NewDecFromInt(tokenIn.Amount).QuoInt64(int64(scalingFactors[0]))
+	sharesOut = sdk.NewDecFromInt(p.GetTotalShares()).Mul(scaledTokenIn).Quo(p.scaledLiquidity()).TruncateInt()
+	if !sharesOut.IsPositive() {
+		return sdk.Int{}, errorsmod.Wrapf(types.ErrInvalidMathApprox, "share out amount must be positive")
+	}
+
+	p.PoolLiquidity = newLiquidity
+	p.ScalingFactors = newScalingFactors
+	p.TotalShares.Amount = p.TotalShares.Amount.Add(sharesOut)
+	return sharesOut, nil
+}
+
+// RemovePoolAsset removes the asset with the given denom from the pool in exchange for tokenIn, the proceeds of
+// swapping the asset's whole balance into another pool asset, which are added to that asset's reserve.
+// It returns the asset's whole balance with the number of shares it was worth. The asset is priced by evaluating
+// the pool's invariant before and after removing it, so that the shares it was worth are the total shares scaled
+// by the share of the invariant lost without it, rounded up, or zero if removing it does not lower the invariant.
+// The total shares are unchanged, as the LPs keep the value of the asset through tokenIn.
+// As tokenIn sets the price the asset is exchanged for, this must only be called through governance,
+// see keeper.HandleRemovePoolAssetProposal.
+// Returns error if the denom or tokenIn's denom is not in the pool, if tokenIn is the asset removed or is not
+// positive, if the pool would have less than types.MinNumOfAssetsInPool assets, or if the resulting liquidity
+// is invalid.
+func (p *Pool) RemovePoolAsset(ctx sdk.Context, denom string, tokenIn sdk.Coin) (tokenOut sdk.Coin, sharesValue sdk.Int, err error) {
+	index := -1
+	for i, coin := range p.PoolLiquidity {
+		if coin.Denom == denom {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return sdk.Coin{}, sdk.Int{}, errorsmod.Wrapf(types.ErrDenomNotFoundInPool, "denom %s", denom)
+	}
+	if p.NumAssets() <= types.MinNumOfAssetsInPool {
+		return sdk.Coin{}, sdk.Int{}, types.ErrTooFewPoolAssets
+	}
+	if !tokenIn.IsValid() || !tokenIn.IsPositive() || tokenIn.Denom == denom {
+		return sdk.Coin{}, sdk.Int{}, fmt.Errorf("token in (%s) must be valid, positive and not the removed asset", tokenIn)
+	}
+	if !p.PoolLiquidity.AmountOf(tokenIn.Denom).IsPositive() {
+		return sdk.Coin{}, sdk.Int{}, errorsmod.Wrapf(types.ErrDenomNotFoundInPool, "denom %s", tokenIn.Denom)
+	}
+
+	newLiquidity := make(sdk.Coins, 0, p.NumAssets()-1)
+	newLiquidity = append(newLiquidity, p.PoolLiquidity[:index]...)
+	newLiquidity = append(newLiquidity, p.PoolLiquidity[index+1:]...)
+	newScalingFactors := make([]uint64, 0, p.NumAssets()-1)
+	newScalingFactors = append(newScalingFactors, p.ScalingFactors[:index]...)
+	newScalingFactors = append(newScalingFactors, p.ScalingFactors[index+1:]...)
+
+	invariantBefore, err := p.scaledInvariant(ctx, p.PoolLiquidity, p.ScalingFactors)
+	if err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	invariantAfter, err := p.scaledInvariant(ctx, newLiquidity, newScalingFactors)
+	if err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	sharesValue = sdk.ZeroInt()
+	if invariantAfter.LT(invariantBefore) {
+		lostRatio := osmomath.OneDec().Sub(invariantAfter.Quo(invariantBefore))
+		totalShares := osmomath.NewDecFromInt(osmomath.NewIntFromBigInt(p.GetTotalShares().BigInt()))
+		sharesValue = sdk.NewIntFromBigInt(totalShares.Mul(lostRatio).Ceil().TruncateInt().BigInt())
+	}
+
+	newLiquidity = newLiquidity.Add(tokenIn)
+	if err := validatePoolLiquidity(newLiquidity, newScalingFactors); err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+
+	tokenOut = p.PoolLiquidity[index]
+	p.PoolLiquidity = newLiquidity
+	p.ScalingFactors = newScalingFactors
+	return tokenOut, sharesValue, nil
+}
+
+// scaledInvariant returns the pool's invariant of the given liquidity, scaled by the given scaling factors.
+func (p Pool) scaledInvariant(ctx sdk.Context, liquidity sdk.Coins, scalingFactors []uint64) (osmomath.BigDec, error) {
+	reserves, err := osmomath.DivCoinAmtsByU64ToBigDec(liquidity, scalingFactors, osmomath.RoundDown)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+	return p.poolInvariant(ctx, reserves)
+}
+
+// scaledLiquidity returns the sum of the pool's reserves, each divided by its scaling factor.
+func (p Pool) scaledLiquidity() sdk.Dec {
+	scaledLiquidity := sdk.ZeroDec()
+	for i, coin := range p.PoolLiquidity {
+		scaledLiquidity = scaledLiquidity.Add(sdk.NewDecFromInt(coin.Amount).QuoInt64(int64(p.ScalingFactors[i])))
+	}
+	return scaledLiquidity
+}
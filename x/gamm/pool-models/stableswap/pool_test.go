@@ -2,8 +2,10 @@
 package stableswap
 
 import (
+	"fmt"
 	"testing"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -536,7 +538,7 @@ func TestCalcJoinPoolNoSwapShares(t *testing.T) {
 			tokensIn:        sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(tenPercentOfTwoPool)), sdk.NewCoin("bar", sdk.NewInt(tenPercentOfTwoPool))),
 			poolAssets:      twoEvenStablePoolAssets,
 			scalingFactors:  defaultTwoAssetScalingFactors,
-			expNumShare:     sdk.NewIntFromUint64(10000000000000000000),
+			expNumShare:     sdk.MustNewDecFromStr("10000000000000000000").TruncateInt(),
 			expTokensJoined: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(tenPercentOfTwoPool)), sdk.NewCoin("bar", sdk.NewInt(tenPercentOfTwoPool))),
 			expPoolAssets:   twoEvenStablePoolAssets,
 			expectPass:      true,
@@ -545,7 +547,7 @@ func TestCalcJoinPoolNoSwapShares(t *testing.T) {
 			tokensIn:        sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(tenPercentOfTwoPool)), sdk.NewCoin("bar", sdk.NewInt(tenPercentOfTwoPool+1))),
 			poolAssets:      twoEvenStablePoolAssets,
 			scalingFactors:  defaultTwoAssetScalingFactors,
-			expNumShare:     sdk.NewIntFromUint64(10000000000000000000),
+			expNumShare:     sdk.MustNewDecFromStr("10000000000000000000").TruncateInt(),
 			expTokensJoined: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(tenPercentOfTwoPool)), sdk.NewCoin("bar", sdk.NewInt(tenPercentOfTwoPool))),
 			expPoolAssets:   twoEvenStablePoolAssets,
 			expectPass:      true,
@@ -554,7 +556,7 @@ func TestCalcJoinPoolNoSwapShares(t *testing.T) {
 			tokensIn:        sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(2*tenPercentOfTwoPool)), sdk.NewCoin("bar", sdk.NewInt(tenPercentOfTwoPool))),
 			poolAssets:      twoUnevenStablePoolAssets,
 			scalingFactors:  defaultTwoAssetScalingFactors,
-			expNumShare:     sdk.NewIntFromUint64(10000000000000000000),
+			expNumShare:     sdk.MustNewDecFromStr("10000000000000000000").TruncateInt(),
 			expTokensJoined: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(2*tenPercentOfTwoPool)), sdk.NewCoin("bar", sdk.NewInt(tenPercentOfTwoPool))),
 			expPoolAssets:   twoUnevenStablePoolAssets,
 			expectPass:      true,
@@ -563,7 +565,7 @@ func TestCalcJoinPoolNoSwapShares(t *testing.T) {
 			tokensIn:        sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(2*tenPercentOfTwoPool)), sdk.NewCoin("bar", sdk.NewInt(tenPercentOfTwoPool+1))),
 			poolAssets:      twoUnevenStablePoolAssets,
 			scalingFactors:  defaultTwoAssetScalingFactors,
-			expNumShare:     sdk.NewIntFromUint64(10000000000000000000),
+			expNumShare:     sdk.MustNewDecFromStr("10000000000000000000").TruncateInt(),
 			expTokensJoined: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(2*tenPercentOfTwoPool)), sdk.NewCoin("bar", sdk.NewInt(tenPercentOfTwoPool))),
 			expPoolAssets:   twoUnevenStablePoolAssets,
 			expectPass:      true,
@@ -572,7 +574,7 @@ func TestCalcJoinPoolNoSwapShares(t *testing.T) {
 			tokensIn:        sdk.NewCoins(sdk.NewCoin("asset/a", sdk.NewInt(tenPercentOfThreePool)), sdk.NewCoin("asset/b", sdk.NewInt(tenPercentOfThreePool)), sdk.NewCoin("asset/c", sdk.NewInt(tenPercentOfThreePool))),
 			poolAssets:      threeEvenStablePoolAssets,
 			scalingFactors:  defaultThreeAssetScalingFactors,
-			expNumShare:     sdk.NewIntFromUint64(10000000000000000000),
+			expNumShare:     sdk.MustNewDecFromStr("10000000000000000000").TruncateInt(),
 			expTokensJoined: sdk.NewCoins(sdk.NewCoin("asset/a", sdk.NewInt(tenPercentOfThreePool)), sdk.NewCoin("asset/b", sdk.NewInt(tenPercentOfThreePool)), sdk.NewCoin("asset/c", sdk.NewInt(tenPercentOfThreePool))),
 			expPoolAssets:   threeEvenStablePoolAssets,
 			expectPass:      true,
@@ -581,7 +583,7 @@ func TestCalcJoinPoolNoSwapShares(t *testing.T) {
 			tokensIn:        sdk.NewCoins(sdk.NewCoin("asset/a", sdk.NewInt(tenPercentOfThreePool)), sdk.NewCoin("asset/b", sdk.NewInt(tenPercentOfThreePool)), sdk.NewCoin("asset/c", sdk.NewInt(tenPercentOfThreePool+1))),
 			poolAssets:      threeEvenStablePoolAssets,
 			scalingFactors:  defaultThreeAssetScalingFactors,
-			expNumShare:     sdk.NewIntFromUint64(10000000000000000000),
+			expNumShare:     sdk.MustNewDecFromStr("10000000000000000000").TruncateInt(),
 			expTokensJoined: sdk.NewCoins(sdk.NewCoin("asset/a", sdk.NewInt(tenPercentOfThreePool)), sdk.NewCoin("asset/b", sdk.NewInt(tenPercentOfThreePool)), sdk.NewCoin("asset/c", sdk.NewInt(tenPercentOfThreePool))),
 			expPoolAssets:   threeEvenStablePoolAssets,
 			expectPass:      true,
@@ -590,7 +592,7 @@ func TestCalcJoinPoolNoSwapShares(t *testing.T) {
 			tokensIn:        sdk.NewCoins(sdk.NewCoin("asset/a", sdk.NewInt(tenPercentOfThreePool)), sdk.NewCoin("asset/b", sdk.NewInt(2*tenPercentOfThreePool)), sdk.NewCoin("asset/c", sdk.NewInt(3*tenPercentOfThreePool))),
 			poolAssets:      threeUnevenStablePoolAssets,
 			scalingFactors:  defaultThreeAssetScalingFactors,
-			expNumShare:     sdk.NewIntFromUint64(10000000000000000000),
+			expNumShare:     sdk.MustNewDecFromStr("10000000000000000000").TruncateInt(),
 			expTokensJoined: sdk.NewCoins(sdk.NewCoin("asset/a", sdk.NewInt(tenPercentOfThreePool)), sdk.NewCoin("asset/b", sdk.NewInt(2*tenPercentOfThreePool)), sdk.NewCoin("asset/c", sdk.NewInt(3*tenPercentOfThreePool))),
 			expPoolAssets:   threeUnevenStablePoolAssets,
 			expectPass:      true,
@@ -599,7 +601,7 @@ func TestCalcJoinPoolNoSwapShares(t *testing.T) {
 			tokensIn:        sdk.NewCoins(sdk.NewCoin("asset/a", sdk.NewInt(tenPercentOfThreePool)), sdk.NewCoin("asset/b", sdk.NewInt(2*tenPercentOfThreePool)), sdk.NewCoin("asset/c", sdk.NewInt(3*tenPercentOfThreePool+1))),
 			poolAssets:      threeUnevenStablePoolAssets,
 			scalingFactors:  defaultThreeAssetScalingFactors,
-			expNumShare:     sdk.NewIntFromUint64(10000000000000000000),
+			expNumShare:     sdk.MustNewDecFromStr("10000000000000000000").TruncateInt(),
 			expTokensJoined: sdk.NewCoins(sdk.NewCoin("asset/a", sdk.NewInt(tenPercentOfThreePool)), sdk.NewCoin("asset/b", sdk.NewInt(2*tenPercentOfThreePool)), sdk.NewCoin("asset/c", sdk.NewInt(3*tenPercentOfThreePool))),
 			expPoolAssets:   threeUnevenStablePoolAssets,
 			expectPass:      true,
@@ -608,7 +610,7 @@ func TestCalcJoinPoolNoSwapShares(t *testing.T) {
 			tokensIn:        sdk.NewCoins(sdk.NewCoin("asset/a", sdk.NewInt(tenPercentOfThreePool)), sdk.NewCoin("asset/b", sdk.NewInt(2*tenPercentOfThreePool)), sdk.NewCoin("asset/c", sdk.NewInt(3*tenPercentOfThreePool))),
 			poolAssets:      threeUnevenStablePoolAssets,
 			scalingFactors:  []uint64{5, 9, 175},
-			expNumShare:     sdk.NewIntFromUint64(10000000000000000000),
+			expNumShare:     sdk.MustNewDecFromStr("10000000000000000000").TruncateInt(),
 			expTokensJoined: sdk.NewCoins(sdk.NewCoin("asset/a", sdk.NewInt(tenPercentOfThreePool)), sdk.NewCoin("asset/b", sdk.NewInt(2*tenPercentOfThreePool)), sdk.NewCoin("asset/c", sdk.NewInt(3*tenPercentOfThreePool))),
 			expPoolAssets:   threeUnevenStablePoolAssets,
 			expectPass:      true,
@@ -1348,7 +1350,7 @@ func TestStableswapSpotPrice(t *testing.T) {
 				if (tc.expectedPrice != sdk.Dec{}) {
 					expectedSpotPrice = tc.expectedPrice
 				} else {
-					expectedSpotPrice, err = p.calcOutAmtGivenIn(sdk.NewInt64Coin(tc.baseDenom, 1), tc.quoteDenom, sdk.ZeroDec())
+					expectedSpotPrice, err = p.calcOutAmtGivenIn(ctx, sdk.NewInt64Coin(tc.baseDenom, 1), tc.quoteDenom, sdk.ZeroDec())
 					require.NoError(t, err)
 				}
 
@@ -1401,3 +1403,204 @@ func TestValidateScalingFactors(t *testing.T) {
 		})
 	}
 }
+
+func TestAddPoolAsset(t *testing.T) {
+	eightEvenStablePoolAssets := fiveEvenStablePoolAssets.Add(
+		sdk.NewInt64Coin("asset/f", 1000000000),
+		sdk.NewInt64Coin("asset/g", 1000000000),
+		sdk.NewInt64Coin("asset/h", 1000000000),
+	)
+
+	tests := map[string]struct {
+		poolAssets             sdk.Coins
+		scalingFactors         []uint64
+		tokenIn                sdk.Coin
+		scalingFactor          uint64
+		expectedSharesOut      sdk.Int
+		expectedScalingFactors []uint64
+		expectedErr            error
+	}{
+		"add asset to even pool": {
+			poolAssets:     twoEvenStablePoolAssets,
+			scalingFactors: defaultTwoAssetScalingFactors,
+			tokenIn:        sdk.NewInt64Coin("baz", 1000000000),
+			scalingFactor:  1,
+			// the token in is half of the previous scaled liquidity
+			expectedSharesOut:      types.InitPoolSharesSupply.QuoRaw(2),
+			expectedScalingFactors: []uint64{1, 1, 1},
+		},
+		"add asset with scaling factor": {
+			poolAssets:             twoEvenStablePoolAssets,
+			scalingFactors:         defaultTwoAssetScalingFactors,
+			tokenIn:                sdk.NewInt64Coin("zab", 1000000000000),
+			scalingFactor:          1000,
+			expectedSharesOut:      types.InitPoolSharesSupply.QuoRaw(2),
+			expectedScalingFactors: []uint64{1, 1, 1000},
+		},
+		"add asset to uneven pool": {
+			poolAssets:             threeUnevenStablePoolAssets,
+			scalingFactors:         []uint64{1, 2, 3},
+			tokenIn:                sdk.NewInt64Coin("asset/d", 3000000),
+			scalingFactor:          1,
+			expectedSharesOut:      types.InitPoolSharesSupply.QuoRaw(1),
+			expectedScalingFactors: []uint64{1, 2, 3, 1},
+		},
+		"error: denom already in pool": {
+			poolAssets:     twoEvenStablePoolAssets,
+			scalingFactors: defaultTwoAssetScalingFactors,
+			tokenIn:        sdk.NewInt64Coin("foo", 1000000000),
+			scalingFactor:  1,
+			expectedErr:    types.ErrDenomAlreadyInPool,
+		},
+		"error: too many assets": {
+			poolAssets:     eightEvenStablePoolAssets,
+			scalingFactors: []uint64{1, 1, 1, 1, 1, 1, 1, 1},
+			tokenIn:        sdk.NewInt64Coin("asset/i", 1000000000),
+			scalingFactor:  1,
+			expectedErr:    types.ErrTooManyPoolAssets,
+		},
+		"error: zero scaling factor": {
+			poolAssets:     twoEvenStablePoolAssets,
+			scalingFactors: defaultTwoAssetScalingFactors,
+			tokenIn:        sdk.NewInt64Coin("baz", 1000000000),
+			scalingFactor:  0,
+			expectedErr:    types.ErrInvalidScalingFactors,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
+
+			sharesOut, err := pool.AddPoolAsset(tc.tokenIn, tc.scalingFactor)
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Equal(t, tc.poolAssets, pool.PoolLiquidity)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectedSharesOut, sharesOut)
+			require.Equal(t, types.InitPoolSharesSupply.Add(sharesOut), pool.GetTotalShares())
+			require.Equal(t, tc.poolAssets.Add(tc.tokenIn), pool.PoolLiquidity)
+			require.Equal(t, tc.expectedScalingFactors, pool.ScalingFactors)
+			require.Equal(t, tc.scalingFactor, pool.GetScalingFactorByDenom(tc.tokenIn.Denom))
+		})
+	}
+}
+
+func TestRemovePoolAsset(t *testing.T) {
+	tests := map[string]struct {
+		poolAssets             sdk.Coins
+		scalingFactors         []uint64
+		amplification          *AmplificationParameters
+		denom                  string
+		tokenIn                sdk.Coin
+		expectedSharesValue    sdk.Int
+		expectedScalingFactors []uint64
+		expectedErr            error
+	}{
+		"remove asset from even pool": {
+			poolAssets:     threeEvenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			denom:          "asset/b",
+			tokenIn:        sdk.NewInt64Coin("asset/a", 1000000),
+			// the invariant of even reserves is their sum, so that the asset is a third of it
+			expectedSharesValue:    types.InitPoolSharesSupply.QuoRaw(3),
+			expectedScalingFactors: []uint64{1, 1},
+		},
+		"remove asset from uneven pool": {
+			poolAssets:     threeUnevenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			denom:          "asset/c",
+			tokenIn:        sdk.NewInt64Coin("asset/a", 2900000),
+			// 1 - (2 (80/81)^(1/4) 1.5) / (3 (0.875)^(1/5) 2)
+			expectedSharesValue:    sdk.MustNewDecFromStr("48805932614576333806").TruncateInt(),
+			expectedScalingFactors: []uint64{1, 1},
+		},
+		"remove scarce asset from uneven pool": {
+			poolAssets:     threeUnevenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			denom:          "asset/a",
+			tokenIn:        sdk.NewInt64Coin("asset/b", 1000000),
+			// 1 - (2 (0.96)^(1/4) 2.5) / (3 (0.875)^(1/5) 2)
+			expectedSharesValue:    sdk.MustNewDecFromStr("14445415505971761749").TruncateInt(),
+			expectedScalingFactors: []uint64{1, 1},
+		},
+		"remove asset from uneven amplified pool": {
+			poolAssets:     threeUnevenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			amplification:  &defaultRamp,
+			denom:          "asset/c",
+			tokenIn:        sdk.NewInt64Coin("asset/a", 3000000),
+			// the Curve invariant of A = 100 is nearly the sum of the reserves
+			expectedSharesValue:    sdk.MustNewDecFromStr("50009371019084060308").TruncateInt(),
+			expectedScalingFactors: []uint64{1, 1},
+		},
+		"remove asset with scaling factor": {
+			poolAssets:             threeUnevenStablePoolAssets,
+			scalingFactors:         []uint64{1, 2, 3},
+			denom:                  "asset/a",
+			tokenIn:                sdk.NewInt64Coin("asset/b", 2000000),
+			expectedSharesValue:    types.InitPoolSharesSupply.QuoRaw(3),
+			expectedScalingFactors: []uint64{2, 3},
+		},
+		"error: denom not in pool": {
+			poolAssets:     threeEvenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			denom:          "asset/d",
+			tokenIn:        sdk.NewInt64Coin("asset/a", 1000000),
+			expectedErr:    errorsmod.Wrapf(types.ErrDenomNotFoundInPool, "denom %s", "asset/d"),
+		},
+		"error: token in not in pool": {
+			poolAssets:     threeEvenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			denom:          "asset/b",
+			tokenIn:        sdk.NewInt64Coin("asset/d", 1000000),
+			expectedErr:    errorsmod.Wrapf(types.ErrDenomNotFoundInPool, "denom %s", "asset/d"),
+		},
+		"error: token in is the removed asset": {
+			poolAssets:     threeEvenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			denom:          "asset/b",
+			tokenIn:        sdk.NewInt64Coin("asset/b", 1000000),
+			expectedErr:    fmt.Errorf("token in (%s) must be valid, positive and not the removed asset", sdk.NewInt64Coin("asset/b", 1000000)),
+		},
+		"error: too few assets": {
+			poolAssets:     twoEvenStablePoolAssets,
+			scalingFactors: defaultTwoAssetScalingFactors,
+			denom:          "foo",
+			tokenIn:        sdk.NewInt64Coin("bar", 1000000),
+			expectedErr:    types.ErrTooFewPoolAssets,
+		},
+	}
+
+	// the invariants are approximated by Newton's method and integer roots
+	errTolerance := osmomath.ErrTolerance{MultiplicativeTolerance: sdk.NewDecWithPrec(1, 12)}
+	ctx := sdk.Context{}.WithBlockTime(defaultRampStartTime)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
+			if tc.amplification != nil {
+				pool = amplifiedPoolStructFromAssets(tc.poolAssets, tc.scalingFactors, *tc.amplification)
+			}
+
+			tokenOut, sharesValue, err := pool.RemovePoolAsset(ctx, tc.denom, tc.tokenIn)
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr.Error(), err.Error())
+				require.Equal(t, tc.poolAssets, pool.PoolLiquidity)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, sdk.NewCoin(tc.denom, tc.poolAssets.AmountOf(tc.denom)), tokenOut)
+			require.Equal(t, 0, errTolerance.Compare(tc.expectedSharesValue, sharesValue), "expected %s, got %s", tc.expectedSharesValue, sharesValue)
+			// the LPs keep the value of the removed asset through the token in
+			require.Equal(t, types.InitPoolSharesSupply, pool.GetTotalShares())
+			require.Equal(t, tc.poolAssets.Sub(sdk.NewCoins(tokenOut)).Add(tc.tokenIn), pool.PoolLiquidity)
+			require.Equal(t, tc.expectedScalingFactors, pool.ScalingFactors)
+		})
+	}
+}
@@ -13,15 +13,18 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -96,6 +99,10 @@ type Pool struct {
 	ScalingFactors []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factors"`
 	// scaling_factor_controller is the address can adjust pool scaling factors
 	ScalingFactorController string `protobuf:"bytes,8,opt,name=scaling_factor_controller,json=scalingFactorController,proto3" json:"scaling_factor_controller,omitempty" yaml:"scaling_factor_controller"`
+	// amplification_parameters, if set, makes the pool use the Curve StableSwap
+	// invariant with the amplification coefficient they describe instead of the
+	// Solidly CFMM. They can only be ramped by the scaling_factor_controller.
+	AmplificationParameters *AmplificationParameters `protobuf:"bytes,9,opt,name=amplification_parameters,json=amplificationParameters,proto3" json:"amplification_parameters,omitempty" yaml:"amplification_parameters"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -130,9 +137,68 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// AmplificationParameters describes the amplification coefficient A of a
+// stableswap pool using the Curve StableSwap invariant. A is ramped linearly
+// from initial_amplification at ramp_start_time to future_amplification at
+// ramp_end_time, and is equal to future_amplification afterwards.
+type AmplificationParameters struct {
+	InitialAmplification github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=initial_amplification,json=initialAmplification,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_amplification" yaml:"initial_amplification"`
+	FutureAmplification  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=future_amplification,json=futureAmplification,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"future_amplification" yaml:"future_amplification"`
+	RampStartTime        time.Time                              `protobuf:"bytes,3,opt,name=ramp_start_time,json=rampStartTime,proto3,stdtime" json:"ramp_start_time" yaml:"ramp_start_time"`
+	RampEndTime          time.Time                              `protobuf:"bytes,4,opt,name=ramp_end_time,json=rampEndTime,proto3,stdtime" json:"ramp_end_time" yaml:"ramp_end_time"`
+}
+
+func (m *AmplificationParameters) Reset()         { *m = AmplificationParameters{} }
+func (m *AmplificationParameters) String() string { return proto.CompactTextString(m) }
+func (*AmplificationParameters) ProtoMessage()    {}
+func (*AmplificationParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae0f054436f9999a, []int{2}
+}
+func (m *AmplificationParameters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AmplificationParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AmplificationParameters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AmplificationParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmplificationParameters.Merge(m, src)
+}
+func (m *AmplificationParameters) XXX_Size() int {
+	return m.Size()
+}
+func (m *AmplificationParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmplificationParameters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmplificationParameters proto.InternalMessageInfo
+
+func (m *AmplificationParameters) GetRampStartTime() time.Time {
+	if m != nil {
+		return m.RampStartTime
+	}
+	return time.Time{}
+}
+
+func (m *AmplificationParameters) GetRampEndTime() time.Time {
+	if m != nil {
+		return m.RampEndTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.PoolParams")
 	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.Pool")
+	proto.RegisterType((*AmplificationParameters)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.AmplificationParameters")
 }
 
 func init() {
@@ -140,50 +206,62 @@ func init() {
 }
 
 var fileDescriptor_ae0f054436f9999a = []byte{
-	// 676 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x4f, 0xd4, 0x4c,
-	0x18, 0xde, 0xc2, 0xc2, 0xf2, 0x0d, 0xdf, 0xb7, 0xe4, 0xab, 0x24, 0x16, 0x88, 0x9d, 0xa5, 0x11,
-	0xb2, 0x21, 0x6e, 0xeb, 0x6a, 0x42, 0x22, 0x37, 0x16, 0x83, 0x31, 0x31, 0x06, 0xcb, 0xc9, 0x1f,
-	0xc9, 0x3a, 0x6d, 0x67, 0xcb, 0xc4, 0x76, 0xa7, 0x76, 0xa6, 0x08, 0x17, 0xcf, 0xc6, 0x93, 0x47,
-	0x8f, 0x9c, 0x3d, 0x79, 0xf0, 0x8f, 0x20, 0x7a, 0xe1, 0x68, 0x3c, 0x54, 0x03, 0x07, 0xaf, 0x66,
-	0xff, 0x02, 0x33, 0xd3, 0xd9, 0x5d, 0x16, 0x95, 0x90, 0x78, 0xd9, 0x9d, 0xf7, 0x7d, 0x9f, 0xf7,
-	0x79, 0x7f, 0x3d, 0x29, 0xb8, 0x45, 0x59, 0x4c, 0x19, 0x61, 0x4e, 0x88, 0xe2, 0xd8, 0x49, 0x28,
-	0x8d, 0x1a, 0x31, 0x0d, 0x70, 0xc4, 0x1c, 0xc6, 0x91, 0x17, 0x61, 0xf6, 0x02, 0x25, 0xa7, 0x9e,
-	0x6d, 0x81, 0xb0, 0x93, 0x94, 0x72, 0xaa, 0xaf, 0xa8, 0x54, 0x5b, 0xa4, 0xda, 0x22, 0x50, 0x64,
-	0xda, 0x43, 0xb8, 0xbd, 0xdb, 0xf4, 0x30, 0x47, 0xcd, 0xf9, 0x39, 0x5f, 0x82, 0xdb, 0x32, 0xd3,
-	0x29, 0x8c, 0x82, 0x66, 0x7e, 0x36, 0xa4, 0x21, 0x2d, 0xfc, 0xe2, 0xa5, 0xbc, 0xff, 0xa3, 0x98,
-	0x74, 0xa9, 0x23, 0x7f, 0x95, 0xcb, 0x0c, 0x29, 0x0d, 0x23, 0xec, 0x48, 0xcb, 0xcb, 0x3a, 0x4e,
-	0x90, 0xa5, 0x88, 0x13, 0xda, 0x55, 0x71, 0x78, 0x36, 0xce, 0x49, 0x8c, 0x19, 0x47, 0x71, 0xd2,
-	0x27, 0x28, 0xea, 0x3a, 0x28, 0xe3, 0x3b, 0x8e, 0xea, 0x4c, 0x1a, 0x67, 0xe2, 0x1e, 0x62, 0x78,
-	0x10, 0xf7, 0x29, 0x51, 0x05, 0xac, 0x1f, 0x1a, 0x00, 0x5b, 0x94, 0x46, 0x5b, 0x28, 0x45, 0x31,
-	0xd3, 0x9f, 0x80, 0x29, 0xb9, 0x92, 0x0e, 0xc6, 0x86, 0x56, 0xd3, 0xea, 0xff, 0xb4, 0xd6, 0x0f,
-	0x73, 0x58, 0xfa, 0x92, 0xc3, 0xe5, 0x90, 0xf0, 0x9d, 0xcc, 0xb3, 0x7d, 0x1a, 0xab, 0x59, 0xd5,
-	0x5f, 0x83, 0x05, 0xcf, 0x1c, 0xbe, 0x9f, 0x60, 0x66, 0xdf, 0xc6, 0x7e, 0x2f, 0x87, 0x33, 0xfb,
-	0x28, 0x8e, 0xd6, 0xac, 0x3e, 0x8f, 0xe5, 0x56, 0xc4, 0x73, 0x13, 0x63, 0xc1, 0x8e, 0xf7, 0x08,
-	0x97, 0xec, 0x63, 0x7f, 0xc7, 0xde, 0xe7, 0xb1, 0xdc, 0x8a, 0x78, 0x6e, 0x62, 0xbc, 0xb6, 0xfc,
-	0xfa, 0xfb, 0xfb, 0x95, 0xc5, 0x91, 0xdb, 0x6f, 0x0f, 0xae, 0x36, 0x9c, 0xd1, 0xfa, 0x34, 0x01,
-	0xca, 0xc2, 0xd4, 0xaf, 0x81, 0x0a, 0x0a, 0x82, 0x14, 0x33, 0xa6, 0x66, 0xd5, 0x7b, 0x39, 0xac,
-	0x16, 0xfc, 0x2a, 0x60, 0xb9, 0x7d, 0x88, 0x5e, 0x05, 0x63, 0x24, 0x90, 0x6d, 0x97, 0xdd, 0x31,
-	0x12, 0xe8, 0x2f, 0xc1, 0xb4, 0xd0, 0x47, 0x3b, 0x91, 0xac, 0xc6, 0x78, 0x4d, 0xab, 0x4f, 0xdf,
-	0x58, 0xb5, 0x2f, 0x2e, 0x20, 0x7b, 0xd8, 0x53, 0x6b, 0x49, 0xec, 0xa1, 0x97, 0xc3, 0x2b, 0x6a,
-	0x77, 0xa3, 0xe2, 0x54, 0x35, 0x2c, 0x17, 0x24, 0xc3, 0x53, 0x3d, 0x00, 0xb3, 0x9d, 0x8c, 0x67,
-	0x29, 0x2e, 0x20, 0x21, 0xdd, 0xc5, 0x69, 0x97, 0xa6, 0x46, 0x59, 0x8e, 0x02, 0x7b, 0x39, 0x5c,
-	0x28, 0xc8, 0x7e, 0x87, 0xb2, 0x5c, 0xbd, 0x70, 0x8b, 0x1e, 0xee, 0x28, 0xa7, 0xfe, 0x10, 0xfc,
-	0xcb, 0x29, 0x47, 0x51, 0x9b, 0xed, 0xa0, 0x14, 0x33, 0x63, 0x42, 0xce, 0x34, 0x67, 0x2b, 0x6d,
-	0x0b, 0x0d, 0x0d, 0x9a, 0xdf, 0xa0, 0xa4, 0xdb, 0x5a, 0x50, 0x6d, 0x5f, 0x2a, 0x2a, 0x9d, 0x4e,
-	0xb6, 0xdc, 0x69, 0x69, 0x6e, 0x4b, 0x4b, 0x4f, 0x41, 0x55, 0x36, 0x10, 0x91, 0xe7, 0x19, 0x09,
-	0x08, 0xdf, 0x37, 0x26, 0x6b, 0xe3, 0xe7, 0x93, 0x5f, 0x17, 0xe4, 0xef, 0xbe, 0xc2, 0xfa, 0x05,
-	0xb4, 0x21, 0x12, 0x98, 0xfb, 0x9f, 0x28, 0x71, 0xaf, 0x5f, 0x41, 0xbf, 0x0f, 0x66, 0x98, 0x8f,
-	0x22, 0xd2, 0x0d, 0xdb, 0x1d, 0xe4, 0x73, 0x9a, 0x32, 0xa3, 0x52, 0x1b, 0xaf, 0x97, 0x5b, 0x4b,
-	0xbd, 0x1c, 0x2e, 0xfe, 0xb2, 0xe9, 0x33, 0x58, 0xcb, 0xad, 0x2a, 0xcf, 0x66, 0xe1, 0xd0, 0x9f,
-	0x82, 0xb9, 0x51, 0x4c, 0xdb, 0xa7, 0x5d, 0x9e, 0xd2, 0x28, 0xc2, 0xa9, 0x31, 0x25, 0xd7, 0x7e,
-	0xb5, 0x97, 0xc3, 0x9a, 0x62, 0xfe, 0x13, 0xd4, 0x72, 0x2f, 0x8f, 0x10, 0x6f, 0x0c, 0x22, 0x6b,
-	0xcd, 0x57, 0x07, 0xb0, 0xf4, 0xf6, 0x00, 0x96, 0x3e, 0x7e, 0x68, 0x4c, 0x88, 0xd3, 0xdc, 0x15,
-	0x9a, 0x5e, 0x38, 0x47, 0xd3, 0xad, 0xc7, 0x87, 0xc7, 0xa6, 0x76, 0x74, 0x6c, 0x6a, 0xdf, 0x8e,
-	0x4d, 0xed, 0xcd, 0x89, 0x59, 0x3a, 0x3a, 0x31, 0x4b, 0x9f, 0x4f, 0xcc, 0xd2, 0xa3, 0xf5, 0x53,
-	0x7b, 0x53, 0x0c, 0x8d, 0x08, 0x79, 0xac, 0x6f, 0x38, 0xbb, 0xcd, 0x55, 0x67, 0xef, 0xbc, 0x8f,
-	0xa4, 0x37, 0x29, 0x3f, 0x12, 0x37, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xce, 0x87, 0x39, 0xee,
-	0x52, 0x05, 0x00, 0x00,
+	// 867 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0x27, 0xdb, 0xa4, 0x9d, 0xd0, 0x44, 0xb8, 0x81, 0x38, 0x09, 0xec, 0x6c, 0x07, 0x5a,
+	0x45, 0x15, 0x6b, 0xb3, 0x20, 0x55, 0x22, 0xb7, 0x6c, 0x20, 0x08, 0x09, 0xaa, 0xe0, 0x70, 0xe1,
+	0x87, 0xe4, 0xce, 0xda, 0xb3, 0xce, 0x08, 0xdb, 0x63, 0x3c, 0xb3, 0xa1, 0xb9, 0x20, 0x24, 0x2e,
+	0x88, 0x53, 0x8f, 0x1c, 0x2b, 0x71, 0xe3, 0xc4, 0x81, 0x3f, 0xa2, 0xe2, 0xd4, 0x23, 0xe2, 0xe0,
+	0xa2, 0xe4, 0xc0, 0x85, 0x03, 0xec, 0x5f, 0x80, 0xe6, 0x87, 0x77, 0xd7, 0x4b, 0x12, 0x1a, 0x71,
+	0xd9, 0xf5, 0x7c, 0xef, 0xbd, 0xef, 0xfb, 0x66, 0xe6, 0xcd, 0x0c, 0x78, 0x8b, 0xf1, 0x94, 0x71,
+	0xca, 0xbd, 0x18, 0xa7, 0xa9, 0x97, 0x33, 0x96, 0x74, 0x52, 0x16, 0x91, 0x84, 0x7b, 0x5c, 0xe0,
+	0x7e, 0x42, 0xf8, 0x97, 0x38, 0x9f, 0xfa, 0x0c, 0x64, 0x86, 0x9b, 0x17, 0x4c, 0x30, 0xfb, 0x8e,
+	0x29, 0x75, 0x65, 0xa9, 0x2b, 0x03, 0xba, 0xd2, 0x9d, 0xa4, 0xbb, 0x47, 0xdd, 0x3e, 0x11, 0xb8,
+	0xbb, 0xb1, 0x1e, 0xaa, 0xe4, 0x40, 0x55, 0x7a, 0x7a, 0xa0, 0x69, 0x36, 0x56, 0x63, 0x16, 0x33,
+	0x8d, 0xcb, 0x2f, 0x83, 0x3e, 0x8f, 0x53, 0x9a, 0x31, 0x4f, 0xfd, 0x1a, 0xa8, 0x15, 0x33, 0x16,
+	0x27, 0xc4, 0x53, 0xa3, 0xfe, 0x70, 0xe0, 0x45, 0xc3, 0x02, 0x0b, 0xca, 0x32, 0x13, 0x87, 0xb3,
+	0x71, 0x41, 0x53, 0xc2, 0x05, 0x4e, 0xf3, 0x8a, 0x40, 0xeb, 0x7a, 0x78, 0x28, 0x0e, 0x3d, 0xe3,
+	0x4c, 0x0d, 0x66, 0xe2, 0x7d, 0xcc, 0xc9, 0x38, 0x1e, 0x32, 0x6a, 0x04, 0xd0, 0x5f, 0x16, 0x00,
+	0xfb, 0x8c, 0x25, 0xfb, 0xb8, 0xc0, 0x29, 0xb7, 0x3f, 0x03, 0x57, 0xd5, 0x92, 0x0c, 0x08, 0x71,
+	0xac, 0xb6, 0xb5, 0x75, 0xad, 0xb7, 0xf3, 0xb8, 0x84, 0x8d, 0xdf, 0x4a, 0x78, 0x3b, 0xa6, 0xe2,
+	0x70, 0xd8, 0x77, 0x43, 0x96, 0x9a, 0xb9, 0x9a, 0xbf, 0x0e, 0x8f, 0x3e, 0xf7, 0xc4, 0x71, 0x4e,
+	0xb8, 0xfb, 0x36, 0x09, 0x47, 0x25, 0x5c, 0x39, 0xc6, 0x69, 0xb2, 0x8d, 0x2a, 0x1e, 0xe4, 0x2f,
+	0xca, 0xcf, 0x3d, 0x42, 0x24, 0x3b, 0x79, 0x40, 0x85, 0x62, 0x9f, 0xfb, 0x7f, 0xec, 0x15, 0x0f,
+	0xf2, 0x17, 0xe5, 0xe7, 0x1e, 0x21, 0xdb, 0xb7, 0xbf, 0xfb, 0xe3, 0xa7, 0x3b, 0x37, 0x6b, 0x7b,
+	0x7f, 0x30, 0xde, 0xb5, 0xc9, 0x1c, 0xd1, 0xdf, 0x0b, 0xa0, 0x29, 0x87, 0xf6, 0x6b, 0x60, 0x11,
+	0x47, 0x51, 0x41, 0x38, 0x37, 0x73, 0xb5, 0x47, 0x25, 0x5c, 0xd6, 0xfc, 0x26, 0x80, 0xfc, 0x2a,
+	0xc5, 0x5e, 0x06, 0x73, 0x34, 0x52, 0xb6, 0x9b, 0xfe, 0x1c, 0x8d, 0xec, 0xaf, 0xc0, 0x92, 0xec,
+	0x8f, 0x20, 0x57, 0xac, 0xce, 0x7c, 0xdb, 0xda, 0x5a, 0x7a, 0xe3, 0xae, 0xfb, 0xec, 0x0d, 0xe4,
+	0x4e, 0x3c, 0xf5, 0x6e, 0xc9, 0x75, 0x18, 0x95, 0xf0, 0x65, 0xb3, 0x76, 0xf5, 0xe6, 0x34, 0x1a,
+	0xc8, 0x07, 0xf9, 0x64, 0xab, 0x3e, 0x04, 0xab, 0x83, 0xa1, 0x18, 0x16, 0x44, 0xa7, 0xc4, 0xec,
+	0x88, 0x14, 0x19, 0x2b, 0x9c, 0xa6, 0x9a, 0x0a, 0x1c, 0x95, 0x70, 0x53, 0x93, 0x9d, 0x95, 0x85,
+	0x7c, 0x5b, 0xc3, 0xd2, 0xc3, 0xbb, 0x06, 0xb4, 0x3f, 0x06, 0xcf, 0x09, 0x26, 0x70, 0x12, 0xf0,
+	0x43, 0x5c, 0x10, 0xee, 0x5c, 0x51, 0x73, 0x5a, 0x77, 0x4d, 0x6f, 0xcb, 0x1e, 0x1a, 0x9b, 0xdf,
+	0x65, 0x34, 0xeb, 0x6d, 0x1a, 0xdb, 0x37, 0xb4, 0xd2, 0x74, 0x31, 0xf2, 0x97, 0xd4, 0xf0, 0x40,
+	0x8d, 0xec, 0x02, 0x2c, 0x2b, 0x03, 0x09, 0xfd, 0x62, 0x48, 0x23, 0x2a, 0x8e, 0x9d, 0x85, 0xf6,
+	0xfc, 0xc5, 0xe4, 0xaf, 0x4b, 0xf2, 0x1f, 0x9f, 0xc2, 0xad, 0x67, 0xe8, 0x0d, 0x59, 0xc0, 0xfd,
+	0xeb, 0x52, 0xe2, 0xfd, 0x4a, 0xc1, 0xbe, 0x07, 0x56, 0x78, 0x88, 0x13, 0x9a, 0xc5, 0xc1, 0x00,
+	0x87, 0x82, 0x15, 0xdc, 0x59, 0x6c, 0xcf, 0x6f, 0x35, 0x7b, 0xb7, 0x46, 0x25, 0xbc, 0xf9, 0xaf,
+	0x95, 0x9e, 0xc9, 0x45, 0xfe, 0xb2, 0x41, 0xf6, 0x34, 0x60, 0xdf, 0x07, 0xeb, 0xf5, 0x9c, 0x20,
+	0x64, 0x99, 0x28, 0x58, 0x92, 0x90, 0xc2, 0xb9, 0xaa, 0x96, 0xfd, 0xd5, 0x51, 0x09, 0xdb, 0x86,
+	0xf9, 0xbc, 0x54, 0xe4, 0xaf, 0xd5, 0x88, 0x77, 0xc7, 0x11, 0xfb, 0x07, 0x0b, 0x38, 0x38, 0xcd,
+	0x13, 0x3a, 0xa0, 0xa1, 0xba, 0x06, 0xf4, 0xce, 0x13, 0x41, 0x0a, 0xee, 0x5c, 0x53, 0xbb, 0xb1,
+	0x7b, 0x99, 0x0e, 0xdb, 0x99, 0xe6, 0xda, 0x1f, 0x53, 0xf5, 0x5e, 0x19, 0x95, 0x10, 0x9a, 0x46,
+	0x3f, 0x47, 0x0e, 0xf9, 0x6b, 0xf8, 0xec, 0xea, 0xed, 0xee, 0xb7, 0x8f, 0x60, 0xe3, 0xfb, 0x47,
+	0xb0, 0xf1, 0xcb, 0xcf, 0x9d, 0x2b, 0xb2, 0x81, 0xde, 0x93, 0x27, 0x6f, 0xf3, 0x82, 0x93, 0x87,
+	0xfe, 0x9c, 0x07, 0x6b, 0xe7, 0x98, 0xb1, 0xbf, 0xb1, 0xc0, 0x0b, 0x34, 0xa3, 0x82, 0xe2, 0x24,
+	0xa8, 0x49, 0x9a, 0x53, 0x79, 0xef, 0xd2, 0x77, 0xc4, 0x4b, 0x7a, 0x6a, 0x67, 0x92, 0x22, 0x7f,
+	0xd5, 0xe0, 0x35, 0x3f, 0xf6, 0xd7, 0xd6, 0xf8, 0x3c, 0xd5, 0x4d, 0xe8, 0x8b, 0xea, 0x83, 0x4b,
+	0x9b, 0xa8, 0x9f, 0xbe, 0x19, 0x0f, 0x37, 0x34, 0x5c, 0xb7, 0x30, 0x00, 0x2b, 0x05, 0x4e, 0xf3,
+	0x80, 0x0b, 0x5c, 0x88, 0x40, 0xde, 0xf4, 0xe6, 0x56, 0xd9, 0x70, 0xf5, 0x33, 0xe0, 0x56, 0xcf,
+	0x80, 0xfb, 0x51, 0xf5, 0x0c, 0xf4, 0x90, 0x39, 0x82, 0x2f, 0x6a, 0xb9, 0x19, 0x02, 0xf4, 0xf0,
+	0x29, 0xb4, 0xfc, 0xeb, 0x12, 0x3d, 0x90, 0xa0, 0xac, 0xb3, 0xef, 0x03, 0x05, 0x04, 0x24, 0x8b,
+	0xb4, 0x4a, 0xf3, 0x3f, 0x55, 0xda, 0x46, 0x65, 0x75, 0x4a, 0xa5, 0x2a, 0xd7, 0x1a, 0x4b, 0x12,
+	0x7b, 0x27, 0x8b, 0x64, 0x4d, 0xef, 0xd3, 0xc7, 0x27, 0x2d, 0xeb, 0xc9, 0x49, 0xcb, 0xfa, 0xfd,
+	0xa4, 0x65, 0x3d, 0x3c, 0x6d, 0x35, 0x9e, 0x9c, 0xb6, 0x1a, 0xbf, 0x9e, 0xb6, 0x1a, 0x9f, 0xec,
+	0x4c, 0xad, 0x9f, 0x69, 0x98, 0x4e, 0x82, 0xfb, 0xbc, 0x1a, 0x78, 0x47, 0xdd, 0xbb, 0xde, 0x83,
+	0x8b, 0x5e, 0xee, 0xfe, 0x82, 0xf2, 0xf7, 0xe6, 0x3f, 0x03, 0x00, 0xb0, 0x70, 0x7c, 0x36, 0xe7,
+	0x07, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -249,6 +327,18 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AmplificationParameters != nil {
+		{
+			size, err := m.AmplificationParameters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.ScalingFactorController) > 0 {
 		i -= len(m.ScalingFactorController)
 		copy(dAtA[i:], m.ScalingFactorController)
@@ -257,20 +347,20 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if len(m.ScalingFactors) > 0 {
-		dAtA2 := make([]byte, len(m.ScalingFactors)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.ScalingFactors)*10)
+		var j2 int
 		for _, num := range m.ScalingFactors {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -330,6 +420,65 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AmplificationParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AmplificationParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AmplificationParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RampEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RampEndTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RampStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RampStartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FutureAmplification.Size()
+		i -= size
+		if _, err := m.FutureAmplification.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InitialAmplification.Size()
+		i -= size
+		if _, err := m.InitialAmplification.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStableswapPool(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStableswapPool(v)
 	base := offset
@@ -392,6 +541,27 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStableswapPool(uint64(l))
 	}
+	if m.AmplificationParameters != nil {
+		l = m.AmplificationParameters.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	return n
+}
+
+func (m *AmplificationParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialAmplification.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = m.FutureAmplification.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RampStartTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RampEndTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
 	return n
 }
 
@@ -839,6 +1009,226 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplificationParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AmplificationParameters == nil {
+				m.AmplificationParameters = &AmplificationParameters{}
+			}
+			if err := m.AmplificationParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AmplificationParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AmplificationParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AmplificationParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmplification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialAmplification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureAmplification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FutureAmplification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RampStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RampEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -12,18 +12,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types2 "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,6 +44,9 @@ type MsgCreateStableswapPool struct {
 	ScalingFactors          []uint64                                 `protobuf:"varint,4,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factor"`
 	FuturePoolGovernor      string                                   `protobuf:"bytes,5,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
 	ScalingFactorController string                                   `protobuf:"bytes,6,opt,name=scaling_factor_controller,json=scalingFactorController,proto3" json:"scaling_factor_controller,omitempty" yaml:"scaling_factor_controller"`
+	// amplification is the amplification coefficient of the pool if it uses the
+	// Curve StableSwap invariant. Zero means the pool uses the Solidly CFMM.
+	Amplification uint64 `protobuf:"varint,7,opt,name=amplification,proto3" json:"amplification,omitempty" yaml:"amplification"`
 }
 
 func (m *MsgCreateStableswapPool) Reset()         { *m = MsgCreateStableswapPool{} }
@@ -116,6 +124,13 @@ func (m *MsgCreateStableswapPool) GetScalingFactorController() string {
 	return ""
 }
 
+func (m *MsgCreateStableswapPool) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
 // Returns a poolID with custom poolName.
 type MsgCreateStableswapPoolResponse struct {
 	PoolID uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
@@ -263,11 +278,232 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse proto.InternalMessageInfo
 
+// Sender must be the pool's scaling_factor_controller in order for the tx to
+// succeed. Ramps the amplification coefficient of a stableswap pool using the
+// Curve StableSwap invariant linearly from its current value to
+// future_amplification at future_time.
+type MsgStableSwapRampAmplification struct {
+	Sender              string    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID              uint64    `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	FutureAmplification uint64    `protobuf:"varint,3,opt,name=future_amplification,json=futureAmplification,proto3" json:"future_amplification,omitempty" yaml:"future_amplification"`
+	FutureTime          time.Time `protobuf:"bytes,4,opt,name=future_time,json=futureTime,proto3,stdtime" json:"future_time" yaml:"future_time"`
+}
+
+func (m *MsgStableSwapRampAmplification) Reset()         { *m = MsgStableSwapRampAmplification{} }
+func (m *MsgStableSwapRampAmplification) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampAmplification) ProtoMessage()    {}
+func (*MsgStableSwapRampAmplification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{4}
+}
+func (m *MsgStableSwapRampAmplification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampAmplification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampAmplification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampAmplification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampAmplification.Merge(m, src)
+}
+func (m *MsgStableSwapRampAmplification) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampAmplification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampAmplification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampAmplification proto.InternalMessageInfo
+
+func (m *MsgStableSwapRampAmplification) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapRampAmplification) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapRampAmplification) GetFutureAmplification() uint64 {
+	if m != nil {
+		return m.FutureAmplification
+	}
+	return 0
+}
+
+func (m *MsgStableSwapRampAmplification) GetFutureTime() time.Time {
+	if m != nil {
+		return m.FutureTime
+	}
+	return time.Time{}
+}
+
+type MsgStableSwapRampAmplificationResponse struct {
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Reset() {
+	*m = MsgStableSwapRampAmplificationResponse{}
+}
+func (m *MsgStableSwapRampAmplificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampAmplificationResponse) ProtoMessage()    {}
+func (*MsgStableSwapRampAmplificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{5}
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampAmplificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampAmplificationResponse.Merge(m, src)
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampAmplificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampAmplificationResponse proto.InternalMessageInfo
+
+// ===================== MsgStableSwapSetScalingFactorRateSource
+// Message to delegate the scaling factors of a stableswap pool to rate
+// sources, which update them automatically. Only the pool's scaling factor
+// controller can set it. A nil rate source removes the current one, giving the
+// control of the scaling factors back to the scaling factor controller.
+type MsgStableSwapSetScalingFactorRateSource struct {
+	Sender     string                          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID     uint64                          `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	RateSource *types2.ScalingFactorRateSource `protobuf:"bytes,3,opt,name=rate_source,json=rateSource,proto3" json:"rate_source,omitempty" yaml:"rate_source"`
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSource) Reset() {
+	*m = MsgStableSwapSetScalingFactorRateSource{}
+}
+func (m *MsgStableSwapSetScalingFactorRateSource) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapSetScalingFactorRateSource) ProtoMessage()    {}
+func (*MsgStableSwapSetScalingFactorRateSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{6}
+}
+func (m *MsgStableSwapSetScalingFactorRateSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapSetScalingFactorRateSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapSetScalingFactorRateSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapSetScalingFactorRateSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateSource.Merge(m, src)
+}
+func (m *MsgStableSwapSetScalingFactorRateSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapSetScalingFactorRateSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapSetScalingFactorRateSource proto.InternalMessageInfo
+
+func (m *MsgStableSwapSetScalingFactorRateSource) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSource) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSource) GetRateSource() *types2.ScalingFactorRateSource {
+	if m != nil {
+		return m.RateSource
+	}
+	return nil
+}
+
+type MsgStableSwapSetScalingFactorRateSourceResponse struct {
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) Reset() {
+	*m = MsgStableSwapSetScalingFactorRateSourceResponse{}
+}
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapSetScalingFactorRateSourceResponse) ProtoMessage() {}
+func (*MsgStableSwapSetScalingFactorRateSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{7}
+}
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapSetScalingFactorRateSourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateSourceResponse.Merge(m, src)
+}
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateSourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapSetScalingFactorRateSourceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStableswapPool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPool")
 	proto.RegisterType((*MsgCreateStableswapPoolResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPoolResponse")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactors")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactorsResponse")
+	proto.RegisterType((*MsgStableSwapRampAmplification)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampAmplification")
+	proto.RegisterType((*MsgStableSwapRampAmplificationResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampAmplificationResponse")
+	proto.RegisterType((*MsgStableSwapSetScalingFactorRateSource)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapSetScalingFactorRateSource")
+	proto.RegisterType((*MsgStableSwapSetScalingFactorRateSourceResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapSetScalingFactorRateSourceResponse")
 }
 
 func init() {
@@ -275,49 +511,66 @@ func init() {
 }
 
 var fileDescriptor_46b7c8a0f24de97c = []byte{
-	// 669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x9b, 0x10, 0xc4, 0x55, 0x80, 0x6a, 0x45, 0x6d, 0x1a, 0x24, 0x3b, 0xb8, 0x0c, 0x69,
-	0x21, 0x3e, 0xda, 0x4a, 0x95, 0xe8, 0xd6, 0x04, 0x15, 0x55, 0x10, 0xa9, 0xb8, 0x62, 0x81, 0x21,
-	0x5c, 0xec, 0xab, 0x39, 0xb0, 0x7d, 0xc6, 0x77, 0xe9, 0x8f, 0x91, 0x95, 0x89, 0x3f, 0x03, 0x31,
-	0xf1, 0x57, 0xa0, 0x8e, 0x1d, 0x99, 0x02, 0x4a, 0x87, 0xee, 0x59, 0x10, 0x1b, 0xba, 0xf3, 0xe5,
-	0x97, 0xd4, 0xf4, 0x07, 0xea, 0x92, 0x9c, 0x9f, 0xbf, 0xf7, 0x7d, 0xef, 0x7d, 0xef, 0xf9, 0xc0,
-	0x23, 0xca, 0x42, 0xca, 0x08, 0x83, 0x3e, 0x0a, 0x43, 0x18, 0x53, 0x1a, 0x54, 0x43, 0xea, 0xe1,
-	0x80, 0x41, 0xc6, 0x51, 0x2b, 0xc0, 0x6c, 0x1f, 0xc5, 0x90, 0x1f, 0xd8, 0x71, 0x42, 0x39, 0xd5,
-	0x97, 0x14, 0xda, 0x16, 0x68, 0x5b, 0xa0, 0x53, 0xb0, 0x3d, 0x04, 0xdb, 0x7b, 0xcb, 0x2d, 0xcc,
-	0xd1, 0x72, 0xc9, 0x70, 0x25, 0x18, 0xb6, 0x10, 0xc3, 0x50, 0x05, 0xa1, 0x4b, 0x49, 0x94, 0x72,
-	0x95, 0x0a, 0x3e, 0xf5, 0xa9, 0x3c, 0x42, 0x71, 0x52, 0xd1, 0x19, 0x14, 0x92, 0x88, 0x42, 0xf9,
-	0xab, 0x42, 0x4f, 0x2e, 0x53, 0xe2, 0xf0, 0xd8, 0x14, 0x88, 0x34, 0xd5, 0x3a, 0xcd, 0x81, 0xb9,
-	0x06, 0xf3, 0xeb, 0x09, 0x46, 0x1c, 0xef, 0x0c, 0x20, 0xdb, 0x94, 0x06, 0xfa, 0x22, 0xc8, 0x33,
-	0x1c, 0x79, 0x38, 0x29, 0x6a, 0x65, 0xad, 0x72, 0xab, 0x36, 0xd3, 0xeb, 0x98, 0xb7, 0x0f, 0x51,
-	0x18, 0xac, 0x5b, 0x69, 0xdc, 0x72, 0x14, 0x40, 0xa7, 0x60, 0x5a, 0x90, 0x36, 0x63, 0x94, 0xa0,
-	0x90, 0x15, 0xa7, 0xca, 0x5a, 0x65, 0x7a, 0x65, 0xcd, 0xbe, 0xbc, 0x19, 0xb6, 0x50, 0xdc, 0x96,
-	0xd9, 0xb5, 0xd9, 0x5e, 0xc7, 0xd4, 0x53, 0x9d, 0x11, 0x52, 0xcb, 0x01, 0xf1, 0x00, 0xa3, 0x7f,
-	0xd2, 0xc0, 0x2c, 0x89, 0x08, 0x27, 0x28, 0x90, 0xed, 0x34, 0x03, 0xf2, 0xb1, 0x4d, 0x3c, 0xc2,
-	0x0f, 0x8b, 0xd9, 0x72, 0xb6, 0x32, 0xbd, 0x32, 0x6f, 0xa7, 0xee, 0xda, 0xc2, 0xdd, 0x81, 0x4a,
-	0x9d, 0x92, 0xa8, 0xf6, 0xf8, 0xa8, 0x63, 0x66, 0xbe, 0xfd, 0x32, 0x2b, 0x3e, 0xe1, 0xef, 0xda,
-	0x2d, 0xdb, 0xa5, 0x21, 0x54, 0xa3, 0x48, 0xff, 0xaa, 0xcc, 0xfb, 0x00, 0xf9, 0x61, 0x8c, 0x99,
-	0x4c, 0x60, 0x4e, 0x41, 0x49, 0x89, 0x22, 0x5f, 0xf4, 0x85, 0xf4, 0x06, 0xb8, 0xcb, 0x5c, 0x14,
-	0x90, 0xc8, 0x6f, 0xee, 0x22, 0x97, 0xd3, 0x84, 0x15, 0x73, 0xe5, 0x6c, 0x25, 0x57, 0x7b, 0xd0,
-	0xeb, 0x98, 0x65, 0x65, 0xd4, 0xd0, 0xf5, 0x71, 0xac, 0xe5, 0xdc, 0x51, 0x81, 0xcd, 0x34, 0x57,
-	0x7f, 0x09, 0x0a, 0xbb, 0x6d, 0xde, 0x4e, 0x70, 0xda, 0x90, 0x4f, 0xf7, 0x70, 0x12, 0xd1, 0xa4,
-	0x78, 0x43, 0x9a, 0x6f, 0xf6, 0x3a, 0xe6, 0xbd, 0x94, 0xf3, 0x2c, 0x94, 0xe5, 0xe8, 0x69, 0x58,
-	0x94, 0xf8, 0x4c, 0x05, 0xf5, 0xb7, 0x60, 0x7e, 0x5c, 0xb5, 0xe9, 0xd2, 0x88, 0x27, 0x34, 0x08,
-	0x70, 0x52, 0xcc, 0x4b, 0xde, 0xd1, 0x5a, 0x27, 0x41, 0x2d, 0x67, 0x6e, 0xac, 0xd6, 0xfa, 0xe0,
-	0xcd, 0x7a, 0xe5, 0xf3, 0xe9, 0xf7, 0xa5, 0x85, 0xb1, 0xfd, 0x73, 0xe5, 0x2e, 0x55, 0x87, 0x9d,
-	0x57, 0x45, 0xa5, 0xd6, 0x26, 0x30, 0x27, 0x2c, 0x9a, 0x83, 0x59, 0x4c, 0x23, 0x86, 0xf5, 0x05,
-	0x70, 0x53, 0x36, 0x45, 0x3c, 0xb9, 0x71, 0xb9, 0x1a, 0xe8, 0x76, 0xcc, 0xbc, 0x80, 0x6c, 0x3d,
-	0x75, 0xf2, 0xe2, 0xd5, 0x96, 0x67, 0xfd, 0xd1, 0xc0, 0xfd, 0x06, 0xf3, 0x53, 0x8a, 0x9d, 0x7d,
-	0x14, 0x6f, 0x78, 0xef, 0xdb, 0x8c, 0xef, 0x8c, 0x9b, 0x79, 0x85, 0xdd, 0x1d, 0x51, 0x9d, 0x9a,
-	0xa4, 0x7a, 0xd6, 0xac, 0xb3, 0xff, 0x3f, 0xeb, 0xf5, 0x55, 0x61, 0x9b, 0x3d, 0x66, 0xdb, 0x88,
-	0x5f, 0x48, 0x76, 0x54, 0x55, 0x39, 0x55, 0x25, 0x68, 0x3d, 0x04, 0x8b, 0x17, 0x36, 0xde, 0xf7,
-	0x72, 0xe5, 0xef, 0x14, 0xc8, 0x36, 0x98, 0xaf, 0x7f, 0xd5, 0x40, 0xe1, 0xcc, 0xaf, 0xbb, 0x7e,
-	0x95, 0xaf, 0x73, 0xc2, 0xe4, 0x4a, 0xcf, 0xaf, 0x81, 0x64, 0x30, 0xfe, 0x1f, 0x1a, 0x30, 0x2e,
-	0x18, 0x6b, 0xe3, 0x8a, 0x7a, 0xe7, 0xd3, 0x95, 0x5e, 0x5d, 0x2b, 0x5d, 0xbf, 0x91, 0xda, 0x9b,
-	0xa3, 0xae, 0xa1, 0x1d, 0x77, 0x0d, 0xed, 0x77, 0xd7, 0xd0, 0xbe, 0x9c, 0x18, 0x99, 0xe3, 0x13,
-	0x23, 0xf3, 0xf3, 0xc4, 0xc8, 0xbc, 0xde, 0x18, 0xb9, 0x72, 0x94, 0x74, 0x35, 0x40, 0x2d, 0xd6,
-	0x7f, 0x80, 0x7b, 0xcb, 0x6b, 0xf0, 0xe0, 0xbc, 0x7b, 0xbc, 0x95, 0x97, 0x17, 0xf7, 0xea, 0xbf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0xdb, 0x04, 0x3e, 0x98, 0x06, 0x00, 0x00,
+	// 938 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x5e, 0x6f, 0xd2, 0x54, 0x4c, 0x54, 0x50, 0x4d, 0xd4, 0xba, 0xa9, 0x64, 0x07, 0xb7, 0x82,
+	0x74, 0xc1, 0x36, 0xbb, 0x15, 0x95, 0xd8, 0x03, 0xd2, 0x66, 0x51, 0x51, 0x81, 0x48, 0xc5, 0x81,
+	0x0b, 0x7b, 0x08, 0x13, 0x67, 0x62, 0x06, 0x6c, 0x8f, 0xf1, 0x4c, 0xb6, 0xdd, 0x23, 0x57, 0xb8,
+	0xf4, 0xc0, 0xff, 0x00, 0xe2, 0xc4, 0x3f, 0xc0, 0x15, 0xf5, 0x58, 0x71, 0xea, 0x29, 0x45, 0xbb,
+	0x07, 0xee, 0xb9, 0x70, 0x45, 0x33, 0x63, 0x3b, 0x9e, 0x76, 0xb3, 0xdd, 0x5d, 0xa5, 0x97, 0xc4,
+	0x7e, 0xfe, 0xde, 0xaf, 0xef, 0xbd, 0xf9, 0x6c, 0xf0, 0x1e, 0xa1, 0x31, 0xa1, 0x98, 0x7a, 0x21,
+	0x8c, 0x63, 0x2f, 0x25, 0x24, 0x72, 0x62, 0x32, 0x46, 0x11, 0xf5, 0x28, 0x83, 0xa3, 0x08, 0xd1,
+	0x07, 0x30, 0xf5, 0xd8, 0x43, 0x37, 0xcd, 0x08, 0x23, 0xfa, 0x46, 0x8e, 0x76, 0x39, 0xda, 0xe5,
+	0x68, 0x09, 0x76, 0x17, 0x60, 0x77, 0x7f, 0x73, 0x84, 0x18, 0xdc, 0x6c, 0x9b, 0x81, 0x00, 0x7b,
+	0x23, 0x48, 0x91, 0x97, 0x1b, 0xbd, 0x80, 0xe0, 0x44, 0xc6, 0x6a, 0xb7, 0x42, 0x12, 0x12, 0x71,
+	0xe9, 0xf1, 0xab, 0xdc, 0x6a, 0x85, 0x84, 0x84, 0x11, 0xf2, 0xc4, 0xdd, 0x68, 0x3a, 0xf1, 0x18,
+	0x8e, 0x11, 0x65, 0x30, 0x4e, 0x73, 0xc0, 0x65, 0x18, 0xe3, 0x84, 0x78, 0xe2, 0x37, 0x37, 0x7d,
+	0x78, 0x9a, 0x1e, 0x16, 0x97, 0x43, 0x8e, 0xc8, 0x5d, 0x3f, 0x50, 0x5c, 0x8b, 0x2a, 0x69, 0x00,
+	0x23, 0x9c, 0x84, 0xc3, 0x09, 0x0c, 0x18, 0xc9, 0x86, 0x19, 0x64, 0x68, 0x48, 0xc9, 0x34, 0x0b,
+	0x90, 0x74, 0xb3, 0x7f, 0xbd, 0x00, 0xae, 0xf6, 0x69, 0xb8, 0x9b, 0x21, 0xc8, 0xd0, 0xa0, 0x8c,
+	0x7c, 0x9f, 0x90, 0x48, 0xbf, 0x05, 0x1a, 0x14, 0x25, 0x63, 0x94, 0x19, 0x5a, 0x47, 0xeb, 0xbe,
+	0xd6, 0xbb, 0x3c, 0x9f, 0x59, 0x97, 0x0e, 0x60, 0x1c, 0x6d, 0xdb, 0xd2, 0x6e, 0xfb, 0x39, 0x40,
+	0x27, 0xa0, 0xc9, 0x6b, 0x19, 0xa6, 0x30, 0x83, 0x31, 0x35, 0xd6, 0x3b, 0x5a, 0xb7, 0xb9, 0x75,
+	0xc7, 0x3d, 0x3d, 0xc9, 0x2e, 0xcf, 0x78, 0x5f, 0x78, 0xf7, 0xae, 0xcc, 0x67, 0x96, 0x2e, 0xf3,
+	0x54, 0x82, 0xda, 0x3e, 0x48, 0x4b, 0x8c, 0xfe, 0xa3, 0x06, 0xae, 0xe0, 0x04, 0x33, 0x0c, 0x23,
+	0xc1, 0xc2, 0x30, 0xc2, 0x3f, 0x4c, 0xf1, 0x18, 0xb3, 0x03, 0xa3, 0xd6, 0xa9, 0x75, 0x9b, 0x5b,
+	0xd7, 0x5c, 0x39, 0x35, 0x97, 0x4f, 0xad, 0xcc, 0xb2, 0x4b, 0x70, 0xd2, 0x7b, 0xff, 0xf1, 0xcc,
+	0x5a, 0xfb, 0xfd, 0x99, 0xd5, 0x0d, 0x31, 0xfb, 0x76, 0x3a, 0x72, 0x03, 0x12, 0x7b, 0xf9, 0x88,
+	0xe5, 0x9f, 0x43, 0xc7, 0xdf, 0x7b, 0xec, 0x20, 0x45, 0x54, 0x38, 0x50, 0xbf, 0x95, 0xa7, 0xe2,
+	0x45, 0x7e, 0x5e, 0x24, 0xd2, 0xfb, 0xe0, 0x0d, 0x95, 0x5f, 0x6a, 0xd4, 0x3b, 0xb5, 0x6e, 0xbd,
+	0x77, 0x73, 0x3e, 0xb3, 0x3a, 0x39, 0x51, 0x8b, 0x61, 0xa9, 0x58, 0xdb, 0x7f, 0x3d, 0x37, 0xdc,
+	0x95, 0xbe, 0xfa, 0x17, 0xa0, 0x35, 0x99, 0xb2, 0x69, 0x86, 0x64, 0x43, 0x21, 0xd9, 0x47, 0x59,
+	0x42, 0x32, 0xe3, 0x82, 0x20, 0xdf, 0x9a, 0xcf, 0xac, 0xeb, 0x32, 0xe6, 0x71, 0x28, 0xdb, 0xd7,
+	0xa5, 0x99, 0x97, 0xf8, 0x49, 0x6e, 0xd4, 0xbf, 0x01, 0xd7, 0x9e, 0xdb, 0x80, 0x80, 0x24, 0x2c,
+	0x23, 0x51, 0x84, 0x32, 0xa3, 0x21, 0xe2, 0x56, 0x6b, 0x5d, 0x06, 0xb5, 0xfd, 0xab, 0x4a, 0xad,
+	0xbb, 0xe5, 0x13, 0xfd, 0x23, 0x70, 0x09, 0xc6, 0x69, 0x84, 0x27, 0x38, 0x80, 0x0c, 0x93, 0xc4,
+	0xb8, 0xd8, 0xd1, 0xba, 0xf5, 0x9e, 0x31, 0x9f, 0x59, 0x2d, 0x19, 0x55, 0x79, 0x6c, 0xfb, 0x2a,
+	0x7c, 0xbb, 0xfb, 0xd3, 0xbf, 0x7f, 0x6c, 0xdc, 0x50, 0x76, 0x37, 0x10, 0xbb, 0xe8, 0x2c, 0x98,
+	0x73, 0x78, 0xa7, 0xf6, 0x5d, 0x60, 0x2d, 0x59, 0x54, 0x1f, 0xd1, 0x94, 0x24, 0x14, 0xe9, 0x37,
+	0xc0, 0x45, 0x41, 0x0a, 0x1e, 0x8b, 0x8d, 0xad, 0xf7, 0xc0, 0xe1, 0xcc, 0x6a, 0x70, 0xc8, 0xbd,
+	0x8f, 0xfd, 0x06, 0x7f, 0x74, 0x6f, 0x6c, 0xff, 0xa7, 0x81, 0xb7, 0xfa, 0x34, 0x94, 0x21, 0x06,
+	0x0f, 0x60, 0xba, 0x33, 0xfe, 0x6e, 0x4a, 0xd9, 0x40, 0x1d, 0xc6, 0x19, 0x76, 0xbf, 0x92, 0x75,
+	0x7d, 0x59, 0xd6, 0xe3, 0x76, 0xa5, 0x76, 0xfe, 0x5d, 0xd9, 0xbe, 0xcd, 0x69, 0x73, 0x15, 0xda,
+	0x2a, 0x7c, 0x41, 0xd1, 0x91, 0x93, 0xfb, 0x38, 0x79, 0x42, 0xfb, 0x5d, 0x70, 0xeb, 0xa5, 0x8d,
+	0x17, 0x5c, 0xda, 0x7f, 0xaf, 0x03, 0x53, 0x41, 0xfb, 0x30, 0x4e, 0x77, 0xaa, 0xb3, 0x5b, 0x39,
+	0x47, 0x7e, 0x79, 0x00, 0xd4, 0x95, 0xaa, 0x09, 0x8f, 0x17, 0x0f, 0xc0, 0x73, 0x9b, 0xf5, 0xa6,
+	0x34, 0xab, 0x35, 0xee, 0x81, 0x66, 0x8e, 0xe6, 0xf2, 0x6b, 0xd4, 0x85, 0x30, 0xb5, 0x5d, 0xa9,
+	0xcd, 0x6e, 0xa1, 0xcd, 0xee, 0x97, 0x85, 0x36, 0xf7, 0x4c, 0x2e, 0x0e, 0x0b, 0x01, 0xaa, 0x38,
+	0xdb, 0x8f, 0x9e, 0x59, 0x9a, 0x0f, 0xa4, 0x85, 0x3b, 0x6c, 0x7b, 0x7c, 0x0a, 0x1b, 0xcb, 0xa6,
+	0x90, 0xc1, 0x38, 0x75, 0xd4, 0x1a, 0xbb, 0xe0, 0xed, 0x93, 0x39, 0x2d, 0xe9, 0xff, 0x79, 0x1d,
+	0xbc, 0xa3, 0x40, 0x07, 0x48, 0x9d, 0x94, 0xcf, 0x8f, 0x81, 0x50, 0xf2, 0x95, 0xcf, 0x61, 0x02,
+	0x9a, 0x95, 0x17, 0x85, 0xa0, 0xbf, 0xb9, 0xe5, 0xa8, 0x62, 0x5e, 0x08, 0xea, 0x92, 0x9a, 0xaa,
+	0x1a, 0x5e, 0x89, 0x65, 0xfb, 0x20, 0x2b, 0x31, 0xc7, 0x9d, 0x7d, 0x85, 0x3e, 0x2e, 0x02, 0xd2,
+	0x6d, 0x13, 0x78, 0xa7, 0x24, 0xa3, 0x20, 0x70, 0xeb, 0x97, 0x06, 0xa8, 0xf5, 0x69, 0xa8, 0xff,
+	0xa6, 0x81, 0xd6, 0xb1, 0x6f, 0xb7, 0xdd, 0xb3, 0xbc, 0x9d, 0x96, 0x28, 0x4f, 0xfb, 0xb3, 0x15,
+	0x04, 0x29, 0xe5, 0xeb, 0x2f, 0x0d, 0x98, 0x2f, 0x91, 0xa5, 0xfe, 0x19, 0xf3, 0x9d, 0x1c, 0xae,
+	0xfd, 0xd5, 0x4a, 0xc3, 0x95, 0x8d, 0xfc, 0xa9, 0x81, 0xeb, 0x27, 0x09, 0xc7, 0xa7, 0xe7, 0x4e,
+	0xfb, 0x42, 0xac, 0xb6, 0xbf, 0xba, 0x58, 0x65, 0xfd, 0x4f, 0x35, 0x70, 0xf3, 0x54, 0x27, 0x6f,
+	0x70, 0xee, 0xe4, 0xcb, 0x83, 0xb6, 0xf7, 0x5e, 0x41, 0xd0, 0xa2, 0xb5, 0xde, 0xde, 0xe3, 0x43,
+	0x53, 0x7b, 0x72, 0x68, 0x6a, 0xff, 0x1c, 0x9a, 0xda, 0xa3, 0x23, 0x73, 0xed, 0xc9, 0x91, 0xb9,
+	0xf6, 0xf4, 0xc8, 0x5c, 0xfb, 0x7a, 0xa7, 0xf2, 0x35, 0x94, 0x17, 0xe0, 0x44, 0x70, 0x44, 0x8b,
+	0x1b, 0x6f, 0x7f, 0xf3, 0x8e, 0xf7, 0xf0, 0xa4, 0x2f, 0xd3, 0x51, 0x43, 0xe8, 0xe9, 0xed, 0xff,
+	0x07, 0x00, 0xcf, 0x4c, 0x6b, 0x4c, 0x8b, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -334,6 +587,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateStableswapPool(ctx context.Context, in *MsgCreateStableswapPool, opts ...grpc.CallOption) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapRampAmplification(ctx context.Context, in *MsgStableSwapRampAmplification, opts ...grpc.CallOption) (*MsgStableSwapRampAmplificationResponse, error)
+	StableSwapSetScalingFactorRateSource(ctx context.Context, in *MsgStableSwapSetScalingFactorRateSource, opts ...grpc.CallOption) (*MsgStableSwapSetScalingFactorRateSourceResponse, error)
 }
 
 type msgClient struct {
@@ -362,10 +617,30 @@ func (c *msgClient) StableSwapAdjustScalingFactors(ctx context.Context, in *MsgS
 	return out, nil
 }
 
+func (c *msgClient) StableSwapRampAmplification(ctx context.Context, in *MsgStableSwapRampAmplification, opts ...grpc.CallOption) (*MsgStableSwapRampAmplificationResponse, error) {
+	out := new(MsgStableSwapRampAmplificationResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampAmplification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StableSwapSetScalingFactorRateSource(ctx context.Context, in *MsgStableSwapSetScalingFactorRateSource, opts ...grpc.CallOption) (*MsgStableSwapSetScalingFactorRateSourceResponse, error) {
+	out := new(MsgStableSwapSetScalingFactorRateSourceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapSetScalingFactorRateSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateStableswapPool(context.Context, *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(context.Context, *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapRampAmplification(context.Context, *MsgStableSwapRampAmplification) (*MsgStableSwapRampAmplificationResponse, error)
+	StableSwapSetScalingFactorRateSource(context.Context, *MsgStableSwapSetScalingFactorRateSource) (*MsgStableSwapSetScalingFactorRateSourceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -378,6 +653,12 @@ func (*UnimplementedMsgServer) CreateStableswapPool(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) StableSwapAdjustScalingFactors(ctx context.Context, req *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustScalingFactors not implemented")
 }
+func (*UnimplementedMsgServer) StableSwapRampAmplification(ctx context.Context, req *MsgStableSwapRampAmplification) (*MsgStableSwapRampAmplificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapRampAmplification not implemented")
+}
+func (*UnimplementedMsgServer) StableSwapSetScalingFactorRateSource(ctx context.Context, req *MsgStableSwapSetScalingFactorRateSource) (*MsgStableSwapSetScalingFactorRateSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapSetScalingFactorRateSource not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -419,6 +700,42 @@ func _Msg_StableSwapAdjustScalingFactors_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StableSwapRampAmplification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapRampAmplification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapRampAmplification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampAmplification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapRampAmplification(ctx, req.(*MsgStableSwapRampAmplification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StableSwapSetScalingFactorRateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapSetScalingFactorRateSource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapSetScalingFactorRateSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapSetScalingFactorRateSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapSetScalingFactorRateSource(ctx, req.(*MsgStableSwapSetScalingFactorRateSource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -431,6 +748,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StableSwapAdjustScalingFactors",
 			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
 		},
+		{
+			MethodName: "StableSwapRampAmplification",
+			Handler:    _Msg_StableSwapRampAmplification_Handler,
+		},
+		{
+			MethodName: "StableSwapSetScalingFactorRateSource",
+			Handler:    _Msg_StableSwapSetScalingFactorRateSource_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/stableswap/tx.proto",
@@ -456,6 +781,11 @@ func (m *MsgCreateStableswapPool) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Amplification != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.ScalingFactorController) > 0 {
 		i -= len(m.ScalingFactorController)
 		copy(dAtA[i:], m.ScalingFactorController)
@@ -628,65 +958,209 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgStableSwapRampAmplification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateStableswapPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgStableSwapRampAmplification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampAmplification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolParams != nil {
-		l = m.PoolParams.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.InitialPoolLiquidity) > 0 {
-		for _, e := range m.InitialPoolLiquidity {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FutureTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FutureTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	if len(m.ScalingFactors) > 0 {
-		l = 0
-		for _, e := range m.ScalingFactors {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if m.FutureAmplification != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FutureAmplification))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.FuturePoolGovernor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ScalingFactorController)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateStableswapPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovTx(uint64(m.PoolID))
+func (m *MsgStableSwapRampAmplificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateSource != nil {
+		{
+			size, err := m.RateSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateStableswapPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolParams != nil {
+		l = m.PoolParams.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.InitialPoolLiquidity) > 0 {
+		for _, e := range m.InitialPoolLiquidity {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.ScalingFactors {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = len(m.FuturePoolGovernor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScalingFactorController)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovTx(uint64(m.Amplification))
+	}
+	return n
+}
+
+func (m *MsgCreateStableswapPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	return n
 }
 
 func (m *MsgStableSwapAdjustScalingFactors) Size() (n int) {
@@ -721,6 +1195,65 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgStableSwapRampAmplification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if m.FutureAmplification != 0 {
+		n += 1 + sovTx(uint64(m.FutureAmplification))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FutureTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if m.RateSource != nil {
+		l = m.RateSource.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -998,6 +1531,25 @@ func (m *MsgCreateStableswapPool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1315,6 +1867,396 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgStableSwapRampAmplification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureAmplification", wireType)
+			}
+			m.FutureAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FutureTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapRampAmplificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapSetScalingFactorRateSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateSource == nil {
+				m.RateSource = &types2.ScalingFactorRateSource{}
+			}
+			if err := m.RateSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateSourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
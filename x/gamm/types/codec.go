@@ -21,8 +21,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgJoinSwapShareAmountOut{}, "osmosis/gamm/join-swap-share-amount-out", nil)
 	cdc.RegisterConcrete(&MsgExitSwapExternAmountOut{}, "osmosis/gamm/exit-swap-extern-amount-out", nil)
 	cdc.RegisterConcrete(&MsgExitSwapShareAmountIn{}, "osmosis/gamm/exit-swap-share-amount-in", nil)
+	cdc.RegisterConcrete(&MsgJoinPoolWithAnyToken{}, "osmosis/gamm/join-pool-with-any-token", nil)
+	cdc.RegisterConcrete(&MsgExitPoolToSingleToken{}, "osmosis/gamm/exit-pool-to-single-token", nil)
 	cdc.RegisterConcrete(&UpdateMigrationRecordsProposal{}, "osmosis/gamm/update-migration-records-proposal", nil)
 	cdc.RegisterConcrete(&ReplaceMigrationRecordsProposal{}, "osmosis/gamm/replace-migration-records-proposal", nil)
+	cdc.RegisterConcrete(&AddPoolAssetProposal{}, "osmosis/gamm/add-pool-asset-proposal", nil)
+	cdc.RegisterConcrete(&RemovePoolAssetProposal{}, "osmosis/gamm/remove-pool-asset-proposal", nil)
+	cdc.RegisterConcrete(&RampAmplificationProposal{}, "osmosis/gamm/ramp-amplification-proposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -41,12 +46,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgJoinSwapShareAmountOut{},
 		&MsgExitSwapExternAmountOut{},
 		&MsgExitSwapShareAmountIn{},
+		&MsgJoinPoolWithAnyToken{},
+		&MsgExitPoolToSingleToken{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&UpdateMigrationRecordsProposal{},
 		&ReplaceMigrationRecordsProposal{},
+		&AddPoolAssetProposal{},
+		&RemovePoolAssetProposal{},
+		&RampAmplificationProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
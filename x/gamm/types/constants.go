@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -15,9 +17,22 @@ const (
 	// We keep this multiplier at 1, but can increase if needed in the unlikely scenario where default scaling factors of 1 cannot accommodate enough assets
 	ScalingFactorMultiplier = 1
 
+	// ScalingFactorRateSourceUpdateGasLimit is the maximum gas a single update of the scaling factors of a stableswap
+	// pool from its rate source can consume, including the queries to the contracts of its contract rate sources.
+	ScalingFactorRateSourceUpdateGasLimit = 1_000_000
+
 	// pools can be created with min and max number of assets defined with this constants
 	MinNumOfAssetsInPool = 2
 	MaxNumOfAssetsInPool = 8
+
+	// stableswap pools using the Curve StableSwap invariant must have an amplification coefficient between these bounds
+	StableswapMinAmplification = 1
+	StableswapMaxAmplification = 1_000_000
+	// StableswapMaxAmplificationChange is the maximum factor by which a single ramp can increase or decrease the amplification coefficient.
+	StableswapMaxAmplificationChange = 10
+	// StableswapMinAmplificationRampDuration is the minimum duration of an amplification ramp,
+	// and the minimum time between the starts of two consecutive ramps.
+	StableswapMinAmplificationRampDuration = 24 * time.Hour
 )
 
 var (
@@ -43,4 +58,8 @@ var (
 
 	// Maximum amount per asset after the application of scaling factors should be 10e34.
 	StableswapMaxScaledAmtPerAsset = sdk.NewDec(10).Power(34).TruncateInt()
+
+	// StableswapMigrationRangeRatio is how far from the peg price, on each side, the concentrated liquidity position
+	// created by migrating stableswap shares ranges.
+	StableswapMigrationRangeRatio = sdk.NewDecWithPrec(1, 2) // 0.01
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -50,6 +51,161 @@ func (e BalancerPoolMigrationLinkNotFoundError) Error() string {
 	return fmt.Sprintf("given PoolIdEntering (%d) does not have a canonical link for any balancer pool", e.PoolIdEntering)
 }
 
+type StableswapMigrationSpotPriceOutOfRangeError struct {
+	PoolId     uint64
+	SpotPrice  sdk.Dec
+	LowerPrice sdk.Dec
+	UpperPrice sdk.Dec
+}
+
+func (e StableswapMigrationSpotPriceOutOfRangeError) Error() string {
+	return fmt.Sprintf("spot price (%s) of concentrated pool with ID %d must be between %s and %s to migrate stableswap shares around the peg price", e.SpotPrice, e.PoolId, e.LowerPrice, e.UpperPrice)
+}
+
+type InvalidAmplificationError struct {
+	Amplification uint64
+}
+
+func (e InvalidAmplificationError) Error() string {
+	return fmt.Sprintf("amplification (%d) must be between %d and %d", e.Amplification, StableswapMinAmplification, StableswapMaxAmplification)
+}
+
+type NotAmplifiedPoolError struct {
+	PoolId uint64
+}
+
+func (e NotAmplifiedPoolError) Error() string {
+	return fmt.Sprintf("pool with ID %d does not use the Curve StableSwap invariant", e.PoolId)
+}
+
+type AmplificationRampTooEarlyError struct {
+	PoolId       uint64
+	EarliestTime time.Time
+}
+
+func (e AmplificationRampTooEarlyError) Error() string {
+	return fmt.Sprintf("amplification of pool with ID %d cannot be ramped again before %s", e.PoolId, e.EarliestTime)
+}
+
+type AmplificationRampTooShortError struct {
+	FutureTime         time.Time
+	EarliestFutureTime time.Time
+}
+
+func (e AmplificationRampTooShortError) Error() string {
+	return fmt.Sprintf("amplification ramp future time (%s) must not be before %s", e.FutureTime, e.EarliestFutureTime)
+}
+
+type AmplificationChangeTooLargeError struct {
+	CurrentAmplification sdk.Dec
+	FutureAmplification  uint64
+}
+
+func (e AmplificationChangeTooLargeError) Error() string {
+	return fmt.Sprintf("future amplification (%d) must be within a factor of %d of the current amplification (%s)", e.FutureAmplification, StableswapMaxAmplificationChange, e.CurrentAmplification)
+}
+
+type ScalingFactorRateSourceNotFoundError struct {
+	PoolId uint64
+}
+
+func (e ScalingFactorRateSourceNotFoundError) Error() string {
+	return fmt.Sprintf("scaling factor rate source for pool %d not found", e.PoolId)
+}
+
+type ScalingFactorsDelegatedError struct {
+	PoolId uint64
+}
+
+func (e ScalingFactorsDelegatedError) Error() string {
+	return fmt.Sprintf("scaling factors of pool %d are delegated to a rate source, which must be removed before adjusting them", e.PoolId)
+}
+
+type InvalidMaxScalingFactorChangeError struct {
+	MaxChangePerUpdate sdk.Dec
+}
+
+func (e InvalidMaxScalingFactorChangeError) Error() string {
+	return fmt.Sprintf("max scaling factor change per update (%s) must be positive and less than 1", e.MaxChangePerUpdate)
+}
+
+type InvalidDenomRateSourceError struct {
+	Denom string
+}
+
+func (e InvalidDenomRateSourceError) Error() string {
+	return fmt.Sprintf("rate source of denom %s must have exactly one of twap and contract address set", e.Denom)
+}
+
+type DuplicateDenomRateSourceError struct {
+	Denom string
+}
+
+func (e DuplicateDenomRateSourceError) Error() string {
+	return fmt.Sprintf("denom %s has more than one rate source", e.Denom)
+}
+
+type RateSourceDenomsMismatchError struct {
+	PoolId         uint64
+	ReferenceDenom string
+}
+
+func (e RateSourceDenomsMismatchError) Error() string {
+	return fmt.Sprintf("rate sources of pool %d must cover exactly the pool assets other than the reference denom %s", e.PoolId, e.ReferenceDenom)
+}
+
+type TwapRateSourceSamePoolError struct {
+	PoolId uint64
+}
+
+func (e TwapRateSourceSamePoolError) Error() string {
+	return fmt.Sprintf("twap rate source of pool %d cannot read the pool's own twap", e.PoolId)
+}
+
+type ContractRateSourceEveryBlockError struct {
+	PoolId uint64
+	Denom  string
+}
+
+func (e ContractRateSourceEveryBlockError) Error() string {
+	return fmt.Sprintf("contract rate source of denom %s in pool %d must update at the end of epochs, not every block", e.Denom, e.PoolId)
+}
+
+type RateSourceOutOfGasError struct {
+	PoolId   uint64
+	GasLimit uint64
+}
+
+func (e RateSourceOutOfGasError) Error() string {
+	return fmt.Sprintf("updating the scaling factors of pool %d from its rate source exceeded the gas limit of %d", e.PoolId, e.GasLimit)
+}
+
+type NonPositiveRateError struct {
+	Denom string
+	Rate  sdk.Dec
+}
+
+func (e NonPositiveRateError) Error() string {
+	return fmt.Sprintf("rate of denom %s (%s) must be positive", e.Denom, e.Rate)
+}
+
+type PoolLinkedToConcentratedPoolError struct {
+	PoolId             uint64
+	ConcentratedPoolId uint64
+}
+
+func (e PoolLinkedToConcentratedPoolError) Error() string {
+	return fmt.Sprintf("cannot change the assets of pool %d, as it is linked to concentrated pool %d for migration", e.PoolId, e.ConcentratedPoolId)
+}
+
+type WeightChangeInProgressError struct {
+	PoolId uint64
+}
+
+func (e WeightChangeInProgressError) Error() string {
+	return fmt.Sprintf("cannot change the assets of pool %d while its smooth weight change is in progress", e.PoolId)
+}
+
 // x/gamm module sentinel errors.
 var (
 	ErrPoolNotFound        = errorsmod.Register(ModuleName, 1, "pool not found")
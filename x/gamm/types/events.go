@@ -6,6 +6,15 @@ const (
 	TypeEvtTokenSwapped  = "token_swapped"
 	TypeEvtMigrateShares = "migrate_shares"
 
+	TypeEvtRampAmplification = "ramp_amplification"
+
+	TypeEvtSetScalingFactorRateSource    = "set_scaling_factor_rate_source"
+	TypeEvtRemoveScalingFactorRateSource = "remove_scaling_factor_rate_source"
+	TypeEvtUpdateScalingFactors          = "update_scaling_factors"
+
+	TypeEvtAddPoolAsset    = "add_pool_asset"
+	TypeEvtRemovePoolAsset = "remove_pool_asset"
+
 	AttributeValueCategory     = ModuleName
 	AttributeKeyPoolId         = "pool_id"
 	AttributeKeyPoolIdEntering = "pool_id_entering"
@@ -14,6 +23,15 @@ const (
 	AttributeKeyTokensIn       = "tokens_in"
 	AttributeKeyTokensOut      = "tokens_out"
 
+	AttributeKeyInitialAmplification = "initial_amplification"
+	AttributeKeyFutureAmplification  = "future_amplification"
+	AttributeKeyFutureTime           = "future_time"
+
+	AttributeKeyOldScalingFactors = "old_scaling_factors"
+	AttributeKeyScalingFactors    = "scaling_factors"
+
+	AttributeKeyShareAmount = "share_amount"
+
 	AttributePositionId = "position_id"
 	AttributeAmount0    = "amount0"
 	AttributeAmount1    = "amount1"
@@ -48,12 +48,14 @@ type BankKeeper interface {
 // CommunityPoolKeeper defines the contract needed to be fulfilled for distribution keeper.
 type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
 }
 
 // ConcentratedLiquidityKeeper defines the contract needed to be fulfilled for the concentrated liquidity keeper.
 type ConcentratedLiquidityKeeper interface {
 	GetConcentratedPoolById(ctx sdk.Context, poolId uint64) (cltypes.ConcentratedPoolExtension, error)
 	CreateFullRangePosition(ctx sdk.Context, clPoolId uint64, owner sdk.AccAddress, coins sdk.Coins) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, err error)
+	CreatePositionAroundPrice(ctx sdk.Context, clPoolId uint64, owner sdk.AccAddress, coins sdk.Coins, price, rangeRatio sdk.Dec) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, err error)
 }
 
 // PoolManager defines the interface needed to be fulfilled for
@@ -103,3 +105,15 @@ type PoolIncentivesKeeper interface {
 type IncentivesKeeper interface {
 	GetEpochInfo(ctx sdk.Context) epochtypes.EpochInfo
 }
+
+// TwapKeeper defines the interface needed to be fulfilled for
+// the twap keeper, to read the rates of twap scaling factor rate sources.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (sdk.Dec, error)
+}
+
+// WasmKeeper defines the interface needed to be fulfilled for
+// the WasmKeeper, to query the rates of contract scaling factor rate sources.
+type WasmKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error)
+}
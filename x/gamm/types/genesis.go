@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
@@ -20,5 +22,16 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	rateSourcePoolIds := map[uint64]bool{}
+	for _, rateSource := range gs.ScalingFactorRateSources {
+		if err := rateSource.Validate(); err != nil {
+			return err
+		}
+		if rateSourcePoolIds[rateSource.PoolId] {
+			return fmt.Errorf("pool %d has more than one scaling factor rate source", rateSource.PoolId)
+		}
+		rateSourcePoolIds[rateSource.PoolId] = true
+	}
 	return nil
 }
@@ -76,9 +76,10 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 type GenesisState struct {
 	Pools []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	// will be renamed to next_pool_id in an upcoming version
-	NextPoolNumber   uint64            `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
-	Params           Params            `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
-	MigrationRecords *MigrationRecords `protobuf:"bytes,4,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+	NextPoolNumber           uint64                    `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
+	Params                   Params                    `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	MigrationRecords         *MigrationRecords         `protobuf:"bytes,4,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+	ScalingFactorRateSources []ScalingFactorRateSource `protobuf:"bytes,5,rep,name=scaling_factor_rate_sources,json=scalingFactorRateSources,proto3" json:"scaling_factor_rate_sources"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -142,6 +143,13 @@ func (m *GenesisState) GetMigrationRecords() *MigrationRecords {
 	return nil
 }
 
+func (m *GenesisState) GetScalingFactorRateSources() []ScalingFactorRateSource {
+	if m != nil {
+		return m.ScalingFactorRateSources
+	}
+	return nil
+}
+
 // MigrationRecords contains all the links between balancer and concentrated
 // pools
 type MigrationRecords struct {
@@ -258,41 +266,44 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x73, 0x6d, 0x1a, 0xfd, 0x7e, 0x57, 0x04, 0xa9, 0x95, 0xc1, 0xad, 0x2a, 0x27, 0x32,
-	0x12, 0xca, 0x92, 0x33, 0x29, 0x7f, 0x86, 0x6c, 0x24, 0x12, 0x08, 0x54, 0x50, 0xe5, 0x32, 0xb1,
-	0x58, 0x67, 0xe7, 0x6a, 0xac, 0xd8, 0xf7, 0x44, 0xbe, 0x4b, 0xd5, 0x6c, 0x8c, 0x8c, 0x48, 0xcc,
-	0x20, 0x66, 0x66, 0x5e, 0x44, 0xc5, 0xd4, 0x91, 0xa9, 0xa0, 0x64, 0x61, 0xe6, 0x15, 0xa0, 0xfb,
-	0xe3, 0xa8, 0x2a, 0x51, 0x27, 0xfb, 0x79, 0x9e, 0xcf, 0xf3, 0xf5, 0xf3, 0x7c, 0xef, 0x8c, 0x7d,
-	0x10, 0x05, 0x88, 0x4c, 0x04, 0x29, 0x2d, 0x8a, 0xe0, 0xb4, 0x1f, 0x33, 0x49, 0xfb, 0x41, 0xca,
-	0x38, 0x13, 0x99, 0x20, 0xd3, 0x12, 0x24, 0x38, 0x2d, 0xcb, 0x10, 0xc5, 0x10, 0xcb, 0xec, 0xb5,
-	0x52, 0x48, 0x41, 0x03, 0x81, 0x7a, 0x33, 0xec, 0xde, 0x6e, 0x0a, 0x90, 0xe6, 0x2c, 0xd0, 0x51,
-	0x3c, 0x3b, 0x09, 0x28, 0x9f, 0x57, 0xa5, 0x44, 0xeb, 0x44, 0xa6, 0xc7, 0x04, 0xb6, 0xe4, 0x99,
-	0x28, 0x88, 0xa9, 0x60, 0xab, 0x21, 0x12, 0xc8, 0xb8, 0xa9, 0xfb, 0x9f, 0x11, 0x6e, 0x1c, 0xd1,
-	0x92, 0x16, 0xc2, 0xf9, 0x88, 0xf0, 0xce, 0x14, 0x20, 0x8f, 0x92, 0x92, 0x51, 0x99, 0x01, 0x8f,
-	0x4e, 0x18, 0x73, 0x51, 0x67, 0xb3, 0xbb, 0x7d, 0xb0, 0x4b, 0xac, 0xaa, 0xd2, 0xa9, 0x06, 0x25,
-	0x23, 0xc8, 0xf8, 0xf0, 0xf0, 0xfc, 0xb2, 0x5d, 0xfb, 0x73, 0xd9, 0x76, 0xe7, 0xb4, 0xc8, 0x07,
-	0xfe, 0x3f, 0x0a, 0xfe, 0xd7, 0x9f, 0xed, 0x6e, 0x9a, 0xc9, 0xb7, 0xb3, 0x98, 0x24, 0x50, 0xd8,
-	0xf1, 0xec, 0xa3, 0x27, 0xc6, 0x93, 0x40, 0xce, 0xa7, 0x4c, 0x68, 0x31, 0x11, 0xde, 0x51, 0xfd,
-	0x23, 0xdb, 0xfe, 0x94, 0x31, 0xff, 0xdd, 0x06, 0xbe, 0xf5, 0xcc, 0x98, 0x76, 0x2c, 0xa9, 0x64,
-	0xce, 0x23, 0xbc, 0xa5, 0x18, 0x61, 0x27, 0x6b, 0x11, 0xe3, 0x0b, 0xa9, 0x7c, 0x21, 0x4f, 0xf8,
-	0x7c, 0xf8, 0xff, 0xf7, 0x6f, 0xbd, 0xad, 0x23, 0x80, 0xfc, 0x79, 0x68, 0x68, 0xa7, 0x8b, 0x9b,
-	0x9c, 0x9d, 0xc9, 0x48, 0xcf, 0xc7, 0x67, 0x45, 0xcc, 0x4a, 0x77, 0xa3, 0x83, 0xba, 0xf5, 0xf0,
-	0xb6, 0xca, 0x2b, 0xf6, 0x95, 0xce, 0x3a, 0x03, 0xdc, 0x98, 0x6a, 0x47, 0xdc, 0xcd, 0x0e, 0xea,
-	0x6e, 0x1f, 0xec, 0x93, 0x75, 0xa7, 0x44, 0x8c, 0x6b, 0xc3, 0xba, 0x5a, 0x3f, 0xb4, 0x1d, 0xce,
-	0x31, 0xde, 0x29, 0xb2, 0xb4, 0x34, 0xcb, 0x97, 0x2c, 0x81, 0x72, 0x2c, 0xdc, 0xba, 0x96, 0xb9,
-	0xb7, 0x5e, 0xe6, 0x65, 0x85, 0x87, 0x86, 0x0e, 0x9b, 0xc5, 0xb5, 0x8c, 0xff, 0x09, 0xe1, 0xe6,
-	0x75, 0xcc, 0x79, 0x8f, 0xf0, 0xdd, 0x98, 0xe6, 0x94, 0x27, 0xac, 0x8c, 0x24, 0x44, 0x09, 0xf0,
-	0x84, 0x71, 0x59, 0x52, 0xc9, 0xc6, 0x66, 0xc9, 0x3c, 0xe3, 0x93, 0xca, 0xa5, 0x87, 0xeb, 0x3f,
-	0x3e, 0xb4, 0x02, 0xaf, 0x61, 0x74, 0xa5, 0x5d, 0x79, 0x71, 0x98, 0xf1, 0x89, 0xdd, 0xad, 0x1d,
-	0xdf, 0x48, 0x09, 0x9f, 0x63, 0xef, 0x66, 0x21, 0x65, 0xfe, 0x6a, 0x56, 0x3d, 0x5b, 0x36, 0x76,
-	0x91, 0x31, 0xbf, 0xca, 0xeb, 0xc3, 0x1a, 0x3b, 0xfb, 0x18, 0x27, 0xf9, 0x8a, 0x31, 0x07, 0xf4,
-	0x5f, 0x92, 0x9b, 0xea, 0xa0, 0xfe, 0xfb, 0x4b, 0x1b, 0x0d, 0x5f, 0x9c, 0x2f, 0x3c, 0x74, 0xb1,
-	0xf0, 0xd0, 0xaf, 0x85, 0x87, 0x3e, 0x2c, 0xbd, 0xda, 0xc5, 0xd2, 0xab, 0xfd, 0x58, 0x7a, 0xb5,
-	0x37, 0xf7, 0xaf, 0xdc, 0x33, 0xbb, 0x70, 0x2f, 0xa7, 0xb1, 0xa8, 0x82, 0xe0, 0xb4, 0xff, 0x38,
-	0x38, 0x33, 0x7f, 0xa4, 0xbe, 0x75, 0x71, 0x43, 0x5f, 0x9b, 0x07, 0x7f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xa6, 0x5b, 0xa5, 0xbb, 0xae, 0x03, 0x00, 0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xb5, 0x49, 0x04, 0x57, 0x04, 0xa9, 0x95, 0xc1, 0x2d, 0x95, 0x13, 0x19, 0x09, 0x65,
+	0x89, 0x4d, 0x0a, 0x65, 0xc8, 0x46, 0x22, 0x15, 0x81, 0x0a, 0xaa, 0x1c, 0x26, 0x16, 0xeb, 0x7c,
+	0xb9, 0x18, 0x2b, 0xf6, 0x5d, 0x74, 0x77, 0xa9, 0x9a, 0x7f, 0xc0, 0x88, 0xc4, 0x0c, 0x62, 0x66,
+	0xe6, 0x47, 0x54, 0x4c, 0x1d, 0x99, 0x0a, 0x4a, 0x16, 0x66, 0xc4, 0x0f, 0x40, 0xbe, 0x3b, 0x47,
+	0x55, 0x71, 0x3b, 0x25, 0xf7, 0xde, 0xf7, 0x7d, 0xf9, 0xde, 0xf7, 0x5e, 0xa0, 0xcb, 0x44, 0xc6,
+	0x44, 0x22, 0xfc, 0x18, 0x65, 0x99, 0x7f, 0xd2, 0x8b, 0x88, 0x44, 0x3d, 0x3f, 0x26, 0x94, 0x88,
+	0x44, 0x78, 0x33, 0xce, 0x24, 0xb3, 0x9a, 0x06, 0xe3, 0xe5, 0x18, 0xcf, 0x60, 0x76, 0x9b, 0x31,
+	0x8b, 0x99, 0x02, 0xf8, 0xf9, 0x37, 0x8d, 0xdd, 0xdd, 0x89, 0x19, 0x8b, 0x53, 0xe2, 0xab, 0x57,
+	0x34, 0x9f, 0xf8, 0x88, 0x2e, 0x8a, 0x16, 0x56, 0x3a, 0xa1, 0xe6, 0xe8, 0x87, 0x69, 0x39, 0xfa,
+	0xe5, 0x47, 0x48, 0x90, 0xb5, 0x09, 0xcc, 0x12, 0x6a, 0xfa, 0x07, 0xa5, 0x2e, 0x05, 0x46, 0x69,
+	0x42, 0xe3, 0x70, 0x82, 0xb0, 0x64, 0x3c, 0xe4, 0x48, 0x92, 0x50, 0xb0, 0x39, 0xc7, 0x44, 0xd3,
+	0xdc, 0xcf, 0x00, 0xd6, 0x8f, 0x11, 0x47, 0x99, 0xb0, 0x3e, 0x02, 0xb8, 0x3d, 0x63, 0x2c, 0x0d,
+	0x31, 0x27, 0x48, 0x26, 0x8c, 0x86, 0x13, 0x42, 0x6c, 0xd0, 0xde, 0xec, 0x6c, 0xed, 0xef, 0x78,
+	0xc6, 0x4c, 0xfe, 0xf3, 0xc5, 0x7c, 0xde, 0x90, 0x25, 0x74, 0x70, 0x74, 0x76, 0xd1, 0xaa, 0xfc,
+	0xb9, 0x68, 0xd9, 0x0b, 0x94, 0xa5, 0x7d, 0xf7, 0x3f, 0x05, 0xf7, 0xeb, 0xcf, 0x56, 0x27, 0x4e,
+	0xe4, 0xbb, 0x79, 0xe4, 0x61, 0x96, 0x99, 0xa9, 0xcc, 0x47, 0x57, 0x8c, 0xa7, 0xbe, 0x5c, 0xcc,
+	0x88, 0x50, 0x62, 0x22, 0xb8, 0x97, 0xf3, 0x87, 0x86, 0x7e, 0x48, 0x88, 0xfb, 0x77, 0x03, 0xde,
+	0x79, 0xae, 0xb3, 0x1e, 0x49, 0x24, 0x89, 0x75, 0x00, 0x6b, 0x39, 0x46, 0x18, 0x67, 0x4d, 0x4f,
+	0xc7, 0xe9, 0x15, 0x71, 0x7a, 0xcf, 0xe8, 0x62, 0x70, 0xfb, 0xfb, 0xb7, 0x6e, 0xed, 0x98, 0xb1,
+	0xf4, 0x45, 0xa0, 0xd1, 0x56, 0x07, 0x36, 0x28, 0x39, 0x95, 0xa1, 0xf2, 0x47, 0xe7, 0x59, 0x44,
+	0xb8, 0xbd, 0xd1, 0x06, 0x9d, 0x6a, 0x70, 0x37, 0xaf, 0xe7, 0xd8, 0xd7, 0xaa, 0x6a, 0xf5, 0x61,
+	0x7d, 0xa6, 0x12, 0xb1, 0x37, 0xdb, 0xa0, 0xb3, 0xb5, 0xbf, 0xe7, 0x95, 0x2d, 0xd7, 0xd3, 0xa9,
+	0x0d, 0xaa, 0xf9, 0xf8, 0x81, 0x61, 0x58, 0x23, 0xb8, 0x9d, 0x25, 0x31, 0xd7, 0xc3, 0x73, 0x82,
+	0x19, 0x1f, 0x0b, 0xbb, 0xaa, 0x64, 0x1e, 0x96, 0xcb, 0xbc, 0x2a, 0xe0, 0x81, 0x46, 0x07, 0x8d,
+	0xec, 0x4a, 0xc5, 0xe2, 0xf0, 0xfe, 0xf5, 0x7b, 0x14, 0x76, 0x4d, 0xe5, 0xd0, 0x2d, 0x97, 0x1f,
+	0x69, 0xe2, 0xa1, 0xe2, 0x05, 0x48, 0x92, 0x91, 0x62, 0x19, 0xdb, 0xb6, 0x28, 0x6f, 0x0b, 0xf7,
+	0x13, 0x80, 0x8d, 0xab, 0xd6, 0xac, 0xf7, 0x00, 0x3e, 0x88, 0x50, 0x8a, 0x28, 0x26, 0x3c, 0x94,
+	0x2c, 0xc4, 0x8c, 0x62, 0x42, 0x65, 0xee, 0x66, 0xac, 0x83, 0x4d, 0x13, 0x3a, 0x2d, 0x36, 0xf3,
+	0xa4, 0xdc, 0xd1, 0xc0, 0x08, 0xbc, 0x61, 0xc3, 0x4b, 0xf4, 0x3c, 0xff, 0xa3, 0x84, 0x4e, 0x8d,
+	0xb1, 0x56, 0x74, 0x23, 0x4a, 0xb8, 0x14, 0x3a, 0x37, 0x0b, 0xe5, 0x0b, 0x5f, 0x7b, 0x55, 0xde,
+	0x92, 0xb1, 0x0d, 0xf4, 0xc2, 0x8b, 0xba, 0x3a, 0x90, 0xb1, 0xb5, 0x07, 0x21, 0x4e, 0xd7, 0x18,
+	0x7d, 0x14, 0xb7, 0x70, 0xaa, 0xbb, 0xfd, 0xea, 0xef, 0x2f, 0x2d, 0x30, 0x78, 0x79, 0xb6, 0x74,
+	0xc0, 0xf9, 0xd2, 0x01, 0xbf, 0x96, 0x0e, 0xf8, 0xb0, 0x72, 0x2a, 0xe7, 0x2b, 0xa7, 0xf2, 0x63,
+	0xe5, 0x54, 0xde, 0x3e, 0xba, 0x74, 0xdb, 0x66, 0xe0, 0x6e, 0x8a, 0x22, 0x51, 0x3c, 0xfc, 0x93,
+	0xde, 0x53, 0xff, 0x54, 0xff, 0x2d, 0xd5, 0xa5, 0x47, 0x75, 0x75, 0xaa, 0x8f, 0xff, 0x0d, 0x00,
+	0x4f, 0xf1, 0xad, 0x7f, 0x59, 0x04, 0x00, 0x00,
 }
 
 func (this *BalancerToConcentratedPoolLink) Equal(that interface{}) bool {
@@ -379,6 +390,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScalingFactorRateSources) > 0 {
+		for iNdEx := len(m.ScalingFactorRateSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScalingFactorRateSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.MigrationRecords != nil {
 		{
 			size, err := m.MigrationRecords.MarshalToSizedBuffer(dAtA[:i])
@@ -540,6 +565,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.MigrationRecords.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ScalingFactorRateSources) > 0 {
+		for _, e := range m.ScalingFactorRateSources {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -814,6 +845,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRateSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScalingFactorRateSources = append(m.ScalingFactorRateSources, ScalingFactorRateSource{})
+			if err := m.ScalingFactorRateSources[len(m.ScalingFactorRateSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
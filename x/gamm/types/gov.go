@@ -3,26 +3,41 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
 	ProposalTypeUpdateMigrationRecords  = "UpdateMigrationRecords"
 	ProposalTypeReplaceMigrationRecords = "ReplaceMigrationRecords"
+	ProposalTypeAddPoolAsset            = "AddPoolAsset"
+	ProposalTypeRemovePoolAsset         = "RemovePoolAsset"
+	ProposalTypeRampAmplification       = "RampAmplification"
 )
 
-// Init registers proposals to update and replace migration records.
+// Init registers proposals to update and replace migration records, to add and remove pool assets,
+// and to ramp stableswap amplification.
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeUpdateMigrationRecords)
 	govtypes.RegisterProposalTypeCodec(&UpdateMigrationRecordsProposal{}, "osmosis/UpdateMigrationRecordsProposal")
 	govtypes.RegisterProposalType(ProposalTypeReplaceMigrationRecords)
 	govtypes.RegisterProposalTypeCodec(&ReplaceMigrationRecordsProposal{}, "osmosis/ReplaceMigrationRecordsProposal")
+	govtypes.RegisterProposalType(ProposalTypeAddPoolAsset)
+	govtypes.RegisterProposalTypeCodec(&AddPoolAssetProposal{}, "osmosis/AddPoolAssetProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemovePoolAsset)
+	govtypes.RegisterProposalTypeCodec(&RemovePoolAssetProposal{}, "osmosis/RemovePoolAssetProposal")
+	govtypes.RegisterProposalType(ProposalTypeRampAmplification)
+	govtypes.RegisterProposalTypeCodec(&RampAmplificationProposal{}, "osmosis/RampAmplificationProposal")
 }
 
 var (
 	_ govtypes.Content = &UpdateMigrationRecordsProposal{}
 	_ govtypes.Content = &ReplaceMigrationRecordsProposal{}
+	_ govtypes.Content = &AddPoolAssetProposal{}
+	_ govtypes.Content = &RemovePoolAssetProposal{}
+	_ govtypes.Content = &RampAmplificationProposal{}
 )
 
 // NewReplacePoolIncentivesProposal returns a new instance of a replace migration record's proposal struct.
@@ -129,3 +144,197 @@ func (p UpdateMigrationRecordsProposal) String() string {
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+// NewAddPoolAssetProposal returns a new instance of an add pool asset proposal struct.
+// The weight must be set for balancer pools, and the scaling factor for stableswap pools.
+func NewAddPoolAssetProposal(title, description string, poolId uint64, token sdk.Coin, weight sdk.Int, scalingFactor uint64) govtypes.Content {
+	return &AddPoolAssetProposal{
+		Title:         title,
+		Description:   description,
+		PoolId:        poolId,
+		Token:         token,
+		Weight:        weight,
+		ScalingFactor: scalingFactor,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *AddPoolAssetProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *AddPoolAssetProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *AddPoolAssetProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *AddPoolAssetProposal) ProposalType() string {
+	return ProposalTypeAddPoolAsset
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+// Exactly one of the weight or the scaling factor must be set.
+func (p *AddPoolAssetProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.PoolId == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+	if !p.Token.IsValid() || !p.Token.IsPositive() {
+		return fmt.Errorf("token (%s) must be valid and positive", p.Token)
+	}
+	hasWeight := !p.Weight.IsNil() && !p.Weight.IsZero()
+	if hasWeight && !p.Weight.IsPositive() {
+		return fmt.Errorf("weight (%s) must be positive", p.Weight)
+	}
+	if hasWeight == (p.ScalingFactor != 0) {
+		return fmt.Errorf("exactly one of weight (balancer pools) or scaling factor (stableswap pools) must be set")
+	}
+
+	return nil
+}
+
+// String returns a string containing the add pool asset proposal.
+func (p AddPoolAssetProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Add Pool Asset Proposal:
+  Title:          %s
+  Description:    %s
+  PoolId:         %d
+  Token:          %s
+  Weight:         %s
+  ScalingFactor:  %d
+`, p.Title, p.Description, p.PoolId, p.Token, p.Weight, p.ScalingFactor))
+	return b.String()
+}
+
+// NewRemovePoolAssetProposal returns a new instance of a remove pool asset proposal struct.
+func NewRemovePoolAssetProposal(title, description string, poolId uint64, denom string, exchangePoolId uint64, exchangeDenom string, exchangeMinAmount sdk.Int) govtypes.Content {
+	return &RemovePoolAssetProposal{
+		Title:             title,
+		Description:       description,
+		PoolId:            poolId,
+		Denom:             denom,
+		ExchangePoolId:    exchangePoolId,
+		ExchangeDenom:     exchangeDenom,
+		ExchangeMinAmount: exchangeMinAmount,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *RemovePoolAssetProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *RemovePoolAssetProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *RemovePoolAssetProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *RemovePoolAssetProposal) ProposalType() string {
+	return ProposalTypeRemovePoolAsset
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+// The removed asset must be exchanged in another pool, into another denom, for a positive min amount.
+func (p *RemovePoolAssetProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.PoolId == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+	if p.ExchangePoolId == 0 || p.ExchangePoolId == p.PoolId {
+		return fmt.Errorf("exchange pool id (%d) must be positive and differ from the pool id", p.ExchangePoolId)
+	}
+	if err := sdk.ValidateDenom(p.ExchangeDenom); err != nil {
+		return err
+	}
+	if p.ExchangeDenom == p.Denom {
+		return fmt.Errorf("exchange denom (%s) must differ from the removed denom", p.ExchangeDenom)
+	}
+	if p.ExchangeMinAmount.IsNil() || !p.ExchangeMinAmount.IsPositive() {
+		return fmt.Errorf("exchange min amount (%s) must be positive", p.ExchangeMinAmount)
+	}
+
+	return nil
+}
+
+// String returns a string containing the remove pool asset proposal.
+func (p RemovePoolAssetProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Pool Asset Proposal:
+  Title:              %s
+  Description:        %s
+  PoolId:             %d
+  Denom:              %s
+  ExchangePoolId:     %d
+  ExchangeDenom:      %s
+  ExchangeMinAmount:  %s
+`, p.Title, p.Description, p.PoolId, p.Denom, p.ExchangePoolId, p.ExchangeDenom, p.ExchangeMinAmount))
+	return b.String()
+}
+
+// NewRampAmplificationProposal returns a new instance of a ramp amplification proposal struct.
+func NewRampAmplificationProposal(title, description string, poolId uint64, futureAmplification uint64, rampDuration time.Duration) govtypes.Content {
+	return &RampAmplificationProposal{
+		Title:               title,
+		Description:         description,
+		PoolId:              poolId,
+		FutureAmplification: futureAmplification,
+		RampDuration:        rampDuration,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *RampAmplificationProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *RampAmplificationProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *RampAmplificationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *RampAmplificationProposal) ProposalType() string {
+	return ProposalTypeRampAmplification
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+// The ramp must last at least StableswapMinAmplificationRampDuration.
+func (p *RampAmplificationProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.PoolId == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+	if p.FutureAmplification < StableswapMinAmplification || p.FutureAmplification > StableswapMaxAmplification {
+		return InvalidAmplificationError{Amplification: p.FutureAmplification}
+	}
+	if p.RampDuration < StableswapMinAmplificationRampDuration {
+		return fmt.Errorf("ramp duration (%s) must be at least %s", p.RampDuration, StableswapMinAmplificationRampDuration)
+	}
+
+	return nil
+}
+
+// String returns a string containing the ramp amplification proposal.
+func (p RampAmplificationProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Ramp Amplification Proposal:
+  Title:                %s
+  Description:          %s
+  PoolId:               %d
+  FutureAmplification:  %d
+  RampDuration:         %s
+`, p.Title, p.Description, p.PoolId, p.FutureAmplification, p.RampDuration))
+	return b.String()
+}
@@ -6,18 +6,24 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -114,39 +120,212 @@ func (m *UpdateMigrationRecordsProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateMigrationRecordsProposal proto.InternalMessageInfo
 
+// AddPoolAssetProposal is a gov Content type for adding a new asset to an
+// existing balancer or stableswap pool. The token is paid from the community
+// pool and the pool shares minted for it are sent back to the community pool,
+// so the price implied by the chosen weight or scaling factor is set by
+// governance rather than by an individual depositor.
+// Exactly one of weight (balancer pools) or scaling_factor (stableswap pools)
+// must be set.
+type AddPoolAssetProposal struct {
+	Title         string                                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolId        uint64                                 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Token         types.Coin                             `protobuf:"bytes,4,opt,name=token,proto3" json:"token" yaml:"token"`
+	Weight        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"weight" yaml:"weight"`
+	ScalingFactor uint64                                 `protobuf:"varint,6,opt,name=scaling_factor,json=scalingFactor,proto3" json:"scaling_factor,omitempty" yaml:"scaling_factor"`
+}
+
+func (m *AddPoolAssetProposal) Reset()      { *m = AddPoolAssetProposal{} }
+func (*AddPoolAssetProposal) ProtoMessage() {}
+func (*AddPoolAssetProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31b9a6c0dbbdfa3, []int{2}
+}
+func (m *AddPoolAssetProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddPoolAssetProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddPoolAssetProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddPoolAssetProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddPoolAssetProposal.Merge(m, src)
+}
+func (m *AddPoolAssetProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddPoolAssetProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddPoolAssetProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddPoolAssetProposal proto.InternalMessageInfo
+
+// RemovePoolAssetProposal is a gov Content type for removing an asset from an
+// existing balancer or stableswap pool. The asset's whole reserve is swapped in
+// the exchange pool into exchange_denom, another asset of the pool, for at
+// least exchange_min_amount, and the proceeds are added to the pool's reserves.
+// The pool shares are unchanged, so that the LPs keep the value of the removed
+// reserve.
+type RemovePoolAssetProposal struct {
+	Title             string                                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolId            uint64                                 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Denom             string                                 `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	ExchangePoolId    uint64                                 `protobuf:"varint,5,opt,name=exchange_pool_id,json=exchangePoolId,proto3" json:"exchange_pool_id,omitempty" yaml:"exchange_pool_id"`
+	ExchangeDenom     string                                 `protobuf:"bytes,6,opt,name=exchange_denom,json=exchangeDenom,proto3" json:"exchange_denom,omitempty" yaml:"exchange_denom"`
+	ExchangeMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=exchange_min_amount,json=exchangeMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"exchange_min_amount" yaml:"exchange_min_amount"`
+}
+
+func (m *RemovePoolAssetProposal) Reset()      { *m = RemovePoolAssetProposal{} }
+func (*RemovePoolAssetProposal) ProtoMessage() {}
+func (*RemovePoolAssetProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31b9a6c0dbbdfa3, []int{3}
+}
+func (m *RemovePoolAssetProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovePoolAssetProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovePoolAssetProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemovePoolAssetProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePoolAssetProposal.Merge(m, src)
+}
+func (m *RemovePoolAssetProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovePoolAssetProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePoolAssetProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePoolAssetProposal proto.InternalMessageInfo
+
+// RampAmplificationProposal is a gov Content type for ramping the
+// amplification coefficient of an existing stableswap pool that uses the
+// Curve StableSwap invariant, regardless of the pool's scaling factor
+// controller. The amplification is ramped linearly from its current value to
+// future_amplification over ramp_duration, starting when the proposal is
+// executed.
+type RampAmplificationProposal struct {
+	Title               string        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description         string        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolId              uint64        `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	FutureAmplification uint64        `protobuf:"varint,4,opt,name=future_amplification,json=futureAmplification,proto3" json:"future_amplification,omitempty" yaml:"future_amplification"`
+	RampDuration        time.Duration `protobuf:"bytes,5,opt,name=ramp_duration,json=rampDuration,proto3,stdduration" json:"ramp_duration" yaml:"ramp_duration"`
+}
+
+func (m *RampAmplificationProposal) Reset()      { *m = RampAmplificationProposal{} }
+func (*RampAmplificationProposal) ProtoMessage() {}
+func (*RampAmplificationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31b9a6c0dbbdfa3, []int{4}
+}
+func (m *RampAmplificationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RampAmplificationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RampAmplificationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RampAmplificationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RampAmplificationProposal.Merge(m, src)
+}
+func (m *RampAmplificationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RampAmplificationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RampAmplificationProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RampAmplificationProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ReplaceMigrationRecordsProposal)(nil), "osmosis.gamm.v1beta1.ReplaceMigrationRecordsProposal")
 	proto.RegisterType((*UpdateMigrationRecordsProposal)(nil), "osmosis.gamm.v1beta1.UpdateMigrationRecordsProposal")
+	proto.RegisterType((*AddPoolAssetProposal)(nil), "osmosis.gamm.v1beta1.AddPoolAssetProposal")
+	proto.RegisterType((*RemovePoolAssetProposal)(nil), "osmosis.gamm.v1beta1.RemovePoolAssetProposal")
+	proto.RegisterType((*RampAmplificationProposal)(nil), "osmosis.gamm.v1beta1.RampAmplificationProposal")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/gov.proto", fileDescriptor_f31b9a6c0dbbdfa3) }
 
 var fileDescriptor_f31b9a6c0dbbdfa3 = []byte{
-	// 377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x4f, 0x4f, 0xcc, 0xcd, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0xcf, 0x2f, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81, 0xca, 0xeb, 0x81,
-	0xe4, 0xf5, 0xa0, 0xf2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x05, 0xfa, 0x20, 0x16, 0x44,
-	0xad, 0x94, 0x12, 0x76, 0xb3, 0x52, 0xf3, 0x52, 0x41, 0x06, 0x40, 0xd4, 0x48, 0x26, 0x83, 0x15,
-	0xc5, 0x43, 0x34, 0x43, 0x38, 0x50, 0x29, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09,
-	0x11, 0x52, 0x6a, 0x67, 0xe2, 0x92, 0x0f, 0x4a, 0x2d, 0xc8, 0x49, 0x4c, 0x4e, 0xf5, 0xcd, 0x4c,
-	0x2f, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0x0b, 0x4a, 0x4d, 0xce, 0x2f, 0x4a, 0x29, 0x0e, 0x28, 0xca,
-	0x2f, 0xc8, 0x2f, 0x4e, 0xcc, 0x11, 0x12, 0xe1, 0x62, 0x2d, 0xc9, 0x2c, 0xc9, 0x49, 0x95, 0x60,
-	0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0x70, 0x84, 0x14, 0xb8, 0xb8, 0x53, 0x52, 0x8b, 0x93, 0x8b,
-	0x32, 0x0b, 0x40, 0x7a, 0x24, 0x98, 0xc0, 0x72, 0xc8, 0x42, 0x42, 0x21, 0x5c, 0xec, 0x45, 0x10,
-	0xa3, 0x24, 0x98, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0x4c, 0xf4, 0xb0, 0xf9, 0x55, 0xcf, 0x29, 0x31,
-	0x27, 0x31, 0x2f, 0x39, 0xb5, 0x28, 0x24, 0xdf, 0x39, 0x3f, 0x2f, 0x39, 0x35, 0xaf, 0xa4, 0x28,
-	0xb1, 0x24, 0x35, 0x25, 0x20, 0x3f, 0x3f, 0xc7, 0x27, 0x33, 0x2f, 0xdb, 0x89, 0xe5, 0xc4, 0x3d,
-	0x79, 0x86, 0x20, 0x98, 0x51, 0x56, 0x61, 0x1d, 0x0b, 0xe4, 0x19, 0x66, 0x2c, 0x90, 0x67, 0x78,
-	0xb1, 0x40, 0x9e, 0xf1, 0xd4, 0x16, 0x5d, 0x29, 0xa8, 0x17, 0x41, 0x21, 0x0a, 0x33, 0xd1, 0x39,
-	0x3f, 0xaf, 0x24, 0x35, 0xaf, 0xa4, 0xeb, 0xf9, 0x06, 0x2d, 0x75, 0x58, 0x90, 0x11, 0xf0, 0xa5,
-	0x52, 0x2b, 0x13, 0x97, 0x5c, 0x68, 0x41, 0x4a, 0x62, 0xc9, 0x50, 0x09, 0x88, 0x50, 0xd2, 0x02,
-	0x42, 0x0d, 0x16, 0x10, 0xf8, 0x3d, 0xe9, 0xe4, 0x75, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x06, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50,
-	0xc3, 0x74, 0x73, 0x12, 0x93, 0x8a, 0x61, 0x1c, 0xfd, 0x32, 0x43, 0x33, 0xfd, 0x0a, 0x48, 0xda,
-	0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x27, 0x32, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xc1, 0xe0, 0x3a, 0x06, 0x04, 0x03, 0x00, 0x00,
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x9b, 0x1f, 0xd5, 0x4e, 0x37, 0xd5, 0xae, 0x37, 0x68, 0x9d, 0x2c, 0xf2, 0x04, 0x1f,
+	0x4a, 0x04, 0xaa, 0x4d, 0x17, 0xc4, 0x21, 0x17, 0x36, 0xde, 0x5d, 0xa4, 0x85, 0x5d, 0x14, 0x59,
+	0x5b, 0x90, 0xb8, 0x98, 0x89, 0x3d, 0x71, 0x47, 0xb5, 0x67, 0x2c, 0x7b, 0x12, 0x5a, 0x89, 0x2b,
+	0xa2, 0xe2, 0xc4, 0xb1, 0xc7, 0x9e, 0x39, 0x71, 0xe0, 0x8f, 0xa8, 0x38, 0xf5, 0x88, 0x38, 0x18,
+	0xd4, 0x1e, 0xe0, 0x9c, 0x3b, 0x12, 0xf2, 0x8c, 0x9d, 0x26, 0x55, 0x00, 0x45, 0x48, 0x20, 0x2e,
+	0x89, 0xdf, 0xfb, 0xde, 0xfb, 0xde, 0xbc, 0xf7, 0x3d, 0x7b, 0x80, 0xce, 0xd2, 0x88, 0xa5, 0x24,
+	0xb5, 0x02, 0x14, 0x45, 0xd6, 0x74, 0x6f, 0x84, 0x39, 0xda, 0xb3, 0x02, 0x36, 0x35, 0xe3, 0x84,
+	0x71, 0xa6, 0xb6, 0x0a, 0xdc, 0xcc, 0x71, 0xb3, 0xc0, 0x3b, 0xad, 0x80, 0x05, 0x4c, 0x04, 0x58,
+	0xf9, 0x93, 0x8c, 0xed, 0x18, 0xab, 0xb9, 0x30, 0xc5, 0x39, 0x81, 0x8c, 0x69, 0x7b, 0x22, 0xc8,
+	0x95, 0xc9, 0xd2, 0x28, 0xa0, 0xbb, 0x28, 0x22, 0x94, 0x59, 0xe2, 0xb7, 0x70, 0xe9, 0x32, 0xc0,
+	0x1a, 0xa1, 0x14, 0xcf, 0x09, 0x3d, 0x46, 0x68, 0x89, 0x07, 0x8c, 0x05, 0x21, 0xb6, 0x84, 0x35,
+	0x9a, 0x8c, 0x2d, 0x7f, 0x92, 0x20, 0x4e, 0x58, 0x81, 0x1b, 0x5f, 0x6d, 0x00, 0xe8, 0xe0, 0x38,
+	0x44, 0x1e, 0x7e, 0x41, 0x02, 0x09, 0x39, 0xd8, 0x63, 0x89, 0x9f, 0x0e, 0x13, 0x16, 0xb3, 0x14,
+	0x85, 0x6a, 0x0b, 0xd4, 0x39, 0xe1, 0x21, 0xd6, 0x94, 0xae, 0xd2, 0xbb, 0xe5, 0x48, 0x43, 0xed,
+	0x82, 0x2d, 0x1f, 0xa7, 0x5e, 0x42, 0xe2, 0x3c, 0x47, 0xdb, 0x10, 0xd8, 0xa2, 0x4b, 0x7d, 0x09,
+	0x36, 0x13, 0x49, 0xa5, 0x55, 0xbb, 0xd5, 0xde, 0xd6, 0xc3, 0x77, 0xcc, 0x55, 0xb3, 0x32, 0x6d,
+	0x14, 0x22, 0xea, 0xe1, 0xe4, 0x25, 0x7b, 0xcc, 0xa8, 0x87, 0x29, 0x4f, 0x10, 0xc7, 0xfe, 0x90,
+	0xb1, 0xf0, 0x39, 0xa1, 0x87, 0x76, 0xed, 0x3c, 0x83, 0x15, 0xa7, 0xa4, 0xea, 0x7f, 0x7c, 0x72,
+	0x06, 0x2b, 0xa7, 0x67, 0xb0, 0xf2, 0xdb, 0x19, 0x54, 0x7e, 0xf8, 0x7e, 0xb7, 0x53, 0x8c, 0x28,
+	0x57, 0xa4, 0x64, 0x7c, 0xcc, 0x28, 0xc7, 0x94, 0x7f, 0xfd, 0xeb, 0x77, 0x6f, 0xbc, 0x5e, 0x8e,
+	0xfc, 0x6f, 0xba, 0x34, 0xbe, 0xdc, 0x00, 0xfa, 0x7e, 0xec, 0x23, 0xfe, 0x7f, 0x19, 0xc4, 0xfe,
+	0x7a, 0x83, 0xd8, 0x29, 0x07, 0xf1, 0xd7, 0x4d, 0x1a, 0xdf, 0x56, 0x41, 0x6b, 0xe0, 0x8b, 0xaa,
+	0x83, 0x34, 0xc5, 0xfc, 0x1f, 0x77, 0xff, 0x26, 0xd8, 0x8c, 0x19, 0x0b, 0x5d, 0xe2, 0x6b, 0xd5,
+	0xae, 0xd2, 0xab, 0xd9, 0xea, 0x2c, 0x83, 0xdb, 0xc7, 0x28, 0x0a, 0xfb, 0x46, 0x01, 0x18, 0x4e,
+	0x23, 0x7f, 0x7a, 0xe6, 0xab, 0x4f, 0x41, 0x9d, 0xb3, 0x43, 0x4c, 0xb5, 0x5a, 0x57, 0xe9, 0x6d,
+	0x3d, 0x6c, 0x9b, 0x45, 0x53, 0xf9, 0x7e, 0x2f, 0x74, 0x45, 0xa8, 0xdd, 0x9a, 0x65, 0xf0, 0xb6,
+	0x64, 0x11, 0x19, 0x86, 0x98, 0x8e, 0xcc, 0x56, 0x3f, 0x01, 0x8d, 0xcf, 0x31, 0x09, 0x0e, 0xb8,
+	0x56, 0xcf, 0x0f, 0x64, 0xbf, 0xf7, 0x53, 0x06, 0x77, 0x02, 0xc2, 0x0f, 0x26, 0x23, 0xd3, 0x63,
+	0x51, 0xf1, 0x5a, 0x15, 0x7f, 0xbb, 0xa9, 0x7f, 0x68, 0xf1, 0xe3, 0x18, 0xa7, 0xe6, 0x33, 0xca,
+	0x67, 0x19, 0x6c, 0x4a, 0x5a, 0xc9, 0x20, 0x79, 0x0b, 0x3a, 0xf5, 0x11, 0xd8, 0x4e, 0x3d, 0x14,
+	0x12, 0x1a, 0xb8, 0x63, 0xe4, 0x71, 0x96, 0x68, 0x0d, 0xd1, 0x53, 0x7b, 0x96, 0xc1, 0x57, 0x64,
+	0xda, 0x32, 0x6e, 0x38, 0xcd, 0xc2, 0xf1, 0xbe, 0xb0, 0xfb, 0x1f, 0xae, 0x27, 0xdb, 0xab, 0xa5,
+	0x6c, 0xab, 0x34, 0x31, 0x7e, 0xaf, 0x82, 0xfb, 0x0e, 0x8e, 0xd8, 0x14, 0xff, 0x47, 0x7a, 0xed,
+	0x80, 0xba, 0x8f, 0x29, 0x8b, 0x84, 0x5e, 0xb7, 0xec, 0x3b, 0xd7, 0xa2, 0x08, 0xb7, 0xe1, 0x48,
+	0x58, 0x7d, 0x0a, 0xee, 0xe0, 0x23, 0xef, 0x00, 0xd1, 0x00, 0xbb, 0x25, 0x7b, 0x5d, 0xb0, 0x3f,
+	0x98, 0x65, 0xf0, 0xbe, 0x4c, 0xb9, 0x19, 0x61, 0x38, 0xdb, 0xa5, 0x6b, 0x28, 0xcb, 0x3d, 0x02,
+	0x73, 0x8f, 0x2b, 0xeb, 0x36, 0x44, 0xdd, 0x85, 0xf1, 0x2f, 0xe3, 0x86, 0xd3, 0x2c, 0x1d, 0x4f,
+	0xc4, 0x41, 0xbe, 0x00, 0xf7, 0xe6, 0x11, 0x11, 0xa1, 0x2e, 0x8a, 0xd8, 0x84, 0x72, 0x6d, 0x53,
+	0xd0, 0x3c, 0x5f, 0x6b, 0x4d, 0x3a, 0x37, 0x0a, 0x5e, 0xd3, 0xc9, 0x9d, 0xb9, 0x5b, 0x22, 0x2f,
+	0x08, 0x1d, 0x08, 0x7f, 0xff, 0xa3, 0xf5, 0xc4, 0x87, 0xd7, 0x1f, 0xaf, 0x95, 0x1a, 0x1b, 0x27,
+	0x55, 0xd0, 0x76, 0x50, 0x14, 0x0f, 0xa2, 0x38, 0x24, 0x63, 0xe2, 0x89, 0x37, 0xfa, 0xdf, 0xdd,
+	0x00, 0x07, 0xb4, 0xc6, 0x13, 0x3e, 0x49, 0xb0, 0x8b, 0x16, 0x0f, 0x21, 0x16, 0xa2, 0x66, 0xc3,
+	0x59, 0x06, 0x1f, 0xc8, 0xcc, 0x55, 0x51, 0x86, 0x73, 0x4f, 0xba, 0x97, 0x1a, 0x50, 0x3f, 0x03,
+	0xcd, 0x04, 0x45, 0xb1, 0x5b, 0x5e, 0x56, 0x5a, 0xbd, 0xf8, 0x1a, 0xc8, 0xdb, 0xcc, 0x2c, 0x6f,
+	0x33, 0xf3, 0x49, 0x11, 0x60, 0x77, 0xf3, 0x89, 0x9f, 0xfe, 0x0c, 0x95, 0x59, 0x06, 0x5b, 0xb2,
+	0xde, 0x12, 0x83, 0xe1, 0xdc, 0xce, 0xed, 0x32, 0xbe, 0x3f, 0x5c, 0x4f, 0x88, 0xd7, 0xe6, 0x42,
+	0xfc, 0xd9, 0xb0, 0xed, 0x0f, 0x3e, 0x7d, 0x6b, 0x61, 0x73, 0x8a, 0xf8, 0xdd, 0x10, 0x8d, 0xd2,
+	0xd2, 0xb0, 0xa6, 0x7b, 0xef, 0x5a, 0x47, 0xf2, 0xee, 0x17, 0x7b, 0x74, 0x7e, 0xa9, 0x2b, 0x17,
+	0x97, 0xba, 0xf2, 0xcb, 0xa5, 0xae, 0x7c, 0x73, 0xa5, 0x57, 0x2e, 0xae, 0xf4, 0xca, 0x8f, 0x57,
+	0x7a, 0x65, 0xd4, 0x10, 0x0d, 0xbe, 0xfd, 0xc7, 0x00, 0xf3, 0xc4, 0x82, 0x3e, 0x7c, 0x08, 0x00,
+	0x00,
 }
 
 func (this *ReplaceMigrationRecordsProposal) Equal(that interface{}) bool {
@@ -219,6 +398,123 @@ func (this *UpdateMigrationRecordsProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *AddPoolAssetProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddPoolAssetProposal)
+	if !ok {
+		that2, ok := that.(AddPoolAssetProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.Token.Equal(&that1.Token) {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	if this.ScalingFactor != that1.ScalingFactor {
+		return false
+	}
+	return true
+}
+func (this *RemovePoolAssetProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemovePoolAssetProposal)
+	if !ok {
+		that2, ok := that.(RemovePoolAssetProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.ExchangePoolId != that1.ExchangePoolId {
+		return false
+	}
+	if this.ExchangeDenom != that1.ExchangeDenom {
+		return false
+	}
+	if !this.ExchangeMinAmount.Equal(that1.ExchangeMinAmount) {
+		return false
+	}
+	return true
+}
+func (this *RampAmplificationProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RampAmplificationProposal)
+	if !ok {
+		that2, ok := that.(RampAmplificationProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.FutureAmplification != that1.FutureAmplification {
+		return false
+	}
+	if this.RampDuration != that1.RampDuration {
+		return false
+	}
+	return true
+}
 func (m *ReplaceMigrationRecordsProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -297,94 +593,887 @@ func (m *UpdateMigrationRecordsProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddPoolAssetProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddPoolAssetProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddPoolAssetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScalingFactor != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ScalingFactor))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemovePoolAssetProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovePoolAssetProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovePoolAssetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExchangeMinAmount.Size()
+		i -= size
+		if _, err := m.ExchangeMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.ExchangeDenom) > 0 {
+		i -= len(m.ExchangeDenom)
+		copy(dAtA[i:], m.ExchangeDenom)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExchangeDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExchangePoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ExchangePoolId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RampAmplificationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RampAmplificationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RampAmplificationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RampDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RampDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGov(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	if m.FutureAmplification != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.FutureAmplification))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReplaceMigrationRecordsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateMigrationRecordsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddPoolAssetProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.ScalingFactor != 0 {
+		n += 1 + sovGov(uint64(m.ScalingFactor))
+	}
+	return n
+}
+
+func (m *RemovePoolAssetProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ExchangePoolId != 0 {
+		n += 1 + sovGov(uint64(m.ExchangePoolId))
+	}
+	l = len(m.ExchangeDenom)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.ExchangeMinAmount.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *RampAmplificationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	if m.FutureAmplification != 0 {
+		n += 1 + sovGov(uint64(m.FutureAmplification))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RampDuration)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReplaceMigrationRecordsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplaceMigrationRecordsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplaceMigrationRecordsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, BalancerToConcentratedPoolLink{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateMigrationRecordsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateMigrationRecordsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateMigrationRecordsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, BalancerToConcentratedPoolLink{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddPoolAssetProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddPoolAssetProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddPoolAssetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactor", wireType)
+			}
+			m.ScalingFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScalingFactor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ReplaceMigrationRecordsProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *UpdateMigrationRecordsProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ReplaceMigrationRecordsProposal) Unmarshal(dAtA []byte) error {
+func (m *RemovePoolAssetProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -407,10 +1496,10 @@ func (m *ReplaceMigrationRecordsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplaceMigrationRecordsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemovePoolAssetProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplaceMigrationRecordsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemovePoolAssetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -478,10 +1567,29 @@ func (m *ReplaceMigrationRecordsProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -491,23 +1599,106 @@ func (m *ReplaceMigrationRecordsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, BalancerToConcentratedPoolLink{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangePoolId", wireType)
+			}
+			m.ExchangePoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExchangePoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -532,7 +1723,7 @@ func (m *ReplaceMigrationRecordsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateMigrationRecordsProposal) Unmarshal(dAtA []byte) error {
+func (m *RampAmplificationProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -555,10 +1746,10 @@ func (m *UpdateMigrationRecordsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateMigrationRecordsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RampAmplificationProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateMigrationRecordsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RampAmplificationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -626,8 +1817,46 @@ func (m *UpdateMigrationRecordsProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureAmplification", wireType)
+			}
+			m.FutureAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RampDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -654,8 +1883,7 @@ func (m *UpdateMigrationRecordsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, BalancerToConcentratedPoolLink{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RampDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,7 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 
@@ -73,3 +75,270 @@ func TestUpdateMigrationRecordsProposalMarshalUnmarshal(t *testing.T) {
 		require.Equal(t, *test.proposal, decoded)
 	}
 }
+
+func TestAddPoolAssetProposalMarshalUnmarshal(t *testing.T) {
+	tests := []struct {
+		proposal *types.AddPoolAssetProposal
+	}{
+		{ // balancer pool asset
+			proposal: &types.AddPoolAssetProposal{
+				Title:       "title",
+				Description: "proposal to add a pool asset",
+				PoolId:      1,
+				Token:       sdk.NewInt64Coin("uatom", 1000),
+				Weight:      sdk.NewInt(100),
+			},
+		},
+		{ // stableswap pool asset
+			proposal: &types.AddPoolAssetProposal{
+				Title:         "title",
+				Description:   "proposal to add a pool asset",
+				PoolId:        2,
+				Token:         sdk.NewInt64Coin("uatom", 1000),
+				Weight:        sdk.ZeroInt(),
+				ScalingFactor: 10,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		bz, err := proto.Marshal(test.proposal)
+		require.NoError(t, err)
+		decoded := types.AddPoolAssetProposal{}
+		err = proto.Unmarshal(bz, &decoded)
+		require.NoError(t, err)
+		require.Equal(t, *test.proposal, decoded)
+	}
+}
+
+func TestAddPoolAssetProposalValidateBasic(t *testing.T) {
+	baseProposal := types.AddPoolAssetProposal{
+		Title:       "title",
+		Description: "proposal to add a pool asset",
+		PoolId:      1,
+		Token:       sdk.NewInt64Coin("uatom", 1000),
+		Weight:      sdk.NewInt(100),
+	}
+
+	tests := map[string]struct {
+		modify    func(*types.AddPoolAssetProposal)
+		expectErr bool
+	}{
+		"valid balancer proposal": {
+			modify: func(p *types.AddPoolAssetProposal) {},
+		},
+		"valid stableswap proposal": {
+			modify: func(p *types.AddPoolAssetProposal) {
+				p.Weight = sdk.ZeroInt()
+				p.ScalingFactor = 10
+			},
+		},
+		"valid stableswap proposal with nil weight": {
+			modify: func(p *types.AddPoolAssetProposal) {
+				p.Weight = sdk.Int{}
+				p.ScalingFactor = 10
+			},
+		},
+		"error: empty title": {
+			modify:    func(p *types.AddPoolAssetProposal) { p.Title = "" },
+			expectErr: true,
+		},
+		"error: zero pool id": {
+			modify:    func(p *types.AddPoolAssetProposal) { p.PoolId = 0 },
+			expectErr: true,
+		},
+		"error: zero token": {
+			modify:    func(p *types.AddPoolAssetProposal) { p.Token = sdk.NewInt64Coin("uatom", 0) },
+			expectErr: true,
+		},
+		"error: invalid token denom": {
+			modify:    func(p *types.AddPoolAssetProposal) { p.Token = sdk.Coin{Denom: "1atom", Amount: sdk.NewInt(1000)} },
+			expectErr: true,
+		},
+		"error: negative weight": {
+			modify:    func(p *types.AddPoolAssetProposal) { p.Weight = sdk.NewInt(-1) },
+			expectErr: true,
+		},
+		"error: neither weight nor scaling factor": {
+			modify:    func(p *types.AddPoolAssetProposal) { p.Weight = sdk.ZeroInt() },
+			expectErr: true,
+		},
+		"error: both weight and scaling factor": {
+			modify:    func(p *types.AddPoolAssetProposal) { p.ScalingFactor = 10 },
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			proposal := baseProposal
+			tc.modify(&proposal)
+			err := proposal.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRemovePoolAssetProposalMarshalUnmarshal(t *testing.T) {
+	proposal := &types.RemovePoolAssetProposal{
+		Title:             "title",
+		Description:       "proposal to remove a pool asset",
+		PoolId:            1,
+		Denom:             "uatom",
+		ExchangePoolId:    2,
+		ExchangeDenom:     "uosmo",
+		ExchangeMinAmount: sdk.NewInt(1000000),
+	}
+
+	bz, err := proto.Marshal(proposal)
+	require.NoError(t, err)
+	decoded := types.RemovePoolAssetProposal{}
+	err = proto.Unmarshal(bz, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, *proposal, decoded)
+}
+
+func TestRemovePoolAssetProposalValidateBasic(t *testing.T) {
+	baseProposal := types.RemovePoolAssetProposal{
+		Title:             "title",
+		Description:       "proposal to remove a pool asset",
+		PoolId:            1,
+		Denom:             "uatom",
+		ExchangePoolId:    2,
+		ExchangeDenom:     "uosmo",
+		ExchangeMinAmount: sdk.NewInt(1000000),
+	}
+
+	tests := map[string]struct {
+		modify    func(*types.RemovePoolAssetProposal)
+		expectErr bool
+	}{
+		"valid proposal": {
+			modify: func(p *types.RemovePoolAssetProposal) {},
+		},
+		"error: empty description": {
+			modify:    func(p *types.RemovePoolAssetProposal) { p.Description = "" },
+			expectErr: true,
+		},
+		"error: zero pool id": {
+			modify:    func(p *types.RemovePoolAssetProposal) { p.PoolId = 0 },
+			expectErr: true,
+		},
+		"error: invalid denom": {
+			modify:    func(p *types.RemovePoolAssetProposal) { p.Denom = "" },
+			expectErr: true,
+		},
+		"error: zero exchange pool id": {
+			modify:    func(p *types.RemovePoolAssetProposal) { p.ExchangePoolId = 0 },
+			expectErr: true,
+		},
+		"error: exchange in the pool itself": {
+			modify:    func(p *types.RemovePoolAssetProposal) { p.ExchangePoolId = p.PoolId },
+			expectErr: true,
+		},
+		"error: invalid exchange denom": {
+			modify:    func(p *types.RemovePoolAssetProposal) { p.ExchangeDenom = "" },
+			expectErr: true,
+		},
+		"error: exchange denom is the removed denom": {
+			modify:    func(p *types.RemovePoolAssetProposal) { p.ExchangeDenom = p.Denom },
+			expectErr: true,
+		},
+		"error: nil exchange min amount": {
+			modify:    func(p *types.RemovePoolAssetProposal) { p.ExchangeMinAmount = sdk.Int{} },
+			expectErr: true,
+		},
+		"error: zero exchange min amount": {
+			modify:    func(p *types.RemovePoolAssetProposal) { p.ExchangeMinAmount = sdk.ZeroInt() },
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			proposal := baseProposal
+			tc.modify(&proposal)
+			err := proposal.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRampAmplificationProposalMarshalUnmarshal(t *testing.T) {
+	proposal := &types.RampAmplificationProposal{
+		Title:               "title",
+		Description:         "proposal to ramp the amplification",
+		PoolId:              1,
+		FutureAmplification: 200,
+		RampDuration:        72 * time.Hour,
+	}
+
+	bz, err := proto.Marshal(proposal)
+	require.NoError(t, err)
+	decoded := types.RampAmplificationProposal{}
+	err = proto.Unmarshal(bz, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, *proposal, decoded)
+}
+
+func TestRampAmplificationProposalValidateBasic(t *testing.T) {
+	baseProposal := types.RampAmplificationProposal{
+		Title:               "title",
+		Description:         "proposal to ramp the amplification",
+		PoolId:              1,
+		FutureAmplification: 200,
+		RampDuration:        types.StableswapMinAmplificationRampDuration,
+	}
+
+	tests := map[string]struct {
+		modify    func(*types.RampAmplificationProposal)
+		expectErr bool
+	}{
+		"valid proposal": {
+			modify: func(p *types.RampAmplificationProposal) {},
+		},
+		"error: empty title": {
+			modify:    func(p *types.RampAmplificationProposal) { p.Title = "" },
+			expectErr: true,
+		},
+		"error: zero pool id": {
+			modify:    func(p *types.RampAmplificationProposal) { p.PoolId = 0 },
+			expectErr: true,
+		},
+		"error: amplification below the minimum": {
+			modify:    func(p *types.RampAmplificationProposal) { p.FutureAmplification = types.StableswapMinAmplification - 1 },
+			expectErr: true,
+		},
+		"error: amplification above the maximum": {
+			modify:    func(p *types.RampAmplificationProposal) { p.FutureAmplification = types.StableswapMaxAmplification + 1 },
+			expectErr: true,
+		},
+		"error: ramp duration too short": {
+			modify: func(p *types.RampAmplificationProposal) {
+				p.RampDuration = types.StableswapMinAmplificationRampDuration - time.Second
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			proposal := baseProposal
+			tc.modify(&proposal)
+			err := proposal.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -14,6 +14,9 @@ type GammHooks interface {
 
 	// AfterSwap is called after SwapExactAmountIn and SwapExactAmountOut in x/gamm.
 	AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
+
+	// AfterCFMMPoolAssetsChanged is called after an asset is added to or removed from a CFMM pool
+	AfterCFMMPoolAssetsChanged(ctx sdk.Context, poolId uint64)
 }
 
 var _ GammHooks = MultiGammHooks{}
@@ -49,3 +52,9 @@ func (h MultiGammHooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, po
 		h[i].AfterCFMMSwap(ctx, sender, poolId, input, output)
 	}
 }
+
+func (h MultiGammHooks) AfterCFMMPoolAssetsChanged(ctx sdk.Context, poolId uint64) {
+	for i := range h {
+		h[i].AfterCFMMPoolAssetsChanged(ctx, poolId)
+	}
+}
@@ -30,6 +30,9 @@ var (
 
 	KeyPrefixMigrationInfoBalancerPool = []byte{0x04}
 	KeyPrefixMigrationInfoCLPool       = []byte{0x05}
+
+	// KeyPrefixScalingFactorRateSource defines prefix to store the scaling factor rate sources of stableswap pools.
+	KeyPrefixScalingFactorRateSource = []byte{0x06}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -68,3 +71,7 @@ func GetKeyPrefixMigrationInfoBalancerPool(balancerPoolId uint64) []byte {
 func GetKeyPrefixMigrationInfoPoolCLPool(concentratedPoolId uint64) []byte {
 	return append(KeyPrefixMigrationInfoCLPool, sdk.Uint64ToBigEndian(concentratedPoolId)...)
 }
+
+func GetKeyScalingFactorRateSource(poolId uint64) []byte {
+	return append(KeyPrefixScalingFactorRateSource, sdk.Uint64ToBigEndian(poolId)...)
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrationLeftoverCoins returns the coins exited from a pool for migration that the concentrated liquidity position
+// did not use, given the amounts of the concentrated pool's token 0 and token 1 the position was created with.
+func MigrationLeftoverCoins(exitCoins sdk.Coins, token0, token1 string, amount0, amount1 sdk.Int) sdk.Coins {
+	return exitCoins.Sub(sdk.NewCoins(sdk.NewCoin(token0, amount0), sdk.NewCoin(token1, amount1)))
+}
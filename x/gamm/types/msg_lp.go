@@ -17,12 +17,14 @@ var (
 	_ LiquidityChangeMsg = MsgExitPool{}
 	_ LiquidityChangeMsg = MsgExitSwapShareAmountIn{}
 	_ LiquidityChangeMsg = MsgExitSwapExternAmountOut{}
+	_ LiquidityChangeMsg = MsgExitPoolToSingleToken{}
 )
 
 var (
 	_ LiquidityChangeMsg = MsgJoinPool{}
 	_ LiquidityChangeMsg = MsgJoinSwapExternAmountIn{}
 	_ LiquidityChangeMsg = MsgJoinSwapShareAmountOut{}
+	_ LiquidityChangeMsg = MsgJoinPoolWithAnyToken{}
 )
 
 func (msg MsgExitPool) LiquidityChangeType() LiquidityChangeType {
@@ -48,3 +50,11 @@ func (msg MsgJoinSwapExternAmountIn) LiquidityChangeType() LiquidityChangeType {
 func (msg MsgJoinSwapShareAmountOut) LiquidityChangeType() LiquidityChangeType {
 	return AddLiquidity
 }
+
+func (msg MsgExitPoolToSingleToken) LiquidityChangeType() LiquidityChangeType {
+	return RemoveLiquidity
+}
+
+func (msg MsgJoinPoolWithAnyToken) LiquidityChangeType() LiquidityChangeType {
+	return AddLiquidity
+}
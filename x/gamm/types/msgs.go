@@ -20,6 +20,8 @@ const (
 	TypeMsgJoinSwapShareAmountOut  = "join_swap_share_amount_out"
 	TypeMsgExitSwapExternAmountOut = "exit_swap_extern_amount_out"
 	TypeMsgExitSwapShareAmountIn   = "exit_swap_share_amount_in"
+	TypeMsgJoinPoolWithAnyToken    = "join_pool_with_any_token"
+	TypeMsgExitPoolToSingleToken   = "exit_pool_to_single_token"
 )
 
 func ValidateFutureGovernor(governor string) error {
@@ -348,3 +350,90 @@ func (msg MsgExitSwapShareAmountIn) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgJoinPoolWithAnyToken{}
+
+func (msg MsgJoinPoolWithAnyToken) Route() string { return RouterKey }
+func (msg MsgJoinPoolWithAnyToken) Type() string  { return TypeMsgJoinPoolWithAnyToken }
+func (msg MsgJoinPoolWithAnyToken) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	// No routes means the token in is already a pool asset.
+	if len(msg.Routes) > 0 {
+		err = SwapAmountInRoutes(msg.Routes).Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	if !msg.ShareOutMinAmount.IsPositive() {
+		return errorsmod.Wrap(ErrNotPositiveCriteria, msg.ShareOutMinAmount.String())
+	}
+
+	return nil
+}
+
+func (msg MsgJoinPoolWithAnyToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgJoinPoolWithAnyToken) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgExitPoolToSingleToken{}
+
+func (msg MsgExitPoolToSingleToken) Route() string { return RouterKey }
+func (msg MsgExitPoolToSingleToken) Type() string  { return TypeMsgExitPoolToSingleToken }
+func (msg MsgExitPoolToSingleToken) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = sdk.ValidateDenom(msg.PoolAssetDenom)
+	if err != nil {
+		return err
+	}
+
+	// No routes means the pool asset is the token out.
+	if len(msg.Routes) > 0 {
+		err = SwapAmountInRoutes(msg.Routes).Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	if !msg.ShareInAmount.IsPositive() {
+		return errorsmod.Wrap(ErrNotPositiveRequireAmount, msg.ShareInAmount.String())
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return errorsmod.Wrap(ErrNotPositiveCriteria, msg.TokenOutMinAmount.String())
+	}
+
+	return nil
+}
+
+func (msg MsgExitPoolToSingleToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgExitPoolToSingleToken) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
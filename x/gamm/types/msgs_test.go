@@ -872,6 +872,205 @@ func TestMsgExitSwapShareAmountIn(t *testing.T) {
 	}
 }
 
+func TestMsgJoinPoolWithAnyToken(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg gammtypes.MsgJoinPoolWithAnyToken) gammtypes.MsgJoinPoolWithAnyToken) gammtypes.MsgJoinPoolWithAnyToken {
+		properMsg := gammtypes.MsgJoinPoolWithAnyToken{
+			Sender:  addr1,
+			PoolId:  1,
+			TokenIn: sdk.NewCoin("test", sdk.NewInt(100)),
+			Routes: []poolmanagertypes.SwapAmountInRoute{{
+				PoolId:        2,
+				TokenOutDenom: "test2",
+			}},
+			ShareOutMinAmount: sdk.NewInt(100),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg gammtypes.MsgJoinPoolWithAnyToken) gammtypes.MsgJoinPoolWithAnyToken {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "join_pool_with_any_token")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        gammtypes.MsgJoinPoolWithAnyToken
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithAnyToken) gammtypes.MsgJoinPoolWithAnyToken {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "no routes",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithAnyToken) gammtypes.MsgJoinPoolWithAnyToken {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithAnyToken) gammtypes.MsgJoinPoolWithAnyToken {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid route denom",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithAnyToken) gammtypes.MsgJoinPoolWithAnyToken {
+				msg.Routes[0].TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount token",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithAnyToken) gammtypes.MsgJoinPoolWithAnyToken {
+				msg.TokenIn.Amount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero criteria",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithAnyToken) gammtypes.MsgJoinPoolWithAnyToken {
+				msg.ShareOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgExitPoolToSingleToken(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg gammtypes.MsgExitPoolToSingleToken) gammtypes.MsgExitPoolToSingleToken) gammtypes.MsgExitPoolToSingleToken {
+		properMsg := gammtypes.MsgExitPoolToSingleToken{
+			Sender:         addr1,
+			PoolId:         1,
+			ShareInAmount:  sdk.NewInt(100),
+			PoolAssetDenom: "test",
+			Routes: []poolmanagertypes.SwapAmountInRoute{{
+				PoolId:        2,
+				TokenOutDenom: "test2",
+			}},
+			TokenOutMinAmount: sdk.NewInt(100),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg gammtypes.MsgExitPoolToSingleToken) gammtypes.MsgExitPoolToSingleToken {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "exit_pool_to_single_token")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        gammtypes.MsgExitPoolToSingleToken
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg gammtypes.MsgExitPoolToSingleToken) gammtypes.MsgExitPoolToSingleToken {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "no routes",
+			msg: createMsg(func(msg gammtypes.MsgExitPoolToSingleToken) gammtypes.MsgExitPoolToSingleToken {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg gammtypes.MsgExitPoolToSingleToken) gammtypes.MsgExitPoolToSingleToken {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid pool asset denom",
+			msg: createMsg(func(msg gammtypes.MsgExitPoolToSingleToken) gammtypes.MsgExitPoolToSingleToken {
+				msg.PoolAssetDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid route denom",
+			msg: createMsg(func(msg gammtypes.MsgExitPoolToSingleToken) gammtypes.MsgExitPoolToSingleToken {
+				msg.Routes[0].TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: createMsg(func(msg gammtypes.MsgExitPoolToSingleToken) gammtypes.MsgExitPoolToSingleToken {
+				msg.ShareInAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero criteria",
+			msg: createMsg(func(msg gammtypes.MsgExitPoolToSingleToken) gammtypes.MsgExitPoolToSingleToken {
+				msg.TokenOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 // Test authz serialize and de-serializes for gamm msg.
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -943,6 +1142,33 @@ func TestAuthzMsg(t *testing.T) {
 				TokenOutMinAmount: sdk.NewInt(1),
 			},
 		},
+		{
+			name: "MsgJoinPoolWithAnyToken",
+			gammMsg: &gammtypes.MsgJoinPoolWithAnyToken{
+				Sender:  addr1,
+				PoolId:  1,
+				TokenIn: coin,
+				Routes: []poolmanagertypes.SwapAmountInRoute{{
+					PoolId:        2,
+					TokenOutDenom: "test",
+				}},
+				ShareOutMinAmount: sdk.NewInt(1),
+			},
+		},
+		{
+			name: "MsgExitPoolToSingleToken",
+			gammMsg: &gammtypes.MsgExitPoolToSingleToken{
+				Sender:         addr1,
+				PoolId:         1,
+				ShareInAmount:  sdk.NewInt(100),
+				PoolAssetDenom: "test",
+				Routes: []poolmanagertypes.SwapAmountInRoute{{
+					PoolId:        2,
+					TokenOutDenom: "test2",
+				}},
+				TokenOutMinAmount: sdk.NewInt(1),
+			},
+		},
 		{
 			name: "MsgSwapExactAmountOut",
 			gammMsg: &gammtypes.MsgSwapExactAmountOut{
@@ -1588,6 +1588,95 @@ func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) GetConcentratedPoolId() ui
 	return 0
 }
 
+// =============================== QueryScalingFactorRateSource
+type QueryScalingFactorRateSourceRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryScalingFactorRateSourceRequest) Reset()         { *m = QueryScalingFactorRateSourceRequest{} }
+func (m *QueryScalingFactorRateSourceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScalingFactorRateSourceRequest) ProtoMessage()    {}
+func (*QueryScalingFactorRateSourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{32}
+}
+func (m *QueryScalingFactorRateSourceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScalingFactorRateSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScalingFactorRateSourceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScalingFactorRateSourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScalingFactorRateSourceRequest.Merge(m, src)
+}
+func (m *QueryScalingFactorRateSourceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScalingFactorRateSourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScalingFactorRateSourceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScalingFactorRateSourceRequest proto.InternalMessageInfo
+
+func (m *QueryScalingFactorRateSourceRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryScalingFactorRateSourceResponse struct {
+	RateSource ScalingFactorRateSource `protobuf:"bytes,1,opt,name=rate_source,json=rateSource,proto3" json:"rate_source"`
+}
+
+func (m *QueryScalingFactorRateSourceResponse) Reset()         { *m = QueryScalingFactorRateSourceResponse{} }
+func (m *QueryScalingFactorRateSourceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScalingFactorRateSourceResponse) ProtoMessage()    {}
+func (*QueryScalingFactorRateSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{33}
+}
+func (m *QueryScalingFactorRateSourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScalingFactorRateSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScalingFactorRateSourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScalingFactorRateSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScalingFactorRateSourceResponse.Merge(m, src)
+}
+func (m *QueryScalingFactorRateSourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScalingFactorRateSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScalingFactorRateSourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScalingFactorRateSourceResponse proto.InternalMessageInfo
+
+func (m *QueryScalingFactorRateSourceResponse) GetRateSource() ScalingFactorRateSource {
+	if m != nil {
+		return m.RateSource
+	}
+	return ScalingFactorRateSource{}
+}
+
 func init() {
 	proto.RegisterType((*QueryPoolRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolRequest")
 	proto.RegisterType((*QueryPoolResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolResponse")
@@ -1621,135 +1710,144 @@ func init() {
 	proto.RegisterType((*QueryTotalLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityResponse")
 	proto.RegisterType((*QueryConcentratedPoolIdLinkFromCFMMRequest)(nil), "osmosis.gamm.v1beta1.QueryConcentratedPoolIdLinkFromCFMMRequest")
 	proto.RegisterType((*QueryConcentratedPoolIdLinkFromCFMMResponse)(nil), "osmosis.gamm.v1beta1.QueryConcentratedPoolIdLinkFromCFMMResponse")
+	proto.RegisterType((*QueryScalingFactorRateSourceRequest)(nil), "osmosis.gamm.v1beta1.QueryScalingFactorRateSourceRequest")
+	proto.RegisterType((*QueryScalingFactorRateSourceResponse)(nil), "osmosis.gamm.v1beta1.QueryScalingFactorRateSourceResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
 
 var fileDescriptor_d9a717df9ca609ef = []byte{
-	// 1967 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x76, 0x4f, 0x1c, 0xaf, 0xfd, 0xb2, 0xb1, 0x9d, 0x5a, 0x27, 0x99, 0x8c, 0x93, 0x99, 0x50,
-	0xec, 0xc6, 0xd9, 0xc4, 0xee, 0xb1, 0x13, 0x2f, 0x3f, 0x86, 0xec, 0xc6, 0xf6, 0xda, 0xc9, 0x58,
-	0x49, 0xec, 0xed, 0xac, 0x40, 0x80, 0xa0, 0xd5, 0x1e, 0x77, 0xc6, 0xbd, 0x99, 0xee, 0x9a, 0x4c,
-	0x57, 0x6f, 0x6c, 0xad, 0xa2, 0x95, 0xf6, 0x04, 0x5c, 0x16, 0x09, 0x58, 0x04, 0x42, 0x70, 0x59,
-	0x21, 0xc4, 0x15, 0x24, 0x4e, 0x1c, 0x10, 0x97, 0x88, 0x53, 0x24, 0x38, 0x20, 0x0e, 0x03, 0x4a,
-	0xe0, 0xc6, 0xc9, 0x97, 0xbd, 0xa2, 0xaa, 0x7a, 0xfd, 0x33, 0x3f, 0xee, 0xf9, 0x59, 0x22, 0x2d,
-	0xa7, 0x99, 0xae, 0x7a, 0x3f, 0xdf, 0xfb, 0xa9, 0x57, 0xaf, 0x1e, 0x9c, 0x67, 0xbe, 0xcb, 0x7c,
-	0xc7, 0x2f, 0x56, 0x2c, 0xd7, 0x2d, 0xbe, 0xbb, 0xb0, 0x6d, 0x73, 0x6b, 0xa1, 0xf8, 0x20, 0xb0,
-	0xeb, 0xfb, 0x7a, 0xad, 0xce, 0x38, 0x23, 0x53, 0x48, 0xa1, 0x0b, 0x0a, 0x1d, 0x29, 0x72, 0x53,
-	0x15, 0x56, 0x61, 0x92, 0xa0, 0x28, 0xfe, 0x29, 0xda, 0xdc, 0xb9, 0x8e, 0xd2, 0xf8, 0x1e, 0x6e,
-	0xcf, 0x86, 0xdb, 0x35, 0xc6, 0xaa, 0xae, 0xe5, 0x59, 0x15, 0xbb, 0x1e, 0x51, 0xf9, 0x0f, 0xad,
-	0x9a, 0x59, 0x67, 0x01, 0xb7, 0x91, 0x3a, 0x5f, 0x96, 0xe4, 0xc5, 0x6d, 0xcb, 0xb7, 0x23, 0xaa,
-	0x32, 0x73, 0x3c, 0xdc, 0xbf, 0x94, 0xdc, 0x97, 0x88, 0x23, 0xaa, 0x9a, 0x55, 0x71, 0x3c, 0x8b,
-	0x3b, 0x2c, 0xa4, 0x3d, 0x5b, 0x61, 0xac, 0x52, 0xb5, 0x8b, 0x56, 0xcd, 0x29, 0x5a, 0x9e, 0xc7,
-	0xb8, 0xdc, 0xf4, 0x71, 0xf7, 0x0c, 0xee, 0xca, 0xaf, 0xed, 0xe0, 0x5e, 0xd1, 0xf2, 0xf6, 0xc3,
-	0x2d, 0xa5, 0xc4, 0x54, 0xa6, 0xaa, 0x0f, 0xb5, 0x45, 0x57, 0x61, 0xf2, 0x2d, 0xa1, 0x75, 0x8b,
-	0xb1, 0xaa, 0x61, 0x3f, 0x08, 0x6c, 0x9f, 0x93, 0xcb, 0xf0, 0x82, 0xb0, 0xcd, 0x74, 0x76, 0xb2,
-	0xda, 0x79, 0xed, 0xe2, 0xf0, 0x0a, 0x39, 0x68, 0x14, 0xc6, 0xf7, 0x2d, 0xb7, 0xba, 0x44, 0x71,
-	0x83, 0x1a, 0x23, 0xe2, 0x5f, 0x69, 0x67, 0x29, 0x93, 0xd5, 0xe8, 0x2d, 0x38, 0x91, 0x10, 0xe2,
-	0xd7, 0x98, 0xe7, 0xdb, 0xe4, 0x2a, 0x0c, 0x0b, 0x12, 0x29, 0xe2, 0xd8, 0x95, 0x29, 0x5d, 0xc1,
-	0xd3, 0x43, 0x78, 0xfa, 0xb2, 0xb7, 0xbf, 0x32, 0xf6, 0xe7, 0xdf, 0xcd, 0x1d, 0x15, 0x5c, 0x25,
-	0x43, 0x12, 0x4b, 0x69, 0xdf, 0x4a, 0x48, 0xf3, 0x43, 0x4c, 0xeb, 0x00, 0xb1, 0x3f, 0xb2, 0x19,
-	0x29, 0xf3, 0x82, 0x8e, 0xa6, 0x08, 0xe7, 0xe9, 0x2a, 0xdc, 0xe8, 0x3c, 0x7d, 0xcb, 0xaa, 0xd8,
-	0xc8, 0x6b, 0x24, 0x38, 0xe9, 0x8f, 0x34, 0x20, 0x49, 0xe9, 0x08, 0xf6, 0x35, 0x38, 0x2a, 0xf4,
-	0xfb, 0x59, 0xed, 0xfc, 0x91, 0x5e, 0xd0, 0x2a, 0x6a, 0x72, 0xa3, 0x03, 0xaa, 0x99, 0xae, 0xa8,
-	0x94, 0xce, 0x26, 0x58, 0x39, 0x98, 0x92, 0xa8, 0xee, 0x04, 0x6e, 0xd2, 0x6c, 0xe9, 0x8f, 0x3b,
-	0x70, 0xb2, 0x65, 0x0f, 0x41, 0x2f, 0xc0, 0x98, 0x17, 0xb8, 0x66, 0x08, 0x5c, 0x44, 0x6a, 0xea,
-	0xa0, 0x51, 0x98, 0x54, 0x91, 0x8a, 0xb6, 0xa8, 0x31, 0xea, 0x21, 0xab, 0x94, 0xb7, 0x8a, 0xba,
-	0xc4, 0xca, 0xdb, 0xfb, 0x35, 0x7b, 0x90, 0xb0, 0xd3, 0x0d, 0x04, 0x15, 0x0b, 0x89, 0x41, 0x49,
-	0x62, 0xbe, 0x5f, 0xb3, 0xa5, 0x9c, 0xb1, 0x24, 0xa8, 0x68, 0x8b, 0x1a, 0xa3, 0x35, 0x64, 0xa5,
-	0xbf, 0xd7, 0x20, 0x2f, 0x85, 0xad, 0x5a, 0xd5, 0xf2, 0x06, 0x73, 0x3c, 0x21, 0xf4, 0xee, 0xae,
-	0x55, 0xb7, 0xfd, 0x41, 0xb0, 0x91, 0x5d, 0x18, 0xe3, 0xec, 0xbe, 0xed, 0xf9, 0xa6, 0x23, 0x82,
-	0x22, 0x02, 0x7a, 0xa6, 0x29, 0x28, 0x61, 0x38, 0x56, 0x99, 0xe3, 0xad, 0xcc, 0x3f, 0x6e, 0x14,
-	0x86, 0x7e, 0xf3, 0x8f, 0xc2, 0xc5, 0x8a, 0xc3, 0x77, 0x83, 0x6d, 0xbd, 0xcc, 0x5c, 0x3c, 0x22,
-	0xf8, 0x33, 0xe7, 0xef, 0xdc, 0x2f, 0x0a, 0xcc, 0xbe, 0x64, 0xf0, 0x8d, 0x51, 0x25, 0xbd, 0xe4,
-	0xd1, 0x0f, 0x32, 0x50, 0x38, 0x14, 0x39, 0x3a, 0xc4, 0x87, 0x49, 0x5f, 0xac, 0x98, 0x2c, 0xe0,
-	0xa6, 0xe5, 0xb2, 0xc0, 0xe3, 0xe8, 0x97, 0x92, 0xd0, 0xfc, 0xf7, 0x46, 0xe1, 0x42, 0x0f, 0x9a,
-	0x4b, 0x1e, 0x3f, 0x68, 0x14, 0x4e, 0x2b, 0x8b, 0x5b, 0xe5, 0x51, 0x63, 0x5c, 0x2e, 0x6d, 0x06,
-	0x7c, 0x59, 0x2e, 0x90, 0x77, 0x00, 0xd0, 0x05, 0x2c, 0xe0, 0xcf, 0xc3, 0x07, 0xe8, 0xe1, 0xcd,
-	0x80, 0xd3, 0x9f, 0x69, 0x30, 0x13, 0x39, 0x61, 0x6d, 0xcf, 0xe1, 0xc2, 0x09, 0x92, 0x6a, 0xbd,
-	0xce, 0xdc, 0xe6, 0x38, 0x9e, 0x6e, 0x89, 0x63, 0x14, 0xb3, 0xaf, 0xc1, 0x84, 0xb2, 0xca, 0xf1,
-	0x42, 0x27, 0x65, 0xa4, 0x93, 0xf4, 0xfe, 0x9c, 0x64, 0x1c, 0x97, 0x62, 0x4a, 0x9e, 0x72, 0x04,
-	0xfd, 0x48, 0x83, 0x8b, 0xdd, 0xc1, 0x61, 0xa8, 0x9a, 0xbd, 0xa6, 0x3d, 0x57, 0xaf, 0xad, 0xc1,
-	0xa9, 0xe8, 0x00, 0x6d, 0x59, 0x75, 0xcb, 0x1d, 0x28, 0xd7, 0xe9, 0x0d, 0x38, 0xdd, 0x26, 0x06,
-	0xad, 0x99, 0x85, 0x91, 0x9a, 0x5c, 0x49, 0x2b, 0xc1, 0x06, 0xd2, 0xd0, 0xb7, 0xf0, 0x0c, 0xbe,
-	0xcd, 0xb8, 0x55, 0x15, 0xd2, 0x6e, 0x39, 0x0f, 0x02, 0x67, 0xc7, 0xe1, 0xfb, 0x03, 0x5f, 0x0b,
-	0x1f, 0x6b, 0x78, 0x3a, 0x3a, 0xc9, 0x44, 0x90, 0x8f, 0x60, 0xac, 0x1a, 0x2e, 0x76, 0xf7, 0xf8,
-	0x9b, 0xc2, 0xe3, 0x71, 0x35, 0x89, 0x38, 0x69, 0x7f, 0x51, 0x88, 0xf8, 0x24, 0xcc, 0x75, 0x74,
-	0xa1, 0x44, 0x39, 0x78, 0xd9, 0xa1, 0x01, 0x64, 0xdb, 0xe5, 0xa0, 0x99, 0xdf, 0x80, 0x17, 0xb9,
-	0x58, 0x36, 0x65, 0x76, 0x86, 0x11, 0x49, 0xb1, 0x74, 0x1a, 0x2d, 0x7d, 0x49, 0x29, 0x4b, 0x32,
-	0x53, 0xe3, 0x18, 0x8f, 0x55, 0xd0, 0x3f, 0x68, 0xf0, 0x72, 0x5b, 0x0d, 0xba, 0xc3, 0xee, 0x3e,
-	0xb4, 0x6a, 0xff, 0x17, 0x35, 0xf4, 0x13, 0x0d, 0x5e, 0xe9, 0x82, 0x1f, 0x9d, 0xf8, 0x7e, 0x7f,
-	0xc7, 0x73, 0x0d, 0x5d, 0x78, 0x22, 0x74, 0x61, 0xc8, 0x4a, 0x07, 0x3c, 0xb3, 0xe4, 0x36, 0x80,
-	0x0a, 0x01, 0x56, 0xd5, 0x41, 0xea, 0xd3, 0x98, 0x92, 0x20, 0x4a, 0xc0, 0x7f, 0x34, 0xbc, 0x44,
-	0xef, 0xd6, 0x18, 0xdf, 0xaa, 0x3b, 0xe5, 0x81, 0xae, 0x62, 0xb2, 0x06, 0x93, 0xc2, 0x78, 0xd3,
-	0xf2, 0x7d, 0x9b, 0x9b, 0x3b, 0xb6, 0xc7, 0x5c, 0xc4, 0x36, 0x1d, 0x5f, 0x19, 0xad, 0x14, 0xd4,
-	0x18, 0x17, 0x4b, 0xcb, 0x62, 0xe5, 0x4d, 0xb1, 0x40, 0x6e, 0xc2, 0x89, 0x07, 0x01, 0xe3, 0xcd,
-	0x72, 0x8e, 0x48, 0x39, 0x67, 0x0f, 0x1a, 0x85, 0xac, 0x92, 0xd3, 0x46, 0x42, 0x8d, 0x09, 0xb9,
-	0x16, 0x4b, 0x12, 0x87, 0x6a, 0x63, 0x78, 0x74, 0x78, 0xf2, 0xa8, 0x71, 0xec, 0xa1, 0xc3, 0x77,
-	0x45, 0x24, 0xd7, 0x6d, 0x9b, 0xfe, 0x51, 0x83, 0xe9, 0xb8, 0xf5, 0xfa, 0xba, 0xc3, 0x77, 0xd7,
-	0x9d, 0x2a, 0xb7, 0xeb, 0xa1, 0xd1, 0xd7, 0xe0, 0xb8, 0xeb, 0x78, 0x66, 0xb2, 0x1c, 0x08, 0xe5,
-	0xd9, 0x83, 0x46, 0x61, 0x4a, 0x29, 0x6f, 0xda, 0xa6, 0xc6, 0x8b, 0xae, 0xe3, 0x45, 0x15, 0x85,
-	0x4c, 0x27, 0x1b, 0x0f, 0x69, 0x7f, 0xdc, 0x62, 0xb4, 0xb4, 0x8f, 0x47, 0x06, 0x6e, 0x1f, 0x7f,
-	0xa1, 0xc1, 0xd9, 0xce, 0x36, 0x7c, 0x46, 0x1a, 0x49, 0x03, 0xaf, 0x95, 0x44, 0x4a, 0x21, 0xb2,
-	0x45, 0x00, 0xbf, 0xc6, 0xb8, 0x59, 0x13, 0xab, 0xe8, 0xdb, 0x93, 0xf1, 0xf1, 0x88, 0xf7, 0xa8,
-	0x31, 0xe6, 0x87, 0xdc, 0xb2, 0x40, 0x7e, 0x3f, 0x03, 0xe7, 0x94, 0xd0, 0x87, 0x56, 0x6d, 0x6d,
-	0xcf, 0x2a, 0x63, 0x97, 0x51, 0xf2, 0xc2, 0xd0, 0xbd, 0x0a, 0x23, 0xbe, 0xed, 0xed, 0xd8, 0x75,
-	0x94, 0x7b, 0xe2, 0xa0, 0x51, 0x38, 0x8e, 0x72, 0xe5, 0x3a, 0x35, 0x90, 0x20, 0x99, 0xda, 0x99,
-	0xae, 0xa9, 0xad, 0x83, 0xaa, 0x13, 0xa2, 0x08, 0xa9, 0x54, 0x7c, 0xe9, 0xa0, 0x51, 0x98, 0x48,
-	0x1c, 0x68, 0xd3, 0xf1, 0xa8, 0xf1, 0x82, 0xfc, 0x5b, 0xf2, 0xc8, 0xb7, 0x61, 0x44, 0x3e, 0xbe,
-	0xfc, 0xec, 0xb0, 0x74, 0xbf, 0xae, 0x87, 0xef, 0xbe, 0xc4, 0x63, 0x2d, 0x72, 0xa2, 0x30, 0x27,
-	0xb2, 0x44, 0xb0, 0xad, 0x9c, 0xc4, 0x92, 0x81, 0xd8, 0x95, 0x2c, 0x6a, 0xa0, 0x50, 0xe9, 0x8c,
-	0x9f, 0x86, 0xcd, 0x6a, 0x07, 0x67, 0xc4, 0x1d, 0x9f, 0xc2, 0xf6, 0xbf, 0xeb, 0xf8, 0x5a, 0xe5,
-	0x51, 0x63, 0x5c, 0x2e, 0x45, 0x1d, 0x9f, 0xc4, 0xf6, 0x61, 0xa6, 0x33, 0xb6, 0xcd, 0x80, 0x3f,
-	0xef, 0x48, 0x7d, 0x27, 0xf2, 0xfc, 0x11, 0xe9, 0xf9, 0x62, 0x8f, 0x9e, 0x17, 0xd0, 0x7a, 0x70,
-	0xbd, 0x78, 0x56, 0x44, 0x3e, 0xc8, 0x0e, 0xb7, 0x3e, 0x2b, 0xa2, 0x2d, 0x8a, 0x17, 0xcb, 0x66,
-	0xa0, 0x3c, 0xf2, 0x93, 0xb0, 0x05, 0xe9, 0xe4, 0x11, 0x0c, 0x57, 0x0d, 0x26, 0xc2, 0x54, 0x6a,
-	0x8e, 0xd6, 0xcd, 0xbe, 0xa3, 0x75, 0xaa, 0x39, 0x33, 0xa3, 0x60, 0x1d, 0xc7, 0x04, 0x4d, 0xc4,
-	0xea, 0x2c, 0xe4, 0xe2, 0x6e, 0xa1, 0xb5, 0xd7, 0xa2, 0x3f, 0x0f, 0x6b, 0x65, 0xeb, 0xf6, 0x67,
-	0xa2, 0x6d, 0xa2, 0x15, 0xb8, 0xa4, 0xae, 0x6c, 0xe6, 0x95, 0x6d, 0x8f, 0xd7, 0x2d, 0x6e, 0xef,
-	0xc8, 0x7a, 0xb6, 0x73, 0xcb, 0xf1, 0xee, 0x8b, 0xce, 0x7a, 0x75, 0xfd, 0xf6, 0xed, 0x30, 0xe7,
-	0xbe, 0x0c, 0x2f, 0x96, 0xef, 0xb9, 0xea, 0x35, 0x1a, 0x5f, 0x69, 0xa7, 0xe3, 0xee, 0x26, 0xb9,
-	0x4b, 0x0d, 0x10, 0x9f, 0x4a, 0x1a, 0x35, 0xe1, 0x72, 0x4f, 0x8a, 0xd0, 0x2d, 0xf3, 0x30, 0x55,
-	0x4e, 0x50, 0x36, 0x6b, 0x34, 0x48, 0xb9, 0x4d, 0xca, 0x95, 0xdf, 0x9e, 0x82, 0xa3, 0x52, 0x03,
-	0x79, 0x1f, 0x64, 0x49, 0xf6, 0xc9, 0x8c, 0xde, 0x69, 0x58, 0xa4, 0xb7, 0xcd, 0x24, 0x72, 0x17,
-	0xbb, 0x13, 0x2a, 0x5c, 0xf4, 0xf3, 0x1f, 0xfc, 0xe5, 0x5f, 0x3f, 0xcc, 0x9c, 0x23, 0xd3, 0xc5,
-	0x8e, 0xb3, 0x25, 0x75, 0x07, 0x7c, 0xa8, 0xc1, 0x68, 0xf8, 0xc6, 0x27, 0x97, 0x52, 0x64, 0xb7,
-	0x0c, 0x09, 0x72, 0x97, 0x7b, 0xa2, 0x45, 0x28, 0x97, 0x24, 0x94, 0xcf, 0x91, 0x42, 0x67, 0x28,
-	0xd1, 0xd4, 0xe0, 0xbb, 0x19, 0x8d, 0x7c, 0xac, 0xc1, 0x78, 0x73, 0x02, 0x92, 0xf9, 0x14, 0x5d,
-	0x1d, 0x53, 0x39, 0xb7, 0xd0, 0x07, 0x07, 0x62, 0x9c, 0x93, 0x18, 0x67, 0xc8, 0x2b, 0x9d, 0x31,
-	0xaa, 0x66, 0x38, 0xca, 0x46, 0xf2, 0x2b, 0x0d, 0x26, 0x5a, 0xee, 0x63, 0xb2, 0xd0, 0x2d, 0x36,
-	0x6d, 0xfd, 0x47, 0xee, 0x4a, 0x3f, 0x2c, 0x88, 0x74, 0x56, 0x22, 0xbd, 0x40, 0x5e, 0xee, 0x8c,
-	0xf4, 0x9e, 0xa4, 0xc6, 0x44, 0xf4, 0xc9, 0xf7, 0x34, 0x18, 0x16, 0x92, 0xc8, 0x85, 0x2e, 0xaa,
-	0x42, 0x48, 0x33, 0x5d, 0xe9, 0x10, 0xc7, 0x7c, 0xba, 0xc7, 0xa4, 0xfa, 0xe2, 0x7b, 0x78, 0x1c,
-	0x1e, 0x89, 0xd8, 0x7e, 0xa4, 0xc1, 0x68, 0x38, 0xbc, 0x49, 0xcd, 0xb6, 0x96, 0x31, 0x51, 0x6a,
-	0xb6, 0xb5, 0x4e, 0x83, 0xe8, 0x82, 0xc4, 0x75, 0x99, 0xbc, 0x7a, 0x38, 0x2e, 0xd9, 0xb0, 0xc5,
-	0xd8, 0xc8, 0x8f, 0x35, 0xc8, 0x1e, 0xf6, 0x14, 0x20, 0x4b, 0x29, 0xca, 0xbb, 0xbc, 0x7f, 0x72,
-	0x5f, 0x19, 0x88, 0x17, 0x0d, 0x19, 0x22, 0x7f, 0xd2, 0x80, 0xb4, 0x8f, 0x79, 0xc8, 0x62, 0x8f,
-	0x52, 0x9b, 0xb1, 0xbc, 0xd6, 0x27, 0x17, 0xa2, 0xb8, 0x2e, 0xdd, 0xb9, 0x44, 0xbe, 0xd4, 0x53,
-	0x98, 0x8b, 0xef, 0x30, 0xc7, 0x33, 0xe5, 0x48, 0xda, 0x16, 0x57, 0x9f, 0xe9, 0x78, 0xe4, 0xdf,
-	0x1a, 0x4c, 0xa7, 0x8c, 0x42, 0xc8, 0xb5, 0x2e, 0xc0, 0xd2, 0xe7, 0x3b, 0xb9, 0xd7, 0x07, 0x65,
-	0x47, 0x03, 0x6f, 0x48, 0x03, 0x97, 0xc9, 0x1b, 0xbd, 0x19, 0x68, 0xef, 0x39, 0x5c, 0x19, 0xa8,
-	0x86, 0x47, 0xea, 0xbe, 0x15, 0x76, 0xfe, 0x52, 0x03, 0x88, 0x67, 0x22, 0x64, 0xb6, 0x4b, 0xd2,
-	0x36, 0x4d, 0x60, 0x72, 0x73, 0x3d, 0x52, 0x23, 0xe8, 0x45, 0x09, 0x5a, 0x27, 0xb3, 0xbd, 0x81,
-	0x56, 0x03, 0x17, 0xf2, 0x58, 0x03, 0xd2, 0x3e, 0x18, 0x49, 0xcd, 0xa7, 0x43, 0x67, 0x33, 0xa9,
-	0xf9, 0x74, 0xf8, 0xf4, 0x85, 0xae, 0x49, 0xe4, 0x5f, 0x25, 0x4b, 0xbd, 0x21, 0x57, 0x85, 0x57,
-	0x7e, 0x46, 0xd5, 0x57, 0xd4, 0x92, 0x5f, 0x6b, 0x70, 0x2c, 0x31, 0xf5, 0x20, 0x73, 0xdd, 0xd0,
-	0x34, 0x27, 0x8d, 0xde, 0x2b, 0x39, 0xa2, 0x5e, 0x92, 0xa8, 0x17, 0xc9, 0x95, 0x7e, 0x50, 0xab,
-	0x67, 0xb7, 0xc8, 0x8b, 0xb1, 0xe8, 0x6d, 0x44, 0xd2, 0x6a, 0x59, 0xeb, 0xa3, 0x3c, 0x37, 0xdb,
-	0x1b, 0x31, 0x82, 0xfc, 0x62, 0x9f, 0x49, 0x21, 0x98, 0xe5, 0xa5, 0xfb, 0x44, 0x83, 0x33, 0x6b,
-	0x3e, 0x77, 0x5c, 0x8b, 0xdb, 0x6d, 0x6f, 0x0c, 0x72, 0x35, 0x0d, 0xc4, 0x21, 0xcf, 0xb3, 0xdc,
-	0x62, 0x7f, 0x4c, 0x68, 0xc1, 0x4d, 0x69, 0xc1, 0x1b, 0xe4, 0x5a, 0x67, 0x0b, 0x12, 0xa7, 0x10,
-	0xd1, 0x16, 0x13, 0xa5, 0x26, 0x3a, 0x89, 0xc2, 0xa4, 0xbf, 0x6a, 0x90, 0x3b, 0xc4, 0xa4, 0xcd,
-	0x80, 0x93, 0x3e, 0xe0, 0xc5, 0x2f, 0x99, 0xd4, 0x94, 0x3f, 0xbc, 0xdb, 0xa7, 0x25, 0x69, 0xd5,
-	0x75, 0xf2, 0xfa, 0xa7, 0xb0, 0x8a, 0x05, 0x5c, 0x98, 0xf5, 0x89, 0x06, 0xf9, 0xf4, 0xc6, 0x94,
-	0x5c, 0x4f, 0xab, 0x87, 0xbd, 0x34, 0xcf, 0xb9, 0xe5, 0x4f, 0x21, 0x01, 0x4d, 0xde, 0x92, 0x26,
-	0x6f, 0x90, 0x9b, 0x9d, 0x4d, 0xee, 0xd4, 0x31, 0x9b, 0x55, 0xc7, 0xbb, 0x6f, 0xde, 0xab, 0x33,
-	0xd7, 0x14, 0xdd, 0x78, 0xf1, 0xbd, 0x64, 0x8b, 0xfe, 0x68, 0x65, 0xe3, 0xf1, 0xd3, 0xbc, 0xf6,
-	0xe4, 0x69, 0x5e, 0xfb, 0xe7, 0xd3, 0xbc, 0xf6, 0x83, 0x67, 0xf9, 0xa1, 0x27, 0xcf, 0xf2, 0x43,
-	0x7f, 0x7b, 0x96, 0x1f, 0xfa, 0xe6, 0x7c, 0xe2, 0x39, 0x81, 0xda, 0xe6, 0xaa, 0xd6, 0xb6, 0x1f,
-	0xa9, 0x7e, 0x77, 0xe1, 0x0b, 0xc5, 0x3d, 0x05, 0x40, 0x3e, 0x2e, 0xb6, 0x47, 0xe4, 0x68, 0xe4,
-	0xea, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x02, 0xa0, 0xcd, 0xc5, 0x1d, 0x00, 0x00,
+	// 2066 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4d, 0x6c, 0x1c, 0x49,
+	0x15, 0x76, 0x4f, 0x1c, 0xaf, 0xe7, 0x39, 0xb1, 0x9d, 0x5a, 0x27, 0x9e, 0xb4, 0x93, 0x99, 0x50,
+	0x9b, 0x8d, 0xb3, 0xb1, 0xdd, 0x63, 0x27, 0x36, 0xb0, 0x86, 0xec, 0xc6, 0xf6, 0xda, 0xc9, 0x58,
+	0xf9, 0xf1, 0x76, 0x22, 0x10, 0x20, 0x68, 0xb5, 0xc7, 0xed, 0x71, 0x6f, 0xa6, 0xbb, 0x26, 0xd3,
+	0xd5, 0x6b, 0x5b, 0x4b, 0xb4, 0xd2, 0x9e, 0x60, 0x2f, 0x8b, 0x04, 0x2c, 0x02, 0x21, 0xb8, 0xac,
+	0x10, 0xe2, 0x8c, 0xc4, 0x89, 0x03, 0xe2, 0x12, 0x71, 0x8a, 0x04, 0x07, 0xc4, 0x61, 0x40, 0x09,
+	0xdc, 0x38, 0xf9, 0xb2, 0x57, 0x54, 0x3f, 0xfd, 0x33, 0x7f, 0x3d, 0x3f, 0x4b, 0xa4, 0xdd, 0x53,
+	0x3c, 0x55, 0xef, 0x7d, 0xef, 0x7b, 0xef, 0x55, 0xbd, 0x7e, 0xf5, 0x02, 0x17, 0x88, 0xe7, 0x10,
+	0xcf, 0xf6, 0xf2, 0x25, 0xd3, 0x71, 0xf2, 0xef, 0x2e, 0x6c, 0x5b, 0xd4, 0x5c, 0xc8, 0x3f, 0xf2,
+	0xad, 0xea, 0xa1, 0x56, 0xa9, 0x12, 0x4a, 0xd0, 0x84, 0x94, 0xd0, 0x98, 0x84, 0x26, 0x25, 0xd4,
+	0x89, 0x12, 0x29, 0x11, 0x2e, 0x90, 0x67, 0x7f, 0x09, 0x59, 0xf5, 0x7c, 0x4b, 0x34, 0x7a, 0x20,
+	0xb7, 0x97, 0x5a, 0x6e, 0x7b, 0x45, 0xb3, 0x6c, 0xbb, 0x25, 0x63, 0xd7, 0x2c, 0x52, 0x52, 0x35,
+	0xaa, 0x26, 0xb5, 0x0c, 0x8f, 0xf8, 0xd5, 0xa2, 0x25, 0xd5, 0x66, 0x03, 0xb5, 0x0a, 0x21, 0x65,
+	0xc7, 0x74, 0xcd, 0x92, 0x55, 0x8d, 0xb4, 0xf7, 0xcd, 0x8a, 0x51, 0x25, 0x3e, 0x0d, 0xa4, 0xb3,
+	0x45, 0x2e, 0x9e, 0xdf, 0x36, 0x3d, 0x2b, 0x94, 0x2a, 0x12, 0xdb, 0x95, 0xfb, 0x57, 0xe2, 0xfb,
+	0xdc, 0xd1, 0x50, 0xaa, 0x62, 0x96, 0x6c, 0xd7, 0xa4, 0x36, 0x09, 0x64, 0xcf, 0x95, 0x08, 0x29,
+	0x95, 0xad, 0xbc, 0x59, 0xb1, 0xf3, 0xa6, 0xeb, 0x12, 0xca, 0x37, 0x3d, 0xb9, 0x7b, 0x56, 0xee,
+	0xf2, 0x5f, 0xdb, 0xfe, 0x6e, 0xde, 0x74, 0x0f, 0x83, 0x2d, 0x61, 0xc4, 0x10, 0x11, 0x12, 0x3f,
+	0xc4, 0x16, 0x5e, 0x83, 0xf1, 0xb7, 0x99, 0xd5, 0x2d, 0x42, 0xca, 0xba, 0xf5, 0xc8, 0xb7, 0x3c,
+	0x8a, 0x66, 0xe0, 0x25, 0xe6, 0x9b, 0x61, 0xef, 0x64, 0x94, 0x0b, 0xca, 0xe5, 0xc1, 0x55, 0x74,
+	0x54, 0xcb, 0x8d, 0x1e, 0x9a, 0x4e, 0x79, 0x19, 0xcb, 0x0d, 0xac, 0x0f, 0xb1, 0xbf, 0x0a, 0x3b,
+	0xcb, 0xa9, 0x8c, 0x82, 0x6f, 0xc3, 0xa9, 0x18, 0x88, 0x57, 0x21, 0xae, 0x67, 0xa1, 0x6b, 0x30,
+	0xc8, 0x44, 0x38, 0xc4, 0xc8, 0xd5, 0x09, 0x4d, 0xd0, 0xd3, 0x02, 0x7a, 0xda, 0x8a, 0x7b, 0xb8,
+	0x9a, 0xfe, 0xcb, 0xef, 0xe7, 0x8e, 0x33, 0xad, 0x82, 0xce, 0x85, 0x39, 0xda, 0x77, 0x62, 0x68,
+	0x5e, 0xc0, 0x69, 0x03, 0x20, 0x8a, 0x47, 0x26, 0xc5, 0x31, 0x2f, 0x69, 0xd2, 0x15, 0x16, 0x3c,
+	0x4d, 0x9c, 0x12, 0x19, 0x3c, 0x6d, 0xcb, 0x2c, 0x59, 0x52, 0x57, 0x8f, 0x69, 0xe2, 0x9f, 0x28,
+	0x80, 0xe2, 0xe8, 0x92, 0xec, 0x12, 0x1c, 0x67, 0xf6, 0xbd, 0x8c, 0x72, 0xe1, 0x58, 0x37, 0x6c,
+	0x85, 0x34, 0xba, 0xd9, 0x82, 0xd5, 0x74, 0x47, 0x56, 0xc2, 0x66, 0x1d, 0x2d, 0x15, 0x26, 0x38,
+	0xab, 0xbb, 0xbe, 0x13, 0x77, 0x9b, 0xc7, 0xe3, 0x2e, 0x9c, 0x6e, 0xd8, 0x93, 0xa4, 0x17, 0x20,
+	0xed, 0xfa, 0x8e, 0x11, 0x10, 0x67, 0x99, 0x9a, 0x38, 0xaa, 0xe5, 0xc6, 0x45, 0xa6, 0xc2, 0x2d,
+	0xac, 0x0f, 0xbb, 0x52, 0x95, 0xe3, 0xad, 0x49, 0x5b, 0x6c, 0xe5, 0xc1, 0x61, 0xc5, 0xea, 0x27,
+	0xed, 0x78, 0x13, 0x4e, 0x37, 0x80, 0x44, 0xa4, 0xb8, 0x30, 0x3d, 0xac, 0x58, 0x1c, 0x27, 0x1d,
+	0x27, 0x15, 0x6e, 0x61, 0x7d, 0xb8, 0x22, 0x55, 0xf1, 0x1f, 0x14, 0xc8, 0x72, 0xb0, 0x35, 0xb3,
+	0x5c, 0xdc, 0x24, 0xb6, 0xcb, 0x40, 0xef, 0xef, 0x99, 0x55, 0xcb, 0xeb, 0x87, 0x1b, 0xda, 0x83,
+	0x34, 0x25, 0x0f, 0x2d, 0xd7, 0x33, 0x6c, 0x96, 0x14, 0x96, 0xd0, 0xb3, 0x75, 0x49, 0x09, 0xd2,
+	0xb1, 0x46, 0x6c, 0x77, 0x75, 0xfe, 0x49, 0x2d, 0x37, 0xf0, 0xbb, 0x7f, 0xe6, 0x2e, 0x97, 0x6c,
+	0xba, 0xe7, 0x6f, 0x6b, 0x45, 0xe2, 0xc8, 0x2b, 0x22, 0xff, 0x99, 0xf3, 0x76, 0x1e, 0xe6, 0x19,
+	0x67, 0x8f, 0x2b, 0x78, 0xfa, 0xb0, 0x40, 0x2f, 0xb8, 0xf8, 0x83, 0x14, 0xe4, 0xda, 0x32, 0x97,
+	0x01, 0xf1, 0x60, 0xdc, 0x63, 0x2b, 0x06, 0xf1, 0xa9, 0x61, 0x3a, 0xc4, 0x77, 0xa9, 0x8c, 0x4b,
+	0x81, 0x59, 0xfe, 0x47, 0x2d, 0x77, 0xa9, 0x0b, 0xcb, 0x05, 0x97, 0x1e, 0xd5, 0x72, 0x93, 0xc2,
+	0xe3, 0x46, 0x3c, 0xac, 0x8f, 0xf2, 0xa5, 0x7b, 0x3e, 0x5d, 0xe1, 0x0b, 0xe8, 0x1d, 0x00, 0x19,
+	0x02, 0xe2, 0xd3, 0x17, 0x11, 0x03, 0x19, 0xe1, 0x7b, 0x3e, 0xc5, 0xbf, 0x50, 0x60, 0x3a, 0x0c,
+	0xc2, 0xfa, 0x81, 0x4d, 0x59, 0x10, 0xb8, 0xd4, 0x46, 0x95, 0x38, 0xf5, 0x79, 0x9c, 0x6c, 0xc8,
+	0x63, 0x98, 0xb3, 0x6f, 0xc0, 0x98, 0xf0, 0xca, 0x76, 0x83, 0x20, 0xa5, 0x78, 0x90, 0xb4, 0xde,
+	0x82, 0xa4, 0x9f, 0xe4, 0x30, 0x05, 0x57, 0x04, 0x02, 0x7f, 0xac, 0xc0, 0xe5, 0xce, 0xe4, 0x64,
+	0xaa, 0xea, 0xa3, 0xa6, 0xbc, 0xd0, 0xa8, 0xad, 0xc3, 0x99, 0xf0, 0x02, 0x6d, 0x99, 0x55, 0xd3,
+	0xe9, 0xeb, 0xac, 0xe3, 0x9b, 0x30, 0xd9, 0x04, 0x23, 0xbd, 0x99, 0x85, 0xa1, 0x0a, 0x5f, 0x49,
+	0x2a, 0xc1, 0xba, 0x94, 0xc1, 0x6f, 0xcb, 0x3b, 0xf8, 0x80, 0x50, 0xb3, 0xcc, 0xd0, 0x6e, 0xdb,
+	0x8f, 0x7c, 0x7b, 0xc7, 0xa6, 0x87, 0x7d, 0x7f, 0x16, 0x3e, 0x51, 0x20, 0xd7, 0x16, 0x53, 0x92,
+	0x7c, 0x0c, 0xe9, 0x72, 0xb0, 0xd8, 0x39, 0xe2, 0x6f, 0xb1, 0x88, 0x47, 0xd5, 0x24, 0xd4, 0xc4,
+	0xbd, 0x65, 0x21, 0xd4, 0xe3, 0x34, 0x37, 0x60, 0x32, 0x62, 0xd9, 0x7f, 0xd9, 0xc1, 0x3e, 0x64,
+	0x9a, 0x71, 0xa4, 0x9b, 0xdf, 0x82, 0x13, 0x94, 0x2d, 0x1b, 0xfc, 0x74, 0x06, 0x19, 0x49, 0xf0,
+	0x74, 0x4a, 0x7a, 0xfa, 0xb2, 0x30, 0x16, 0x57, 0xc6, 0xfa, 0x08, 0x8d, 0x4c, 0xe0, 0x3f, 0x2a,
+	0x70, 0xb1, 0xa9, 0x06, 0xdd, 0x25, 0xf7, 0xf7, 0xcd, 0xca, 0x17, 0xa2, 0x86, 0x7e, 0xaa, 0xc0,
+	0xab, 0x1d, 0xf8, 0xcb, 0x20, 0xbe, 0xdf, 0xdb, 0xf5, 0x5c, 0x97, 0x21, 0x3c, 0x15, 0x84, 0x30,
+	0x50, 0xc5, 0x7d, 0xde, 0x59, 0x74, 0x07, 0x40, 0xa4, 0x40, 0x56, 0xd5, 0x7e, 0xea, 0x53, 0x5a,
+	0x20, 0xb0, 0x12, 0xf0, 0x5f, 0x45, 0x7e, 0x44, 0xef, 0x57, 0x08, 0xdd, 0xaa, 0xda, 0xc5, 0xbe,
+	0x3e, 0xc5, 0x68, 0x1d, 0xc6, 0x99, 0xf3, 0x86, 0xe9, 0x79, 0x16, 0x35, 0x76, 0x2c, 0x97, 0x38,
+	0x92, 0xdb, 0x54, 0xf4, 0xc9, 0x68, 0x94, 0xc0, 0xfa, 0x28, 0x5b, 0x5a, 0x61, 0x2b, 0x6f, 0xb1,
+	0x05, 0x74, 0x0b, 0x4e, 0x3d, 0xf2, 0x09, 0xad, 0xc7, 0x39, 0xc6, 0x71, 0xce, 0x1d, 0xd5, 0x72,
+	0x19, 0x81, 0xd3, 0x24, 0x82, 0xf5, 0x31, 0xbe, 0x16, 0x21, 0xb1, 0x4b, 0xb5, 0x39, 0x38, 0x3c,
+	0x38, 0x7e, 0x5c, 0x1f, 0xd9, 0xb7, 0xe9, 0x1e, 0xcb, 0xe4, 0x86, 0x65, 0xe1, 0x3f, 0x29, 0x30,
+	0x15, 0xb5, 0x5e, 0xdf, 0xb4, 0xe9, 0xde, 0x86, 0x5d, 0xa6, 0x56, 0x35, 0x70, 0xfa, 0x3a, 0x9c,
+	0x74, 0x6c, 0xd7, 0x88, 0x97, 0x03, 0x66, 0x3c, 0x73, 0x54, 0xcb, 0x4d, 0x08, 0xe3, 0x75, 0xdb,
+	0x58, 0x3f, 0xe1, 0xd8, 0x6e, 0x58, 0x51, 0xd0, 0x54, 0xbc, 0xf1, 0xe0, 0xfe, 0x47, 0x2d, 0x46,
+	0x43, 0xfb, 0x78, 0xac, 0xef, 0xf6, 0xf1, 0x57, 0x0a, 0x9c, 0x6b, 0xed, 0xc3, 0xe7, 0xa4, 0x91,
+	0xd4, 0xe1, 0x4c, 0xe3, 0x91, 0x92, 0xcc, 0x16, 0x01, 0xbc, 0x0a, 0xa1, 0x46, 0x85, 0xad, 0xca,
+	0xd8, 0x9e, 0x8e, 0xae, 0x47, 0xb4, 0x87, 0xf5, 0xb4, 0x17, 0x68, 0xf3, 0x02, 0xf9, 0x61, 0x0a,
+	0xce, 0x0b, 0xd0, 0x7d, 0xb3, 0xb2, 0x7e, 0x60, 0x16, 0x65, 0x97, 0x51, 0x70, 0x83, 0xd4, 0xbd,
+	0x06, 0x43, 0x9e, 0xe5, 0xee, 0x58, 0x55, 0x89, 0x7b, 0xea, 0xa8, 0x96, 0x3b, 0x29, 0x71, 0xf9,
+	0x3a, 0xd6, 0xa5, 0x40, 0xfc, 0x68, 0xa7, 0x3a, 0x1e, 0x6d, 0x0d, 0x44, 0x9d, 0x30, 0x6c, 0x91,
+	0xb4, 0xf4, 0xea, 0xcb, 0x47, 0xb5, 0xdc, 0x58, 0xec, 0x42, 0x1b, 0xb6, 0x8b, 0xf5, 0x97, 0xf8,
+	0x9f, 0x05, 0x17, 0x7d, 0x17, 0x86, 0xf8, 0xe3, 0xcb, 0xcb, 0x0c, 0xf2, 0xf0, 0x6b, 0x5a, 0xf0,
+	0x5c, 0x8c, 0x3d, 0xd6, 0xc2, 0x20, 0x32, 0x77, 0x42, 0x4f, 0x98, 0xda, 0xea, 0x69, 0x59, 0x32,
+	0x24, 0x77, 0x81, 0x85, 0x75, 0x09, 0xca, 0x83, 0xf1, 0xf3, 0xa0, 0x59, 0x6d, 0x11, 0x8c, 0xa8,
+	0xe3, 0x13, 0xdc, 0xfe, 0x7f, 0x1d, 0x5f, 0x23, 0x1e, 0xd6, 0x47, 0xf9, 0x52, 0xd8, 0xf1, 0x71,
+	0x6e, 0x1f, 0xa5, 0x5a, 0x73, 0xbb, 0xe7, 0xd3, 0x17, 0x9d, 0xa9, 0xef, 0x85, 0x91, 0x3f, 0xc6,
+	0x23, 0x9f, 0xef, 0x32, 0xf2, 0x8c, 0x5a, 0x17, 0xa1, 0x67, 0xcf, 0x8a, 0x30, 0x06, 0x99, 0xc1,
+	0xc6, 0x67, 0x45, 0xb8, 0x85, 0xe5, 0x87, 0xe5, 0x9e, 0x2f, 0x22, 0xf2, 0xb3, 0xa0, 0x05, 0x69,
+	0x15, 0x11, 0x99, 0xae, 0x0a, 0x8c, 0x05, 0x47, 0xa9, 0x3e, 0x5b, 0xb7, 0x7a, 0xce, 0xd6, 0x99,
+	0xfa, 0x93, 0x19, 0x26, 0xeb, 0xa4, 0x3c, 0xa0, 0xb1, 0x5c, 0x9d, 0x03, 0x35, 0xea, 0x16, 0x1a,
+	0x7b, 0x2d, 0xfc, 0xcb, 0xa0, 0x56, 0x36, 0x6e, 0x7f, 0x2e, 0xda, 0x26, 0x5c, 0x82, 0x2b, 0xe2,
+	0x93, 0x4d, 0xdc, 0xa2, 0xe5, 0xd2, 0xaa, 0x49, 0xad, 0x1d, 0x5e, 0xcf, 0x76, 0x6e, 0xdb, 0xee,
+	0x43, 0xd6, 0x59, 0xaf, 0x6d, 0xdc, 0xb9, 0x13, 0x9c, 0xb9, 0xd7, 0xe1, 0x44, 0x71, 0xd7, 0x71,
+	0x8c, 0xe0, 0x34, 0x89, 0x4f, 0xda, 0x64, 0xd4, 0xdd, 0xc4, 0x77, 0xb1, 0x0e, 0xec, 0xa7, 0x40,
+	0xc3, 0x06, 0xcc, 0x74, 0x65, 0x48, 0x86, 0x65, 0x1e, 0x26, 0x8a, 0x31, 0xc9, 0x7a, 0x8b, 0x3a,
+	0x2a, 0x36, 0xa1, 0x60, 0x1d, 0x5e, 0x11, 0xe7, 0x43, 0x8c, 0x7d, 0x36, 0xf8, 0xd4, 0x47, 0x37,
+	0xa9, 0x75, 0x9f, 0xcf, 0x7c, 0xfa, 0x6a, 0x04, 0xbf, 0x0f, 0x17, 0x93, 0x31, 0x25, 0xdb, 0x07,
+	0x30, 0x12, 0x1b, 0x2f, 0xc9, 0x9e, 0x70, 0x4e, 0x6b, 0x35, 0xe1, 0xd2, 0xda, 0x60, 0xad, 0x0e,
+	0xb2, 0xd4, 0xea, 0x50, 0x0d, 0x57, 0xae, 0x7e, 0x98, 0x81, 0xe3, 0xdc, 0x3c, 0x7a, 0x1f, 0xf8,
+	0x47, 0xc6, 0x43, 0xd3, 0xad, 0x31, 0x9b, 0xa6, 0x2c, 0xea, 0xe5, 0xce, 0x82, 0x82, 0x3b, 0x7e,
+	0xe5, 0x83, 0xbf, 0xfe, 0xfb, 0xc7, 0xa9, 0xf3, 0x68, 0x2a, 0xdf, 0x72, 0x8a, 0x26, 0xbe, 0x6a,
+	0x1f, 0x29, 0x30, 0x1c, 0x4c, 0x2d, 0xd0, 0x95, 0x04, 0xec, 0x86, 0xb1, 0x87, 0x3a, 0xd3, 0x95,
+	0xac, 0xa4, 0x72, 0x85, 0x53, 0xf9, 0x12, 0xca, 0xb5, 0xa6, 0x12, 0xce, 0x41, 0x7e, 0x90, 0x52,
+	0xd0, 0x27, 0x0a, 0x8c, 0xd6, 0x5f, 0x29, 0x34, 0x9f, 0x60, 0xab, 0xe5, 0xe5, 0x54, 0x17, 0x7a,
+	0xd0, 0x90, 0x1c, 0xe7, 0x38, 0xc7, 0x69, 0xf4, 0x6a, 0x6b, 0x8e, 0xa2, 0xbd, 0x0f, 0xef, 0x17,
+	0xfa, 0x8d, 0x02, 0x63, 0x0d, 0x1d, 0x06, 0x5a, 0xe8, 0x94, 0x9b, 0xa6, 0x8e, 0x4a, 0xbd, 0xda,
+	0x8b, 0x8a, 0x64, 0x3a, 0xcb, 0x99, 0x5e, 0x42, 0x17, 0x5b, 0x33, 0xdd, 0xe5, 0xd2, 0xf2, 0x6a,
+	0x79, 0xe8, 0x87, 0x0a, 0x0c, 0x32, 0x24, 0x74, 0xa9, 0x83, 0xa9, 0x80, 0xd2, 0x74, 0x47, 0x39,
+	0xc9, 0x63, 0x3e, 0x39, 0x62, 0xdc, 0x7c, 0xfe, 0x3d, 0x79, 0xf7, 0x1e, 0xb3, 0xdc, 0x7e, 0xac,
+	0xc0, 0x70, 0x30, 0x8e, 0x4a, 0x3c, 0x6d, 0x0d, 0x83, 0x2f, 0x75, 0xa6, 0x2b, 0x59, 0xc9, 0x6b,
+	0x81, 0xf3, 0x9a, 0x41, 0xaf, 0xb5, 0xe7, 0xc5, 0x5b, 0xd0, 0x88, 0x1b, 0xfa, 0xa9, 0x02, 0x99,
+	0x76, 0x8f, 0x1b, 0xb4, 0x9c, 0x60, 0xbc, 0xc3, 0x8b, 0x4e, 0xfd, 0x5a, 0x5f, 0xba, 0xd2, 0x91,
+	0x01, 0xf4, 0x67, 0x05, 0x50, 0xf3, 0xe0, 0x0a, 0x2d, 0x76, 0x89, 0x5a, 0xcf, 0x65, 0xa9, 0x47,
+	0x2d, 0xc9, 0xe2, 0x06, 0x0f, 0xe7, 0x32, 0xfa, 0x6a, 0x57, 0x69, 0xce, 0xbf, 0x43, 0x6c, 0xd7,
+	0xe0, 0x43, 0x76, 0x8b, 0x7d, 0xcc, 0x0d, 0xdb, 0x45, 0xff, 0x51, 0x60, 0x2a, 0x61, 0xb8, 0x83,
+	0xae, 0x77, 0x20, 0x96, 0x3c, 0xb1, 0x52, 0xdf, 0xe8, 0x57, 0x5d, 0x3a, 0x78, 0x93, 0x3b, 0xb8,
+	0x82, 0xde, 0xec, 0xce, 0x41, 0xeb, 0xc0, 0xa6, 0xc2, 0x41, 0x31, 0x0e, 0x13, 0x1d, 0x04, 0xf3,
+	0xf3, 0xd7, 0x0a, 0x40, 0x34, 0xe5, 0x41, 0xb3, 0x1d, 0x0e, 0x6d, 0xdd, 0x4c, 0x49, 0x9d, 0xeb,
+	0x52, 0x5a, 0x92, 0x5e, 0xe4, 0xa4, 0x35, 0x34, 0xdb, 0x1d, 0x69, 0x31, 0x42, 0x42, 0x4f, 0x14,
+	0x40, 0xcd, 0xa3, 0x9e, 0xc4, 0xf3, 0xd4, 0x76, 0xda, 0xa4, 0x2e, 0xf5, 0xa8, 0x25, 0x99, 0xaf,
+	0x73, 0xe6, 0x5f, 0x47, 0xcb, 0xdd, 0x31, 0x17, 0x85, 0x97, 0xff, 0x0c, 0xab, 0x2f, 0xab, 0x25,
+	0xbf, 0x55, 0x60, 0x24, 0x36, 0xc7, 0x41, 0x73, 0x9d, 0xd8, 0xd4, 0x1f, 0x1a, 0xad, 0x5b, 0x71,
+	0xc9, 0x7a, 0x99, 0xb3, 0x5e, 0x44, 0x57, 0x7b, 0x61, 0x2d, 0x06, 0x09, 0xec, 0x5c, 0xa4, 0xc3,
+	0xd7, 0x1e, 0x4a, 0xaa, 0x65, 0x8d, 0x63, 0x06, 0x75, 0xb6, 0x3b, 0x61, 0x49, 0xf2, 0x2b, 0x3d,
+	0x1e, 0x0a, 0xa6, 0xcc, 0x3f, 0xba, 0x4f, 0x15, 0x38, 0xbb, 0xee, 0x51, 0xdb, 0x61, 0x4d, 0x4a,
+	0xe3, 0xab, 0x09, 0x5d, 0x4b, 0x22, 0xd1, 0xe6, 0xc1, 0xa9, 0x2e, 0xf6, 0xa6, 0x24, 0x3d, 0xb8,
+	0xc5, 0x3d, 0x78, 0x13, 0x5d, 0x6f, 0xed, 0x41, 0xec, 0x16, 0x4a, 0xb6, 0xf9, 0x58, 0xa9, 0x09,
+	0x6f, 0x22, 0x73, 0xe9, 0x6f, 0x0a, 0xa8, 0x6d, 0x5c, 0x62, 0x83, 0xa2, 0x1e, 0xe8, 0x45, 0x6f,
+	0x33, 0x75, 0xa9, 0x47, 0x2d, 0xe9, 0x55, 0x81, 0x7b, 0x75, 0x03, 0xbd, 0xf1, 0x19, 0xbc, 0x22,
+	0x3e, 0x65, 0x6e, 0x7d, 0xaa, 0x40, 0x36, 0xb9, 0xd5, 0x46, 0x37, 0x92, 0xea, 0x61, 0x37, 0xcf,
+	0x01, 0x75, 0xe5, 0x33, 0x20, 0x48, 0x97, 0xb7, 0xb8, 0xcb, 0x9b, 0xe8, 0x56, 0x6b, 0x97, 0x5b,
+	0xbd, 0x01, 0x8c, 0xb2, 0xed, 0x3e, 0x34, 0x76, 0xab, 0xc4, 0x31, 0xd8, 0xfb, 0x22, 0xff, 0x5e,
+	0xfc, 0xd1, 0xf1, 0x98, 0x25, 0x74, 0xb2, 0x4d, 0x8f, 0x8d, 0x5e, 0x4f, 0xca, 0x4b, 0xe2, 0xbb,
+	0x41, 0x5d, 0xee, 0x47, 0x55, 0x3a, 0xb9, 0x9a, 0x5c, 0xca, 0xa2, 0xbc, 0xb6, 0xff, 0x2f, 0xeb,
+	0xd5, 0xcd, 0x27, 0xcf, 0xb2, 0xca, 0xd3, 0x67, 0x59, 0xe5, 0x5f, 0xcf, 0xb2, 0xca, 0x8f, 0x9e,
+	0x67, 0x07, 0x9e, 0x3e, 0xcf, 0x0e, 0xfc, 0xfd, 0x79, 0x76, 0xe0, 0xdb, 0xf3, 0xb1, 0x77, 0x9f,
+	0xc4, 0x9f, 0x2b, 0x9b, 0xdb, 0x5e, 0x68, 0xec, 0xdd, 0x85, 0x2f, 0xe7, 0x0f, 0x84, 0x49, 0xfe,
+	0x0a, 0xdc, 0x1e, 0xe2, 0x33, 0xac, 0x6b, 0xff, 0x1b, 0x00, 0x4f, 0x4a, 0xf5, 0xee, 0xa5, 0x1f,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1796,6 +1894,9 @@ type QueryClient interface {
 	// ConcentratedPoolIdLinkFromBalancer returns the pool id of the concentrated
 	// pool that is linked with the given CFMM pool.
 	ConcentratedPoolIdLinkFromCFMM(ctx context.Context, in *QueryConcentratedPoolIdLinkFromCFMMRequest, opts ...grpc.CallOption) (*QueryConcentratedPoolIdLinkFromCFMMResponse, error)
+	// ScalingFactorRateSource returns the rate source the scaling factors of the
+	// given stableswap pool are delegated to.
+	ScalingFactorRateSource(ctx context.Context, in *QueryScalingFactorRateSourceRequest, opts ...grpc.CallOption) (*QueryScalingFactorRateSourceResponse, error)
 }
 
 type queryClient struct {
@@ -1956,6 +2057,15 @@ func (c *queryClient) ConcentratedPoolIdLinkFromCFMM(ctx context.Context, in *Qu
 	return out, nil
 }
 
+func (c *queryClient) ScalingFactorRateSource(ctx context.Context, in *QueryScalingFactorRateSourceRequest, opts ...grpc.CallOption) (*QueryScalingFactorRateSourceResponse, error) {
+	out := new(QueryScalingFactorRateSourceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/ScalingFactorRateSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
@@ -1990,6 +2100,9 @@ type QueryServer interface {
 	// ConcentratedPoolIdLinkFromBalancer returns the pool id of the concentrated
 	// pool that is linked with the given CFMM pool.
 	ConcentratedPoolIdLinkFromCFMM(context.Context, *QueryConcentratedPoolIdLinkFromCFMMRequest) (*QueryConcentratedPoolIdLinkFromCFMMResponse, error)
+	// ScalingFactorRateSource returns the rate source the scaling factors of the
+	// given stableswap pool are delegated to.
+	ScalingFactorRateSource(context.Context, *QueryScalingFactorRateSourceRequest) (*QueryScalingFactorRateSourceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2044,6 +2157,9 @@ func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context,
 func (*UnimplementedQueryServer) ConcentratedPoolIdLinkFromCFMM(ctx context.Context, req *QueryConcentratedPoolIdLinkFromCFMMRequest) (*QueryConcentratedPoolIdLinkFromCFMMResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConcentratedPoolIdLinkFromCFMM not implemented")
 }
+func (*UnimplementedQueryServer) ScalingFactorRateSource(ctx context.Context, req *QueryScalingFactorRateSourceRequest) (*QueryScalingFactorRateSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScalingFactorRateSource not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2337,6 +2453,24 @@ func _Query_ConcentratedPoolIdLinkFromCFMM_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScalingFactorRateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScalingFactorRateSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScalingFactorRateSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/ScalingFactorRateSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScalingFactorRateSource(ctx, req.(*QueryScalingFactorRateSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2405,6 +2539,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConcentratedPoolIdLinkFromCFMM",
 			Handler:    _Query_ConcentratedPoolIdLinkFromCFMM_Handler,
 		},
+		{
+			MethodName: "ScalingFactorRateSource",
+			Handler:    _Query_ScalingFactorRateSource_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/query.proto",
@@ -3572,6 +3710,67 @@ func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryScalingFactorRateSourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScalingFactorRateSourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScalingFactorRateSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScalingFactorRateSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScalingFactorRateSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScalingFactorRateSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateSource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -4055,6 +4254,29 @@ func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryScalingFactorRateSourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryScalingFactorRateSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateSource.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7040,6 +7262,158 @@ func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
+func (m *QueryScalingFactorRateSourceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScalingFactorRateSourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScalingFactorRateSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScalingFactorRateSourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScalingFactorRateSourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScalingFactorRateSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs the stateless validation of the scaling factor rate source.
+// The maximum change per update must be positive and less than 1, and every denom must have exactly one valid
+// rate source. Whether the denoms match the pool assets is checked against the pool when the rate source is set.
+func (s ScalingFactorRateSource) Validate() error {
+	if s.PoolId == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+
+	if err := sdk.ValidateDenom(s.ReferenceDenom); err != nil {
+		return err
+	}
+
+	if s.MaxChangePerUpdate.IsNil() || !s.MaxChangePerUpdate.IsPositive() || s.MaxChangePerUpdate.GTE(sdk.OneDec()) {
+		return InvalidMaxScalingFactorChangeError{MaxChangePerUpdate: s.MaxChangePerUpdate}
+	}
+
+	if len(s.DenomRateSources) == 0 {
+		return RateSourceDenomsMismatchError{PoolId: s.PoolId, ReferenceDenom: s.ReferenceDenom}
+	}
+
+	seenDenoms := map[string]bool{s.ReferenceDenom: true}
+	for _, denomRateSource := range s.DenomRateSources {
+		if err := denomRateSource.validate(s.PoolId); err != nil {
+			return err
+		}
+		// Contracts are queried at the end of epochs only, so that they cannot be queried every block.
+		if denomRateSource.ContractAddress != "" && s.EpochIdentifier == "" {
+			return ContractRateSourceEveryBlockError{PoolId: s.PoolId, Denom: denomRateSource.Denom}
+		}
+		if seenDenoms[denomRateSource.Denom] {
+			return DuplicateDenomRateSourceError{Denom: denomRateSource.Denom}
+		}
+		seenDenoms[denomRateSource.Denom] = true
+	}
+
+	return nil
+}
+
+func (s DenomRateSource) validate(poolId uint64) error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+
+	if (s.Twap == nil) == (s.ContractAddress == "") {
+		return InvalidDenomRateSourceError{Denom: s.Denom}
+	}
+
+	if s.Twap != nil {
+		if s.Twap.PoolId == poolId {
+			return TwapRateSourceSamePoolError{PoolId: poolId}
+		}
+		if s.Twap.Duration <= 0 {
+			return fmt.Errorf("twap duration of denom %s must be positive", s.Denom)
+		}
+		return nil
+	}
+
+	_, err := sdk.AccAddressFromBech32(s.ContractAddress)
+	return err
+}
@@ -0,0 +1,1051 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/scaling_factor_rate_source.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScalingFactorRateSource delegates the scaling factors of a stableswap pool to
+// rate sources, instead of the pool's scaling factor controller adjusting them
+// manually. The scaling factor of the reference asset is kept fixed, and the
+// scaling factor of every other asset is set to the reference scaling factor
+// divided by the asset's rate, which is the price of one unit of the asset in
+// units of the reference asset.
+type ScalingFactorRateSource struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// reference_denom is the pool asset whose scaling factor is kept fixed.
+	ReferenceDenom string `protobuf:"bytes,2,opt,name=reference_denom,json=referenceDenom,proto3" json:"reference_denom,omitempty" yaml:"reference_denom"`
+	// denom_rate_sources contains the rate source of every other pool asset.
+	DenomRateSources []DenomRateSource `protobuf:"bytes,3,rep,name=denom_rate_sources,json=denomRateSources,proto3" json:"denom_rate_sources" yaml:"denom_rate_sources"`
+	// max_change_per_update is the maximum relative change of each scaling
+	// factor in a single update, e.g. 0.001 for 0.1%.
+	MaxChangePerUpdate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_change_per_update,json=maxChangePerUpdate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_per_update" yaml:"max_change_per_update"`
+	// epoch_identifier, if set, makes the scaling factors update at the end of
+	// every epoch with this identifier. Otherwise, they update every block,
+	// which is only allowed if none of the denom rate sources is a contract.
+	EpochIdentifier string `protobuf:"bytes,5,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
+}
+
+func (m *ScalingFactorRateSource) Reset()         { *m = ScalingFactorRateSource{} }
+func (m *ScalingFactorRateSource) String() string { return proto.CompactTextString(m) }
+func (*ScalingFactorRateSource) ProtoMessage()    {}
+func (*ScalingFactorRateSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_713607bd1614d737, []int{0}
+}
+func (m *ScalingFactorRateSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingFactorRateSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingFactorRateSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScalingFactorRateSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingFactorRateSource.Merge(m, src)
+}
+func (m *ScalingFactorRateSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingFactorRateSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingFactorRateSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingFactorRateSource proto.InternalMessageInfo
+
+func (m *ScalingFactorRateSource) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *ScalingFactorRateSource) GetReferenceDenom() string {
+	if m != nil {
+		return m.ReferenceDenom
+	}
+	return ""
+}
+
+func (m *ScalingFactorRateSource) GetDenomRateSources() []DenomRateSource {
+	if m != nil {
+		return m.DenomRateSources
+	}
+	return nil
+}
+
+func (m *ScalingFactorRateSource) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+// DenomRateSource is the rate source of a single pool asset. Exactly one of
+// twap and contract_address must be set.
+type DenomRateSource struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// twap makes the rate the arithmetic TWAP of the asset in units of the
+	// reference asset in another pool.
+	Twap *TwapRateSource `protobuf:"bytes,2,opt,name=twap,proto3" json:"twap,omitempty" yaml:"twap"`
+	// contract_address makes the rate the response of the contract to the
+	// query {"scaling_factor_rate":{"denom":..., "reference_denom":...}},
+	// which must be of the form {"rate":"<decimal>"}.
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+}
+
+func (m *DenomRateSource) Reset()         { *m = DenomRateSource{} }
+func (m *DenomRateSource) String() string { return proto.CompactTextString(m) }
+func (*DenomRateSource) ProtoMessage()    {}
+func (*DenomRateSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_713607bd1614d737, []int{1}
+}
+func (m *DenomRateSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRateSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRateSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRateSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRateSource.Merge(m, src)
+}
+func (m *DenomRateSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRateSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRateSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRateSource proto.InternalMessageInfo
+
+func (m *DenomRateSource) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomRateSource) GetTwap() *TwapRateSource {
+	if m != nil {
+		return m.Twap
+	}
+	return nil
+}
+
+func (m *DenomRateSource) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// TwapRateSource is a rate source reading the arithmetic TWAP of a pool over
+// the given duration up to the current block time.
+type TwapRateSource struct {
+	PoolId   uint64        `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+}
+
+func (m *TwapRateSource) Reset()         { *m = TwapRateSource{} }
+func (m *TwapRateSource) String() string { return proto.CompactTextString(m) }
+func (*TwapRateSource) ProtoMessage()    {}
+func (*TwapRateSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_713607bd1614d737, []int{2}
+}
+func (m *TwapRateSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRateSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRateSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapRateSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRateSource.Merge(m, src)
+}
+func (m *TwapRateSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRateSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRateSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRateSource proto.InternalMessageInfo
+
+func (m *TwapRateSource) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TwapRateSource) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ScalingFactorRateSource)(nil), "osmosis.gamm.v1beta1.ScalingFactorRateSource")
+	proto.RegisterType((*DenomRateSource)(nil), "osmosis.gamm.v1beta1.DenomRateSource")
+	proto.RegisterType((*TwapRateSource)(nil), "osmosis.gamm.v1beta1.TwapRateSource")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/v1beta1/scaling_factor_rate_source.proto", fileDescriptor_713607bd1614d737)
+}
+
+var fileDescriptor_713607bd1614d737 = []byte{
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0x68, 0x37, 0xc0, 0x45, 0x4d, 0x15, 0x0d, 0xd6, 0x75, 0x28, 0x29, 0x11, 0x4c, 0x95,
+	0xd0, 0x12, 0x3a, 0x04, 0x07, 0x6e, 0x74, 0xd5, 0xa4, 0x72, 0x40, 0x28, 0x83, 0x0b, 0x97, 0xc8,
+	0x75, 0xdc, 0x34, 0xa2, 0x89, 0x23, 0xdb, 0xed, 0xba, 0x23, 0xff, 0x00, 0x6e, 0xfc, 0xa4, 0x1d,
+	0x90, 0xd8, 0x11, 0x71, 0x08, 0xa8, 0xfd, 0x07, 0xf9, 0x05, 0x28, 0xb6, 0xbb, 0x75, 0xa5, 0x17,
+	0x4e, 0x89, 0xbf, 0xf7, 0xbe, 0xef, 0xf3, 0x7b, 0x7e, 0x0f, 0xbc, 0x20, 0x2c, 0x26, 0x2c, 0x62,
+	0x6e, 0x08, 0xe3, 0xd8, 0x9d, 0x76, 0x06, 0x98, 0xc3, 0x8e, 0xcb, 0x10, 0x1c, 0x47, 0x49, 0xe8,
+	0x0f, 0x21, 0xe2, 0x84, 0xfa, 0x14, 0x72, 0xec, 0x33, 0x32, 0xa1, 0x08, 0x3b, 0x29, 0x25, 0x9c,
+	0x18, 0x3b, 0x8a, 0xe6, 0x14, 0x34, 0x47, 0xd1, 0x9a, 0x3b, 0x21, 0x09, 0x89, 0x48, 0x70, 0x8b,
+	0x3f, 0x99, 0xdb, 0x34, 0x43, 0x42, 0xc2, 0x31, 0x76, 0xc5, 0x69, 0x30, 0x19, 0xba, 0xc1, 0x84,
+	0x42, 0x1e, 0x91, 0x44, 0xc6, 0xed, 0x1f, 0x65, 0xb0, 0x7b, 0x2a, 0x0d, 0x4f, 0x84, 0x9f, 0x07,
+	0x39, 0x3e, 0x15, 0x6e, 0xc6, 0x53, 0x70, 0x3b, 0x25, 0x64, 0xec, 0x47, 0x41, 0x43, 0x6b, 0x69,
+	0xed, 0x4a, 0xd7, 0xc8, 0x33, 0xab, 0x76, 0x0e, 0xe3, 0xf1, 0x2b, 0x5b, 0x05, 0x6c, 0x6f, 0xbb,
+	0xf8, 0xeb, 0x07, 0xc6, 0x31, 0xd0, 0x29, 0x1e, 0x62, 0x8a, 0x13, 0x84, 0xfd, 0x00, 0x27, 0x24,
+	0x6e, 0xdc, 0x6a, 0x69, 0xed, 0xbb, 0xdd, 0x66, 0x9e, 0x59, 0x0f, 0x24, 0x69, 0x2d, 0xc1, 0xf6,
+	0x6a, 0x57, 0x48, 0xaf, 0x00, 0x8c, 0x29, 0x30, 0x44, 0x64, 0xb5, 0x68, 0xd6, 0x28, 0xb7, 0xca,
+	0xed, 0xea, 0xd1, 0x13, 0x67, 0x53, 0xd9, 0x8e, 0x20, 0x5e, 0x5f, 0xba, 0xfb, 0xe8, 0x22, 0xb3,
+	0x4a, 0x79, 0x66, 0xed, 0x49, 0xcb, 0x7f, 0xe5, 0x6c, 0xaf, 0x1e, 0xdc, 0xe4, 0x30, 0xe3, 0xb3,
+	0x06, 0xee, 0xc7, 0x70, 0xe6, 0xa3, 0x11, 0x4c, 0x42, 0xec, 0xa7, 0x98, 0xfa, 0x93, 0x34, 0x80,
+	0x1c, 0x37, 0x2a, 0xa2, 0x86, 0xb7, 0x85, 0xe8, 0xaf, 0xcc, 0x3a, 0x08, 0x23, 0x3e, 0x9a, 0x0c,
+	0x1c, 0x44, 0x62, 0x17, 0x89, 0xeb, 0xa8, 0xcf, 0x21, 0x0b, 0x3e, 0xb9, 0xfc, 0x3c, 0xc5, 0xcc,
+	0xe9, 0x61, 0x94, 0x67, 0xd6, 0x43, 0x69, 0xbf, 0x51, 0xd4, 0xf6, 0x8c, 0x18, 0xce, 0x8e, 0x05,
+	0xfc, 0x0e, 0xd3, 0x0f, 0x02, 0x34, 0x4e, 0x40, 0x1d, 0xa7, 0x04, 0x8d, 0xfc, 0x28, 0xc0, 0x09,
+	0x8f, 0x86, 0x11, 0xa6, 0x8d, 0x2d, 0xe1, 0xbe, 0x9f, 0x67, 0xd6, 0xae, 0xd4, 0x5b, 0xcf, 0xb0,
+	0x3d, 0x5d, 0x40, 0xfd, 0x6b, 0xe4, 0xbb, 0x06, 0xf4, 0xb5, 0xa6, 0x18, 0x07, 0x60, 0x4b, 0x3e,
+	0x89, 0x26, 0x04, 0xeb, 0x79, 0x66, 0xdd, 0x5b, 0xe9, 0x8f, 0xed, 0xc9, 0xb0, 0xd1, 0x07, 0x15,
+	0x7e, 0x06, 0x53, 0xf1, 0x72, 0xd5, 0xa3, 0xc7, 0x9b, 0x3b, 0xfe, 0xfe, 0x0c, 0xa6, 0x2b, 0x0d,
+	0xd7, 0xf3, 0xcc, 0xaa, 0x4a, 0xb1, 0x82, 0x6b, 0x7b, 0x42, 0xa2, 0x28, 0x07, 0x91, 0x84, 0x53,
+	0x88, 0xb8, 0x0f, 0x83, 0x80, 0x62, 0x56, 0x3c, 0xe4, 0x5a, 0x39, 0xeb, 0x19, 0xb6, 0xa7, 0x2f,
+	0xa1, 0xd7, 0x0a, 0xf9, 0xaa, 0x81, 0xda, 0x4d, 0xc7, 0xff, 0x9b, 0x4b, 0x0f, 0xdc, 0x59, 0x8e,
+	0xbc, 0x2a, 0x6b, 0xcf, 0x91, 0x3b, 0xe1, 0x2c, 0x77, 0xc2, 0xe9, 0xa9, 0x84, 0xee, 0xbe, 0x1a,
+	0x1e, 0x5d, 0x35, 0x47, 0xe1, 0xf6, 0xb7, 0xdf, 0x96, 0xe6, 0x5d, 0xe9, 0x74, 0xdf, 0x5c, 0xcc,
+	0x4d, 0xed, 0x72, 0x6e, 0x6a, 0x7f, 0xe6, 0xa6, 0xf6, 0x65, 0x61, 0x96, 0x2e, 0x17, 0x66, 0xe9,
+	0xe7, 0xc2, 0x2c, 0x7d, 0x7c, 0xb6, 0x32, 0x20, 0xaa, 0x79, 0x87, 0x63, 0x38, 0x60, 0xcb, 0x83,
+	0x3b, 0xed, 0xbc, 0x74, 0x67, 0x72, 0xdf, 0xc5, 0xb8, 0x0c, 0xb6, 0xc5, 0x2d, 0x9e, 0xff, 0x1d,
+	0x00, 0x48, 0xbd, 0xf5, 0xd3, 0x0c, 0x04, 0x00, 0x00,
+}
+
+func (m *ScalingFactorRateSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingFactorRateSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingFactorRateSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintScalingFactorRateSource(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.MaxChangePerUpdate.Size()
+		i -= size
+		if _, err := m.MaxChangePerUpdate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScalingFactorRateSource(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DenomRateSources) > 0 {
+		for iNdEx := len(m.DenomRateSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRateSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScalingFactorRateSource(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ReferenceDenom) > 0 {
+		i -= len(m.ReferenceDenom)
+		copy(dAtA[i:], m.ReferenceDenom)
+		i = encodeVarintScalingFactorRateSource(dAtA, i, uint64(len(m.ReferenceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintScalingFactorRateSource(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomRateSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRateSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRateSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintScalingFactorRateSource(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Twap != nil {
+		{
+			size, err := m.Twap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScalingFactorRateSource(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintScalingFactorRateSource(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapRateSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRateSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRateSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintScalingFactorRateSource(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintScalingFactorRateSource(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScalingFactorRateSource(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScalingFactorRateSource(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScalingFactorRateSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovScalingFactorRateSource(uint64(m.PoolId))
+	}
+	l = len(m.ReferenceDenom)
+	if l > 0 {
+		n += 1 + l + sovScalingFactorRateSource(uint64(l))
+	}
+	if len(m.DenomRateSources) > 0 {
+		for _, e := range m.DenomRateSources {
+			l = e.Size()
+			n += 1 + l + sovScalingFactorRateSource(uint64(l))
+		}
+	}
+	l = m.MaxChangePerUpdate.Size()
+	n += 1 + l + sovScalingFactorRateSource(uint64(l))
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovScalingFactorRateSource(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomRateSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovScalingFactorRateSource(uint64(l))
+	}
+	if m.Twap != nil {
+		l = m.Twap.Size()
+		n += 1 + l + sovScalingFactorRateSource(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovScalingFactorRateSource(uint64(l))
+	}
+	return n
+}
+
+func (m *TwapRateSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovScalingFactorRateSource(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovScalingFactorRateSource(uint64(l))
+	return n
+}
+
+func sovScalingFactorRateSource(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScalingFactorRateSource(x uint64) (n int) {
+	return sovScalingFactorRateSource(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScalingFactorRateSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScalingFactorRateSource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingFactorRateSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingFactorRateSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScalingFactorRateSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScalingFactorRateSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRateSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScalingFactorRateSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRateSources = append(m.DenomRateSources, DenomRateSource{})
+			if err := m.DenomRateSources[len(m.DenomRateSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangePerUpdate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScalingFactorRateSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangePerUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScalingFactorRateSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScalingFactorRateSource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomRateSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScalingFactorRateSource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRateSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRateSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScalingFactorRateSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScalingFactorRateSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Twap == nil {
+				m.Twap = &TwapRateSource{}
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScalingFactorRateSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScalingFactorRateSource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapRateSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScalingFactorRateSource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRateSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRateSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScalingFactorRateSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScalingFactorRateSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScalingFactorRateSource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScalingFactorRateSource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScalingFactorRateSource(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScalingFactorRateSource
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScalingFactorRateSource
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScalingFactorRateSource
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScalingFactorRateSource
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScalingFactorRateSource
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScalingFactorRateSource
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScalingFactorRateSource        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScalingFactorRateSource          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScalingFactorRateSource = fmt.Errorf("proto: unexpected end of group")
+)
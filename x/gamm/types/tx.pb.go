@@ -842,6 +842,236 @@ func (m *MsgExitSwapExternAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExitSwapExternAmountOutResponse proto.InternalMessageInfo
 
+// ===================== MsgJoinPoolWithAnyToken
+// MsgJoinPoolWithAnyToken swaps token_in along the routes into the pool asset
+// the last route outputs, splits it across the pool assets in the ratio of
+// their value in the pool, and joins the pool with them without swap. If the
+// split join fails, it joins the pool with the whole routed token instead.
+// With no routes, token_in must already be a pool asset.
+type MsgJoinPoolWithAnyToken struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId            uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenIn           types.Coin                             `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	Routes            []types1.SwapAmountInRoute             `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes"`
+	ShareOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=share_out_min_amount,json=shareOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_min_amount" yaml:"share_out_min_amount"`
+}
+
+func (m *MsgJoinPoolWithAnyToken) Reset()         { *m = MsgJoinPoolWithAnyToken{} }
+func (m *MsgJoinPoolWithAnyToken) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinPoolWithAnyToken) ProtoMessage()    {}
+func (*MsgJoinPoolWithAnyToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{16}
+}
+func (m *MsgJoinPoolWithAnyToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinPoolWithAnyToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinPoolWithAnyToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgJoinPoolWithAnyToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinPoolWithAnyToken.Merge(m, src)
+}
+func (m *MsgJoinPoolWithAnyToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinPoolWithAnyToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinPoolWithAnyToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgJoinPoolWithAnyToken proto.InternalMessageInfo
+
+func (m *MsgJoinPoolWithAnyToken) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgJoinPoolWithAnyToken) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgJoinPoolWithAnyToken) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgJoinPoolWithAnyToken) GetRoutes() []types1.SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+type MsgJoinPoolWithAnyTokenResponse struct {
+	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount" yaml:"share_out_amount"`
+}
+
+func (m *MsgJoinPoolWithAnyTokenResponse) Reset()         { *m = MsgJoinPoolWithAnyTokenResponse{} }
+func (m *MsgJoinPoolWithAnyTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinPoolWithAnyTokenResponse) ProtoMessage()    {}
+func (*MsgJoinPoolWithAnyTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{17}
+}
+func (m *MsgJoinPoolWithAnyTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinPoolWithAnyTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinPoolWithAnyTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgJoinPoolWithAnyTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinPoolWithAnyTokenResponse.Merge(m, src)
+}
+func (m *MsgJoinPoolWithAnyTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinPoolWithAnyTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinPoolWithAnyTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgJoinPoolWithAnyTokenResponse proto.InternalMessageInfo
+
+// ===================== MsgExitPoolToSingleToken
+// MsgExitPoolToSingleToken exits the pool to the pool asset of denom
+// pool_asset_denom, and swaps it along the routes into the denom the last route
+// outputs. With no routes, the pool asset is the token out.
+type MsgExitPoolToSingleToken struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId            uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	ShareInAmount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=share_in_amount,json=shareInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_in_amount" yaml:"share_in_amount"`
+	PoolAssetDenom    string                                 `protobuf:"bytes,4,opt,name=pool_asset_denom,json=poolAssetDenom,proto3" json:"pool_asset_denom,omitempty" yaml:"pool_asset_denom"`
+	Routes            []types1.SwapAmountInRoute             `protobuf:"bytes,5,rep,name=routes,proto3" json:"routes"`
+	TokenOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+}
+
+func (m *MsgExitPoolToSingleToken) Reset()         { *m = MsgExitPoolToSingleToken{} }
+func (m *MsgExitPoolToSingleToken) String() string { return proto.CompactTextString(m) }
+func (*MsgExitPoolToSingleToken) ProtoMessage()    {}
+func (*MsgExitPoolToSingleToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{18}
+}
+func (m *MsgExitPoolToSingleToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExitPoolToSingleToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExitPoolToSingleToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExitPoolToSingleToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExitPoolToSingleToken.Merge(m, src)
+}
+func (m *MsgExitPoolToSingleToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExitPoolToSingleToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExitPoolToSingleToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExitPoolToSingleToken proto.InternalMessageInfo
+
+func (m *MsgExitPoolToSingleToken) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgExitPoolToSingleToken) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgExitPoolToSingleToken) GetPoolAssetDenom() string {
+	if m != nil {
+		return m.PoolAssetDenom
+	}
+	return ""
+}
+
+func (m *MsgExitPoolToSingleToken) GetRoutes() []types1.SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+type MsgExitPoolToSingleTokenResponse struct {
+	TokenOut types.Coin `protobuf:"bytes,1,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+}
+
+func (m *MsgExitPoolToSingleTokenResponse) Reset()         { *m = MsgExitPoolToSingleTokenResponse{} }
+func (m *MsgExitPoolToSingleTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExitPoolToSingleTokenResponse) ProtoMessage()    {}
+func (*MsgExitPoolToSingleTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{19}
+}
+func (m *MsgExitPoolToSingleTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExitPoolToSingleTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExitPoolToSingleTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExitPoolToSingleTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExitPoolToSingleTokenResponse.Merge(m, src)
+}
+func (m *MsgExitPoolToSingleTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExitPoolToSingleTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExitPoolToSingleTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExitPoolToSingleTokenResponse proto.InternalMessageInfo
+
+func (m *MsgExitPoolToSingleTokenResponse) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgJoinPool)(nil), "osmosis.gamm.v1beta1.MsgJoinPool")
 	proto.RegisterType((*MsgJoinPoolResponse)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolResponse")
@@ -859,88 +1089,103 @@ func init() {
 	proto.RegisterType((*MsgExitSwapShareAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapShareAmountInResponse")
 	proto.RegisterType((*MsgExitSwapExternAmountOut)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOut")
 	proto.RegisterType((*MsgExitSwapExternAmountOutResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOutResponse")
+	proto.RegisterType((*MsgJoinPoolWithAnyToken)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolWithAnyToken")
+	proto.RegisterType((*MsgJoinPoolWithAnyTokenResponse)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolWithAnyTokenResponse")
+	proto.RegisterType((*MsgExitPoolToSingleToken)(nil), "osmosis.gamm.v1beta1.MsgExitPoolToSingleToken")
+	proto.RegisterType((*MsgExitPoolToSingleTokenResponse)(nil), "osmosis.gamm.v1beta1.MsgExitPoolToSingleTokenResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/tx.proto", fileDescriptor_cfc8fd3ac7df3247) }
 
 var fileDescriptor_cfc8fd3ac7df3247 = []byte{
-	// 1214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5d, 0x6f, 0xdb, 0x54,
-	0x18, 0xae, 0x93, 0xac, 0xed, 0x4e, 0xe9, 0x47, 0xbc, 0x7e, 0xa4, 0xee, 0x96, 0xb4, 0x07, 0xd4,
-	0x75, 0xeb, 0x62, 0xaf, 0x9d, 0x34, 0x50, 0x6f, 0x10, 0x81, 0x49, 0x64, 0x5a, 0x94, 0xc9, 0xbb,
-	0x99, 0xb8, 0x89, 0x9c, 0xc6, 0xca, 0xcc, 0xea, 0x73, 0xa2, 0x1c, 0xbb, 0xcb, 0x84, 0x04, 0x12,
-	0x12, 0x48, 0x70, 0x05, 0x42, 0x7c, 0xfc, 0x0c, 0x6e, 0xf9, 0x07, 0xbb, 0xdc, 0x05, 0x48, 0x30,
-	0xa4, 0x08, 0xb5, 0x17, 0xdc, 0xa2, 0xfc, 0x02, 0x74, 0x7c, 0x8e, 0x1d, 0xdb, 0xb1, 0x9b, 0xb8,
-	0x4b, 0xd6, 0x9b, 0xad, 0x39, 0xe7, 0xfd, 0x3a, 0xcf, 0xfb, 0xf8, 0x39, 0xaf, 0x0d, 0xae, 0x61,
-	0x62, 0x62, 0x62, 0x10, 0xa5, 0xa9, 0x99, 0xa6, 0x72, 0xbc, 0x57, 0xd7, 0x2d, 0x6d, 0x4f, 0xb1,
-	0x3a, 0x72, 0xab, 0x8d, 0x2d, 0x2c, 0x2e, 0xf3, 0x6d, 0x99, 0x6e, 0xcb, 0x7c, 0x5b, 0x5a, 0x6e,
-	0xe2, 0x26, 0x76, 0x0c, 0x14, 0xfa, 0x17, 0xb3, 0x95, 0xb2, 0x9a, 0x69, 0x20, 0xac, 0x38, 0xff,
-	0xf2, 0xa5, 0xfc, 0xa1, 0xe3, 0xaf, 0xd4, 0x35, 0xa2, 0x7b, 0xc1, 0x0f, 0xb1, 0x81, 0xf8, 0xfe,
-	0x2d, 0x37, 0x7b, 0x0b, 0xe3, 0x23, 0x53, 0x43, 0x5a, 0x53, 0x6f, 0x7b, 0x76, 0xe4, 0x99, 0xd6,
-	0xaa, 0xb5, 0xb1, 0x6d, 0xe9, 0xcc, 0x1a, 0xbe, 0x4a, 0x81, 0xb9, 0x0a, 0x69, 0xde, 0xc7, 0x06,
-	0x7a, 0x88, 0xf1, 0x91, 0x78, 0x03, 0x4c, 0x13, 0x1d, 0x35, 0xf4, 0x76, 0x4e, 0xd8, 0x14, 0x76,
-	0x2e, 0x97, 0xb2, 0xbd, 0x6e, 0x61, 0xfe, 0xb9, 0x66, 0x1e, 0x1d, 0x40, 0xb6, 0x0e, 0x55, 0x6e,
-	0x20, 0xee, 0x82, 0x19, 0x9a, 0xa2, 0x66, 0x34, 0x72, 0xa9, 0x4d, 0x61, 0x27, 0x53, 0x12, 0x7b,
-	0xdd, 0xc2, 0x02, 0xb3, 0xe5, 0x1b, 0x50, 0x9d, 0xa6, 0x7f, 0x95, 0x1b, 0x62, 0x1b, 0x2c, 0x91,
-	0x27, 0x5a, 0x5b, 0xaf, 0x61, 0xdb, 0xaa, 0x69, 0x26, 0xb6, 0x91, 0x95, 0x4b, 0x3b, 0x19, 0x3e,
-	0x7e, 0xd1, 0x2d, 0x4c, 0xbd, 0xea, 0x16, 0xb6, 0x9b, 0x86, 0xf5, 0xc4, 0xae, 0xcb, 0x87, 0xd8,
-	0x54, 0xf8, 0x11, 0xd9, 0x7f, 0x45, 0xd2, 0x78, 0xaa, 0x58, 0xcf, 0x5b, 0x3a, 0x91, 0xcb, 0xc8,
-	0xea, 0x75, 0x0b, 0xab, 0xbe, 0x1c, 0x2c, 0x14, 0x8d, 0x0a, 0xd5, 0x05, 0x27, 0x43, 0xd5, 0xb6,
-	0x3e, 0x70, 0x16, 0xc5, 0x3a, 0x98, 0xb7, 0xf0, 0x53, 0x1d, 0xd5, 0x0c, 0x54, 0x33, 0xb5, 0x0e,
-	0xc9, 0x65, 0x36, 0xd3, 0x3b, 0x73, 0xfb, 0xeb, 0x32, 0x8b, 0x2b, 0x53, 0x04, 0x5d, 0xfc, 0xe5,
-	0x0f, 0xb1, 0x81, 0x4a, 0x6f, 0xd3, 0x5a, 0x7a, 0xdd, 0xc2, 0x06, 0xcb, 0xe0, 0xf7, 0xe6, 0x99,
-	0x08, 0x54, 0xe7, 0x9c, 0xe5, 0x32, 0xaa, 0x68, 0x1d, 0x72, 0xb0, 0xf1, 0xed, 0xbf, 0xbf, 0xde,
-	0x5c, 0x0d, 0x34, 0xfc, 0x53, 0x6c, 0xa0, 0x22, 0x2d, 0x0e, 0xfe, 0x25, 0x80, 0x2b, 0x3e, 0x70,
-	0x55, 0x9d, 0xb4, 0x30, 0x22, 0xba, 0x48, 0x22, 0xc0, 0x60, 0x70, 0x97, 0x13, 0x83, 0xb1, 0xc6,
-	0x9b, 0x13, 0x8a, 0x37, 0x88, 0x46, 0x05, 0xcc, 0xba, 0xe7, 0xc9, 0xa5, 0x86, 0x01, 0xb1, 0xc6,
-	0x81, 0x58, 0x0c, 0x02, 0x01, 0xd5, 0x19, 0x7e, 0x78, 0xf8, 0x37, 0x23, 0xce, 0xbd, 0x8e, 0x61,
-	0x4d, 0x94, 0x38, 0x2d, 0xb0, 0xc8, 0xce, 0x66, 0xa0, 0x31, 0xf1, 0x26, 0x14, 0x0e, 0xaa, 0xf3,
-	0xce, 0x4a, 0x19, 0x71, 0xa0, 0x74, 0xb0, 0xc0, 0xce, 0x4b, 0xd1, 0x34, 0x0d, 0x34, 0x02, 0x6f,
-	0xde, 0xe1, 0x70, 0x5d, 0xf5, 0xc3, 0xc5, 0xdd, 0xfb, 0xc4, 0x79, 0xcb, 0x59, 0xaf, 0xda, 0x56,
-	0xc5, 0x40, 0x91, 0xcc, 0xd1, 0x3b, 0x86, 0xc5, 0x98, 0xd3, 0x74, 0x88, 0xe3, 0x82, 0xeb, 0x11,
-	0xe7, 0x21, 0xb8, 0xec, 0xc5, 0xce, 0x09, 0xc3, 0xaa, 0xca, 0xf1, 0xaa, 0x96, 0x42, 0x55, 0x41,
-	0x75, 0xd6, 0xad, 0x04, 0x7e, 0x9d, 0x06, 0xcb, 0x15, 0xd2, 0x7c, 0xf4, 0x4c, 0x6b, 0xdd, 0xeb,
-	0x68, 0x87, 0x9c, 0x2c, 0x65, 0x94, 0xa4, 0x9f, 0x0f, 0xc0, 0xb4, 0x23, 0x29, 0x84, 0xf3, 0x4a,
-	0x96, 0x5d, 0x85, 0xf3, 0x49, 0x90, 0x57, 0x1a, 0x4d, 0xe5, 0x66, 0x51, 0xa9, 0x5b, 0x29, 0x43,
-	0xeb, 0x54, 0x79, 0x8c, 0x00, 0x4f, 0x69, 0xa7, 0x5f, 0x8f, 0xa7, 0xe2, 0xe7, 0x60, 0x39, 0xaa,
-	0x1d, 0xb9, 0x8c, 0x73, 0xaa, 0x4a, 0x62, 0x12, 0x6d, 0xc4, 0xb7, 0x18, 0xaa, 0x59, 0x5f, 0x87,
-	0xd9, 0x19, 0x0f, 0xb6, 0x69, 0x9b, 0xb7, 0x02, 0x6d, 0xa6, 0x22, 0x5c, 0xd4, 0x29, 0xda, 0x45,
-	0xe6, 0x58, 0x34, 0x10, 0xfc, 0x41, 0x00, 0x57, 0xa3, 0x1a, 0xe1, 0x17, 0x8d, 0x7e, 0xd2, 0xf1,
-	0x88, 0x46, 0x38, 0x1e, 0x54, 0x17, 0xdc, 0x03, 0xb0, 0xf4, 0xf0, 0x9b, 0x34, 0x58, 0x19, 0xac,
-	0xaa, 0x6a, 0x5b, 0x49, 0xf8, 0x51, 0x09, 0xf1, 0x43, 0x19, 0x91, 0x1f, 0x55, 0xdb, 0x8a, 0x22,
-	0xc8, 0x67, 0xe0, 0x4a, 0x84, 0x30, 0x73, 0x55, 0x78, 0x90, 0x18, 0x0b, 0x29, 0x56, 0xeb, 0xa1,
-	0xba, 0xd4, 0x97, 0x7a, 0x2e, 0x0e, 0x81, 0x27, 0x30, 0x33, 0x8c, 0x9e, 0x23, 0x3c, 0x81, 0x07,
-	0xd7, 0x29, 0x41, 0xe0, 0x10, 0x82, 0x50, 0x9f, 0xef, 0x05, 0x70, 0x2d, 0xb2, 0x17, 0x1e, 0x45,
-	0x5a, 0x60, 0xd1, 0x3b, 0x46, 0x80, 0x21, 0xe7, 0xd6, 0xca, 0x50, 0x38, 0xa8, 0xce, 0x73, 0x44,
-	0x38, 0x3f, 0xfe, 0x4b, 0x81, 0x75, 0x7e, 0xc3, 0xb1, 0xba, 0x2c, 0xbd, 0x8d, 0xce, 0xa3, 0x21,
-	0x89, 0xee, 0x84, 0xf1, 0x4b, 0x44, 0xff, 0xfa, 0x1c, 0x9f, 0x44, 0x44, 0xc5, 0x84, 0x6a, 0xd6,
-	0xbd, 0x96, 0xfb, 0x12, 0x71, 0x8b, 0x32, 0xe0, 0xfa, 0xe0, 0x0c, 0xc1, 0x69, 0x40, 0x21, 0xf5,
-	0x09, 0xc5, 0x2f, 0x02, 0xd8, 0x8a, 0x85, 0xfc, 0x42, 0x47, 0x0c, 0xf8, 0x7b, 0x3a, 0xc0, 0x86,
-	0x47, 0x74, 0xf7, 0x5c, 0x8a, 0x91, 0x88, 0x0d, 0xef, 0xbb, 0xf7, 0xb5, 0x81, 0x6a, 0x0d, 0x1d,
-	0x61, 0x93, 0x4b, 0xc1, 0x7a, 0xaf, 0x5b, 0x58, 0x09, 0xd1, 0xd8, 0xd9, 0x77, 0x6f, 0xe2, 0x32,
-	0xfa, 0x88, 0xfe, 0x8c, 0xc4, 0x2a, 0x33, 0xe9, 0x71, 0x2c, 0x46, 0xc5, 0x2e, 0xbd, 0x09, 0x15,
-	0x3b, 0x9b, 0x71, 0x4e, 0xa1, 0x7e, 0xe1, 0xf9, 0x31, 0xc8, 0xb8, 0x60, 0x5b, 0x2f, 0x50, 0x7c,
-	0xfe, 0x48, 0x83, 0x1c, 0x9f, 0x92, 0x42, 0x75, 0x4d, 0x50, 0x7b, 0x4a, 0xee, 0x31, 0x69, 0x73,
-	0xfd, 0x74, 0x93, 0xc2, 0x85, 0x7b, 0x06, 0x6e, 0xe1, 0x55, 0xdb, 0x62, 0x84, 0x8b, 0x98, 0x69,
-	0x33, 0x93, 0x9d, 0x69, 0xe3, 0xa6, 0xa0, 0x4b, 0x6f, 0x68, 0x0a, 0xda, 0xa5, 0x84, 0xdb, 0x1e,
-	0x1c, 0x76, 0x07, 0x09, 0x67, 0x20, 0xf8, 0xb3, 0x00, 0x36, 0xe3, 0xfa, 0x7a, 0xb1, 0xe3, 0x50,
-	0x2f, 0x05, 0x24, 0x5f, 0x65, 0x7e, 0xed, 0x9d, 0xa4, 0xc2, 0x05, 0x86, 0x8e, 0xf4, 0x18, 0x86,
-	0x0e, 0xaa, 0x3e, 0x1e, 0x65, 0x7c, 0xea, 0x93, 0x79, 0x3d, 0xf5, 0x89, 0x08, 0x09, 0xd5, 0x25,
-	0xce, 0xc4, 0xbe, 0xfa, 0x14, 0x29, 0x19, 0x76, 0x62, 0xc8, 0x10, 0xbc, 0xef, 0x68, 0xd9, 0x3f,
-	0x09, 0x00, 0xc6, 0x83, 0xee, 0xd7, 0x9f, 0xf0, 0x43, 0x25, 0x4c, 0xf4, 0xa1, 0xda, 0xff, 0x6d,
-	0x06, 0xa4, 0x2b, 0xa4, 0x29, 0x3e, 0x06, 0xb3, 0xde, 0xf7, 0x93, 0x2d, 0x39, 0xea, 0xeb, 0x8e,
-	0xec, 0xfb, 0x0a, 0x20, 0xdd, 0x18, 0x6a, 0xe2, 0x9d, 0xe9, 0x31, 0x98, 0xf5, 0x5e, 0xb0, 0xe3,
-	0x23, 0xbb, 0x26, 0x67, 0x44, 0x1e, 0x78, 0x93, 0x24, 0x20, 0x3b, 0xf8, 0xce, 0x77, 0x33, 0xd6,
-	0x7f, 0xc0, 0x56, 0xda, 0x1f, 0xdd, 0xd6, 0x4b, 0x7a, 0x0c, 0xc4, 0x88, 0x37, 0x89, 0xdd, 0x51,
-	0x23, 0x55, 0x6d, 0x4b, 0xba, 0x93, 0xc0, 0xd8, 0xcb, 0xfb, 0xa5, 0x00, 0x56, 0x63, 0x46, 0x54,
-	0xe5, 0xcc, 0x66, 0x0c, 0x3a, 0x48, 0xef, 0x26, 0x74, 0x88, 0x2c, 0x22, 0x34, 0x19, 0x0d, 0x2f,
-	0x22, 0xe8, 0x30, 0x42, 0x11, 0x31, 0x97, 0xf4, 0x57, 0x02, 0x58, 0x8b, 0x53, 0xaf, 0xdb, 0x67,
-	0xb2, 0x27, 0xc2, 0x43, 0x7a, 0x2f, 0xa9, 0x87, 0x57, 0xc7, 0x17, 0x60, 0x25, 0xfa, 0xda, 0x96,
-	0x87, 0x86, 0x0c, 0xd8, 0x4b, 0x77, 0x93, 0xd9, 0xbb, 0x05, 0x94, 0xee, 0xbf, 0x38, 0xc9, 0x0b,
-	0x2f, 0x4f, 0xf2, 0xc2, 0x3f, 0x27, 0x79, 0xe1, 0xbb, 0xd3, 0xfc, 0xd4, 0xcb, 0xd3, 0xfc, 0xd4,
-	0x9f, 0xa7, 0xf9, 0xa9, 0x4f, 0x6e, 0xfb, 0x64, 0x82, 0xc7, 0x2e, 0x1e, 0x69, 0x75, 0xe2, 0xfe,
-	0x50, 0x8e, 0xf7, 0xee, 0x2a, 0x1d, 0x26, 0x5b, 0x8e, 0x68, 0xd4, 0xa7, 0x9d, 0x4f, 0xa9, 0x77,
-	0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x38, 0x69, 0x9d, 0xcb, 0xf8, 0x15, 0x00, 0x00,
+	// 1389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4d, 0x4f, 0x1b, 0xc7,
+	0x1b, 0x67, 0xb1, 0x21, 0x64, 0xf2, 0x87, 0x80, 0xc3, 0x8b, 0x59, 0x12, 0xdb, 0xcc, 0xbf, 0x25,
+	0xbc, 0x64, 0x77, 0x03, 0x51, 0x49, 0xc5, 0xa5, 0xc2, 0x2d, 0x52, 0x1d, 0xc5, 0x72, 0xb4, 0x44,
+	0x6a, 0xd4, 0x8b, 0xb5, 0x86, 0x95, 0xd9, 0x06, 0xcf, 0x58, 0x9e, 0x31, 0x18, 0xb5, 0x6a, 0xa5,
+	0x4a, 0xad, 0xd4, 0x9e, 0x5a, 0x55, 0x7d, 0xf9, 0x04, 0x3d, 0xf7, 0x58, 0xf5, 0x13, 0xe4, 0x98,
+	0x43, 0x2b, 0xb5, 0xa9, 0x64, 0x55, 0x70, 0xe8, 0xb5, 0xf2, 0x27, 0xa8, 0x66, 0x77, 0x76, 0xbd,
+	0xbb, 0xde, 0xc5, 0x2c, 0xd8, 0xa0, 0x5e, 0x12, 0x98, 0x79, 0xde, 0xe6, 0x79, 0x7e, 0xf3, 0x7b,
+	0x9e, 0x1d, 0xc0, 0x1d, 0x4c, 0x2a, 0x98, 0x18, 0x44, 0x29, 0x6b, 0x95, 0x8a, 0x72, 0xb0, 0x5a,
+	0xd2, 0xa9, 0xb6, 0xaa, 0xd0, 0x86, 0x5c, 0xad, 0x61, 0x8a, 0x13, 0x93, 0x7c, 0x5b, 0x66, 0xdb,
+	0x32, 0xdf, 0x16, 0x27, 0xcb, 0xb8, 0x8c, 0x4d, 0x01, 0x85, 0xfd, 0x64, 0xc9, 0x8a, 0x13, 0x5a,
+	0xc5, 0x40, 0x58, 0x31, 0xff, 0xe5, 0x4b, 0xa9, 0x1d, 0x53, 0x5f, 0x29, 0x69, 0x44, 0x77, 0x8c,
+	0xef, 0x60, 0x03, 0xf1, 0xfd, 0x7b, 0xb6, 0xf7, 0x2a, 0xc6, 0xfb, 0x15, 0x0d, 0x69, 0x65, 0xbd,
+	0xe6, 0xc8, 0x91, 0x43, 0xad, 0x5a, 0xac, 0xe1, 0x3a, 0xd5, 0x2d, 0x69, 0xf8, 0x6a, 0x10, 0xdc,
+	0xc8, 0x93, 0xf2, 0x23, 0x6c, 0xa0, 0x27, 0x18, 0xef, 0x27, 0x96, 0xc0, 0x30, 0xd1, 0xd1, 0xae,
+	0x5e, 0x4b, 0x0a, 0x19, 0x61, 0xf1, 0x7a, 0x76, 0xa2, 0xd5, 0x4c, 0x8f, 0x1e, 0x69, 0x95, 0xfd,
+	0x0d, 0x68, 0xad, 0x43, 0x95, 0x0b, 0x24, 0x56, 0xc0, 0x35, 0xe6, 0xa2, 0x68, 0xec, 0x26, 0x07,
+	0x33, 0xc2, 0x62, 0x3c, 0x9b, 0x68, 0x35, 0xd3, 0x63, 0x96, 0x2c, 0xdf, 0x80, 0xea, 0x30, 0xfb,
+	0x29, 0xb7, 0x9b, 0xa8, 0x81, 0x71, 0xb2, 0xa7, 0xd5, 0xf4, 0x22, 0xae, 0xd3, 0xa2, 0x56, 0xc1,
+	0x75, 0x44, 0x93, 0x31, 0xd3, 0xc3, 0xbb, 0x2f, 0x9a, 0xe9, 0x81, 0x57, 0xcd, 0xf4, 0x42, 0xd9,
+	0xa0, 0x7b, 0xf5, 0x92, 0xbc, 0x83, 0x2b, 0x0a, 0x3f, 0xa2, 0xf5, 0x9f, 0x44, 0x76, 0x9f, 0x2b,
+	0xf4, 0xa8, 0xaa, 0x13, 0x39, 0x87, 0x68, 0xab, 0x99, 0x9e, 0x76, 0xf9, 0xb0, 0x4c, 0x31, 0xab,
+	0x50, 0x1d, 0x33, 0x3d, 0x14, 0xea, 0x74, 0xd3, 0x5c, 0x4c, 0x94, 0xc0, 0x28, 0xc5, 0xcf, 0x75,
+	0x54, 0x34, 0x50, 0xb1, 0xa2, 0x35, 0x48, 0x32, 0x9e, 0x89, 0x2d, 0xde, 0x58, 0x9b, 0x95, 0x2d,
+	0xbb, 0x32, 0xcb, 0xa0, 0x9d, 0x7f, 0xf9, 0x6d, 0x6c, 0xa0, 0xec, 0xff, 0x59, 0x2c, 0xad, 0x66,
+	0x7a, 0xce, 0xf2, 0xe0, 0xd6, 0xe6, 0x9e, 0x08, 0x54, 0x6f, 0x98, 0xcb, 0x39, 0x94, 0xd7, 0x1a,
+	0x64, 0x63, 0xee, 0xcb, 0xbf, 0x7f, 0x5a, 0x9e, 0xf6, 0x14, 0xfc, 0x03, 0x6c, 0x20, 0x89, 0x05,
+	0x07, 0xff, 0x10, 0xc0, 0x2d, 0x57, 0x72, 0x55, 0x9d, 0x54, 0x31, 0x22, 0x7a, 0x82, 0x04, 0x24,
+	0xc3, 0x4a, 0x77, 0x2e, 0x72, 0x32, 0x66, 0x78, 0x71, 0x7c, 0xf6, 0x3a, 0xb3, 0x91, 0x07, 0x23,
+	0xf6, 0x79, 0x92, 0x83, 0xdd, 0x12, 0x31, 0xc3, 0x13, 0x71, 0xd3, 0x9b, 0x08, 0xa8, 0x5e, 0xe3,
+	0x87, 0x87, 0x7f, 0x5a, 0xc0, 0xd9, 0x6a, 0x18, 0xb4, 0xaf, 0xc0, 0xa9, 0x82, 0x9b, 0xd6, 0xd9,
+	0x0c, 0xd4, 0x23, 0xdc, 0xf8, 0xcc, 0x41, 0x75, 0xd4, 0x5c, 0xc9, 0x21, 0x9e, 0x28, 0x1d, 0x8c,
+	0x59, 0xe7, 0x65, 0xd9, 0xac, 0x18, 0xe8, 0x0c, 0xb8, 0x79, 0x8d, 0xa7, 0xeb, 0xb6, 0x3b, 0x5d,
+	0x5c, 0xbd, 0x0d, 0x9c, 0xff, 0x99, 0xeb, 0x85, 0x3a, 0xcd, 0x1b, 0x28, 0x10, 0x39, 0x7a, 0xc3,
+	0xa0, 0x16, 0x72, 0xca, 0xe0, 0x96, 0x2b, 0xb9, 0x0e, 0x70, 0x9e, 0x80, 0xeb, 0x8e, 0xed, 0xa4,
+	0xd0, 0x2d, 0xaa, 0x24, 0x8f, 0x6a, 0xdc, 0x17, 0x15, 0x54, 0x47, 0xec, 0x48, 0xe0, 0xe7, 0x31,
+	0x30, 0x99, 0x27, 0xe5, 0xed, 0x43, 0xad, 0xba, 0xd5, 0xd0, 0x76, 0x38, 0x58, 0x72, 0x28, 0x4a,
+	0x3d, 0x1f, 0x83, 0x61, 0x93, 0x52, 0x08, 0xc7, 0x95, 0x2c, 0xdb, 0x0c, 0xe7, 0xa2, 0x20, 0x27,
+	0x34, 0xe6, 0xca, 0xf6, 0xa2, 0x32, 0xb5, 0x6c, 0x9c, 0xc5, 0xa9, 0x72, 0x1b, 0x1e, 0x9c, 0xb2,
+	0x4a, 0x5f, 0x0c, 0xa7, 0x89, 0x8f, 0xc1, 0x64, 0x50, 0x39, 0x92, 0x71, 0xf3, 0x54, 0xf9, 0xc8,
+	0x20, 0x9a, 0x0b, 0x2f, 0x31, 0x54, 0x27, 0x5c, 0x15, 0xb6, 0xce, 0xb8, 0xb1, 0xc0, 0xca, 0x3c,
+	0xef, 0x29, 0x33, 0x23, 0x61, 0x49, 0x67, 0xd9, 0x96, 0x2c, 0x45, 0xc9, 0x40, 0xf0, 0x1b, 0x01,
+	0xdc, 0x0e, 0x2a, 0x84, 0x9b, 0x34, 0xda, 0x4e, 0x7b, 0x43, 0x1a, 0x7e, 0x7b, 0x50, 0x1d, 0xb3,
+	0x0f, 0x60, 0xb9, 0x87, 0x5f, 0xc4, 0xc0, 0x54, 0x67, 0x54, 0x85, 0x3a, 0x8d, 0x82, 0x8f, 0xbc,
+	0x0f, 0x1f, 0xca, 0x19, 0xf1, 0x51, 0xa8, 0xd3, 0x20, 0x80, 0x7c, 0x08, 0x6e, 0x05, 0x10, 0x33,
+	0x67, 0x85, 0xc7, 0x91, 0x73, 0x21, 0x86, 0x72, 0x3d, 0x54, 0xc7, 0xdb, 0x54, 0xcf, 0xc9, 0xc1,
+	0x73, 0x03, 0xe3, 0x19, 0xe1, 0xc2, 0x37, 0x70, 0xe3, 0x2e, 0x03, 0x08, 0xec, 0x02, 0x10, 0xa6,
+	0xf3, 0xb5, 0x00, 0xee, 0x04, 0xd6, 0xc2, 0x81, 0x48, 0x15, 0xdc, 0x74, 0x8e, 0xe1, 0x41, 0xc8,
+	0xb9, 0xb9, 0xd2, 0x67, 0x0e, 0xaa, 0xa3, 0x3c, 0x23, 0x1c, 0x1f, 0xff, 0x0c, 0x82, 0x59, 0xde,
+	0xe1, 0xac, 0xb8, 0xa8, 0x5e, 0x43, 0xe7, 0xe1, 0x90, 0x48, 0x3d, 0xa1, 0xf7, 0x14, 0xd1, 0x6e,
+	0x9f, 0xbd, 0xa3, 0x88, 0x20, 0x9b, 0x50, 0x9d, 0xb0, 0xdb, 0x72, 0x9b, 0x22, 0xee, 0x31, 0x04,
+	0xdc, 0xed, 0x9c, 0x21, 0x38, 0x0c, 0x58, 0x4a, 0x5d, 0x44, 0xf1, 0x83, 0x00, 0xe6, 0x43, 0x53,
+	0x7e, 0xa5, 0x23, 0x06, 0xfc, 0x35, 0xe6, 0x41, 0xc3, 0x36, 0xdb, 0x3d, 0x17, 0x63, 0x44, 0x42,
+	0xc3, 0x5b, 0x76, 0xbf, 0x36, 0x50, 0x71, 0x57, 0x47, 0xb8, 0xc2, 0xa9, 0x60, 0xb6, 0xd5, 0x4c,
+	0x4f, 0xf9, 0x60, 0x6c, 0xee, 0xdb, 0x9d, 0x38, 0x87, 0xde, 0x61, 0xbf, 0x06, 0xe6, 0x2a, 0xde,
+	0xef, 0x71, 0x2c, 0x84, 0xc5, 0x86, 0x2e, 0x83, 0xc5, 0x4e, 0x47, 0x9c, 0x19, 0xa8, 0x9b, 0x78,
+	0xbe, 0xf5, 0x22, 0xce, 0x5b, 0xd6, 0x2b, 0x24, 0x9f, 0xdf, 0x62, 0x20, 0xc9, 0xa7, 0x24, 0x5f,
+	0x5c, 0x7d, 0xe4, 0x9e, 0xac, 0x7d, 0x4c, 0x56, 0x5c, 0x37, 0xdc, 0x44, 0x7f, 0xe0, 0x8e, 0x80,
+	0x1d, 0x78, 0xa1, 0x4e, 0x2d, 0xc0, 0x05, 0xcc, 0xb4, 0xf1, 0xfe, 0xce, 0xb4, 0x61, 0x53, 0xd0,
+	0xd0, 0x25, 0x4d, 0x41, 0x2b, 0x0c, 0x70, 0x0b, 0x9d, 0xc3, 0x6e, 0x27, 0xe0, 0x0c, 0x04, 0xbf,
+	0x17, 0x40, 0x26, 0xac, 0xae, 0x57, 0x3b, 0x0e, 0xb5, 0x06, 0x81, 0xe8, 0x8a, 0xcc, 0xcd, 0xbd,
+	0xfd, 0x64, 0x38, 0xcf, 0xd0, 0x11, 0xeb, 0xc1, 0xd0, 0xc1, 0xd8, 0xc7, 0x81, 0x8c, 0x8b, 0x7d,
+	0xe2, 0x17, 0x63, 0x9f, 0x00, 0x93, 0x50, 0x1d, 0xe7, 0x48, 0x6c, 0xb3, 0x8f, 0xc4, 0xc0, 0xb0,
+	0x18, 0x02, 0x06, 0x6f, 0xbf, 0x63, 0x61, 0x7f, 0x27, 0x00, 0x18, 0x9e, 0x74, 0x37, 0xff, 0xf8,
+	0x2f, 0x95, 0xd0, 0xd7, 0x4b, 0x05, 0x7f, 0x89, 0x81, 0x19, 0xd7, 0xe7, 0xfd, 0x7b, 0x06, 0xdd,
+	0xdb, 0x44, 0x47, 0x4f, 0x59, 0x8e, 0xff, 0x2b, 0xa3, 0x4f, 0xfb, 0xd3, 0x2d, 0xde, 0x83, 0x4f,
+	0xb7, 0xb0, 0x41, 0x6a, 0xe8, 0x92, 0x06, 0xa9, 0x65, 0x06, 0xac, 0xd7, 0x83, 0x1f, 0x63, 0xa4,
+	0x43, 0x83, 0xee, 0x49, 0x1a, 0x3a, 0x92, 0xcc, 0xb3, 0x33, 0x54, 0xa5, 0x43, 0x8a, 0x77, 0xb5,
+	0x43, 0xd4, 0x8f, 0x71, 0xa7, 0xab, 0xb1, 0xc0, 0x9e, 0xe2, 0x6d, 0x03, 0x95, 0xf7, 0xf5, 0xfe,
+	0xc2, 0xea, 0xf2, 0x5f, 0x59, 0xb6, 0xc0, 0xb8, 0x19, 0x85, 0x46, 0x88, 0x6e, 0x37, 0x52, 0x8b,
+	0x7e, 0xe6, 0xda, 0xd9, 0xf2, 0x4b, 0x40, 0x75, 0x8c, 0x2d, 0x6d, 0xb2, 0x15, 0xab, 0x95, 0xb6,
+	0x01, 0x3c, 0xd4, 0x1b, 0x00, 0x07, 0xb6, 0xc9, 0xe1, 0x2b, 0x6e, 0x93, 0x26, 0x80, 0x29, 0x96,
+	0x88, 0x09, 0x05, 0x8e, 0x60, 0x0a, 0x32, 0x61, 0x38, 0x09, 0x7b, 0x30, 0xba, 0x78, 0xe7, 0x58,
+	0xfb, 0xf9, 0x3a, 0x88, 0xe5, 0x49, 0x39, 0xf1, 0x0c, 0x8c, 0x38, 0x8f, 0xc6, 0xf3, 0x72, 0xd0,
+	0x93, 0xb6, 0xec, 0xba, 0x5e, 0xe2, 0x52, 0x57, 0x11, 0x27, 0xe6, 0x67, 0x60, 0xc4, 0x79, 0x55,
+	0x0c, 0xb7, 0x6c, 0x8b, 0x88, 0x4b, 0x5d, 0x45, 0x5c, 0xf7, 0x79, 0xa2, 0xf3, 0xa1, 0x6b, 0x39,
+	0x54, 0xbf, 0x43, 0x56, 0x5c, 0x3b, 0xbb, 0xac, 0xe3, 0xf4, 0x00, 0x24, 0x02, 0x9e, 0x4f, 0x56,
+	0xce, 0x6a, 0xa9, 0x50, 0xa7, 0xe2, 0x83, 0x08, 0xc2, 0x8e, 0xdf, 0x4f, 0x05, 0x30, 0x1d, 0xf2,
+	0x5d, 0xae, 0x9c, 0x5a, 0x8c, 0x4e, 0x05, 0xf1, 0x61, 0x44, 0x85, 0xc0, 0x20, 0x7c, 0x9f, 0x83,
+	0xdd, 0x83, 0xf0, 0x2a, 0x88, 0x0f, 0x23, 0x2a, 0x38, 0x41, 0x7c, 0x26, 0x80, 0x99, 0xb0, 0x91,
+	0xed, 0xfe, 0xa9, 0xe8, 0x09, 0xd0, 0x10, 0xdf, 0x8c, 0xaa, 0xe1, 0xc4, 0xf1, 0x09, 0x98, 0x0a,
+	0xfe, 0x56, 0x91, 0xbb, 0x9a, 0xf4, 0xc8, 0x8b, 0xeb, 0xd1, 0xe4, 0x9d, 0x00, 0x3e, 0x02, 0x93,
+	0x81, 0xc3, 0x8a, 0xd4, 0xf5, 0x72, 0xba, 0xc5, 0xc5, 0x37, 0x22, 0x89, 0xfb, 0x8f, 0xdf, 0xd9,
+	0xd4, 0xe4, 0xae, 0x37, 0xd8, 0x23, 0x2f, 0xae, 0x47, 0x93, 0xb7, 0x03, 0xc8, 0x3e, 0x7a, 0x71,
+	0x9c, 0x12, 0x5e, 0x1e, 0xa7, 0x84, 0xbf, 0x8e, 0x53, 0xc2, 0x57, 0x27, 0xa9, 0x81, 0x97, 0x27,
+	0xa9, 0x81, 0xdf, 0x4f, 0x52, 0x03, 0xef, 0xdf, 0x77, 0x31, 0x3a, 0xb7, 0x2d, 0xed, 0x6b, 0x25,
+	0x62, 0xff, 0xa2, 0x1c, 0xac, 0xae, 0x2b, 0x0d, 0x8b, 0x90, 0x4d, 0x7e, 0x2f, 0x0d, 0x9b, 0x7f,
+	0x3e, 0x7b, 0xf0, 0xef, 0x00, 0x29, 0x11, 0xfc, 0xe0, 0xec, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -963,6 +1208,8 @@ type MsgClient interface {
 	JoinSwapShareAmountOut(ctx context.Context, in *MsgJoinSwapShareAmountOut, opts ...grpc.CallOption) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(ctx context.Context, in *MsgExitSwapExternAmountOut, opts ...grpc.CallOption) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapShareAmountIn, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInResponse, error)
+	JoinPoolWithAnyToken(ctx context.Context, in *MsgJoinPoolWithAnyToken, opts ...grpc.CallOption) (*MsgJoinPoolWithAnyTokenResponse, error)
+	ExitPoolToSingleToken(ctx context.Context, in *MsgExitPoolToSingleToken, opts ...grpc.CallOption) (*MsgExitPoolToSingleTokenResponse, error)
 }
 
 type msgClient struct {
@@ -1045,6 +1292,24 @@ func (c *msgClient) ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapSh
 	return out, nil
 }
 
+func (c *msgClient) JoinPoolWithAnyToken(ctx context.Context, in *MsgJoinPoolWithAnyToken, opts ...grpc.CallOption) (*MsgJoinPoolWithAnyTokenResponse, error) {
+	out := new(MsgJoinPoolWithAnyTokenResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/JoinPoolWithAnyToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExitPoolToSingleToken(ctx context.Context, in *MsgExitPoolToSingleToken, opts ...grpc.CallOption) (*MsgExitPoolToSingleTokenResponse, error) {
+	out := new(MsgExitPoolToSingleTokenResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/ExitPoolToSingleToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
@@ -1055,6 +1320,8 @@ type MsgServer interface {
 	JoinSwapShareAmountOut(context.Context, *MsgJoinSwapShareAmountOut) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(context.Context, *MsgExitSwapExternAmountOut) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(context.Context, *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error)
+	JoinPoolWithAnyToken(context.Context, *MsgJoinPoolWithAnyToken) (*MsgJoinPoolWithAnyTokenResponse, error)
+	ExitPoolToSingleToken(context.Context, *MsgExitPoolToSingleToken) (*MsgExitPoolToSingleTokenResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1085,6 +1352,12 @@ func (*UnimplementedMsgServer) ExitSwapExternAmountOut(ctx context.Context, req
 func (*UnimplementedMsgServer) ExitSwapShareAmountIn(ctx context.Context, req *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExitSwapShareAmountIn not implemented")
 }
+func (*UnimplementedMsgServer) JoinPoolWithAnyToken(ctx context.Context, req *MsgJoinPoolWithAnyToken) (*MsgJoinPoolWithAnyTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinPoolWithAnyToken not implemented")
+}
+func (*UnimplementedMsgServer) ExitPoolToSingleToken(ctx context.Context, req *MsgExitPoolToSingleToken) (*MsgExitPoolToSingleTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExitPoolToSingleToken not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1234,6 +1507,42 @@ func _Msg_ExitSwapShareAmountIn_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_JoinPoolWithAnyToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinPoolWithAnyToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).JoinPoolWithAnyToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/JoinPoolWithAnyToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinPoolWithAnyToken(ctx, req.(*MsgJoinPoolWithAnyToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExitPoolToSingleToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExitPoolToSingleToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExitPoolToSingleToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/ExitPoolToSingleToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExitPoolToSingleToken(ctx, req.(*MsgExitPoolToSingleToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1270,6 +1579,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExitSwapShareAmountIn",
 			Handler:    _Msg_ExitSwapShareAmountIn_Handler,
 		},
+		{
+			MethodName: "JoinPoolWithAnyToken",
+			Handler:    _Msg_JoinPoolWithAnyToken_Handler,
+		},
+		{
+			MethodName: "ExitPoolToSingleToken",
+			Handler:    _Msg_ExitPoolToSingleToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/tx.proto",
@@ -2037,66 +2354,277 @@ func (m *MsgExitSwapExternAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgJoinPoolWithAnyToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgJoinPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
-	}
-	l = m.ShareOutAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.TokenInMaxs) > 0 {
-		for _, e := range m.TokenInMaxs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+
+func (m *MsgJoinPoolWithAnyToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgJoinPoolWithAnyToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ShareOutAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.TokenIn) > 0 {
-		for _, e := range m.TokenIn {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ShareOutMinAmount.Size()
+		i -= size
+		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *MsgExitPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinPoolWithAnyTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinPoolWithAnyTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinPoolWithAnyTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExitPoolToSingleToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExitPoolToSingleToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExitPoolToSingleToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PoolAssetDenom) > 0 {
+		i -= len(m.PoolAssetDenom)
+		copy(dAtA[i:], m.PoolAssetDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolAssetDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.ShareInAmount.Size()
+		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExitPoolToSingleTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExitPoolToSingleTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExitPoolToSingleTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgJoinPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenInMaxs) > 0 {
+		for _, e := range m.TokenInMaxs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgJoinPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenIn) > 0 {
+		for _, e := range m.TokenIn {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExitPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.PoolId != 0 {
@@ -2328,13 +2856,91 @@ func (m *MsgExitSwapExternAmountOutResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgJoinPoolWithAnyToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ShareOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
+
+func (m *MsgJoinPoolWithAnyTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExitPoolToSingleToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PoolAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExitPoolToSingleTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2565,12 +3171,532 @@ func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = append(m.TokenIn, types.Coin{})
+			if err := m.TokenIn[len(m.TokenIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExitPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExitPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutMins = append(m.TokenOutMins, types.Coin{})
+			if err := m.TokenOutMins[len(m.TokenOutMins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExitPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExitPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = append(m.TokenOut, types.Coin{})
+			if err := m.TokenOut[len(m.TokenOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types1.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2580,23 +3706,23 @@ func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = append(m.TokenIn, types.Coin{})
-			if err := m.TokenIn[len(m.TokenIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2621,7 +3747,7 @@ func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2644,10 +3770,10 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2683,10 +3809,10 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2696,14 +3822,29 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types1.SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2731,13 +3872,13 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2764,8 +3905,7 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutMins = append(m.TokenOutMins, types.Coin{})
-			if err := m.TokenOutMins[len(m.TokenOutMins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2790,7 +3930,7 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2813,17 +3953,17 @@ func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2833,23 +3973,23 @@ func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOut = append(m.TokenOut, types.Coin{})
-			if err := m.TokenOut[len(m.TokenOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2874,7 +4014,7 @@ func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2897,10 +4037,10 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinSwapExternAmountIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinSwapExternAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2936,10 +4076,10 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2949,26 +4089,11 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Routes = append(m.Routes, types1.SwapAmountInRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
@@ -3004,7 +4129,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3032,7 +4157,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3057,7 +4182,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3080,15 +4205,15 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinSwapExternAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinSwapExternAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3116,7 +4241,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3141,7 +4266,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3164,10 +4289,10 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinSwapShareAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinSwapShareAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3203,10 +4328,29 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3216,29 +4360,27 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, types1.SwapAmountOutRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3266,15 +4408,15 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3284,22 +4426,23 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3324,7 +4467,7 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3347,10 +4490,10 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinSwapShareAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinSwapShareAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3408,7 +4551,7 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3431,10 +4574,10 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinSwapExternAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitSwapShareAmountIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinSwapExternAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitSwapShareAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3467,32 +4610,64 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3502,28 +4677,29 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3551,7 +4727,7 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3576,7 +4752,7 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExitSwapShareAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3599,15 +4775,15 @@ func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinSwapExternAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitSwapShareAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinSwapExternAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitSwapShareAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3635,7 +4811,7 @@ func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3660,7 +4836,7 @@ func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgExitSwapExternAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3683,10 +4859,10 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinSwapShareAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitSwapExternAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinSwapShareAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitSwapExternAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3742,41 +4918,9 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3786,29 +4930,28 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInMaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3836,7 +4979,7 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3861,7 +5004,7 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExitSwapExternAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3884,15 +5027,15 @@ func (m *MsgJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinSwapShareAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitSwapExternAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinSwapShareAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitSwapExternAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3920,7 +5063,7 @@ func (m *MsgJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3945,7 +5088,7 @@ func (m *MsgJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinPoolWithAnyToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3968,10 +5111,10 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitSwapShareAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinPoolWithAnyToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitSwapShareAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinPoolWithAnyToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4027,9 +5170,9 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4039,29 +5182,30 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4071,29 +5215,29 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, types1.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4121,7 +5265,7 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4146,7 +5290,7 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitSwapShareAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinPoolWithAnyTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4169,15 +5313,15 @@ func (m *MsgExitSwapShareAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitSwapShareAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinPoolWithAnyTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitSwapShareAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinPoolWithAnyTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4205,7 +5349,7 @@ func (m *MsgExitSwapShareAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4230,7 +5374,7 @@ func (m *MsgExitSwapShareAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitSwapExternAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgExitPoolToSingleToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4253,10 +5397,10 @@ func (m *MsgExitSwapExternAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitSwapExternAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitPoolToSingleToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitSwapExternAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitPoolToSingleToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4312,7 +5456,73 @@ func (m *MsgExitSwapExternAmountOut) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4339,13 +5549,14 @@ func (m *MsgExitSwapExternAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, types1.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4373,7 +5584,7 @@ func (m *MsgExitSwapExternAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4398,7 +5609,7 @@ func (m *MsgExitSwapExternAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitSwapExternAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExitPoolToSingleTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4421,17 +5632,17 @@ func (m *MsgExitSwapExternAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitSwapExternAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitPoolToSingleTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitSwapExternAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitPoolToSingleTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4441,23 +5652,22 @@ func (m *MsgExitSwapExternAmountOutResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3,9 +3,11 @@ package cli
 import (
 	"errors"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v16/x/incentives/types"
@@ -23,6 +25,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCreateGaugeCmd(),
 		NewAddToGaugeCmd(),
+		NewClaimLockRewardsCmd(),
+		NewClaimLockReceiptRewardsCmd(),
 	)
 
 	return cmd
@@ -119,3 +123,27 @@ func NewAddToGaugeCmd() *cobra.Command {
 		Short: "add coins to gauge to distribute more rewards to users",
 	})
 }
+
+func NewClaimLockRewardsCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgClaimLockRewards](&osmocli.TxCliDesc{
+		Use:     "claim-lock-rewards [lock_ids] [flags]",
+		Short:   "claim the rewards accrued by locks and send them to their reward receivers",
+		Example: "osmosisd tx incentives claim-lock-rewards 75,76,77 --from WALLET_NAME",
+	})
+}
+
+func NewClaimLockReceiptRewardsCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgClaimLockReceiptRewards](&osmocli.TxCliDesc{
+		Use:     "claim-lock-receipt-rewards [receipt_denoms] [flags]",
+		Short:   "claim the rewards accrued by held lock receipt tokens",
+		Example: "osmosisd tx incentives claim-lock-receipt-rewards lockup/receipt/gamm/pool/1/336h0m0s --from WALLET_NAME",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"ReceiptDenoms": parseReceiptDenoms,
+		},
+	})
+}
+
+// parseReceiptDenoms parses the comma-separated lock receipt denoms argument.
+func parseReceiptDenoms(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	return strings.Split(arg, ","), osmocli.UsedArg, nil
+}
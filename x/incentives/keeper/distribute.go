@@ -316,6 +316,17 @@ func (k Keeper) distributeInternal(
 		// Get distribution epoch duration. This is used to calculate the emission rate.
 		currentEpoch := k.GetEpochInfo(ctx)
 
+		// The incentive records are funded by the module account. The owner of an external gauge is recorded as
+		// their creator so that it can manage them, the module account is recorded for all other gauges.
+		moduleAddress := k.ak.GetModuleAddress(types.ModuleName)
+		creator := moduleAddress
+		if gauge.Owner != "" {
+			creator, err = sdk.AccAddressFromBech32(gauge.Owner)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		// For every coin in the gauge, calculate the remaining reward per epoch
 		// and create a concentrated liquidity incentive record for it that
 		// is supposed to distribute over that epoch.
@@ -331,7 +342,8 @@ func (k Keeper) distributeInternal(
 
 			_, err := k.clk.CreateIncentive(ctx,
 				pool.GetId(),
-				k.ak.GetModuleAddress(types.ModuleName),
+				moduleAddress,
+				creator,
 				remainCoinPerEpoch,
 				emissionRate,
 				// Use current block time as start time, NOT the gauge start time.
@@ -422,6 +434,8 @@ func (k Keeper) getDistributeToBaseLocks(ctx sdk.Context, gauge types.Gauge, cac
 }
 
 // Distribute distributes coins from an array of gauges to all eligible locks and pools in the case of "NoLock" gauges.
+// Gauges of native denoms distribute to the lock accumulator of their denom and duration, from which locks claim their rewards,
+// while gauges of synthetic denoms send their rewards to the eligible locks directly.
 // CONTRACT: gauges must be active.
 func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, error) {
 	distrInfo := newDistributionInfo()
@@ -431,13 +445,17 @@ func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, er
 
 	for _, gauge := range gauges {
 		var gaugeDistributedCoins sdk.Coins
-		filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
-		// send based on synthetic lockup coins if it's distributing to synthetic lockups
 		var err error
-		if lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) {
+		switch {
+		case lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom):
+			// send based on synthetic lockup coins if it's distributing to synthetic lockups
+			filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
 			gaugeDistributedCoins, err = k.distributeSyntheticInternal(ctx, gauge, filteredLocks, &distrInfo)
-		} else {
-			gaugeDistributedCoins, err = k.distributeInternal(ctx, gauge, filteredLocks, &distrInfo)
+		case gauge.DistributeTo.LockQueryType == lockuptypes.ByDuration:
+			// native locks accrue their rewards in the lock accumulator of the gauge, to be claimed by their owners
+			gaugeDistributedCoins, err = k.distributeToLockAccumulator(ctx, gauge)
+		default:
+			gaugeDistributedCoins, err = k.distributeInternal(ctx, gauge, nil, &distrInfo)
 		}
 		if err != nil {
 			return nil, err
@@ -16,7 +16,7 @@ import (
 var _ = suite.TestingSuite(nil)
 
 // TestDistribute tests that when the distribute command is executed on a provided gauge
-// that the correct amount of rewards is sent to the correct lock owners.
+// that the correct amount of rewards is claimed by the correct lock owners.
 func (s *KeeperTestSuite) TestDistribute() {
 	defaultGauge := perpGaugeDesc{
 		lockDenom:    defaultLPDenom,
@@ -157,6 +157,13 @@ func (s *KeeperTestSuite) TestDistribute() {
 
 		_, err := s.App.IncentivesKeeper.Distribute(s.Ctx, gauges)
 		s.Require().NoError(err)
+		// claim the rewards accrued by all of the locks
+		for _, addr := range addrs {
+			for _, lock := range s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, addr) {
+				_, err := s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, addr, lock.ID)
+				s.Require().NoError(err)
+			}
+		}
 		// check expected rewards against actual rewards received
 		for i, addr := range addrs {
 			bal := s.App.BankKeeper.GetAllBalances(s.Ctx, addr)
@@ -517,6 +524,8 @@ func (s *KeeperTestSuite) TestDistribute_ExternalIncentives_NoLock() {
 					s.Require().Equal(tc.expectedRemainingAmountIncentiveRecord[i], incentiveRecords.IncentiveRecordBody.RemainingCoin.Amount)
 					s.Require().Equal(expectedEmissionRatePerEpoch, incentiveRecords.IncentiveRecordBody.EmissionRate)
 					s.Require().Equal(time.Nanosecond, incentiveRecords.MinUptime)
+					// The owner of the gauge manages the incentive records created from it.
+					s.Require().Equal(s.TestAccs[0].String(), incentiveRecords.IncentiveRecordBody.Creator)
 				}
 
 				// Check that the gauge's distribution state was updated
@@ -596,6 +605,13 @@ func (s *KeeperTestSuite) TestSyntheticDistribute() {
 		addrs := s.SetupUserSyntheticLocks(tc.users)
 		_, err := s.App.IncentivesKeeper.Distribute(s.Ctx, gauges)
 		s.Require().NoError(err)
+		// claim the rewards accrued by all of the locks
+		for _, addr := range addrs {
+			for _, lock := range s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, addr) {
+				_, err := s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, addr, lock.ID)
+				s.Require().NoError(err)
+			}
+		}
 		// check expected rewards against actual rewards received
 		for i, addr := range addrs {
 			var rewards string
@@ -16,6 +16,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v16/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
@@ -184,6 +185,11 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		NumEpochsPaidOver: numEpochsPaidOver,
 	}
 
+	// The owner of an external no lock gauge manages the incentive records created from it.
+	if distrTo.LockQueryType == lockuptypes.NoLock && distrTo.Denom == types.NoLockExternalGaugeDenom(poolId) {
+		gauge.Owner = owner.String()
+	}
+
 	// Fixed gas consumption create gauge based on the number of coins to add
 	ctx.GasMeter().ConsumeGas(uint64(types.BaseGasFeeForCreateGauge*len(gauge.Coins)), "scaling gas cost for creating gauge rewards")
 
@@ -234,6 +240,72 @@ func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins s
 	return nil
 }
 
+// DistributeEarlyUnlockPenalties redistributes the early unlock penalties held by the lockup module account
+// to the remaining lockers of each denom, by adding them to the lock accumulator of the denom and the shortest
+// lockable duration. Penalties are kept by the lockup module while there is no locker to redistribute them to,
+// or on failure, until the next attempt.
+func (k Keeper) DistributeEarlyUnlockPenalties(ctx sdk.Context) {
+	penalties := k.lk.GetEarlyUnlockPenalties(ctx)
+	if penalties.Empty() {
+		return
+	}
+
+	shortestDuration, found := k.getShortestLockableDuration(ctx)
+	if !found {
+		ctx.Logger().Error("no lockable durations to redistribute early unlock penalties over")
+		return
+	}
+
+	lockupModuleAddress := k.ak.GetModuleAddress(lockuptypes.ModuleName)
+	for _, penalty := range penalties {
+		penalty := penalty
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			lockAccum, err := k.getOrCreateLockAccumulator(cacheCtx, penalty.Denom, shortestDuration)
+			if err != nil {
+				return err
+			}
+			totalShares, err := lockAccum.GetTotalShares()
+			if err != nil {
+				return err
+			}
+			if !totalShares.IsPositive() {
+				return nil
+			}
+
+			rewardsPerShare, distributed := splitAccumulatorRewards(sdk.NewCoins(penalty), totalShares)
+			if distributed.Empty() {
+				return nil
+			}
+			if err := k.bk.SendCoinsFromAccountToModule(cacheCtx, lockupModuleAddress, types.ModuleName, distributed); err != nil {
+				return err
+			}
+			if err := k.lk.RemoveEarlyUnlockPenalty(cacheCtx, distributed[0]); err != nil {
+				return err
+			}
+			lockAccum.AddToAccumulator(rewardsPerShare)
+			return nil
+		})
+		if err != nil {
+			ctx.Logger().Error("failed to redistribute early unlock penalty", "denom", penalty.Denom, "error", err.Error())
+		}
+	}
+}
+
+// getShortestLockableDuration returns the shortest of the lockable durations, if any.
+func (k Keeper) getShortestLockableDuration(ctx sdk.Context) (time.Duration, bool) {
+	durations := k.GetLockableDurations(ctx)
+	if len(durations) == 0 {
+		return 0, false
+	}
+	shortestDuration := durations[0]
+	for _, duration := range durations {
+		if duration < shortestDuration {
+			shortestDuration = duration
+		}
+	}
+	return shortestDuration, true
+}
+
 // GetGaugeByID returns gauge from gauge ID.
 func (k Keeper) GetGaugeByID(ctx sdk.Context, gaugeID uint64) (*types.Gauge, error) {
 	gauge := types.Gauge{}
@@ -427,6 +427,34 @@ func (s *KeeperTestSuite) TestAddToGaugeRewards() {
 	}
 }
 
+// TestAddToGaugeRewards_ExternalNoLockGauge tests that external no lock gauges record their owner,
+// and that any account can add to them without changing the owner.
+func (s *KeeperTestSuite) TestAddToGaugeRewards_ExternalNoLockGauge() {
+	s.SetupTest()
+	owner, other := s.TestAccs[0], s.TestAccs[1]
+	clPool := s.PrepareConcentratedPool()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000))
+	s.FundAcc(owner, coins.Add(coins...))
+	s.FundAcc(other, coins)
+
+	gaugeId, err := s.App.IncentivesKeeper.CreateGauge(s.Ctx, false, owner, coins, lockuptypes.QueryCondition{LockQueryType: lockuptypes.NoLock}, s.Ctx.BlockTime(), 1, clPool.GetId())
+	s.Require().NoError(err)
+	gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeId)
+	s.Require().NoError(err)
+	s.Require().Equal(owner.String(), gauge.Owner)
+
+	// Both the owner and other accounts can add to the gauge, while the owner is unchanged.
+	err = s.App.IncentivesKeeper.AddToGaugeRewards(s.Ctx, other, coins, gaugeId)
+	s.Require().NoError(err)
+
+	err = s.App.IncentivesKeeper.AddToGaugeRewards(s.Ctx, owner, coins, gaugeId)
+	s.Require().NoError(err)
+	gauge, err = s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeId)
+	s.Require().NoError(err)
+	s.Require().Equal(coins.Add(coins...).Add(coins...), gauge.Coins)
+	s.Require().Equal(owner.String(), gauge.Owner)
+}
+
 // TestCreateGauge_NoLockGauges tests the CreateGauge function
 // specifically focusing on the no lock gauge type and test cases around it.
 // It tests the following:
@@ -582,3 +610,45 @@ func (s *KeeperTestSuite) TestCreateGauge_NoLockGauges() {
 		})
 	}
 }
+
+// TestDistributeEarlyUnlockPenalties tests that early unlock penalties pending redistribution are added
+// to the lock accumulator of their denom, to be claimed by the remaining lockers of the denom.
+func (s *KeeperTestSuite) TestDistributeEarlyUnlockPenalties() {
+	s.SetupTest()
+
+	lockupParams := s.App.LockupKeeper.GetParams(s.Ctx)
+	lockupParams.EarlyUnlockConfigs = []lockuptypes.EarlyUnlockConfig{{
+		Denom:                 defaultLPDenom,
+		MaxPenalty:            sdk.NewDecWithPrec(5, 1),
+		RedistributeToLockers: true,
+	}}
+	s.App.LockupKeeper.SetParams(s.Ctx, lockupParams)
+
+	addrs := s.SetupManyLocks(2, defaultLiquidTokens, defaultLPTokens, defaultLockDuration)
+	locks := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, addrs[0])
+	s.Require().Len(locks, 1)
+	penalty, err := s.App.LockupKeeper.InstantUnlock(s.Ctx, locks[0].ID, addrs[0], nil)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(defaultLPDenom, 5)), penalty)
+
+	// the epoch end adds the penalty to the lock accumulator, without creating any gauge
+	err = s.App.IncentivesKeeper.AfterEpochEnd(s.Ctx, s.App.IncentivesKeeper.GetParams(s.Ctx).DistrEpochIdentifier, 1)
+	s.Require().NoError(err)
+	s.Require().True(s.App.LockupKeeper.GetEarlyUnlockPenalties(s.Ctx).Empty())
+	s.Require().Empty(s.App.IncentivesKeeper.GetGauges(s.Ctx))
+
+	// the remaining locker claims the penalty
+	locks = s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, addrs[1])
+	s.Require().Len(locks, 1)
+	claimed, err := s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, addrs[1], locks[0].ID)
+	s.Require().NoError(err)
+	s.Require().Equal(penalty, claimed)
+	s.Require().Equal(penalty.AmountOf(defaultLPDenom), s.App.BankKeeper.GetBalance(s.Ctx, addrs[1], defaultLPDenom).Amount)
+
+	// the penalty is kept pending redistribution while there is no locker to redistribute it to
+	_, err = s.App.LockupKeeper.InstantUnlock(s.Ctx, locks[0].ID, addrs[1], nil)
+	s.Require().NoError(err)
+	err = s.App.IncentivesKeeper.AfterEpochEnd(s.Ctx, s.App.IncentivesKeeper.GetParams(s.Ctx).DistrEpochIdentifier, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(penalty, s.App.LockupKeeper.GetEarlyUnlockPenalties(s.Ctx))
+}
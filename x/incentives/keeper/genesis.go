@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	"github.com/osmosis-labs/osmosis/v16/x/incentives/types"
 )
 
@@ -18,14 +22,120 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 	}
 	k.SetLastGaugeID(ctx, genState.LastGaugeId)
+	for _, accumObject := range genState.LockReceiptAccumulators {
+		if err := k.initAccumulator(ctx, accumObject); err != nil {
+			panic(err)
+		}
+		receiptDenom := strings.TrimPrefix(accumObject.Name, types.KeyLockReceiptAccumulator(""))
+		ctx.KVStore(k.storeKey).Set(lockReceiptAccumulatorStoreKey(receiptDenom), []byte{1})
+	}
+	for _, accumObject := range genState.LockAccumulators {
+		denom, duration, err := types.ParseLockAccumulatorName(accumObject.Name)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.initAccumulator(ctx, accumObject); err != nil {
+			panic(err)
+		}
+		info := types.LockableDurationsInfo{LockableDurations: append(k.GetLockAccumulatorDurations(ctx, denom), duration)}
+		osmoutils.MustSet(ctx.KVStore(k.storeKey), lockAccumulatorDurationsStoreKey(denom), &info)
+	}
+	if genState.SettledLockRewardsAccumulator != nil {
+		if err := k.initAccumulator(ctx, *genState.SettledLockRewardsAccumulator); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the x/incentives module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	lockReceiptAccumulators := []types.AccumObject{}
+	for _, receiptDenom := range k.GetLockReceiptAccumulatorDenoms(ctx) {
+		accumObject, err := k.exportAccumulator(ctx, types.KeyLockReceiptAccumulator(receiptDenom))
+		if err != nil {
+			panic(err)
+		}
+		lockReceiptAccumulators = append(lockReceiptAccumulators, accumObject)
+	}
+
+	lockAccumulators := []types.AccumObject{}
+	for _, denom := range k.getLockAccumulatorDenoms(ctx) {
+		for _, duration := range k.GetLockAccumulatorDurations(ctx, denom) {
+			accumObject, err := k.exportAccumulator(ctx, types.KeyLockAccumulator(denom, duration))
+			if err != nil {
+				panic(err)
+			}
+			lockAccumulators = append(lockAccumulators, accumObject)
+		}
+	}
+
+	var settledLockRewardsAccumulator *types.AccumObject
+	if _, err := k.GetSettledLockRewardsAccumulator(ctx); err == nil {
+		accumObject, err := k.exportAccumulator(ctx, types.SettledLockRewardsAccumulator)
+		if err != nil {
+			panic(err)
+		}
+		settledLockRewardsAccumulator = &accumObject
+	}
+
 	return &types.GenesisState{
-		Params:            k.GetParams(ctx),
-		LockableDurations: k.GetLockableDurations(ctx),
-		Gauges:            k.GetNotFinishedGauges(ctx),
-		LastGaugeId:       k.GetLastGaugeID(ctx),
+		Params:                        k.GetParams(ctx),
+		LockableDurations:             k.GetLockableDurations(ctx),
+		Gauges:                        k.GetNotFinishedGauges(ctx),
+		LastGaugeId:                   k.GetLastGaugeID(ctx),
+		LockReceiptAccumulators:       lockReceiptAccumulators,
+		LockAccumulators:              lockAccumulators,
+		SettledLockRewardsAccumulator: settledLockRewardsAccumulator,
+	}
+}
+
+// initAccumulator creates the given accumulator along with its positions.
+func (k Keeper) initAccumulator(ctx sdk.Context, accumObject types.AccumObject) error {
+	store := ctx.KVStore(k.storeKey)
+	accumContent := accumObject.GetAccumContent()
+	if accumContent == nil {
+		accumContent = &accum.AccumulatorContent{AccumValue: sdk.NewDecCoins(), TotalShares: sdk.ZeroDec()}
+	}
+	if err := accum.MakeAccumulatorWithValueAndShare(store, accumObject.Name, accumContent.AccumValue, accumContent.TotalShares); err != nil {
+		return err
 	}
+	for _, position := range accumObject.Positions {
+		position := position
+		osmoutils.MustSet(store, accum.FormatPositionPrefixKey(accumObject.Name, position.Name), &position.Record)
+	}
+	return nil
+}
+
+// exportAccumulator returns the accumulator with the given name along with its positions.
+func (k Keeper) exportAccumulator(ctx sdk.Context, name string) (types.AccumObject, error) {
+	store := ctx.KVStore(k.storeKey)
+	accumulator, err := accum.GetAccumulator(store, name)
+	if err != nil {
+		return types.AccumObject{}, err
+	}
+	totalShares, err := accumulator.GetTotalShares()
+	if err != nil {
+		return types.AccumObject{}, err
+	}
+
+	prefix := accum.FormatPositionPrefixKey(name, "")
+	positions, err := osmoutils.GatherValuesFromStorePrefixWithKeyParser(store, prefix, func(key []byte, value []byte) (types.AccumPosition, error) {
+		record := accum.Record{}
+		if err := record.Unmarshal(value); err != nil {
+			return types.AccumPosition{}, err
+		}
+		return types.AccumPosition{Name: string(key[len(prefix):]), Record: record}, nil
+	})
+	if err != nil {
+		return types.AccumObject{}, err
+	}
+
+	return types.AccumObject{
+		Name: name,
+		AccumContent: &accum.AccumulatorContent{
+			AccumValue:  accumulator.GetValue(),
+			TotalShares: totalShares,
+		},
+		Positions: positions,
+	}, nil
 }
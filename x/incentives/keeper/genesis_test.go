@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	osmoapp "github.com/osmosis-labs/osmosis/v16/app"
 
 	"github.com/osmosis-labs/osmosis/v16/x/incentives/types"
@@ -113,3 +114,78 @@ func TestIncentivesInitGenesis(t *testing.T) {
 	require.Len(t, gauges, 1)
 	require.Equal(t, gauges[0], gauge)
 }
+
+// TestLockReceiptAccumulatorsGenesis tests that the lock receipt accumulators and their positions
+// are initialized from genesis, and exported back as is.
+func TestLockReceiptAccumulatorsGenesis(t *testing.T) {
+	app := osmoapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	receiptDenom := lockuptypes.LockReceiptDenom("lptoken", time.Second)
+	genesis := app.IncentivesKeeper.ExportGenesis(ctx)
+	require.Empty(t, genesis.LockReceiptAccumulators)
+
+	holder := sdk.AccAddress([]byte("addr1---------------"))
+	rewardsPerShare := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 1)))
+	genesis.LockReceiptAccumulators = []types.AccumObject{{
+		Name: types.KeyLockReceiptAccumulator(receiptDenom),
+		AccumContent: &accum.AccumulatorContent{
+			AccumValue:  rewardsPerShare,
+			TotalShares: sdk.NewDec(10),
+		},
+		Positions: []types.AccumPosition{{
+			Name: holder.String(),
+			Record: accum.Record{
+				NumShares:             sdk.NewDec(10),
+				AccumValuePerShare:    sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(2, 1))),
+				UnclaimedRewardsTotal: sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)),
+			},
+		}},
+	}}
+	app.IncentivesKeeper.InitGenesis(ctx, *genesis)
+
+	require.Equal(t, []string{receiptDenom}, app.IncentivesKeeper.GetLockReceiptAccumulatorDenoms(ctx))
+	lockReceiptAccum, err := app.IncentivesKeeper.GetLockReceiptAccumulator(ctx, receiptDenom)
+	require.NoError(t, err)
+	shares, err := lockReceiptAccum.GetPositionSize(holder.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10), shares)
+
+	require.Equal(t, genesis, app.IncentivesKeeper.ExportGenesis(ctx))
+}
+
+// TestLockAccumulatorsGenesis tests that the lock accumulators with their durations by denom, and the settled lock rewards,
+// are exported to genesis and initialized back from it.
+func (s *KeeperTestSuite) TestLockAccumulatorsGenesis() {
+	s.SetupTest()
+	owner := s.TestAccs[0]
+	lockCoins := sdk.Coins{sdk.NewInt64Coin("lptoken", 10)}
+
+	s.LockTokens(owner, lockCoins, defaultLockDuration)
+	s.LockTokens(owner, lockCoins, 2*defaultLockDuration)
+	locks := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, owner)
+	gaugeID := s.setupActiveLockRewardsGauge(sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	s.distributeGauge(gaugeID)
+	_, gauge, _, startTime := s.setupNewGaugeWithDuration(true, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, 2*defaultLockDuration, "lptoken")
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	err := s.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(s.Ctx, *gauge)
+	s.Require().NoError(err)
+	s.distributeGauge(gauge.Id)
+
+	// adding tokens to a lock settles its rewards for the owner
+	s.FundAcc(owner, lockCoins)
+	_, err = s.App.LockupKeeper.AddTokensToLockByID(s.Ctx, locks[0].ID, owner, lockCoins[0])
+	s.Require().NoError(err)
+
+	genesis := s.App.IncentivesKeeper.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.LockAccumulators, 2)
+	s.Require().NotNil(genesis.SettledLockRewardsAccumulator)
+	s.Require().Len(genesis.SettledLockRewardsAccumulator.Positions, 1)
+
+	s.SetupTest()
+	s.App.IncentivesKeeper.InitGenesis(s.Ctx, *genesis)
+	s.Require().Equal(
+		[]time.Duration{defaultLockDuration, 2 * defaultLockDuration},
+		s.App.IncentivesKeeper.GetLockAccumulatorDurations(s.Ctx, "lptoken"))
+	s.Require().Equal(genesis, s.App.IncentivesKeeper.ExportGenesis(s.Ctx))
+}
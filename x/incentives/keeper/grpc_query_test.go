@@ -450,16 +450,16 @@ func (s *KeeperTestSuite) TestGRPCToDistributeCoins() {
 	// distribute coins to stakers
 	distrCoins, err := s.querier.Distribute(s.Ctx, gauges)
 	s.Require().NoError(err)
-	s.Require().Equal(distrCoins, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	s.Require().Equal(distrCoins, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
 
 	// check gauge changes after distribution
 	// ensure the gauge's filled epochs have been increased by 1
-	// ensure we have distributed 4 out of the 10 stake tokens
+	// ensure we have distributed 5 out of the 10 stake tokens
 	gauge, err = s.querier.GetGaugeByID(s.Ctx, gaugeID)
 	s.Require().NoError(err)
 	s.Require().NotNil(gauge)
 	s.Require().Equal(gauge.FilledEpochs, uint64(1))
-	s.Require().Equal(gauge.DistributedCoins, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	s.Require().Equal(gauge.DistributedCoins, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
 	gauges = []types.Gauge{*gauge}
 
 	// move gauge from an upcoming to an active status
@@ -467,7 +467,7 @@ func (s *KeeperTestSuite) TestGRPCToDistributeCoins() {
 	err = s.querier.MoveUpcomingGaugeToActiveGauge(s.Ctx, *gauge)
 	s.Require().NoError(err)
 
-	// check that the to distribute coins is equal to the initial gauge coin balance minus what has been distributed already (10-4=6)
+	// check that the to distribute coins is equal to the initial gauge coin balance minus what has been distributed already (10-5=5)
 	res, err = s.querier.ModuleToDistributeCoins(sdk.WrapSDKContext(s.Ctx), &types.ModuleToDistributeCoinsRequest{})
 	s.Require().NoError(err)
 	s.Require().Equal(res.Coins, coins.Sub(distrCoins))
@@ -475,9 +475,9 @@ func (s *KeeperTestSuite) TestGRPCToDistributeCoins() {
 	// distribute second round to stakers
 	distrCoins, err = s.querier.Distribute(s.Ctx, gauges)
 	s.Require().NoError(err)
-	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, distrCoins)
+	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 5)}, distrCoins)
 
-	// now that all coins have been distributed (4 in first found 6 in the second round)
+	// now that all coins have been distributed (5 in first found 5 in the second round)
 	// to distribute coins should be null
 	res, err = s.querier.ModuleToDistributeCoins(sdk.WrapSDKContext(s.Ctx), &types.ModuleToDistributeCoinsRequest{})
 	s.Require().NoError(err)
@@ -509,20 +509,20 @@ func (s *KeeperTestSuite) TestGRPCDistributedCoins() {
 	// distribute coins to stakers
 	distrCoins, err := s.querier.Distribute(s.Ctx, gauges)
 	s.Require().NoError(err)
-	s.Require().Equal(distrCoins, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	s.Require().Equal(distrCoins, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
 
 	// check gauge changes after distribution
 	// ensure the gauge's filled epochs have been increased by 1
-	// ensure we have distributed 4 out of the 10 stake tokens
+	// ensure we have distributed 5 out of the 10 stake tokens
 	gauge, err = s.querier.GetGaugeByID(s.Ctx, gaugeID)
 	s.Require().NoError(err)
 	s.Require().NotNil(gauge)
 	s.Require().Equal(gauge.FilledEpochs, uint64(1))
-	s.Require().Equal(gauge.DistributedCoins, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	s.Require().Equal(gauge.DistributedCoins, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
 	gauges = []types.Gauge{*gauge}
 
 	// distribute second round to stakers
 	distrCoins, err = s.querier.Distribute(s.Ctx, gauges)
 	s.Require().NoError(err)
-	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, distrCoins)
+	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 5)}, distrCoins)
 }
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/osmosis-labs/osmosis/v16/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
 	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // BeforeEpochStart is the epoch start hook.
@@ -17,6 +20,13 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	params := k.GetParams(ctx)
 	if epochIdentifier == params.DistrEpochIdentifier {
+		// send the rewards settled when locks were updated or deleted
+		k.SendSettledLockRewards(ctx)
+		// redistribute early unlock penalties to the remaining lockers
+		k.DistributeEarlyUnlockPenalties(ctx)
+		// redistribute the rewards of pooled locks to the holders of their lock receipt tokens
+		k.DistributeLockReceiptRewards(ctx)
+
 		// begin distribution if it's start time
 		gauges := k.GetUpcomingGauges(ctx)
 		for _, gauge := range gauges {
@@ -58,7 +68,11 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooks  = Hooks{}
+	_ lockuptypes.LockupHooks = Hooks{}
+	_ banktypes.BankHooks     = Hooks{}
+)
 
 // Hooks returns the hook wrapper struct.
 func (k Keeper) Hooks() Hooks {
@@ -74,3 +88,52 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+// lockup hooks
+// Lock positions in the lock accumulators are updated whenever a lock is stored or deleted,
+// which covers all of the other lockup hooks.
+func (h Hooks) AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins) {
+}
+
+func (h Hooks) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+}
+
+func (h Hooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+}
+
+func (h Hooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+}
+
+func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins) {
+}
+
+func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration, newDuration time.Duration) {
+}
+
+// BeforeLockUpdated settles the rewards accrued by the lock, and updates its positions in the lock accumulators.
+// Panics on failure, as the positions would otherwise no longer match the coins of the lock.
+func (h Hooks) BeforeLockUpdated(ctx sdk.Context, lock lockuptypes.PeriodLock) {
+	if err := h.k.beforeLockUpdated(ctx, lock); err != nil {
+		panic(err)
+	}
+}
+
+// BeforeLockDeleted settles the rewards accrued by the lock, and removes its positions from the lock accumulators.
+// Panics on failure, as the positions would otherwise outlive the lock.
+func (h Hooks) BeforeLockDeleted(ctx sdk.Context, lockID uint64) {
+	if err := h.k.beforeLockDeleted(ctx, lockID); err != nil {
+		panic(err)
+	}
+}
+
+// bank hooks
+
+// TrackBeforeSend updates the positions of the sender and the recipient of lock receipt tokens
+// in the lock receipt accumulators.
+func (h Hooks) TrackBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) {
+	h.k.trackLockReceiptSend(ctx, from, to, amount)
+}
+
+func (h Hooks) BlockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	return nil
+}
@@ -0,0 +1,60 @@
+package keeper
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/incentives/types"
+)
+
+// RegisterInvariants registers all incentives invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "lock-accumulators-invariant", LockAccumulatorsInvariant(keeper))
+}
+
+// LockAccumulatorsInvariant ensures that the position of each lock in the lock accumulator of a denom and duration
+// is equal to its amount of the denom if it is locked for the duration or longer, and that there is no other position.
+func LockAccumulatorsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, denom := range keeper.getLockAccumulatorDenoms(ctx) {
+			for _, duration := range keeper.GetLockAccumulatorDurations(ctx, denom) {
+				lockAccum, err := keeper.GetLockAccumulator(ctx, denom, duration)
+				if err != nil {
+					panic(err)
+				}
+				totalShares, err := lockAccum.GetTotalShares()
+				if err != nil {
+					panic(err)
+				}
+
+				lockedSum := sdk.ZeroDec()
+				for _, lock := range keeper.lk.GetLocksLongerThanDurationDenom(ctx, denom, duration) {
+					shares := sdk.NewDecFromInt(lock.Coins.AmountOfNoDenomValidation(denom))
+					if !shares.IsPositive() {
+						continue
+					}
+					positionSize, err := lockAccum.GetPositionSize(types.LockPositionName(lock.ID))
+					if err != nil || !positionSize.Equal(shares) {
+						return sdk.FormatInvariant(types.ModuleName, "lock-accumulators-invariant",
+							fmt.Sprintf("\tposition of lock %d in the lock accumulator of %s and %s does not fit its amount: %s\n",
+								lock.ID, denom, duration, shares.String(),
+							)), true
+					}
+					lockedSum = lockedSum.Add(shares)
+				}
+
+				if !totalShares.Equal(lockedSum) {
+					return sdk.FormatInvariant(types.ModuleName, "lock-accumulators-invariant",
+						fmt.Sprintf("\tlock accumulator of %s and %s shares do not fit the eligible locks sum: %s != %s\n",
+							denom, duration, totalShares.String(), lockedSum.String(),
+						)), true
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "lock-accumulators-invariant", "All lock accumulators invariant passed"), false
+	}
+}
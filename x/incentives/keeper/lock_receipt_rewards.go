@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v16/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+)
+
+// Lock receipt tokens earn the rewards of the pooled lock backing them for as long as they are held.
+// There is a lock receipt accumulator per receipt denom, in which every account holding receipt tokens has a position,
+// with its balance of receipt tokens as shares. Positions are kept up to date by the bank send hooks, and the rewards
+// claimed by the pooled lock are added to the accumulator at every distribution epoch, to be claimed by the holders.
+// Module accounts have no position as they can not claim, which includes the lockup module account holding
+// the locked receipt tokens. Their share of the rewards goes to the other holders.
+
+// GetLockReceiptAccumulator returns the lock receipt accumulator of the given lock receipt denom.
+// Returns error if the accumulator does not exist.
+func (k Keeper) GetLockReceiptAccumulator(ctx sdk.Context, receiptDenom string) (accum.AccumulatorObject, error) {
+	return accum.GetAccumulator(ctx.KVStore(k.storeKey), types.KeyLockReceiptAccumulator(receiptDenom))
+}
+
+// GetLockReceiptAccumulatorDenoms returns the lock receipt denoms that have a lock receipt accumulator.
+func (k Keeper) GetLockReceiptAccumulatorDenoms(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := combineKeys(types.KeyPrefixLockReceiptAccumulators, []byte{})
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	receiptDenoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		receiptDenoms = append(receiptDenoms, string(iterator.Key()[len(prefix):]))
+	}
+	return receiptDenoms
+}
+
+// getOrCreateLockReceiptAccumulator returns the lock receipt accumulator of the given lock receipt denom,
+// creating it if it does not exist yet. Receipt tokens are minted through a send, so the accumulator
+// is created before any holder needs a position in it.
+func (k Keeper) getOrCreateLockReceiptAccumulator(ctx sdk.Context, receiptDenom string) (accum.AccumulatorObject, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(lockReceiptAccumulatorStoreKey(receiptDenom)) {
+		if err := accum.MakeAccumulator(store, types.KeyLockReceiptAccumulator(receiptDenom)); err != nil {
+			return accum.AccumulatorObject{}, err
+		}
+		store.Set(lockReceiptAccumulatorStoreKey(receiptDenom), []byte{1})
+	}
+	return k.GetLockReceiptAccumulator(ctx, receiptDenom)
+}
+
+// ClaimLockReceiptRewards claims the rewards accrued by the lock receipt tokens of the given denom held by the owner,
+// and sends them to the owner. Returns the claimed rewards.
+func (k Keeper) ClaimLockReceiptRewards(ctx sdk.Context, owner sdk.AccAddress, receiptDenom string) (sdk.Coins, error) {
+	if _, _, err := lockuptypes.ParseLockReceiptDenom(receiptDenom); err != nil {
+		return nil, err
+	}
+	lockReceiptAccum, err := k.getOrCreateLockReceiptAccumulator(ctx, receiptDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// sends that do not call the bank send hooks are not tracked, so the position is synced to the balance of the owner first.
+	if k.isLockReceiptHolder(ctx, owner) {
+		balance := k.bk.GetBalance(ctx, owner, receiptDenom).Amount
+		if err := setLockReceiptPosition(lockReceiptAccum, owner, balance); err != nil {
+			return nil, err
+		}
+	}
+
+	name := owner.String()
+	hasPosition, err := lockReceiptAccum.HasPosition(name)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPosition {
+		return sdk.NewCoins(), nil
+	}
+
+	// the dust of the claimed rewards is kept by the module account.
+	rewards, _, err := lockReceiptAccum.ClaimRewards(name)
+	if err != nil {
+		return nil, err
+	}
+	if rewards.Empty() {
+		return rewards, nil
+	}
+
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, rewards); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtDistribution,
+			sdk.NewAttribute(types.AttributeReceiver, name),
+			sdk.NewAttribute(types.AttributeAmount, rewards.String()),
+		),
+	})
+
+	return rewards, nil
+}
+
+// DistributeLockReceiptRewards claims the rewards accrued by the pooled locks backing lock receipt tokens
+// into their rewards addresses, and adds them to the lock receipt accumulators to be claimed by the receipt holders.
+// Rewards are kept by the rewards address while there is no holder to distribute them to, or on failure,
+// until the next attempt.
+func (k Keeper) DistributeLockReceiptRewards(ctx sdk.Context) {
+	for _, lock := range k.lk.GetLockReceiptPooledLocks(ctx) {
+		lock := lock
+		receiptDenom := lockuptypes.LockReceiptDenom(lock.Coins[0].Denom, lock.Duration)
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.distributeLockReceiptRewards(cacheCtx, lock, receiptDenom)
+		})
+		if err != nil {
+			ctx.Logger().Error("failed to distribute lock receipt rewards", "denom", receiptDenom, "error", err.Error())
+		}
+	}
+}
+
+// distributeLockReceiptRewards claims the rewards accrued by the given pooled lock into the rewards address of the given
+// lock receipt denom, and moves the rewards address balance into the lock receipt accumulator.
+func (k Keeper) distributeLockReceiptRewards(ctx sdk.Context, pooledLock lockuptypes.PeriodLock, receiptDenom string) error {
+	if _, err := k.claimLockRewards(ctx, pooledLock); err != nil {
+		return err
+	}
+
+	rewardsAddress := lockuptypes.LockReceiptRewardsAddress(receiptDenom)
+	rewards := k.bk.GetAllBalances(ctx, rewardsAddress)
+	if rewards.Empty() {
+		return nil
+	}
+
+	lockReceiptAccum, err := k.getOrCreateLockReceiptAccumulator(ctx, receiptDenom)
+	if err != nil {
+		return err
+	}
+	totalShares, err := lockReceiptAccum.GetTotalShares()
+	if err != nil {
+		return err
+	}
+	if !totalShares.IsPositive() {
+		return nil
+	}
+
+	rewardsPerShare, distributed := splitAccumulatorRewards(rewards, totalShares)
+	if distributed.Empty() {
+		return nil
+	}
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, rewardsAddress, types.ModuleName, distributed); err != nil {
+		return err
+	}
+	lockReceiptAccum.AddToAccumulator(rewardsPerShare)
+	return nil
+}
+
+// trackLockReceiptSend updates the positions of the sender and the recipient of lock receipt tokens
+// in the lock receipt accumulators. It is called before the balances are updated, so the positions are set
+// to the balances after the send. Failures are logged rather than returned, so that sends never fail because
+// of them, and the positions are synced again when claiming.
+func (k Keeper) trackLockReceiptSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) {
+	if from.Equals(to) {
+		return
+	}
+	for _, coin := range amount {
+		if !lockuptypes.IsLockReceiptDenom(coin.Denom) {
+			continue
+		}
+		coin := coin
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			lockReceiptAccum, err := k.getOrCreateLockReceiptAccumulator(cacheCtx, coin.Denom)
+			if err != nil {
+				return err
+			}
+			if k.isLockReceiptHolder(cacheCtx, from) {
+				// the send fails on insufficient funds, so the position is left as is.
+				balance := k.bk.GetBalance(cacheCtx, from, coin.Denom).Amount.Sub(coin.Amount)
+				if !balance.IsNegative() {
+					if err := setLockReceiptPosition(lockReceiptAccum, from, balance); err != nil {
+						return err
+					}
+				}
+			}
+			if k.isLockReceiptHolder(cacheCtx, to) {
+				balance := k.bk.GetBalance(cacheCtx, to, coin.Denom).Amount.Add(coin.Amount)
+				if err := setLockReceiptPosition(lockReceiptAccum, to, balance); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			ctx.Logger().Error("failed to track lock receipt send", "denom", coin.Denom, "error", err.Error())
+		}
+	}
+}
+
+// isLockReceiptHolder returns true if the given address can have a position in the lock receipt accumulators,
+// that is if it is not a module account.
+func (k Keeper) isLockReceiptHolder(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, isModuleAccount := k.ak.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return !isModuleAccount
+}
+
+// setLockReceiptPosition sets the position of the given holder in the given lock receipt accumulator to its balance
+// of receipt tokens. The rewards accrued by the position are kept as unclaimed rewards, and the position is removed
+// once it has neither shares nor claimable rewards left.
+func setLockReceiptPosition(lockReceiptAccum accum.AccumulatorObject, holder sdk.AccAddress, balance sdk.Int) error {
+	name := holder.String()
+	shares := sdk.NewDecFromInt(balance)
+	hasPosition, err := lockReceiptAccum.HasPosition(name)
+	if err != nil {
+		return err
+	}
+	if !hasPosition {
+		if !shares.IsPositive() {
+			return nil
+		}
+		return lockReceiptAccum.NewPosition(name, shares, nil)
+	}
+
+	prevShares, err := lockReceiptAccum.GetPositionSize(name)
+	if err != nil {
+		return err
+	}
+	if !prevShares.Equal(shares) {
+		if err := lockReceiptAccum.UpdatePosition(name, shares.Sub(prevShares)); err != nil {
+			return err
+		}
+	}
+	if !shares.IsZero() {
+		return nil
+	}
+
+	position, err := lockReceiptAccum.GetPosition(name)
+	if err != nil {
+		return err
+	}
+	if unclaimed, _ := position.UnclaimedRewardsTotal.TruncateDecimal(); unclaimed.Empty() {
+		_, err = lockReceiptAccum.DeletePosition(name)
+	}
+	return err
+}
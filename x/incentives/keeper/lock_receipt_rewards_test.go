@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+)
+
+// TestLockReceiptRewards tests that the holders of lock receipt tokens accrue the rewards of the pooled lock
+// for as long as they hold them, without any gauge being created, and that module accounts do not accrue them.
+func (s *KeeperTestSuite) TestLockReceiptRewards() {
+	s.SetupTest()
+	distrEpochIdentifier := s.App.IncentivesKeeper.GetParams(s.Ctx).DistrEpochIdentifier
+
+	addrs := s.SetupManyLocks(1, defaultLiquidTokens, defaultLPTokens, defaultLockDuration)
+	holder, other := addrs[0], s.TestAccs[1]
+	locks := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, holder)
+	s.Require().Len(locks, 1)
+	receipt, err := s.App.LockupKeeper.TokenizeLock(s.Ctx, locks[0].ID, holder)
+	s.Require().NoError(err)
+	rewardsAddress := lockuptypes.LockReceiptRewardsAddress(receipt.Denom)
+
+	// the holder sends half of its receipt tokens, and the pooled lock earns rewards
+	half := sdk.NewCoin(receipt.Denom, receipt.Amount.QuoRaw(2))
+	err = s.App.BankKeeper.SendCoins(s.Ctx, holder, other, sdk.NewCoins(half))
+	s.Require().NoError(err)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	s.FundAcc(rewardsAddress, rewards)
+
+	// the epoch end adds the rewards to the lock receipt accumulator
+	err = s.App.IncentivesKeeper.AfterEpochEnd(s.Ctx, distrEpochIdentifier, 1)
+	s.Require().NoError(err)
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, rewardsAddress).Empty())
+	s.Require().Empty(s.App.IncentivesKeeper.GetGauges(s.Ctx))
+
+	// both holders claim their share of the rewards
+	for _, addr := range []sdk.AccAddress{holder, other} {
+		balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, addr, "stake")
+		claimed, err := s.App.IncentivesKeeper.ClaimLockReceiptRewards(s.Ctx, addr, receipt.Denom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), claimed)
+		s.Require().Equal(balanceBefore.AddAmount(sdk.NewInt(5)), s.App.BankKeeper.GetBalance(s.Ctx, addr, "stake"))
+	}
+
+	// the other holder locks its receipt tokens, so they are held by the lockup module account
+	_, err = s.App.LockupKeeper.CreateLock(s.Ctx, other, sdk.NewCoins(half), defaultLockDuration)
+	s.Require().NoError(err)
+	s.FundAcc(rewardsAddress, rewards)
+	err = s.App.IncentivesKeeper.AfterEpochEnd(s.Ctx, distrEpochIdentifier, 2)
+	s.Require().NoError(err)
+
+	// the remaining holder accrues all of the rewards
+	claimed, err := s.App.IncentivesKeeper.ClaimLockReceiptRewards(s.Ctx, holder, receipt.Denom)
+	s.Require().NoError(err)
+	s.Require().Equal(rewards, claimed)
+	claimed, err = s.App.IncentivesKeeper.ClaimLockReceiptRewards(s.Ctx, other, receipt.Denom)
+	s.Require().NoError(err)
+	s.Require().True(claimed.Empty())
+
+	lockReceiptAccum, err := s.App.IncentivesKeeper.GetLockReceiptAccumulator(s.Ctx, receipt.Denom)
+	s.Require().NoError(err)
+	hasPosition, err := lockReceiptAccum.HasPosition(s.App.AccountKeeper.GetModuleAddress(lockuptypes.ModuleName).String())
+	s.Require().NoError(err)
+	s.Require().False(hasPosition)
+}
+
+// TestLockReceiptMultiSend tests that lock receipt tokens can not be multi-sent, as multi-sends do not call the bank
+// send hooks, so that the positions in the lock receipt accumulator keep adding up to the receipt supply.
+func (s *KeeperTestSuite) TestLockReceiptMultiSend() {
+	s.SetupTest()
+
+	addrs := s.SetupManyLocks(1, defaultLiquidTokens, defaultLPTokens, defaultLockDuration)
+	holder, others := addrs[0], []sdk.AccAddress{s.TestAccs[1], s.TestAccs[2]}
+	locks := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, holder)
+	s.Require().Len(locks, 1)
+	receipt, err := s.App.LockupKeeper.TokenizeLock(s.Ctx, locks[0].ID, holder)
+	s.Require().NoError(err)
+
+	// the holder multi-sends a third of its receipt tokens to each of the other accounts
+	third := sdk.NewCoins(sdk.NewCoin(receipt.Denom, receipt.Amount.QuoRaw(3)))
+	msg := &banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{banktypes.NewInput(holder, third.Add(third...))},
+		Outputs: []banktypes.Output{
+			banktypes.NewOutput(others[0], third),
+			banktypes.NewOutput(others[1], third),
+		},
+	}
+	_, err = s.App.MsgServiceRouter().Handler(msg)(s.Ctx, msg)
+	s.Require().ErrorIs(err, lockuptypes.ErrLockReceiptMultiSend)
+
+	// a regular send to one of the other accounts is tracked
+	err = s.App.BankKeeper.SendCoins(s.Ctx, holder, others[0], third)
+	s.Require().NoError(err)
+
+	lockReceiptAccum, err := s.App.IncentivesKeeper.GetLockReceiptAccumulator(s.Ctx, receipt.Denom)
+	s.Require().NoError(err)
+	positionsTotal := sdk.ZeroDec()
+	for _, addr := range append([]sdk.AccAddress{holder}, others...) {
+		hasPosition, err := lockReceiptAccum.HasPosition(addr.String())
+		s.Require().NoError(err)
+		if !hasPosition {
+			s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, addr, receipt.Denom).IsZero())
+			continue
+		}
+		positionSize, err := lockReceiptAccum.GetPositionSize(addr.String())
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecFromInt(s.App.BankKeeper.GetBalance(s.Ctx, addr, receipt.Denom).Amount), positionSize)
+		positionsTotal = positionsTotal.Add(positionSize)
+	}
+	supply := s.App.BankKeeper.GetSupply(s.Ctx, receipt.Denom)
+	s.Require().Equal(sdk.NewDecFromInt(supply.Amount), positionsTotal)
+	totalShares, err := lockReceiptAccum.GetTotalShares()
+	s.Require().NoError(err)
+	s.Require().Equal(positionsTotal, totalShares)
+}
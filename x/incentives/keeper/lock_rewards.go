@@ -0,0 +1,409 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v16/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+)
+
+// Locks accrue the rewards of the gauges distributing to them in lock accumulators, rather than being sent
+// their rewards on every distribution. There is a lock accumulator per denom and duration that gauges distribute to,
+// in which every lock of the denom locked for the duration or longer has a position, with its amount of the denom as shares.
+// Distributing to the locks of a gauge is thus a single addition to the accumulator, and lock owners claim their
+// rewards lazily. The rewards accrued by a lock are also settled for its reward receiver whenever the lock is modified
+// or deleted, so that the positions of a lock always reflect its current coins and duration. Settled rewards are sent
+// at the next distribution epoch, so that the lockup hooks never move funds.
+
+// GetLockAccumulator returns the lock accumulator of the given denom and duration.
+// Returns error if the accumulator does not exist.
+func (k Keeper) GetLockAccumulator(ctx sdk.Context, denom string, duration time.Duration) (accum.AccumulatorObject, error) {
+	return accum.GetAccumulator(ctx.KVStore(k.storeKey), types.KeyLockAccumulator(denom, duration))
+}
+
+// GetLockAccumulatorDurations returns the durations of the lock accumulators of the given denom.
+func (k Keeper) GetLockAccumulatorDurations(ctx sdk.Context, denom string) []time.Duration {
+	store := ctx.KVStore(k.storeKey)
+	info := types.LockableDurationsInfo{}
+	found, err := osmoutils.Get(store, lockAccumulatorDurationsStoreKey(denom), &info)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return []time.Duration{}
+	}
+	return info.LockableDurations
+}
+
+// getLockAccumulatorDenoms returns the denoms that have lock accumulators.
+func (k Keeper) getLockAccumulatorDenoms(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := combineKeys(types.KeyPrefixLockAccumulatorDurations, []byte{})
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()[len(prefix):]))
+	}
+	return denoms
+}
+
+// getOrCreateLockAccumulator returns the lock accumulator of the given denom and duration.
+// If it does not exist yet, it is created with a position for every lock that is eligible to it.
+// This iterates over the locks once per denom and duration, after which positions are kept up to date by the lockup hooks.
+func (k Keeper) getOrCreateLockAccumulator(ctx sdk.Context, denom string, duration time.Duration) (accum.AccumulatorObject, error) {
+	durations := k.GetLockAccumulatorDurations(ctx, denom)
+	for _, d := range durations {
+		if d == duration {
+			return k.GetLockAccumulator(ctx, denom, duration)
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if err := accum.MakeAccumulator(store, types.KeyLockAccumulator(denom, duration)); err != nil {
+		return accum.AccumulatorObject{}, err
+	}
+	info := types.LockableDurationsInfo{LockableDurations: append(durations, duration)}
+	osmoutils.MustSet(store, lockAccumulatorDurationsStoreKey(denom), &info)
+
+	lockAccum, err := k.GetLockAccumulator(ctx, denom, duration)
+	if err != nil {
+		return accum.AccumulatorObject{}, err
+	}
+	for _, lock := range k.lk.GetLocksLongerThanDurationDenom(ctx, denom, duration) {
+		shares := sdk.NewDecFromInt(lock.Coins.AmountOfNoDenomValidation(denom))
+		if !shares.IsPositive() {
+			continue
+		}
+		if err := lockAccum.NewPosition(types.LockPositionName(lock.ID), shares, nil); err != nil {
+			return accum.AccumulatorObject{}, err
+		}
+	}
+
+	return lockAccum, nil
+}
+
+// ClaimLockRewards claims the rewards accrued by the given lock of the owner, and sends them to the reward receiver of the lock.
+// Returns the claimed rewards.
+func (k Keeper) ClaimLockRewards(ctx sdk.Context, owner sdk.AccAddress, lockID uint64) (sdk.Coins, error) {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+	if lock.Owner != owner.String() {
+		return nil, errorsmod.Wrapf(lockuptypes.ErrNotLockOwner, "lock %d is owned by %s", lockID, lock.Owner)
+	}
+
+	return k.claimLockRewards(ctx, *lock)
+}
+
+// claimLockRewards claims the rewards accrued by the given lock in all of the lock accumulators of its denoms,
+// and sends them to the reward receiver of the lock. Returns the claimed rewards.
+func (k Keeper) claimLockRewards(ctx sdk.Context, lock lockuptypes.PeriodLock) (sdk.Coins, error) {
+	rewards, err := k.claimLockPositions(ctx, lock)
+	if err != nil || rewards.Empty() {
+		return rewards, err
+	}
+
+	rewardReceiver := lockRewardReceiver(lock)
+	rewardReceiverAddr, err := sdk.AccAddressFromBech32(rewardReceiver)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, rewardReceiverAddr, rewards); err != nil {
+		return nil, err
+	}
+
+	emitLockRewardsDistributionEvent(ctx, rewardReceiver, rewards)
+	return rewards, nil
+}
+
+// claimLockPositions claims the rewards accrued by the positions of the given lock in all of the lock accumulators
+// of its denoms, without sending them. Returns the claimed rewards.
+func (k Keeper) claimLockPositions(ctx sdk.Context, lock lockuptypes.PeriodLock) (sdk.Coins, error) {
+	name := types.LockPositionName(lock.ID)
+	rewards := sdk.NewCoins()
+	for _, coin := range lock.Coins {
+		for _, duration := range k.GetLockAccumulatorDurations(ctx, coin.Denom) {
+			lockAccum, err := k.GetLockAccumulator(ctx, coin.Denom, duration)
+			if err != nil {
+				return nil, err
+			}
+			hasPosition, err := lockAccum.HasPosition(name)
+			if err != nil {
+				return nil, err
+			}
+			if !hasPosition {
+				continue
+			}
+
+			// the dust of the claimed rewards is kept by the module account.
+			claimed, _, err := lockAccum.ClaimRewards(name)
+			if err != nil {
+				return nil, err
+			}
+			rewards = rewards.Add(claimed...)
+		}
+	}
+	return rewards, nil
+}
+
+// lockRewardReceiver returns the address that the rewards of the given lock go to.
+func lockRewardReceiver(lock lockuptypes.PeriodLock) string {
+	// if the reward receiver stored in state is an empty string, it indicates that the owner is the reward receiver.
+	if lock.RewardReceiverAddress == lockuptypes.DefaultOwnerReceiverPlaceholder {
+		return lock.Owner
+	}
+	return lock.RewardReceiverAddress
+}
+
+// GetSettledLockRewardsAccumulator returns the accumulator that the rewards of locks are settled in when locks
+// are updated or deleted, with a position per reward receiver.
+// Returns error if the accumulator does not exist.
+func (k Keeper) GetSettledLockRewardsAccumulator(ctx sdk.Context) (accum.AccumulatorObject, error) {
+	return accum.GetAccumulator(ctx.KVStore(k.storeKey), types.SettledLockRewardsAccumulator)
+}
+
+// settleLockRewards claims the rewards accrued by the given lock and settles them for its reward receiver,
+// rather than sending them, so that updating or deleting a lock never moves funds.
+// Positions in the settled lock rewards accumulator have no shares, and hold the settled rewards
+// as unclaimed rewards until they are sent at the next distribution epoch.
+func (k Keeper) settleLockRewards(ctx sdk.Context, lock lockuptypes.PeriodLock) error {
+	rewards, err := k.claimLockPositions(ctx, lock)
+	if err != nil || rewards.Empty() {
+		return err
+	}
+
+	settledAccum, err := k.GetSettledLockRewardsAccumulator(ctx)
+	if errors.As(err, &accum.AccumDoesNotExistError{}) {
+		if err := accum.MakeAccumulator(ctx.KVStore(k.storeKey), types.SettledLockRewardsAccumulator); err != nil {
+			return err
+		}
+		settledAccum, err = k.GetSettledLockRewardsAccumulator(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	rewardReceiver := lockRewardReceiver(lock)
+	hasPosition, err := settledAccum.HasPosition(rewardReceiver)
+	if err != nil {
+		return err
+	}
+	if !hasPosition {
+		if err := settledAccum.NewPosition(rewardReceiver, sdk.ZeroDec(), nil); err != nil {
+			return err
+		}
+	}
+	return settledAccum.AddToUnclaimedRewards(rewardReceiver, sdk.NewDecCoinsFromCoins(rewards...))
+}
+
+// SendSettledLockRewards sends the rewards settled when locks were updated or deleted to their reward receivers.
+// Rewards that fail to be sent are kept settled until the next attempt.
+func (k Keeper) SendSettledLockRewards(ctx sdk.Context) {
+	if _, err := k.GetSettledLockRewardsAccumulator(ctx); err != nil {
+		// no lock rewards were ever settled.
+		return
+	}
+	settled, err := k.exportAccumulator(ctx, types.SettledLockRewardsAccumulator)
+	if err != nil {
+		ctx.Logger().Error("failed to get settled lock rewards", "error", err.Error())
+		return
+	}
+
+	for _, position := range settled.Positions {
+		rewardReceiver := position.Name
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			settledAccum, err := k.GetSettledLockRewardsAccumulator(cacheCtx)
+			if err != nil {
+				return err
+			}
+			rewards, _, err := settledAccum.ClaimRewards(rewardReceiver)
+			if err != nil || rewards.Empty() {
+				return err
+			}
+			rewardReceiverAddr, err := sdk.AccAddressFromBech32(rewardReceiver)
+			if err != nil {
+				return err
+			}
+			if err := k.bk.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, rewardReceiverAddr, rewards); err != nil {
+				return err
+			}
+
+			emitLockRewardsDistributionEvent(cacheCtx, rewardReceiver, rewards)
+			return nil
+		})
+		if err != nil {
+			ctx.Logger().Error("failed to send settled lock rewards", "receiver", rewardReceiver, "error", err.Error())
+		}
+	}
+}
+
+func emitLockRewardsDistributionEvent(ctx sdk.Context, rewardReceiver string, rewards sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtDistribution,
+			sdk.NewAttribute(types.AttributeReceiver, rewardReceiver),
+			sdk.NewAttribute(types.AttributeAmount, rewards.String()),
+		),
+	})
+}
+
+// updateLockPositions sets the positions of the given lock in the lock accumulators of the given denoms
+// to its amount of each denom, or removes them when the lock is not eligible to an accumulator anymore.
+// CONTRACT: the rewards accrued by the positions of the lock must have been claimed.
+func (k Keeper) updateLockPositions(ctx sdk.Context, lock lockuptypes.PeriodLock, denoms []string) error {
+	name := types.LockPositionName(lock.ID)
+	for _, denom := range denoms {
+		for _, duration := range k.GetLockAccumulatorDurations(ctx, denom) {
+			lockAccum, err := k.GetLockAccumulator(ctx, denom, duration)
+			if err != nil {
+				return err
+			}
+
+			shares := sdk.ZeroDec()
+			if lock.Duration >= duration {
+				shares = sdk.NewDecFromInt(lock.Coins.AmountOfNoDenomValidation(denom))
+			}
+
+			hasPosition, err := lockAccum.HasPosition(name)
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case hasPosition && shares.IsZero():
+				_, err = lockAccum.DeletePosition(name)
+			case hasPosition:
+				var prevShares sdk.Dec
+				prevShares, err = lockAccum.GetPositionSize(name)
+				if err == nil && !prevShares.Equal(shares) {
+					err = lockAccum.UpdatePosition(name, shares.Sub(prevShares))
+				}
+			case shares.IsPositive():
+				err = lockAccum.NewPosition(name, shares, nil)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// beforeLockUpdated settles the rewards accrued by the lock as it was stored before for its previous reward receiver,
+// then updates the positions of the lock to the given state.
+func (k Keeper) beforeLockUpdated(ctx sdk.Context, lock lockuptypes.PeriodLock) error {
+	denoms := []string{}
+	for _, coin := range lock.Coins {
+		denoms = append(denoms, coin.Denom)
+	}
+
+	// the lock does not exist yet when it is created.
+	if prevLock, err := k.lk.GetLockByID(ctx, lock.ID); err == nil {
+		if err := k.settleLockRewards(ctx, *prevLock); err != nil {
+			return err
+		}
+		for _, coin := range prevLock.Coins {
+			if lock.Coins.AmountOfNoDenomValidation(coin.Denom).IsZero() {
+				denoms = append(denoms, coin.Denom)
+			}
+		}
+	}
+
+	return k.updateLockPositions(ctx, lock, denoms)
+}
+
+// beforeLockDeleted settles the rewards accrued by the lock for its reward receiver, then removes its positions.
+func (k Keeper) beforeLockDeleted(ctx sdk.Context, lockID uint64) error {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil
+	}
+
+	if err := k.settleLockRewards(ctx, *lock); err != nil {
+		return err
+	}
+
+	denoms := []string{}
+	for _, coin := range lock.Coins {
+		denoms = append(denoms, coin.Denom)
+	}
+	lock.Coins = sdk.Coins{}
+	return k.updateLockPositions(ctx, *lock, denoms)
+}
+
+// distributeToLockAccumulator distributes the coins of a gauge for the current epoch to its lock accumulator,
+// to be claimed by the locks eligible to the gauge. It also updates the gauge for the distribution.
+// CONTRACT: gauge passed in as argument must be an active ByDuration gauge of a native denom.
+func (k Keeper) distributeToLockAccumulator(ctx sdk.Context, gauge types.Gauge) (sdk.Coins, error) {
+	// if gauge is empty, don't create its accumulator.
+	if gauge.Coins.Empty() {
+		return nil, nil
+	}
+
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+	// if its a perpetual gauge, we set remaining epochs to 1.
+	// otherwise is is a non perpetual gauge and we determine how many epoch payouts are left
+	remainEpochs := uint64(1)
+	if !gauge.IsPerpetual {
+		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
+	}
+
+	// defense in depth
+	// this should never happen in practice since gauge passed in should always be an active gauge.
+	if remainEpochs == uint64(0) {
+		return nil, fmt.Errorf("gauge with id of %d is not active", gauge.Id)
+	}
+
+	lockAccum, err := k.getOrCreateLockAccumulator(ctx, gauge.DistributeTo.Denom, gauge.DistributeTo.Duration)
+	if err != nil {
+		return nil, err
+	}
+	totalShares, err := lockAccum.GetTotalShares()
+	if err != nil {
+		return nil, err
+	}
+	if totalShares.IsZero() {
+		return nil, nil
+	}
+
+	totalDistrCoins := sdk.NewCoins()
+	for _, coin := range remainCoins {
+		// distribution amount = gauge_size / remain_epochs
+		amt := coin.Amount.Quo(sdk.NewIntFromUint64(remainEpochs))
+		if amt.IsPositive() {
+			totalDistrCoins = totalDistrCoins.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	// only the coins backing the rewards per share are recorded as distributed,
+	// the rest is left in the gauge to be distributed in the next epochs.
+	rewardsPerShare, distributedCoins := splitAccumulatorRewards(totalDistrCoins, totalShares)
+	lockAccum.AddToAccumulator(rewardsPerShare)
+
+	err = k.updateGaugePostDistribute(ctx, gauge, distributedCoins)
+	return distributedCoins, err
+}
+
+// splitAccumulatorRewards returns the rewards per share of distributing the given coins over the given total shares
+// of an accumulator, and the coins backing them. Rewards per share are truncated so that they never exceed the given
+// coins, while the backing coins are rounded up so that the accrued rewards are always claimable.
+// The rest of the given coins is left to be distributed later.
+func splitAccumulatorRewards(coins sdk.Coins, totalShares sdk.Dec) (sdk.DecCoins, sdk.Coins) {
+	rewardsPerShare := sdk.NewDecCoinsFromCoins(coins...).QuoDecTruncate(totalShares)
+	distributed := sdk.NewCoins()
+	for _, reward := range rewardsPerShare {
+		amount := reward.Amount.MulRoundUp(totalShares).Ceil().TruncateInt()
+		distributed = distributed.Add(sdk.NewCoin(reward.Denom, amount))
+	}
+	return rewardsPerShare, distributed
+}
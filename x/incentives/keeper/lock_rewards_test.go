@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/incentives/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+)
+
+// setupActiveLockRewardsGauge creates a perpetual gauge distributing to the lptoken locks of the default duration or longer,
+// and moves it to the active gauges.
+func (s *KeeperTestSuite) setupActiveLockRewardsGauge(coins sdk.Coins) uint64 {
+	gaugeID, gauge, _, startTime := s.setupNewGaugeWithDuration(true, coins, defaultLockDuration, "lptoken")
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	err := s.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(s.Ctx, *gauge)
+	s.Require().NoError(err)
+	return gaugeID
+}
+
+// distributeGauge distributes the coins of the given gauge for the current epoch.
+func (s *KeeperTestSuite) distributeGauge(gaugeID uint64) {
+	gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeID)
+	s.Require().NoError(err)
+	_, err = s.App.IncentivesKeeper.Distribute(s.Ctx, []types.Gauge{*gauge})
+	s.Require().NoError(err)
+}
+
+// TestClaimLockRewards tests that locks accrue the rewards of a gauge without receiving them,
+// until their owners claim them.
+func (s *KeeperTestSuite) TestClaimLockRewards() {
+	s.SetupTest()
+	owner, other := s.TestAccs[0], s.TestAccs[1]
+
+	// the lock of the owner is eligible to the gauge, and the lock of the other account is eligible through its longer duration
+	s.LockTokens(owner, sdk.Coins{sdk.NewInt64Coin("lptoken", 10)}, defaultLockDuration)
+	s.LockTokens(other, sdk.Coins{sdk.NewInt64Coin("lptoken", 30)}, 2*defaultLockDuration)
+	ownerLock := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, owner)[0]
+	otherLock := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, other)[0]
+
+	gaugeID := s.setupActiveLockRewardsGauge(sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	s.distributeGauge(gaugeID)
+	s.ValidateDistributedGauge(gaugeID, 1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+
+	// the rewards are kept by the module account until claimed
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, owner, "stake").IsZero())
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, other, "stake").IsZero())
+	lockAccum, err := s.App.IncentivesKeeper.GetLockAccumulator(s.Ctx, "lptoken", defaultLockDuration)
+	s.Require().NoError(err)
+	totalShares, err := lockAccum.GetTotalShares()
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(40), totalShares)
+
+	// only the owner of a lock can claim its rewards
+	_, err = s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, other, ownerLock.ID)
+	s.Require().ErrorIs(err, lockuptypes.ErrNotLockOwner)
+
+	claimed, err := s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, owner, ownerLock.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), claimed)
+	s.Require().Equal(claimed, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+
+	claimed, err = s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, other, otherLock.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 75)), claimed)
+
+	// rewards can only be claimed once
+	claimed, err = s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, owner, ownerLock.ID)
+	s.Require().NoError(err)
+	s.Require().True(claimed.Empty())
+
+	// the rewards are sent to the reward receiver of the lock
+	err = s.App.LockupKeeper.SetLockRewardReceiverAddress(s.Ctx, ownerLock.ID, owner, other.String())
+	s.Require().NoError(err)
+	s.AddToGauge(sdk.Coins{sdk.NewInt64Coin("stake", 40)}, gaugeID)
+	s.distributeGauge(gaugeID)
+	claimed, err = s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, owner, ownerLock.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), claimed)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 85), s.App.BankKeeper.GetBalance(s.Ctx, other, "stake"))
+}
+
+// TestDistributeToLockAccumulatorDust tests that only the coins backing the rewards per share added to the lock accumulator
+// are recorded as distributed, while the truncated rest is left in the gauge.
+func (s *KeeperTestSuite) TestDistributeToLockAccumulatorDust() {
+	s.SetupTest()
+	owner := s.TestAccs[0]
+
+	// 10 stake over 3e18 shares are 3.33...e-18 stake per share, truncated to 3e-18, that are backed by 9 stake
+	s.LockTokens(owner, sdk.Coins{sdk.NewCoin("lptoken", sdk.NewIntWithDecimal(3, 18))}, defaultLockDuration)
+	ownerLock := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, owner)[0]
+	gaugeID := s.setupActiveLockRewardsGauge(sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	s.distributeGauge(gaugeID)
+	s.ValidateDistributedGauge(gaugeID, 1, sdk.Coins{sdk.NewInt64Coin("stake", 9)})
+
+	claimed, err := s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, owner, ownerLock.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 9)), claimed)
+}
+
+// TestLockPositionsFollowLocks tests that the positions of locks in the lock accumulators follow the changes of the locks,
+// and that the rewards accrued by a lock are settled for its reward receiver whenever it is modified or deleted,
+// to be sent at the next distribution epoch.
+func (s *KeeperTestSuite) TestLockPositionsFollowLocks() {
+	s.SetupTest()
+	owner, other := s.TestAccs[0], s.TestAccs[1]
+	lockCoins := sdk.Coins{sdk.NewInt64Coin("lptoken", 10)}
+	stakeBalance := func(addr sdk.AccAddress) int64 {
+		return s.App.BankKeeper.GetBalance(s.Ctx, addr, "stake").Amount.Int64()
+	}
+	settledBalance := func(addr sdk.AccAddress) int64 {
+		settledAccum, err := s.App.IncentivesKeeper.GetSettledLockRewardsAccumulator(s.Ctx)
+		s.Require().NoError(err)
+		position, err := settledAccum.GetPosition(addr.String())
+		s.Require().NoError(err)
+		return position.UnclaimedRewardsTotal.AmountOf("stake").TruncateInt64()
+	}
+	totalShares := func() sdk.Dec {
+		lockAccum, err := s.App.IncentivesKeeper.GetLockAccumulator(s.Ctx, "lptoken", defaultLockDuration)
+		s.Require().NoError(err)
+		shares, err := lockAccum.GetTotalShares()
+		s.Require().NoError(err)
+		return shares
+	}
+	requireInvariant := func() {
+		_, broken := keeper.LockAccumulatorsInvariant(*s.App.IncentivesKeeper)(s.Ctx)
+		s.Require().False(broken)
+	}
+
+	// the lock accumulator is created with the existing locks on the first distribution
+	s.LockTokens(owner, lockCoins, defaultLockDuration)
+	ownerLock := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, owner)[0]
+	gaugeID := s.setupActiveLockRewardsGauge(sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	s.distributeGauge(gaugeID)
+	s.Require().Equal([]time.Duration{defaultLockDuration}, s.App.IncentivesKeeper.GetLockAccumulatorDurations(s.Ctx, "lptoken"))
+	s.Require().Equal(sdk.NewDec(10), totalShares())
+
+	// a new lock joins the accumulator without the rewards distributed before it
+	s.LockTokens(other, lockCoins, defaultLockDuration)
+	otherLock := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, other)[0]
+	s.Require().Equal(sdk.NewDec(20), totalShares())
+	requireInvariant()
+
+	// adding tokens to a lock settles its rewards, without sending them
+	s.FundAcc(owner, lockCoins)
+	_, err := s.App.LockupKeeper.AddTokensToLockByID(s.Ctx, ownerLock.ID, owner, lockCoins[0])
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), stakeBalance(owner))
+	s.Require().Equal(int64(100), settledBalance(owner))
+	s.Require().Equal(sdk.NewDec(30), totalShares())
+	requireInvariant()
+
+	// transferring a lock settles its rewards for the previous owner
+	s.AddToGauge(sdk.Coins{sdk.NewInt64Coin("stake", 90)}, gaugeID)
+	s.distributeGauge(gaugeID)
+	err = s.App.LockupKeeper.TransferLock(s.Ctx, otherLock.ID, other, owner)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(30), settledBalance(other))
+	claimed, err := s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, owner, otherLock.ID)
+	s.Require().NoError(err)
+	s.Require().True(claimed.Empty())
+
+	// unlocking locks keep accruing rewards until they are deleted
+	_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, ownerLock.ID, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(160), settledBalance(owner))
+	s.Require().Equal(sdk.NewDec(30), totalShares())
+	requireInvariant()
+
+	s.AddToGauge(sdk.Coins{sdk.NewInt64Coin("stake", 60)}, gaugeID)
+	s.distributeGauge(gaugeID)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(defaultLockDuration))
+	s.App.LockupKeeper.WithdrawAllMaturedLocks(s.Ctx)
+	_, err = s.App.LockupKeeper.GetLockByID(s.Ctx, ownerLock.ID)
+	s.Require().Error(err)
+	s.Require().Equal(int64(200), settledBalance(owner))
+	s.Require().Equal(sdk.NewDec(10), totalShares())
+	requireInvariant()
+
+	// the transferred lock still holds its share of the last distribution
+	claimed, err = s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, owner, otherLock.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), claimed)
+
+	// the settled rewards are sent to their reward receivers
+	s.App.IncentivesKeeper.SendSettledLockRewards(s.Ctx)
+	s.Require().Equal(int64(220), stakeBalance(owner))
+	s.Require().Equal(int64(30), stakeBalance(other))
+	settledAccum, err := s.App.IncentivesKeeper.GetSettledLockRewardsAccumulator(s.Ctx)
+	s.Require().NoError(err)
+	for _, addr := range []sdk.AccAddress{owner, other} {
+		hasPosition, err := settledAccum.HasPosition(addr.String())
+		s.Require().NoError(err)
+		s.Require().False(hasPosition)
+	}
+}
+
+// TestLockAccumulatorsInvariant tests that the lock accumulators invariant breaks when the positions of the locks
+// do not fit their amounts.
+func (s *KeeperTestSuite) TestLockAccumulatorsInvariant() {
+	s.SetupTest()
+	owner := s.TestAccs[0]
+	s.LockTokens(owner, sdk.Coins{sdk.NewInt64Coin("lptoken", 10)}, defaultLockDuration)
+	s.LockTokens(owner, sdk.Coins{sdk.NewInt64Coin("lptoken", 20)}, 2*defaultLockDuration)
+	gaugeID := s.setupActiveLockRewardsGauge(sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	s.distributeGauge(gaugeID)
+
+	_, broken := keeper.LockAccumulatorsInvariant(*s.App.IncentivesKeeper)(s.Ctx)
+	s.Require().False(broken)
+
+	lock := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, owner)[0]
+	lockAccum, err := s.App.IncentivesKeeper.GetLockAccumulator(s.Ctx, "lptoken", defaultLockDuration)
+	s.Require().NoError(err)
+	err = lockAccum.UpdatePosition(types.LockPositionName(lock.ID), sdk.OneDec())
+	s.Require().NoError(err)
+
+	_, broken = keeper.LockAccumulatorsInvariant(*s.App.IncentivesKeeper)(s.Ctx)
+	s.Require().True(broken)
+}
@@ -79,3 +79,61 @@ func (server msgServer) AddToGauge(goCtx context.Context, msg *types.MsgAddToGau
 
 	return &types.MsgAddToGaugeResponse{}, nil
 }
+
+// ClaimLockRewards claims the rewards accrued by the owner's locks.
+// Emits a claim lock rewards event per lock and returns the claimed rewards.
+func (server msgServer) ClaimLockRewards(goCtx context.Context, msg *types.MsgClaimLockRewards) (*types.MsgClaimLockRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := sdk.NewCoins()
+	for _, lockId := range msg.LockIds {
+		rewards, err := server.keeper.ClaimLockRewards(ctx, owner, lockId)
+		if err != nil {
+			return nil, err
+		}
+		claimed = claimed.Add(rewards...)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtClaimLockRewards,
+				sdk.NewAttribute(types.AttributeLockID, osmoutils.Uint64ToString(lockId)),
+				sdk.NewAttribute(types.AttributeAmount, rewards.String()),
+			),
+		})
+	}
+
+	return &types.MsgClaimLockRewardsResponse{Claimed: claimed}, nil
+}
+
+// ClaimLockReceiptRewards claims the rewards accrued by the owner's lock receipt tokens.
+// Emits a claim lock receipt rewards event per receipt denom and returns the claimed rewards.
+func (server msgServer) ClaimLockReceiptRewards(goCtx context.Context, msg *types.MsgClaimLockReceiptRewards) (*types.MsgClaimLockReceiptRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := sdk.NewCoins()
+	for _, receiptDenom := range msg.ReceiptDenoms {
+		rewards, err := server.keeper.ClaimLockReceiptRewards(ctx, owner, receiptDenom)
+		if err != nil {
+			return nil, err
+		}
+		claimed = claimed.Add(rewards...)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtClaimLockReceiptRewards,
+				sdk.NewAttribute(types.AttributeReceiptDenom, receiptDenom),
+				sdk.NewAttribute(types.AttributeAmount, rewards.String()),
+			),
+		})
+	}
+
+	return &types.MsgClaimLockReceiptRewardsResponse{Claimed: claimed}, nil
+}
@@ -37,6 +37,16 @@ func gaugeDenomStoreKey(denom string) []byte {
 	return combineKeys(types.KeyPrefixGaugesByDenom, []byte(denom))
 }
 
+// lockAccumulatorDurationsStoreKey returns the combined byte array (store key) of the provided lock accumulator durations key prefix and the denom.
+func lockAccumulatorDurationsStoreKey(denom string) []byte {
+	return combineKeys(types.KeyPrefixLockAccumulatorDurations, []byte(denom))
+}
+
+// lockReceiptAccumulatorStoreKey returns the combined byte array (store key) of the provided lock receipt accumulators key prefix and the lock receipt denom.
+func lockReceiptAccumulatorStoreKey(receiptDenom string) []byte {
+	return combineKeys(types.KeyPrefixLockReceiptAccumulators, []byte(receiptDenom))
+}
+
 // getGaugeRefs returns the gauge IDs specified by the provided key.
 func (k Keeper) getGaugeRefs(ctx sdk.Context, key []byte) []uint64 {
 	store := ctx.KVStore(k.storeKey)
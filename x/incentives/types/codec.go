@@ -18,6 +18,8 @@ var (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateGauge{}, "osmosis/incentives/create-gauge", nil)
 	cdc.RegisterConcrete(&MsgAddToGauge{}, "osmosis/incentives/add-to-gauge", nil)
+	cdc.RegisterConcrete(&MsgClaimLockRewards{}, "osmosis/incentives/claim-lock-rewards", nil)
+	cdc.RegisterConcrete(&MsgClaimLockReceiptRewards{}, "osmosis/incentives/claim-lock-receipt-rewards", nil)
 }
 
 // RegisterInterfaces registers interfaces and implementations of the incentives module.
@@ -26,6 +28,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateGauge{},
 		&MsgAddToGauge{},
+		&MsgClaimLockRewards{},
+		&MsgClaimLockReceiptRewards{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
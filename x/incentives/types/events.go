@@ -6,8 +6,13 @@ const (
 	TypeEvtAddToGauge   = "add_to_gauge"
 	TypeEvtDistribution = "distribution"
 
-	AttributeGaugeID     = "gauge_id"
-	AttributeLockedDenom = "denom"
-	AttributeReceiver    = "receiver"
-	AttributeAmount      = "amount"
+	TypeEvtClaimLockRewards        = "claim_lock_rewards"
+	TypeEvtClaimLockReceiptRewards = "claim_lock_receipt_rewards"
+
+	AttributeGaugeID      = "gauge_id"
+	AttributeLockedDenom  = "denom"
+	AttributeReceiver     = "receiver"
+	AttributeAmount       = "amount"
+	AttributeLockID       = "lock_id"
+	AttributeReceiptDenom = "receipt_denom"
 )
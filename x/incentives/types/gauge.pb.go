@@ -58,6 +58,12 @@ type Gauge struct {
 	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
 	// distributed_coins are coins that have been distributed already
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// owner is the account that created the gauge. It is only set for external
+	// gauges distributing to concentrated liquidity pools without locks. The
+	// owner is recorded as the creator of the incentive records the gauge
+	// creates, so that it can manage them, including the coins other accounts
+	// added to the gauge.
+	Owner string `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
 func (m *Gauge) Reset()         { *m = Gauge{} }
@@ -149,6 +155,13 @@ func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Gauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 type LockableDurationsInfo struct {
 	// List of incentivised durations that gauges will pay out to
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
@@ -202,42 +215,42 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/gauge.proto", fileDescriptor_c0304e2bb0159901) }
 
 var fileDescriptor_c0304e2bb0159901 = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
+	// 556 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc7, 0x9b, 0xad, 0x1d, 0xc3, 0xed, 0x10, 0xb5, 0x86, 0x94, 0x56, 0x22, 0x2d, 0x45, 0x48,
-	0xbd, 0xcc, 0xa6, 0x43, 0xda, 0x81, 0x63, 0x07, 0x42, 0x93, 0x90, 0x28, 0xd5, 0x0e, 0x88, 0x4b,
-	0xe4, 0x24, 0x6e, 0x66, 0x35, 0xc9, 0x2f, 0x8a, 0x9d, 0x6a, 0x7d, 0x03, 0x8e, 0x13, 0x27, 0x9e,
-	0x81, 0x27, 0xd9, 0x71, 0x47, 0x4e, 0x1b, 0x6a, 0xdf, 0x80, 0x27, 0x40, 0xb1, 0x13, 0xb5, 0x2a,
-	0x57, 0x4e, 0x8e, 0x7f, 0xdf, 0xdf, 0xbf, 0xef, 0x47, 0x0e, 0x72, 0x40, 0xc6, 0x20, 0x85, 0xa4,
-	0x22, 0xf1, 0x79, 0xa2, 0xc4, 0x82, 0x4b, 0x1a, 0xb2, 0x3c, 0xe4, 0x24, 0xcd, 0x40, 0x01, 0xc6,
-	0xa5, 0x4e, 0x36, 0x7a, 0xf7, 0x38, 0x84, 0x10, 0xb4, 0x4c, 0x8b, 0x2f, 0x93, 0xd9, 0x75, 0x42,
-	0x80, 0x30, 0xe2, 0x54, 0xdf, 0xbc, 0x7c, 0x46, 0x83, 0x3c, 0x63, 0x4a, 0x40, 0x52, 0xea, 0xbd,
-	0x5d, 0x5d, 0x89, 0x98, 0x4b, 0xc5, 0xe2, 0xb4, 0x6a, 0xe0, 0xeb, 0x59, 0xd4, 0x63, 0x92, 0xd3,
-	0xc5, 0xc8, 0xe3, 0x8a, 0x8d, 0xa8, 0x0f, 0xa2, 0x6a, 0xd0, 0xa9, 0x56, 0x8d, 0xc0, 0x9f, 0xe7,
-	0xa9, 0x3e, 0x8c, 0x34, 0xf8, 0x5e, 0x47, 0x8d, 0x0f, 0xc5, 0xd6, 0xf8, 0x09, 0xda, 0x13, 0x81,
-	0x6d, 0xf5, 0xad, 0x61, 0x7d, 0xba, 0x27, 0x02, 0xfc, 0x02, 0xb5, 0x84, 0x74, 0x53, 0x9e, 0xa5,
-	0x5c, 0xe5, 0x2c, 0xb2, 0xf7, 0xfa, 0xd6, 0xf0, 0x70, 0xda, 0x14, 0x72, 0x52, 0x85, 0xf0, 0x05,
-	0x3a, 0x0a, 0x84, 0x54, 0x99, 0xf0, 0x72, 0xc5, 0x5d, 0x05, 0xf6, 0x7e, 0xdf, 0x1a, 0x36, 0x4f,
-	0x1d, 0x52, 0x59, 0x37, 0xf3, 0xc8, 0xe7, 0x9c, 0x67, 0xcb, 0x73, 0x48, 0x02, 0x51, 0xb8, 0x1a,
-	0xd7, 0x6f, 0xef, 0x7b, 0xb5, 0x69, 0x6b, 0x53, 0x7a, 0x09, 0x98, 0xa1, 0x46, 0xb1, 0xb0, 0xb4,
-	0xeb, 0xfd, 0xfd, 0x61, 0xf3, 0xb4, 0x43, 0x8c, 0x25, 0x52, 0x58, 0x22, 0xa5, 0x25, 0x72, 0x0e,
-	0x22, 0x19, 0xbf, 0x2e, 0xaa, 0x7f, 0x3e, 0xf4, 0x86, 0xa1, 0x50, 0x57, 0xb9, 0x47, 0x7c, 0x88,
-	0x69, 0xe9, 0xdf, 0x1c, 0x27, 0x32, 0x98, 0x53, 0xb5, 0x4c, 0xb9, 0xd4, 0x05, 0x72, 0x6a, 0x3a,
-	0xe3, 0x2f, 0x08, 0x49, 0xc5, 0x32, 0xe5, 0x16, 0xf8, 0xec, 0x86, 0x5e, 0xb5, 0x4b, 0x0c, 0x5b,
-	0x52, 0xb1, 0x25, 0x97, 0x15, 0xdb, 0xf1, 0xf3, 0x62, 0xd0, 0x9f, 0xfb, 0x5e, 0x7b, 0xc9, 0xe2,
-	0xe8, 0xed, 0x60, 0x53, 0x3b, 0xb8, 0x79, 0xe8, 0x59, 0xd3, 0xc7, 0x3a, 0x50, 0xa4, 0x63, 0x8a,
-	0x8e, 0x93, 0x3c, 0x76, 0x79, 0x0a, 0xfe, 0x95, 0x74, 0x53, 0x26, 0x02, 0x17, 0x16, 0x3c, 0xb3,
-	0x0f, 0x34, 0xcc, 0x76, 0x92, 0xc7, 0xef, 0xb5, 0x34, 0x61, 0x22, 0xf8, 0xb4, 0xe0, 0x19, 0x7e,
-	0x89, 0x8e, 0x66, 0x22, 0x8a, 0x78, 0x50, 0xd6, 0xd8, 0x8f, 0x74, 0x66, 0xcb, 0x04, 0x4d, 0x32,
-	0xbe, 0x46, 0xed, 0x0d, 0xa2, 0xc0, 0x35, 0x78, 0x0e, 0xff, 0x3f, 0x9e, 0xa7, 0x5b, 0x53, 0x74,
-	0x64, 0xf0, 0xcd, 0x42, 0xcf, 0x3e, 0x82, 0x3f, 0x67, 0x5e, 0xc4, 0xdf, 0x95, 0x6f, 0x51, 0x5e,
-	0x24, 0x33, 0xc0, 0x80, 0x70, 0x54, 0x0a, 0x6e, 0xf5, 0x4a, 0xa5, 0x6d, 0x95, 0x4b, 0xed, 0xb2,
-	0xac, 0x6a, 0xc7, 0xaf, 0x4a, 0x94, 0x1d, 0x83, 0xf2, 0xdf, 0x16, 0x83, 0x1f, 0x05, 0xd2, 0x76,
-	0xb4, 0x3b, 0x74, 0x3c, 0xb9, 0x5d, 0x39, 0xd6, 0xdd, 0xca, 0xb1, 0x7e, 0xaf, 0x1c, 0xeb, 0x66,
-	0xed, 0xd4, 0xee, 0xd6, 0x4e, 0xed, 0xd7, 0xda, 0xa9, 0x7d, 0x3d, 0xdb, 0x32, 0x58, 0xbe, 0xb7,
-	0x93, 0x88, 0x79, 0xb2, 0xba, 0xd0, 0xc5, 0xe8, 0x8c, 0x5e, 0x6f, 0xff, 0x9d, 0xda, 0xb4, 0x77,
-	0xa0, 0xd7, 0x7b, 0xf3, 0x37, 0x00, 0x00, 0xff, 0xff, 0x31, 0x3f, 0xd2, 0xb8, 0xc0, 0x03, 0x00,
-	0x00,
+	0x1c, 0xc7, 0x9b, 0xae, 0x1d, 0xab, 0xdb, 0x21, 0x6a, 0x15, 0x29, 0xad, 0x44, 0x5a, 0x8a, 0x90,
+	0x72, 0x99, 0x4d, 0x87, 0xb4, 0x03, 0xc7, 0x0e, 0x84, 0x26, 0x21, 0x51, 0xa2, 0x1d, 0x10, 0x97,
+	0xc8, 0x49, 0xdc, 0xcc, 0x6a, 0x12, 0x47, 0xb1, 0x53, 0xd6, 0x37, 0xe0, 0xb8, 0x23, 0x8f, 0x80,
+	0x78, 0x92, 0x1d, 0x77, 0xe4, 0xb4, 0xa1, 0xf6, 0x0d, 0x78, 0x02, 0x14, 0x3b, 0x51, 0xab, 0x72,
+	0xe5, 0xe4, 0xf8, 0xf7, 0xfd, 0xfd, 0xfb, 0x7e, 0xe4, 0x00, 0x8b, 0x8b, 0x98, 0x0b, 0x26, 0x30,
+	0x4b, 0x7c, 0x9a, 0x48, 0xb6, 0xa4, 0x02, 0x87, 0x24, 0x0f, 0x29, 0x4a, 0x33, 0x2e, 0x39, 0x84,
+	0xa5, 0x8e, 0xb6, 0xfa, 0xa0, 0x17, 0xf2, 0x90, 0x2b, 0x19, 0x17, 0x5f, 0x3a, 0x73, 0x60, 0x85,
+	0x9c, 0x87, 0x11, 0xc5, 0xea, 0xe6, 0xe5, 0x73, 0x1c, 0xe4, 0x19, 0x91, 0x8c, 0x27, 0xa5, 0x3e,
+	0xdc, 0xd7, 0x25, 0x8b, 0xa9, 0x90, 0x24, 0x4e, 0xab, 0x06, 0xbe, 0x9a, 0x85, 0x3d, 0x22, 0x28,
+	0x5e, 0x4e, 0x3c, 0x2a, 0xc9, 0x04, 0xfb, 0x9c, 0x55, 0x0d, 0xfa, 0xd5, 0xaa, 0x11, 0xf7, 0x17,
+	0x79, 0xaa, 0x0e, 0x2d, 0x8d, 0x7f, 0x34, 0x40, 0xf3, 0x7d, 0xb1, 0x35, 0x7c, 0x0c, 0xea, 0x2c,
+	0x30, 0x8d, 0x91, 0x61, 0x37, 0x9c, 0x3a, 0x0b, 0xe0, 0x73, 0xd0, 0x61, 0xc2, 0x4d, 0x69, 0x96,
+	0x52, 0x99, 0x93, 0xc8, 0xac, 0x8f, 0x0c, 0xfb, 0xc8, 0x69, 0x33, 0x31, 0xab, 0x42, 0xf0, 0x02,
+	0x1c, 0x07, 0x4c, 0xc8, 0x8c, 0x79, 0xb9, 0xa4, 0xae, 0xe4, 0xe6, 0xc1, 0xc8, 0xb0, 0xdb, 0xa7,
+	0x16, 0xaa, 0xac, 0xeb, 0x79, 0xe8, 0x53, 0x4e, 0xb3, 0xd5, 0x39, 0x4f, 0x02, 0x56, 0xb8, 0x9a,
+	0x36, 0x6e, 0xef, 0x87, 0x35, 0xa7, 0xb3, 0x2d, 0xbd, 0xe4, 0x90, 0x80, 0x66, 0xb1, 0xb0, 0x30,
+	0x1b, 0xa3, 0x03, 0xbb, 0x7d, 0xda, 0x47, 0xda, 0x12, 0x2a, 0x2c, 0xa1, 0xd2, 0x12, 0x3a, 0xe7,
+	0x2c, 0x99, 0xbe, 0x2a, 0xaa, 0x7f, 0x3e, 0x0c, 0xed, 0x90, 0xc9, 0xab, 0xdc, 0x43, 0x3e, 0x8f,
+	0x71, 0xe9, 0x5f, 0x1f, 0x27, 0x22, 0x58, 0x60, 0xb9, 0x4a, 0xa9, 0x50, 0x05, 0xc2, 0xd1, 0x9d,
+	0xe1, 0x67, 0x00, 0x84, 0x24, 0x99, 0x74, 0x0b, 0x7c, 0x66, 0x53, 0xad, 0x3a, 0x40, 0x9a, 0x2d,
+	0xaa, 0xd8, 0xa2, 0xcb, 0x8a, 0xed, 0xf4, 0x59, 0x31, 0xe8, 0xcf, 0xfd, 0xb0, 0xbb, 0x22, 0x71,
+	0xf4, 0x66, 0xbc, 0xad, 0x1d, 0xdf, 0x3c, 0x0c, 0x0d, 0xa7, 0xa5, 0x02, 0x45, 0x3a, 0xc4, 0xa0,
+	0x97, 0xe4, 0xb1, 0x4b, 0x53, 0xee, 0x5f, 0x09, 0x37, 0x25, 0x2c, 0x70, 0xf9, 0x92, 0x66, 0xe6,
+	0xa1, 0x82, 0xd9, 0x4d, 0xf2, 0xf8, 0x9d, 0x92, 0x66, 0x84, 0x05, 0x1f, 0x97, 0x34, 0x83, 0x2f,
+	0xc0, 0xf1, 0x9c, 0x45, 0x11, 0x0d, 0xca, 0x1a, 0xf3, 0x91, 0xca, 0xec, 0xe8, 0xa0, 0x4e, 0x86,
+	0xd7, 0xa0, 0xbb, 0x45, 0x14, 0xb8, 0x1a, 0xcf, 0xd1, 0xff, 0xc7, 0xf3, 0x64, 0x67, 0x8a, 0x8a,
+	0xc0, 0x1e, 0x68, 0xf2, 0xaf, 0x09, 0xcd, 0xcc, 0xd6, 0xc8, 0xb0, 0x5b, 0x8e, 0xbe, 0x8c, 0xbf,
+	0x19, 0xe0, 0xe9, 0x07, 0xee, 0x2f, 0x88, 0x17, 0xd1, 0xb7, 0xe5, 0x0b, 0x15, 0x17, 0xc9, 0x9c,
+	0x43, 0x0e, 0x60, 0x54, 0x0a, 0x6e, 0xf5, 0x76, 0x85, 0x69, 0x94, 0xab, 0xee, 0x13, 0xae, 0x6a,
+	0xa7, 0x2f, 0x4b, 0xc0, 0x7d, 0x0d, 0xf8, 0xdf, 0x16, 0xe3, 0xef, 0x05, 0xe8, 0x6e, 0xb4, 0x3f,
+	0x74, 0x3a, 0xfb, 0x72, 0xb6, 0x63, 0xaf, 0x7c, 0x6d, 0x27, 0x11, 0xf1, 0x44, 0x75, 0xc1, 0xcb,
+	0xc9, 0x19, 0xbe, 0xde, 0xfd, 0x37, 0x95, 0xe5, 0xdb, 0xb5, 0x65, 0xdc, 0xad, 0x2d, 0xe3, 0xf7,
+	0xda, 0x32, 0x6e, 0x36, 0x56, 0xed, 0x6e, 0x63, 0xd5, 0x7e, 0x6d, 0xac, 0x9a, 0x77, 0xa8, 0xd6,
+	0x7b, 0xfd, 0x77, 0x00, 0x89, 0x30, 0x3e, 0xf0, 0xd6, 0x03, 0x00, 0x00,
 }
 
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
@@ -260,6 +273,13 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -414,6 +434,10 @@ func (m *Gauge) Size() (n int) {
 			n += 1 + l + sovGauge(uint64(l))
 		}
 	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
 	return n
 }
 
@@ -678,6 +702,38 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGauge(dAtA[iNdEx:])
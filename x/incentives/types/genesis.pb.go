@@ -9,6 +9,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	accum "github.com/osmosis-labs/osmosis/osmoutils/accum"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -40,6 +41,16 @@ type GenesisState struct {
 	// last_gauge_id is what the gauge number will increment from when creating
 	// the next gauge after genesis
 	LastGaugeId uint64 `protobuf:"varint,4,opt,name=last_gauge_id,json=lastGaugeId,proto3" json:"last_gauge_id,omitempty"`
+	// lock_receipt_accumulators are the accumulators that the holders of lock
+	// receipt tokens accrue the rewards of the pooled locks in
+	LockReceiptAccumulators []AccumObject `protobuf:"bytes,5,rep,name=lock_receipt_accumulators,json=lockReceiptAccumulators,proto3" json:"lock_receipt_accumulators" yaml:"lock_receipt_accumulators"`
+	// lock_accumulators are the accumulators that locks accrue the rewards of
+	// the gauges distributing to their denom and duration in
+	LockAccumulators []AccumObject `protobuf:"bytes,6,rep,name=lock_accumulators,json=lockAccumulators,proto3" json:"lock_accumulators" yaml:"lock_accumulators"`
+	// settled_lock_rewards_accumulator is the accumulator that the rewards of
+	// locks are settled in for their reward receivers when locks are updated or
+	// deleted, if any
+	SettledLockRewardsAccumulator *AccumObject `protobuf:"bytes,7,opt,name=settled_lock_rewards_accumulator,json=settledLockRewardsAccumulator,proto3" json:"settled_lock_rewards_accumulator,omitempty" yaml:"settled_lock_rewards_accumulator"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -103,36 +114,192 @@ func (m *GenesisState) GetLastGaugeId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetLockReceiptAccumulators() []AccumObject {
+	if m != nil {
+		return m.LockReceiptAccumulators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockAccumulators() []AccumObject {
+	if m != nil {
+		return m.LockAccumulators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSettledLockRewardsAccumulator() *AccumObject {
+	if m != nil {
+		return m.SettledLockRewardsAccumulator
+	}
+	return nil
+}
+
+// AccumObject is an accumulator of the incentives module, along with the
+// positions in it.
+type AccumObject struct {
+	// Accumulator's name (pulled from AccumulatorContent)
+	Name         string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	AccumContent *accum.AccumulatorContent `protobuf:"bytes,2,opt,name=accum_content,json=accumContent,proto3" json:"accum_content,omitempty"`
+	// positions are all the positions in the accumulator
+	Positions []AccumPosition `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions"`
+}
+
+func (m *AccumObject) Reset()         { *m = AccumObject{} }
+func (m *AccumObject) String() string { return proto.CompactTextString(m) }
+func (*AccumObject) ProtoMessage()    {}
+func (*AccumObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a288ccc95d977d2d, []int{1}
+}
+func (m *AccumObject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccumObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccumObject.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccumObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccumObject.Merge(m, src)
+}
+func (m *AccumObject) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccumObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccumObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccumObject proto.InternalMessageInfo
+
+func (m *AccumObject) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AccumObject) GetAccumContent() *accum.AccumulatorContent {
+	if m != nil {
+		return m.AccumContent
+	}
+	return nil
+}
+
+func (m *AccumObject) GetPositions() []AccumPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+// AccumPosition is a position in an accumulator, by name.
+type AccumPosition struct {
+	// Position's name in the accumulator
+	Name   string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	Record accum.Record `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+}
+
+func (m *AccumPosition) Reset()         { *m = AccumPosition{} }
+func (m *AccumPosition) String() string { return proto.CompactTextString(m) }
+func (*AccumPosition) ProtoMessage()    {}
+func (*AccumPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a288ccc95d977d2d, []int{2}
+}
+func (m *AccumPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccumPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccumPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccumPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccumPosition.Merge(m, src)
+}
+func (m *AccumPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccumPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccumPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccumPosition proto.InternalMessageInfo
+
+func (m *AccumPosition) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AccumPosition) GetRecord() accum.Record {
+	if m != nil {
+		return m.Record
+	}
+	return accum.Record{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.incentives.GenesisState")
+	proto.RegisterType((*AccumObject)(nil), "osmosis.incentives.AccumObject")
+	proto.RegisterType((*AccumPosition)(nil), "osmosis.incentives.AccumPosition")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/genesis.proto", fileDescriptor_a288ccc95d977d2d) }
 
 var fileDescriptor_a288ccc95d977d2d = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x6e, 0xfa, 0x30,
-	0x10, 0xc6, 0x13, 0x40, 0x0c, 0xe1, 0xff, 0x1f, 0x6a, 0x75, 0x08, 0x0c, 0x4e, 0x14, 0xa9, 0x12,
-	0x4b, 0x6d, 0x95, 0x4a, 0xb4, 0xea, 0x88, 0x2a, 0xa1, 0x6e, 0x88, 0x6e, 0x5d, 0x90, 0x03, 0xae,
-	0x6b, 0x35, 0x89, 0x11, 0xe7, 0xa0, 0xf2, 0x16, 0x9d, 0xaa, 0x3e, 0x12, 0x23, 0x63, 0x27, 0x5a,
-	0xc1, 0x1b, 0xf4, 0x09, 0xaa, 0x38, 0xb1, 0x5a, 0x09, 0x36, 0x9f, 0xbf, 0xdf, 0x7d, 0xf7, 0xdd,
-	0x79, 0xa1, 0x82, 0x54, 0x81, 0x04, 0x2a, 0xb3, 0x29, 0xcf, 0xb4, 0x5c, 0x72, 0xa0, 0x82, 0x67,
-	0x1c, 0x24, 0x90, 0xf9, 0x42, 0x69, 0x85, 0x50, 0x45, 0x90, 0x5f, 0xa2, 0x73, 0x2a, 0x94, 0x50,
-	0x46, 0xa6, 0xc5, 0xab, 0x24, 0x3b, 0x58, 0x28, 0x25, 0x12, 0x4e, 0x4d, 0x15, 0xe7, 0x8f, 0x74,
-	0x96, 0x2f, 0x98, 0x96, 0x2a, 0xab, 0xf4, 0xe0, 0xc8, 0xac, 0x39, 0x5b, 0xb0, 0x14, 0xac, 0xc1,
-	0xb1, 0x30, 0x2c, 0x17, 0xbc, 0xd4, 0xa3, 0xb7, 0x9a, 0xf7, 0x6f, 0x58, 0x86, 0xbb, 0xd7, 0x4c,
-	0x73, 0x74, 0xed, 0x35, 0x4b, 0x03, 0xdf, 0x0d, 0xdd, 0x6e, 0xab, 0xd7, 0x21, 0x87, 0x61, 0xc9,
-	0xc8, 0x10, 0x83, 0xc6, 0x7a, 0x1b, 0x38, 0xe3, 0x8a, 0x47, 0x57, 0x5e, 0xd3, 0x38, 0x83, 0x5f,
-	0x0b, 0xeb, 0xdd, 0x56, 0xaf, 0x7d, 0xac, 0x73, 0x58, 0x10, 0xb6, 0xb1, 0xc4, 0x91, 0xf2, 0x50,
-	0xa2, 0xa6, 0xcf, 0x2c, 0x4e, 0xf8, 0xc4, 0xee, 0x07, 0x7e, 0xbd, 0x32, 0x29, 0x2f, 0x40, 0xec,
-	0x05, 0xc8, 0x6d, 0x45, 0x0c, 0xce, 0x0a, 0x93, 0xef, 0x6d, 0xd0, 0x5e, 0xb1, 0x34, 0xb9, 0x89,
-	0x0e, 0x2d, 0xa2, 0xf7, 0xcf, 0xc0, 0x1d, 0x9f, 0x58, 0xc1, 0x36, 0x02, 0x8a, 0xbc, 0xff, 0x09,
-	0x03, 0x3d, 0x31, 0xf3, 0x27, 0x72, 0xe6, 0x37, 0x42, 0xb7, 0xdb, 0x18, 0xb7, 0x8a, 0x4f, 0x13,
-	0xf0, 0x6e, 0x36, 0x18, 0xad, 0x77, 0xd8, 0xdd, 0xec, 0xb0, 0xfb, 0xb5, 0xc3, 0xee, 0xeb, 0x1e,
-	0x3b, 0x9b, 0x3d, 0x76, 0x3e, 0xf6, 0xd8, 0x79, 0xe8, 0x0b, 0xa9, 0x9f, 0xf2, 0x98, 0x4c, 0x55,
-	0x4a, 0xab, 0x0d, 0xcf, 0x13, 0x16, 0x83, 0x2d, 0xe8, 0xf2, 0xa2, 0x4f, 0x5f, 0xfe, 0x1e, 0x5c,
-	0xaf, 0xe6, 0x1c, 0xe2, 0xa6, 0x59, 0xe1, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0x1e, 0xba, 0x6c,
-	0x4f, 0x20, 0x02, 0x00, 0x00,
+	// 588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xd3, 0x4a,
+	0x14, 0xcd, 0xb4, 0x69, 0x9e, 0x3a, 0x69, 0xf4, 0x60, 0x84, 0x84, 0x13, 0xa9, 0xb6, 0x6b, 0x84,
+	0x08, 0x42, 0xd8, 0x4a, 0x91, 0x0a, 0x82, 0x55, 0x03, 0xa8, 0x42, 0x42, 0x10, 0x99, 0x1d, 0x1b,
+	0x6b, 0x6c, 0x0f, 0xc6, 0x60, 0x7b, 0x82, 0x67, 0x1c, 0xe8, 0x07, 0xb0, 0x67, 0x83, 0xc4, 0x17,
+	0xf0, 0x2d, 0x65, 0xd7, 0x25, 0xab, 0x80, 0x92, 0x3f, 0xc8, 0x17, 0x20, 0xcf, 0x8c, 0x89, 0xa3,
+	0xa6, 0x22, 0x3b, 0xdf, 0x39, 0xe7, 0xdc, 0x73, 0xee, 0xd5, 0x8c, 0xa1, 0x49, 0x59, 0x4a, 0x59,
+	0xcc, 0x9c, 0x38, 0x0b, 0x48, 0xc6, 0xe3, 0x09, 0x61, 0x4e, 0x44, 0x32, 0xc2, 0x62, 0x66, 0x8f,
+	0x73, 0xca, 0x29, 0x42, 0x8a, 0x61, 0x2f, 0x19, 0xbd, 0x6b, 0x11, 0x8d, 0xa8, 0x80, 0x9d, 0xf2,
+	0x4b, 0x32, 0x7b, 0x7a, 0x44, 0x69, 0x94, 0x10, 0x47, 0x54, 0x7e, 0xf1, 0xc6, 0x09, 0x8b, 0x1c,
+	0xf3, 0x98, 0x66, 0x0a, 0x37, 0xd6, 0x78, 0x8d, 0x71, 0x8e, 0x53, 0x56, 0x35, 0x58, 0x17, 0x06,
+	0x17, 0x11, 0x51, 0xf8, 0x41, 0x85, 0xe3, 0x20, 0x28, 0x52, 0x67, 0x32, 0xf0, 0x09, 0xc7, 0x03,
+	0x59, 0x49, 0x8a, 0xf5, 0x7d, 0x07, 0xee, 0x9d, 0xc8, 0xfc, 0xaf, 0x38, 0xe6, 0x04, 0x3d, 0x80,
+	0x2d, 0xe9, 0xa1, 0x01, 0x13, 0xf4, 0xdb, 0x87, 0x3d, 0xfb, 0xe2, 0x3c, 0xf6, 0x48, 0x30, 0x86,
+	0xcd, 0xb3, 0xa9, 0xd1, 0x70, 0x15, 0x1f, 0xdd, 0x87, 0x2d, 0x61, 0xce, 0xb4, 0x2d, 0x73, 0xbb,
+	0xdf, 0x3e, 0xec, 0xae, 0x53, 0x9e, 0x94, 0x8c, 0x4a, 0x28, 0xe9, 0x88, 0x42, 0x94, 0xd0, 0xe0,
+	0x3d, 0xf6, 0x13, 0xe2, 0x55, 0x2b, 0x60, 0xda, 0xb6, 0x6a, 0x22, 0x97, 0x64, 0x57, 0x4b, 0xb2,
+	0x9f, 0x28, 0xc6, 0xf0, 0x66, 0xd9, 0x64, 0x31, 0x35, 0xba, 0xa7, 0x38, 0x4d, 0x1e, 0x5a, 0x17,
+	0x5b, 0x58, 0xdf, 0x7e, 0x19, 0xc0, 0xbd, 0x5a, 0x01, 0x95, 0x90, 0x21, 0x0b, 0x76, 0x12, 0xcc,
+	0xb8, 0x27, 0xfc, 0xbd, 0x38, 0xd4, 0x9a, 0x26, 0xe8, 0x37, 0xdd, 0x76, 0x79, 0x28, 0x02, 0x3e,
+	0x0b, 0xd1, 0x67, 0x00, 0xbb, 0xa5, 0xd2, 0xcb, 0x49, 0x40, 0xe2, 0x31, 0xf7, 0xc4, 0xd6, 0x8a,
+	0x04, 0x73, 0x9a, 0x33, 0x6d, 0x47, 0x84, 0x33, 0xd6, 0x4d, 0x78, 0x5c, 0xf2, 0x5e, 0xfa, 0xef,
+	0x48, 0xc0, 0x87, 0x7d, 0x15, 0xd1, 0x5c, 0x46, 0x5c, 0xdb, 0xcf, 0x72, 0xaf, 0x97, 0x98, 0x2b,
+	0xa1, 0xe3, 0x1a, 0x82, 0x32, 0x28, 0x06, 0x58, 0xb5, 0x6f, 0x6d, 0x66, 0x6f, 0x2a, 0x7b, 0xad,
+	0x66, 0xbf, 0x6a, 0x7b, 0xa5, 0x3c, 0x5b, 0xf1, 0xfb, 0x0a, 0xa0, 0xc9, 0x08, 0xe7, 0x09, 0x09,
+	0x3d, 0x95, 0xf7, 0x23, 0xce, 0x43, 0x56, 0x17, 0x6a, 0xff, 0x99, 0x60, 0x13, 0xff, 0x3b, 0x8b,
+	0xa9, 0x71, 0x4b, 0x7a, 0xff, 0xab, 0xa5, 0xe5, 0xee, 0x2b, 0xca, 0x73, 0xb1, 0x08, 0x41, 0xa8,
+	0x05, 0xb3, 0x7e, 0x00, 0xd8, 0xae, 0xf5, 0x46, 0x37, 0x60, 0x33, 0xc3, 0x29, 0x11, 0xb7, 0x74,
+	0x77, 0xf8, 0xff, 0x62, 0x6a, 0xb4, 0xa5, 0x53, 0x79, 0x6a, 0xb9, 0x02, 0x44, 0x2f, 0x60, 0x47,
+	0x78, 0x78, 0x01, 0xcd, 0x38, 0xc9, 0xb8, 0xb6, 0x25, 0x82, 0xdf, 0xfe, 0x1b, 0x5c, 0xa0, 0xb6,
+	0x7a, 0x18, 0x76, 0xcd, 0xef, 0xb1, 0x14, 0xb8, 0x7b, 0x82, 0xa1, 0x2a, 0xf4, 0x14, 0xee, 0x8e,
+	0x29, 0x8b, 0xeb, 0x17, 0xf4, 0xe0, 0xd2, 0x25, 0x8c, 0x14, 0x53, 0xdd, 0xf6, 0xa5, 0xd2, 0xfa,
+	0x00, 0x3b, 0x2b, 0x8c, 0xcd, 0x86, 0x79, 0x04, 0x5b, 0x39, 0x09, 0x68, 0x1e, 0xaa, 0x29, 0xf6,
+	0x2f, 0x99, 0xc2, 0x15, 0xa4, 0xea, 0x8d, 0x49, 0xc9, 0x70, 0xf4, 0xfa, 0x28, 0x8a, 0xf9, 0xdb,
+	0xc2, 0xb7, 0x03, 0x9a, 0x3a, 0x4a, 0x78, 0x37, 0xc1, 0x3e, 0xab, 0x0a, 0x67, 0x32, 0x38, 0x72,
+	0x3e, 0xd5, 0x7f, 0x25, 0xfc, 0x74, 0x4c, 0xd8, 0xd9, 0x4c, 0x07, 0xe7, 0x33, 0x1d, 0xfc, 0x9e,
+	0xe9, 0xe0, 0xcb, 0x5c, 0x6f, 0x9c, 0xcf, 0xf5, 0xc6, 0xcf, 0xb9, 0xde, 0xf0, 0x5b, 0xe2, 0x45,
+	0xde, 0xfb, 0x33, 0x00, 0x5c, 0x1e, 0x03, 0x71, 0x12, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -155,6 +322,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SettledLockRewardsAccumulator != nil {
+		{
+			size, err := m.SettledLockRewardsAccumulator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LockAccumulators) > 0 {
+		for iNdEx := len(m.LockAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.LockReceiptAccumulators) > 0 {
+		for iNdEx := len(m.LockReceiptAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockReceiptAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.LastGaugeId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastGaugeId))
 		i--
@@ -199,6 +406,102 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccumObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccumObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccumObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AccumContent != nil {
+		{
+			size, err := m.AccumContent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccumPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccumPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccumPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -233,6 +536,60 @@ func (m *GenesisState) Size() (n int) {
 	if m.LastGaugeId != 0 {
 		n += 1 + sovGenesis(uint64(m.LastGaugeId))
 	}
+	if len(m.LockReceiptAccumulators) > 0 {
+		for _, e := range m.LockReceiptAccumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockAccumulators) > 0 {
+		for _, e := range m.LockAccumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SettledLockRewardsAccumulator != nil {
+		l = m.SettledLockRewardsAccumulator.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *AccumObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.AccumContent != nil {
+		l = m.AccumContent.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccumPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -391,6 +748,377 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockReceiptAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockReceiptAccumulators = append(m.LockReceiptAccumulators, AccumObject{})
+			if err := m.LockReceiptAccumulators[len(m.LockReceiptAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockAccumulators = append(m.LockAccumulators, AccumObject{})
+			if err := m.LockAccumulators[len(m.LockAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledLockRewardsAccumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SettledLockRewardsAccumulator == nil {
+				m.SettledLockRewardsAccumulator = &AccumObject{}
+			}
+			if err := m.SettledLockRewardsAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccumObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccumObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccumObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumContent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccumContent == nil {
+				m.AccumContent = &accum.AccumulatorContent{}
+			}
+			if err := m.AccumContent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, AccumPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccumPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccumPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccumPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
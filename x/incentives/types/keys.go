@@ -1,6 +1,11 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 var (
 	// ModuleName defines the module name.
@@ -45,9 +50,25 @@ var (
 	// KeyIndexSeparator defines key for merging bytes.
 	KeyIndexSeparator = []byte{0x07}
 
+	// KeyPrefixLockAccumulatorDurations defines prefix key for storing the durations of the lock accumulators by denomination.
+	KeyPrefixLockAccumulatorDurations = []byte{0x08}
+
+	// KeyPrefixLockReceiptAccumulators defines prefix key for storing the lock receipt denoms that have a lock receipt accumulator.
+	KeyPrefixLockReceiptAccumulators = []byte{0x09}
+
 	// LockableDurationsKey defines key for storing valid durations for giving incentives.
 	LockableDurationsKey = []byte("lockable_durations")
 
+	// LockAccumulatorPrefix is the prefix of the names of the accumulators that locks accrue rewards in.
+	LockAccumulatorPrefix = "lock"
+
+	// LockReceiptAccumulatorPrefix is the prefix of the names of the accumulators that lock receipt holders accrue rewards in.
+	LockReceiptAccumulatorPrefix = "lock-receipt"
+
+	// SettledLockRewardsAccumulator is the name of the accumulator that the rewards of locks are settled in
+	// for their reward receivers, when locks are updated or deleted.
+	SettledLockRewardsAccumulator = "settled-lock-rewards"
+
 	NoLockInternalPrefix = "no-lock/i/"
 	NoLockExternalPrefix = "no-lock/e/"
 )
@@ -65,3 +86,34 @@ func NoLockExternalGaugeDenom(poolId uint64) string {
 func NoLockInternalGaugeDenom(poolId uint64) string {
 	return fmt.Sprintf("%s%d", NoLockInternalPrefix, poolId)
 }
+
+// KeyLockAccumulator returns the name of the accumulator that the locks of the given denom,
+// locked for the given duration or longer, accrue rewards in.
+func KeyLockAccumulator(denom string, duration time.Duration) string {
+	return strings.Join([]string{LockAccumulatorPrefix, denom, duration.String()}, "/")
+}
+
+// ParseLockAccumulatorName returns the denom and duration of the lock accumulator with the given name.
+func ParseLockAccumulatorName(name string) (string, time.Duration, error) {
+	denomAndDuration := strings.TrimPrefix(name, LockAccumulatorPrefix+"/")
+	separatorIndex := strings.LastIndex(denomAndDuration, "/")
+	if denomAndDuration == name || separatorIndex <= 0 {
+		return "", 0, fmt.Errorf("invalid lock accumulator name %s", name)
+	}
+	duration, err := time.ParseDuration(denomAndDuration[separatorIndex+1:])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid lock accumulator name %s: %w", name, err)
+	}
+	return denomAndDuration[:separatorIndex], duration, nil
+}
+
+// LockPositionName returns the name of the position of the given lock in a lock accumulator.
+func LockPositionName(lockID uint64) string {
+	return strconv.FormatUint(lockID, 10)
+}
+
+// KeyLockReceiptAccumulator returns the name of the accumulator that the holders of the given lock receipt denom
+// accrue the rewards of its pooled lock in.
+func KeyLockReceiptAccumulator(receiptDenom string) string {
+	return strings.Join([]string{LockReceiptAccumulatorPrefix, receiptDenom}, "/")
+}
@@ -13,6 +13,9 @@ import (
 const (
 	TypeMsgCreateGauge = "create_gauge"
 	TypeMsgAddToGauge  = "add_to_gauge"
+
+	TypeMsgClaimLockRewards        = "claim_lock_rewards"
+	TypeMsgClaimLockReceiptRewards = "claim_lock_receipt_rewards"
 )
 
 var _ sdk.Msg = &MsgCreateGauge{}
@@ -138,3 +141,100 @@ func (m MsgAddToGauge) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgClaimLockRewards{}
+
+// NewMsgClaimLockRewards creates a message to claim the rewards accrued by the given locks.
+func NewMsgClaimLockRewards(owner sdk.AccAddress, lockIds []uint64) *MsgClaimLockRewards {
+	return &MsgClaimLockRewards{
+		Owner:   owner.String(),
+		LockIds: lockIds,
+	}
+}
+
+// Route takes a claim lock rewards message, then returns the RouterKey used for slashing.
+func (m MsgClaimLockRewards) Route() string { return RouterKey }
+
+// Type takes a claim lock rewards message, then returns a claim lock rewards message type.
+func (m MsgClaimLockRewards) Type() string { return TypeMsgClaimLockRewards }
+
+// ValidateBasic checks that the claim lock rewards message is valid.
+func (m MsgClaimLockRewards) ValidateBasic() error {
+	if m.Owner == "" {
+		return errors.New("owner should be set")
+	}
+	if len(m.LockIds) == 0 {
+		return errors.New("lock ids should not be empty")
+	}
+
+	seen := make(map[uint64]bool, len(m.LockIds))
+	for _, lockId := range m.LockIds {
+		if seen[lockId] {
+			return fmt.Errorf("duplicate lock id %d", lockId)
+		}
+		seen[lockId] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes takes a claim lock rewards message and turns it into a byte array.
+func (m MsgClaimLockRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a claim lock rewards message and returns the owner in a byte array.
+func (m MsgClaimLockRewards) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgClaimLockReceiptRewards{}
+
+// NewMsgClaimLockReceiptRewards creates a message to claim the rewards accrued by the held lock receipt tokens of the given denoms.
+func NewMsgClaimLockReceiptRewards(owner sdk.AccAddress, receiptDenoms []string) *MsgClaimLockReceiptRewards {
+	return &MsgClaimLockReceiptRewards{
+		Owner:         owner.String(),
+		ReceiptDenoms: receiptDenoms,
+	}
+}
+
+// Route takes a claim lock receipt rewards message, then returns the RouterKey used for slashing.
+func (m MsgClaimLockReceiptRewards) Route() string { return RouterKey }
+
+// Type takes a claim lock receipt rewards message, then returns a claim lock receipt rewards message type.
+func (m MsgClaimLockReceiptRewards) Type() string { return TypeMsgClaimLockReceiptRewards }
+
+// ValidateBasic checks that the claim lock receipt rewards message is valid.
+func (m MsgClaimLockReceiptRewards) ValidateBasic() error {
+	if m.Owner == "" {
+		return errors.New("owner should be set")
+	}
+	if len(m.ReceiptDenoms) == 0 {
+		return errors.New("receipt denoms should not be empty")
+	}
+
+	seen := make(map[string]bool, len(m.ReceiptDenoms))
+	for _, receiptDenom := range m.ReceiptDenoms {
+		if _, _, err := lockuptypes.ParseLockReceiptDenom(receiptDenom); err != nil {
+			return err
+		}
+		if seen[receiptDenom] {
+			return fmt.Errorf("duplicate receipt denom %s", receiptDenom)
+		}
+		seen[receiptDenom] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes takes a claim lock receipt rewards message and turns it into a byte array.
+func (m MsgClaimLockReceiptRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a claim lock receipt rewards message and returns the owner in a byte array.
+func (m MsgClaimLockReceiptRewards) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
@@ -301,6 +301,161 @@ func TestMsgAddToGauge(t *testing.T) {
 	}
 }
 
+// TestMsgClaimLockRewards tests if valid/invalid claim lock rewards messages are properly validated/invalidated
+func TestMsgClaimLockRewards(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper claimLockRewards message
+	createMsg := func(after func(msg incentivestypes.MsgClaimLockRewards) incentivestypes.MsgClaimLockRewards) incentivestypes.MsgClaimLockRewards {
+		properMsg := *incentivestypes.NewMsgClaimLockRewards(
+			addr1,
+			[]uint64{1, 2},
+		)
+
+		return after(properMsg)
+	}
+
+	// validate claimLockRewards message was created as intended
+	msg := createMsg(func(msg incentivestypes.MsgClaimLockRewards) incentivestypes.MsgClaimLockRewards {
+		return msg
+	})
+	require.Equal(t, msg.Route(), incentivestypes.RouterKey)
+	require.Equal(t, msg.Type(), "claim_lock_rewards")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        incentivestypes.MsgClaimLockRewards
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg incentivestypes.MsgClaimLockRewards) incentivestypes.MsgClaimLockRewards {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty owner",
+			msg: createMsg(func(msg incentivestypes.MsgClaimLockRewards) incentivestypes.MsgClaimLockRewards {
+				msg.Owner = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty lock ids",
+			msg: createMsg(func(msg incentivestypes.MsgClaimLockRewards) incentivestypes.MsgClaimLockRewards {
+				msg.LockIds = []uint64{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate lock ids",
+			msg: createMsg(func(msg incentivestypes.MsgClaimLockRewards) incentivestypes.MsgClaimLockRewards {
+				msg.LockIds = []uint64{1, 1}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgClaimLockReceiptRewards tests if valid/invalid claim lock receipt rewards messages are properly validated/invalidated
+func TestMsgClaimLockReceiptRewards(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	receiptDenom := lockuptypes.LockReceiptDenom("lptoken", time.Second)
+
+	// make a proper claimLockReceiptRewards message
+	createMsg := func(after func(msg incentivestypes.MsgClaimLockReceiptRewards) incentivestypes.MsgClaimLockReceiptRewards) incentivestypes.MsgClaimLockReceiptRewards {
+		properMsg := *incentivestypes.NewMsgClaimLockReceiptRewards(
+			addr1,
+			[]string{receiptDenom},
+		)
+
+		return after(properMsg)
+	}
+
+	// validate claimLockReceiptRewards message was created as intended
+	msg := createMsg(func(msg incentivestypes.MsgClaimLockReceiptRewards) incentivestypes.MsgClaimLockReceiptRewards {
+		return msg
+	})
+	require.Equal(t, msg.Route(), incentivestypes.RouterKey)
+	require.Equal(t, msg.Type(), "claim_lock_receipt_rewards")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        incentivestypes.MsgClaimLockReceiptRewards
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg incentivestypes.MsgClaimLockReceiptRewards) incentivestypes.MsgClaimLockReceiptRewards {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty owner",
+			msg: createMsg(func(msg incentivestypes.MsgClaimLockReceiptRewards) incentivestypes.MsgClaimLockReceiptRewards {
+				msg.Owner = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty receipt denoms",
+			msg: createMsg(func(msg incentivestypes.MsgClaimLockReceiptRewards) incentivestypes.MsgClaimLockReceiptRewards {
+				msg.ReceiptDenoms = []string{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "not a lock receipt denom",
+			msg: createMsg(func(msg incentivestypes.MsgClaimLockReceiptRewards) incentivestypes.MsgClaimLockReceiptRewards {
+				msg.ReceiptDenoms = []string{"lptoken"}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate receipt denoms",
+			msg: createMsg(func(msg incentivestypes.MsgClaimLockReceiptRewards) incentivestypes.MsgClaimLockReceiptRewards {
+				msg.ReceiptDenoms = []string{receiptDenom, receiptDenom}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 // // Test authz serialize and de-serializes for incentives msg.
 func TestAuthzMsg(t *testing.T) {
 	appParams.SetAddressPrefixes()
@@ -336,6 +491,20 @@ func TestAuthzMsg(t *testing.T) {
 				NumEpochsPaidOver: 1,
 			},
 		},
+		{
+			name: "MsgClaimLockRewards",
+			incentivesMsg: &incentivestypes.MsgClaimLockRewards{
+				Owner:   addr1,
+				LockIds: []uint64{1},
+			},
+		},
+		{
+			name: "MsgClaimLockReceiptRewards",
+			incentivesMsg: &incentivestypes.MsgClaimLockReceiptRewards{
+				Owner:         addr1,
+				ReceiptDenoms: []string{lockuptypes.LockReceiptDenom("lptoken", time.Second)},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
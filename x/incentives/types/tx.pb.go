@@ -284,58 +284,275 @@ func (m *MsgAddToGaugeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddToGaugeResponse proto.InternalMessageInfo
 
+// MsgClaimLockRewards claims the rewards accrued by the given locks of the
+// owner, and sends them to the reward receiver of each lock.
+type MsgClaimLockRewards struct {
+	// owner is the owner of the locks
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// lock_ids are the IDs of the locks to claim the rewards of
+	LockIds []uint64 `protobuf:"varint,2,rep,packed,name=lock_ids,json=lockIds,proto3" json:"lock_ids,omitempty" yaml:"lock_ids"`
+}
+
+func (m *MsgClaimLockRewards) Reset()         { *m = MsgClaimLockRewards{} }
+func (m *MsgClaimLockRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimLockRewards) ProtoMessage()    {}
+func (*MsgClaimLockRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{4}
+}
+func (m *MsgClaimLockRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimLockRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimLockRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimLockRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimLockRewards.Merge(m, src)
+}
+func (m *MsgClaimLockRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimLockRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimLockRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimLockRewards proto.InternalMessageInfo
+
+func (m *MsgClaimLockRewards) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgClaimLockRewards) GetLockIds() []uint64 {
+	if m != nil {
+		return m.LockIds
+	}
+	return nil
+}
+
+type MsgClaimLockRewardsResponse struct {
+	// claimed are the rewards claimed from all of the given locks
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
+}
+
+func (m *MsgClaimLockRewardsResponse) Reset()         { *m = MsgClaimLockRewardsResponse{} }
+func (m *MsgClaimLockRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimLockRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimLockRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{5}
+}
+func (m *MsgClaimLockRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimLockRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimLockRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimLockRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimLockRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimLockRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimLockRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimLockRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimLockRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimLockRewardsResponse) GetClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
+// MsgClaimLockReceiptRewards claims the rewards accrued by the lock receipt
+// tokens of the given denoms held by the owner, and sends them to the owner.
+type MsgClaimLockReceiptRewards struct {
+	// owner is the holder of the lock receipt tokens
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// receipt_denoms are the lock receipt denoms to claim the rewards of
+	ReceiptDenoms []string `protobuf:"bytes,2,rep,name=receipt_denoms,json=receiptDenoms,proto3" json:"receipt_denoms,omitempty" yaml:"receipt_denoms"`
+}
+
+func (m *MsgClaimLockReceiptRewards) Reset()         { *m = MsgClaimLockReceiptRewards{} }
+func (m *MsgClaimLockReceiptRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimLockReceiptRewards) ProtoMessage()    {}
+func (*MsgClaimLockReceiptRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{6}
+}
+func (m *MsgClaimLockReceiptRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimLockReceiptRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimLockReceiptRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimLockReceiptRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimLockReceiptRewards.Merge(m, src)
+}
+func (m *MsgClaimLockReceiptRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimLockReceiptRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimLockReceiptRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimLockReceiptRewards proto.InternalMessageInfo
+
+func (m *MsgClaimLockReceiptRewards) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgClaimLockReceiptRewards) GetReceiptDenoms() []string {
+	if m != nil {
+		return m.ReceiptDenoms
+	}
+	return nil
+}
+
+type MsgClaimLockReceiptRewardsResponse struct {
+	// claimed are the rewards claimed from all of the given lock receipt denoms
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
+}
+
+func (m *MsgClaimLockReceiptRewardsResponse) Reset()         { *m = MsgClaimLockReceiptRewardsResponse{} }
+func (m *MsgClaimLockReceiptRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimLockReceiptRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimLockReceiptRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{7}
+}
+func (m *MsgClaimLockReceiptRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimLockReceiptRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimLockReceiptRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimLockReceiptRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimLockReceiptRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimLockReceiptRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimLockReceiptRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimLockReceiptRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimLockReceiptRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimLockReceiptRewardsResponse) GetClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateGauge)(nil), "osmosis.incentives.MsgCreateGauge")
 	proto.RegisterType((*MsgCreateGaugeResponse)(nil), "osmosis.incentives.MsgCreateGaugeResponse")
 	proto.RegisterType((*MsgAddToGauge)(nil), "osmosis.incentives.MsgAddToGauge")
 	proto.RegisterType((*MsgAddToGaugeResponse)(nil), "osmosis.incentives.MsgAddToGaugeResponse")
+	proto.RegisterType((*MsgClaimLockRewards)(nil), "osmosis.incentives.MsgClaimLockRewards")
+	proto.RegisterType((*MsgClaimLockRewardsResponse)(nil), "osmosis.incentives.MsgClaimLockRewardsResponse")
+	proto.RegisterType((*MsgClaimLockReceiptRewards)(nil), "osmosis.incentives.MsgClaimLockReceiptRewards")
+	proto.RegisterType((*MsgClaimLockReceiptRewardsResponse)(nil), "osmosis.incentives.MsgClaimLockReceiptRewardsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/tx.proto", fileDescriptor_8ea120e22291556e) }
 
 var fileDescriptor_8ea120e22291556e = []byte{
-	// 642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0xde, 0xb2, 0xc0, 0xc2, 0x2c, 0x18, 0x68, 0x50, 0xca, 0x6a, 0xda, 0xa5, 0x31, 0x66, 0x25,
-	0xd9, 0x19, 0xc1, 0x84, 0x03, 0x37, 0x97, 0x18, 0xc3, 0x81, 0x88, 0x0d, 0x89, 0x09, 0x89, 0x69,
-	0xa6, 0xed, 0x58, 0x26, 0x6c, 0xfb, 0x9a, 0xce, 0x74, 0x81, 0xbf, 0xe0, 0x89, 0xdf, 0xe1, 0xc9,
-	0x9f, 0xe0, 0x91, 0x23, 0xf1, 0xe4, 0x09, 0x0c, 0x1c, 0xbc, 0xf3, 0x0b, 0x4c, 0xa7, 0x2d, 0xec,
-	0x46, 0x90, 0x8b, 0x97, 0x4e, 0xdf, 0x7c, 0xef, 0x7d, 0xf3, 0xde, 0xf7, 0x4d, 0x8b, 0x9e, 0x82,
-	0x88, 0x40, 0x70, 0x41, 0x78, 0xec, 0xb3, 0x58, 0xf2, 0x01, 0x13, 0x44, 0x1e, 0xe1, 0x24, 0x05,
-	0x09, 0xba, 0x5e, 0x82, 0xf8, 0x16, 0x6c, 0x2d, 0x84, 0x10, 0x82, 0x82, 0x49, 0xfe, 0x56, 0x64,
-	0xb6, 0xe6, 0x69, 0xc4, 0x63, 0x20, 0xea, 0x59, 0x6e, 0x59, 0x21, 0x40, 0xd8, 0x67, 0x44, 0x45,
-	0x5e, 0xf6, 0x99, 0x48, 0x1e, 0x31, 0x21, 0x69, 0x94, 0x94, 0x09, 0xa6, 0xaf, 0xe8, 0x89, 0x47,
-	0x05, 0x23, 0x83, 0x55, 0x8f, 0x49, 0xba, 0x4a, 0x7c, 0xe0, 0x71, 0x85, 0xdf, 0xd1, 0x5a, 0x48,
-	0xb3, 0x90, 0x95, 0xf8, 0x52, 0x85, 0xf7, 0xc1, 0x3f, 0xc8, 0x12, 0xb5, 0x14, 0x90, 0xfd, 0xa3,
-	0x8e, 0x1e, 0x6d, 0x8b, 0x70, 0x33, 0x65, 0x54, 0xb2, 0x77, 0x79, 0x8d, 0xbe, 0x8c, 0x66, 0xb8,
-	0x70, 0x13, 0x96, 0x26, 0x4c, 0x66, 0xb4, 0x6f, 0x68, 0x6d, 0xad, 0x33, 0xe5, 0x34, 0xb9, 0xd8,
-	0xa9, 0xb6, 0xf4, 0x17, 0x68, 0x02, 0x0e, 0x63, 0x96, 0x1a, 0x63, 0x6d, 0xad, 0x33, 0xdd, 0x9b,
-	0xbb, 0x3e, 0xb7, 0x66, 0x8e, 0x69, 0xd4, 0xdf, 0xb0, 0xd5, 0xb6, 0xed, 0x14, 0xb0, 0xbe, 0x85,
-	0x66, 0x03, 0x2e, 0x64, 0xca, 0xbd, 0x4c, 0x32, 0x57, 0x82, 0x51, 0x6f, 0x6b, 0x9d, 0xe6, 0x9a,
-	0x89, 0x2b, 0xb9, 0x8a, 0x86, 0xf0, 0x87, 0x8c, 0xa5, 0xc7, 0x9b, 0x10, 0x07, 0x5c, 0x72, 0x88,
-	0x7b, 0xe3, 0xa7, 0xe7, 0x56, 0xcd, 0x99, 0xb9, 0x2d, 0xdd, 0x05, 0x9d, 0xa2, 0x89, 0x7c, 0x62,
-	0x61, 0x8c, 0xb7, 0xeb, 0x9d, 0xe6, 0xda, 0x12, 0x2e, 0x34, 0xc1, 0xb9, 0x26, 0xb8, 0xd4, 0x04,
-	0x6f, 0x02, 0x8f, 0x7b, 0xaf, 0xf2, 0xea, 0xaf, 0x17, 0x56, 0x27, 0xe4, 0x72, 0x3f, 0xf3, 0xb0,
-	0x0f, 0x11, 0x29, 0x05, 0x2c, 0x96, 0xae, 0x08, 0x0e, 0x88, 0x3c, 0x4e, 0x98, 0x50, 0x05, 0xc2,
-	0x29, 0x98, 0xf5, 0x8f, 0x08, 0x09, 0x49, 0x53, 0xe9, 0xe6, 0xfa, 0x1b, 0x13, 0xaa, 0xd5, 0x16,
-	0x2e, 0xcc, 0xc1, 0x95, 0x39, 0x78, 0xb7, 0x32, 0xa7, 0xf7, 0x2c, 0x3f, 0xe8, 0xfa, 0xdc, 0x9a,
-	0x2b, 0x46, 0xbf, 0x71, 0xcd, 0x3e, 0xb9, 0xb0, 0x34, 0x67, 0x5a, 0x71, 0xe5, 0xd9, 0x3a, 0x41,
-	0x0b, 0x71, 0x16, 0xb9, 0x2c, 0x01, 0x7f, 0x5f, 0xb8, 0x09, 0xe5, 0x81, 0x0b, 0x03, 0x96, 0x1a,
-	0x93, 0x6d, 0xad, 0x33, 0xee, 0xcc, 0xc7, 0x59, 0xf4, 0x56, 0x41, 0x3b, 0x94, 0x07, 0xef, 0x07,
-	0x2c, 0xd5, 0x17, 0x51, 0x23, 0x01, 0xe8, 0xbb, 0x3c, 0x30, 0x1a, 0x2a, 0x67, 0x32, 0x0f, 0xb7,
-	0x82, 0x8d, 0xe7, 0x5f, 0x7e, 0x7f, 0x5b, 0xb1, 0xee, 0xb0, 0xdb, 0x57, 0x06, 0x76, 0x95, 0xeb,
-	0xb6, 0x81, 0x9e, 0x8c, 0x7a, 0xea, 0x30, 0x91, 0x40, 0x2c, 0x98, 0x7d, 0xa1, 0xa1, 0xd9, 0x6d,
-	0x11, 0xbe, 0x09, 0x82, 0x5d, 0x28, 0xdc, 0xbe, 0xb1, 0x52, 0xfb, 0xb7, 0x95, 0x4b, 0x68, 0x4a,
-	0x91, 0xe7, 0x3d, 0x8d, 0xa9, 0x9e, 0x1a, 0x2a, 0xde, 0x0a, 0x74, 0x86, 0x1a, 0x29, 0x3b, 0xa4,
-	0x69, 0x20, 0x8c, 0xfa, 0xff, 0x37, 0xa7, 0xe2, 0xbe, 0x7f, 0x76, 0x1a, 0x04, 0x5d, 0x09, 0xe5,
-	0xec, 0x8b, 0xe8, 0xf1, 0xc8, 0x80, 0xd5, 0xe8, 0x6b, 0xdf, 0x35, 0x54, 0xdf, 0x16, 0xa1, 0xfe,
-	0x09, 0x35, 0x87, 0x6f, 0xbb, 0x8d, 0xff, 0xfe, 0x74, 0xf1, 0xa8, 0x7a, 0xad, 0x95, 0x87, 0x73,
-	0xaa, 0x63, 0xf4, 0x3d, 0x84, 0x86, 0xd4, 0x5d, 0xbe, 0xa7, 0xf2, 0x36, 0xa5, 0xf5, 0xf2, 0xc1,
-	0x94, 0x8a, 0xbb, 0xb7, 0x73, 0x7a, 0x69, 0x6a, 0x67, 0x97, 0xa6, 0xf6, 0xeb, 0xd2, 0xd4, 0x4e,
-	0xae, 0xcc, 0xda, 0xd9, 0x95, 0x59, 0xfb, 0x79, 0x65, 0xd6, 0xf6, 0xd6, 0x87, 0xe4, 0x2c, 0xe9,
-	0xba, 0x7d, 0xea, 0x89, 0x2a, 0x20, 0x83, 0xd5, 0x75, 0x72, 0x34, 0xf2, 0xeb, 0xca, 0x25, 0xf6,
-	0x26, 0xd5, 0xb5, 0x7e, 0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x41, 0x82, 0x0e, 0xdd, 0x04,
-	0x00, 0x00,
+	// 831 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x1c, 0xed, 0x34, 0x6d, 0xd3, 0x4e, 0xdb, 0xa5, 0xeb, 0xdd, 0xa5, 0xae, 0x17, 0xd9, 0x59, 0x8b,
+	0x3f, 0xa1, 0x52, 0xc6, 0xb4, 0x48, 0x3d, 0xf4, 0x04, 0x29, 0x08, 0x55, 0xa2, 0xa2, 0x58, 0x95,
+	0x90, 0x56, 0x42, 0xd6, 0xc4, 0x33, 0x78, 0x47, 0xb5, 0x3d, 0x96, 0x67, 0x9c, 0xdd, 0x1e, 0x91,
+	0x38, 0xc1, 0x65, 0xaf, 0x7c, 0x05, 0x4e, 0x88, 0x23, 0x9f, 0x60, 0x8f, 0x2b, 0x4e, 0x9c, 0x52,
+	0xd4, 0x1e, 0xb8, 0xe7, 0x13, 0xa0, 0x19, 0xdb, 0x69, 0xc2, 0x26, 0x64, 0x2b, 0x01, 0x97, 0xda,
+	0x33, 0xef, 0xfd, 0x7e, 0x7e, 0xbf, 0xf7, 0x3c, 0x75, 0xe0, 0x43, 0x2e, 0x12, 0x2e, 0x98, 0xf0,
+	0x58, 0x1a, 0xd2, 0x54, 0xb2, 0x3e, 0x15, 0x9e, 0x7c, 0x86, 0xb2, 0x9c, 0x4b, 0x6e, 0x18, 0x15,
+	0x88, 0x6e, 0x40, 0xeb, 0x7e, 0xc4, 0x23, 0xae, 0x61, 0x4f, 0xdd, 0x95, 0x4c, 0xeb, 0x2e, 0x4e,
+	0x58, 0xca, 0x3d, 0xfd, 0xb7, 0xda, 0x72, 0x22, 0xce, 0xa3, 0x98, 0x7a, 0x7a, 0xd5, 0x2b, 0xbe,
+	0xf1, 0x24, 0x4b, 0xa8, 0x90, 0x38, 0xc9, 0x2a, 0x82, 0x1d, 0xea, 0xf6, 0x5e, 0x0f, 0x0b, 0xea,
+	0xf5, 0xf7, 0x7a, 0x54, 0xe2, 0x3d, 0x2f, 0xe4, 0x2c, 0xad, 0xf1, 0x29, 0xd2, 0x22, 0x5c, 0x44,
+	0xb4, 0xc2, 0x77, 0x6a, 0x3c, 0xe6, 0xe1, 0x79, 0x91, 0xe9, 0x4b, 0x09, 0xb9, 0xbf, 0x35, 0xe0,
+	0x9d, 0x13, 0x11, 0x1d, 0xe5, 0x14, 0x4b, 0xfa, 0x99, 0xaa, 0x31, 0x1e, 0xc1, 0x0d, 0x26, 0x82,
+	0x8c, 0xe6, 0x19, 0x95, 0x05, 0x8e, 0x4d, 0xd0, 0x02, 0xed, 0x55, 0x7f, 0x9d, 0x89, 0xd3, 0x7a,
+	0xcb, 0x78, 0x17, 0x2e, 0xf3, 0xa7, 0x29, 0xcd, 0xcd, 0xc5, 0x16, 0x68, 0xaf, 0x75, 0xb7, 0x86,
+	0x03, 0x67, 0xe3, 0x02, 0x27, 0xf1, 0xa1, 0xab, 0xb7, 0x5d, 0xbf, 0x84, 0x8d, 0x63, 0xb8, 0x49,
+	0x98, 0x90, 0x39, 0xeb, 0x15, 0x92, 0x06, 0x92, 0x9b, 0x8d, 0x16, 0x68, 0xaf, 0xef, 0xdb, 0xa8,
+	0xb6, 0xab, 0x14, 0x84, 0xbe, 0x2c, 0x68, 0x7e, 0x71, 0xc4, 0x53, 0xc2, 0x24, 0xe3, 0x69, 0x77,
+	0xe9, 0xc5, 0xc0, 0x59, 0xf0, 0x37, 0x6e, 0x4a, 0xcf, 0xb8, 0x81, 0xe1, 0xb2, 0x9a, 0x58, 0x98,
+	0x4b, 0xad, 0x46, 0x7b, 0x7d, 0x7f, 0x07, 0x95, 0x9e, 0x20, 0xe5, 0x09, 0xaa, 0x3c, 0x41, 0x47,
+	0x9c, 0xa5, 0xdd, 0x0f, 0x54, 0xf5, 0x4f, 0x97, 0x4e, 0x3b, 0x62, 0xf2, 0x49, 0xd1, 0x43, 0x21,
+	0x4f, 0xbc, 0xca, 0xc0, 0xf2, 0xd2, 0x11, 0xe4, 0xdc, 0x93, 0x17, 0x19, 0x15, 0xba, 0x40, 0xf8,
+	0x65, 0x67, 0xe3, 0x2b, 0x08, 0x85, 0xc4, 0xb9, 0x0c, 0x94, 0xff, 0xe6, 0xb2, 0x96, 0x6a, 0xa1,
+	0x32, 0x1c, 0x54, 0x87, 0x83, 0xce, 0xea, 0x70, 0xba, 0x6f, 0xa9, 0x07, 0x0d, 0x07, 0xce, 0x56,
+	0x39, 0xfa, 0x28, 0x35, 0xf7, 0xf9, 0xa5, 0x03, 0xfc, 0x35, 0xdd, 0x4b, 0xb1, 0x0d, 0x0f, 0xde,
+	0x4f, 0x8b, 0x24, 0xa0, 0x19, 0x0f, 0x9f, 0x88, 0x20, 0xc3, 0x8c, 0x04, 0xbc, 0x4f, 0x73, 0x73,
+	0xa5, 0x05, 0xda, 0x4b, 0xfe, 0xdd, 0xb4, 0x48, 0x3e, 0xd5, 0xd0, 0x29, 0x66, 0xe4, 0x8b, 0x3e,
+	0xcd, 0x8d, 0x6d, 0xd8, 0xcc, 0x38, 0x8f, 0x03, 0x46, 0xcc, 0xa6, 0xe6, 0xac, 0xa8, 0xe5, 0x31,
+	0x39, 0x7c, 0xfb, 0xfb, 0x3f, 0x7f, 0xde, 0x75, 0xa6, 0xc4, 0x1d, 0xea, 0x00, 0x3b, 0x3a, 0x75,
+	0xd7, 0x84, 0x6f, 0x4e, 0x66, 0xea, 0x53, 0x91, 0xf1, 0x54, 0x50, 0xf7, 0x12, 0xc0, 0xcd, 0x13,
+	0x11, 0x7d, 0x4c, 0xc8, 0x19, 0x2f, 0xd3, 0x1e, 0x45, 0x09, 0xfe, 0x39, 0xca, 0x1d, 0xb8, 0xaa,
+	0x9b, 0x2b, 0x4d, 0x8b, 0x5a, 0x53, 0x53, 0xaf, 0x8f, 0x89, 0x41, 0x61, 0x33, 0xa7, 0x4f, 0x71,
+	0x4e, 0x84, 0xd9, 0xf8, 0xf7, 0xc3, 0xa9, 0x7b, 0xcf, 0x9e, 0x1d, 0x13, 0xd2, 0x91, 0xbc, 0x9a,
+	0x7d, 0x1b, 0x3e, 0x98, 0x18, 0x70, 0x34, 0xfa, 0x8f, 0x00, 0xde, 0x53, 0xae, 0xc4, 0x98, 0x25,
+	0x9f, 0xf3, 0xf0, 0xdc, 0x2f, 0xdb, 0xbe, 0xb6, 0x01, 0x08, 0xae, 0xaa, 0xb7, 0x35, 0x60, 0x44,
+	0x98, 0x8b, 0xad, 0x46, 0x7b, 0xa9, 0x7b, 0x6f, 0x38, 0x70, 0xde, 0x28, 0xa9, 0x35, 0xe2, 0xfa,
+	0x4d, 0x75, 0x7b, 0x4c, 0xc4, 0xe1, 0xae, 0x92, 0xfb, 0xce, 0xb4, 0xa8, 0x94, 0x82, 0x8e, 0xa2,
+	0x75, 0xaa, 0xd1, 0xdc, 0xef, 0x00, 0x7c, 0x38, 0x45, 0x5b, 0xad, 0x5d, 0x39, 0xac, 0xab, 0x28,
+	0x31, 0xc1, 0x7f, 0xe0, 0x70, 0xd5, 0xdb, 0xfd, 0x15, 0x40, 0x6b, 0x52, 0x46, 0x48, 0x59, 0x26,
+	0x6f, 0xeb, 0xd4, 0x47, 0xf0, 0x4e, 0x5e, 0x56, 0x06, 0x84, 0xa6, 0x3c, 0x29, 0xfd, 0x5a, 0xeb,
+	0xee, 0x0c, 0x07, 0xce, 0x83, 0xb2, 0x60, 0x12, 0x77, 0xfd, 0xcd, 0x6a, 0xe3, 0x13, 0xbd, 0x3e,
+	0xdc, 0x57, 0xde, 0x75, 0xe6, 0x79, 0xa7, 0x2b, 0x46, 0x1e, 0xfe, 0x00, 0xa0, 0x3b, 0x5b, 0xfc,
+	0xff, 0x6c, 0xe5, 0xfe, 0x2f, 0x0d, 0xd8, 0x38, 0x11, 0x91, 0xf1, 0x35, 0x5c, 0x1f, 0xff, 0xdf,
+	0xea, 0xa2, 0x57, 0x3f, 0x14, 0x68, 0xf2, 0xac, 0x5a, 0xbb, 0xf3, 0x39, 0xa3, 0x69, 0x1e, 0x43,
+	0x38, 0x76, 0x96, 0x1f, 0xcd, 0xa8, 0xbc, 0xa1, 0x58, 0xef, 0xcf, 0xa5, 0x8c, 0x7a, 0xc7, 0x70,
+	0xeb, 0x95, 0xc3, 0xf2, 0xde, 0x2c, 0x6d, 0x7f, 0x23, 0x5a, 0xde, 0x6b, 0x12, 0x47, 0x4f, 0xfb,
+	0x16, 0xc0, 0xed, 0x59, 0x2f, 0x1e, 0x9a, 0xdf, 0x6c, 0x9c, 0x6f, 0x1d, 0xdc, 0x8e, 0x5f, 0x6b,
+	0xe8, 0x9e, 0x3e, 0x3e, 0x18, 0x4b, 0xba, 0xea, 0xd1, 0x89, 0x71, 0x4f, 0xd4, 0x0b, 0xaf, 0xbf,
+	0x77, 0xe0, 0x3d, 0x9b, 0xf8, 0x09, 0xa0, 0xd2, 0x7f, 0x71, 0x65, 0x83, 0x97, 0x57, 0x36, 0xf8,
+	0xe3, 0xca, 0x06, 0xcf, 0xaf, 0xed, 0x85, 0x97, 0xd7, 0xf6, 0xc2, 0xef, 0xd7, 0xf6, 0x42, 0x6f,
+	0x45, 0x7f, 0x36, 0x3e, 0xfc, 0x6b, 0x00, 0xa0, 0xe5, 0x73, 0xb8, 0x3d, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -352,6 +569,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
 	AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...grpc.CallOption) (*MsgAddToGaugeResponse, error)
+	ClaimLockRewards(ctx context.Context, in *MsgClaimLockRewards, opts ...grpc.CallOption) (*MsgClaimLockRewardsResponse, error)
+	ClaimLockReceiptRewards(ctx context.Context, in *MsgClaimLockReceiptRewards, opts ...grpc.CallOption) (*MsgClaimLockReceiptRewardsResponse, error)
 }
 
 type msgClient struct {
@@ -380,10 +599,30 @@ func (c *msgClient) AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ClaimLockRewards(ctx context.Context, in *MsgClaimLockRewards, opts ...grpc.CallOption) (*MsgClaimLockRewardsResponse, error) {
+	out := new(MsgClaimLockRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/ClaimLockRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimLockReceiptRewards(ctx context.Context, in *MsgClaimLockReceiptRewards, opts ...grpc.CallOption) (*MsgClaimLockReceiptRewardsResponse, error) {
+	out := new(MsgClaimLockReceiptRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/ClaimLockReceiptRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
 	AddToGauge(context.Context, *MsgAddToGauge) (*MsgAddToGaugeResponse, error)
+	ClaimLockRewards(context.Context, *MsgClaimLockRewards) (*MsgClaimLockRewardsResponse, error)
+	ClaimLockReceiptRewards(context.Context, *MsgClaimLockReceiptRewards) (*MsgClaimLockReceiptRewardsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -396,6 +635,12 @@ func (*UnimplementedMsgServer) CreateGauge(ctx context.Context, req *MsgCreateGa
 func (*UnimplementedMsgServer) AddToGauge(ctx context.Context, req *MsgAddToGauge) (*MsgAddToGaugeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToGauge not implemented")
 }
+func (*UnimplementedMsgServer) ClaimLockRewards(ctx context.Context, req *MsgClaimLockRewards) (*MsgClaimLockRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimLockRewards not implemented")
+}
+func (*UnimplementedMsgServer) ClaimLockReceiptRewards(ctx context.Context, req *MsgClaimLockReceiptRewards) (*MsgClaimLockReceiptRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimLockReceiptRewards not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -437,6 +682,42 @@ func _Msg_AddToGauge_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimLockRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimLockRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimLockRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/ClaimLockRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimLockRewards(ctx, req.(*MsgClaimLockRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimLockReceiptRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimLockReceiptRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimLockReceiptRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/ClaimLockReceiptRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimLockReceiptRewards(ctx, req.(*MsgClaimLockReceiptRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -449,6 +730,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddToGauge",
 			Handler:    _Msg_AddToGauge_Handler,
 		},
+		{
+			MethodName: "ClaimLockRewards",
+			Handler:    _Msg_ClaimLockRewards_Handler,
+		},
+		{
+			MethodName: "ClaimLockReceiptRewards",
+			Handler:    _Msg_ClaimLockReceiptRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/tx.proto",
@@ -631,72 +920,233 @@ func (m *MsgAddToGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgClaimLockRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateGauge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgClaimLockRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimLockRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IsPerpetual {
-		n += 2
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.DistributeTo.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.LockIds) > 0 {
+		dAtA3 := make([]byte, len(m.LockIds)*10)
+		var j2 int
+		for _, num := range m.LockIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	if m.NumEpochsPaidOver != 0 {
-		n += 1 + sovTx(uint64(m.NumEpochsPaidOver))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClaimLockRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddToGauge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgClaimLockRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimLockRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GaugeId != 0 {
-		n += 1 + sovTx(uint64(m.GaugeId))
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if len(m.Rewards) > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimLockReceiptRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimLockReceiptRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimLockReceiptRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiptDenoms) > 0 {
+		for iNdEx := len(m.ReceiptDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiptDenoms[iNdEx])
+			copy(dAtA[i:], m.ReceiptDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimLockReceiptRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimLockReceiptRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimLockReceiptRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsPerpetual {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.DistributeTo.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.NumEpochsPaidOver != 0 {
+		n += 1 + sovTx(uint64(m.NumEpochsPaidOver))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgCreateGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddToGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovTx(uint64(m.GaugeId))
+	}
+	if len(m.Rewards) > 0 {
 		for _, e := range m.Rewards {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
@@ -714,6 +1164,75 @@ func (m *MsgAddToGaugeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimLockRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LockIds) > 0 {
+		l = 0
+		for _, e := range m.LockIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgClaimLockRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimLockReceiptRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ReceiptDenoms) > 0 {
+		for _, s := range m.ReceiptDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimLockReceiptRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1195,6 +1714,448 @@ func (m *MsgAddToGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
+func (m *MsgClaimLockRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimLockRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimLockRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIds = append(m.LockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIds) == 0 {
+					m.LockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIds = append(m.LockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgClaimLockRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimLockRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimLockRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, types1.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimLockReceiptRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimLockReceiptRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimLockReceiptRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptDenoms = append(m.ReceiptDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimLockReceiptRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimLockReceiptRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimLockReceiptRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, types1.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
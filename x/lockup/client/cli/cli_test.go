@@ -64,6 +64,124 @@ func TestBeginUnlockingByIDCmd(t *testing.T) {
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestSplitLockCmd(t *testing.T) {
+	desc, _ := NewSplitLockCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgSplitLock]{
+		"basic test": {
+			Cmd: "10 5uosmo --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSplitLock{
+				Owner: testAddresses[0].String(),
+				ID:    10,
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 5)),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestMergeLocksCmd(t *testing.T) {
+	desc, _ := NewMergeLocksCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgMergeLocks]{
+		"basic test": {
+			Cmd: "10,11,12 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgMergeLocks{
+				Owner:   testAddresses[0].String(),
+				LockIDs: []uint64{10, 11, 12},
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestTransferLockCmd(t *testing.T) {
+	desc, _ := NewTransferLockCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgTransferLock]{
+		"basic test": {
+			Cmd: "10 " + testAddresses[1].String() + " --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgTransferLock{
+				Owner:     testAddresses[0].String(),
+				ID:        10,
+				Recipient: testAddresses[1].String(),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestCancelUnlockingCmd(t *testing.T) {
+	desc, _ := NewCancelUnlockingCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgCancelUnlocking]{
+		"basic test no coins": {
+			Cmd: "10 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgCancelUnlocking{
+				Owner: testAddresses[0].String(),
+				ID:    10,
+				Coins: sdk.Coins(nil),
+			},
+		},
+		"basic test w/ coins": {
+			Cmd: "10 --amount=5uosmo --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgCancelUnlocking{
+				Owner: testAddresses[0].String(),
+				ID:    10,
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 5)),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestInstantUnlockCmd(t *testing.T) {
+	desc, _ := NewInstantUnlockCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgInstantUnlock]{
+		"basic test no coins": {
+			Cmd: "10 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgInstantUnlock{
+				Owner: testAddresses[0].String(),
+				ID:    10,
+				Coins: sdk.Coins(nil),
+			},
+		},
+		"basic test w/ coins": {
+			Cmd: "10 --amount=5uosmo --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgInstantUnlock{
+				Owner: testAddresses[0].String(),
+				ID:    10,
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 5)),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestTokenizeLockCmd(t *testing.T) {
+	desc, _ := NewTokenizeLockCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgTokenizeLock]{
+		"basic test": {
+			Cmd: "10 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgTokenizeLock{
+				Owner: testAddresses[0].String(),
+				ID:    10,
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestRedeemLockReceiptCmd(t *testing.T) {
+	desc, _ := NewRedeemLockReceiptCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgRedeemLockReceipt]{
+		"basic test": {
+			Cmd: "5lockup/receipt/gamm/pool/1/24h0m0s --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgRedeemLockReceipt{
+				Owner:   testAddresses[0].String(),
+				Receipt: sdk.NewInt64Coin("lockup/receipt/gamm/pool/1/24h0m0s", 5),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestModuleBalanceCmd(t *testing.T) {
 	desc, _ := GetCmdModuleBalance()
 	tcs := map[string]osmocli.QueryCliTestCase[*types.ModuleBalanceRequest]{
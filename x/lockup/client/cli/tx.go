@@ -15,6 +15,13 @@ func GetTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(cmd, NewBeginUnlockingAllCmd)
 	osmocli.AddTxCmd(cmd, NewBeginUnlockByIDCmd)
 	osmocli.AddTxCmd(cmd, NewForceUnlockByIdCmd)
+	osmocli.AddTxCmd(cmd, NewSplitLockCmd)
+	osmocli.AddTxCmd(cmd, NewMergeLocksCmd)
+	osmocli.AddTxCmd(cmd, NewTransferLockCmd)
+	osmocli.AddTxCmd(cmd, NewCancelUnlockingCmd)
+	osmocli.AddTxCmd(cmd, NewInstantUnlockCmd)
+	osmocli.AddTxCmd(cmd, NewTokenizeLockCmd)
+	osmocli.AddTxCmd(cmd, NewRedeemLockReceiptCmd)
 
 	return cmd
 }
@@ -62,3 +69,74 @@ func NewForceUnlockByIdCmd() (*osmocli.TxCliDesc, *types.MsgForceUnlock) {
 		Flags: osmocli.FlagDesc{OptionalFlags: []*pflag.FlagSet{FlagSetUnlockTokens()}},
 	}, &types.MsgForceUnlock{}
 }
+
+// NewSplitLockCmd splits the given amount of a period lock into a new lock.
+func NewSplitLockCmd() (*osmocli.TxCliDesc, *types.MsgSplitLock) {
+	return &osmocli.TxCliDesc{
+		Use:     "split-lock [id] [coins]",
+		Short:   "split the given amount of a period lock into a new lock",
+		Example: "osmosisd tx lockup split-lock 75 1000000gamm/pool/1 --from WALLET_NAME",
+	}, &types.MsgSplitLock{}
+}
+
+// NewMergeLocksCmd merges period locks of the same denom and duration into the first of them.
+func NewMergeLocksCmd() (*osmocli.TxCliDesc, *types.MsgMergeLocks) {
+	return &osmocli.TxCliDesc{
+		Use:     "merge-locks [lock-ids]",
+		Short:   "merge period locks of the same denom and duration into the first of them",
+		Example: "osmosisd tx lockup merge-locks 75,76,77 --from WALLET_NAME",
+	}, &types.MsgMergeLocks{}
+}
+
+// NewTransferLockCmd transfers the ownership of a period lock to the recipient.
+func NewTransferLockCmd() (*osmocli.TxCliDesc, *types.MsgTransferLock) {
+	return &osmocli.TxCliDesc{
+		Use:     "transfer-lock [id] [recipient]",
+		Short:   "transfer the ownership of a period lock to the recipient",
+		Example: "osmosisd tx lockup transfer-lock 75 osmo1... --from WALLET_NAME",
+	}, &types.MsgTransferLock{}
+}
+
+// NewCancelUnlockingCmd moves an unlocking period lock back to the locked state.
+func NewCancelUnlockingCmd() (*osmocli.TxCliDesc, *types.MsgCancelUnlocking) {
+	return &osmocli.TxCliDesc{
+		Use:   "cancel-unlocking [id]",
+		Short: "move an unlocking period lock back to the locked state",
+		Long:  "move an unlocking period lock back to the locked state with its original duration. if no amount provided, entire lock is re-locked",
+		CustomFlagOverrides: map[string]string{
+			"coins": FlagAmount,
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*pflag.FlagSet{FlagSetUnlockTokens()}},
+	}, &types.MsgCancelUnlocking{}
+}
+
+// NewInstantUnlockCmd instantly unlocks a period lock by ID in exchange for a penalty.
+func NewInstantUnlockCmd() (*osmocli.TxCliDesc, *types.MsgInstantUnlock) {
+	return &osmocli.TxCliDesc{
+		Use:   "instant-unlock [id]",
+		Short: "instantly unlock a period lock by ID in exchange for a penalty",
+		Long:  "instantly unlock a period lock by ID, paying a penalty proportional to the remaining lock duration. only allowed for denoms with early unlock enabled. if no amount provided, entire lock is unlocked",
+		CustomFlagOverrides: map[string]string{
+			"coins": FlagAmount,
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*pflag.FlagSet{FlagSetUnlockTokens()}},
+	}, &types.MsgInstantUnlock{}
+}
+
+// NewTokenizeLockCmd tokenizes a period lock by ID into lock receipt tokens.
+func NewTokenizeLockCmd() (*osmocli.TxCliDesc, *types.MsgTokenizeLock) {
+	return &osmocli.TxCliDesc{
+		Use:   "tokenize-lock [id]",
+		Short: "tokenize a period lock by ID into lock receipt tokens",
+		Long:  "tokenize a period lock by ID, moving it into the pooled lock of its denom and duration and minting lock receipt tokens 1:1 with the locked tokens",
+	}, &types.MsgTokenizeLock{}
+}
+
+// NewRedeemLockReceiptCmd redeems lock receipt tokens back into a period lock.
+func NewRedeemLockReceiptCmd() (*osmocli.TxCliDesc, *types.MsgRedeemLockReceipt) {
+	return &osmocli.TxCliDesc{
+		Use:   "redeem-lock-receipt [receipt]",
+		Short: "redeem lock receipt tokens back into a period lock",
+		Long:  "burn lock receipt tokens and move the claimed tokens out of the pooled lock into a period lock of the sender, with the same duration",
+	}, &types.MsgRedeemLockReceipt{}
+}
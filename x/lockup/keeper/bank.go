@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+)
+
+// LockReceiptBankMsgServer wraps the message server of the bank module.
+// Multi-sends do not call the bank send hooks, which keep the reward positions of lock receipt holders in sync
+// with their balances, so multi-sends of lock receipt tokens are rejected.
+type LockReceiptBankMsgServer struct {
+	banktypes.MsgServer
+}
+
+var _ banktypes.MsgServer = LockReceiptBankMsgServer{}
+
+// NewLockReceiptBankMsgServer returns the given bank message server wrapped to reject multi-sends of lock receipt tokens.
+func NewLockReceiptBankMsgServer(msgServer banktypes.MsgServer) LockReceiptBankMsgServer {
+	return LockReceiptBankMsgServer{MsgServer: msgServer}
+}
+
+// MultiSend performs the multi-send of the wrapped message server.
+// Returns error if any of the inputs sends lock receipt tokens.
+func (server LockReceiptBankMsgServer) MultiSend(goCtx context.Context, msg *banktypes.MsgMultiSend) (*banktypes.MsgMultiSendResponse, error) {
+	for _, input := range msg.Inputs {
+		for _, coin := range input.Coins {
+			if types.IsLockReceiptDenom(coin.Denom) {
+				return nil, errorsmod.Wrapf(types.ErrLockReceiptMultiSend, "denom %s", coin.Denom)
+			}
+		}
+	}
+	return server.MsgServer.MultiSend(goCtx, msg)
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+)
+
+// InstantUnlock immediately unlocks the given coins of the owner's lock, regardless of its remaining duration,
+// and charges the owner a penalty proportional to the remaining duration. Returns the penalty charged.
+// Coins provided as the parameter does not require to have all the tokens in the lock. In that case,
+// the lock is split and only the newly created lock is unlocked, while the rest stays in the lock.
+// The penalty of each denom is sent to the community pool, or kept in the module account to be redistributed
+// to the remaining lockers of the denom, as configured in the early unlock params.
+// Instant unlocking would fail on either of the following conditions.
+// 1. Only lock owner is able to instantly unlock the lock.
+// 2. Early unlock must be enabled for every denom of the unlocked coins.
+// 3. Locks that have synthetic lockup or lock concentrated liquidity shares are not allowed to instantly unlock.
+func (k Keeper) InstantUnlock(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, coins sdk.Coins) (sdk.Coins, error) {
+	lock, err := k.getEditableLock(ctx, lockID, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !coins.IsAllLTE(lock.Coins) {
+		return nil, fmt.Errorf("requested amount to unlock exceeds locked tokens")
+	}
+
+	coinsToUnlock := coins
+	if len(coins) == 0 {
+		coinsToUnlock = lock.Coins
+	}
+
+	penalty, err := k.GetEarlyUnlockPenalty(ctx, *lock, coinsToUnlock)
+	if err != nil {
+		return nil, err
+	}
+
+	// the unlocked coins are sent back to the owner in full, and the penalty is then charged from the owner.
+	err = k.PartialForceUnlock(ctx, *lock, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	communityPoolPenalty, redistributedPenalty := sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range penalty {
+		config, _ := k.GetEarlyUnlockConfig(ctx, coin.Denom)
+		if config.RedistributeToLockers {
+			redistributedPenalty = redistributedPenalty.Add(coin)
+		} else {
+			communityPoolPenalty = communityPoolPenalty.Add(coin)
+		}
+	}
+
+	if !communityPoolPenalty.Empty() {
+		err = k.ck.FundCommunityPool(ctx, communityPoolPenalty, owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !redistributedPenalty.Empty() {
+		err = k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, redistributedPenalty)
+		if err != nil {
+			return nil, err
+		}
+		for _, coin := range redistributedPenalty {
+			k.addEarlyUnlockPenalty(ctx, coin)
+		}
+	}
+
+	return penalty, nil
+}
+
+// GetEarlyUnlockPenalty returns the penalty charged for instantly unlocking the given coins of the lock.
+// The penalty of each coin is its amount multiplied by the max penalty of its denom, scaled linearly by
+// the fraction of the lock duration remaining, rounded up.
+// Returns an error if early unlock is not enabled for any of the denoms of the given coins.
+func (k Keeper) GetEarlyUnlockPenalty(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coins) (sdk.Coins, error) {
+	// locks that have not started unlocking have their entire duration remaining.
+	remaining := lock.Duration
+	if lock.IsUnlocking() {
+		remaining = lock.EndTime.Sub(ctx.BlockTime())
+		if remaining < 0 {
+			remaining = 0
+		}
+	}
+
+	remainingFraction := sdk.ZeroDec()
+	if lock.Duration > 0 {
+		remainingFraction = sdk.NewDec(int64(remaining)).QuoInt64(int64(lock.Duration))
+	}
+
+	penalty := sdk.NewCoins()
+	for _, coin := range coins {
+		config, found := k.GetEarlyUnlockConfig(ctx, coin.Denom)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrEarlyUnlockNotEnabled, "denom %s", coin.Denom)
+		}
+
+		penaltyAmount := config.MaxPenalty.Mul(remainingFraction).MulInt(coin.Amount).Ceil().TruncateInt()
+		penalty = penalty.Add(sdk.NewCoin(coin.Denom, penaltyAmount))
+	}
+
+	return penalty, nil
+}
+
+// GetEarlyUnlockPenalties returns the early unlock penalties held by the module account that are
+// pending redistribution to lockers.
+func (k Keeper) GetEarlyUnlockPenalties(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEarlyUnlockPenalty)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	penalties := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		penalties = penalties.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return penalties
+}
+
+// RemoveEarlyUnlockPenalty subtracts the given coin from the early unlock penalty of its denom pending redistribution,
+// and removes the penalty once it is entirely redistributed.
+// Called once the coin has been moved out of the module account to be redistributed.
+func (k Keeper) RemoveEarlyUnlockPenalty(ctx sdk.Context, coin sdk.Coin) error {
+	penalty := k.GetEarlyUnlockPenalties(ctx).AmountOf(coin.Denom)
+	if coin.Amount.GT(penalty) {
+		return fmt.Errorf("cannot remove %s from early unlock penalty %s%s", coin, penalty, coin.Denom)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEarlyUnlockPenalty)
+	store.Delete([]byte(coin.Denom))
+	k.addEarlyUnlockPenalty(ctx, sdk.NewCoin(coin.Denom, penalty.Sub(coin.Amount)))
+	return nil
+}
+
+// addEarlyUnlockPenalty adds the given coin to the early unlock penalty of its denom pending redistribution.
+func (k Keeper) addEarlyUnlockPenalty(ctx sdk.Context, coin sdk.Coin) {
+	if !coin.IsPositive() {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEarlyUnlockPenalty)
+
+	amount := coin.Amount
+	if bz := store.Get([]byte(coin.Denom)); bz != nil {
+		var existing sdk.Int
+		if err := existing.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+		amount = amount.Add(existing)
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(coin.Denom), bz)
+}
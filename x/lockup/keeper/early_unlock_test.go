@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/lockup/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+)
+
+func (s *KeeperTestSuite) TestInstantUnlock() {
+	defaultCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	tests := []struct {
+		name                  string
+		coinsToUnlock         sdk.Coins
+		earlyUnlockDenom      string
+		redistributeToLockers bool
+		isNotOwner            bool
+		withSynthLock         bool
+		elapsedUnlocking      time.Duration
+		expectedPenalty       sdk.Coins
+		expectPass            bool
+	}{
+		{
+			name:            "instant unlock of the entire lock",
+			expectedPenalty: sdk.NewCoins(sdk.NewInt64Coin("stake", 20)),
+			expectPass:      true,
+		},
+		{
+			name:            "instant unlock of part of the lock",
+			coinsToUnlock:   sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+			expectedPenalty: sdk.NewCoins(sdk.NewInt64Coin("stake", 8)),
+			expectPass:      true,
+		},
+		{
+			name:            "instant unlock rounds the penalty up",
+			coinsToUnlock:   sdk.NewCoins(sdk.NewInt64Coin("stake", 3)),
+			expectedPenalty: sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+			expectPass:      true,
+		},
+		{
+			name:             "instant unlock of an unlocking lock is charged for the remaining duration",
+			elapsedUnlocking: time.Minute / 2,
+			expectedPenalty:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			expectPass:       true,
+		},
+		{
+			name:                  "instant unlock with penalty redistributed to lockers",
+			redistributeToLockers: true,
+			expectedPenalty:       sdk.NewCoins(sdk.NewInt64Coin("stake", 20)),
+			expectPass:            true,
+		},
+		{
+			name:          "error: instant unlock of more than the lock",
+			coinsToUnlock: sdk.NewCoins(sdk.NewInt64Coin("stake", 101)),
+		},
+		{
+			name:             "error: early unlock not enabled for denom",
+			earlyUnlockDenom: "uosmo",
+		},
+		{
+			name:       "error: sender is not the owner of the lock",
+			isNotOwner: true,
+		},
+		{
+			name:          "error: lock has a synthetic lock",
+			withSynthLock: true,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			owner := s.TestAccs[0]
+
+			earlyUnlockDenom := "stake"
+			if test.earlyUnlockDenom != "" {
+				earlyUnlockDenom = test.earlyUnlockDenom
+			}
+			params := s.App.LockupKeeper.GetParams(s.Ctx)
+			params.EarlyUnlockConfigs = []types.EarlyUnlockConfig{{
+				Denom:                 earlyUnlockDenom,
+				MaxPenalty:            sdk.NewDecWithPrec(2, 1),
+				RedistributeToLockers: test.redistributeToLockers,
+			}}
+			s.App.LockupKeeper.SetParams(s.Ctx, params)
+
+			s.FundAcc(owner, defaultCoins)
+			lock, err := s.App.LockupKeeper.CreateLock(s.Ctx, owner, defaultCoins, time.Minute)
+			s.Require().NoError(err)
+			if test.elapsedUnlocking != 0 {
+				_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, lock.ID, nil)
+				s.Require().NoError(err)
+				s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(test.elapsedUnlocking))
+			}
+			if test.withSynthLock {
+				err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, lock.ID, "synthstakestakedtovalidator", time.Minute, false)
+				s.Require().NoError(err)
+			}
+			sender := owner
+			if test.isNotOwner {
+				sender = s.TestAccs[1]
+			}
+			communityPoolBefore := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+
+			penalty, err := s.App.LockupKeeper.InstantUnlock(s.Ctx, lock.ID, sender, test.coinsToUnlock)
+			if !test.expectPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedPenalty.String(), penalty.String())
+
+			// the owner receives the unlocked coins minus the penalty
+			unlockedCoins := defaultCoins
+			if !test.coinsToUnlock.Empty() {
+				unlockedCoins = test.coinsToUnlock
+			}
+			s.Require().Equal(unlockedCoins.Sub(penalty).String(), s.App.BankKeeper.GetAllBalances(s.Ctx, owner).String())
+			s.Require().Equal(defaultCoins.Sub(unlockedCoins).String(), s.App.LockupKeeper.GetAccountLockedCoins(s.Ctx, owner).String())
+
+			// the penalty is either kept for redistribution or sent to the community pool
+			communityPoolIncrease := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx).Sub(communityPoolBefore)
+			if test.redistributeToLockers {
+				s.Require().Equal(penalty.String(), s.App.LockupKeeper.GetEarlyUnlockPenalties(s.Ctx).String())
+				s.Require().True(communityPoolIncrease.IsZero())
+			} else {
+				s.Require().True(s.App.LockupKeeper.GetEarlyUnlockPenalties(s.Ctx).Empty())
+				s.Require().Equal(sdk.NewDecCoinsFromCoins(penalty...).String(), communityPoolIncrease.String())
+			}
+
+			// module balance still matches the locks and the penalties pending redistribution
+			_, broken := keeper.LocksBalancesInvariant(*s.App.LockupKeeper)(s.Ctx)
+			s.Require().False(broken)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEarlyUnlockPenalties() {
+	s.SetupTest()
+
+	s.Require().True(s.App.LockupKeeper.GetEarlyUnlockPenalties(s.Ctx).Empty())
+
+	// penalties accumulate per denom
+	s.App.LockupKeeper.AddEarlyUnlockPenalty(s.Ctx, sdk.NewInt64Coin("stake", 10))
+	s.App.LockupKeeper.AddEarlyUnlockPenalty(s.Ctx, sdk.NewInt64Coin("stake", 5))
+	s.App.LockupKeeper.AddEarlyUnlockPenalty(s.Ctx, sdk.NewInt64Coin("uosmo", 7))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 15), sdk.NewInt64Coin("uosmo", 7)), s.App.LockupKeeper.GetEarlyUnlockPenalties(s.Ctx))
+
+	// removing more than the penalty of a denom fails
+	err := s.App.LockupKeeper.RemoveEarlyUnlockPenalty(s.Ctx, sdk.NewInt64Coin("stake", 16))
+	s.Require().Error(err)
+
+	// removing part of the penalty of a denom leaves the remainder pending
+	err = s.App.LockupKeeper.RemoveEarlyUnlockPenalty(s.Ctx, sdk.NewInt64Coin("stake", 10))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("uosmo", 7)), s.App.LockupKeeper.GetEarlyUnlockPenalties(s.Ctx))
+
+	// removing the rest of a denom leaves the others untouched
+	err = s.App.LockupKeeper.RemoveEarlyUnlockPenalty(s.Ctx, sdk.NewInt64Coin("stake", 5))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 7)), s.App.LockupKeeper.GetEarlyUnlockPenalties(s.Ctx))
+}
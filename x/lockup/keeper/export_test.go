@@ -29,3 +29,7 @@ func (k Keeper) Lock(ctx sdk.Context, lock types.PeriodLock, tokensToLock sdk.Co
 func (k Keeper) UnlockMaturedLockInternalLogic(ctx sdk.Context, lock types.PeriodLock) error {
 	return k.unlockMaturedLockInternalLogic(ctx, lock)
 }
+
+func (k Keeper) AddEarlyUnlockPenalty(ctx sdk.Context, coin sdk.Coin) {
+	k.addEarlyUnlockPenalty(ctx, coin)
+}
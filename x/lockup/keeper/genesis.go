@@ -17,6 +17,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if err := k.InitializeAllSyntheticLocks(ctx, genState.SyntheticLocks); err != nil {
 		return
 	}
+	for _, penalty := range genState.EarlyUnlockPenalties {
+		k.addEarlyUnlockPenalty(ctx, penalty)
+	}
+	for _, lockID := range genState.LockReceiptPooledLockIds {
+		lock, err := k.GetLockByID(ctx, lockID)
+		if err != nil {
+			panic(err)
+		}
+		k.setLockReceiptPooledLockID(ctx, types.LockReceiptDenom(lock.Coins[0].Denom, lock.Duration), lock.ID)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -25,9 +35,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err != nil {
 		panic(err)
 	}
+	pooledLockIds := []uint64{}
+	for _, lock := range k.GetLockReceiptPooledLocks(ctx) {
+		pooledLockIds = append(pooledLockIds, lock.ID)
+	}
 	return &types.GenesisState{
-		LastLockId:     k.GetLastLockID(ctx),
-		Locks:          locks,
-		SyntheticLocks: k.GetAllSyntheticLockups(ctx),
+		LastLockId:               k.GetLastLockID(ctx),
+		Locks:                    locks,
+		SyntheticLocks:           k.GetAllSyntheticLockups(ctx),
+		EarlyUnlockPenalties:     k.GetEarlyUnlockPenalties(ctx),
+		LockReceiptPooledLockIds: pooledLockIds,
 	}
 }
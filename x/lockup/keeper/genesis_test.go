@@ -14,6 +14,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var (
@@ -127,6 +128,45 @@ func TestExportGenesis(t *testing.T) {
 	})
 }
 
+func TestLockReceiptPooledLocksGenesis(t *testing.T) {
+	app := osmoapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithBlockTime(now.Add(time.Second))
+
+	// the module account owns another lock of the same denom and duration than the pooled lock,
+	// which must not be mistaken for the pooled lock.
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+	receiptDenom := types.LockReceiptDenom("foo", time.Minute)
+	genesis := types.GenesisState{
+		LastLockId: 2,
+		Locks: []types.PeriodLock{
+			{
+				ID:                    1,
+				Owner:                 moduleAddress.String(),
+				RewardReceiverAddress: "",
+				Duration:              time.Minute,
+				Coins:                 sdk.Coins{sdk.NewInt64Coin("foo", 5000000)},
+			},
+			{
+				ID:                    2,
+				Owner:                 moduleAddress.String(),
+				RewardReceiverAddress: types.LockReceiptRewardsAddress(receiptDenom).String(),
+				Duration:              time.Minute,
+				Coins:                 sdk.Coins{sdk.NewInt64Coin("foo", 10000000)},
+			},
+		},
+		LockReceiptPooledLockIds: []uint64{2},
+	}
+	app.LockupKeeper.InitGenesis(ctx, genesis)
+
+	pooledLocks := app.LockupKeeper.GetLockReceiptPooledLocks(ctx)
+	require.Len(t, pooledLocks, 1)
+	require.Equal(t, uint64(2), pooledLocks[0].ID)
+
+	genesisExported := app.LockupKeeper.ExportGenesis(ctx)
+	require.Equal(t, []uint64{2}, genesisExported.LockReceiptPooledLockIds)
+}
+
 func TestMarshalUnmarshalGenesis(t *testing.T) {
 	app := osmoapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -571,7 +571,7 @@ func (s *KeeperTestSuite) TestParams() {
 	s.Require().Equal([]string(nil), res.Params.ForceUnlockAllowedAddresses)
 
 	// Set new params & query
-	s.App.LockupKeeper.SetParams(s.Ctx, types.NewParams([]string{s.TestAccs[0].String()}))
+	s.App.LockupKeeper.SetParams(s.Ctx, types.NewParams([]string{s.TestAccs[0].String()}, nil))
 	res, err = s.querier.Params(sdk.WrapSDKContext(s.Ctx), &types.QueryParamsRequest{})
 	s.Require().NoError(err)
 	s.Require().Equal([]string{s.TestAccs[0].String()}, res.Params.ForceUnlockAllowedAddresses)
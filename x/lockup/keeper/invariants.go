@@ -16,6 +16,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
 	ir.RegisterRoute(types.ModuleName, "synthetic-lockup-invariant", SyntheticLockupInvariant(keeper))
 	ir.RegisterRoute(types.ModuleName, "accumulation-store-invariant", AccumulationStoreInvariant(keeper))
 	ir.RegisterRoute(types.ModuleName, "locks-amount-invariant", LocksBalancesInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "lock-receipts-invariant", LockReceiptsInvariant(keeper))
 }
 
 // SyntheticLockupInvariant ensures that synthetic lock's underlying lock id and the actual lock's id has the same id.
@@ -84,16 +85,18 @@ func AccumulationStoreInvariant(keeper Keeper) sdk.Invariant {
 }
 
 // LocksBalancesInvariant ensure that the module balance and the sum of all
-// tokens within all locks have the equivalent amount of tokens.
+// tokens within all locks, along with the early unlock penalties pending
+// redistribution, have the equivalent amount of tokens.
 func LocksBalancesInvariant(keeper Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		moduleAcc := keeper.ak.GetModuleAccount(ctx, types.ModuleName)
 		balances := keeper.bk.GetAllBalances(ctx, moduleAcc.GetAddress())
+		earlyUnlockPenalties := keeper.GetEarlyUnlockPenalties(ctx)
 
 		// loop all denoms on lockup module
 		for _, coin := range balances {
 			denom := coin.Denom
-			lockedAmount := sdk.ZeroInt()
+			lockedAmount := earlyUnlockPenalties.AmountOf(denom)
 			locksByDenom := keeper.GetLocksDenom(ctx, denom)
 			for _, lock := range locksByDenom {
 				lockedAmount = lockedAmount.Add(lock.Coins.AmountOf(denom))
@@ -109,3 +112,24 @@ func LocksBalancesInvariant(keeper Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "locks-amount-invariant", "All lockup amount invariant passed"), false
 	}
 }
+
+// LockReceiptsInvariant ensures that the supply of each lock receipt denom
+// is equal to the amount of tokens within the pooled lock backing it.
+func LockReceiptsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, lock := range keeper.GetLockReceiptPooledLocks(ctx) {
+			for _, coin := range lock.Coins {
+				receiptDenom := types.LockReceiptDenom(coin.Denom, lock.Duration)
+				supply := keeper.bk.GetSupply(ctx, receiptDenom)
+				if !supply.Amount.Equal(coin.Amount) {
+					return sdk.FormatInvariant(types.ModuleName, "lock-receipts-invariant",
+						fmt.Sprintf("\tsupply of %s does not fit pooled lock %d amount: %s != %s\n",
+							receiptDenom, lock.ID, supply.Amount.String(), coin.Amount.String(),
+						)), true
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "lock-receipts-invariant", "All lock receipts invariant passed"), false
+	}
+}
@@ -55,6 +55,16 @@ func (k Keeper) GetForceUnlockAllowedAddresses(ctx sdk.Context) (forceUnlockAllo
 	return k.GetParams(ctx).ForceUnlockAllowedAddresses
 }
 
+// GetEarlyUnlockConfig returns the early unlock config of the given denom, and whether early unlock is enabled for it.
+func (k Keeper) GetEarlyUnlockConfig(ctx sdk.Context, denom string) (types.EarlyUnlockConfig, bool) {
+	for _, config := range k.GetParams(ctx).EarlyUnlockConfigs {
+		if config.Denom == denom {
+			return config, true
+		}
+	}
+	return types.EarlyUnlockConfig{}, false
+}
+
 // Logger returns a logger instance.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
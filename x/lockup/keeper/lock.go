@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -458,6 +459,7 @@ func (k Keeper) unlockMaturedLockInternalLogic(ctx sdk.Context, lock types.Perio
 
 // SetLockRewardReceiverAddress changes the reward recipient address to the given address.
 // Storing an empty string for reward receiver would indicate the owner being reward receiver.
+// Module accounts and addresses blocked from receiving funds are not allowed to be the reward receiver.
 func (k Keeper) SetLockRewardReceiverAddress(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, newReceiverAddress string) error {
 	lock, err := k.GetLockByID(ctx, lockID)
 	if err != nil {
@@ -477,6 +479,16 @@ func (k Keeper) SetLockRewardReceiverAddress(ctx sdk.Context, lockID uint64, own
 		return types.ErrRewardReceiverIsSame
 	}
 
+	if newReceiverAddress != types.DefaultOwnerReceiverPlaceholder {
+		newReceiver, err := sdk.AccAddressFromBech32(newReceiverAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.validateRecipient(ctx, newReceiver); err != nil {
+			return err
+		}
+	}
+
 	lock.RewardReceiverAddress = newReceiverAddress
 
 	err = k.setLock(ctx, *lock)
@@ -553,6 +565,243 @@ func (k Keeper) ExtendLockup(ctx sdk.Context, lockID uint64, owner sdk.AccAddres
 	return nil
 }
 
+// SplitLockByID splits the given coins of the owner's lock into a new lock of the same duration and reward receiver,
+// and returns the new lock's ID.
+// Splitting would fail on either of the following conditions.
+// 1. Only lock owner is able to split the lock.
+// 2. Locks that are unlocking, have synthetic lockup or lock concentrated liquidity shares are not allowed to split.
+// 3. Provided coins should be less than the coins of the lock.
+func (k Keeper) SplitLockByID(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, coins sdk.Coins) (uint64, error) {
+	lock, err := k.getEditableLock(ctx, lockID, owner)
+	if err != nil {
+		return 0, err
+	}
+
+	if !coins.IsAllLTE(lock.Coins) || lock.Coins.Sub(coins).Empty() {
+		return 0, fmt.Errorf("coins to split (%s) should be less than the coins of lock %d (%s)", coins, lock.ID, lock.Coins)
+	}
+
+	splitLock, err := k.SplitLock(ctx, *lock, coins, false)
+	if err != nil {
+		return 0, err
+	}
+
+	// the split lock has the same denoms and duration as the original lock,
+	// so only lock refs are added, and the accumulation store is unchanged.
+	err = k.addLockRefs(ctx, splitLock)
+	if err != nil {
+		return 0, err
+	}
+
+	return splitLock.ID, nil
+}
+
+// MergeLocks merges the coins of the owner's locks into the first of the given locks, deleting the others,
+// and returns the merged lock. The merged lock keeps the reward receiver of the first lock.
+// Merging would fail on either of the following conditions.
+// 1. Only lock owner is able to merge the locks.
+// 2. Locks that are unlocking, have synthetic lockup or lock concentrated liquidity shares are not allowed to merge.
+// 3. All locks should have the same denoms and duration.
+func (k Keeper) MergeLocks(ctx sdk.Context, owner sdk.AccAddress, lockIDs []uint64) (types.PeriodLock, error) {
+	if len(lockIDs) < 2 {
+		return types.PeriodLock{}, fmt.Errorf("at least two locks are required to merge, got %d", len(lockIDs))
+	}
+
+	locks := make([]types.PeriodLock, 0, len(lockIDs))
+	seen := make(map[uint64]bool, len(lockIDs))
+	for _, lockID := range lockIDs {
+		if seen[lockID] {
+			return types.PeriodLock{}, fmt.Errorf("lock %d is merged more than once", lockID)
+		}
+		seen[lockID] = true
+
+		lock, err := k.getEditableLock(ctx, lockID, owner)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+		if lock.IsUnlocking() {
+			return types.PeriodLock{}, fmt.Errorf("cannot merge unlocking lock %d", lock.ID)
+		}
+		locks = append(locks, *lock)
+	}
+
+	mergedLock := locks[0]
+	for _, lock := range locks[1:] {
+		if lock.Duration != mergedLock.Duration {
+			return types.PeriodLock{}, fmt.Errorf("lock %d duration (%s) differs from lock %d duration (%s)", lock.ID, lock.Duration, mergedLock.ID, mergedLock.Duration)
+		}
+		if !lock.Coins.DenomsSubsetOf(mergedLock.Coins) || !mergedLock.Coins.DenomsSubsetOf(lock.Coins) {
+			return types.PeriodLock{}, fmt.Errorf("lock %d denoms (%s) differ from lock %d denoms (%s)", lock.ID, lock.Coins, mergedLock.ID, mergedLock.Coins)
+		}
+
+		// the merged lock has the same denoms and duration, so its lock refs and the accumulation store are unchanged.
+		err := k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, lock)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+		k.deleteLock(ctx, lock.ID)
+		mergedLock.Coins = mergedLock.Coins.Add(lock.Coins...)
+	}
+
+	err := k.setLock(ctx, mergedLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	return mergedLock, nil
+}
+
+// TransferLock transfers the ownership of the owner's lock to the recipient.
+// The reward receiver of the lock is reset to the recipient.
+// Transferring would fail on either of the following conditions.
+// 1. Only lock owner is able to transfer the lock, and not to itself.
+// 2. Locks that have synthetic lockup or lock concentrated liquidity shares are not allowed to transfer.
+// 3. Module accounts and addresses blocked from receiving funds are not allowed to receive the lock.
+func (k Keeper) TransferLock(ctx sdk.Context, lockID uint64, owner, recipient sdk.AccAddress) error {
+	lock, err := k.getEditableLock(ctx, lockID, owner)
+	if err != nil {
+		return err
+	}
+
+	if owner.Equals(recipient) {
+		return fmt.Errorf("cannot transfer lock %d to its owner", lock.ID)
+	}
+	if err := k.validateRecipient(ctx, recipient); err != nil {
+		return err
+	}
+
+	// lock refs are keyed by owner, so they are replaced with the ones of the recipient.
+	err = k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
+	if err != nil {
+		return err
+	}
+
+	lock.Owner = recipient.String()
+	lock.RewardReceiverAddress = types.DefaultOwnerReceiverPlaceholder
+
+	return k.setLockAndAddLockRefs(ctx, *lock)
+}
+
+// validateRecipient returns error if the recipient of a lock or of its rewards is blocked from receiving funds,
+// or is a module account, as it could not use the coins it receives.
+func (k Keeper) validateRecipient(ctx sdk.Context, recipient sdk.AccAddress) error {
+	if k.bk.BlockedAddr(recipient) {
+		return errorsmod.Wrapf(types.ErrBlockedRecipient, "%s is blocked from receiving funds", recipient)
+	}
+	if _, ok := k.ak.GetAccount(ctx, recipient).(authtypes.ModuleAccountI); ok {
+		return errorsmod.Wrapf(types.ErrBlockedRecipient, "%s is a module account", recipient)
+	}
+	return nil
+}
+
+// CancelUnlocking moves the given coins of the owner's unlocking lock back to the locked state with the lock's
+// original duration, and returns the ID of the re-locked lock.
+// Coins provided as the parameter does not require to have all the tokens in the lock. In that case,
+// the lock is split and only the newly created lock is re-locked, while the rest keeps unlocking.
+// Cancelling would fail on either of the following conditions.
+// 1. Only lock owner is able to cancel unlocking of the lock.
+// 2. Locks that are not unlocking or have finished unlocking are not allowed to cancel unlocking.
+// 3. Locks that lock concentrated liquidity shares are not allowed to cancel unlocking.
+// 4. Locks that have synthetic lockup are only allowed to cancel unlocking entirely, and only if the synthetic
+// lockup is unlocking.
+// Synthetic lockups of an unlocking lock are superfluid undelegations, which have already undelegated from the validator.
+// They keep unbonding on their own and are slashed from the re-locked lock, so they are left unchanged.
+// As they are tied to the lock ID, the lock can not be split to re-lock a portion of it.
+func (k Keeper) CancelUnlocking(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, coins sdk.Coins) (uint64, error) {
+	lock, err := k.getOwnedLock(ctx, lockID, owner)
+	if err != nil {
+		return 0, err
+	}
+
+	if !lock.IsUnlocking() {
+		return 0, fmt.Errorf("lock %d is not unlocking", lock.ID)
+	}
+	if !ctx.BlockTime().Before(lock.EndTime) {
+		return 0, fmt.Errorf("lock %d has finished unlocking at %s", lock.ID, lock.EndTime)
+	}
+
+	if !coins.IsAllLTE(lock.Coins) {
+		return 0, fmt.Errorf("requested amount to cancel unlocking exceeds unlocking tokens")
+	}
+
+	isPartial := len(coins) != 0 && !coins.IsEqual(lock.Coins)
+	synthLock, err := k.GetSyntheticLockupByUnderlyingLockId(ctx, lock.ID)
+	if err != nil {
+		return 0, err
+	}
+	if !synthLock.IsNil() {
+		if !synthLock.IsUnlocking() {
+			return 0, fmt.Errorf("cannot cancel unlocking of lock %d with bonded synthetic lock %s", lock.ID, synthLock.SynthDenom)
+		}
+		if isPartial {
+			return 0, fmt.Errorf("cannot cancel unlocking of a portion of lock %d with synthetic lock %s", lock.ID, synthLock.SynthDenom)
+		}
+	}
+
+	// If the amount to cancel is empty, or the entire coins amount, re-lock the entire lock.
+	// Otherwise, split the lock into two locks, and re-lock the newly created lock.
+	// The remaining lock keeps its lock refs, as they do not depend on the amount of coins.
+	if isPartial {
+		splitLock, err := k.SplitLock(ctx, *lock, coins, true)
+		if err != nil {
+			return 0, err
+		}
+		lock = &splitLock
+	}
+
+	// remove existing lock refs from unlocking queue
+	err = k.deleteLockRefs(ctx, types.KeyPrefixUnlocking, *lock)
+	if err != nil {
+		return 0, err
+	}
+
+	// the accumulation store keeps unlocking locks under their duration until they mature,
+	// so re-locking with the same duration leaves it unchanged.
+	lock.EndTime = time.Time{}
+	err = k.setLockAndAddLockRefs(ctx, *lock)
+	if err != nil {
+		return 0, err
+	}
+
+	return lock.ID, nil
+}
+
+// getEditableLock returns the lock of the given ID if it is owned by the owner, has no synthetic lockup
+// and does not lock concentrated liquidity shares, whose locks are tied to the position they were created with.
+func (k Keeper) getEditableLock(ctx sdk.Context, lockID uint64, owner sdk.AccAddress) (*types.PeriodLock, error) {
+	lock, err := k.getOwnedLock(ctx, lockID, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return nil, fmt.Errorf("cannot edit lockup with synthetic lock %d", lock.ID)
+	}
+
+	return lock, nil
+}
+
+// getOwnedLock returns the lock of the given ID if it is owned by the owner,
+// and does not lock concentrated liquidity shares.
+func (k Keeper) getOwnedLock(ctx sdk.Context, lockID uint64, owner sdk.AccAddress) (*types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return nil, types.ErrNotLockOwner
+	}
+
+	for _, coin := range lock.Coins {
+		if strings.HasPrefix(coin.Denom, cltypes.ConcentratedLiquidityTokenPrefix) {
+			return nil, fmt.Errorf("cannot edit concentrated liquidity lock %d", lock.ID)
+		}
+	}
+
+	return lock, nil
+}
+
 // InitializeAllLocks takes a set of locks, and initializes state to be storing
 // them all correctly. This utilizes batch optimizations to improve efficiency,
 // as this becomes a bottleneck at chain initialization & upgrades.
@@ -779,6 +1028,10 @@ func (k Keeper) removeTokensFromLock(ctx sdk.Context, lock *types.PeriodLock, co
 
 // setLock is a utility to store lock object into the store.
 func (k Keeper) setLock(ctx sdk.Context, lock types.PeriodLock) error {
+	if k.hooks != nil {
+		k.hooks.BeforeLockUpdated(ctx, lock)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz, err := proto.Marshal(&lock)
 	if err != nil {
@@ -812,6 +1065,10 @@ func (k Keeper) setSyntheticLockAndResetRefs(ctx sdk.Context, lock types.PeriodL
 
 // deleteLock removes the lock object from the state.
 func (k Keeper) deleteLock(ctx sdk.Context, id uint64) {
+	if k.hooks != nil {
+		k.hooks.BeforeLockDeleted(ctx, id)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(lockStoreKey(id))
 }
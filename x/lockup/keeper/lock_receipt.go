@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+)
+
+// TokenizeLock moves the owner's lock into the pooled lock of its denom and duration held by the lockup
+// module account, and mints the owner lock receipt tokens 1:1 with the locked coins. Returns the minted receipt.
+// The pooled lock is never unlocking and keeps being incentivized like any other lock. Its rewards are sent
+// to the rewards address of the receipt denom, to be redistributed to the lockers of the receipt tokens.
+// Tokenizing would fail on either of the following conditions.
+// 1. Only lock owner is able to tokenize the lock.
+// 2. Locks that are unlocking, have synthetic lockup or lock concentrated liquidity shares are not allowed to tokenize.
+// 3. Locks of more than one denom or of lock receipt tokens are not allowed to tokenize.
+func (k Keeper) TokenizeLock(ctx sdk.Context, lockID uint64, owner sdk.AccAddress) (sdk.Coin, error) {
+	lock, err := k.getEditableLock(ctx, lockID, owner)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if lock.IsUnlocking() {
+		return sdk.Coin{}, fmt.Errorf("cannot tokenize unlocking lock %d", lock.ID)
+	}
+	if len(lock.Coins) != 1 {
+		return sdk.Coin{}, fmt.Errorf("cannot tokenize lock %d with %d denoms", lock.ID, len(lock.Coins))
+	}
+
+	coin := lock.Coins[0]
+	if types.IsLockReceiptDenom(coin.Denom) {
+		return sdk.Coin{}, fmt.Errorf("cannot tokenize lock %d of lock receipt tokens", lock.ID)
+	}
+
+	receiptDenom := types.LockReceiptDenom(coin.Denom, lock.Duration)
+	if err := sdk.ValidateDenom(receiptDenom); err != nil {
+		return sdk.Coin{}, fmt.Errorf("cannot tokenize lock %d: %w", lock.ID, err)
+	}
+
+	// lock refs are keyed by owner, so the ones of the owner are removed in either case.
+	err = k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, *lock)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	pooledLock, found := k.getLockReceiptPooledLock(ctx, receiptDenom)
+	if found {
+		// the pooled lock has the same denom and duration, so its lock refs and the accumulation store are unchanged.
+		k.deleteLock(ctx, lock.ID)
+		pooledLock.Coins = pooledLock.Coins.Add(lock.Coins...)
+		err = k.setLock(ctx, pooledLock)
+	} else {
+		// the lock becomes the pooled lock of its denom and duration.
+		lock.Owner = k.ak.GetModuleAddress(types.ModuleName).String()
+		lock.RewardReceiverAddress = types.LockReceiptRewardsAddress(receiptDenom).String()
+		err = k.setLockAndAddLockRefs(ctx, *lock)
+		k.setLockReceiptPooledLockID(ctx, receiptDenom, lock.ID)
+	}
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	receipt := sdk.NewCoin(receiptDenom, coin.Amount)
+	if err := k.bk.MintCoins(ctx, types.ModuleName, sdk.NewCoins(receipt)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(receipt)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return receipt, nil
+}
+
+// RedeemLockReceipt burns the owner's lock receipt tokens and moves the claimed coins out of the pooled lock
+// into a new lock of the owner with the same duration, that is not unlocking. Returns the ID of the owner's lock.
+// When the entire pooled lock is claimed, the pooled lock itself is handed over to the owner.
+func (k Keeper) RedeemLockReceipt(ctx sdk.Context, owner sdk.AccAddress, receipt sdk.Coin) (uint64, error) {
+	if !receipt.IsPositive() {
+		return 0, fmt.Errorf("cannot redeem a zero lock receipt amount")
+	}
+
+	denom, _, err := types.ParseLockReceiptDenom(receipt.Denom)
+	if err != nil {
+		return 0, err
+	}
+
+	pooledLock, found := k.getLockReceiptPooledLock(ctx, receipt.Denom)
+	if !found {
+		return 0, fmt.Errorf("no pooled lock backs lock receipt %s", receipt.Denom)
+	}
+
+	claimedCoins := sdk.NewCoins(sdk.NewCoin(denom, receipt.Amount))
+	if !claimedCoins.IsAllLTE(pooledLock.Coins) {
+		return 0, fmt.Errorf("lock receipt %s exceeds the coins of pooled lock %d (%s)", receipt, pooledLock.ID, pooledLock.Coins)
+	}
+
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(receipt)); err != nil {
+		return 0, err
+	}
+	if err := k.bk.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(receipt)); err != nil {
+		return 0, err
+	}
+
+	redeemedLock := pooledLock
+	if pooledLock.Coins.Sub(claimedCoins).Empty() {
+		// lock refs are keyed by owner, so they are replaced with the ones of the owner.
+		err = k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, pooledLock)
+		k.deleteLockReceiptPooledLockID(ctx, receipt.Denom)
+	} else {
+		// the split lock has the same denom and duration, so the accumulation store is unchanged.
+		redeemedLock, err = k.SplitLock(ctx, pooledLock, claimedCoins, false)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	redeemedLock.Owner = owner.String()
+	redeemedLock.RewardReceiverAddress = types.DefaultOwnerReceiverPlaceholder
+	if err := k.setLockAndAddLockRefs(ctx, redeemedLock); err != nil {
+		return 0, err
+	}
+
+	return redeemedLock.ID, nil
+}
+
+// GetLockReceiptPooledLocks returns the pooled locks held by the lockup module account,
+// that back the lock receipt tokens of their denom and duration.
+func (k Keeper) GetLockReceiptPooledLocks(ctx sdk.Context) []types.PeriodLock {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLockReceiptPooledLock)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	locks := []types.PeriodLock{}
+	for ; iterator.Valid(); iterator.Next() {
+		lock, err := k.GetLockByID(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if err != nil {
+			panic(err)
+		}
+		locks = append(locks, *lock)
+	}
+	return locks
+}
+
+// getLockReceiptPooledLock returns the pooled lock backing the given lock receipt denom, if any.
+// The ID of the pooled lock is stored explicitly, as the lockup module account is not guaranteed
+// to own no other lock of the same denom and duration.
+func (k Keeper) getLockReceiptPooledLock(ctx sdk.Context, receiptDenom string) (types.PeriodLock, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLockReceiptPooledLock)
+	bz := store.Get([]byte(receiptDenom))
+	if bz == nil {
+		return types.PeriodLock{}, false
+	}
+	lock, err := k.GetLockByID(ctx, sdk.BigEndianToUint64(bz))
+	if err != nil {
+		panic(err)
+	}
+	return *lock, true
+}
+
+// setLockReceiptPooledLockID sets the ID of the pooled lock backing the given lock receipt denom.
+func (k Keeper) setLockReceiptPooledLockID(ctx sdk.Context, receiptDenom string, lockID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLockReceiptPooledLock)
+	store.Set([]byte(receiptDenom), sdk.Uint64ToBigEndian(lockID))
+}
+
+// deleteLockReceiptPooledLockID removes the ID of the pooled lock backing the given lock receipt denom,
+// once the pooled lock is handed over to a redeemer.
+func (k Keeper) deleteLockReceiptPooledLockID(ctx sdk.Context, receiptDenom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLockReceiptPooledLock)
+	store.Delete([]byte(receiptDenom))
+}
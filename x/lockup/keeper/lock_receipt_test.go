@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/lockup/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+)
+
+func (s *KeeperTestSuite) TestTokenizeLock() {
+	defaultCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	receiptDenom := types.LockReceiptDenom("stake", time.Minute)
+	tests := []struct {
+		name          string
+		lockCoins     sdk.Coins
+		isNotOwner    bool
+		isUnlocking   bool
+		withSynthLock bool
+		expectPass    bool
+	}{
+		{
+			name:       "tokenize a lock",
+			expectPass: true,
+		},
+		{
+			name:      "error: lock with more than one denom",
+			lockCoins: sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uosmo", 100)),
+		},
+		{
+			name:      "error: lock of lock receipt tokens",
+			lockCoins: sdk.NewCoins(sdk.NewInt64Coin(receiptDenom, 100)),
+		},
+		{
+			name:       "error: sender is not the owner of the lock",
+			isNotOwner: true,
+		},
+		{
+			name:        "error: lock is unlocking",
+			isUnlocking: true,
+		},
+		{
+			name:          "error: lock has a synthetic lock",
+			withSynthLock: true,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			owner := s.TestAccs[0]
+
+			lockCoins := defaultCoins
+			if !test.lockCoins.Empty() {
+				lockCoins = test.lockCoins
+			}
+			s.FundAcc(owner, lockCoins)
+			lock, err := s.App.LockupKeeper.CreateLock(s.Ctx, owner, lockCoins, time.Minute)
+			s.Require().NoError(err)
+			if test.isUnlocking {
+				_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, lock.ID, nil)
+				s.Require().NoError(err)
+			}
+			if test.withSynthLock {
+				err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, lock.ID, "synthstakestakedtovalidator", time.Minute, false)
+				s.Require().NoError(err)
+			}
+			sender := owner
+			if test.isNotOwner {
+				sender = s.TestAccs[1]
+			}
+
+			receipt, err := s.App.LockupKeeper.TokenizeLock(s.Ctx, lock.ID, sender)
+			if !test.expectPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewInt64Coin(receiptDenom, 100), receipt)
+
+			// the owner holds the receipt tokens instead of the lock
+			s.Require().Equal(sdk.NewCoins(receipt), s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+			s.Require().Empty(s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, owner))
+
+			// the lock became the pooled lock, which is still incentivized
+			pooledLocks := s.App.LockupKeeper.GetLockReceiptPooledLocks(s.Ctx)
+			s.Require().Len(pooledLocks, 1)
+			s.Require().Equal(lock.ID, pooledLocks[0].ID)
+			s.Require().Equal(types.LockReceiptRewardsAddress(receiptDenom).String(), pooledLocks[0].RewardReceiverAddress)
+			s.Require().Equal(lockCoins.AmountOf("stake"), s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, types.QueryCondition{
+				LockQueryType: types.ByDuration,
+				Denom:         "stake",
+				Duration:      time.Minute,
+			}))
+
+			_, broken := keeper.LockReceiptsInvariant(*s.App.LockupKeeper)(s.Ctx)
+			s.Require().False(broken)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRedeemLockReceipt() {
+	s.SetupTest()
+	owner, other := s.TestAccs[0], s.TestAccs[1]
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	receiptDenom := types.LockReceiptDenom("stake", time.Minute)
+	accumulation := func() sdk.Int {
+		return s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, types.QueryCondition{
+			LockQueryType: types.ByDuration,
+			Denom:         "stake",
+			Duration:      time.Minute,
+		})
+	}
+
+	// redeeming fails while no pooled lock backs the receipt denom
+	_, err := s.App.LockupKeeper.RedeemLockReceipt(s.Ctx, owner, sdk.NewInt64Coin(receiptDenom, 10))
+	s.Require().Error(err)
+
+	// tokenizing the locks of two owners merges them into a single pooled lock
+	for _, addr := range []sdk.AccAddress{owner, other} {
+		s.FundAcc(addr, coins)
+		lock, err := s.App.LockupKeeper.CreateLock(s.Ctx, addr, coins, time.Minute)
+		s.Require().NoError(err)
+		_, err = s.App.LockupKeeper.TokenizeLock(s.Ctx, lock.ID, addr)
+		s.Require().NoError(err)
+	}
+	pooledLocks := s.App.LockupKeeper.GetLockReceiptPooledLocks(s.Ctx)
+	s.Require().Len(pooledLocks, 1)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), pooledLocks[0].Coins)
+	s.Require().Equal(sdk.NewInt(200), accumulation())
+
+	// receipt tokens can be sent, and redeemed by their holder
+	err = s.App.BankKeeper.SendCoins(s.Ctx, other, owner, sdk.NewCoins(sdk.NewInt64Coin(receiptDenom, 100)))
+	s.Require().NoError(err)
+
+	// redeeming more than the pooled lock fails
+	_, err = s.App.LockupKeeper.RedeemLockReceipt(s.Ctx, owner, sdk.NewInt64Coin(receiptDenom, 201))
+	s.Require().Error(err)
+
+	// partial redemption splits a new lock for the holder off the pooled lock
+	lockID, err := s.App.LockupKeeper.RedeemLockReceipt(s.Ctx, owner, sdk.NewInt64Coin(receiptDenom, 150))
+	s.Require().NoError(err)
+	redeemedLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockID)
+	s.Require().NoError(err)
+	s.Require().Equal(owner.String(), redeemedLock.Owner)
+	s.Require().Equal(types.DefaultOwnerReceiverPlaceholder, redeemedLock.RewardReceiverAddress)
+	s.Require().Equal(time.Minute, redeemedLock.Duration)
+	s.Require().False(redeemedLock.IsUnlocking())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 150)), redeemedLock.Coins)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 150)), s.App.LockupKeeper.GetAccountLockedCoins(s.Ctx, owner))
+	s.Require().Equal(sdk.NewInt64Coin(receiptDenom, 50), s.App.BankKeeper.GetBalance(s.Ctx, owner, receiptDenom))
+	s.Require().Equal(sdk.NewInt(200), accumulation())
+
+	_, broken := keeper.LockReceiptsInvariant(*s.App.LockupKeeper)(s.Ctx)
+	s.Require().False(broken)
+
+	// redeeming the rest hands the pooled lock over to the holder
+	pooledLockID := s.App.LockupKeeper.GetLockReceiptPooledLocks(s.Ctx)[0].ID
+	lockID, err = s.App.LockupKeeper.RedeemLockReceipt(s.Ctx, owner, sdk.NewInt64Coin(receiptDenom, 50))
+	s.Require().NoError(err)
+	s.Require().Equal(pooledLockID, lockID)
+	s.Require().Empty(s.App.LockupKeeper.GetLockReceiptPooledLocks(s.Ctx))
+	s.Require().Len(s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, owner), 2)
+	s.Require().True(s.App.BankKeeper.GetSupply(s.Ctx, receiptDenom).IsZero())
+	s.Require().Equal(sdk.NewInt(200), accumulation())
+
+	_, broken = keeper.LocksBalancesInvariant(*s.App.LockupKeeper)(s.Ctx)
+	s.Require().False(broken)
+}
@@ -13,6 +13,8 @@ import (
 	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func (s *KeeperTestSuite) TestBeginUnlocking() { // test for all unlockable coins
@@ -538,11 +540,13 @@ func (s *KeeperTestSuite) TestCreateLock() {
 }
 
 func (s *KeeperTestSuite) TestSetLockRewardReceiverAddress() {
+	const unblockedModuleName = "unblocked"
 	testCases := []struct {
 		name                  string
 		isnotOwner            bool
 		lockID                uint64
 		useNewReceiverAddress bool
+		newReceiver           sdk.AccAddress
 		exepctedErrorType     error
 	}{
 		{
@@ -572,11 +576,24 @@ func (s *KeeperTestSuite) TestSetLockRewardReceiverAddress() {
 			useNewReceiverAddress: false,
 			exepctedErrorType:     types.ErrRewardReceiverIsSame,
 		},
+		{
+			name:              "error: new receiver address is a module account",
+			lockID:            1,
+			newReceiver:       authtypes.NewModuleAddress(distrtypes.ModuleName),
+			exepctedErrorType: types.ErrBlockedRecipient,
+		},
+		{
+			name:              "error: new receiver address is a module account not blocked from receiving funds",
+			lockID:            1,
+			newReceiver:       authtypes.NewModuleAddress(unblockedModuleName),
+			exepctedErrorType: types.ErrBlockedRecipient,
+		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
 			s.SetupTest()
+			s.App.AccountKeeper.SetModuleAccount(s.Ctx, authtypes.NewEmptyModuleAccount(unblockedModuleName))
 
 			addr1 := s.TestAccs[0]
 			coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
@@ -599,11 +616,16 @@ func (s *KeeperTestSuite) TestSetLockRewardReceiverAddress() {
 			if tc.useNewReceiverAddress {
 				newReceiver = s.TestAccs[1]
 			}
+			if tc.newReceiver != nil {
+				newReceiver = tc.newReceiver
+			}
 
 			// System under test
 			// now change the reward receiver state
 			err = s.App.LockupKeeper.SetLockRewardReceiverAddress(s.Ctx, tc.lockID, owner, newReceiver.String())
-			if tc.exepctedErrorType != nil {
+			if tc.newReceiver != nil {
+				s.Require().ErrorIs(err, tc.exepctedErrorType)
+			} else if tc.exepctedErrorType != nil {
 				s.Require().Error(err)
 				s.Require().ErrorContains(tc.exepctedErrorType, err.Error())
 			} else {
@@ -1576,3 +1598,489 @@ func (s *KeeperTestSuite) TestPartialForceUnlock() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestSplitLockByID() {
+	defaultCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	tests := []struct {
+		name          string
+		coinsToSplit  sdk.Coins
+		isNotOwner    bool
+		isUnlocking   bool
+		withSynthLock bool
+		lockDenom     string
+		expectPass    bool
+	}{
+		{
+			name:         "split part of the lock",
+			coinsToSplit: sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+			expectPass:   true,
+		},
+		{
+			name:         "error: split the entire lock",
+			coinsToSplit: defaultCoins,
+		},
+		{
+			name:         "error: split more than the lock",
+			coinsToSplit: sdk.NewCoins(sdk.NewInt64Coin("stake", 101)),
+		},
+		{
+			name:         "error: split a denom not in the lock",
+			coinsToSplit: sdk.NewCoins(sdk.NewInt64Coin("foo", 40)),
+		},
+		{
+			name:         "error: sender is not the owner of the lock",
+			coinsToSplit: sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+			isNotOwner:   true,
+		},
+		{
+			name:         "error: lock is unlocking",
+			coinsToSplit: sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+			isUnlocking:  true,
+		},
+		{
+			name:          "error: lock has a synthetic lock",
+			coinsToSplit:  sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+			withSynthLock: true,
+		},
+		{
+			name:         "error: lock of concentrated liquidity shares",
+			coinsToSplit: sdk.NewCoins(sdk.NewInt64Coin("cl/pool/1/1", 40)),
+			lockDenom:    "cl/pool/1/1",
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			owner := s.TestAccs[0]
+			lockCoins := defaultCoins
+			if test.lockDenom != "" {
+				lockCoins = sdk.NewCoins(sdk.NewInt64Coin(test.lockDenom, 100))
+			}
+			s.FundAcc(owner, lockCoins)
+			lock, err := s.App.LockupKeeper.CreateLock(s.Ctx, owner, lockCoins, time.Minute)
+			s.Require().NoError(err)
+			if test.isUnlocking {
+				_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, lock.ID, nil)
+				s.Require().NoError(err)
+			}
+			if test.withSynthLock {
+				err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, lock.ID, "synthstakestakedtovalidator", time.Minute, false)
+				s.Require().NoError(err)
+			}
+			sender := owner
+			if test.isNotOwner {
+				sender = s.TestAccs[1]
+			}
+
+			splitLockID, err := s.App.LockupKeeper.SplitLockByID(s.Ctx, lock.ID, sender, test.coinsToSplit)
+			if !test.expectPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the split lock has the split coins, and the same owner and duration as the original lock
+			splitLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, splitLockID)
+			s.Require().NoError(err)
+			s.Require().Equal(test.coinsToSplit, splitLock.Coins)
+			s.Require().Equal(lock.Owner, splitLock.Owner)
+			s.Require().Equal(lock.Duration, splitLock.Duration)
+			s.Require().False(splitLock.IsUnlocking())
+
+			originalLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lock.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(lockCoins.Sub(test.coinsToSplit), originalLock.Coins)
+
+			// both locks are referenced, and the accumulation store is unchanged
+			locks := s.App.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(s.Ctx, owner, "stake", time.Minute)
+			s.Require().Len(locks, 2)
+			acc := s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, types.QueryCondition{Denom: "stake", Duration: time.Minute})
+			s.Require().Equal(lockCoins.AmountOf("stake"), acc)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMergeLocks() {
+	tests := []struct {
+		name               string
+		lockDurations      []time.Duration
+		lockDenoms         []string
+		lockIDs            []uint64
+		isNotOwner         bool
+		unlockingLockIndex int
+		withSynthLock      bool
+		expectPass         bool
+	}{
+		{
+			name:               "merge two locks",
+			lockDurations:      []time.Duration{time.Minute, time.Minute},
+			lockDenoms:         []string{"stake", "stake"},
+			lockIDs:            []uint64{1, 2},
+			unlockingLockIndex: -1,
+			expectPass:         true,
+		},
+		{
+			name:               "merge three locks into the last created one",
+			lockDurations:      []time.Duration{time.Minute, time.Minute, time.Minute},
+			lockDenoms:         []string{"stake", "stake", "stake"},
+			lockIDs:            []uint64{3, 1, 2},
+			unlockingLockIndex: -1,
+			expectPass:         true,
+		},
+		{
+			name:               "error: single lock",
+			lockDurations:      []time.Duration{time.Minute},
+			lockDenoms:         []string{"stake"},
+			lockIDs:            []uint64{1},
+			unlockingLockIndex: -1,
+		},
+		{
+			name:               "error: duplicate lock",
+			lockDurations:      []time.Duration{time.Minute, time.Minute},
+			lockDenoms:         []string{"stake", "stake"},
+			lockIDs:            []uint64{1, 2, 2},
+			unlockingLockIndex: -1,
+		},
+		{
+			name:               "error: different durations",
+			lockDurations:      []time.Duration{time.Minute, time.Hour},
+			lockDenoms:         []string{"stake", "stake"},
+			lockIDs:            []uint64{1, 2},
+			unlockingLockIndex: -1,
+		},
+		{
+			name:               "error: different denoms",
+			lockDurations:      []time.Duration{time.Minute, time.Minute},
+			lockDenoms:         []string{"stake", "foo"},
+			lockIDs:            []uint64{1, 2},
+			unlockingLockIndex: -1,
+		},
+		{
+			name:               "error: sender is not the owner of the locks",
+			lockDurations:      []time.Duration{time.Minute, time.Minute},
+			lockDenoms:         []string{"stake", "stake"},
+			lockIDs:            []uint64{1, 2},
+			isNotOwner:         true,
+			unlockingLockIndex: -1,
+		},
+		{
+			name:               "error: a lock is unlocking",
+			lockDurations:      []time.Duration{time.Minute, time.Minute},
+			lockDenoms:         []string{"stake", "stake"},
+			lockIDs:            []uint64{1, 2},
+			unlockingLockIndex: 1,
+		},
+		{
+			name:               "error: a lock has a synthetic lock",
+			lockDurations:      []time.Duration{time.Minute, time.Minute},
+			lockDenoms:         []string{"stake", "stake"},
+			lockIDs:            []uint64{1, 2},
+			unlockingLockIndex: -1,
+			withSynthLock:      true,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			owner := s.TestAccs[0]
+			totalCoins := sdk.NewCoins()
+			for i, duration := range test.lockDurations {
+				// lock separately so that locks of the same denom and duration are not added to each other
+				coins := sdk.NewCoins(sdk.NewInt64Coin(test.lockDenoms[i], int64(100*(i+1))))
+				s.FundAcc(owner, coins)
+				lock, err := s.App.LockupKeeper.CreateLock(s.Ctx, owner, coins, duration)
+				s.Require().NoError(err)
+				totalCoins = totalCoins.Add(coins...)
+				if i == test.unlockingLockIndex {
+					_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, lock.ID, nil)
+					s.Require().NoError(err)
+				}
+			}
+			if test.withSynthLock {
+				err := s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, test.lockIDs[1], "synthstakestakedtovalidator", time.Minute, false)
+				s.Require().NoError(err)
+			}
+			sender := owner
+			if test.isNotOwner {
+				sender = s.TestAccs[1]
+			}
+
+			mergedLock, err := s.App.LockupKeeper.MergeLocks(s.Ctx, sender, test.lockIDs)
+			if !test.expectPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the first lock holds all the coins, and the others are deleted
+			s.Require().Equal(test.lockIDs[0], mergedLock.ID)
+			s.Require().Equal(totalCoins, mergedLock.Coins)
+			storedLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, mergedLock.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(mergedLock, *storedLock)
+			for _, lockID := range test.lockIDs[1:] {
+				_, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockID)
+				s.Require().Error(err)
+			}
+
+			// only the merged lock is referenced, and the accumulation store is unchanged
+			locks := s.App.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(s.Ctx, owner, "stake", time.Minute)
+			s.Require().Len(locks, 1)
+			s.Require().Equal(mergedLock.ID, locks[0].ID)
+			acc := s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, types.QueryCondition{Denom: "stake", Duration: time.Minute})
+			s.Require().Equal(totalCoins.AmountOf("stake"), acc)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTransferLock() {
+	const unblockedModuleName = "unblocked"
+	tests := []struct {
+		name               string
+		isNotOwner         bool
+		isUnlocking        bool
+		withSynthLock      bool
+		withRewardReceiver bool
+		recipientIsOwner   bool
+		recipient          sdk.AccAddress
+		expectPass         bool
+	}{
+		{
+			name:       "transfer lock",
+			expectPass: true,
+		},
+		{
+			name:        "transfer unlocking lock",
+			isUnlocking: true,
+			expectPass:  true,
+		},
+		{
+			name:               "transfer lock with reward receiver resets the reward receiver",
+			withRewardReceiver: true,
+			expectPass:         true,
+		},
+		{
+			name:       "error: sender is not the owner of the lock",
+			isNotOwner: true,
+		},
+		{
+			name:             "error: recipient is the owner of the lock",
+			recipientIsOwner: true,
+		},
+		{
+			name:          "error: lock has a synthetic lock",
+			withSynthLock: true,
+		},
+		{
+			name:      "error: recipient is a module account",
+			recipient: authtypes.NewModuleAddress(distrtypes.ModuleName),
+		},
+		{
+			name:      "error: recipient is a module account not blocked from receiving funds",
+			recipient: authtypes.NewModuleAddress(unblockedModuleName),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			s.App.AccountKeeper.SetModuleAccount(s.Ctx, authtypes.NewEmptyModuleAccount(unblockedModuleName))
+			owner, recipient := s.TestAccs[0], s.TestAccs[1]
+			coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+			s.FundAcc(owner, coins)
+			lock, err := s.App.LockupKeeper.CreateLock(s.Ctx, owner, coins, time.Minute)
+			s.Require().NoError(err)
+			if test.isUnlocking {
+				_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, lock.ID, nil)
+				s.Require().NoError(err)
+			}
+			if test.withSynthLock {
+				err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, lock.ID, "synthstakestakedtovalidator", time.Minute, false)
+				s.Require().NoError(err)
+			}
+			if test.withRewardReceiver {
+				err = s.App.LockupKeeper.SetLockRewardReceiverAddress(s.Ctx, lock.ID, owner, s.TestAccs[2].String())
+				s.Require().NoError(err)
+			}
+			sender := owner
+			if test.isNotOwner {
+				sender = recipient
+			}
+			if test.recipientIsOwner {
+				recipient = owner
+			}
+			if test.recipient != nil {
+				recipient = test.recipient
+			}
+
+			err = s.App.LockupKeeper.TransferLock(s.Ctx, lock.ID, sender, recipient)
+			if !test.expectPass {
+				s.Require().Error(err)
+				if test.recipient != nil {
+					s.Require().ErrorIs(err, types.ErrBlockedRecipient)
+				}
+				return
+			}
+			s.Require().NoError(err)
+
+			transferredLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lock.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(recipient.String(), transferredLock.Owner)
+			s.Require().Equal(types.DefaultOwnerReceiverPlaceholder, transferredLock.RewardReceiverAddress)
+			s.Require().Equal(coins, transferredLock.Coins)
+			s.Require().Equal(test.isUnlocking, transferredLock.IsUnlocking())
+
+			// the lock is referenced by the recipient instead of the owner
+			s.Require().Empty(s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, owner))
+			recipientLocks := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, recipient)
+			s.Require().Len(recipientLocks, 1)
+			s.Require().Equal(lock.ID, recipientLocks[0].ID)
+			if test.isUnlocking {
+				s.Require().Equal(coins, s.App.LockupKeeper.GetAccountUnlockingCoins(s.Ctx, recipient))
+			} else {
+				s.Require().Equal(coins, s.App.LockupKeeper.GetAccountLockedCoins(s.Ctx, recipient))
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelUnlocking() {
+	defaultCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	tests := []struct {
+		name           string
+		coinsToRelock  sdk.Coins
+		isNotOwner     bool
+		isNotUnlocking bool
+		isMatured      bool
+		withSynthLock  bool
+		synthIsBonded  bool
+		lockDenom      string
+		expectPass     bool
+	}{
+		{
+			name:       "cancel unlocking of the entire lock",
+			expectPass: true,
+		},
+		{
+			name:          "cancel unlocking of the entire lock with coins set",
+			coinsToRelock: defaultCoins,
+			expectPass:    true,
+		},
+		{
+			name:          "cancel unlocking of part of the lock",
+			coinsToRelock: sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+			expectPass:    true,
+		},
+		{
+			name:          "error: cancel unlocking of more than the lock",
+			coinsToRelock: sdk.NewCoins(sdk.NewInt64Coin("stake", 101)),
+		},
+		{
+			name:       "error: sender is not the owner of the lock",
+			isNotOwner: true,
+		},
+		{
+			name:           "error: lock is not unlocking",
+			isNotUnlocking: true,
+		},
+		{
+			name:      "error: lock has finished unlocking",
+			isMatured: true,
+		},
+		{
+			name:          "cancel unlocking of the entire lock with an unlocking synthetic lock",
+			withSynthLock: true,
+			expectPass:    true,
+		},
+		{
+			name:          "error: cancel unlocking of part of the lock with an unlocking synthetic lock",
+			coinsToRelock: sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+			withSynthLock: true,
+		},
+		{
+			name:          "error: lock has a bonded synthetic lock",
+			withSynthLock: true,
+			synthIsBonded: true,
+		},
+		{
+			name:      "error: lock of concentrated liquidity shares",
+			lockDenom: "cl/pool/1/1",
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			owner := s.TestAccs[0]
+			lockCoins := defaultCoins
+			if test.lockDenom != "" {
+				lockCoins = sdk.NewCoins(sdk.NewInt64Coin(test.lockDenom, 100))
+			}
+			s.FundAcc(owner, lockCoins)
+			lock, err := s.App.LockupKeeper.CreateLock(s.Ctx, owner, lockCoins, time.Minute)
+			s.Require().NoError(err)
+			if !test.isNotUnlocking {
+				_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, lock.ID, nil)
+				s.Require().NoError(err)
+			}
+			if test.withSynthLock {
+				err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, lock.ID, "synthstakestakedtovalidator", time.Minute, !test.synthIsBonded)
+				s.Require().NoError(err)
+			}
+			if test.isMatured {
+				s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+			}
+			sender := owner
+			if test.isNotOwner {
+				sender = s.TestAccs[1]
+			}
+
+			lockedLockID, err := s.App.LockupKeeper.CancelUnlocking(s.Ctx, lock.ID, sender, test.coinsToRelock)
+			if !test.expectPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			relockedCoins := lockCoins
+			isPartial := !test.coinsToRelock.Empty() && !test.coinsToRelock.IsEqual(lockCoins)
+			if isPartial {
+				relockedCoins = test.coinsToRelock
+				s.Require().NotEqual(lock.ID, lockedLockID)
+			} else {
+				s.Require().Equal(lock.ID, lockedLockID)
+			}
+
+			// the re-locked lock keeps its original duration and is no longer unlocking
+			lockedLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockedLockID)
+			s.Require().NoError(err)
+			s.Require().Equal(relockedCoins, lockedLock.Coins)
+			s.Require().Equal(lock.Duration, lockedLock.Duration)
+			s.Require().False(lockedLock.IsUnlocking())
+
+			// the lock refs are moved from the unlocking to the not unlocking queue
+			s.Require().Equal(lockCoins.Sub(relockedCoins).String(), s.App.LockupKeeper.GetAccountUnlockingCoins(s.Ctx, owner).String())
+			locks := s.App.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(s.Ctx, owner, "stake", time.Minute)
+			s.Require().Len(locks, 1)
+			s.Require().Equal(lockedLockID, locks[0].ID)
+
+			// the accumulation store is unchanged
+			acc := s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, types.QueryCondition{Denom: "stake", Duration: time.Minute})
+			s.Require().Equal(lockCoins.AmountOf("stake"), acc)
+
+			// the re-locked coins are not withdrawn when the original unlock time matures,
+			// while the synthetic lock keeps unbonding until it matures
+			if test.withSynthLock {
+				s.Require().True(s.App.LockupKeeper.HasAnySyntheticLockups(s.Ctx, lockedLockID))
+			}
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+			s.App.LockupKeeper.DeleteAllMaturedSyntheticLocks(s.Ctx)
+			s.App.LockupKeeper.WithdrawAllMaturedLocks(s.Ctx)
+			s.Require().False(s.App.LockupKeeper.HasAnySyntheticLockups(s.Ctx, lockedLockID))
+			s.Require().Equal(relockedCoins, s.App.LockupKeeper.GetAccountLockedCoins(s.Ctx, owner))
+			s.Require().Equal(lockCoins.Sub(relockedCoins).String(), s.App.BankKeeper.GetAllBalances(s.Ctx, owner).String())
+		})
+	}
+}
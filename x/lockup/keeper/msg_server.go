@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v16/x/lockup/types"
@@ -247,3 +248,208 @@ func (server msgServer) SetRewardReceiverAddress(goCtx context.Context, msg *typ
 
 	return &types.MsgSetRewardReceiverAddressResponse{Success: true}, nil
 }
+
+// SplitLock splits the given coins of the lock into a new lock of the same owner, duration and reward receiver.
+// SplitLock would fail if the lock is unlocking OR if the lock has a synthetic lock
+// OR if the given coins are not less than the coins of the lock.
+func (server msgServer) SplitLock(goCtx context.Context, msg *types.MsgSplitLock) (*types.MsgSplitLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	splitLockID, err := server.keeper.SplitLockByID(ctx, msg.ID, owner, msg.Coins)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSplitLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributeSplitLockID, osmoutils.Uint64ToString(splitLockID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, msg.Coins.String()),
+		),
+	})
+
+	return &types.MsgSplitLockResponse{SplitLockID: splitLockID}, nil
+}
+
+// MergeLocks merges the coins of the given locks into the first of them.
+// MergeLocks would fail if the locks differ in owner, denom or duration, OR if any of them is unlocking
+// OR if any of them has a synthetic lock.
+func (server msgServer) MergeLocks(goCtx context.Context, msg *types.MsgMergeLocks) (*types.MsgMergeLocksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	mergedLock, err := server.keeper.MergeLocks(ctx, owner, msg.LockIDs)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	lockIDs := make([]string, len(msg.LockIDs))
+	for i, lockID := range msg.LockIDs {
+		lockIDs[i] = osmoutils.Uint64ToString(lockID)
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtMergeLocks,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(mergedLock.ID)),
+			sdk.NewAttribute(types.AttributeMergedLockIDs, strings.Join(lockIDs, ",")),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, mergedLock.Coins.String()),
+		),
+	})
+
+	return &types.MsgMergeLocksResponse{MergedLockID: mergedLock.ID}, nil
+}
+
+// TransferLock transfers the ownership of the lock to the recipient, resetting its reward receiver.
+// TransferLock would fail if the lock has a synthetic lock.
+func (server msgServer) TransferLock(goCtx context.Context, msg *types.MsgTransferLock) (*types.MsgTransferLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.TransferLock(ctx, msg.ID, owner, recipient)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTransferLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeRecipient, msg.Recipient),
+		),
+	})
+
+	return &types.MsgTransferLockResponse{}, nil
+}
+
+// CancelUnlocking moves the given coins of the unlocking lock back to the locked state with the lock's original duration.
+// CancelUnlocking would fail if the lock is not unlocking OR if the lock has a bonded synthetic lock
+// OR if a portion of a lock with a synthetic lock is given OR if the given coins exceed the coins of the lock.
+func (server msgServer) CancelUnlocking(goCtx context.Context, msg *types.MsgCancelUnlocking) (*types.MsgCancelUnlockingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	lockedLockID, err := server.keeper.CancelUnlocking(ctx, msg.ID, owner, msg.Coins)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelUnlocking,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributeLockedLockID, osmoutils.Uint64ToString(lockedLockID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, msg.Coins.String()),
+		),
+	})
+
+	return &types.MsgCancelUnlockingResponse{LockedLockID: lockedLockID}, nil
+}
+
+// InstantUnlock immediately unlocks the given coins of the owner's lock in exchange for a penalty
+// proportional to the remaining lock duration.
+func (server msgServer) InstantUnlock(goCtx context.Context, msg *types.MsgInstantUnlock) (*types.MsgInstantUnlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	penalty, err := server.keeper.InstantUnlock(ctx, msg.ID, owner, msg.Coins)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtInstantUnlock,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, msg.Coins.String()),
+			sdk.NewAttribute(types.AttributeEarlyUnlockPenalty, penalty.String()),
+		),
+	})
+
+	return &types.MsgInstantUnlockResponse{Penalty: penalty}, nil
+}
+
+// TokenizeLock moves the owner's lock into the pooled lock of its denom and duration, and mints the owner
+// lock receipt tokens representing a claim on the locked coins.
+func (server msgServer) TokenizeLock(goCtx context.Context, msg *types.MsgTokenizeLock) (*types.MsgTokenizeLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := server.keeper.TokenizeLock(ctx, msg.ID, owner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTokenizeLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeLockReceipt, receipt.String()),
+		),
+	})
+
+	return &types.MsgTokenizeLockResponse{Receipt: receipt}, nil
+}
+
+// RedeemLockReceipt burns the owner's lock receipt tokens and moves the claimed coins out of the pooled lock
+// into a lock of the owner.
+func (server msgServer) RedeemLockReceipt(goCtx context.Context, msg *types.MsgRedeemLockReceipt) (*types.MsgRedeemLockReceiptResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	lockID, err := server.keeper.RedeemLockReceipt(ctx, owner, msg.Receipt)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtRedeemLockReceipt,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(lockID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeLockReceipt, msg.Receipt.String()),
+		),
+	})
+
+	return &types.MsgRedeemLockReceiptResponse{LockID: lockID}, nil
+}
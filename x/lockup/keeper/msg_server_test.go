@@ -549,3 +549,146 @@ func (s *KeeperTestSuite) TestSetRewardReceiverAddress() {
 
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgSplitMergeAndTransferLock() {
+	s.SetupTest()
+	owner, recipient := s.TestAccs[0], s.TestAccs[1]
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.FundAcc(owner, coins)
+
+	msgServer := keeper.NewMsgServerImpl(s.App.LockupKeeper)
+	lockResp, err := msgServer.LockTokens(sdk.WrapSDKContext(s.Ctx), types.NewMsgLockTokens(owner, time.Minute, coins))
+	s.Require().NoError(err)
+
+	// split part of the lock into a new lock
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	splitResp, err := msgServer.SplitLock(sdk.WrapSDKContext(s.Ctx), types.NewMsgSplitLock(owner, lockResp.ID, sdk.NewCoins(sdk.NewInt64Coin("stake", 40))))
+	s.Require().NoError(err)
+	s.Require().NotEqual(lockResp.ID, splitResp.SplitLockID)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtSplitLock, 1)
+
+	// merge the split lock back into the original lock
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	mergeResp, err := msgServer.MergeLocks(sdk.WrapSDKContext(s.Ctx), types.NewMsgMergeLocks(owner, []uint64{lockResp.ID, splitResp.SplitLockID}))
+	s.Require().NoError(err)
+	s.Require().Equal(lockResp.ID, mergeResp.MergedLockID)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtMergeLocks, 1)
+
+	// transfer the merged lock to the recipient
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.TransferLock(sdk.WrapSDKContext(s.Ctx), types.NewMsgTransferLock(owner, mergeResp.MergedLockID, recipient))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtTransferLock, 1)
+
+	lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, mergeResp.MergedLockID)
+	s.Require().NoError(err)
+	s.Require().Equal(recipient.String(), lock.Owner)
+	s.Require().Equal(coins, lock.Coins)
+
+	// the owner can no longer edit the transferred lock
+	_, err = msgServer.SplitLock(sdk.WrapSDKContext(s.Ctx), types.NewMsgSplitLock(owner, lock.ID, sdk.NewCoins(sdk.NewInt64Coin("stake", 40))))
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestMsgCancelUnlocking() {
+	s.SetupTest()
+	owner := s.TestAccs[0]
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.FundAcc(owner, coins)
+
+	msgServer := keeper.NewMsgServerImpl(s.App.LockupKeeper)
+	lockResp, err := msgServer.LockTokens(sdk.WrapSDKContext(s.Ctx), types.NewMsgLockTokens(owner, time.Minute, coins))
+	s.Require().NoError(err)
+
+	// cancelling unlocking of a lock that is not unlocking fails
+	_, err = msgServer.CancelUnlocking(sdk.WrapSDKContext(s.Ctx), types.NewMsgCancelUnlocking(owner, lockResp.ID, nil))
+	s.Require().Error(err)
+
+	_, err = msgServer.BeginUnlocking(sdk.WrapSDKContext(s.Ctx), types.NewMsgBeginUnlocking(owner, lockResp.ID, nil))
+	s.Require().NoError(err)
+
+	// cancel unlocking of part of the lock
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	cancelResp, err := msgServer.CancelUnlocking(sdk.WrapSDKContext(s.Ctx), types.NewMsgCancelUnlocking(owner, lockResp.ID, sdk.NewCoins(sdk.NewInt64Coin("stake", 40))))
+	s.Require().NoError(err)
+	s.Require().NotEqual(lockResp.ID, cancelResp.LockedLockID)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCancelUnlocking, 1)
+
+	// cancel unlocking of the rest of the lock
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	cancelResp, err = msgServer.CancelUnlocking(sdk.WrapSDKContext(s.Ctx), types.NewMsgCancelUnlocking(owner, lockResp.ID, nil))
+	s.Require().NoError(err)
+	s.Require().Equal(lockResp.ID, cancelResp.LockedLockID)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCancelUnlocking, 1)
+
+	s.Require().Empty(s.App.LockupKeeper.GetAccountUnlockingCoins(s.Ctx, owner))
+	s.Require().Len(s.App.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(s.Ctx, owner, "stake", time.Minute), 2)
+}
+
+func (s *KeeperTestSuite) TestMsgInstantUnlock() {
+	s.SetupTest()
+	owner := s.TestAccs[0]
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.FundAcc(owner, coins)
+
+	msgServer := keeper.NewMsgServerImpl(s.App.LockupKeeper)
+	lockResp, err := msgServer.LockTokens(sdk.WrapSDKContext(s.Ctx), types.NewMsgLockTokens(owner, time.Minute, coins))
+	s.Require().NoError(err)
+
+	// instant unlock fails while early unlock is not enabled for the denom
+	_, err = msgServer.InstantUnlock(sdk.WrapSDKContext(s.Ctx), types.NewMsgInstantUnlock(owner, lockResp.ID, nil))
+	s.Require().Error(err)
+
+	params := s.App.LockupKeeper.GetParams(s.Ctx)
+	params.EarlyUnlockConfigs = []types.EarlyUnlockConfig{{Denom: "stake", MaxPenalty: sdk.NewDecWithPrec(1, 1)}}
+	s.App.LockupKeeper.SetParams(s.Ctx, params)
+
+	// instant unlock of part of the lock
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	unlockResp, err := msgServer.InstantUnlock(sdk.WrapSDKContext(s.Ctx), types.NewMsgInstantUnlock(owner, lockResp.ID, sdk.NewCoins(sdk.NewInt64Coin("stake", 40))))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 4)), unlockResp.Penalty)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtInstantUnlock, 1)
+
+	// instant unlock of the rest of the lock
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	unlockResp, err = msgServer.InstantUnlock(sdk.WrapSDKContext(s.Ctx), types.NewMsgInstantUnlock(owner, lockResp.ID, nil))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 6)), unlockResp.Penalty)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtInstantUnlock, 1)
+
+	s.Require().Empty(s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, owner))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+}
+
+func (s *KeeperTestSuite) TestMsgTokenizeLockAndRedeemLockReceipt() {
+	s.SetupTest()
+	owner := s.TestAccs[0]
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.FundAcc(owner, coins)
+
+	msgServer := keeper.NewMsgServerImpl(s.App.LockupKeeper)
+	lockResp, err := msgServer.LockTokens(sdk.WrapSDKContext(s.Ctx), types.NewMsgLockTokens(owner, time.Minute, coins))
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	tokenizeResp, err := msgServer.TokenizeLock(sdk.WrapSDKContext(s.Ctx), types.NewMsgTokenizeLock(owner, lockResp.ID))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(types.LockReceiptDenom("stake", time.Minute), 100), tokenizeResp.Receipt)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtTokenizeLock, 1)
+
+	// the lock can not be tokenized twice
+	_, err = msgServer.TokenizeLock(sdk.WrapSDKContext(s.Ctx), types.NewMsgTokenizeLock(owner, lockResp.ID))
+	s.Require().Error(err)
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	redeemResp, err := msgServer.RedeemLockReceipt(sdk.WrapSDKContext(s.Ctx), types.NewMsgRedeemLockReceipt(owner, tokenizeResp.Receipt))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtRedeemLockReceipt, 1)
+
+	lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, redeemResp.LockID)
+	s.Require().NoError(err)
+	s.Require().Equal(owner.String(), lock.Owner)
+	s.Require().Equal(coins, lock.Coins)
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, owner).Empty())
+}
@@ -12,6 +12,13 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgLockTokens{}, "osmosis/lockup/lock-tokens", nil)
 	cdc.RegisterConcrete(&MsgBeginUnlockingAll{}, "osmosis/lockup/begin-unlock-tokens", nil)
 	cdc.RegisterConcrete(&MsgBeginUnlocking{}, "osmosis/lockup/begin-unlock-period-lock", nil)
+	cdc.RegisterConcrete(&MsgSplitLock{}, "osmosis/lockup/split-lock", nil)
+	cdc.RegisterConcrete(&MsgMergeLocks{}, "osmosis/lockup/merge-locks", nil)
+	cdc.RegisterConcrete(&MsgTransferLock{}, "osmosis/lockup/transfer-lock", nil)
+	cdc.RegisterConcrete(&MsgCancelUnlocking{}, "osmosis/lockup/cancel-unlocking", nil)
+	cdc.RegisterConcrete(&MsgInstantUnlock{}, "osmosis/lockup/instant-unlock", nil)
+	cdc.RegisterConcrete(&MsgTokenizeLock{}, "osmosis/lockup/tokenize-lock", nil)
+	cdc.RegisterConcrete(&MsgRedeemLockReceipt{}, "osmosis/lockup/redeem-lock-receipt", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -20,6 +27,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgLockTokens{},
 		&MsgBeginUnlockingAll{},
 		&MsgBeginUnlocking{},
+		&MsgSplitLock{},
+		&MsgMergeLocks{},
+		&MsgTransferLock{},
+		&MsgCancelUnlocking{},
+		&MsgInstantUnlock{},
+		&MsgTokenizeLock{},
+		&MsgRedeemLockReceipt{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
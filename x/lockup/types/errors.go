@@ -13,4 +13,7 @@ var (
 	ErrSyntheticDurationLongerThanNative = errorsmod.Register(ModuleName, 3, "synthetic lockup duration should be shorter than native lockup duration")
 	ErrLockupNotFound                    = errorsmod.Register(ModuleName, 4, "lockup not found")
 	ErrRewardReceiverIsSame              = errorsmod.Register(ModuleName, 5, "reward receiver is the same")
+	ErrEarlyUnlockNotEnabled             = errorsmod.Register(ModuleName, 6, "early unlock is not enabled for denom")
+	ErrBlockedRecipient                  = errorsmod.Register(ModuleName, 7, "recipient is not allowed to receive locks or lock rewards")
+	ErrLockReceiptMultiSend              = errorsmod.Register(ModuleName, 8, "lock receipt tokens can not be multi-sent")
 )
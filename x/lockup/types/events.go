@@ -2,10 +2,17 @@ package types
 
 // event types.
 const (
-	TypeEvtLockTokens      = "lock_tokens"
-	TypeEvtAddTokensToLock = "add_tokens_to_lock"
-	TypeEvtBeginUnlockAll  = "begin_unlock_all"
-	TypeEvtBeginUnlock     = "begin_unlock"
+	TypeEvtLockTokens        = "lock_tokens"
+	TypeEvtAddTokensToLock   = "add_tokens_to_lock"
+	TypeEvtBeginUnlockAll    = "begin_unlock_all"
+	TypeEvtBeginUnlock       = "begin_unlock"
+	TypeEvtSplitLock         = "split_lock"
+	TypeEvtMergeLocks        = "merge_locks"
+	TypeEvtTransferLock      = "transfer_lock"
+	TypeEvtCancelUnlocking   = "cancel_unlocking"
+	TypeEvtInstantUnlock     = "instant_unlock"
+	TypeEvtTokenizeLock      = "tokenize_lock"
+	TypeEvtRedeemLockReceipt = "redeem_lock_receipt"
 
 	AttributePeriodLockID         = "period_lock_id"
 	AttributePeriodLockOwner      = "owner"
@@ -13,4 +20,10 @@ const (
 	AttributePeriodLockDuration   = "duration"
 	AttributePeriodLockUnlockTime = "unlock_time"
 	AttributeUnlockedCoins        = "unlocked_coins"
+	AttributeSplitLockID          = "split_lock_id"
+	AttributeMergedLockIDs        = "merged_lock_ids"
+	AttributeRecipient            = "recipient"
+	AttributeLockedLockID         = "locked_lock_id"
+	AttributeEarlyUnlockPenalty   = "penalty"
+	AttributeLockReceipt          = "receipt"
 )
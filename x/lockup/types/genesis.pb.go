@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -28,6 +30,13 @@ type GenesisState struct {
 	LastLockId     uint64          `protobuf:"varint,1,opt,name=last_lock_id,json=lastLockId,proto3" json:"last_lock_id,omitempty"`
 	Locks          []PeriodLock    `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
 	SyntheticLocks []SyntheticLock `protobuf:"bytes,3,rep,name=synthetic_locks,json=syntheticLocks,proto3" json:"synthetic_locks"`
+	// early_unlock_penalties are the early unlock penalties held by the module
+	// account pending redistribution to lockers.
+	EarlyUnlockPenalties github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=early_unlock_penalties,json=earlyUnlockPenalties,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"early_unlock_penalties"`
+	// lock_receipt_pooled_lock_ids are the IDs of the pooled locks held by the
+	// module account, that back the lock receipt tokens of their denom and
+	// duration.
+	LockReceiptPooledLockIds []uint64 `protobuf:"varint,5,rep,packed,name=lock_receipt_pooled_lock_ids,json=lockReceiptPooledLockIds,proto3" json:"lock_receipt_pooled_lock_ids,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +93,20 @@ func (m *GenesisState) GetSyntheticLocks() []SyntheticLock {
 	return nil
 }
 
+func (m *GenesisState) GetEarlyUnlockPenalties() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EarlyUnlockPenalties
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockReceiptPooledLockIds() []uint64 {
+	if m != nil {
+		return m.LockReceiptPooledLockIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.lockup.GenesisState")
 }
@@ -91,24 +114,32 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/genesis.proto", fileDescriptor_648db7c6ebb608b0) }
 
 var fileDescriptor_648db7c6ebb608b0 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xc9, 0x4f, 0xce, 0x2e, 0x2d, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xca, 0xea, 0x41, 0x64, 0xa5,
-	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x24, 0x9a, 0x19,
-	0x20, 0x0a, 0x22, 0xa5, 0xb4, 0x8d, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x64, 0x70, 0x49, 0x62, 0x49,
-	0xaa, 0x90, 0x02, 0x17, 0x4f, 0x4e, 0x62, 0x71, 0x49, 0x3c, 0x48, 0x4d, 0x7c, 0x66, 0x8a, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x17, 0x48, 0xcc, 0x27, 0x3f, 0x39, 0xdb, 0x33, 0x45, 0xc8,
-	0x8c, 0x8b, 0x15, 0x24, 0x59, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0xea,
-	0x06, 0xbd, 0x80, 0xd4, 0xa2, 0xcc, 0xfc, 0x14, 0x90, 0x62, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19,
-	0x82, 0x20, 0xca, 0x85, 0x7c, 0xb8, 0xf8, 0x8b, 0x2b, 0xf3, 0x4a, 0x32, 0x52, 0x4b, 0x32, 0x93,
-	0xe3, 0x21, 0x26, 0x30, 0x83, 0x4d, 0x90, 0x45, 0x37, 0x21, 0x18, 0xa6, 0x0c, 0xc9, 0x10, 0xbe,
-	0x62, 0x64, 0xc1, 0x62, 0x27, 0x9f, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
-	0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88,
-	0x32, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x1a, 0xac, 0x9b,
-	0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97, 0x19, 0x9a, 0xe9, 0x57, 0xc0, 0xc2, 0xa2, 0xa4, 0xb2,
-	0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x1a, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x93,
-	0x16, 0x4f, 0x6e, 0x01, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x3d, 0x8f, 0xda, 0x30,
+	0x1c, 0xc6, 0x13, 0x08, 0x1d, 0x5c, 0x44, 0xa5, 0x08, 0x55, 0x01, 0xd1, 0x10, 0x75, 0xca, 0x82,
+	0x5d, 0xa8, 0xc4, 0xd8, 0x81, 0x0e, 0x55, 0x25, 0x06, 0x14, 0xd4, 0xa5, 0x4b, 0x94, 0x17, 0x2b,
+	0x58, 0x84, 0x38, 0xca, 0xdf, 0xa0, 0x32, 0xf6, 0x1b, 0xf4, 0x73, 0xf4, 0x93, 0x30, 0x32, 0xde,
+	0x74, 0x77, 0x82, 0x6f, 0x71, 0xd3, 0xc9, 0x76, 0x22, 0x71, 0x4c, 0x8e, 0xfd, 0x3c, 0xf9, 0x3d,
+	0xff, 0x17, 0x34, 0xe2, 0xb0, 0xe3, 0xc0, 0x80, 0xe4, 0x3c, 0xd9, 0xee, 0x4b, 0x92, 0xd1, 0x82,
+	0x02, 0x03, 0x5c, 0x56, 0x5c, 0x70, 0xbb, 0x57, 0xab, 0x58, 0xab, 0xc3, 0x7e, 0xc6, 0x33, 0xae,
+	0x24, 0x22, 0xbf, 0xb4, 0x6b, 0x38, 0xb8, 0x63, 0xc8, 0xa3, 0x96, 0xdc, 0x44, 0x69, 0x24, 0x8e,
+	0x80, 0x92, 0xc3, 0x34, 0xa6, 0x22, 0x9a, 0x92, 0x84, 0xb3, 0x42, 0xeb, 0x9f, 0x5f, 0x5a, 0xa8,
+	0xfb, 0x43, 0x47, 0xae, 0x45, 0x24, 0xa8, 0xed, 0xa1, 0x6e, 0x1e, 0x81, 0x08, 0x25, 0x23, 0x64,
+	0xa9, 0x63, 0x7a, 0xa6, 0x6f, 0x05, 0x48, 0xbe, 0x2d, 0x79, 0xb2, 0xfd, 0x99, 0xda, 0x73, 0xd4,
+	0x91, 0x22, 0x38, 0x2d, 0xaf, 0xed, 0xbf, 0x9f, 0x0d, 0xf1, 0xdb, 0x1a, 0xf1, 0x8a, 0x56, 0x8c,
+	0xa7, 0xd2, 0xbc, 0xb0, 0x4e, 0x8f, 0x63, 0x23, 0xd0, 0x76, 0x7b, 0x89, 0x3e, 0xc0, 0xb1, 0x10,
+	0x1b, 0x2a, 0x58, 0x12, 0x6a, 0x42, 0x5b, 0x11, 0x3e, 0xdd, 0x13, 0xd6, 0x8d, 0xed, 0x06, 0xd2,
+	0x83, 0xdb, 0x47, 0xb0, 0xff, 0x9a, 0xe8, 0x23, 0x8d, 0xaa, 0xfc, 0x18, 0xee, 0x0b, 0x55, 0x6b,
+	0x49, 0x8b, 0x28, 0x17, 0x8c, 0x82, 0x63, 0x29, 0xea, 0x00, 0xeb, 0xd6, 0xb1, 0x6c, 0x1d, 0xd7,
+	0xad, 0xe3, 0xef, 0x9c, 0x15, 0x8b, 0x2f, 0x92, 0xf8, 0xff, 0x69, 0xec, 0x67, 0x4c, 0x6c, 0xf6,
+	0x31, 0x4e, 0xf8, 0x8e, 0xd4, 0x73, 0xd2, 0xc7, 0x04, 0xd2, 0x2d, 0x11, 0xc7, 0x92, 0x82, 0xfa,
+	0x01, 0x82, 0xbe, 0x8a, 0xfa, 0xa5, 0x92, 0x56, 0x4d, 0x90, 0xfd, 0x0d, 0x8d, 0x54, 0x74, 0x45,
+	0x13, 0xca, 0x4a, 0x11, 0x96, 0x9c, 0xe7, 0x34, 0x6d, 0x46, 0x07, 0x4e, 0xc7, 0x6b, 0xfb, 0x56,
+	0xe0, 0xc8, 0x7b, 0xa0, 0x2d, 0x2b, 0xe5, 0xd0, 0x83, 0x84, 0xc5, 0xf2, 0xf7, 0xec, 0xa6, 0x82,
+	0x7a, 0x08, 0x93, 0x3c, 0x8a, 0xa1, 0xb9, 0x90, 0xc3, 0x74, 0x4e, 0xfe, 0x34, 0x7b, 0x55, 0x15,
+	0x9d, 0x2e, 0xae, 0x79, 0xbe, 0xb8, 0xe6, 0xf3, 0xc5, 0x35, 0xff, 0x5d, 0x5d, 0xe3, 0x7c, 0x75,
+	0x8d, 0x87, 0xab, 0x6b, 0xc4, 0xef, 0xd4, 0x46, 0xbf, 0xbe, 0x0e, 0x00, 0xcc, 0xd3, 0xce, 0xc4,
+	0x52, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -131,6 +162,38 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockReceiptPooledLockIds) > 0 {
+		dAtA2 := make([]byte, len(m.LockReceiptPooledLockIds)*10)
+		var j1 int
+		for _, num := range m.LockReceiptPooledLockIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EarlyUnlockPenalties) > 0 {
+		for iNdEx := len(m.EarlyUnlockPenalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EarlyUnlockPenalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.SyntheticLocks) > 0 {
 		for iNdEx := len(m.SyntheticLocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -199,6 +262,19 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.EarlyUnlockPenalties) > 0 {
+		for _, e := range m.EarlyUnlockPenalties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockReceiptPooledLockIds) > 0 {
+		l = 0
+		for _, e := range m.LockReceiptPooledLockIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -324,6 +400,116 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyUnlockPenalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EarlyUnlockPenalties = append(m.EarlyUnlockPenalties, types.Coin{})
+			if err := m.EarlyUnlockPenalties[len(m.EarlyUnlockPenalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockReceiptPooledLockIds = append(m.LockReceiptPooledLockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockReceiptPooledLockIds) == 0 {
+					m.LockReceiptPooledLockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockReceiptPooledLockIds = append(m.LockReceiptPooledLockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockReceiptPooledLockIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -13,6 +13,10 @@ type LockupHooks interface {
 	OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 	OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration time.Duration, newDuration time.Duration)
+	// BeforeLockUpdated is called before the given lock is stored, while the previous lock with the same ID, if any, is still in the store.
+	BeforeLockUpdated(ctx sdk.Context, lock PeriodLock)
+	// BeforeLockDeleted is called before the lock with the given ID is removed from the store.
+	BeforeLockDeleted(ctx sdk.Context, lockID uint64)
 }
 
 var _ LockupHooks = MultiLockupHooks{}
@@ -59,3 +63,15 @@ func (h MultiLockupHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDur
 		h[i].OnLockupExtend(ctx, lockID, prevDuration, newDuration)
 	}
 }
+
+func (h MultiLockupHooks) BeforeLockUpdated(ctx sdk.Context, lock PeriodLock) {
+	for i := range h {
+		h[i].BeforeLockUpdated(ctx, lock)
+	}
+}
+
+func (h MultiLockupHooks) BeforeLockDeleted(ctx sdk.Context, lockID uint64) {
+	for i := range h {
+		h[i].BeforeLockDeleted(ctx, lockID)
+	}
+}
@@ -64,6 +64,12 @@ var (
 	// KeyPrefixLockAccumulation defines prefix for the lock accumulation store.
 	KeyPrefixLockAccumulation = []byte{0x20}
 
+	// KeyPrefixEarlyUnlockPenalty defines prefix for the early unlock penalties pending redistribution to lockers, by denom.
+	KeyPrefixEarlyUnlockPenalty = []byte{0x21}
+
+	// KeyPrefixLockReceiptPooledLock defines prefix for the IDs of the pooled locks backing lock receipt tokens, by lock receipt denom.
+	KeyPrefixLockReceiptPooledLock = []byte{0x22}
+
 	// KeyIndexSeparator defines separator between keys when combine, it should be one that is not used in denom expression.
 	KeyIndexSeparator = []byte{0xFF}
 )
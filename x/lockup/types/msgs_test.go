@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v16/x/lockup/types"
@@ -262,7 +263,476 @@ func TestMsgExtendLockup(t *testing.T) {
 	}
 }
 
+func TestMsgSplitLock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSplitLock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgSplitLock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgSplitLock{
+				Owner: invalidAddr,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgSplitLock{
+				Owner: addr1,
+				ID:    0,
+				Coins: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+			},
+		},
+		{
+			name: "empty coins",
+			msg: types.MsgSplitLock{
+				Owner: addr1,
+				ID:    1,
+			},
+		},
+		{
+			name: "invalid coin length",
+			msg: types.MsgSplitLock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewCoin("test1", sdk.NewInt(100)), sdk.NewCoin("test2", sdk.NewInt(100))),
+			},
+		},
+		{
+			name: "zero token amount",
+			msg: types.MsgSplitLock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.Coins{sdk.NewCoin("test", sdk.NewInt(0))},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "split_lock")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgMergeLocks(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgMergeLocks
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIDs: []uint64{1, 2, 3},
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgMergeLocks{
+				Owner:   invalidAddr,
+				LockIDs: []uint64{1, 2},
+			},
+		},
+		{
+			name: "single lock ID",
+			msg: types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIDs: []uint64{1},
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIDs: []uint64{1, 0},
+			},
+		},
+		{
+			name: "duplicate lockup ID",
+			msg: types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIDs: []uint64{1, 2, 1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "merge_locks")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgTransferLock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+	addr2, _ := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgTransferLock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgTransferLock{
+				Owner:     addr1,
+				ID:        1,
+				Recipient: addr2,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgTransferLock{
+				Owner:     invalidAddr,
+				ID:        1,
+				Recipient: addr2,
+			},
+		},
+		{
+			name: "invalid recipient",
+			msg: types.MsgTransferLock{
+				Owner:     addr1,
+				ID:        1,
+				Recipient: invalidAddr,
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgTransferLock{
+				Owner:     addr1,
+				ID:        0,
+				Recipient: addr2,
+			},
+		},
+		{
+			name: "recipient is owner",
+			msg: types.MsgTransferLock{
+				Owner:     addr1,
+				ID:        1,
+				Recipient: addr1,
+			},
+		},
+		{
+			name: "recipient is the lockup module account",
+			msg: types.MsgTransferLock{
+				Owner:     addr1,
+				ID:        1,
+				Recipient: authtypes.NewModuleAddress(types.ModuleName).String(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "transfer_lock")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
 // // Test authz serialize and de-serializes for lockup msg.
+func TestMsgCancelUnlocking(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCancelUnlocking
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCancelUnlocking{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper msg with empty coins",
+			msg: types.MsgCancelUnlocking{
+				Owner: addr1,
+				ID:    1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgCancelUnlocking{
+				Owner: invalidAddr,
+				ID:    1,
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgCancelUnlocking{
+				Owner: addr1,
+				ID:    0,
+			},
+		},
+		{
+			name: "invalid coin length",
+			msg: types.MsgCancelUnlocking{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewCoin("test1", sdk.NewInt(100)), sdk.NewCoin("test2", sdk.NewInt(100))),
+			},
+		},
+		{
+			name: "zero token amount",
+			msg: types.MsgCancelUnlocking{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.Coins{sdk.NewCoin("test", sdk.NewInt(0))},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "cancel_unlocking")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgInstantUnlock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgInstantUnlock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgInstantUnlock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper msg with empty coins",
+			msg: types.MsgInstantUnlock{
+				Owner: addr1,
+				ID:    1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgInstantUnlock{
+				Owner: invalidAddr,
+				ID:    1,
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgInstantUnlock{
+				Owner: addr1,
+				ID:    0,
+			},
+		},
+		{
+			name: "invalid coin length",
+			msg: types.MsgInstantUnlock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewCoin("test1", sdk.NewInt(100)), sdk.NewCoin("test2", sdk.NewInt(100))),
+			},
+		},
+		{
+			name: "zero token amount",
+			msg: types.MsgInstantUnlock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.Coins{sdk.NewCoin("test", sdk.NewInt(0))},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "instant_unlock")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgTokenizeLock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgTokenizeLock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgTokenizeLock{
+				Owner: addr1,
+				ID:    1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgTokenizeLock{
+				Owner: invalidAddr,
+				ID:    1,
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgTokenizeLock{
+				Owner: addr1,
+				ID:    0,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "tokenize_lock")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgRedeemLockReceipt(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+	receiptDenom := types.LockReceiptDenom("test", time.Hour)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgRedeemLockReceipt
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgRedeemLockReceipt{
+				Owner:   addr1,
+				Receipt: sdk.NewCoin(receiptDenom, sdk.NewInt(100)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgRedeemLockReceipt{
+				Owner:   invalidAddr,
+				Receipt: sdk.NewCoin(receiptDenom, sdk.NewInt(100)),
+			},
+		},
+		{
+			name: "zero receipt amount",
+			msg: types.MsgRedeemLockReceipt{
+				Owner:   addr1,
+				Receipt: sdk.NewCoin(receiptDenom, sdk.NewInt(0)),
+			},
+		},
+		{
+			name: "not a lock receipt denom",
+			msg: types.MsgRedeemLockReceipt{
+				Owner:   addr1,
+				Receipt: sdk.NewCoin("test", sdk.NewInt(100)),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "redeem_lock_receipt")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 := sdk.AccAddress(pk1.Address()).String()
@@ -294,6 +764,59 @@ func TestAuthzMsg(t *testing.T) {
 				Owner: addr1,
 			},
 		},
+		{
+			name: "MsgSplitLock",
+			msg: &types.MsgSplitLock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(coin),
+			},
+		},
+		{
+			name: "MsgMergeLocks",
+			msg: &types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIDs: []uint64{1, 2},
+			},
+		},
+		{
+			name: "MsgTransferLock",
+			msg: &types.MsgTransferLock{
+				Owner:     addr1,
+				ID:        1,
+				Recipient: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			},
+		},
+		{
+			name: "MsgCancelUnlocking",
+			msg: &types.MsgCancelUnlocking{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(coin),
+			},
+		},
+		{
+			name: "MsgInstantUnlock",
+			msg: &types.MsgInstantUnlock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(coin),
+			},
+		},
+		{
+			name: "MsgTokenizeLock",
+			msg: &types.MsgTokenizeLock{
+				Owner: addr1,
+				ID:    1,
+			},
+		},
+		{
+			name: "MsgRedeemLockReceipt",
+			msg: &types.MsgRedeemLockReceipt{
+				Owner:   addr1,
+				Receipt: sdk.NewCoin(types.LockReceiptDenom("denom", time.Hour), sdk.NewInt(1)),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -10,6 +10,7 @@ import (
 // Parameter store keys.
 var (
 	KeyForceUnlockAllowedAddresses = []byte("ForceUnlockAllowedAddresses")
+	KeyEarlyUnlockConfigs          = []byte("EarlyUnlockConfigs")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -19,9 +20,10 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(forceUnlockAllowedAddresses []string) Params {
+func NewParams(forceUnlockAllowedAddresses []string, earlyUnlockConfigs []EarlyUnlockConfig) Params {
 	return Params{
 		ForceUnlockAllowedAddresses: forceUnlockAllowedAddresses,
+		EarlyUnlockConfigs:          earlyUnlockConfigs,
 	}
 }
 
@@ -29,6 +31,7 @@ func NewParams(forceUnlockAllowedAddresses []string) Params {
 func DefaultParams() Params {
 	return Params{
 		ForceUnlockAllowedAddresses: []string{},
+		EarlyUnlockConfigs:          []EarlyUnlockConfig{},
 	}
 }
 
@@ -37,6 +40,9 @@ func (p Params) Validate() error {
 	if err := validateAddresses(p.ForceUnlockAllowedAddresses); err != nil {
 		return err
 	}
+	if err := validateEarlyUnlockConfigs(p.EarlyUnlockConfigs); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -44,6 +50,7 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyForceUnlockAllowedAddresses, &p.ForceUnlockAllowedAddresses, validateAddresses),
+		paramtypes.NewParamSetPair(KeyEarlyUnlockConfigs, &p.EarlyUnlockConfigs, validateEarlyUnlockConfigs),
 	}
 }
 
@@ -61,3 +68,27 @@ func validateAddresses(i interface{}) error {
 
 	return nil
 }
+
+func validateEarlyUnlockConfigs(i interface{}) error {
+	configs, ok := i.([]EarlyUnlockConfig)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenDenoms := make(map[string]bool, len(configs))
+	for _, config := range configs {
+		if err := sdk.ValidateDenom(config.Denom); err != nil {
+			return err
+		}
+		if seenDenoms[config.Denom] {
+			return fmt.Errorf("duplicate early unlock config for denom %s", config.Denom)
+		}
+		seenDenoms[config.Denom] = true
+
+		if config.MaxPenalty.IsNil() || config.MaxPenalty.IsNegative() || config.MaxPenalty.GTE(sdk.OneDec()) {
+			return fmt.Errorf("early unlock max penalty for denom %s must be in [0, 1), got %s", config.Denom, config.MaxPenalty)
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,6 +26,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Params struct {
 	ForceUnlockAllowedAddresses []string `protobuf:"bytes,1,rep,name=force_unlock_allowed_addresses,json=forceUnlockAllowedAddresses,proto3" json:"force_unlock_allowed_addresses,omitempty" yaml:"force_unlock_allowed_address"`
+	// early_unlock_configs lists the denoms whose locks can be instantly
+	// unlocked by their owners in exchange for a penalty.
+	EarlyUnlockConfigs []EarlyUnlockConfig `protobuf:"bytes,2,rep,name=early_unlock_configs,json=earlyUnlockConfigs,proto3" json:"early_unlock_configs" yaml:"early_unlock_configs"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -67,28 +71,108 @@ func (m *Params) GetForceUnlockAllowedAddresses() []string {
 	return nil
 }
 
+func (m *Params) GetEarlyUnlockConfigs() []EarlyUnlockConfig {
+	if m != nil {
+		return m.EarlyUnlockConfigs
+	}
+	return nil
+}
+
+// EarlyUnlockConfig enables instant unlocking with a penalty for locks of a
+// single denom.
+type EarlyUnlockConfig struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// max_penalty is the fraction of the unlocked coins charged when a lock is
+	// exited with its full duration remaining. The penalty charged decreases
+	// linearly with the remaining duration.
+	MaxPenalty github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_penalty,json=maxPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_penalty" yaml:"max_penalty"`
+	// redistribute_to_lockers sends the penalty to the remaining lockers of the
+	// denom when true, and to the community pool otherwise.
+	RedistributeToLockers bool `protobuf:"varint,3,opt,name=redistribute_to_lockers,json=redistributeToLockers,proto3" json:"redistribute_to_lockers,omitempty" yaml:"redistribute_to_lockers"`
+}
+
+func (m *EarlyUnlockConfig) Reset()         { *m = EarlyUnlockConfig{} }
+func (m *EarlyUnlockConfig) String() string { return proto.CompactTextString(m) }
+func (*EarlyUnlockConfig) ProtoMessage()    {}
+func (*EarlyUnlockConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4595e58f5e17053c, []int{1}
+}
+func (m *EarlyUnlockConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EarlyUnlockConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EarlyUnlockConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EarlyUnlockConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EarlyUnlockConfig.Merge(m, src)
+}
+func (m *EarlyUnlockConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *EarlyUnlockConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_EarlyUnlockConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EarlyUnlockConfig proto.InternalMessageInfo
+
+func (m *EarlyUnlockConfig) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EarlyUnlockConfig) GetRedistributeToLockers() bool {
+	if m != nil {
+		return m.RedistributeToLockers
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.lockup.Params")
+	proto.RegisterType((*EarlyUnlockConfig)(nil), "osmosis.lockup.EarlyUnlockConfig")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/params.proto", fileDescriptor_4595e58f5e17053c) }
 
 var fileDescriptor_4595e58f5e17053c = []byte{
-	// 214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xc9, 0x4f, 0xce, 0x2e, 0x2d, 0xd0, 0x2f, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0x4a, 0xea, 0x41, 0x24, 0xa5, 0x44,
-	0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x52, 0x19, 0x17, 0x5b, 0x00,
-	0x58, 0x97, 0x50, 0x0e, 0x97, 0x5c, 0x5a, 0x7e, 0x51, 0x72, 0x6a, 0x7c, 0x69, 0x1e, 0x48, 0x47,
-	0x7c, 0x62, 0x4e, 0x4e, 0x7e, 0x79, 0x6a, 0x4a, 0x7c, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71,
-	0x6a, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xa7, 0x93, 0xfa, 0xa7, 0x7b, 0xf2, 0xca, 0x95, 0x89,
-	0xb9, 0x39, 0x56, 0x4a, 0xf8, 0xd4, 0x2b, 0x05, 0x49, 0x83, 0xa5, 0x43, 0xc1, 0xb2, 0x8e, 0x10,
-	0x49, 0x47, 0x98, 0x59, 0x4e, 0x3e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x65, 0x94, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xf5, 0x82, 0x6e,
-	0x4e, 0x62, 0x52, 0x31, 0x8c, 0xa3, 0x5f, 0x66, 0x68, 0xa6, 0x5f, 0x01, 0xf3, 0x72, 0x49, 0x65,
-	0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x33, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x24, 0x72,
-	0x2d, 0x01, 0x11, 0x01, 0x00, 0x00,
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x6e, 0x95, 0x40,
+	0x18, 0x86, 0x99, 0x9e, 0xd8, 0xd8, 0xa9, 0x31, 0x3a, 0xa9, 0x91, 0x78, 0x92, 0x01, 0xa7, 0x49,
+	0x65, 0x53, 0x88, 0x35, 0x71, 0xe1, 0xae, 0x58, 0x77, 0x5d, 0x34, 0x44, 0x37, 0xdd, 0x90, 0x01,
+	0xa6, 0x48, 0x3a, 0x30, 0x64, 0x06, 0x14, 0xee, 0xc2, 0xcb, 0xea, 0xb2, 0x4b, 0xe3, 0x82, 0x98,
+	0x73, 0x6e, 0xc0, 0x70, 0x01, 0xc6, 0x30, 0x73, 0x48, 0x8e, 0x7f, 0x5d, 0xc1, 0xcc, 0xf3, 0x7e,
+	0x4f, 0x5e, 0xc8, 0x07, 0x97, 0x42, 0x95, 0x42, 0x15, 0x2a, 0xe0, 0x22, 0xbd, 0x6e, 0xeb, 0xa0,
+	0xa6, 0x92, 0x96, 0xca, 0xaf, 0xa5, 0x68, 0x04, 0x7a, 0xb8, 0x81, 0xbe, 0x81, 0xcf, 0x0e, 0x72,
+	0x91, 0x0b, 0x8d, 0x82, 0xe9, 0xcd, 0xa4, 0xc8, 0x0f, 0x00, 0x77, 0x2f, 0xf4, 0x18, 0xe2, 0x10,
+	0x5f, 0x09, 0x99, 0xb2, 0xb8, 0xad, 0xa6, 0x91, 0x98, 0x72, 0x2e, 0x3e, 0xb3, 0x2c, 0xa6, 0x59,
+	0x26, 0x99, 0x52, 0x4c, 0xd9, 0xc0, 0x5d, 0x78, 0x7b, 0xe1, 0x8b, 0x71, 0x70, 0x0e, 0x7b, 0x5a,
+	0xf2, 0x37, 0xe4, 0xae, 0x3c, 0x89, 0x96, 0x1a, 0x7f, 0xd0, 0xf4, 0xd4, 0xc0, 0xd3, 0xd9, 0x85,
+	0x3a, 0x78, 0xc0, 0xa8, 0xe4, 0xfd, 0x3c, 0x9d, 0x8a, 0xea, 0xaa, 0xc8, 0x95, 0xbd, 0xe3, 0x2e,
+	0xbc, 0xfd, 0x93, 0xe7, 0xfe, 0xef, 0xed, 0xfd, 0x77, 0x53, 0xd6, 0xa8, 0xde, 0xea, 0x64, 0x78,
+	0x78, 0x33, 0x38, 0xd6, 0x38, 0x38, 0x4b, 0x53, 0xe5, 0x5f, 0x32, 0x12, 0x21, 0xf6, 0xe7, 0x9c,
+	0x22, 0x3f, 0x01, 0x7c, 0xfc, 0x97, 0x0e, 0x1d, 0xc1, 0x7b, 0x19, 0xab, 0x44, 0x69, 0x03, 0x17,
+	0x78, 0x7b, 0xe1, 0xa3, 0x71, 0x70, 0x1e, 0x18, 0xb3, 0xbe, 0x26, 0x91, 0xc1, 0x88, 0xc1, 0xfd,
+	0x92, 0x76, 0x71, 0xcd, 0x2a, 0xca, 0x9b, 0xde, 0xde, 0xd1, 0xe9, 0xb3, 0xa9, 0xcb, 0xb7, 0xc1,
+	0x39, 0xca, 0x8b, 0xe6, 0x63, 0x9b, 0xf8, 0xa9, 0x28, 0x83, 0x54, 0x7f, 0xc1, 0xe6, 0x71, 0xac,
+	0xb2, 0xeb, 0xa0, 0xe9, 0x6b, 0xa6, 0xfc, 0x33, 0x96, 0x8e, 0x83, 0x83, 0x8c, 0x7b, 0x4b, 0x45,
+	0x22, 0x58, 0xd2, 0xee, 0xc2, 0x1c, 0xd0, 0x25, 0x7c, 0x2a, 0x59, 0x56, 0xa8, 0x46, 0x16, 0x49,
+	0xdb, 0xb0, 0xb8, 0x11, 0xf1, 0x54, 0x96, 0x49, 0x65, 0x2f, 0x5c, 0xe0, 0xdd, 0x0f, 0xc9, 0x38,
+	0x38, 0xd8, 0x48, 0xfe, 0x13, 0x24, 0xd1, 0x93, 0x6d, 0xf2, 0x5e, 0x9c, 0x9b, 0xfb, 0xf0, 0xfc,
+	0xf2, 0x64, 0xab, 0xe7, 0xe6, 0x47, 0x1f, 0x73, 0x9a, 0xa8, 0xf9, 0x10, 0x7c, 0x7a, 0xf9, 0x3a,
+	0xe8, 0xe6, 0xb5, 0xd2, 0xbd, 0x6f, 0x56, 0x18, 0xdc, 0xae, 0x30, 0xf8, 0xbe, 0xc2, 0xe0, 0xcb,
+	0x1a, 0x5b, 0xb7, 0x6b, 0x6c, 0x7d, 0x5d, 0x63, 0x2b, 0xd9, 0xd5, 0x8b, 0xf4, 0xea, 0xd7, 0x00,
+	0x0a, 0xb6, 0x79, 0x4b, 0x8d, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -111,6 +195,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EarlyUnlockConfigs) > 0 {
+		for iNdEx := len(m.EarlyUnlockConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EarlyUnlockConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ForceUnlockAllowedAddresses) > 0 {
 		for iNdEx := len(m.ForceUnlockAllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ForceUnlockAllowedAddresses[iNdEx])
@@ -123,6 +221,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EarlyUnlockConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EarlyUnlockConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EarlyUnlockConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RedistributeToLockers {
+		i--
+		if m.RedistributeToLockers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxPenalty.Size()
+		i -= size
+		if _, err := m.MaxPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -146,6 +294,30 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.EarlyUnlockConfigs) > 0 {
+		for _, e := range m.EarlyUnlockConfigs {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EarlyUnlockConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MaxPenalty.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.RedistributeToLockers {
+		n += 2
+	}
 	return n
 }
 
@@ -216,6 +388,176 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ForceUnlockAllowedAddresses = append(m.ForceUnlockAllowedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyUnlockConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EarlyUnlockConfigs = append(m.EarlyUnlockConfigs, EarlyUnlockConfig{})
+			if err := m.EarlyUnlockConfigs[len(m.EarlyUnlockConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EarlyUnlockConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EarlyUnlockConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EarlyUnlockConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedistributeToLockers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RedistributeToLockers = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// LockReceiptDenomPrefix is the prefix of the denoms of lock receipt tokens, minted when tokenizing a lock.
+const LockReceiptDenomPrefix = "lockup/receipt"
+
+// LockReceiptDenom returns the denom of the lock receipt tokens that represent a claim on locked coins
+// of the given denom and duration.
+func LockReceiptDenom(denom string, duration time.Duration) string {
+	return fmt.Sprintf("%s/%s/%s", LockReceiptDenomPrefix, denom, duration)
+}
+
+// IsLockReceiptDenom returns true if the given denom is the denom of lock receipt tokens.
+func IsLockReceiptDenom(denom string) bool {
+	return strings.HasPrefix(denom, LockReceiptDenomPrefix+"/")
+}
+
+// ParseLockReceiptDenom returns the denom and duration of the locked coins claimed by the given lock receipt denom.
+func ParseLockReceiptDenom(receiptDenom string) (string, time.Duration, error) {
+	if !IsLockReceiptDenom(receiptDenom) {
+		return "", 0, fmt.Errorf("%s is not a lock receipt denom", receiptDenom)
+	}
+
+	denomAndDuration := strings.TrimPrefix(receiptDenom, LockReceiptDenomPrefix+"/")
+	separator := strings.LastIndex(denomAndDuration, "/")
+	if separator <= 0 {
+		return "", 0, fmt.Errorf("invalid lock receipt denom %s", receiptDenom)
+	}
+
+	denom := denomAndDuration[:separator]
+	duration, err := time.ParseDuration(denomAndDuration[separator+1:])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid lock receipt denom %s: %w", receiptDenom, err)
+	}
+
+	// only accept the canonical form of the denom, as minted by the module.
+	if LockReceiptDenom(denom, duration) != receiptDenom {
+		return "", 0, fmt.Errorf("invalid lock receipt denom %s", receiptDenom)
+	}
+
+	return denom, duration, nil
+}
+
+// LockReceiptRewardsAddress returns the address receiving the incentives of the pooled lock backing the given
+// lock receipt denom, to be redistributed to the lockers of the lock receipt tokens.
+func LockReceiptRewardsAddress(receiptDenom string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(receiptDenom))
+}
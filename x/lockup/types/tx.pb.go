@@ -659,1026 +659,4006 @@ func (m *MsgSetRewardReceiverAddressResponse) GetSuccess() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*MsgLockTokens)(nil), "osmosis.lockup.MsgLockTokens")
-	proto.RegisterType((*MsgLockTokensResponse)(nil), "osmosis.lockup.MsgLockTokensResponse")
-	proto.RegisterType((*MsgBeginUnlockingAll)(nil), "osmosis.lockup.MsgBeginUnlockingAll")
-	proto.RegisterType((*MsgBeginUnlockingAllResponse)(nil), "osmosis.lockup.MsgBeginUnlockingAllResponse")
-	proto.RegisterType((*MsgBeginUnlocking)(nil), "osmosis.lockup.MsgBeginUnlocking")
-	proto.RegisterType((*MsgBeginUnlockingResponse)(nil), "osmosis.lockup.MsgBeginUnlockingResponse")
-	proto.RegisterType((*MsgExtendLockup)(nil), "osmosis.lockup.MsgExtendLockup")
-	proto.RegisterType((*MsgExtendLockupResponse)(nil), "osmosis.lockup.MsgExtendLockupResponse")
-	proto.RegisterType((*MsgForceUnlock)(nil), "osmosis.lockup.MsgForceUnlock")
-	proto.RegisterType((*MsgForceUnlockResponse)(nil), "osmosis.lockup.MsgForceUnlockResponse")
-	proto.RegisterType((*MsgSetRewardReceiverAddress)(nil), "osmosis.lockup.MsgSetRewardReceiverAddress")
-	proto.RegisterType((*MsgSetRewardReceiverAddressResponse)(nil), "osmosis.lockup.MsgSetRewardReceiverAddressResponse")
+// MsgSplitLock splits the given coins of a lock into a new lock of the same
+// owner, duration and reward receiver. The lock must not be unlocking.
+type MsgSplitLock struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ID    uint64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Amount of coins split into the new lock. Must be less than the lock's coins.
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
 }
 
-func init() { proto.RegisterFile("osmosis/lockup/tx.proto", fileDescriptor_bcdad5af0d24735f) }
-
-var fileDescriptor_bcdad5af0d24735f = []byte{
-	// 791 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6f, 0xd3, 0x48,
-	0x18, 0x8e, 0x93, 0x7e, 0xbe, 0xed, 0x26, 0x5b, 0xab, 0xdb, 0xa6, 0xde, 0x5d, 0xbb, 0xeb, 0xdd,
-	0x6d, 0xb2, 0xbb, 0x8d, 0xbd, 0x49, 0x11, 0x87, 0x5e, 0x50, 0xd3, 0x82, 0x54, 0xa9, 0x91, 0x90,
-	0x69, 0x25, 0xc4, 0x81, 0xca, 0x71, 0x86, 0xa9, 0x95, 0xc4, 0x13, 0x79, 0xec, 0x7e, 0x48, 0xfc,
-	0x02, 0x4e, 0x1c, 0x39, 0x23, 0x71, 0x81, 0x0b, 0x12, 0x7f, 0xa2, 0xc7, 0x72, 0xe3, 0x80, 0x52,
-	0xd4, 0x1e, 0x90, 0x38, 0xf6, 0x17, 0x20, 0x8f, 0x3f, 0xe4, 0x7c, 0x34, 0x09, 0x48, 0x20, 0x2e,
-	0xb5, 0x67, 0xde, 0xe7, 0x7d, 0xe6, 0x79, 0x1e, 0xcf, 0x4c, 0x03, 0x8b, 0x84, 0x36, 0x09, 0x35,
-	0xa9, 0xda, 0x20, 0x46, 0xdd, 0x6d, 0xa9, 0xce, 0xb1, 0xd2, 0xb2, 0x89, 0x43, 0xf8, 0x74, 0x50,
-	0x50, 0xfc, 0x82, 0x30, 0x8f, 0x09, 0x26, 0xac, 0xa4, 0x7a, 0x6f, 0x3e, 0x4a, 0x98, 0xd3, 0x9b,
-	0xa6, 0x45, 0x54, 0xf6, 0x37, 0x98, 0x12, 0x31, 0x21, 0xb8, 0x81, 0x54, 0x36, 0xaa, 0xba, 0x8f,
-	0xd4, 0x9a, 0x6b, 0xeb, 0x8e, 0x49, 0xac, 0xb0, 0x6e, 0x30, 0x66, 0xb5, 0xaa, 0x53, 0xa4, 0x1e,
-	0x16, 0xab, 0xc8, 0xd1, 0x8b, 0xaa, 0x41, 0xcc, 0xb0, 0xbe, 0xd4, 0xa5, 0xc8, 0x7b, 0xf8, 0x25,
-	0xf9, 0x45, 0x12, 0x7e, 0xaa, 0x50, 0xbc, 0x43, 0x8c, 0xfa, 0x2e, 0xa9, 0x23, 0x8b, 0xf2, 0x2b,
-	0x30, 0x4e, 0x8e, 0x2c, 0x64, 0x67, 0xb9, 0x65, 0x2e, 0x3f, 0x5d, 0xfe, 0xf9, 0xaa, 0x2d, 0xcd,
-	0x9e, 0xe8, 0xcd, 0xc6, 0xba, 0xcc, 0xa6, 0x65, 0xcd, 0x2f, 0xf3, 0x07, 0x30, 0x15, 0xca, 0xc8,
-	0x26, 0x97, 0xb9, 0xfc, 0x4c, 0x69, 0x49, 0xf1, 0x75, 0x2a, 0xa1, 0x4e, 0x65, 0x2b, 0x00, 0x94,
-	0x8b, 0xa7, 0x6d, 0x29, 0xf1, 0xa9, 0x2d, 0xf1, 0x61, 0xcb, 0x2a, 0x69, 0x9a, 0x0e, 0x6a, 0xb6,
-	0x9c, 0x93, 0xab, 0xb6, 0x94, 0xf1, 0xf9, 0xc3, 0x9a, 0xfc, 0xec, 0x5c, 0xe2, 0xb4, 0x88, 0x9d,
-	0xd7, 0x61, 0xdc, 0x33, 0x43, 0xb3, 0xa9, 0xe5, 0x14, 0x5b, 0xc6, 0xb7, 0xab, 0x78, 0x76, 0x95,
-	0xc0, 0xae, 0xb2, 0x49, 0x4c, 0xab, 0xfc, 0xbf, 0xb7, 0xcc, 0xcb, 0x73, 0x29, 0x8f, 0x4d, 0xe7,
-	0xc0, 0xad, 0x2a, 0x06, 0x69, 0xaa, 0x41, 0x36, 0xfe, 0xa3, 0x40, 0x6b, 0x75, 0xd5, 0x39, 0x69,
-	0x21, 0xca, 0x1a, 0xa8, 0xe6, 0x33, 0xaf, 0x4b, 0x4f, 0x3e, 0xbe, 0xfe, 0x57, 0xe8, 0x13, 0x53,
-	0xc1, 0x61, 0xa9, 0xc8, 0x39, 0xf8, 0xa5, 0x23, 0x26, 0x0d, 0xd1, 0x16, 0xb1, 0x28, 0xe2, 0xd3,
-	0x90, 0xdc, 0xde, 0x62, 0x59, 0x8d, 0x69, 0xc9, 0xed, 0x2d, 0x19, 0xc3, 0x7c, 0x85, 0xe2, 0x32,
-	0xc2, 0xa6, 0xb5, 0x67, 0x79, 0x0c, 0xa6, 0x85, 0x37, 0x1a, 0x8d, 0x51, 0x63, 0x5d, 0xcf, 0x79,
-	0x4a, 0xe4, 0x2e, 0x25, 0x55, 0x8f, 0xae, 0xe0, 0x5a, 0x71, 0x45, 0xbb, 0xf0, 0x5b, 0xbf, 0x85,
-	0x22, 0x61, 0x37, 0x60, 0xd2, 0x6f, 0xa0, 0x59, 0x8e, 0xe5, 0x26, 0x28, 0x9d, 0xfb, 0x4f, 0xb9,
-	0x8b, 0x6c, 0x93, 0xd4, 0x3c, 0x4f, 0x5a, 0x08, 0x95, 0xdf, 0x73, 0x30, 0xd7, 0x43, 0x3b, 0xf2,
-	0x9e, 0xf0, 0xc3, 0x48, 0x86, 0x61, 0x7c, 0x8f, 0x2f, 0xb7, 0xea, 0xe5, 0x95, 0x1b, 0x94, 0x57,
-	0x8b, 0xd9, 0x2c, 0x78, 0xef, 0xf2, 0x3e, 0x2c, 0xf5, 0xb8, 0x8b, 0x12, 0xcb, 0xc2, 0x24, 0x75,
-	0x0d, 0x03, 0x51, 0xca, 0x7c, 0x4e, 0x69, 0xe1, 0x90, 0xcf, 0x43, 0xc6, 0x0d, 0xe1, 0x5e, 0x5e,
-	0x91, 0xc9, 0xee, 0x69, 0xf9, 0x0d, 0x07, 0x99, 0x0a, 0xc5, 0xb7, 0x8f, 0x1d, 0x64, 0xb1, 0x68,
-	0xdd, 0xd6, 0x57, 0xa7, 0x17, 0x3f, 0x61, 0xa9, 0x6f, 0x79, 0xc2, 0xe4, 0x35, 0x58, 0xec, 0x12,
-	0x3d, 0x3c, 0x14, 0xf9, 0x15, 0x07, 0xe9, 0x0a, 0xc5, 0x77, 0x88, 0x6d, 0x20, 0x3f, 0xcc, 0x1f,
-	0x78, 0x9f, 0xc8, 0x25, 0x58, 0xe8, 0x14, 0x3b, 0x82, 0xc3, 0xe7, 0x1c, 0xfc, 0x5a, 0xa1, 0xf8,
-	0x1e, 0x72, 0x34, 0x74, 0xa4, 0xdb, 0x35, 0x0d, 0x19, 0xc8, 0x3c, 0x44, 0xf6, 0x46, 0xad, 0x66,
-	0x7b, 0xdb, 0x62, 0x54, 0xbb, 0x0b, 0x30, 0xd1, 0x88, 0xef, 0x9a, 0x60, 0xc4, 0x6f, 0x42, 0xc6,
-	0x66, 0xc4, 0xfb, 0x76, 0xc0, 0xcc, 0xbe, 0xf3, 0x74, 0x59, 0xb8, 0x6a, 0x4b, 0x0b, 0x3e, 0x53,
-	0x17, 0x40, 0xd6, 0xd2, 0x76, 0x87, 0x16, 0xf9, 0x16, 0xfc, 0x39, 0x40, 0xe3, 0x70, 0x97, 0xa5,
-	0xb7, 0x63, 0x90, 0xaa, 0x50, 0xcc, 0x6b, 0x00, 0xb1, 0x7f, 0x03, 0xbf, 0x77, 0xdf, 0x16, 0x1d,
-	0xd7, 0x9f, 0xf0, 0xf7, 0xc0, 0x72, 0xb4, 0x2a, 0x86, 0xb9, 0xde, 0xab, 0xf0, 0xaf, 0x3e, 0xbd,
-	0x3d, 0x28, 0x61, 0x75, 0x14, 0x54, 0xb4, 0xd0, 0x43, 0x48, 0x77, 0xdd, 0x59, 0x7f, 0x0c, 0xed,
-	0x17, 0xfe, 0x19, 0x0a, 0x89, 0xf8, 0xef, 0xc3, 0x6c, 0xc7, 0x99, 0x96, 0xfa, 0xb4, 0xc6, 0x01,
-	0x42, 0x6e, 0x08, 0x20, 0x62, 0xde, 0x83, 0x99, 0xf8, 0x11, 0x12, 0xfb, 0xf4, 0xc5, 0xea, 0xc2,
-	0xca, 0xe0, 0x7a, 0x44, 0xfb, 0x18, 0xb2, 0xd7, 0xee, 0xdb, 0xff, 0xfa, 0x70, 0x5c, 0x07, 0x16,
-	0xd6, 0xbe, 0x00, 0x1c, 0xae, 0x5e, 0xde, 0x39, 0xbd, 0x10, 0xb9, 0xb3, 0x0b, 0x91, 0xfb, 0x70,
-	0x21, 0x72, 0x4f, 0x2f, 0xc5, 0xc4, 0xd9, 0xa5, 0x98, 0x78, 0x77, 0x29, 0x26, 0x1e, 0x94, 0x62,
-	0x07, 0x37, 0x20, 0x2e, 0x34, 0xf4, 0x2a, 0x0d, 0x07, 0xea, 0x61, 0xf1, 0xa6, 0x7a, 0x1c, 0xfd,
-	0x76, 0xf2, 0x0e, 0x72, 0x75, 0x82, 0x5d, 0x77, 0x6b, 0x9f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xa0,
-	0x14, 0xce, 0x00, 0x5a, 0x09, 0x00, 0x00,
+func (m *MsgSplitLock) Reset()         { *m = MsgSplitLock{} }
+func (m *MsgSplitLock) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitLock) ProtoMessage()    {}
+func (*MsgSplitLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{12}
+}
+func (m *MsgSplitLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitLock.Merge(m, src)
+}
+func (m *MsgSplitLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitLock.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgSplitLock proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSplitLock) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// LockTokens lock tokens
-	LockTokens(ctx context.Context, in *MsgLockTokens, opts ...grpc.CallOption) (*MsgLockTokensResponse, error)
-	// BeginUnlockingAll begin unlocking all tokens
-	BeginUnlockingAll(ctx context.Context, in *MsgBeginUnlockingAll, opts ...grpc.CallOption) (*MsgBeginUnlockingAllResponse, error)
-	// MsgBeginUnlocking begins unlocking tokens by lock ID
-	BeginUnlocking(ctx context.Context, in *MsgBeginUnlocking, opts ...grpc.CallOption) (*MsgBeginUnlockingResponse, error)
-	// MsgEditLockup edits the existing lockups by lock ID
-	ExtendLockup(ctx context.Context, in *MsgExtendLockup, opts ...grpc.CallOption) (*MsgExtendLockupResponse, error)
-	ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ...grpc.CallOption) (*MsgForceUnlockResponse, error)
-	// SetRewardReceiverAddress edits the reward receiver for the given lock ID
-	SetRewardReceiverAddress(ctx context.Context, in *MsgSetRewardReceiverAddress, opts ...grpc.CallOption) (*MsgSetRewardReceiverAddressResponse, error)
+func (m *MsgSplitLock) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSplitLock) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgSplitLockResponse struct {
+	SplitLockID uint64 `protobuf:"varint,1,opt,name=splitLockID,proto3" json:"splitLockID,omitempty"`
 }
 
-func (c *msgClient) LockTokens(ctx context.Context, in *MsgLockTokens, opts ...grpc.CallOption) (*MsgLockTokensResponse, error) {
-	out := new(MsgLockTokensResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/LockTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSplitLockResponse) Reset()         { *m = MsgSplitLockResponse{} }
+func (m *MsgSplitLockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitLockResponse) ProtoMessage()    {}
+func (*MsgSplitLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{13}
+}
+func (m *MsgSplitLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSplitLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitLockResponse.Merge(m, src)
+}
+func (m *MsgSplitLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitLockResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) BeginUnlockingAll(ctx context.Context, in *MsgBeginUnlockingAll, opts ...grpc.CallOption) (*MsgBeginUnlockingAllResponse, error) {
-	out := new(MsgBeginUnlockingAllResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/BeginUnlockingAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSplitLockResponse proto.InternalMessageInfo
+
+func (m *MsgSplitLockResponse) GetSplitLockID() uint64 {
+	if m != nil {
+		return m.SplitLockID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) BeginUnlocking(ctx context.Context, in *MsgBeginUnlocking, opts ...grpc.CallOption) (*MsgBeginUnlockingResponse, error) {
-	out := new(MsgBeginUnlockingResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/BeginUnlocking", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgMergeLocks merges the coins of the given locks into the first of them.
+// The locks must all have the same owner, denom and duration, and must not be
+// unlocking.
+type MsgMergeLocks struct {
+	Owner   string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LockIDs []uint64 `protobuf:"varint,2,rep,packed,name=lockIDs,proto3" json:"lockIDs,omitempty"`
 }
 
-func (c *msgClient) ExtendLockup(ctx context.Context, in *MsgExtendLockup, opts ...grpc.CallOption) (*MsgExtendLockupResponse, error) {
-	out := new(MsgExtendLockupResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/ExtendLockup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgMergeLocks) Reset()         { *m = MsgMergeLocks{} }
+func (m *MsgMergeLocks) String() string { return proto.CompactTextString(m) }
+func (*MsgMergeLocks) ProtoMessage()    {}
+func (*MsgMergeLocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{14}
+}
+func (m *MsgMergeLocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMergeLocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMergeLocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgMergeLocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMergeLocks.Merge(m, src)
+}
+func (m *MsgMergeLocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMergeLocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMergeLocks.DiscardUnknown(m)
 }
 
-func (c *msgClient) ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ...grpc.CallOption) (*MsgForceUnlockResponse, error) {
-	out := new(MsgForceUnlockResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/ForceUnlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgMergeLocks proto.InternalMessageInfo
+
+func (m *MsgMergeLocks) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetRewardReceiverAddress(ctx context.Context, in *MsgSetRewardReceiverAddress, opts ...grpc.CallOption) (*MsgSetRewardReceiverAddressResponse, error) {
-	out := new(MsgSetRewardReceiverAddressResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/SetRewardReceiverAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgMergeLocks) GetLockIDs() []uint64 {
+	if m != nil {
+		return m.LockIDs
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// LockTokens lock tokens
-	LockTokens(context.Context, *MsgLockTokens) (*MsgLockTokensResponse, error)
-	// BeginUnlockingAll begin unlocking all tokens
-	BeginUnlockingAll(context.Context, *MsgBeginUnlockingAll) (*MsgBeginUnlockingAllResponse, error)
-	// MsgBeginUnlocking begins unlocking tokens by lock ID
-	BeginUnlocking(context.Context, *MsgBeginUnlocking) (*MsgBeginUnlockingResponse, error)
-	// MsgEditLockup edits the existing lockups by lock ID
-	ExtendLockup(context.Context, *MsgExtendLockup) (*MsgExtendLockupResponse, error)
-	ForceUnlock(context.Context, *MsgForceUnlock) (*MsgForceUnlockResponse, error)
-	// SetRewardReceiverAddress edits the reward receiver for the given lock ID
-	SetRewardReceiverAddress(context.Context, *MsgSetRewardReceiverAddress) (*MsgSetRewardReceiverAddressResponse, error)
+type MsgMergeLocksResponse struct {
+	MergedLockID uint64 `protobuf:"varint,1,opt,name=mergedLockID,proto3" json:"mergedLockID,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgMergeLocksResponse) Reset()         { *m = MsgMergeLocksResponse{} }
+func (m *MsgMergeLocksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMergeLocksResponse) ProtoMessage()    {}
+func (*MsgMergeLocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{15}
 }
-
-func (*UnimplementedMsgServer) LockTokens(ctx context.Context, req *MsgLockTokens) (*MsgLockTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LockTokens not implemented")
+func (m *MsgMergeLocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) BeginUnlockingAll(ctx context.Context, req *MsgBeginUnlockingAll) (*MsgBeginUnlockingAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BeginUnlockingAll not implemented")
+func (m *MsgMergeLocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMergeLocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) BeginUnlocking(ctx context.Context, req *MsgBeginUnlocking) (*MsgBeginUnlockingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BeginUnlocking not implemented")
+func (m *MsgMergeLocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMergeLocksResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ExtendLockup(ctx context.Context, req *MsgExtendLockup) (*MsgExtendLockupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExtendLockup not implemented")
+func (m *MsgMergeLocksResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ForceUnlock(ctx context.Context, req *MsgForceUnlock) (*MsgForceUnlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ForceUnlock not implemented")
+func (m *MsgMergeLocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMergeLocksResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) SetRewardReceiverAddress(ctx context.Context, req *MsgSetRewardReceiverAddress) (*MsgSetRewardReceiverAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetRewardReceiverAddress not implemented")
+
+var xxx_messageInfo_MsgMergeLocksResponse proto.InternalMessageInfo
+
+func (m *MsgMergeLocksResponse) GetMergedLockID() uint64 {
+	if m != nil {
+		return m.MergedLockID
+	}
+	return 0
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+// MsgTransferLock transfers the ownership of a lock to the recipient. The
+// reward receiver of the lock is reset to the recipient.
+type MsgTransferLock struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ID        uint64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
 }
 
-func _Msg_LockTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLockTokens)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LockTokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.lockup.Msg/LockTokens",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LockTokens(ctx, req.(*MsgLockTokens))
+func (m *MsgTransferLock) Reset()         { *m = MsgTransferLock{} }
+func (m *MsgTransferLock) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLock) ProtoMessage()    {}
+func (*MsgTransferLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{16}
+}
+func (m *MsgTransferLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgTransferLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLock.Merge(m, src)
+}
+func (m *MsgTransferLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLock.DiscardUnknown(m)
 }
 
-func _Msg_BeginUnlockingAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBeginUnlockingAll)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BeginUnlockingAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.lockup.Msg/BeginUnlockingAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BeginUnlockingAll(ctx, req.(*MsgBeginUnlockingAll))
+var xxx_messageInfo_MsgTransferLock proto.InternalMessageInfo
+
+func (m *MsgTransferLock) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_BeginUnlocking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBeginUnlocking)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgTransferLock) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).BeginUnlocking(ctx, in)
+	return 0
+}
+
+func (m *MsgTransferLock) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.lockup.Msg/BeginUnlocking",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BeginUnlocking(ctx, req.(*MsgBeginUnlocking))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ExtendLockup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExtendLockup)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExtendLockup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.lockup.Msg/ExtendLockup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExtendLockup(ctx, req.(*MsgExtendLockup))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgTransferLockResponse struct {
 }
 
-func _Msg_ForceUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgForceUnlock)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ForceUnlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.lockup.Msg/ForceUnlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ForceUnlock(ctx, req.(*MsgForceUnlock))
+func (m *MsgTransferLockResponse) Reset()         { *m = MsgTransferLockResponse{} }
+func (m *MsgTransferLockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLockResponse) ProtoMessage()    {}
+func (*MsgTransferLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{17}
+}
+func (m *MsgTransferLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgTransferLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLockResponse.Merge(m, src)
+}
+func (m *MsgTransferLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLockResponse.DiscardUnknown(m)
 }
 
-func _Msg_SetRewardReceiverAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetRewardReceiverAddress)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetRewardReceiverAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.lockup.Msg/SetRewardReceiverAddress",
+var xxx_messageInfo_MsgTransferLockResponse proto.InternalMessageInfo
+
+// MsgCancelUnlocking moves the given coins of an unlocking lock back to the
+// locked state with the lock's original duration.
+type MsgCancelUnlocking struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ID    uint64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Amount of coins to re-lock. Re-lock all if not set.
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *MsgCancelUnlocking) Reset()         { *m = MsgCancelUnlocking{} }
+func (m *MsgCancelUnlocking) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnlocking) ProtoMessage()    {}
+func (*MsgCancelUnlocking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{18}
+}
+func (m *MsgCancelUnlocking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnlocking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnlocking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetRewardReceiverAddress(ctx, req.(*MsgSetRewardReceiverAddress))
+}
+func (m *MsgCancelUnlocking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnlocking.Merge(m, src)
+}
+func (m *MsgCancelUnlocking) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnlocking) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnlocking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUnlocking proto.InternalMessageInfo
+
+func (m *MsgCancelUnlocking) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.lockup.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "LockTokens",
-			Handler:    _Msg_LockTokens_Handler,
-		},
-		{
-			MethodName: "BeginUnlockingAll",
-			Handler:    _Msg_BeginUnlockingAll_Handler,
-		},
-		{
-			MethodName: "BeginUnlocking",
-			Handler:    _Msg_BeginUnlocking_Handler,
-		},
-		{
-			MethodName: "ExtendLockup",
-			Handler:    _Msg_ExtendLockup_Handler,
-		},
-		{
-			MethodName: "ForceUnlock",
-			Handler:    _Msg_ForceUnlock_Handler,
-		},
-		{
-			MethodName: "SetRewardReceiverAddress",
-			Handler:    _Msg_SetRewardReceiverAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/lockup/tx.proto",
+func (m *MsgCancelUnlocking) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
 }
 
-func (m *MsgLockTokens) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelUnlocking) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgLockTokens) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCancelUnlockingResponse struct {
+	LockedLockID uint64 `protobuf:"varint,1,opt,name=lockedLockID,proto3" json:"lockedLockID,omitempty"`
 }
 
-func (m *MsgLockTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgCancelUnlockingResponse) Reset()         { *m = MsgCancelUnlockingResponse{} }
+func (m *MsgCancelUnlockingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnlockingResponse) ProtoMessage()    {}
+func (*MsgCancelUnlockingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{19}
+}
+func (m *MsgCancelUnlockingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnlockingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnlockingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x12
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgCancelUnlockingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnlockingResponse.Merge(m, src)
+}
+func (m *MsgCancelUnlockingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnlockingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnlockingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUnlockingResponse proto.InternalMessageInfo
+
+func (m *MsgCancelUnlockingResponse) GetLockedLockID() uint64 {
+	if m != nil {
+		return m.LockedLockID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgLockTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgInstantUnlock immediately unlocks the given coins of a lock, regardless
+// of its remaining duration, in exchange for a penalty. Only allowed for
+// denoms with early unlock enabled by governance.
+type MsgInstantUnlock struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ID    uint64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Amount of coins to unlock. Unlock all if not set.
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *MsgInstantUnlock) Reset()         { *m = MsgInstantUnlock{} }
+func (m *MsgInstantUnlock) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantUnlock) ProtoMessage()    {}
+func (*MsgInstantUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{20}
+}
+func (m *MsgInstantUnlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantUnlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgInstantUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantUnlock.Merge(m, src)
+}
+func (m *MsgInstantUnlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantUnlock.DiscardUnknown(m)
 }
 
-func (m *MsgLockTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgInstantUnlock proto.InternalMessageInfo
+
+func (m *MsgInstantUnlock) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *MsgLockTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgInstantUnlock) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgBeginUnlockingAll) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgInstantUnlock) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgBeginUnlockingAll) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgInstantUnlockResponse struct {
+	Penalty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=penalty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"penalty"`
 }
 
-func (m *MsgBeginUnlockingAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgInstantUnlockResponse) Reset()         { *m = MsgInstantUnlockResponse{} }
+func (m *MsgInstantUnlockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantUnlockResponse) ProtoMessage()    {}
+func (*MsgInstantUnlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{21}
+}
+func (m *MsgInstantUnlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantUnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantUnlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgInstantUnlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantUnlockResponse.Merge(m, src)
+}
+func (m *MsgInstantUnlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantUnlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantUnlockResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBeginUnlockingAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgInstantUnlockResponse proto.InternalMessageInfo
+
+func (m *MsgInstantUnlockResponse) GetPenalty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Penalty
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgBeginUnlockingAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgTokenizeLock moves a lock into the pooled lock of its denom and duration
+// held by the lockup module, and mints the owner fungible lock receipt tokens
+// representing a claim on the locked coins.
+type MsgTokenizeLock struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ID    uint64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
 }
 
-func (m *MsgBeginUnlockingAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Unlocks) > 0 {
-		for iNdEx := len(m.Unlocks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Unlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MsgTokenizeLock) Reset()         { *m = MsgTokenizeLock{} }
+func (m *MsgTokenizeLock) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeLock) ProtoMessage()    {}
+func (*MsgTokenizeLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{22}
+}
+func (m *MsgTokenizeLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenizeLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenizeLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgBeginUnlocking) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgTokenizeLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeLock.Merge(m, src)
 }
-
-func (m *MsgBeginUnlocking) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTokenizeLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenizeLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeLock.DiscardUnknown(m)
 }
 
-func (m *MsgBeginUnlocking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.ID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgTokenizeLock proto.InternalMessageInfo
+
+func (m *MsgTokenizeLock) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgBeginUnlockingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTokenizeLock) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgBeginUnlockingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgTokenizeLockResponse struct {
+	Receipt types1.Coin `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt"`
 }
 
-func (m *MsgBeginUnlockingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnlockingLockID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UnlockingLockID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgTokenizeLockResponse) Reset()         { *m = MsgTokenizeLockResponse{} }
+func (m *MsgTokenizeLockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeLockResponse) ProtoMessage()    {}
+func (*MsgTokenizeLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{23}
+}
+func (m *MsgTokenizeLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenizeLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenizeLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgTokenizeLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeLockResponse.Merge(m, src)
+}
+func (m *MsgTokenizeLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenizeLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeLockResponse.DiscardUnknown(m)
 }
 
-func (m *MsgExtendLockup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTokenizeLockResponse proto.InternalMessageInfo
+
+func (m *MsgTokenizeLockResponse) GetReceipt() types1.Coin {
+	if m != nil {
+		return m.Receipt
 	}
-	return dAtA[:n], nil
+	return types1.Coin{}
 }
 
-func (m *MsgExtendLockup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRedeemLockReceipt burns lock receipt tokens and moves the claimed coins
+// out of the pooled lock into a lock of the owner, with the same duration.
+type MsgRedeemLockReceipt struct {
+	Owner   string      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Receipt types1.Coin `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt"`
 }
 
-func (m *MsgExtendLockup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintTx(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x1a
-	if m.ID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRedeemLockReceipt) Reset()         { *m = MsgRedeemLockReceipt{} }
+func (m *MsgRedeemLockReceipt) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemLockReceipt) ProtoMessage()    {}
+func (*MsgRedeemLockReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{24}
 }
-
-func (m *MsgExtendLockupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRedeemLockReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemLockReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemLockReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgExtendLockupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRedeemLockReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemLockReceipt.Merge(m, src)
+}
+func (m *MsgRedeemLockReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemLockReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemLockReceipt.DiscardUnknown(m)
 }
 
-func (m *MsgExtendLockupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgRedeemLockReceipt proto.InternalMessageInfo
+
+func (m *MsgRedeemLockReceipt) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgForceUnlock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRedeemLockReceipt) GetReceipt() types1.Coin {
+	if m != nil {
+		return m.Receipt
 	}
-	return dAtA[:n], nil
+	return types1.Coin{}
 }
 
-func (m *MsgForceUnlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRedeemLockReceiptResponse struct {
+	LockID uint64 `protobuf:"varint,1,opt,name=lockID,proto3" json:"lockID,omitempty"`
 }
 
-func (m *MsgForceUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgRedeemLockReceiptResponse) Reset()         { *m = MsgRedeemLockReceiptResponse{} }
+func (m *MsgRedeemLockReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemLockReceiptResponse) ProtoMessage()    {}
+func (*MsgRedeemLockReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{25}
+}
+func (m *MsgRedeemLockReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemLockReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemLockReceiptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRedeemLockReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemLockReceiptResponse.Merge(m, src)
+}
+func (m *MsgRedeemLockReceiptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemLockReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemLockReceiptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemLockReceiptResponse proto.InternalMessageInfo
+
+func (m *MsgRedeemLockReceiptResponse) GetLockID() uint64 {
+	if m != nil {
+		return m.LockID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgForceUnlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgLockTokens)(nil), "osmosis.lockup.MsgLockTokens")
+	proto.RegisterType((*MsgLockTokensResponse)(nil), "osmosis.lockup.MsgLockTokensResponse")
+	proto.RegisterType((*MsgBeginUnlockingAll)(nil), "osmosis.lockup.MsgBeginUnlockingAll")
+	proto.RegisterType((*MsgBeginUnlockingAllResponse)(nil), "osmosis.lockup.MsgBeginUnlockingAllResponse")
+	proto.RegisterType((*MsgBeginUnlocking)(nil), "osmosis.lockup.MsgBeginUnlocking")
+	proto.RegisterType((*MsgBeginUnlockingResponse)(nil), "osmosis.lockup.MsgBeginUnlockingResponse")
+	proto.RegisterType((*MsgExtendLockup)(nil), "osmosis.lockup.MsgExtendLockup")
+	proto.RegisterType((*MsgExtendLockupResponse)(nil), "osmosis.lockup.MsgExtendLockupResponse")
+	proto.RegisterType((*MsgForceUnlock)(nil), "osmosis.lockup.MsgForceUnlock")
+	proto.RegisterType((*MsgForceUnlockResponse)(nil), "osmosis.lockup.MsgForceUnlockResponse")
+	proto.RegisterType((*MsgSetRewardReceiverAddress)(nil), "osmosis.lockup.MsgSetRewardReceiverAddress")
+	proto.RegisterType((*MsgSetRewardReceiverAddressResponse)(nil), "osmosis.lockup.MsgSetRewardReceiverAddressResponse")
+	proto.RegisterType((*MsgSplitLock)(nil), "osmosis.lockup.MsgSplitLock")
+	proto.RegisterType((*MsgSplitLockResponse)(nil), "osmosis.lockup.MsgSplitLockResponse")
+	proto.RegisterType((*MsgMergeLocks)(nil), "osmosis.lockup.MsgMergeLocks")
+	proto.RegisterType((*MsgMergeLocksResponse)(nil), "osmosis.lockup.MsgMergeLocksResponse")
+	proto.RegisterType((*MsgTransferLock)(nil), "osmosis.lockup.MsgTransferLock")
+	proto.RegisterType((*MsgTransferLockResponse)(nil), "osmosis.lockup.MsgTransferLockResponse")
+	proto.RegisterType((*MsgCancelUnlocking)(nil), "osmosis.lockup.MsgCancelUnlocking")
+	proto.RegisterType((*MsgCancelUnlockingResponse)(nil), "osmosis.lockup.MsgCancelUnlockingResponse")
+	proto.RegisterType((*MsgInstantUnlock)(nil), "osmosis.lockup.MsgInstantUnlock")
+	proto.RegisterType((*MsgInstantUnlockResponse)(nil), "osmosis.lockup.MsgInstantUnlockResponse")
+	proto.RegisterType((*MsgTokenizeLock)(nil), "osmosis.lockup.MsgTokenizeLock")
+	proto.RegisterType((*MsgTokenizeLockResponse)(nil), "osmosis.lockup.MsgTokenizeLockResponse")
+	proto.RegisterType((*MsgRedeemLockReceipt)(nil), "osmosis.lockup.MsgRedeemLockReceipt")
+	proto.RegisterType((*MsgRedeemLockReceiptResponse)(nil), "osmosis.lockup.MsgRedeemLockReceiptResponse")
+}
+
+func init() { proto.RegisterFile("osmosis/lockup/tx.proto", fileDescriptor_bcdad5af0d24735f) }
+
+var fileDescriptor_bcdad5af0d24735f = []byte{
+	// 1218 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x3a, 0x6d, 0xd3, 0xbc, 0xa6, 0xf9, 0x61, 0xa5, 0x89, 0xb3, 0xa4, 0xb6, 0x3b, 0xa4,
+	0xb5, 0x29, 0xf1, 0x2e, 0x49, 0x50, 0x05, 0xe6, 0x00, 0x75, 0x02, 0x52, 0xa4, 0x58, 0xa0, 0x25,
+	0x95, 0x10, 0x48, 0x54, 0xeb, 0xf5, 0x74, 0xbb, 0xca, 0x7a, 0x67, 0xb5, 0xb3, 0x4e, 0x13, 0xc4,
+	0x85, 0x2b, 0x27, 0x8e, 0x1c, 0x11, 0x12, 0x17, 0xb8, 0x20, 0xf1, 0x4f, 0x94, 0x5b, 0x11, 0x42,
+	0xe2, 0x80, 0x5c, 0x94, 0x1c, 0x90, 0x38, 0xe6, 0x2f, 0x40, 0x33, 0xb3, 0xbb, 0xd9, 0x5d, 0x6f,
+	0x6c, 0x97, 0x02, 0x0a, 0x17, 0xdb, 0x3b, 0xef, 0x9b, 0xf7, 0xbe, 0xef, 0x9b, 0xd9, 0x79, 0x63,
+	0x58, 0x24, 0xb4, 0x43, 0xa8, 0x45, 0x55, 0x9b, 0x18, 0x7b, 0x5d, 0x57, 0xf5, 0x0f, 0x14, 0xd7,
+	0x23, 0x3e, 0xc9, 0x4f, 0x07, 0x01, 0x45, 0x04, 0xe4, 0x79, 0x93, 0x98, 0x84, 0x87, 0x54, 0xf6,
+	0x4b, 0xa0, 0xe4, 0x39, 0xbd, 0x63, 0x39, 0x44, 0xe5, 0x9f, 0xc1, 0x50, 0xd1, 0x24, 0xc4, 0xb4,
+	0xb1, 0xca, 0x9f, 0x5a, 0xdd, 0x07, 0x6a, 0xbb, 0xeb, 0xe9, 0xbe, 0x45, 0x9c, 0x30, 0x6e, 0xf0,
+	0xcc, 0x6a, 0x4b, 0xa7, 0x58, 0xdd, 0x5f, 0x6b, 0x61, 0x5f, 0x5f, 0x53, 0x0d, 0x62, 0x85, 0xf1,
+	0xa5, 0x14, 0x23, 0xf6, 0x25, 0x42, 0xe8, 0x9b, 0x1c, 0x5c, 0x6d, 0x52, 0x73, 0x87, 0x18, 0x7b,
+	0xbb, 0x64, 0x0f, 0x3b, 0x34, 0x7f, 0x0b, 0x2e, 0x92, 0x47, 0x0e, 0xf6, 0x0a, 0x52, 0x59, 0xaa,
+	0x4e, 0x36, 0x66, 0x4f, 0x7a, 0xa5, 0xa9, 0x43, 0xbd, 0x63, 0xd7, 0x11, 0x1f, 0x46, 0x9a, 0x08,
+	0xe7, 0x1f, 0xc2, 0xe5, 0x90, 0x46, 0x21, 0x57, 0x96, 0xaa, 0x57, 0xd6, 0x97, 0x14, 0xc1, 0x53,
+	0x09, 0x79, 0x2a, 0x5b, 0x01, 0xa0, 0xb1, 0xf6, 0xb8, 0x57, 0x1a, 0xfb, 0xb3, 0x57, 0xca, 0x87,
+	0x53, 0x56, 0x49, 0xc7, 0xf2, 0x71, 0xc7, 0xf5, 0x0f, 0x4f, 0x7a, 0xa5, 0x19, 0x91, 0x3f, 0x8c,
+	0xa1, 0x2f, 0x9f, 0x96, 0x24, 0x2d, 0xca, 0x9e, 0xd7, 0xe1, 0x22, 0x13, 0x43, 0x0b, 0xe3, 0xe5,
+	0x71, 0x5e, 0x46, 0xc8, 0x55, 0x98, 0x5c, 0x25, 0x90, 0xab, 0x6c, 0x12, 0xcb, 0x69, 0xbc, 0xc2,
+	0xca, 0x7c, 0xfb, 0xb4, 0x54, 0x35, 0x2d, 0xff, 0x61, 0xb7, 0xa5, 0x18, 0xa4, 0xa3, 0x06, 0xde,
+	0x88, 0xaf, 0x1a, 0x6d, 0xef, 0xa9, 0xfe, 0xa1, 0x8b, 0x29, 0x9f, 0x40, 0x35, 0x91, 0xb9, 0x5e,
+	0xfa, 0xfc, 0x8f, 0xef, 0x6f, 0xcb, 0x19, 0x36, 0xd5, 0x7c, 0xee, 0x0a, 0xaa, 0xc0, 0xb5, 0x84,
+	0x4d, 0x1a, 0xa6, 0x2e, 0x71, 0x28, 0xce, 0x4f, 0x43, 0x6e, 0x7b, 0x8b, 0x7b, 0x75, 0x41, 0xcb,
+	0x6d, 0x6f, 0x21, 0x13, 0xe6, 0x9b, 0xd4, 0x6c, 0x60, 0xd3, 0x72, 0xee, 0x39, 0x2c, 0x83, 0xe5,
+	0x98, 0x77, 0x6d, 0x7b, 0x54, 0x5b, 0xeb, 0x15, 0xc6, 0x04, 0xa5, 0x98, 0xb4, 0x58, 0xba, 0x5a,
+	0xd7, 0x89, 0x33, 0xda, 0x85, 0xe5, 0xac, 0x42, 0x11, 0xb1, 0x57, 0x61, 0x42, 0x4c, 0xa0, 0x05,
+	0x89, 0xfb, 0x26, 0x2b, 0xc9, 0xfd, 0xa7, 0xbc, 0x87, 0x3d, 0x8b, 0xb4, 0x99, 0x26, 0x2d, 0x84,
+	0xa2, 0xdf, 0x24, 0x98, 0xeb, 0x4b, 0x3b, 0xf2, 0x9e, 0x10, 0x66, 0xe4, 0x42, 0x33, 0xfe, 0x8b,
+	0x95, 0x5b, 0x65, 0x7e, 0x55, 0x06, 0xf9, 0xe5, 0x72, 0x99, 0x35, 0xf6, 0x1b, 0xdd, 0x87, 0xa5,
+	0x3e, 0x75, 0x91, 0x63, 0x05, 0x98, 0xa0, 0x5d, 0xc3, 0xc0, 0x94, 0x72, 0x9d, 0x97, 0xb5, 0xf0,
+	0x31, 0x5f, 0x85, 0x99, 0x6e, 0x08, 0x67, 0x7e, 0x45, 0x22, 0xd3, 0xc3, 0xe8, 0x07, 0x09, 0x66,
+	0x9a, 0xd4, 0x7c, 0xfb, 0xc0, 0xc7, 0x0e, 0xb7, 0xb6, 0xeb, 0xfe, 0x6d, 0xf7, 0xe2, 0x6f, 0xd8,
+	0xf8, 0xbf, 0xf9, 0x86, 0xa1, 0x0d, 0x58, 0x4c, 0x91, 0x1e, 0x6e, 0x0a, 0xfa, 0x4e, 0x82, 0xe9,
+	0x26, 0x35, 0xdf, 0x21, 0x9e, 0x81, 0x85, 0x99, 0xe7, 0x78, 0x9f, 0xa0, 0x75, 0x58, 0x48, 0x92,
+	0x1d, 0x41, 0xe1, 0xd7, 0x12, 0xbc, 0xd0, 0xa4, 0xe6, 0xfb, 0xd8, 0xd7, 0xf0, 0x23, 0xdd, 0x6b,
+	0x6b, 0xd8, 0xc0, 0xd6, 0x3e, 0xf6, 0xee, 0xb6, 0xdb, 0x1e, 0xdb, 0x16, 0xa3, 0xca, 0x5d, 0x80,
+	0x4b, 0x76, 0x7c, 0xd7, 0x04, 0x4f, 0xf9, 0x4d, 0x98, 0xf1, 0x78, 0xe2, 0xfb, 0x5e, 0x90, 0x99,
+	0xaf, 0xf3, 0x64, 0x43, 0x3e, 0xe9, 0x95, 0x16, 0x44, 0xa6, 0x14, 0x00, 0x69, 0xd3, 0x5e, 0x82,
+	0x0b, 0x7a, 0x13, 0x5e, 0x1c, 0xc0, 0x71, 0x04, 0x95, 0x3f, 0x4a, 0x30, 0xc5, 0x32, 0xb8, 0xb6,
+	0xe5, 0xef, 0x9c, 0xef, 0x55, 0xac, 0x17, 0xd9, 0xdb, 0x9e, 0x6e, 0x67, 0x94, 0x31, 0x17, 0xef,
+	0xf7, 0x6b, 0x30, 0x1f, 0x97, 0x12, 0xa9, 0x2f, 0xc3, 0x15, 0x1a, 0x0e, 0x46, 0xc7, 0x75, 0x7c,
+	0x08, 0x79, 0xbc, 0x0f, 0x36, 0xb1, 0x67, 0x62, 0x36, 0x32, 0xfa, 0xe2, 0x16, 0x60, 0x42, 0x2c,
+	0x27, 0x2d, 0xe4, 0xca, 0xe3, 0xd5, 0x0b, 0x5a, 0xf8, 0x98, 0xdd, 0x54, 0x3a, 0xac, 0x42, 0x4d,
+	0x1c, 0xb6, 0x6f, 0xc0, 0xb5, 0x44, 0xcd, 0x88, 0x2e, 0x82, 0x29, 0x8e, 0x6b, 0x27, 0xf8, 0x26,
+	0xc6, 0xd0, 0x57, 0xe2, 0xa4, 0xd9, 0xf5, 0x74, 0x87, 0x3e, 0xc0, 0xde, 0x73, 0xad, 0xdc, 0x3a,
+	0x4c, 0x7a, 0xd8, 0xb0, 0x5c, 0x0b, 0x3b, 0x7e, 0xb0, 0x05, 0xe7, 0x4f, 0x7a, 0xa5, 0xd9, 0x70,
+	0x0b, 0x06, 0x21, 0xa4, 0x9d, 0xc2, 0xea, 0x37, 0x98, 0xba, 0xe5, 0xf4, 0x5d, 0x27, 0x60, 0x23,
+	0x56, 0x63, 0x09, 0x16, 0x53, 0x0c, 0x43, 0x85, 0xe8, 0x17, 0x09, 0xf2, 0x4d, 0x6a, 0x6e, 0xea,
+	0x8e, 0x81, 0xed, 0xff, 0x45, 0xa3, 0x59, 0x61, 0x7a, 0x4b, 0x29, 0xbd, 0x06, 0xe7, 0x5f, 0x8b,
+	0xba, 0x00, 0x7a, 0x0b, 0xe4, 0x7e, 0x59, 0xf1, 0x75, 0x65, 0x43, 0xe9, 0x75, 0x8d, 0x8f, 0xa1,
+	0x9f, 0x24, 0x98, 0x6d, 0x52, 0x73, 0xdb, 0xa1, 0xbe, 0xee, 0xf8, 0xe7, 0xfe, 0x60, 0xad, 0x23,
+	0xe6, 0xcb, 0xf5, 0x94, 0x2f, 0x96, 0x60, 0x1f, 0x18, 0x83, 0x3e, 0x93, 0xa0, 0x90, 0xd6, 0x14,
+	0x99, 0x82, 0x61, 0xc2, 0xc5, 0x8e, 0x6e, 0xfb, 0x87, 0x05, 0xe9, 0x9f, 0x67, 0x19, 0xe6, 0x46,
+	0xb6, 0x78, 0x5d, 0xc8, 0x1e, 0x76, 0xac, 0x4f, 0xf0, 0xf3, 0xbc, 0x2e, 0x67, 0x6c, 0xfd, 0x20,
+	0xb3, 0xd8, 0xfa, 0xbb, 0x62, 0xeb, 0xc7, 0xaa, 0x45, 0x7a, 0x5f, 0x87, 0x09, 0x7e, 0x9a, 0xbb,
+	0x3e, 0xaf, 0x3b, 0x50, 0xef, 0x05, 0xa6, 0x57, 0x0b, 0xf1, 0xac, 0x21, 0xb1, 0xf3, 0x4d, 0xc3,
+	0x6d, 0x8c, 0x3b, 0x22, 0x29, 0x0f, 0x8c, 0xac, 0x24, 0x56, 0x3b, 0xf7, 0x6c, 0xb5, 0xb3, 0x2f,
+	0xa6, 0x1e, 0x67, 0xc2, 0x25, 0xd7, 0x42, 0x92, 0x77, 0x60, 0x39, 0x8b, 0x63, 0xa4, 0xff, 0xb4,
+	0x1b, 0x4a, 0xf1, 0x6e, 0xb8, 0xfe, 0xf3, 0x24, 0x8c, 0x37, 0xa9, 0x99, 0xd7, 0x00, 0x62, 0x7f,
+	0x47, 0xae, 0xa7, 0x6f, 0xad, 0x89, 0x6b, 0xb8, 0x7c, 0x73, 0x60, 0x38, 0xaa, 0x69, 0xc2, 0x5c,
+	0xff, 0x95, 0x7c, 0x25, 0x63, 0x6e, 0x1f, 0x4a, 0x5e, 0x1d, 0x05, 0x15, 0x15, 0xfa, 0x18, 0xa6,
+	0x93, 0xc1, 0xfc, 0x8d, 0xa1, 0xf3, 0xe5, 0x97, 0x86, 0x42, 0xa2, 0xfc, 0x1f, 0xc0, 0x54, 0xe2,
+	0x6e, 0x59, 0xca, 0x98, 0x1a, 0x07, 0xc8, 0x95, 0x21, 0x80, 0x28, 0xf3, 0x3d, 0xb8, 0x12, 0xbf,
+	0xca, 0x15, 0x33, 0xe6, 0xc5, 0xe2, 0xf2, 0xad, 0xc1, 0xf1, 0x28, 0xed, 0xa7, 0x50, 0x38, 0xf3,
+	0xfe, 0xf4, 0x72, 0x46, 0x8e, 0xb3, 0xc0, 0xf2, 0xc6, 0x33, 0x80, 0xa3, 0xea, 0xef, 0xc2, 0xe4,
+	0xe9, 0xbd, 0x66, 0x39, 0x2b, 0x43, 0x18, 0x95, 0x57, 0x06, 0x45, 0xa3, 0x84, 0x1a, 0x40, 0xec,
+	0x8e, 0x90, 0xb5, 0x39, 0x4f, 0xc3, 0xf2, 0xcd, 0x81, 0xe1, 0xf8, 0x9a, 0x26, 0xba, 0x78, 0xd6,
+	0x9a, 0xc6, 0x01, 0x72, 0x65, 0x08, 0x20, 0xca, 0xac, 0xc3, 0x4c, 0xba, 0xc3, 0xa2, 0x8c, 0xb9,
+	0x29, 0x8c, 0x7c, 0x7b, 0x38, 0x26, 0x2a, 0xf1, 0x11, 0x5c, 0x4d, 0xb6, 0xaa, 0x72, 0xc6, 0xe4,
+	0x04, 0x42, 0xae, 0x0e, 0x43, 0x24, 0x9c, 0x89, 0x1f, 0xd8, 0x99, 0xce, 0xc4, 0x00, 0x72, 0x65,
+	0x08, 0x20, 0x7e, 0x20, 0xf4, 0x9f, 0xa2, 0x59, 0x5b, 0xa0, 0x0f, 0x25, 0xaf, 0x8e, 0x82, 0x0a,
+	0x0b, 0x35, 0x76, 0x3e, 0x5c, 0x8f, 0xf5, 0xaa, 0x60, 0x66, 0xcd, 0xd6, 0x5b, 0x34, 0x7c, 0x50,
+	0xf7, 0xd7, 0xee, 0xa8, 0x07, 0x51, 0x1b, 0x61, 0xbd, 0xeb, 0xf1, 0x51, 0x51, 0x7a, 0x72, 0x54,
+	0x94, 0x7e, 0x3f, 0x2a, 0x4a, 0x5f, 0x1c, 0x17, 0xc7, 0x9e, 0x1c, 0x17, 0xc7, 0x7e, 0x3d, 0x2e,
+	0x8e, 0xb5, 0x2e, 0xf1, 0x3f, 0x7e, 0x1b, 0x7f, 0x0d, 0x00, 0xae, 0x74, 0xdc, 0xf4, 0x64, 0x12,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// LockTokens lock tokens
+	LockTokens(ctx context.Context, in *MsgLockTokens, opts ...grpc.CallOption) (*MsgLockTokensResponse, error)
+	// BeginUnlockingAll begin unlocking all tokens
+	BeginUnlockingAll(ctx context.Context, in *MsgBeginUnlockingAll, opts ...grpc.CallOption) (*MsgBeginUnlockingAllResponse, error)
+	// MsgBeginUnlocking begins unlocking tokens by lock ID
+	BeginUnlocking(ctx context.Context, in *MsgBeginUnlocking, opts ...grpc.CallOption) (*MsgBeginUnlockingResponse, error)
+	// MsgEditLockup edits the existing lockups by lock ID
+	ExtendLockup(ctx context.Context, in *MsgExtendLockup, opts ...grpc.CallOption) (*MsgExtendLockupResponse, error)
+	ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ...grpc.CallOption) (*MsgForceUnlockResponse, error)
+	// SetRewardReceiverAddress edits the reward receiver for the given lock ID
+	SetRewardReceiverAddress(ctx context.Context, in *MsgSetRewardReceiverAddress, opts ...grpc.CallOption) (*MsgSetRewardReceiverAddressResponse, error)
+	// SplitLock splits the given coins of a lock into a new lock
+	SplitLock(ctx context.Context, in *MsgSplitLock, opts ...grpc.CallOption) (*MsgSplitLockResponse, error)
+	// MergeLocks merges locks of the same denom and duration into the first lock
+	MergeLocks(ctx context.Context, in *MsgMergeLocks, opts ...grpc.CallOption) (*MsgMergeLocksResponse, error)
+	// TransferLock transfers the ownership of a lock to another address
+	TransferLock(ctx context.Context, in *MsgTransferLock, opts ...grpc.CallOption) (*MsgTransferLockResponse, error)
+	// CancelUnlocking moves an unlocking lock back to the locked state
+	CancelUnlocking(ctx context.Context, in *MsgCancelUnlocking, opts ...grpc.CallOption) (*MsgCancelUnlockingResponse, error)
+	// InstantUnlock immediately unlocks a lock in exchange for a penalty
+	InstantUnlock(ctx context.Context, in *MsgInstantUnlock, opts ...grpc.CallOption) (*MsgInstantUnlockResponse, error)
+	// TokenizeLock tokenizes a lock into fungible lock receipt tokens
+	TokenizeLock(ctx context.Context, in *MsgTokenizeLock, opts ...grpc.CallOption) (*MsgTokenizeLockResponse, error)
+	// RedeemLockReceipt redeems lock receipt tokens back into a lock
+	RedeemLockReceipt(ctx context.Context, in *MsgRedeemLockReceipt, opts ...grpc.CallOption) (*MsgRedeemLockReceiptResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) LockTokens(ctx context.Context, in *MsgLockTokens, opts ...grpc.CallOption) (*MsgLockTokensResponse, error) {
+	out := new(MsgLockTokensResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/LockTokens", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgForceUnlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BeginUnlockingAll(ctx context.Context, in *MsgBeginUnlockingAll, opts ...grpc.CallOption) (*MsgBeginUnlockingAllResponse, error) {
+	out := new(MsgBeginUnlockingAllResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/BeginUnlockingAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgForceUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) BeginUnlocking(ctx context.Context, in *MsgBeginUnlocking, opts ...grpc.CallOption) (*MsgBeginUnlockingResponse, error) {
+	out := new(MsgBeginUnlockingResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/BeginUnlocking", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSetRewardReceiverAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ExtendLockup(ctx context.Context, in *MsgExtendLockup, opts ...grpc.CallOption) (*MsgExtendLockupResponse, error) {
+	out := new(MsgExtendLockupResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/ExtendLockup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetRewardReceiverAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ...grpc.CallOption) (*MsgForceUnlockResponse, error) {
+	out := new(MsgForceUnlockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/ForceUnlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetRewardReceiverAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardReceiver) > 0 {
-		i -= len(m.RewardReceiver)
-		copy(dAtA[i:], m.RewardReceiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardReceiver)))
-		i--
-		dAtA[i] = 0x1a
+func (c *msgClient) SetRewardReceiverAddress(ctx context.Context, in *MsgSetRewardReceiverAddress, opts ...grpc.CallOption) (*MsgSetRewardReceiverAddressResponse, error) {
+	out := new(MsgSetRewardReceiverAddressResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/SetRewardReceiverAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.LockID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LockID))
-		i--
-		dAtA[i] = 0x10
+	return out, nil
+}
+
+func (c *msgClient) SplitLock(ctx context.Context, in *MsgSplitLock, opts ...grpc.CallOption) (*MsgSplitLockResponse, error) {
+	out := new(MsgSplitLockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/SplitLock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) MergeLocks(ctx context.Context, in *MsgMergeLocks, opts ...grpc.CallOption) (*MsgMergeLocksResponse, error) {
+	out := new(MsgMergeLocksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/MergeLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSetRewardReceiverAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferLock(ctx context.Context, in *MsgTransferLock, opts ...grpc.CallOption) (*MsgTransferLockResponse, error) {
+	out := new(MsgTransferLockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/TransferLock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetRewardReceiverAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelUnlocking(ctx context.Context, in *MsgCancelUnlocking, opts ...grpc.CallOption) (*MsgCancelUnlockingResponse, error) {
+	out := new(MsgCancelUnlockingResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/CancelUnlocking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetRewardReceiverAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) InstantUnlock(ctx context.Context, in *MsgInstantUnlock, opts ...grpc.CallOption) (*MsgInstantUnlockResponse, error) {
+	out := new(MsgInstantUnlockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/InstantUnlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) TokenizeLock(ctx context.Context, in *MsgTokenizeLock, opts ...grpc.CallOption) (*MsgTokenizeLockResponse, error) {
+	out := new(MsgTokenizeLockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/TokenizeLock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgLockTokens) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) RedeemLockReceipt(ctx context.Context, in *MsgRedeemLockReceipt, opts ...grpc.CallOption) (*MsgRedeemLockReceiptResponse, error) {
+	out := new(MsgRedeemLockReceiptResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/RedeemLockReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+	return out, nil
 }
 
-func (m *MsgLockTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovTx(uint64(m.ID))
-	}
-	return n
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// LockTokens lock tokens
+	LockTokens(context.Context, *MsgLockTokens) (*MsgLockTokensResponse, error)
+	// BeginUnlockingAll begin unlocking all tokens
+	BeginUnlockingAll(context.Context, *MsgBeginUnlockingAll) (*MsgBeginUnlockingAllResponse, error)
+	// MsgBeginUnlocking begins unlocking tokens by lock ID
+	BeginUnlocking(context.Context, *MsgBeginUnlocking) (*MsgBeginUnlockingResponse, error)
+	// MsgEditLockup edits the existing lockups by lock ID
+	ExtendLockup(context.Context, *MsgExtendLockup) (*MsgExtendLockupResponse, error)
+	ForceUnlock(context.Context, *MsgForceUnlock) (*MsgForceUnlockResponse, error)
+	// SetRewardReceiverAddress edits the reward receiver for the given lock ID
+	SetRewardReceiverAddress(context.Context, *MsgSetRewardReceiverAddress) (*MsgSetRewardReceiverAddressResponse, error)
+	// SplitLock splits the given coins of a lock into a new lock
+	SplitLock(context.Context, *MsgSplitLock) (*MsgSplitLockResponse, error)
+	// MergeLocks merges locks of the same denom and duration into the first lock
+	MergeLocks(context.Context, *MsgMergeLocks) (*MsgMergeLocksResponse, error)
+	// TransferLock transfers the ownership of a lock to another address
+	TransferLock(context.Context, *MsgTransferLock) (*MsgTransferLockResponse, error)
+	// CancelUnlocking moves an unlocking lock back to the locked state
+	CancelUnlocking(context.Context, *MsgCancelUnlocking) (*MsgCancelUnlockingResponse, error)
+	// InstantUnlock immediately unlocks a lock in exchange for a penalty
+	InstantUnlock(context.Context, *MsgInstantUnlock) (*MsgInstantUnlockResponse, error)
+	// TokenizeLock tokenizes a lock into fungible lock receipt tokens
+	TokenizeLock(context.Context, *MsgTokenizeLock) (*MsgTokenizeLockResponse, error)
+	// RedeemLockReceipt redeems lock receipt tokens back into a lock
+	RedeemLockReceipt(context.Context, *MsgRedeemLockReceipt) (*MsgRedeemLockReceiptResponse, error)
 }
 
-func (m *MsgBeginUnlockingAll) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgBeginUnlockingAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Unlocks) > 0 {
-		for _, e := range m.Unlocks {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (*UnimplementedMsgServer) LockTokens(ctx context.Context, req *MsgLockTokens) (*MsgLockTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockTokens not implemented")
+}
+func (*UnimplementedMsgServer) BeginUnlockingAll(ctx context.Context, req *MsgBeginUnlockingAll) (*MsgBeginUnlockingAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginUnlockingAll not implemented")
+}
+func (*UnimplementedMsgServer) BeginUnlocking(ctx context.Context, req *MsgBeginUnlocking) (*MsgBeginUnlockingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginUnlocking not implemented")
+}
+func (*UnimplementedMsgServer) ExtendLockup(ctx context.Context, req *MsgExtendLockup) (*MsgExtendLockupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendLockup not implemented")
+}
+func (*UnimplementedMsgServer) ForceUnlock(ctx context.Context, req *MsgForceUnlock) (*MsgForceUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceUnlock not implemented")
+}
+func (*UnimplementedMsgServer) SetRewardReceiverAddress(ctx context.Context, req *MsgSetRewardReceiverAddress) (*MsgSetRewardReceiverAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardReceiverAddress not implemented")
+}
+func (*UnimplementedMsgServer) SplitLock(ctx context.Context, req *MsgSplitLock) (*MsgSplitLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitLock not implemented")
+}
+func (*UnimplementedMsgServer) MergeLocks(ctx context.Context, req *MsgMergeLocks) (*MsgMergeLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeLocks not implemented")
+}
+func (*UnimplementedMsgServer) TransferLock(ctx context.Context, req *MsgTransferLock) (*MsgTransferLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLock not implemented")
+}
+func (*UnimplementedMsgServer) CancelUnlocking(ctx context.Context, req *MsgCancelUnlocking) (*MsgCancelUnlockingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUnlocking not implemented")
+}
+func (*UnimplementedMsgServer) InstantUnlock(ctx context.Context, req *MsgInstantUnlock) (*MsgInstantUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantUnlock not implemented")
+}
+func (*UnimplementedMsgServer) TokenizeLock(ctx context.Context, req *MsgTokenizeLock) (*MsgTokenizeLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeLock not implemented")
+}
+func (*UnimplementedMsgServer) RedeemLockReceipt(ctx context.Context, req *MsgRedeemLockReceipt) (*MsgRedeemLockReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemLockReceipt not implemented")
 }
 
-func (m *MsgBeginUnlocking) Size() (n int) {
-	if m == nil {
-		return 0
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_LockTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockTokens)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).LockTokens(ctx, in)
 	}
-	if m.ID != 0 {
-		n += 1 + sovTx(uint64(m.ID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/LockTokens",
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockTokens(ctx, req.(*MsgLockTokens))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBeginUnlockingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_BeginUnlockingAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginUnlockingAll)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).BeginUnlockingAll(ctx, in)
 	}
-	if m.UnlockingLockID != 0 {
-		n += 1 + sovTx(uint64(m.UnlockingLockID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/BeginUnlockingAll",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginUnlockingAll(ctx, req.(*MsgBeginUnlockingAll))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgExtendLockup) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_BeginUnlocking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginUnlocking)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).BeginUnlocking(ctx, in)
 	}
-	if m.ID != 0 {
-		n += 1 + sovTx(uint64(m.ID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/BeginUnlocking",
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginUnlocking(ctx, req.(*MsgBeginUnlocking))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgExtendLockupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ExtendLockup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendLockup)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendLockup(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/ExtendLockup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendLockup(ctx, req.(*MsgExtendLockup))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgForceUnlock) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ForceUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceUnlock)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ForceUnlock(ctx, in)
 	}
-	if m.ID != 0 {
-		n += 1 + sovTx(uint64(m.ID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/ForceUnlock",
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceUnlock(ctx, req.(*MsgForceUnlock))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgForceUnlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetRewardReceiverAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardReceiverAddress)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardReceiverAddress(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/SetRewardReceiverAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardReceiverAddress(ctx, req.(*MsgSetRewardReceiverAddress))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetRewardReceiverAddress) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SplitLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitLock)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SplitLock(ctx, in)
 	}
-	if m.LockID != 0 {
-		n += 1 + sovTx(uint64(m.LockID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/SplitLock",
 	}
-	l = len(m.RewardReceiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitLock(ctx, req.(*MsgSplitLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MergeLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMergeLocks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MergeLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/MergeLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MergeLocks(ctx, req.(*MsgMergeLocks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/TransferLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferLock(ctx, req.(*MsgTransferLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelUnlocking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUnlocking)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUnlocking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/CancelUnlocking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUnlocking(ctx, req.(*MsgCancelUnlocking))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InstantUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantUnlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/InstantUnlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantUnlock(ctx, req.(*MsgInstantUnlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TokenizeLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenizeLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TokenizeLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/TokenizeLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TokenizeLock(ctx, req.(*MsgTokenizeLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemLockReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemLockReceipt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemLockReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/RedeemLockReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemLockReceipt(ctx, req.(*MsgRedeemLockReceipt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.lockup.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LockTokens",
+			Handler:    _Msg_LockTokens_Handler,
+		},
+		{
+			MethodName: "BeginUnlockingAll",
+			Handler:    _Msg_BeginUnlockingAll_Handler,
+		},
+		{
+			MethodName: "BeginUnlocking",
+			Handler:    _Msg_BeginUnlocking_Handler,
+		},
+		{
+			MethodName: "ExtendLockup",
+			Handler:    _Msg_ExtendLockup_Handler,
+		},
+		{
+			MethodName: "ForceUnlock",
+			Handler:    _Msg_ForceUnlock_Handler,
+		},
+		{
+			MethodName: "SetRewardReceiverAddress",
+			Handler:    _Msg_SetRewardReceiverAddress_Handler,
+		},
+		{
+			MethodName: "SplitLock",
+			Handler:    _Msg_SplitLock_Handler,
+		},
+		{
+			MethodName: "MergeLocks",
+			Handler:    _Msg_MergeLocks_Handler,
+		},
+		{
+			MethodName: "TransferLock",
+			Handler:    _Msg_TransferLock_Handler,
+		},
+		{
+			MethodName: "CancelUnlocking",
+			Handler:    _Msg_CancelUnlocking_Handler,
+		},
+		{
+			MethodName: "InstantUnlock",
+			Handler:    _Msg_InstantUnlock_Handler,
+		},
+		{
+			MethodName: "TokenizeLock",
+			Handler:    _Msg_TokenizeLock_Handler,
+		},
+		{
+			MethodName: "RedeemLockReceipt",
+			Handler:    _Msg_RedeemLockReceipt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/lockup/tx.proto",
+}
+
+func (m *MsgLockTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginUnlockingAll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginUnlockingAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginUnlockingAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginUnlockingAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginUnlockingAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginUnlockingAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unlocks) > 0 {
+		for iNdEx := len(m.Unlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginUnlocking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginUnlocking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginUnlocking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginUnlockingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginUnlockingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginUnlockingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockingLockID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnlockingLockID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendLockup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendLockup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendLockup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendLockupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendLockupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendLockupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceUnlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceUnlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardReceiverAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardReceiverAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardReceiverAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardReceiver) > 0 {
+		i -= len(m.RewardReceiver)
+		copy(dAtA[i:], m.RewardReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardReceiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LockID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardReceiverAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardReceiverAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardReceiverAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SplitLockID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SplitLockID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergeLocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergeLocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergeLocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockIDs) > 0 {
+		dAtA4 := make([]byte, len(m.LockIDs)*10)
+		var j3 int
+		for _, num := range m.LockIDs {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergeLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergeLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergeLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MergedLockID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MergedLockID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnlocking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnlocking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnlocking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnlockingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnlockingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnlockingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockedLockID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockedLockID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantUnlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantUnlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Penalty) > 0 {
+		for iNdEx := len(m.Penalty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Penalty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenizeLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenizeLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenizeLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenizeLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemLockReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemLockReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemLockReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemLockReceiptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemLockReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemLockReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLockTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgLockTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *MsgBeginUnlockingAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBeginUnlockingAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unlocks) > 0 {
+		for _, e := range m.Unlocks {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBeginUnlocking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBeginUnlockingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.UnlockingLockID != 0 {
+		n += 1 + sovTx(uint64(m.UnlockingLockID))
+	}
+	return n
+}
+
+func (m *MsgExtendLockup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExtendLockupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgForceUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgForceUnlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetRewardReceiverAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockID != 0 {
+		n += 1 + sovTx(uint64(m.LockID))
+	}
+	l = len(m.RewardReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRewardReceiverAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSplitLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSplitLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SplitLockID != 0 {
+		n += 1 + sovTx(uint64(m.SplitLockID))
+	}
+	return n
+}
+
+func (m *MsgMergeLocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LockIDs) > 0 {
+		l = 0
+		for _, e := range m.LockIDs {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgMergeLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MergedLockID != 0 {
+		n += 1 + sovTx(uint64(m.MergedLockID))
+	}
+	return n
+}
+
+func (m *MsgTransferLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelUnlocking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelUnlockingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockedLockID != 0 {
+		n += 1 + sovTx(uint64(m.LockedLockID))
+	}
+	return n
+}
+
+func (m *MsgInstantUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgInstantUnlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Penalty) > 0 {
+		for _, e := range m.Penalty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTokenizeLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *MsgTokenizeLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Receipt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemLockReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Receipt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemLockReceiptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockID != 0 {
+		n += 1 + sovTx(uint64(m.LockID))
 	}
 	return n
 }
 
-func (m *MsgSetRewardReceiverAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocks = append(m.Unlocks, &PeriodLock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlocking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlocking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingLockID", wireType)
+			}
+			m.UnlockingLockID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockingLockID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendLockup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendLockup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendLockupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceUnlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceUnlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockID", wireType)
+			}
+			m.LockID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1701,10 +4681,10 @@ func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1740,8 +4720,27 @@ func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1768,15 +4767,135 @@ func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitLockID", wireType)
+			}
+			m.SplitLockID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplitLockID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMergeLocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMergeLocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMergeLocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1786,26 +4905,100 @@ func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIDs = append(m.LockIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIDs) == 0 {
+					m.LockIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIDs = append(m.LockIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIDs", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1827,7 +5020,7 @@ func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMergeLocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1850,17 +5043,17 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMergeLocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMergeLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergedLockID", wireType)
 			}
-			m.ID = 0
+			m.MergedLockID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1870,7 +5063,7 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.MergedLockID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1896,7 +5089,7 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1919,10 +5112,10 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAll: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAll: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1957,6 +5150,57 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1978,7 +5222,7 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferLockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2001,46 +5245,12 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferLockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Unlocks = append(m.Unlocks, &PeriodLock{})
-			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2062,7 +5272,7 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnlocking) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2085,10 +5295,10 @@ func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlocking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnlocking: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlocking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnlocking: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2197,7 +5407,7 @@ func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnlockingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2220,37 +5430,17 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnlockingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnlockingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingLockID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedLockID", wireType)
 			}
-			m.UnlockingLockID = 0
+			m.LockedLockID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2260,7 +5450,7 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnlockingLockID |= uint64(b&0x7F) << shift
+				m.LockedLockID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2286,7 +5476,7 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
+func (m *MsgInstantUnlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2309,10 +5499,10 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendLockup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInstantUnlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendLockup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInstantUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2368,7 +5558,7 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2395,7 +5585,8 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2420,7 +5611,7 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgInstantUnlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2443,17 +5634,17 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendLockupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInstantUnlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInstantUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2463,12 +5654,26 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Penalty = append(m.Penalty, types1.Coin{})
+			if err := m.Penalty[len(m.Penalty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2490,7 +5695,7 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenizeLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2513,10 +5718,10 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceUnlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenizeLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenizeLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2570,40 +5775,6 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2625,7 +5796,7 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenizeLockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2648,17 +5819,17 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceUnlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenizeLockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenizeLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2668,12 +5839,25 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2695,7 +5879,7 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemLockReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2718,10 +5902,10 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemLockReceipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemLockReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2757,29 +5941,10 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockID", wireType)
-			}
-			m.LockID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LockID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2789,23 +5954,24 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardReceiver = string(dAtA[iNdEx:postIndex])
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2828,7 +5994,7 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemLockReceiptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2851,17 +6017,17 @@ func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemLockReceiptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemLockReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockID", wireType)
 			}
-			var v int
+			m.LockID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2871,12 +6037,11 @@ func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.LockID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -39,6 +39,10 @@ func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 func (h Hooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 }
 
+// AfterCFMMPoolAssetsChanged hook is a noop.
+func (h Hooks) AfterCFMMPoolAssetsChanged(ctx sdk.Context, poolId uint64) {
+}
+
 // Distribute coins after minter module allocate assets to pool-incentives module.
 func (h Hooks) AfterDistributeMintedCoin(ctx sdk.Context) {
 	// @Sunny, @Tony, @Dev, what comments should we keep after modifying own BeginBlocker to hooks?
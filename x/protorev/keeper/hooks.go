@@ -62,6 +62,12 @@ func (h Hooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 	h.k.StoreSwap(ctx, poolId, input[0].Denom, output[0].Denom)
 }
 
+// AfterCFMMPoolAssetsChanged hook checks and potentially stores the pool via the highest liquidity method,
+// as for a newly created pool with the pool's new assets.
+func (h Hooks) AfterCFMMPoolAssetsChanged(ctx sdk.Context, poolId uint64) {
+	h.k.AfterPoolCreatedWithCoins(ctx, poolId)
+}
+
 // ----------------------------------------------------------------------------
 // CONCENTRATED LIQUIDITY HOOKS
 // ----------------------------------------------------------------------------
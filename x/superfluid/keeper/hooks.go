@@ -3,6 +3,7 @@ package keeper
 import (
 	"time"
 
+	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v16/x/superfluid/keeper/internal/events"
 	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 
@@ -63,6 +64,12 @@ func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, oldDuration, newDuration time.Duration) {
 }
 
+func (h Hooks) BeforeLockUpdated(ctx sdk.Context, lock lockuptypes.PeriodLock) {
+}
+
+func (h Hooks) BeforeLockDeleted(ctx sdk.Context, lockID uint64) {
+}
+
 // staking hooks.
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)   {}
 func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {}
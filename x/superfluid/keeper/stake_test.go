@@ -678,6 +678,53 @@ func (s *KeeperTestSuite) TestSuperfluidUnbondLock() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCancelUnlockingOfSuperfluidUnbondingLock() {
+	s.SetupTest()
+
+	// setup validators
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+	// setup superfluid delegations
+	_, intermediaryAccs, locks := s.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	s.checkIntermediaryAccountDelegations(intermediaryAccs)
+	lock := locks[0]
+	valAddr := intermediaryAccs[0].ValAddr
+
+	err := s.App.SuperfluidKeeper.SuperfluidUndelegate(s.Ctx, lock.Owner, lock.ID)
+	s.Require().NoError(err)
+	err = s.App.SuperfluidKeeper.SuperfluidUnbondLock(s.Ctx, lock.ID, lock.GetOwner())
+	s.Require().NoError(err)
+
+	// cancelling unlocking re-locks the underlying lock, while the unbonding synth lock is left unchanged
+	_, err = s.App.LockupKeeper.CancelUnlocking(s.Ctx, lock.ID, lock.OwnerAddress(), nil)
+	s.Require().NoError(err)
+	updatedLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lock.ID)
+	s.Require().NoError(err)
+	s.Require().False(updatedLock.IsUnlocking())
+	synthLock, err := s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, lock.ID, keeper.UnstakingSyntheticDenom(lock.Coins[0].Denom, valAddr))
+	s.Require().NoError(err)
+	s.Require().True(synthLock.IsUnlocking())
+
+	// once the synth lock finishes unbonding, the lock stays locked and can be superfluid delegated again
+	unbondingDuration := s.App.StakingKeeper.GetParams(s.Ctx).UnbondingTime
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(unbondingDuration))
+	s.App.LockupKeeper.DeleteAllMaturedSyntheticLocks(s.Ctx)
+	s.App.LockupKeeper.WithdrawAllMaturedLocks(s.Ctx)
+	updatedLock, err = s.App.LockupKeeper.GetLockByID(s.Ctx, lock.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(lock.Coins, updatedLock.Coins)
+	s.Require().False(s.App.LockupKeeper.HasAnySyntheticLockups(s.Ctx, lock.ID))
+
+	err = s.App.SuperfluidKeeper.SuperfluidDelegate(s.Ctx, lock.Owner, lock.ID, valAddr)
+	s.Require().NoError(err)
+
+	// check invariant is fine
+	reason, broken := keeper.AllInvariants(*s.App.SuperfluidKeeper)(s.Ctx)
+	s.Require().False(broken, reason)
+}
+
 func (s *KeeperTestSuite) TestSuperfluidUndelegateAndUnbondLock() {
 	var lockAmount int64 = 1000000
 	testCases := []struct {
@@ -840,6 +840,9 @@ type MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse struct {
 	Amount1          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount1" yaml:"amount1"`
 	LiquidityCreated github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidity_created,json=liquidityCreated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_created" yaml:"liquidity_created"`
 	JoinTime         time.Time                              `protobuf:"bytes,4,opt,name=join_time,json=joinTime,proto3,stdtime" json:"join_time" yaml:"join_time"`
+	// leftover_coins are the coins exited from the pool leaving that the
+	// concentrated liquidity position did not use, which stay with the sender.
+	LeftoverCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=leftover_coins,json=leftoverCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"leftover_coins" yaml:"leftover_coins"`
 }
 
 func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Reset() {
@@ -886,6 +889,13 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) GetJo
 	return time.Time{}
 }
 
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) GetLeftoverCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LeftoverCoins
+	}
+	return nil
+}
+
 // ===================== MsgAddToConcentratedLiquiditySuperfluidPosition
 type MsgAddToConcentratedLiquiditySuperfluidPosition struct {
 	PositionId    uint64     `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
@@ -1045,88 +1055,89 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 1288 bytes of a gzipped FileDescriptorProto
+	// 1311 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x73, 0xd3, 0x46,
-	0x14, 0x8f, 0x6c, 0x93, 0xc0, 0x86, 0x00, 0x51, 0xf9, 0x30, 0x2e, 0x58, 0x66, 0x61, 0x98, 0x50,
-	0xb0, 0x14, 0x43, 0x0b, 0x4c, 0x4e, 0x89, 0xe3, 0xa1, 0x63, 0x1a, 0x4f, 0x33, 0x22, 0x99, 0xce,
-	0x70, 0xf1, 0xc8, 0xde, 0x45, 0x51, 0x23, 0x6b, 0x8d, 0x57, 0xca, 0xc7, 0xf4, 0xd4, 0x5e, 0xe8,
-	0x70, 0xe2, 0xd8, 0x5b, 0xef, 0x3d, 0x74, 0xfa, 0x27, 0xf4, 0xd0, 0x03, 0xd3, 0x13, 0xb7, 0x76,
-	0xda, 0x19, 0xd3, 0x49, 0x0e, 0xbd, 0xe7, 0xd8, 0x53, 0x67, 0xa5, 0xd5, 0xda, 0x4e, 0x24, 0x1c,
-	0x19, 0xf7, 0xd0, 0x0b, 0x78, 0x77, 0xdf, 0x7b, 0xbf, 0xdf, 0x7b, 0xfb, 0xde, 0xdb, 0xa7, 0x80,
-	0x0f, 0x09, 0x6d, 0x11, 0x6a, 0x51, 0x8d, 0x7a, 0x6d, 0xdc, 0x79, 0x66, 0x7b, 0x16, 0xd2, 0xdc,
-	0x1d, 0xb5, 0xdd, 0x21, 0x2e, 0x91, 0x65, 0x7e, 0xa8, 0xf6, 0x0e, 0x73, 0xe7, 0x4d, 0x62, 0x12,
-	0xff, 0x58, 0x63, 0xbf, 0x02, 0xc9, 0xdc, 0xac, 0xd1, 0xb2, 0x1c, 0xa2, 0xf9, 0xff, 0xf2, 0xad,
-	0xbc, 0x49, 0x88, 0x69, 0x63, 0xcd, 0x5f, 0x35, 0xbc, 0x67, 0x1a, 0xf2, 0x3a, 0x86, 0x6b, 0x11,
-	0x27, 0x3c, 0x6f, 0xfa, 0xd6, 0xb5, 0x86, 0x41, 0xb1, 0xb6, 0x55, 0x6a, 0x60, 0xd7, 0x28, 0x69,
-	0x4d, 0x62, 0x85, 0xe7, 0xca, 0x61, 0x7d, 0xd7, 0x6a, 0x61, 0xea, 0x1a, 0xad, 0x36, 0x17, 0xb8,
-	0x1e, 0x41, 0xbd, 0xf7, 0x33, 0x10, 0x82, 0xdf, 0x49, 0xe0, 0x42, 0x8d, 0x9a, 0x4f, 0xc4, 0x7e,
-	0x05, 0xdb, 0xd8, 0x34, 0x5c, 0x2c, 0xdf, 0x02, 0x93, 0x14, 0x3b, 0x08, 0x77, 0xb2, 0x52, 0x41,
-	0x9a, 0x3b, 0x55, 0x9e, 0x3d, 0xe8, 0x2a, 0x33, 0xbb, 0x46, 0xcb, 0x5e, 0x80, 0xc1, 0x3e, 0xd4,
-	0xb9, 0x80, 0x7c, 0x09, 0x4c, 0xd9, 0xa4, 0xb9, 0x59, 0xb7, 0x50, 0x36, 0x55, 0x90, 0xe6, 0x32,
-	0xfa, 0x24, 0x5b, 0x56, 0x91, 0x7c, 0x19, 0x9c, 0xdc, 0x32, 0xec, 0xba, 0x81, 0x50, 0x27, 0x9b,
-	0x66, 0x56, 0xf4, 0xa9, 0x2d, 0xc3, 0x5e, 0x42, 0xa8, 0xb3, 0x50, 0x78, 0xf9, 0xf7, 0x4f, 0x1f,
-	0x45, 0x44, 0xb7, 0x88, 0x38, 0x01, 0xa8, 0x80, 0xab, 0x91, 0xcc, 0x74, 0x4c, 0xdb, 0xc4, 0xa1,
-	0x18, 0x7e, 0x2d, 0x81, 0x4b, 0x03, 0x12, 0xeb, 0x0e, 0x1a, 0x23, 0xfb, 0x05, 0xc8, 0x28, 0x5e,
-	0x8d, 0xa0, 0xe8, 0x09, 0x1c, 0x78, 0x0d, 0x28, 0x31, 0x14, 0x04, 0xcd, 0x6f, 0x8e, 0xd2, 0x6c,
-	0x10, 0x07, 0xad, 0x90, 0xe6, 0xe6, 0x58, 0x68, 0x5e, 0x67, 0x34, 0xf3, 0x91, 0x34, 0x19, 0x4e,
-	0x91, 0x89, 0x45, 0xf0, 0x0c, 0x39, 0x08, 0x9e, 0x3f, 0x4a, 0xe0, 0x46, 0x8c, 0x2f, 0x4b, 0xce,
-	0x98, 0x49, 0xcb, 0x65, 0x90, 0x61, 0xb9, 0xec, 0x67, 0xc5, 0xf4, 0xdd, 0xcb, 0x6a, 0x90, 0xec,
-	0x2a, 0x4b, 0x76, 0x95, 0x27, 0xbb, 0xba, 0x4c, 0x2c, 0xa7, 0xfc, 0xc1, 0xeb, 0xae, 0x32, 0x71,
-	0xd0, 0x55, 0xa6, 0x03, 0x00, 0xa6, 0x04, 0x75, 0x5f, 0x17, 0x7e, 0x0a, 0xee, 0x1c, 0x87, 0x6f,
-	0xe8, 0x60, 0x3f, 0x19, 0xa9, 0x9f, 0x0c, 0x3c, 0x90, 0xc0, 0x95, 0x1a, 0x35, 0x99, 0xf0, 0x92,
-	0x83, 0xde, 0xaf, 0x16, 0x0c, 0x70, 0x82, 0x91, 0xa3, 0xd9, 0x54, 0x21, 0xfd, 0x6e, 0xcf, 0xe6,
-	0x99, 0x67, 0x3f, 0xbc, 0x55, 0xe6, 0x4c, 0xcb, 0xdd, 0xf0, 0x1a, 0x6a, 0x93, 0xb4, 0x34, 0x5e,
-	0xf3, 0xc1, 0x7f, 0x45, 0x8a, 0x36, 0x35, 0x77, 0xb7, 0x8d, 0xa9, 0xaf, 0x40, 0xf5, 0xc0, 0xf2,
-	0xbb, 0xaa, 0xea, 0x16, 0xcb, 0x85, 0x1b, 0x61, 0x2e, 0x30, 0xf7, 0x8a, 0x86, 0x83, 0x8a, 0x51,
-	0xe5, 0x75, 0xdf, 0xbf, 0xed, 0x58, 0x9f, 0x45, 0xd4, 0xce, 0x80, 0x54, 0xb5, 0xc2, 0x03, 0x96,
-	0xaa, 0x56, 0xe0, 0x8b, 0x14, 0xd0, 0x6a, 0xd4, 0x5c, 0xee, 0x60, 0xc3, 0xc5, 0x8f, 0x3c, 0xdb,
-	0xd6, 0x0d, 0xc7, 0xc4, 0xab, 0x84, 0x5a, 0xac, 0x79, 0xfd, 0xbf, 0xe3, 0x27, 0xdf, 0x06, 0x53,
-	0x6d, 0x42, 0x6c, 0x96, 0x22, 0x19, 0xe6, 0x71, 0x59, 0x3e, 0xe8, 0x2a, 0x67, 0x02, 0xa6, 0xfc,
-	0x00, 0xea, 0x93, 0xec, 0x57, 0x15, 0xc1, 0xe7, 0xe0, 0x41, 0xc2, 0x40, 0x88, 0xa0, 0x5e, 0x04,
-	0x41, 0xee, 0x55, 0x06, 0x32, 0xb1, 0x22, 0xe7, 0x01, 0x68, 0x73, 0x03, 0xd5, 0x0a, 0x2f, 0x99,
-	0xbe, 0x1d, 0xd6, 0xae, 0xb3, 0x35, 0x6a, 0xae, 0x3b, 0xab, 0x84, 0xd8, 0x5f, 0x6c, 0x58, 0x2e,
-	0xb6, 0x2d, 0xea, 0x62, 0xc4, 0x96, 0x49, 0xa2, 0xdc, 0xe7, 0x67, 0x6a, 0x98, 0x9f, 0x0b, 0x37,
-	0x58, 0x52, 0x29, 0x61, 0x52, 0x79, 0x0e, 0xdb, 0x2e, 0x6e, 0xf7, 0xc0, 0x8b, 0x6c, 0x03, 0x3e,
-	0x06, 0x85, 0x38, 0x66, 0xc2, 0xed, 0x9b, 0xe0, 0x2c, 0xde, 0xb1, 0x5c, 0x8c, 0xea, 0xbc, 0x10,
-	0x69, 0x56, 0x2a, 0xa4, 0xe7, 0x32, 0xfa, 0x4c, 0xb0, 0xbd, 0xe2, 0xd7, 0x23, 0x85, 0xdf, 0xa6,
-	0xc1, 0x43, 0xdf, 0x98, 0x1d, 0xa4, 0x67, 0xcd, 0x32, 0x3b, 0x86, 0x8b, 0x9f, 0x6c, 0x18, 0x1d,
-	0x4c, 0xd7, 0x88, 0x08, 0xf6, 0x32, 0x71, 0x9a, 0xd8, 0x71, 0xd9, 0x19, 0x0a, 0x03, 0x9f, 0x30,
-	0x0c, 0x03, 0xed, 0xa9, 0x3f, 0x0c, 0xfc, 0x00, 0x8a, 0x96, 0x65, 0x82, 0x59, 0xea, 0x13, 0xa8,
-	0xbb, 0xa4, 0xde, 0x0a, 0x18, 0x0d, 0xef, 0x5f, 0x05, 0xde, 0xbf, 0xb2, 0x9c, 0xc1, 0x61, 0x0b,
-	0x50, 0x3f, 0x4b, 0xb9, 0x5b, 0xdc, 0x4b, 0xf9, 0xa5, 0x04, 0xce, 0xb8, 0x64, 0x13, 0x3b, 0x75,
-	0xe2, 0xb9, 0xf5, 0x16, 0x2b, 0x86, 0xcc, 0xb0, 0x62, 0xa8, 0x72, 0x98, 0x0b, 0x01, 0xcc, 0xa0,
-	0x3a, 0x4c, 0x54, 0x25, 0xa7, 0x7d, 0xe5, 0xcf, 0x3d, 0xb7, 0xc6, 0x54, 0x7f, 0x4d, 0x83, 0xc5,
-	0x51, 0xaf, 0x42, 0xdc, 0xfb, 0x53, 0x30, 0x65, 0xb4, 0x88, 0xe7, 0xb8, 0xf3, 0xfc, 0x4e, 0x16,
-	0x19, 0xdd, 0x3f, 0xba, 0xca, 0xcd, 0x63, 0xb0, 0xaa, 0x3a, 0x6e, 0xef, 0x56, 0xb8, 0x19, 0xa8,
-	0x87, 0x06, 0x7b, 0xb6, 0x4b, 0xfe, 0x1d, 0xbe, 0xb7, 0xed, 0x92, 0xb0, 0x5d, 0x92, 0xb7, 0xc1,
-	0xac, 0x6d, 0x3d, 0xf7, 0x2c, 0x64, 0xb9, 0xbb, 0xf5, 0xa6, 0x5f, 0xe8, 0x28, 0x68, 0x19, 0xe5,
-	0xc7, 0x09, 0x50, 0x2a, 0xb8, 0xd9, 0xcb, 0x80, 0x23, 0x06, 0xa1, 0x7e, 0x4e, 0xec, 0x05, 0xcd,
-	0x04, 0xc9, 0xeb, 0xe0, 0xd4, 0x97, 0xc4, 0x72, 0xea, 0x6c, 0xa6, 0xf3, 0x3b, 0xd1, 0xf4, 0xdd,
-	0x9c, 0x1a, 0x0c, 0x7c, 0x6a, 0x38, 0xf0, 0xa9, 0x6b, 0xe1, 0xc0, 0x57, 0xbe, 0xc2, 0x6f, 0xff,
-	0x5c, 0x00, 0x21, 0x54, 0xe1, 0xab, 0xb7, 0x8a, 0xa4, 0x9f, 0x64, 0x6b, 0x26, 0x0c, 0x7f, 0x0b,
-	0x7a, 0xf7, 0x12, 0x42, 0x6b, 0xa4, 0xff, 0xc2, 0x56, 0x42, 0xfc, 0x5e, 0xcb, 0x12, 0xe5, 0xf4,
-	0x00, 0x4c, 0x87, 0x0d, 0x48, 0xbc, 0x9c, 0xe5, 0x8b, 0x07, 0x5d, 0x45, 0x0e, 0xdb, 0x85, 0x38,
-	0x84, 0x7d, 0xbd, 0x0a, 0xf5, 0xd5, 0x61, 0x6a, 0x58, 0x1d, 0xd6, 0xc3, 0x84, 0x47, 0x98, 0x5a,
-	0x1d, 0x8c, 0xe6, 0x87, 0xd7, 0xd5, 0xd5, 0xa8, 0x84, 0x0f, 0xd5, 0xa1, 0x3e, 0xe3, 0x6f, 0x54,
-	0xf8, 0xfa, 0x08, 0x40, 0x89, 0x07, 0x75, 0x44, 0x80, 0xd2, 0x21, 0x80, 0x12, 0xfc, 0x25, 0xed,
-	0x3f, 0x06, 0x49, 0x22, 0x2b, 0xaa, 0x63, 0xe4, 0x08, 0xf7, 0x95, 0x55, 0xea, 0x3f, 0x2c, 0xab,
-	0xf4, 0xb8, 0xcb, 0x6a, 0x13, 0xcc, 0x38, 0x78, 0xbb, 0x2e, 0xb2, 0x3e, 0x7b, 0xc2, 0x47, 0x78,
-	0x94, 0xb8, 0xa4, 0xce, 0x07, 0x08, 0x03, 0xc6, 0xa0, 0x7e, 0xda, 0xc1, 0xdb, 0x22, 0xee, 0xfd,
-	0x3d, 0x3e, 0x33, 0xac, 0xc7, 0xdf, 0xfd, 0x07, 0x80, 0x74, 0x8d, 0x9a, 0x72, 0x07, 0xc8, 0x51,
-	0x63, 0x8c, 0x7a, 0xf4, 0x83, 0x4f, 0x8d, 0xfc, 0x46, 0xc9, 0x95, 0x8e, 0x2d, 0x2a, 0xd2, 0x60,
-	0x07, 0x9c, 0x8f, 0xfc, 0x94, 0xb9, 0x3d, 0xd4, 0x54, 0x4f, 0x38, 0x77, 0x2f, 0x81, 0x70, 0x1c,
-	0xb2, 0x18, 0xf4, 0x8f, 0x83, 0x1c, 0x0a, 0x1f, 0x0b, 0xf9, 0xc8, 0x48, 0xfe, 0xbd, 0x04, 0xae,
-	0x0d, 0xff, 0xe0, 0x78, 0x98, 0xc0, 0xa9, 0x01, 0xcd, 0xdc, 0xe2, 0xa8, 0x9a, 0x82, 0xe1, 0x0b,
-	0x09, 0x5c, 0x8e, 0xff, 0x30, 0x98, 0x8f, 0xb1, 0x1f, 0xab, 0x91, 0x7b, 0x98, 0x54, 0x43, 0x30,
-	0xf9, 0x59, 0x02, 0x77, 0x12, 0x4d, 0xdd, 0xcb, 0x31, 0x50, 0x49, 0x8c, 0xe4, 0x3e, 0x1b, 0x83,
-	0x11, 0xe1, 0xc2, 0x57, 0xe0, 0x42, 0xf4, 0xe8, 0x7a, 0x27, 0x06, 0x25, 0x52, 0x3a, 0xf7, 0x71,
-	0x12, 0x69, 0x01, 0xfe, 0xa7, 0x04, 0x3e, 0x19, 0x6d, 0xa2, 0x5c, 0x89, 0xc5, 0x1b, 0xc1, 0x5a,
-	0x6e, 0x6d, 0x9c, 0xd6, 0x06, 0xb2, 0x23, 0xd1, 0xbb, 0x1e, 0x97, 0x1d, 0x49, 0x8c, 0xc4, 0x66,
-	0xc7, 0x28, 0xef, 0x60, 0x79, 0xf5, 0xf5, 0x5e, 0x5e, 0x7a, 0xb3, 0x97, 0x97, 0xfe, 0xda, 0xcb,
-	0x4b, 0xaf, 0xf6, 0xf3, 0x13, 0x6f, 0xf6, 0xf3, 0x13, 0xbf, 0xef, 0xe7, 0x27, 0x9e, 0xde, 0xef,
-	0x7b, 0x11, 0x38, 0x60, 0xd1, 0x36, 0x1a, 0x34, 0x5c, 0x68, 0x5b, 0xa5, 0xfb, 0xda, 0xce, 0xc0,
-	0xdf, 0xe8, 0xd8, 0x2b, 0xd1, 0x98, 0xf4, 0x67, 0xa5, 0x7b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0x7f, 0x02, 0xa2, 0xf3, 0xc6, 0x13, 0x00, 0x00,
+	0x14, 0x8f, 0x6c, 0x93, 0xc0, 0x86, 0x04, 0xa2, 0xf2, 0x61, 0x54, 0xb0, 0xcc, 0x92, 0x61, 0x42,
+	0xc1, 0x52, 0x0c, 0x2d, 0x30, 0x39, 0x11, 0xc7, 0x43, 0xc7, 0x10, 0x4f, 0x19, 0x11, 0xa6, 0x33,
+	0x5c, 0x34, 0xb2, 0x77, 0xa3, 0xa8, 0x91, 0xb5, 0x46, 0x2b, 0xe5, 0x63, 0x7a, 0x6a, 0x2f, 0x74,
+	0xe8, 0x85, 0x63, 0x6f, 0xbd, 0xf7, 0xd0, 0xe9, 0x9f, 0xd0, 0x43, 0x0f, 0x1c, 0xb9, 0x95, 0x69,
+	0x67, 0x4c, 0x27, 0x39, 0xf4, 0x9e, 0x63, 0x4f, 0x9d, 0xd5, 0xc7, 0xda, 0x4e, 0xe4, 0x38, 0x0a,
+	0xee, 0xa1, 0x97, 0x44, 0xbb, 0xfb, 0xde, 0xef, 0xfd, 0xde, 0xdb, 0xf7, 0xde, 0xee, 0x1a, 0x7c,
+	0x4c, 0x68, 0x8b, 0x50, 0x8b, 0xaa, 0xd4, 0x6f, 0x63, 0x77, 0xd5, 0xf6, 0x2d, 0xa4, 0x7a, 0x5b,
+	0x4a, 0xdb, 0x25, 0x1e, 0x11, 0xc5, 0x68, 0x51, 0xe9, 0x2e, 0x4a, 0xe7, 0x4c, 0x62, 0x92, 0x60,
+	0x59, 0x65, 0x5f, 0xa1, 0xa4, 0x34, 0x63, 0xb4, 0x2c, 0x87, 0xa8, 0xc1, 0xdf, 0x68, 0xaa, 0x60,
+	0x12, 0x62, 0xda, 0x58, 0x0d, 0x46, 0x0d, 0x7f, 0x55, 0x45, 0xbe, 0x6b, 0x78, 0x16, 0x71, 0xe2,
+	0xf5, 0x66, 0x80, 0xae, 0x36, 0x0c, 0x8a, 0xd5, 0x8d, 0x72, 0x03, 0x7b, 0x46, 0x59, 0x6d, 0x12,
+	0x2b, 0x5e, 0x97, 0xf7, 0xeb, 0x7b, 0x56, 0x0b, 0x53, 0xcf, 0x68, 0xb5, 0x23, 0x81, 0x6b, 0x09,
+	0xd4, 0xbb, 0x9f, 0xa1, 0x10, 0xfc, 0x41, 0x00, 0xe7, 0xeb, 0xd4, 0x7c, 0xca, 0xe7, 0xab, 0xd8,
+	0xc6, 0xa6, 0xe1, 0x61, 0xf1, 0x06, 0x18, 0xa7, 0xd8, 0x41, 0xd8, 0xcd, 0x0b, 0x45, 0x61, 0xee,
+	0x54, 0x65, 0x66, 0xaf, 0x23, 0x4f, 0x6d, 0x1b, 0x2d, 0x7b, 0x01, 0x86, 0xf3, 0x50, 0x8b, 0x04,
+	0xc4, 0x8b, 0x60, 0xc2, 0x26, 0xcd, 0x75, 0xdd, 0x42, 0xf9, 0x4c, 0x51, 0x98, 0xcb, 0x69, 0xe3,
+	0x6c, 0x58, 0x43, 0xe2, 0x25, 0x70, 0x72, 0xc3, 0xb0, 0x75, 0x03, 0x21, 0x37, 0x9f, 0x65, 0x28,
+	0xda, 0xc4, 0x86, 0x61, 0x2f, 0x22, 0xe4, 0x2e, 0x14, 0x5f, 0xfd, 0xfd, 0xcb, 0x27, 0x09, 0xd1,
+	0x2d, 0xa1, 0x88, 0x00, 0x94, 0xc1, 0x95, 0x44, 0x66, 0x1a, 0xa6, 0x6d, 0xe2, 0x50, 0x0c, 0xbf,
+	0x11, 0xc0, 0xc5, 0x3e, 0x89, 0x67, 0x0e, 0x1a, 0x21, 0xfb, 0x05, 0xc8, 0x28, 0x5e, 0x49, 0xa0,
+	0xe8, 0x73, 0x3b, 0xf0, 0x2a, 0x90, 0x07, 0x50, 0xe0, 0x34, 0xbf, 0x3d, 0x48, 0xb3, 0x41, 0x1c,
+	0xb4, 0x4c, 0x9a, 0xeb, 0x23, 0xa1, 0x79, 0x8d, 0xd1, 0x2c, 0x24, 0xd2, 0x64, 0x76, 0x4a, 0x4c,
+	0x2c, 0x81, 0x67, 0xcc, 0x81, 0xf3, 0xfc, 0x59, 0x00, 0xb3, 0x03, 0x7c, 0x59, 0x74, 0x46, 0x4c,
+	0x5a, 0xac, 0x80, 0x1c, 0xcb, 0xe5, 0x20, 0x2b, 0x26, 0x6f, 0x5f, 0x52, 0xc2, 0x64, 0x57, 0x58,
+	0xb2, 0x2b, 0x51, 0xb2, 0x2b, 0x4b, 0xc4, 0x72, 0x2a, 0x1f, 0xbd, 0xe9, 0xc8, 0x63, 0x7b, 0x1d,
+	0x79, 0x32, 0x34, 0xc0, 0x94, 0xa0, 0x16, 0xe8, 0xc2, 0xcf, 0xc1, 0xad, 0xa3, 0xf0, 0x8d, 0x1d,
+	0xec, 0x25, 0x23, 0xf4, 0x92, 0x81, 0x7b, 0x02, 0xb8, 0x5c, 0xa7, 0x26, 0x13, 0x5e, 0x74, 0xd0,
+	0x87, 0xd5, 0x82, 0x01, 0x4e, 0x30, 0x72, 0x34, 0x9f, 0x29, 0x66, 0x0f, 0xf7, 0x6c, 0x9e, 0x79,
+	0xf6, 0xd3, 0x7b, 0x79, 0xce, 0xb4, 0xbc, 0x35, 0xbf, 0xa1, 0x34, 0x49, 0x4b, 0x8d, 0x6a, 0x3e,
+	0xfc, 0x57, 0xa2, 0x68, 0x5d, 0xf5, 0xb6, 0xdb, 0x98, 0x06, 0x0a, 0x54, 0x0b, 0x91, 0x0f, 0xab,
+	0xaa, 0x1b, 0x2c, 0x17, 0x66, 0xe3, 0x5c, 0x60, 0xee, 0x95, 0x0c, 0x07, 0x95, 0x92, 0xca, 0xeb,
+	0x2e, 0x98, 0x3d, 0xcc, 0x67, 0x1e, 0xb5, 0x69, 0x90, 0xa9, 0x55, 0xa3, 0x80, 0x65, 0x6a, 0x55,
+	0xf8, 0x32, 0x03, 0xd4, 0x3a, 0x35, 0x97, 0x5c, 0x6c, 0x78, 0xf8, 0xa1, 0x6f, 0xdb, 0x9a, 0xe1,
+	0x98, 0xf8, 0x09, 0xa1, 0x16, 0x6b, 0x5e, 0xff, 0xef, 0xf8, 0x89, 0x37, 0xc1, 0x44, 0x9b, 0x10,
+	0x9b, 0xa5, 0x48, 0x8e, 0x79, 0x5c, 0x11, 0xf7, 0x3a, 0xf2, 0x74, 0xc8, 0x34, 0x5a, 0x80, 0xda,
+	0x38, 0xfb, 0xaa, 0x21, 0xf8, 0x02, 0xdc, 0x4b, 0x19, 0x08, 0x1e, 0xd4, 0x0b, 0x20, 0xcc, 0xbd,
+	0x6a, 0x5f, 0x26, 0x56, 0xc5, 0x02, 0x00, 0xed, 0x08, 0xa0, 0x56, 0x8d, 0x4a, 0xa6, 0x67, 0x86,
+	0xb5, 0xeb, 0x7c, 0x9d, 0x9a, 0xcf, 0x9c, 0x27, 0x84, 0xd8, 0x5f, 0xae, 0x59, 0x1e, 0xb6, 0x2d,
+	0xea, 0x61, 0xc4, 0x86, 0x69, 0xa2, 0xdc, 0xe3, 0x67, 0x66, 0x98, 0x9f, 0x0b, 0xb3, 0x2c, 0xa9,
+	0xe4, 0x38, 0xa9, 0x7c, 0x87, 0x4d, 0x97, 0x36, 0xbb, 0xc6, 0x4b, 0x6c, 0x02, 0x3e, 0x02, 0xc5,
+	0x41, 0xcc, 0xb8, 0xdb, 0xd7, 0xc1, 0x19, 0xbc, 0x65, 0x79, 0x18, 0xe9, 0x51, 0x21, 0xd2, 0xbc,
+	0x50, 0xcc, 0xce, 0xe5, 0xb4, 0xa9, 0x70, 0x7a, 0x39, 0xa8, 0x47, 0x0a, 0xbf, 0xcb, 0x82, 0xfb,
+	0x01, 0x98, 0x1d, 0xa6, 0x67, 0xdd, 0x32, 0x5d, 0xc3, 0xc3, 0x4f, 0xd7, 0x0c, 0x17, 0xd3, 0x15,
+	0xc2, 0x83, 0xbd, 0x44, 0x9c, 0x26, 0x76, 0x3c, 0xb6, 0x86, 0xe2, 0xc0, 0xa7, 0x0c, 0x43, 0x5f,
+	0x7b, 0xea, 0x0d, 0x43, 0xb4, 0x00, 0x79, 0xcb, 0x32, 0xc1, 0x0c, 0x0d, 0x08, 0xe8, 0x1e, 0xd1,
+	0x5b, 0x21, 0xa3, 0xe1, 0xfd, 0xab, 0x18, 0xf5, 0xaf, 0x7c, 0xc4, 0x60, 0x3f, 0x02, 0xd4, 0xce,
+	0xd0, 0xc8, 0xad, 0xc8, 0x4b, 0xf1, 0x95, 0x00, 0xa6, 0x3d, 0xb2, 0x8e, 0x1d, 0x9d, 0xf8, 0x9e,
+	0xde, 0x62, 0xc5, 0x90, 0x1b, 0x56, 0x0c, 0xb5, 0xc8, 0xcc, 0xf9, 0xd0, 0x4c, 0xbf, 0x3a, 0x4c,
+	0x55, 0x25, 0xa7, 0x03, 0xe5, 0x2f, 0x7c, 0xaf, 0xce, 0x54, 0xdf, 0xe5, 0xc0, 0x83, 0xe3, 0x6e,
+	0x05, 0xdf, 0xf7, 0xe7, 0x60, 0xc2, 0x68, 0x11, 0xdf, 0xf1, 0xe6, 0xa3, 0x3d, 0x79, 0xc0, 0xe8,
+	0xfe, 0xd1, 0x91, 0xaf, 0x1f, 0x81, 0x55, 0xcd, 0xf1, 0xba, 0xbb, 0x12, 0xc1, 0x40, 0x2d, 0x06,
+	0xec, 0x62, 0x97, 0xf3, 0x99, 0x51, 0x60, 0x97, 0x39, 0x76, 0x59, 0xdc, 0x04, 0x33, 0xb6, 0xf5,
+	0xc2, 0xb7, 0x90, 0xe5, 0x6d, 0xeb, 0xcd, 0xa0, 0xd0, 0x51, 0xd8, 0x32, 0x2a, 0x8f, 0x52, 0x58,
+	0xa9, 0xe2, 0x66, 0x37, 0x03, 0x0e, 0x00, 0x42, 0xed, 0x2c, 0x9f, 0x0b, 0x9b, 0x09, 0x12, 0x9f,
+	0x81, 0x53, 0x5f, 0x11, 0xcb, 0xd1, 0xd9, 0x9d, 0x2e, 0xe8, 0x44, 0x93, 0xb7, 0x25, 0x25, 0xbc,
+	0xf0, 0x29, 0xf1, 0x85, 0x4f, 0x59, 0x89, 0x2f, 0x7c, 0x95, 0xcb, 0xd1, 0xee, 0x9f, 0x0d, 0x4d,
+	0x70, 0x55, 0xf8, 0xfa, 0xbd, 0x2c, 0x68, 0x27, 0xd9, 0x98, 0x09, 0x8b, 0xdf, 0x0b, 0x60, 0xda,
+	0xc6, 0xab, 0x1e, 0xd9, 0xc0, 0xae, 0x1e, 0xb6, 0xd9, 0x13, 0x29, 0x33, 0xab, 0x5f, 0x3d, 0x5d,
+	0x66, 0x4d, 0xc5, 0xca, 0xc1, 0x10, 0xfe, 0x1e, 0x9e, 0x24, 0x8b, 0x08, 0xad, 0x90, 0xde, 0xf4,
+	0x59, 0x8e, 0xa3, 0xd1, 0x6d, 0xa0, 0xbc, 0xb8, 0xef, 0x81, 0xc9, 0xb8, 0x1d, 0xf2, 0x73, 0xbc,
+	0x72, 0x61, 0xaf, 0x23, 0x8b, 0x71, 0xf3, 0xe2, 0x8b, 0xb0, 0xa7, 0x73, 0xa2, 0x9e, 0xae, 0x90,
+	0x19, 0xd6, 0x15, 0xf4, 0xb8, 0xfc, 0x10, 0xa6, 0x96, 0x8b, 0xd1, 0xfc, 0xf0, 0x2a, 0xbf, 0x92,
+	0x54, 0x7e, 0xb1, 0x3a, 0xd4, 0xa6, 0x82, 0x89, 0x6a, 0x34, 0x3e, 0x60, 0xa0, 0x9c, 0xcf, 0x7d,
+	0x88, 0x81, 0xf2, 0x3e, 0x03, 0x65, 0xf8, 0x5b, 0x16, 0xdc, 0x4b, 0x19, 0x59, 0x5e, 0xab, 0xc7,
+	0x8e, 0x70, 0x4f, 0x91, 0x67, 0xfe, 0xc3, 0x22, 0xcf, 0x8e, 0xba, 0xc8, 0xd7, 0xc1, 0x94, 0x83,
+	0x37, 0x75, 0x5e, 0x83, 0xf9, 0x13, 0x81, 0x85, 0x87, 0xa9, 0x0b, 0xfc, 0x5c, 0x68, 0xa1, 0x0f,
+	0x0c, 0x6a, 0xa7, 0x1d, 0xbc, 0xc9, 0xe3, 0xde, 0x7b, 0xe2, 0xe4, 0x86, 0x9d, 0x38, 0xb7, 0xff,
+	0x01, 0x20, 0x5b, 0xa7, 0xa6, 0xe8, 0x02, 0x31, 0xe9, 0x52, 0xa5, 0x1c, 0x7c, 0x7e, 0x2a, 0x89,
+	0x2f, 0x26, 0xa9, 0x7c, 0x64, 0x51, 0x9e, 0x06, 0x5b, 0xe0, 0x5c, 0xe2, 0xc3, 0xea, 0xe6, 0x50,
+	0xa8, 0xae, 0xb0, 0x74, 0x27, 0x85, 0xf0, 0x20, 0xcb, 0xfc, 0xd9, 0x71, 0x14, 0xcb, 0xb1, 0xb0,
+	0x74, 0x27, 0x85, 0x30, 0xb7, 0xfc, 0xa3, 0x00, 0xae, 0x0e, 0x7f, 0xfe, 0xdc, 0x4f, 0xe1, 0x54,
+	0x9f, 0xa6, 0xf4, 0xe0, 0xb8, 0x9a, 0x9c, 0xe1, 0x4b, 0x01, 0x5c, 0x1a, 0xfc, 0x4c, 0x99, 0x1f,
+	0x80, 0x3f, 0x50, 0x43, 0xba, 0x9f, 0x56, 0x83, 0x33, 0xf9, 0x55, 0x00, 0xb7, 0x52, 0xbd, 0x01,
+	0x96, 0x06, 0x98, 0x4a, 0x03, 0x22, 0x3d, 0x1e, 0x01, 0x08, 0x77, 0xe1, 0x6b, 0x70, 0x3e, 0xf9,
+	0x22, 0x7d, 0x6b, 0x80, 0x95, 0x44, 0x69, 0xe9, 0xd3, 0x34, 0xd2, 0xdc, 0xf8, 0x9f, 0x02, 0xf8,
+	0xec, 0x78, 0xf7, 0xdb, 0xe5, 0x81, 0xf6, 0x8e, 0x81, 0x26, 0xad, 0x8c, 0x12, 0xad, 0x2f, 0x3b,
+	0x52, 0x9d, 0xeb, 0x83, 0xb2, 0x23, 0x0d, 0x88, 0xf4, 0x78, 0x04, 0x20, 0xb1, 0x0b, 0x95, 0x27,
+	0xcf, 0xef, 0xf6, 0x74, 0xfe, 0x08, 0xb8, 0x64, 0x1b, 0x0d, 0x1a, 0x0f, 0xd4, 0x8d, 0xf2, 0x5d,
+	0x75, 0xab, 0xef, 0x97, 0x41, 0x76, 0x1a, 0xbc, 0xd9, 0x29, 0x08, 0x6f, 0x77, 0x0a, 0xc2, 0x5f,
+	0x3b, 0x05, 0xe1, 0xf5, 0x6e, 0x61, 0xec, 0xed, 0x6e, 0x61, 0xec, 0xdd, 0x6e, 0x61, 0xac, 0x31,
+	0x1e, 0xdc, 0xdc, 0xee, 0xfc, 0x3b, 0x00, 0x15, 0x52, 0x25, 0x78, 0x54, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2086,6 +2097,20 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Marsh
 	_ = i
 	var l int
 	_ = l
+	if len(m.LeftoverCoins) > 0 {
+		for iNdEx := len(m.LeftoverCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LeftoverCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JoinTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime):])
 	if err5 != nil {
 		return 0, err5
@@ -2511,6 +2536,12 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Size(
 	n += 1 + l + sovTx(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime)
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.LeftoverCoins) > 0 {
+		for _, e := range m.LeftoverCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4280,6 +4311,40 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Unmar
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftoverCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeftoverCoins = append(m.LeftoverCoins, types.Coin{})
+			if err := m.LeftoverCoins[len(m.LeftoverCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -115,3 +115,7 @@ func (k *Keeper) SetAmmInterface(poolManagerInterface types.PoolManagerInterface
 func (k *Keeper) AfterCreatePool(ctx sdk.Context, poolId uint64) error {
 	return k.afterCreatePool(ctx, poolId)
 }
+
+func (k *Keeper) AfterPoolAssetsChange(ctx sdk.Context, poolId uint64) error {
+	return k.afterPoolAssetsChange(ctx, poolId)
+}
@@ -60,6 +60,11 @@ func (hook *gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, pool
 	hook.k.trackChangedPool(ctx, poolId)
 }
 
+// AfterCFMMPoolAssetsChanged is called after an asset is added to or removed from a CFMM pool in x/gamm.
+func (hook *gammhook) AfterCFMMPoolAssetsChanged(ctx sdk.Context, poolId uint64) {
+	hook.k.mustTrackPoolAssetsChange(ctx, poolId)
+}
+
 type concentratedLiquidityListener struct {
 	k Keeper
 }
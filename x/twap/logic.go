@@ -99,6 +99,58 @@ func (k Keeper) afterCreatePool(ctx sdk.Context, poolId uint64) error {
 	return err
 }
 
+// mustTrackPoolAssetsChange is a wrapper around afterPoolAssetsChange that panics on error.
+func (k Keeper) mustTrackPoolAssetsChange(ctx sdk.Context, poolId uint64) {
+	err := k.afterPoolAssetsChange(ctx, poolId)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// afterPoolAssetsChange brings the most recent twap records of a pool in line with its current denoms,
+// after an asset was added to or removed from it.
+// It creates new twap records for the denom pairs with an added denom, as afterCreatePool does,
+// and stops tracking the denom pairs with a removed denom. The historical records of the removed
+// denom pairs are kept, so that twaps over periods before the removal can still be queried until pruned.
+func (k Keeper) afterPoolAssetsChange(ctx sdk.Context, poolId uint64) error {
+	denoms, err := k.poolmanagerKeeper.RouteGetPoolDenoms(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	records, err := k.GetAllMostRecentRecordsForPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	trackedPairs := make(map[types.DenomPair]bool, len(records))
+	for _, record := range records {
+		trackedPairs[types.DenomPair{Denom0: record.Asset0Denom, Denom1: record.Asset1Denom}] = true
+	}
+
+	currentPairs := make(map[types.DenomPair]bool)
+	for _, denomPair := range types.GetAllUniqueDenomPairs(denoms) {
+		currentPairs[denomPair] = true
+		if trackedPairs[denomPair] {
+			continue
+		}
+		record, err := newTwapRecord(k.poolmanagerKeeper, ctx, poolId, denomPair.Denom0, denomPair.Denom1)
+		if err != nil {
+			return err
+		}
+		k.StoreNewRecord(ctx, record)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, record := range records {
+		if !currentPairs[types.DenomPair{Denom0: record.Asset0Denom, Denom1: record.Asset1Denom}] {
+			store.Delete(types.FormatMostRecentTWAPKey(poolId, record.Asset0Denom, record.Asset1Denom))
+		}
+	}
+
+	k.trackChangedPool(ctx, poolId)
+	return nil
+}
+
 func (k Keeper) EndBlock(ctx sdk.Context) {
 	// get changed pools grabs all altered pool ids from the transient store.
 	// 'altered pool ids' gets automatically cleared on commit by being a transient store
@@ -1358,6 +1358,83 @@ func (s *TestSuite) TestAfterCreatePool() {
 	}
 }
 
+func (s *TestSuite) TestAfterPoolAssetsChange() {
+	tests := map[string]struct {
+		poolCoins         sdk.Coins
+		newDenoms         []string
+		addedDenomPairs   []types.DenomPair
+		removedDenomPairs []types.DenomPair
+	}{
+		"asset added": {
+			poolCoins:       defaultTwoAssetCoins,
+			newDenoms:       []string{denom0, denom1, denom2},
+			addedDenomPairs: []types.DenomPair{{Denom0: denom0, Denom1: denom2}, {Denom0: denom1, Denom1: denom2}},
+		},
+		"asset removed": {
+			poolCoins:         defaultThreeAssetCoins,
+			newDenoms:         []string{denom0, denom1},
+			removedDenomPairs: []types.DenomPair{{Denom0: denom0, Denom1: denom2}, {Denom0: denom1, Denom1: denom2}},
+		},
+		"assets unchanged": {
+			poolCoins: defaultTwoAssetCoins,
+			newDenoms: []string{denom0, denom1},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId := s.PrepareBalancerPoolWithCoins(tc.poolCoins...)
+			recordsBefore, err := s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+
+			ammMock := twapmock.NewProgrammedAmmInterface(s.App.PoolManagerKeeper)
+			ammMock.ProgramPoolDenomsOverride(poolId, tc.newDenoms, nil)
+			for _, denomPair := range tc.addedDenomPairs {
+				ammMock.ProgramPoolSpotPriceOverride(poolId, denomPair.Denom0, denomPair.Denom1, sdk.OneDec(), nil)
+				ammMock.ProgramPoolSpotPriceOverride(poolId, denomPair.Denom1, denomPair.Denom0, sdk.OneDec(), nil)
+			}
+			s.twapkeeper.SetAmmInterface(ammMock)
+
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+			err = s.twapkeeper.AfterPoolAssetsChange(s.Ctx, poolId)
+			s.Require().NoError(err)
+
+			denomPairs := types.GetAllUniqueDenomPairs(tc.newDenoms)
+			records, err := s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Len(records, len(denomPairs))
+
+			// the records of the pairs already tracked are unchanged, and the added pairs get new records
+			for _, denomPair := range denomPairs {
+				record, err := s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, poolId, denomPair.Denom0, denomPair.Denom1)
+				s.Require().NoError(err)
+				if osmoutils.Contains(tc.addedDenomPairs, denomPair) {
+					expectedRecord, err := twap.NewTwapRecord(ammMock, s.Ctx, poolId, denomPair.Denom0, denomPair.Denom1)
+					s.Require().NoError(err)
+					s.Require().Equal(expectedRecord, record)
+					continue
+				}
+				s.Require().Contains(recordsBefore, record)
+			}
+
+			// the removed pairs are no longer tracked, but their history can still be queried
+			for _, denomPair := range tc.removedDenomPairs {
+				_, err := s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, poolId, denomPair.Denom0, denomPair.Denom1)
+				s.Require().Error(err)
+				_, err = s.twapkeeper.GetRecordAtOrBeforeTime(s.Ctx, poolId, s.Ctx.BlockTime(), denomPair.Denom0, denomPair.Denom1)
+				s.Require().NoError(err)
+			}
+
+			s.Require().Equal([]uint64{poolId}, s.twapkeeper.GetChangedPools(s.Ctx))
+
+			// the records of the pool can be updated again
+			err = s.twapkeeper.UpdateRecords(s.Ctx, poolId)
+			s.Require().NoError(err)
+		})
+	}
+}
+
 // This tests the behavior of computeArithmeticTwap, around error returning
 // when there has been an intermediate spot price error.
 func (s *TestSuite) TestComputeArithmeticTwapWithSpotPriceError() {